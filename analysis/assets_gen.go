@@ -0,0 +1,8 @@
+// Code generated by tools/embed; DO NOT EDIT.
+
+package analysis
+
+// assets contains the embedded files by name
+var assets = map[string]string{
+	"logo.png": "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x0f\xb2\x00\x00\x02\xe3\b\x06\x00\x00\x00%?\xab\xd5\x00\x00\x00\x01sRGB\x00\xae\xce\x1c\xe9\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\x00\tpHYs\x00\x00\x0e\xc3\x00\x00\x0e\xc3\x01\xc7o\xa8d\x00\x00\xff\xa5IDATx^\xec\xfd\a\xc0%\xc9]\xdf{\xff\xaa:\x9c\xf0\x84I\x9b\x83v\xb5\x92VBZ\xa1,$\xc0\xc6`\xc0\xc6\xe4Kz\xf1\xbd&\x180&\x18\xb01&\\#0/\xe6}\x9d\xe0rm0\x06\xeck\x83\xb9\xe2\x8a`\x11M\x8e\xc6H i%mޝ\r\xb3;;\xb33;\xe9\t'u\xa8\xba\xff\xeasFZI\xbb\x92v5\xe19\xcf\xf3\xfd\xec\xf4\xd3}\xba\xfb\x9c\t{\xba\xaa\xfe\xdd\xf5\xafr1F\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00p\xa1\xf8\xc5\x1a\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\v\x82Dv\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0\x05E\";\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xe0\x82\"\x91\x1d\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00pA\x91\xc8\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb8\xa0Hd\a\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\\P$\xb2\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00.(\x12\xd9\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x94\x8b1.6\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcc\xd1\x7f\x16\x15\x1b\x85Шnfj\xdbZ\xc3\xf2A)\xf51\x88\xc1\x96JmS\xa9igjl\x1dB\xd0Zu\xdf\xe2͗\xc7\xd6\xfe\xd7\xc89'\xefry\x9f\xc6\xf5\x9f\x8f\xed?\xef\x17\xe15(ߨ輜2\xe9\x86\xefw\xddA\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0EC\";\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xbb\xcd\xe3\xff46\xedY5aC\xa1\xddR\x883\rμ\xc7\x0e\x84\xd4S\xc0֍\\\xb7\xddʅ\xb6\xdb\xef\x8aU[\x05\xc5h\xfbR\x9awf?ҹ\xa1\x99'\xaf\xcfl;\x1dH\xfbҒ\xf2\xc4\xed\xdcn\xe9\xd8\xfb/\xa7f4\xff\xf3\xa5$v\x97\x16\xdb\x0e\xe9/bR߈\xdc\xf6\xd9:F'\xfb\x1bv\x7f%\xf9\xcc\xf6y;5\x93\xcbgv\xbaW\xec\xfee\xbc\x1d\xea\xc9ey\xb7x\x9fkk\xf0\x12y7\xb0\xed\xfd*\xfc\x95*\xaf\xf9\xa1Ň\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x9e\x0e\x89\xec\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xecp\x93'\xbf7\xfa\xd1\x1fI!̈́>QLI\xdb\xedX.\x8c\x95fGO\xa9\xd9\xf9l(\xdb!\xe7R*vm۵2{m/\xe6\xa2\x1d\xef\x9cߗ֩\xcf\xc0\xfcu\xa8\xdb.\u07fb\xcbS_\xe4\x82w\xe7\xa5\xc5Ni\xdbU\xfbl\xb7\xc8\x11\xb7\x9d\xe7\x13\xda\x13\xfb}\x9a\xd6N\xba\x8cr\xfb+w\xc9\xebi\xc6\xf5n\x9d\xfe\x02\xa6\xeb\x17a\xafˍn\x95\xfe.J\xbblI\x87\xba\\\xf7\xe8\x95e\xf3?\x7f\xca\xebO\xa9\xf9]\xa2\xfb\xe2c\x9c\xfd\xa5s_to\x9bs\xefϑ\x9f\xaf4\xf5\xb5\xbcO\xffF\xfb쳮U\x96_+\x9f]-\xef\x0eJ7\x91\xf4\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x00`\xef!\x91\x1d\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\xcbd\xfa\xd8wź}Bm\xbb\xa5A\xf5\xc7r!\xbe\x7fi\x83-i\xdb\xcd\xf3ŋ5\xc9\xf6w3\xa0\xa7Yһ\x99\xd4m\xe9R\xa4\xedGi\xfb\x92tN\x12\xbd-\xe9\xfc\xc5\xcb2%\xaaϓ\xb5]7\x8b\xbam\xcf_v\xdab_\xeaD`\xe7\xcc\xdf0\xcfQO\xef\x9f\xef\xcb\xe3\xb8\xdb\xff\x01\xd29\xe9\xf7O\xeb\"O{.\x9b\xc6\xfeK\x7f\x8e.\x87\xfdi\x84ve\xb1e\xa7\xd99NY\x97\x8c\xde%\xe5\x9b2T\xdd\xfa}\t\xf0]\x06\xfb\xf9\xedL\xaaϼ\xff\xef\xea\xec\xef\x1az\xb6.\xedu\xfa{\xa7\x03\xf6\xef\xd3%\xf7\xdb?\xaa\xaf\xd5f\x8d\x82\xbd=\xfd\x1e\xe9\xf7\xf3\xf6\xf1\xd1>'\xaao\xaf\xf7)\xcb\x0f(/\x0e\xc8\x17\xeb\xf6\xf1\x03\xe9\x05\xbf8\xff\x83\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0.A\";\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\xc9\xf6\x91o\x88m}L!l\xcam\xdee{\x82|\x97\xce\xdc*\x8bi\xbb\x95wQζ\xb3v\xa6.\v\xbbK\x94\xee~\xcc\xd7ݦSc\x1b\xe9pv>\xdd9\xedO\x19\xd2]r\xbb\xd3t\xb0j\x9f\x972\xd3\xe7K\x9a\xa5]\xaey_Zv6+\x17[&\xed\\|\xee\xfc\xfc\x94\b\x9e\xcf\xf3\xb0\xbb}\xde\xfe;\xff\x1b-\xd6m\xfa\xbc\xb4mK\xb7N\x9b\xe7ϱO\xf1\xd3\xc5\xd6\xe5\xe1\xc3S\x13\xe9\x17\x7f\xeb\xf4\xefs\xde\xf9\x7f\x88\x94\x98o\xbbS\x7f\x89\xf4O\x90\x12ϻD\xfd*M\xe9\x9e^/\xfe~\xe7\a\x02H\xd2:\r$\xd0}\xdc\"\xfb\xff\xfcv\xb76qc\xb1a\xd2>\x97\xdb\xe7\xa7\xc4\xf5\xf4\x1bۿgL\xff>\xf69\xe7\xff\x17\x9d\x9f\xf6\xbe\xfb\xfd2UY\x1a( \xbdqh\x87W\xed-kvȖ4˻/T|\xdc\xef\x9f\xff\x9d\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00`)\x90\xc8\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0sT?\xfe]\xb1\xa9\x8fu3\xaa\xc7\xed\xdfSJ$OI\xe9yL\x89\xea\x8d-\xb1\xcb]\xeed\x8bu\x92\x12\x99\xbbl\xea\xb4\xcc\x13\x9d\x9b<%NϷ;q\xfe\x86\xd8%c{\xe5\xd9H\xf3$\xf3\xc5\a\xd9\xfe\x10\x82-霨0\x98,\xf6K.\x14\xb6.\xec\xf7\xefw\xeb\xf4\xfe\xba\xa8\xba\xf3:\x8bY\xdb\xd3\xcc\xef\xe7\xf5\xf3m;\xf7\xfc\xefm\xfb\xbb\xa9\xc4m\xdd\xfd\xfeR\xdb\xebu\xeb.\x01|\xb1o1\xc1{\xa7\xac\x9e\xf2\xe2r(\x17\x7f\x97\xeeϼ\xf8{t\x16\xdb\xcdx\xbey>\xf9\xde\xdby>m\xcf\xffܓ<\xfd;\xcdu\xe3\at\x7f\xcf\xf9\xec\xf8i\xddw\x8b\xff\x1f.\xcdl?\x9f\t\x7f\xf1\xcf\xd0m\x17a\xb5;/\xfd&\xf3\xf5\xfc3Ϋ\xed-\xde{y\xfb\x18\x9f~\xef.\x81\xbe\xb5\xff\x7f\xf6yjU6k\xe9\rvf\xf7?t\xbeN\xbf\xe5\xe2\xcf<\xcaRb|H\xf3\xce\xdb_\xafo߇C*\xca\xebU\x967\xca\xe7\a\xa5\xeb\x7f\xe4\xfc\x9f\x06\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00v\x04\x12\xd9\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xf8\b\xce\x1e\xff\xae8\xd8\xfa\x99.\xa9\xd97\x8d|[+\xab+u\x99ܡ\x9d\x9f\x94-\x12\xccS\xe6q7\x9bw\xca@NKb\xebv\x914\xed\xd2\xd2*v\xeb.-Y\xc1\xb6\xb38\xe8Μ\x1f\x0f\xf3\x84鴝\x92\x9e\x8d\xafW\xecT۶%%>\xa7<\xf3t,%G\xa7\x84lW\xa5\x19\xd7\x17}\x00b\x9a]\xdc\xde۽N봙>w\x91\xeb\x9cv\xa7\xed\xf3\t߶\xdd\xfa\xd6V\xf3\xe3>eh\xbf/\xa9\xdd\xd6ݹ\x8b\x19˻~\x06\xe9\xd8\xf9\xfd\xf3u[\xa4\xbf\xcb\xe5\xe3cJ0\x9f\xff\xfd\xdf\xd7\x15\xc2\xfel\xef\xfb;\xbdo$\x01\xfb\xb7\xf5\xf3\x7f\x93\xe8\xe6I\xeai\x9f\v)Q?\x9c\xff\xe7\x9e\xfb\xe0\xed\xee\xa3\xec\xef\x9b\xfe\xce\xe9߽\xfb\xbb\xdbb\xda4\xa2\x80\xfd\xff\xf2\xf6\xef|~6\xfc\xee\xff\xe5\xe2\xffC\xc8\xe73ƻ\xf4g:\xff\xef\xfb\xbe\xc5)\x16\xe3\xf9\xc7/\xfe\xbc\xdd\xf7\xe5\xfc_\xa4[/\xfe}]\xfa\x9c\xa2[\x87\xf4\xef\xde\xfd\x81\xd3\xdfid\xbfk\x9a\xeb\xff\x90\xfd^\xd7)\xefݢ|x\xb3\xca\xeb\xfe\xcd\xe2\x03\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\xe0\xd2\"\x91\x1d\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80䑯\x8ejO+\xd6G\xd5T\x8f\xca5\x9b\xcaB+\x17\xfav\xb0'\xb9Ō\xe7\xc0\x12\nn(W\x1c\x90+_ \xf5_*=\x9f\x19\xdc\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\\\\$\xb2\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xf6\x9c\xe9\xe1o\x8dmu\xafBxP\xb1=\xa6\x18&ʛ\x97ʹ\x89\x9c\xdfT涔\xc5\xda\x16/\xb5\x85\x14m\xf1\x8b\x19Ɂe\x14\xa7\xf6þ\xcf>W\xc8\xfaj\xb3\x81Z\xd7W\xb0\xd7r\x85\xf2\xf5W\xab\xcco\x92\xae\xf9!\x12\xdc\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\\\x10$\xb2\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00v\xad\xea\xd8\xd7ĺ\xbaSM\xfb\xb8\xf6mN\x14۠ش\xb2\r\xf9`'\xa4\x94]\xe7\xd3\xd3s\xcd\xdc\xcc6\x83\xf2,\xca\xdb.\xa5$\xf6\x94\xf8\xdb\xe6R\xc8\xec\x9c\xc6^\x03K\xaa\x8d\xf6\x1dN_\xfa\xd4O$\xad\xd3\xf2\x14\xfd\x83vh\xa2\xcaժ\xb2\xa1T>Oe\xffU\xb6\xfa\x19\x12\xdb\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00<'$\xb2\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00v\x85\xfa\x91\xaf\x8cu}Z\xa19.ߞT\x11G*\xda\xc6\x0e\xd8\xc1&v\xc9\xebʲ\xf9\x922Ճ\x1d\va\xbeN\xcf\xce\xfb\x8bD\xf5.\x81\xdd\xd9~[\xdb*\xda\xe2\x9c\xfd \x8f\x1dK,\xf4Ҍ\xec)\x97ݾ\xff\xb6t\xeb\x98Ͽ\xe0!}\xbf7\xba\xe3\xca\xfav\t\xf4\x15\xecP\x9b\xd5j\xf2\x91Z;\xbc\x153\rz/\xd1\xca௪\xbc\xe1\xc7m\x0f\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00|x$\xb2\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x96\xcfC\xdf\x1f\xdb\xfav5\xf5\x9d\n\xedÊm\u0530\x94\xdaږY)\xdf\xf4\x95\xfbB\xf2\x95-3{C\xd5奧\xfc\xf5\x94\xb7\x1bC\xa6֖\xa8\xdc^{۟\xabh&\x8b\xa4\xdey\"{\xdaLӶǬ\xb5\xe3\xf6\xa2!w\x17˫\xc9\xe6\xeby?\x91\xf9\xcc\xecݶ\vݫ~x\xb1\xfd\x1c\xdbw>%\xb4o\xcdωvQ\x85\xbe\xadm\xc9\xd2u1\xb1\xcbcS3{o\xe5\xd7力U\xf6^\xa22\xbfV\xfe\xe6\xff\x83\v\x04\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0\a \x91\x1d\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb0\xb3=\xf6\xcd1V\x875\x9b\xbcMj\x82b\xd3\xca7Qyt\xcab\xca\xceuRZ\x0f\xd3\f\xd33{9\xb5\xa5\xb1%S\f}5u_m\x93\xab\xef6\xa5\x94\x90\xee[E\xb5j\xa2\xad]H\xa7\xc9ے\x8dV\xe6\x1b\x89s\xdd9r\xadZ\x97\xa6tO)\xef\xc5\xfc\x18\xb0\x8c\xea\xe1|\xedS\xf2\xba]\a\xd9<\x89]ݸ\rN\xe3p\xae\xdb\xcc\xed\xc7\xfc\x90m\xb4iZv\xfbާ)ٵm;m;\xb3ť\x93\xd256M\x93\xbb+\xda\xcbm\xbb\x06\xb3\xfcj\xe5動\xf7^\xad\xfcy\xff\x9a\xc4v\x00\x00\x00\x00\x00\x00\x00\x00\x00`\x8f#\x91\x1d\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb0\xa3L\x8f|w\xac\xa7\x7f\xaaXݯ\xb2\x1a\xabl\xfb\xf2a(\xe5\xc7\xd4%\xe0\xa6iՕ\xb2g\xe3|I\xd3I\x9bƶ\x9d\xcb\xe4\x94˥٣]\xab\x90\x12mc\xa3\xf4d\xbcl֟\xf2\xfe\xf4\xde4\xe3t;\xff\x8c\xb4ǧ\xf7\xdb\xf1\x94ܻ\x10B\xb0\x8fq\xdd\xccվ{\x1f\xb0\xa4\xfct\xb1aR\xf6yJ[\xef\xbe\xea\xe9{\xedՔ\x95}\xf5\xd3\xe0\x0f\xa5\xd4f\xf3\xbdv\r\xc9U\xb6\x91N\xb4k0e\xac\xa7\x04\xf7\xb4\ueb93\x94\xcc^\xdbq;\xcfޟ>\xaf\xb1\xf7Wq\xa8V\a\xe4\xf2C\xca\xfbW*+VU\xdc\xf2\x16\x12\xdb\x01\x00\x00\x00\x00\x00\x00\x00\x00\x80=\x86Dv\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0e5y\xec\xabb]\x1f\xd1\xfa\xe6\x1fuI\xb2\xb1I3\x9f\xe7r)q<\xab%7S\xb4\xff\\\xb5\x9a\x9erw\t\xb4\xad\x9a\xf9\x8c\xea)\xf9<\xe5\xe4\xdaҫJ\xdb\b\xf3\xc4\xf4\xf4(<:[\xd2\xc1\xf4yNM>\xee\x12ҟ*%\xaeg\xcew\xeb\x99\xfd\x97\x92w\xd3)\xe9\xb7I\xdbvT>\xda禙\xa8Cս\aXF#\xd7\xeb&c\xf7\xf6\xe5\xf6v\x05ev\x91\xf8\x98\x12\xd1\x17'\xb4\xb6\xed\xd3\xf5\x92\xdb>[w3\xb7\xa7D\xf6ڮ\xaaV3\xb7\xa2\x18\x1a\xbb6\x9a.\xc1\xbd\xb0O\xf1\xe9\xdaJI\xf1ii\xd2 \x11v\xfd\xe5隵k\xa5\xbb\x0eӇ\xa7\xeb\xafЙld\xd7\xf4U\xca\xfb\xaf\xd2ڋ\x7f\x8b\xa4v\x00\x00\x00\x00\x00\x00\x00\x00\x00`\x0f \x91\x1d\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00pi\xdd\xf3\x95Q\x93wK\xed#\x92\xdfTJ\xa4MO\xae]۟\x1f\a\xb0\xfb\x84ܮ\xf74\xbb{\xad:D\x858TY\xdc 7x\xa14\xb8V\xba\xe9\xa7In\a\x00\x00\x00\x00\x00\x00\x00\x00\x00v\x19\x12\xd9\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17U\xf5\xd0\xe7\xc4\xe9\xe4\x0eŐ˵c\xf9fSe\x98\xa9Ls\x9e\xbb\x81\x14\a\n\xc1^eO.\xde\x01`\u05c9\x85\x94\xa7Y\xdfk\xc5بn3\x85\xb8\xaa\xcc\x1f\xb4k\x7fMg\xea\xc75Xy\xa1V\xd7>I\xba\xf1ߐ\xd4\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x00\xec\x02$\xb2\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00.\xa8\xf1\xa3\xff0V\xa3\xff\xa1\xacz@\xc3v[Y̤\xd8Ө\xd8R\x1e\xa5\xc2)\xa5\xb0\xcfgh\x0e\xa5\xd4\x16jl;\xcfF\xdd\xfb\x01\xecB\xc1.\xfc,\xcc\x17\xd7\xd85\x1f\xd5\xdaf\xe8J\x03\xa7\xc1\xec\x80\x1d\xdbV,\xa6\x1a[\xd1\xd0\x14\xb7\xc8\xf7?Yk\xb7\xfc\x17\x92\xda\x01\x00\x00\x00\x00\x00\x00\x00\x00\x80%E\";\x00\x00\x00\x00\x00X>ǿ;\x86\xe6q5\xe1I\xc58R\xef\xdc\xfdRH=\x9f\x1b\xd9\x0e[\xda\xf9yn\x91\x19\x91\xf6\x03؛\n[\xa2\x15\x04\xd1ʃ\x90f\xfb\xb4\x1d.\xb7\xb2!\x1d\xc8T\xaf\xbd\xb4;\xed\x99\x14\xd9+\x17e\x89\xbd\xf7\xc6\x1fx\x7f\xf2\xc4c?0\xbf\xb1z\xe3\x9bH\xa8\x00\x00\x00H\x8e~m\x9cN\xeeR>zH\x16\xb0ɅV\xde\x05\xf9\x98\xe24[B݅m\xdeퟷ\xaf\\jN\xa5ح\xea\xd6\xd17]\xb3ͷ\xfd\xf4i\x00v\xa5\x94\xc0\x9en\xdd\xc4\xeez\xef^{+\vRb\xbb\xf1\xe3T6d\xb6\x91\xe2\xb5\\!\x95\x13v\xdc\xd9q\xe7\x9d\xc5o\xafTQ\xbc^\xba\xfeG\x88\xc3\x00`\x87:\xf9\xe0+\xa2\x9f\x1cQ\xafYW\x13N(++\xadO\x86\x8b\xa3\x00\x96M\xc8\v\xd5M\xa9\xe8F\xea\xf9\\\xae\xdc\xd6H/\xd4\xcam\xf7\xd1\x1e\x03\x00\x00\x17]|\xf4M1\xb4\xc7\xd5Ɠr\U0005c726\xca\xcfܹ8\x8a\xbd\xe8\xf1r\xac\x15w\xb55T\x9fT\x9e\xe7\nÞ4\xf8l\xad_\xfbfڧ\xc0\xb3\xb0u\xffg\xc5z\xeb.\xf52gqަf\xed\xb6\x0eL\xcb\xc5Q\x00\xf8 \xedL\xf2W\xabq\x8d\\\xf6\xa4\xb24H}e\xf5\xb1\x1b\xab*O\xa9|M\xea\x98\xf9\x91\x91\xc8\x0e\x00\x00\x00<\x17\x8f\xfd\xb3\xa8\x1b\xbf\x8f\x9b_\x00p\x91\x84ǿ5\xce\xea\xbbU7\x8f*\xb4\xe74\xacF]\xbff\x85(\x9f\x96֊`[k\xb1O\xbd\xd2\xd6!\xf5\x84\xb6\xf5\"\x99=IoJ\t\x12\xa1\x9e\xbf\x06\xb0\xf7\xa4r \x15\t\xe9~i\x97)\x91\x12\xd9m\xf1y\x97 \x11\xdcvw\xda3i\xdct\x91Ǟ\xdb\xe2\xe5\x94͓.\x16\xc5L\xdbL\xed\xa3\xec\x98\xcbm\x9fWm\xe5S\fv\x9e\x9d\x9fe\x85\xdaѸ{o\xe6K;\xaf/e\x03\xa9[[\xb9e\xef\x99\xf6?\xd3\xce\xf5\xea=\x8f\x84x\x00\x00\xb0|6\x1f\xfc\x9c\xe8fw\xaahN\xab\xd7X\x1b\xa8\xcd,\xfe:k\xed\x9c\xc5\ti\x9d\xa5\xc1\x84Jk+\x95\x16\xbeyeլk\aY#\xc8\xdaM)\x94K\x89\xac\xad\xb5\x9d\xec\xdc\xdc\xde\xd0.\x06&\x03\xb0\xfbإ\xdf\xc5g\xad\x95\v1\xc5c]Q`\x1a\xdb\bVF\xacY\xb1\x91\x06\xb7\x98X(W[\x01\x91\x8e-X\xb9\xd1T\xeb\x8a\xfd-ŕ\xa8P\xdel\x9f\xf7\xd7\xd5\x7f\xdeO\x10K\x01\xc0\x0e2{\xef\xa1\xd8\x1bm\xd9\xd6~[lݟ\xdaN\x8aj`ie+\xd6$\xb3k8\x8e\xe5Ut\xf7\xcb7\xfd\x8bu\xf05\xf7ra\x03\x00\x80\x8f\xdd#\xdf\x1c\xab\xfa>U\xd5Cj\x9b\xe3\nq\xa2^nM\x8f\xf4l\xbf\xf6\xf2mZ2k\x87\xe4\xcac\xd1=\xab\x97;\xbdx3\xf6\xa2\x90\xaf\xd8w\xe2Z\xfb~\x1c\x91|\xa5\xa6gߎ+\xbe^\xba\xe9\xc7i\x9f\x02\x1f\xa5\xf0З\xc5\xfa\xcc[\x94\x8d\xe6ݗԳ%=\x9a\xab\xb9\x8c\x00<\x83Y!\r\xd2C\xbe\xa95\xd4ҳ}/UN\x95/U^qPz\xd1я\xaa\x00!\x91\x1d\xd8\xcd\x1e\xff\xea\xa8PYᰥ\xb6\xb6\xa59\xa7\xb6\x1dY\x991\xb6\x82\xa3V\xcfZ\x1e\xf3\x8e\u05f6\xa4\x99.l\x9d:f'\xce6\n&,\x04\x00\xe0CLC\x90o\x06\xaa\xf2B\xfe\x8a\xcf\xd0\xf0\x96\x9f'r\a\x80\x8fő\uf39a\x1dV\x9c\xfcF\xf7\xb2\x9b\x90\xafKHOk\xfb\x91\xd6\x16\xab4\xb1펥X\xc5w=\x9c\xd3éT\x04/\x96\xa2ߝ\x97\x96\xf9\xbd\x8eEOg{Sz\x0f\x80\xbd,,ʅy\xa2\x94s\xd9\xe2\x01\xf7\xa2\x1c\x89g\xe7\xa7=\x83\xba\xad\xbar\xa4+\x7fR\xf2\xfbB\xf7\x91Ƨ{/]Q\x94>\xcb֭\xfdHEPzm\xbfG\xeb\xb3.\x87>\xfd\xfe)Q\xa3\xfb}\xd3̂鵽ѻS\xf66o\xa7\xa7\xd7\xde\xf6\xa7'\xf3\xce~\xa5\xf3\xbdfnlg\xe5\xf6\xb6\x81r\xbf\"\x9f\r\xe7ko\xe5\x9e}v\xb5\xef\r*\xaf\xfc\xffw\xbf\x03\x00\x00\xc0\xc56}\xf2\x7f\x8f\xfd\x93\xbf\xdb=k\xaa\xdb3\xb6NIJӮ\x15\x932RS{\xab,֬\xadd\x1b\xd6`ri\x9d\xa4\x01\x85\xba\xf6\x935[\xf2\x94\xc8\xfe\xfe\x98.\xb6)\xde;\x9f\xbcn'\xa56\x13\x80]\xa9uv\xad\xa7\x01-\xd2\x00c]V{*\x1b\x16ϫ\xad\x1c\xf0\x99\x95)\xdd@\x17+v,\xdd\xeb\xb12\"VV\xc2\xccl\xa9勠\x10\x86Vr\f\xedP\xb4b\xa7\xb1\xd8h\xd5b\xa4\xebl\xdf5\xd2m\xbfLl\x04\x00\x97Q\xf5\xf07\xc7x\xee\xffRo4\x98\xb7\xf7\xbc\xb5\xfb\n+\xfbS\xc7F\x00\xcbib\xed\xb3\xcc\xe2\xb448l\xbdfM\xb83\xaaˏS\xf1\xea\xbbiw\x01\x00\x80\x8f\xda\xec\U0006f223\xe9;U\u05cf\xe9\xc0\xf6!)\xa4{\u008d\x9c\xab\xad\xa9ak\xd5\x167\xa4{\xc9A\xb1\x97\xee/[\x1ca\xe7(ZLa\xc7}\xba\xa7\x94\xa4\x16H\\\x9focO\x8a\xbdM\xb94\x0ef\x9a\xcfĚ\xa9\xd3|]\xfd\xd7l\xd06\x05>J\xf5\xf1/\x88\xe3ӿ\xa2}i\xf6\xf5\xae3f\x1aPvq?\xde3Q\x10\x80g\xd0X\x99\xd1;\xa3\xb6͔5WZy1\xd2v\xdcP\xbb\xfeJ\xed{\xf1\xed\x1fu=L\";\xb0\x1b<\xfa\xcf\xe2lv\xa7\xaa\xfa\x01\v\xdcN\xd82Rf\x01[o:\xec:\xfe\xc4ԡڂ\xb8,\xd6\xf3\x8eD\xa9\x88HK\x9b\x1a\x1c\xf3\x8eD\x1d\xb7\xe8L\x94\xf6uR\a\x02\x00\x00\xf0\x01\xd6&\xf3\x9b`)\x89\xe9\xaa/\x96\xae}37\xc1\x00\xe0Y\x98<\xf6U\xb1\x99\xfc\x99\x95\xa5\x87\xd5k\xa4\xb2ɤf`G\x16\xc5i\x17\x9f<%NI\xeb\xb49\x9cُy\x92g\x97\xd4\x10S\xa2gJn\xb0\xd7.S\x13S\xf2D:\xfd\xfcë\xc5\xfb;i\xae\x88\xd49\x1a\xc0^\xf4\xb4\x83Y<\xb5\x8c\bih\xe1g溧\xa0\v)\x01\xab\xf3\xfeηqq\xff$\xfd>\xe7?\xb5\x9bUp\xf1\xfb\xe6\x8b\xf2)\x95g\xe9\x8eK\x1a=>%\xb6\x9f\xbf/[\xbc\xef>\x8c\x99\x1f\xb07\xa7\xedTޥ\xedt<\xfd~\xa9\xbcKe_J\xeaX\xbc\xb6\xa5\xadS\x92}\xfa=\x83\x15\x8b\xadBz\xb0\xe4mm\x7f\xc7\xe8\x83\xc6\xe5k\x94\xa5Ď\xec\x90\xf2\xfcz\rn\xfa\x91\xf9\x1f\f\x00\x00\xe0\xa3\x14\x1f\xf9\xa6X\xcd\xfeLM\xf5\x88\\3\xd6p\x9c\xda%\xd6FI\x1d\v\xb3\xb4N\xed\x8e.\x92\xeb\x9a/U\xbbj\xad\x94hm\x90\xa6\x9b`݅t\xd0\xda0i\xdd\xc5rc\x85\xccޓ\xcf\xdf\xefԤ\x8f\x98\x7f@ZRg\x19\x00\xbbR\xd5\xda5o\x01L\xcagLC\x8cu\xc1L2\x0f\xa2lcde\x84\xc5X\x16\xa7E\x95\xdd\xeen\xf4\xf5\xdc\xe2\x9c,\r\xe0~\xc8vlYY3Q\xba\x05\xe4\xad\\\xc9\xd2@e\xa9<\xc9[\x9d\xb1\xb2d8x\x91\xfak\x9f-]G\xec\x03\x00\x97ڹß\x12\x87\xa3?Q9\xbd2\xbd\xb22<j\x1a{\xca\xe3S\xee\xaf\x01X*\xd1\x1dT\xebΨ(\x06\n\xd6\x16k{\xa7Ԯ~\xaaVn\xfe\x15\xdaZ\x00\x00\xe0i\xd5\x0f~M\xac\x9b3\xaa\xab\xa3\n\xcdq\x8b\a\xce)\xf7\xadJ\x97)KϻÆ52\xec\xc4\xee\x86\xf2\xf9\xc5ۏ\xb4H\x8d\xff\xc0\x99\xf8\xbaG\xe3i\xbdh}\x84\x96\xfc\x86\xbd,\xaa\xa7\xac\xc9\xe4\xfdA\xfb\xca\x1cը\xb8Q+\xafx\x90\xb6)\xf0\xd1x\xec\xef\xc7͍\xb7ȍ\xcfjMî\x80\x8dmT\x15\x06j\xb3\x99\xcal\xba8\x11\x00>P\x93E\x85\xd8X\xbbN*ۡ\xda\xd0j\xb2r\x83V_~\xf8Y\xd5\xc1$\xb2\x03K\xa6>\xfa=q:z\x87\xaa\xea\x11\xedo\x1e\xb5\x8b\xd8[\xd8f\xd7}\x17Х\xc0-%\xad\xdbʖi\xbfLo\xb1\xe0\xcf[a\x91α\x9dݬ`\xb6\xd8v\xf0\xf3\xf2bޱ:\xbd)\xe9z\bu\x9a4\x92*\x00\x00\xf8\x00y\xb0\x9a\xb7\xe9KŊt\xed\x17\xd9\xf2\xc3Ϫ\x01\x0e\x00{M\xf5\xe0\xff\x1a\xdb\xc9\xc3\x1a\xcc\xfe\\\xb1ɺ\aJN}yo\xf1FJx\xe8b\x98J\x95\xc5%]l\x92\x121\xcf?}Z\xc4,I\xf9\xd4\xe7T]<c\xe7\xa5M;%\xdd\xdb(R'\xe7\xf3\xce'\xa8\x9e_w\xb1\x10\xc55\xb0gu3\xa0'\xa9̱\xa5+\x1bl\x9dʆ\xee\xf5G\xb8\xff\xd1\x154)\xe9jQ\xa6,\x1e\xa0\xbfoݕ]\xf3c)\x99\xdcٶK\xc9\\\xb6N\xe5\x93o\xe7\xbf\x7f\xfa\x98\xae\x8cK/\x16租Y\xfd\x94Dz\xdb\xdf%j؟\xab;\xdfdݠ\x1d\xe9Ϟ~\xb6\xf3\xcf\xe8>;\r\xdc\x11\x94\xb7\xe9\xcfaKw~Z\xcf\a\xf9\b\x8b'\xfa>nw\xeb\xee\xcf\xdf\xed\x8bV$\xda\xfbӖ\xbdg\x16z\xdd,ﾸJyy\xbd\xb2\xfeM\xf6Or\x9d\xb5s\x7fp\xf1'\x00\x00\x00{\xd1֑\xaf\x8e\xb3\xf1;\xe5\xaa\a5l\xc7\x1a\xa4\x04\xf4\xda\xdaM\xa9m3\xb4\x00\xadkLX\xdb\"5^\xba\xc5\xf6w\x9d\rM\x7f\xc3~\x94v\xae\xb5sBJio\xed\xf0\xc4\xda\x1c\xf5\xbc\xa9\xd2\xf4\xbbvScG\xba6\x8f5uʦ\x94k\xec\xf3S\xc2{o\xd1~\x01\xb0\xfbd}\x8bKR,\x93fyI3j\xcdc\xa3.\x9e\xb1\xd8*X\xd9\xe1\xac̈i\x80\x8b\xb4\xbf+kҒʇ\xb4\xc7\xdeӝk\x85If\xafS\xf1c'\x84\xd6\xdek\xb1S\x99ʐl\xac\xaa\b\x1a\xe7\x03\xd5\xfd\xdbT\f\xff\xa6\xf6_\xfb\x03\xe9]\x00\x80\x8b\xec\xcc\x1d\xb7\xc4}\xb3\x87\x95U\xd7H\xfe\ti\xe04\r\xfbԯ\xe9\b\r,\xaf+\xac\x05vTy\n\xf1fCy\x8b\a\xf3\x83_!]\xffS\xb4\xaf\x00\x00\xc0\xfb\x8c\x1e\xf8\x9b\xb1\x9a\xbcCm}ZW\xa4\xc7\xef\xe9\xf6Oz\x8e\x9d\xee\x1b\xa7\xbb<\xdd\xfd\x9d\xd4\xf1\xa7U\x9b\xadt\xfd\x83\xba>B\x8b\x11N\xd3s\xf0\xf4l\xbd\xeb\xffS\xe5\xb6Ϥ\xfb?]\x8b#\xfdH\xf7\x85\xe6\xf7\x82\xa4I\xfa\x81=*h\xa0\xb69\xab\xa2<\xa8:;\xa3\xa9\xbfVk\xaf8F\xdb\x14\xf8(T\xefr1\x9b\xbcHYa\xe5h~\xd4\n\xefT`_\xad\xb8zNu\xbe\xadr\xc2@\xd3\x00\x9e^ݟ\xca\xcf\x0e(\xcbS\x7f\xef\x91\x15(+\xd2\x1b\xb6\x9fu\xfdK\";\xb0\x93=\xf1=Q\x93\xc7Վ\xefS={D\xb96\x94\xc7`-p\xbbփ5\x1a>h\xc41\x00\x00p\xf1\x85\xb6'_nhj\xd5q\xffU\xe7Ӌ\x00\x00\xef\xf3\xc8wFm\xfd\x994\xbbW\x8a\xa7\xe7ϒRi\xd9r\xa3\x13\x00\x96Qۍ\xb8\\H\xd9\x15\xf2ٍr\xe5\xcdRϖ\x9b\xfe\x05ma\x00\x00v\x9b\xbb>5\xce&\xefR\x8f\x193\x01\xecV\xaf\x9b\x10\xc7\x00\xc0E\x14\xdeuEt\xf1\xb4b\xb8V>;\xaeP\xa7|\x95\xfd\xca<\x89\xec\xc0\xf2\x9a\xa9\xc9\xd2̩^y\x164\x8d\xb5\xfa\xaf\xa6\x9f\x04\x00\x00{ڑ\xaf\x8d\x9a\x1cW\x9c\x1cVU\x1f\x95wc\x15i\xa2\x8a\x94\xdb\x10J\xf2\x1bpQ5e\xab<u\xdemk\x85\xecj\x9d\xb9\xa6\xd5\x157\x9d\xa4}\n|$\xefH3^\xd0\x7f\x13\xc03({\x8a\xd5X\xd1\a\xb9\"S\xd54\x8aѩ\xc8R\x1b/*\x9b\xde,\xe5\xa7l{\xa4iyH\xee\x9a\xcfSﺟ|\xd6\xf5/\x89\xec\xc0N\xf3\xd8w\xc7\xed\xed\xdf\xd1x|\xa7\x0ef\xafV\xd3l\xaa\x8d\xa7\xe4\xb5aA^\xad<͜\xd5\xe4\xf3Q\xca\xf2\xf9l\\\x00\x00\xe0\xd2I3\t\xfbb[\xb3\xe2\x80z\x1f\x7f\x9a\x1b`\x00`\xb6\x1e\xfb۱\u07beKY\xbb\xa9\xa2zR\xbdv\xa4,\xddn\xe8fV_U\xd4@N\x9bݹ\x00\x80%\xe3z\x9aϒ\x18T[\xb1ަ\xfb\xd3>SȜ\x9c\xf7\x9a\x85\r\xe5\xf9>[\xaeWѻEE~\x8bt\xfd\x0f\xd3N\x06\x00`Il\xdc\xff)Q\xf5\x1d*ڳ\xea\xb7^\xbe\xeblHU\x0e`wڎS\xf5\x06/P\xb1\xf6\x99\xd2\xcd?Na\a\x00\x17Xx\xe7z\xf4\xedD\xad\x0eʗ'\xa5Y&\xf9}r\x1a/\xce\x00\xb0t\\\xad\x90犭S\x16[M\xf3\xbe\xfa\xafܤ\x1d\x05\x00\xc0\x1e4\xbb\xf7S\xe2h\xf4\x1e\xed\xcbnRh\xb7Ԇ3\xf2n[E\xd6ʹ4\xf0M1\xcfq\xb0\xf6\x03p\xb14\xbdZ\xf9ľk1\xaa\xc9z\x9a\xde\xf8\n\xad^\xf3'\xb4O\x81\x0f\xa3yඨ\xb3\x8f+w\f4\b\xe0\xe9E\xb5\n!\xc8\xe7\xa5\\\x9e\xa9\xa9\xad}\x17\xbd\xb2E\"\xbb\xe2@j'\n\xfd\x91\xb4\xffS\xe5o\xfe\x83\xe7T\xf7\x92\xc8\x0e\xec\x00\x9b\x0f\xfe\x9d\xd8L\xfeHy}T\xab\xad\xe4\xc3\xd0.\xf4\xfd\xaa\xfc\x93v\x95\xda\xc5/+\f\xbc\x94\xa5$\xf68\xbf1\x1cSg\xa2\x9c\xeb\x17\x00\x80K-\x86\\.\x9b\xa8\x19\xbeB\xf9K\xdf\xc9\r0\x00{V\xf5З\xc7`q\x8c\xafN\xab\xa8K\xb5n\xaa\\\x16\xd0$]阦b\x1f(Ɓ\x9a\x98\xa9p\x1bi'\x00`\xd9\xc4ܖt\x0f\xca\xcax\x17\xd2\x1d\xe5y9\xef\xa3\xd2^W7\xf6\xc3v\xa4Q\xf6\xbb\xf3l\xbfm\xa6\xfc7g\xfb7W\xae\x94\xf7\a\x95\x17/RQ\xbeB\xc5u\xff\xdf\xf4n\x00\x00p\x99l\x9c\xf9\xeeX>\xf1\xcb]G\xc3\xd8nʷ#\x15\x16\xbe\x15\xa9\x86N\xb3\xe5X\xfc\xd6=\x8c\x06\x80\xddhVX\xa02\xb6\xa2\xaeմ\xc8\xd5\f^\xa6\xde\xfag\xa8\xbc\xe1_\x11\xa7\x00\xc0Ǩy\xf4\x1f\xc7\xfcԏJ\xd5\x01U\xbeR9ؐ&=)[\xb3\xf6\xe5\xf6\xe2,\x00˧U\xecYS\xa9.\xe4ڙ\xaa\xe1\xad*o\xbb\x8b\xb6\x13\x00\x00{@u\xf4Mq:\xfa-eӻ4\xac\xa7r͊5\xfcW4\xe9?iG\xa3\xbc\x82\xb24/_\xf7\x9cx1I_Z<\xf7\x97q\xf1\xd4\xe5T\xc5x5%\xd6h\xe2\xc7\x1a\xbc\xf0\xbb\xa4\xfd?H\xfb\x14x&\xf7\xbf!֧ޮ0\x18\xaa\xd70\x91*\x80\xa7\x17|-\xa7R\xce\xf7\xec\x855\xedj{\x9d\xfa\x01f\xa9\uf01d\xe0\xb6\xe6]\t\xd6^\xaf\xec\x85o\x7f\xce\xf5.\x89\xec\xc0\xe5\xf0\xd8?\x8b\xb3\xe6/\xd5\xdbx\x9b\x85q\x8db\x9cȻv~q\a\xbb\xc8\x1b[\xd2\xf4V+\xe3\xf9\xbe$Z`\x97\x8e\xb9.\xad\xddvx\x8b\xf3hH\x00\x00p\xc9Y\x9d\xdcZ\xbd\x9d\x1d\xfc\x12\xe9\x05?\xcb\r0\x00{\xca\xecط\xc4\xe9\xf4wԎ\xefSo*\r\xea^\x17\x9d(\xce,D\xb9\xda\xd6\xe9\x1eC\xba\x8baK\x9a\x92\xdd[\x9c\x93\x055vR\x9e\x12\x1d\x01\x00\xcb\xc7U\xf6\xc3\n\xf2\x98F5\xb7E\xe9\x86uZ\xa7\x04wk\x0e\xfb\xa3\xf3\xe3\xe7\x97t\x0f\xeb}\xafMH\x83\x9c\xd8v\x96)\xe4=\xab\x13\n5Y\xae6+\xec\xed\x99\U000b5fe6\xe1\x8d̄\b\x00\xc0Eu\xfc\xbbc\x9c\xfe\x99\xea\xd1\x03j&\x1b\x1a\xc6\xc9|\xbf\xd5\xc5\xf2V\xa7[\xfd\xac\x10\x14k\x8bߚFE\xcf\xf6\x01\xc0.\x94f\x94\xe8f\bK\x83vt1\x8b\xc5)\xbeT]\x14j\xf3\\×>Ll\x02\x00\xcf\xd1\xe8\xfeO\x8d+[\x7f$U7k\x96\x1dWo8S\xdc\x1e\xca\xe5\x03+wG\x8b\xb3\x00,\x9b\x18\x9d\\\xbf\x91\xea\x9e\xdaz&w\xf0o\xc9\xdf\xfaV\xdaL\x00\x00\xecf\xefyE\xec\xfa\x01\xc5-{1\xb6u\x9aa=H\xa9ۏ\xad\xc2pq\x7f9%\xad\xa7\xd1\xcd\xe5\xe7㡧\xc1ϻԤ\xc5sb\xe0\"\xe8\x12ٷץ\xdci3\xdf\xd2\xfakR\xd2\r\x80\xa73}\xe4\x7f\x8b\xfdS?וߡ\x97\xcb7<\xff\x03\xf0\xf4b/\xc8\xc5\xd2\xca\v+'\x9a\xd4\xe8\x9bZ\x93\xce\xd6.\xf5#\xc8Uǉ\x8a}/\x96^r\xef\xc7T\xef\x92\xc8\x0e\\J\x8f\xfc/Q\x93\xbf\x90FO(LZ\xf9\xbe\xedK\x97`w\x19\xa6\x8eB\xb9\xc5s^Ѣ\xb9`;\x8bƂ\xc0\xc5\x03\xf4\xf40=\x8d]֍R\x96\xf2\xd9\xd3:\x95\r\x00\x00\xe0\x12\x8b\xaa\xd5S\xf1\xba\rn\x80\x01\xd8\x1b\x8e~o\f\xd5\x1f\xc9m\xdd\xde\xe5\"\xda\v\xf9\xac\xee\xf2\x1c\xbaX\xa6Z\xeb\x96vx\xac\xcbcwMz@e\xb1K\xb4\xd8&\x8br\x99\x93s\xad\\\x9d\xde\x00\x00X6M\x16\xbau\xeaw\x90\x86W\xec\x96\x10\xad\xcc\xefnh\xa9Ձn\x9dz-xg\xe5\xbeU\x0e.\xcd\xda\xde%\xb0\x9b\xe9\xe4\xfd}\x15R\v:-O\xe9\xbbP\xe7\xebVe\xd4j\\cKώ]\xad^q\x9bz\xfdWH7\xfd3\xda\xdc\x00\x00|\f&\x8f}A\xac\xc7\xefP1>\xab^euu\x93\x06U\xae\xad>\xbeَ\xa6\x01\xc9ƶ\xcc,vk\x14,v\x93K\x95\xb4W\xd6\xcc\xeby\x00\xd8m\xc6\xf9>\x15n\xaa<nt\x1d\xac-\x8c閐\xeecžF\xe5D\xbd\xe1\xf3\xd4[\xf9|\xe9y?J<\x02\x00\xcf\xc2\xd6]7ŵ٣R\xf5|\x8d\xb3G\xd4/\xa3\xdcdhM\xcc\xc2ڙ\xa9\xff\x13\x80e\x14C.\x97\x92ժ\x81\xaa\xbaQy\xfd\xb7K72\xe3%\x00\x00\xbbM8\xf2\xcdq2\xfa\x13Uս:P\xd5\xf3{&\xe96q\xcak\xc82[R\x0e\x83SpQYz\f\xdc\rn\x9ew\xf3\\t\x13\xb0\xa7\t\xfd\xd2s\xe5\xd4J \xbf\x01\x17Q]Vʷ\xd6\xe4z\x8d\xce\xfa\x9e\x0e\xbc\xe64mS\xe0i4\x8f\xfd\xd3\x18N\xfds\x95\xb5\x95\u05fdB\x1aےS@\x03xz\xb3\xac\xb2\xa6\\OY\xea\xff\x9d\xee\xe5\x16V^\xa4\xe6^\xf7\x1c\xadԴ?P\xff\xb6s\x1fs\x9dK\";p\xb1=\xf8\xf5q\xd2\xfc\x9e\xda\xfa!y\x8b\xeb\x06v\xc9u\xa3T\xa8P\xeb*9\xbb\xd0e\x8b\xefF}7~j'\xa4\x8eCv\xd1OWl\x9d\xb2\xd6\xedZ\xef\x96T\x024j\xed\xbf\x14\xf0e\x91\x11q\x00\x00\xb8\xe4\\\xa3\x99;\xa0\xdekNr\x03\f\xc0\xae\xd6<\xf6%1\x8c\xfeD~tF\xf9,\x8d\xb4WY\x183\xb0%u:\xb3\x13\xc2LM\xac\x15\xbc\xc5))\xe1\xa1W\xdbn/\x9ff\xe9m-V\xb1\xb0%%\xbd+6\xdd@\\YH#y\x01\x00\x96\xcd,ݛR\xb0\xb2<\xcd\\hU\x80\xad\xb3\xee\x1e\xd5\xfc\xbe\xb2\x15\xf3]\a\x85\xc4G\xbf\xb8\xef5\xef\xb4\xe0R2\xc8p\x9c\x8et\xdb\xf3\x9b۶N\xf7\xb4Bף\xc1\xea\x97t\x1f,\xd5-VOt\xb3\xbeG\xb5\xbe\xb6S\x1a\xf9\xac\xd1F(\x95\x97\x87\xd4\xeb\xbfP\xe5\xe06\xe9\xfa\x1f\xa6\x1d\x0e\x00\xc0\x87\xb1q\xf4o\xc5\xf5\xcd\xff\xaeh\xe1X[\xf7\xad\x0e\xb6\x9a9uL\xe9\xe2\xb8U۹fq\xddc\xf3\x93\xd3\xc8ɲ}\xb6n\xadR\xefb\xbc8Ӡ\xab\xcf\x01`\xf7\xa95\xed\xe2\x9a\\)\x06IK\xa6h\x05e\xb08$M VLҽ\xac\\\xd3|\xa2Ɋm\xae\xbdB\aoz71\b\x00|\x14\xc6\xef\xd9\x17\x87\xf5\xa6\xb59\xaf\xb4r\xf4I\xe5\xd1+ozv\xc4ۯ\x94\xe9\x02`\x19\xb5\xa1\xaf\xacwNjV5\vA\xbd\u05cfh\x1b\x01\x00\xb0KT\xc7\xdf\x14ǣ_Q;}\xaf\x8aJ\x1a\xa6{\xc9q\x9f\xd4۰F@\xa9\xd8\xf6l\x99\xe7*\xf8\x94\xd0\xe4'J7\x9cC\xb5&\xe72[\xacY\x90\x96\xee!p\xcaoH\x83\xa8\x06e\xe7s\"\x80\x8b\xa0\xca\xed;6.\xac\x8d:ը|\xb5V>\xfe/h\x9f\x02Oc\xf4\x1e\x17WRw\x9d\xb5\xc2.\x9cC\xd2x\xd3\xca\xf7E\xe7\x1e\x00\xf8 S_\xabH\xf7\x80Ҁ\xf7\xf9T\xf6\xa2\xeb\xfb7\xae{\xd6ܻZ\xc3\xd7\x1f\xb9 \xf5-\x89\xec\xc0\xc5\xf0\xd8W\xc7\xf1\xf6\xbb\xec\xda}o7\xc0\x98\v\xa5\xbc\xb7%er\xa4$\x8ft٥\x11)|L\x83\x93)\xb7}>\xedL\x1dx\xd3 7\xe9\xf9MZ\x06\xa9\xf3Pڰ\xf7t\xefk\xe7\xb3]\x85\xa6[{G\";\x00\x00\x97\x9c\xab4ͮS\xffU\x8fq\x03\f\xc0\xae4\xbe\xf7\x93cS\xbd]\xbd\xb6Q\xcf\xfeSk1Hʀ\xc8\xd2\xcc\xeb۪\xea\xa8h%`Y\xac\xca\xe5\xebv̩m*5\xed\xc4\xe2\x9e\\>/\xba\x87U1\xd6vh*\xa7\xda\xe2\x9dF.\x92\xc8\x0e\x00K)%\xadG\xaf\x90F۷2?\xcdTؽN\xd9p\xb6\xee\xf9m[\xa7\xba\xc2^\xa7\x8e\ni4\xfe.).\xed\x8b\x1a\xbb5\xf9.\xab\xdd\xea\x03_\xc9;\xab/\\\x9a\xb5\xbd\xfb\xf4\xb4\xbb;\xb5Kp\x0f\xb9\xed\xb0\xdf'\xfd\x9e\xde~\a\xfb\x98&\xdd\a\xb3í\xcbm\xbbo\xc7\xfaV\xff\xacȗkʬ\xce\xe9\xdd\xfa\xae\xc5'\x01\x00\xb0w\x85G\xbf(Nƿ\xab0\x1d\xcb5\a\xb4Z[\fg\xf1X\xba\x8f\xd5U\xd9V\x8fFoqZn\x15o\xca#\xaaSB\x91\xd5\xc7]BQ\xaa\xebӧ\xa4:|^\xcf[śv\x00\xc0\ue4de\xb9\xa7\x8cu\x8b=\x1a+\xf3Ҧ\xcf\xda\xc5\xd2Hc\x8bI\xcaYWv\x86v]\xe3\xd4a\xbb\xec+\x1f^\xa3~y\x83\xdcͿL\x01\t\x00\xcf`\xfaε\xd8o\xb7\xad`-TY\xbb\xd3\xcd\xf6\xa9\xc8*Ŷ\xb5vgjk\x02XF!\xa6\xc1О\x94o\xd64s\x99z\xaf=K{\b\x00\x80ew\xdf_\x8d\xd5l[MuNY\xdcV/\xaf\xe6\tKa\xd6\xe51\xd4q\xd0\xcd×r\x1b\xbad\xf5$\xdd3N\t\xea\xc1\xb6\xbb\xe7\xc7\xd1N\xe8n,wۭ\x9d\x1b\xba\xddQe\xf7\xbc\x18\xb88\xd2@\xfcy\x1d\x94\r&\n\x83\xaf\x90\xbf\xf5\xbf\xd0>\x05>\xc8\xf8\xdekbv\xe6\x84z\xa9\xbb\xa6;\xa4\xa6\x99)\xcbj9\x9e\xff\x01x\x06m\xd1(\vCk\v\xa66\x9e\xb5\v}\xadQ\xddS\xf4\x1f\xa7\xd5\xf5WI/\xfeO\x17\xa4\x00!\x91\x1d\xb8\x80Ƈ\xbf8\xeaܻ4\xac\x8f\xd9E;S,\xae\xb7\x88\xcc\"\xba`\x95~lm;\x9de\xeb\xae\xe3P\x8a\xd6Rֺq\xf3\x80-u\xd2M\x0f˻\xa0ς\xbb6\xdd\bn\x1b\x85X\xc9\xd9\xfb\xbc\xfd\xec\x12C\xcew$\xe2\xfa\x05\x00\xe0\xd2sS\xcd\xf2\x9b\xd4{\xe5#D\xf4\x00v\x95x\xa7\x8b\xaar\xb9z0\x7f\x00\xe5-^ɢ\x82/,\x1a\xe9\xab\xf1\xdbʔ+s\xb6t\x89\x0e#;\xaf\x9e?\xa4J\xd2{\xb2\xdcޗ\x16\x8bWl\x7f\x1b\x83\xc57\xb1\x8be\x88_\x00`I\xb5\x8b\x82>\xb5~ӒFeL\xf7\xa6\xd2:\xdd\xc3\n\x99\x95\xf1霔\bg\x9b>t\xb7\xc0\x82\xfd\xd7\xd8\xfe~\x1a\xb81\xdd\xc7JoN\x99\xe9\xe9\xbdݽ\xad\xf9\xe74\xaeg\xfb\xd3\xfd\xb2\x99\x9cO\xa3\xf4\xcf?'\x89\xc1)\xef\xea\xa4\xc5\xfbS]\xd2-\xa9\x1eJ\xbb\xd2gTj\xf3B1\xbfE\xb1\xffr\x15\xb7\xfeB\xda\t\x00\xc0\xae7>\xf1\x9dq\xba\xf9V\xf9\xf1\xfdZ\xb5\xea3O\x9d\bc\xd1\xd5\xdd\xc1\x1f\xb0z2\r\x88\x9c\xeaV[\xd2\xe0d\xa9.\rV\xef\xc6Ҫ\xefF.X\xa4\x96\x1eJ\xbd\xafn\xb5\xa5\x1bL\xc6^3c&\x80]*Թ\x94Y9\x99:h\xfb\xa6\vk|c\xf1Fc\xfb\x1b+GK[\xa7\xb21\xdd\xd8\xf2\xa9\xec4\xa9|MqQ\xac\xb5\xb5r\xbd\xfa\xeb\xafWq\xcb/\x12w\x00\xc0\a\x99\xbdc_\xec5\x9bV\x96\xa6\x19\xf2l\xc7\xf8\x1a\x95={]\xa5\xc1\x94\xd2=!\x00\xcb(\x84u\v5O*K3\xb2g\x85z\xaf9C;\b\x00\x80%\xd5\xdc\xf7Y\xb1\xde|\xbb\x06a\xc3^\xa5築U\xed\xe9ynz\x9d\xf2\x19\xba篩A\x9fv\x05\xfb\x11\xd5\xda:\xa8\xb5uJl\x8f\xea\xcd3\xd6ӡ\xb9.\xef!\r\x9eZ,\xee\xa3L\xe7\xfb\x81\x8b`j߳^ʭY\x99I\xab\xdf#\xdd\xf4\xcfi\x9f\x02O1\xbe\xf7\xb6\xd8;uW7gQ\f\aՎ\xa6\xca\xfaS\x85u\xa7l\xc3\xcai\x00x\x1amV+\v}k0Z{0\xf5\x1d\xf7\xad\xc6\xe1\x1a\r\xf7\xfd\xf5\v\x96Ğ\x90\xc8\x0e|\xac\x1e}SԹ\xdfV;\xbeCY\x9c\xd8\xc5j\xc1\x9b\xefw\x13\xab\xa7q\xdc\x01\x00\xc0n3\xd3\xec\x9a\xcfU\xef\xc6_\xe1\x06\x18\x80\xe5w\xdf\xd7\xc40\xfe=\xa9}T\x9e\x19\xd3\x01\x00K\xe8\xfc\xfd\xed\xb4N\x83Cv\x03Dv\x9d%\x16\x1d-^\xb5E\xbb\x1d\x00\xb0{<\xf6\x1d\xb1\x1e\xfd\xbe\x8a\xad{\x16;\x00\x00\x97T\x1a\x88\xab\xddT\xc8z\xf2+\xaf\x93>\xeeO\x887\x00 \xb9\U000c6a3aV\xac\xa6\x8aj\xe6c\x12f)\xb9%(\xc6F.\x96\xf3\xf3\x00,\x9d6+\x94Ͷ\xa4\xe2\x80\xc6\xfb_\xa7\xe1\v\x7f\x9b\xf6\x0f\x00\x00\xcb\xe6\xce\xd7\xc4z\xfcn\x15\xe9\xb9jv\xc0\xda\xe9\xe3\xc5\x01`\xf9Đ\xcb\xe5\xdb\x1a\xd9\xf6\xcak\xba\xd1\xf3\x01\x9c\xf7\x97\x03\x12D\x01<'\x93\xe1Ta|\x85V\x8a4+\xfb\x96\xda\xd9Y\xb9+\x9f/\x7f\xebC\x17\xb4\xaeM\xb7\x8d\x01<\x17\x8f\xff\x9dx\xee\xf6\xd7ƭ'\x7fF\xd3\xea\xdd\xca\xf2\x89TfRї\xf2L.\xa3\r\x00\x00\xc0\xae\x14\xa3\xca\xfcy\x8b\x17\x00\xb0\x9c\xa6\x8f|m\x9c\xdc\xfd\xaaX\x8d\xde*_=)\xdf\f\x17G\x00\x00X.]\xe2\xfaS\xd6\xef\x93:b\xd8r\xe6/\\<w{\x19\xb7\xefya\x1c?\xf8\xb9q\xfa\xf8\xf7q\xd3\x0e\x00\xb0t\xc6G\xbe8\x9e\xbd\xe7P\xdc<\xfd\x13\n\x9b\x9b\x8b\xbd\x00\x80K-h&\xf9}\xf2mO\xd5\xf6\x9fj\xfb\xdd>\x8e\xef\x7f\x031\x06\x00\xb4\xad\x15\x92\x8d\xd2\xec\x8cs\xe7ghL\v\xdd\x13\x81e\x16U+MԪP\xa9(\xaf\x9b\xef\x04\x00\x00Kat\xdf'ƭw\xac\xc4\xd9\xf6=*\xe2\x01\xa9X\x95\xca3\x8b\xa3\xc0r\xea\xfa\x05\xa4\xd0ӯ\xccw\x00\xe8l\x1f\xfdr\xeeS\x03x\xce\xdctU+\xc5ii\\\xa9\xa9Ϫ=x\xcd\x05ObO\x98\x91\x1dx\xb6\x1e\xfe\xe2\xa8so\x97\xb6\xc6\xdaZ;\xa3\xc2.\xcbn\xde\xc2\xd66Z\xdb\n^mn\x17nV\xab\xd70\xa3!\x00\x00\xbbMtS\xb9\xd72\x92#\x80%\xf5\xe0\xb7\xc4\xc9\xe8\x9djfw+\v\xe7Է\xd2̇\xdc\n\xb74 W\xb38\t\x00\x80%\xd2=\xa8~\x9a{\xdc\xe7\x13\xdb\xe3>)\xdbR\xccG\xaal\xd7,\xed\xf3W(\xcbnR\xee\xafV\xef\xd6ߠm\x0f\x00ؙ\x1e\xfd\xa7q2\xfeu\x85\xe9C*\x9aR\x85\xcb\xe5tR\x8d\xa2\xf2\xc0\xf3'\x00\xb8\x1cF~\xda\xf5\rȴ&\xb5ޖ\x99\x82\xf7\x8a\xe5\xaa|o\x9f\xdc\xca\x17H7\xfdKb\f\x00{\xcf;\x0eF\xc5\xd66B\xb7D\xdbv>ݯYܳ\x89\xd9|\r`\xe94\xbeQ\xdedj\x82S\xfe\xc6\t\xed\x1c\x00\x00v\xbaǿ7\x863\xbf\xaaP\x9fT\x9c=\xa1ܚ\xe4.\xb7\xfd>Wkm\xf5i\fZ\x99g>\x00K\xca[\xcc9V5\xbcM\xbd\xdb\xee\xa0}\n\x98\xe6\xe8\xe7\xc4x\xfc\xf7U,^\x03\xc0\xb3\xe6\xf6IqK\xb1\x1ak\xbc:\xd4\xca+F\x17\xa5\x8e%\x91\x1d\xf8h=\xf0\xd91\x9e{\x9b\xc2t\"嵲AJ\xf2(\xbb\xc4u\x05\xab\xf2\xbb\xb5\xedr\x8dBY+\xa6s\xa6\xbd\xee\xad\x00\x00`\xf7\xa8\xb2\\嫷\xb8\x01\x06`\xa9\xb4\xc7>-fO\xfe\xa5B\x1dԶ\xad29\xf9,J\xa9#Y\xac\xed\x84\xf4\xe4\x8a\aU\x00\x80%\xf4ԙ؟\xf6^\xf74=˞/\xe9G\x9b\x06p)\x15\u0557\xf3=m\xf8\xc7\xd5\x1b\xecW\x7f\xf5\xafH7\xfc*\xed|\x00\xc0ew\xfa\x91/\x8a\a\xa6\x7f\xa40k\x15\xa7c\x15\xc1b\xb6\x94\xf7\x93:\x1b\xa6\x0e\x86m\x9aed\x94^\x00\x00.\xb1\xc6\xdf \xef\x8e\xdbb\xe5p\x1a\xef6\x1c\xb2\xc5\niw\xc2\x16i\xd2\xde(\xb7Z(\xdb\xff\x06\x15\xd7\xff\x1c\xf1\x05\x80=#\xbc}%z\xef\xad,\x9cߛ\x89\xa1\xb1\xed0\x9f)/-\x81\"\x11XV\xb5\x9b\xaa\x88\a\xb5\xd5\xd4Z{\xe3&\x173\x00\x00;T8\xfe\x9d\xb1\xd9\xfcC\x85\xad\xd3*\xe3C\xf2\xdd\x18SW\xcc\x0ff\xa7\x15}\xb4fy\xa9VW\xaa\f\xa7\xe7\xfb\x81ed1f\x1d*\xf9\x03\xff\x8b\xb2\x17\xbd\x85\xf6)\xf6\xbcٱ\xef\x8d\xfe\xdc\x0f\xaa8c/z\xf4\xff\x04\xf0\x1c\xa5g^M\xa3\xc9z\xadl\xff\xb7\xab\xbc\xe1__\x94:\x96Dv\xe0#\x88\x8f~V\f[\xbf\xa3l\xcb\"\xba\xa6'\x95\xb9b\xe14\x89=\rgi4\xe1ĮϬ\x91\xbc\xbd\xb6\xc8/倴vz\xb98\n\x00\x00v\x8f\xe9\xe0&\xf5o\xbb\x97\x1b`\x00\x96Bs\xe4+\xa2\xdf\xfc\xef\xf2[\xa7,lY\xb3\xc5\x02\x95,%A\xa4\xf8%͊2\xbf\xff\xa0\xe8\xe5\x1b\"\x18\x00\xc0\x12zj\"{\xb2\xb8\xdf}\xfe\xbe\xb7\x8b)q=\rBiK\xb4\xba\xceY\x1d\x98\xea\xc2|6_\xd7Uי\xba\x95\xd38\xb3\xba\xb3\x7fP~\xf0*\xed{\xc1\xef\xd0\xe6\a\x00\\2\xe1\xc87\xc5\xe9\xe87\xe5\xdb\xe3*\xec\xbfj\xba\xad~\x96[5\xd7\xeb\xe25UVo\xc5F1\xb3\xfa\xac(\xac:K\x83-\x03\x00.\xb56^ce\xf3L\xceoZ\xac1\xb2\xf8\xc2vv\xa1\xc7\xc0\x96\xa1\xc5\x19\xa7\xed$\x8b/|T6\xb8RZ\xf9|\xe9Ɵ\"\xb6\x00\xb0\xeb\xb5o\xeb\xc7.\x8f=\xfd01\xa4\xe7\x0f\xb1\x9b\x95\xbd\xdbG\";\xb0\xb4\x1a7Unm\xa0M\xd7\xd7\xfak\x1f\xe6b\x06\x00`\xa79\xf6]q\xb6\xf9\xdbjGO\xc8\xc5-\xf9b\xa4^\\\xb76x\xba\xa7lK'5\xd6W\xad\x8d>PT&\x1fR\xb6#\xb0\xa4,Μ\xb5\x99z\xaf\xbf83\xc5\x02˦\xbe\xd7\xc5\xe2ԪTn[YO\";\x80\xe7h6U\xb5\"UW}\x89V\xaf\xbfx\x03Ő\xc8\x0e<\x83\xb3\x8f~I<p\xea\x17\xadf\xcfԴ\xa5ԛ(\xef\xd9:\xae\xa9\xadk\xd5ͦ\xfayz\xd8b'\xa7̏\x94Ğ\xdb:\xe4v\xbc\xa7\xa6\xea\xa9\xd7cF\f\x00\x00v\x9bz\xdfg\xaa\xb8\xf5W\xb8\t\x06`g;\xfa\xad\xb1\xd9x\xb3\xda\xed'U\x06'\x97\x0f\x15B\x9c\xcf\u009e\x85\xf9\xe0yi\xf4\xad\x98\xdbv_ޕ\xcaۍś\x01\x00X\"\x1f\x9cȞ\xc4\xd8u\x96\ue5a2\x96K\t\x80\xf2\xf2!\xb3}\x99\xbd>\xff\x9eL\x9a\x1d\x90\x8as\xf3\xc5Nk۞\xa2zrVg\xfa|\xa6\xadድ>\xfc+\xd2u?F\f\x00\x00\xb8\xe0\xa6\x0f\xfe\x7fb\x18\xff\xb9\x8a\xf6\x84-\xad\x05j\x16\xa7U\xb6\xac\\\xa1\xb6\xa9\x14\xdbJ\x99\xd5@\xae,\xac\xda*\xbbgPM\xe3\x94\xcb\xea-\x00\xc0%\xd7\xe4\xd3.\xae\xf0\xe1J\x8b\x1br\xc5|K\x8d\xb6籇\xc5\x11\xfd4\x88\xa4\xedQ\x9b[\xaca\xa1\x89\x9f\xa9\xd9w\xa5\x8a\x03_$]\xfb\xef\x89)\x00\xecJ\xf5\xc3_\x10\xfd\xc9_W\x9a\x91\xfd}\x89\xec\x8byA\xde7#;\xfd\x13\x81\xa5\xd5%\xb2\xebjm\x0f^\xa4\u0557\xfd)\xed\x19\x00\x00v\x90\xad\xfb^\x1a\xfd\xb9\xfb\xd5oKeyP(gj3\xa7P\x1d\xb4\xb6\xf9\x96\\V)\x8bN\xae]\xeb\xeeU\x04\xb7\xa5\xa0ZE$\xd1\x11K̷\x9a\x84u\r^w\x8a\xb6)\xf6\xbc\xd9\xfd\xfbc\xefL\xea\xf39\x94\x9c-~{~\x00\x00\x9e\xadf\xaa\xea\xeaORy\xcb\xff\xb8\xa8\xf5+\x89\xec\xc0\a{\xec\x9bb\xd8\xfem\x8d\xce=\xa25\x15R>\xb5\nݩj\xf7\xabi3\v\xf4Ϊ,[\v\xf0\xec\xdc`\xbfR\xeeGk\xe7\xb9L>;\xbf\xdf\x02\xbc8\xb0\vy\xb3\xfbH\x00\x00\xb0{\xc4+\xbfI\xee\xe6\x7f\xcdM0\x00;\xd6\xf4\xfe\xabb\xb6\xf5\xa4\x8a\xd4o6_\xb7X\xc5kڦ$\xbeQ\nml)\xe4\xd3C\xa9P̓\xf9\xdc\xfc\xbe\x80\x1372\x01\x00K\xec\xa9\t\xed\x8b$\xf6n\xd3[]\xe7\xd3=;{\xed\xda\xee\x98Rg\xeat_/\xad\a\xc1\xea\xc8B\xae\x9b\xb1=\xdd\xe8\x9b\xcfЮv`\xcbJ\xaa \x15\x8bMU\xfd(7|\x81\xf2\xd5ϐ\xbf\xe6G\x88\a\x00\x00\x1f\x93\xd1ݯ\x8c>l\xaah\xcf*kfV\x0fy\xab\x7f\xb2\xf9A\xd7X\xe0f\xf5\x90\xdfV\xf0\xb3\xae\xea\x8a͊UcCe\xdd4\x97)\xd8ۚ\x9f\v\x00\xb8\xb4J+\xab\xdb\xcab\x89Z\xad˔\xb9\xa1\xbc\xc5\x1cQ\x8dB\xa8,,\xc9\xe4\x9b\xd2ΙY9\x9e\xfa\x19\x14\x16\x93\xec\xd7,\xf3\xaa\xf3M\xad\xdd6&\x96\x00\xb0\xeb\x8c\xef|i\x1cN\x0f\xcf_tI\xeb\xf6\xabk\xc4z{I\xb1\a,\xbbhm\x9a6\x1e\x90\x0e}\xb6\xf2\xe7\xff,\x175\x00\x00;\xc0\xf8\xae7D\xdf\xd6\xea\xc7S\xd6\x06?!5\x954\xeb):k\x83\x97c5Vc{\xe5R\xbb\xae\x10\xbc\xda0Q\xa6\xb1\xf2\xdc\xda\xe9\xb6[5\x89\xecXb\xbe\xb6o\xf3\xd5\x1a\xbe\xe6qڦ\xd8Ӧ\x87_\x10ݙ\xc7\xd5s\xe9^\xb4\x14\x9ak\xe4=\x03a\x03xnF\aި\x95\x17\xfe\xc1E\xaf[Id\a\x16FG\xbf-\xd6gߪ\xc1\xe4Q\xf5\xda\xf4@\xc5\xf6\x95/R\xee\xceX\xe5~z~R\xea\x1b\x14\x0eٱR\xb5\xab\x15\xfd)9\xad\xd8\u0383\xb6N\x19웶\xdeP\xe6SOX{Ɉe\x00\x00\xec>\xaf\x9bp\x03\f\xc0\x8e\x14n\xdf\x1fc\xdbʇ\xb6\xeb$\xe6]O\xc1\x17j\xb3\x89\xda|\xa4\xde\xf4\x06E7\xb5c#\x8b[&]R{J\xceK\xb1O\x17\xbf8\xe2\x17\x00\xc0\xf2Iu\xde\xfbf\xf7z*\xdb\xdf\x1d\xf3\xbdy]\x17S\xaf\x8c\xc4*=W\xdb2\xb3\xedF\xaa\xf7\xcf_g\xad\xe4\xd3,\x8a\xde\xeaˠ\xe0\x1a\xb5\xf6_o\xbcj\xc7\xec\x03\xf2Z\xb5\xaf4u\xa5bq\xa3z\xfd\xdbl\xb9E\xbaᇉ\x0f\x00\x00\x1f\x9d\x13\xdf\x17\xa7g~M\xd3\xcd۵\xd6\xf3\x16\xbb\x15V\x05\x15v`Q7e\x8d\x1a\xefU\xbbL\x83Ye\xf5\x92\xd59\x99\xc5i\xa9~rc\xf94c{\x1a\x84%\xd5k\xc4o\x00py\xd4\xc3E\xec`\xb1D\x1aX$Z8\xd0.B\x02+\x9f++˝\xc5\x0eʝ\x9c\xfa݀Y>\x04[۾\x184\x99͔\xaf\x7f\x9c\xb2+?W\xfe\xda\x7fA,\x01`Wؼ\xfdʸ\x1e\x17\x1d\xa5\xd3\xe0\x80V\x02v\xf7d\xb4\x18\xa8\xa9\xebO\x95\x1eB\x00XJ\xd9T\xb3\xb0\xae\xdeu_%]\xf7\xa3\xb4_\x00\x00\xb8\x8c&G\xbf6N6~C\a\xeb'\xbaǜi\xd0n\xa7U[\x06RL\x89\x8c\xb6d\xd6\xf6n\xecu\x9a\xd4\"\r\x9a\x9aے\xda\xe3iP\xef\xa6g\x8b\xadK&\xba\xc0\x12\xb3\xef\xf9\xc4ݠ\xc1\xab\x1f\xa5m\x8a=+\x1e\xfe\x9c89\xfe;\x1a\fk\xb9\xb2P]y\x15\xe9\x06u\x1a8\x1b\x00\x9e\x8bK\x94\x1fC\";p\xe4{c}\xf6电\x0fw\x8fNR'\xa0\x10\xfb\x8a\xb9\xb7X\x8e\a)\x00\x00\xec5c\xb7\xaa\xa1;%\xd5k\ne\xa5\xe8[eՊTn\xa8n\x9c\x8a\xd7v=0\x00`\xe7\xb8\xe7ˣ\xb6\x7f\xde6Hd\x00\x00\xe0R\xdb\xee\x1d\xd4\xea\xea'I\xb7\xbc\x858\x01\x00\xf0\xb4\xc2C\x9f\x1f\xc3\xe6_(V'\x95\xa7\x19q\xb2\x9e\x94\x92\xd2\x01\x00{O\x9a\xcd4\x9c\xd0TA~p\xb3\xca\xfd_(\xdd\xc4\xe0X\x00\x96\xdb\xd9\xf7\xec\x8b\a\xaaj\xf1\n\xc0\xae\xd3\x06\x9d.*\x1dzM\x1a\xc1\a\x00\x00\\\x16\x0f\xff@\x9cn\xff\x17\xb5\xcdC*|\xae\xb2:?\x807\xb0\a\xb5Q\xdbW|\xb2V_\xf8{\xb4O\xb1'\xb5\x87\xff\xd78\xde\xfaE\xad\x84(_\xafK\xbe\x95\x8aFM\xdb*'?\x14\xc03h\xb2V\xa1ge\xc5\xf6A\r\xb4%WT\x92\xfd\xdaX\xb9F\xfb^~\xfc\x92թ\f\xb7\x81=m\xf4\xeeϋ\x9b'\x7fAU\xf5\xf0|6B?\xb4\xe5J\xf9lMΧY0\x00\x00\xc0^\xe3\xd3\b\xa4\x8bX>=\x8a\x8d)o=\x05\xf7њ\xce\xd9\xc1\xf9\x01\x00\xd8!&\xf7\xdd\x14\xabꗥ\xe6\xd6\xc5\x1e\x00\x00p)\r\x9b\x89&g\x7fE\x1b\xef\x19ƭï\x8f\xf1\xe4\xf7\xf1d\x10\x00\xa0\xea\xe8\xf7\xc5Ƀ\x7f7\x8e\xefxc\xac\xcf\xfe\x99|}Byz*\xeb{\x8aq8?\t\x00\xb0\xf7\f\x8eK\xfd\\\xfd\xecZ\xf9\xe9H\xa3S\xffU\xe3\xfb?\x89\x18\x02\xc0R\xcb(ŀ\xdd\xcdE\xe5\xf4\x93\x00\x00\xe0\xb2i\x1f\xfe\xac،\xff\x9d\x8a\xea\x9c\x06\xd5U*\xd2l\xeb\xc0^\xe6\x1a\xf5ʛ\x17/\x80=\xe6\xe1\x7f\x1a\xc7\xdbw(\x8b\x95\xbcolG\x1aX0-M\x1a\x83\f\x00\x9eQ\x13\x83\xfc\xe8z\r\x8b\xd3r\xa9\xdfB\xd5\xd7t\x98ke\xdf\xe7\xcdO\xb8D\x98\x91\x1d{\xd3\xe1/\x8b\xb3\xad\xb7\xaa\x0e\xadrת\x9f.\xc2\xc6~4\xfb\xa5X*\xe4#5Ŗʚ\x19\r\x01\x00\xd8k*?P\x19\xcfJ\xed\xaa\xb5\a\xaa\xf4\\VYSJ\xc5TM\xf9\n巽\x83\x91\x1c\x01\\~\x87\xdf\x18\xe3\xf6\xdb\x14G\x99\x15RC\xf9\x95-iJ\xfc\x02\x00\xc0%\x17\xa6R\x9a\xf4 \xcb5\x8b\x8df\xf2r\xf9\v\xb4\xb2\xf2W\xe4o\xf9\x8f\xc4\x0e\x00\xb0\xd7\x1c\xf9\xde؎~F\xbe:+\xd7̤z13ez\x0eeuEp\x03\xd5Vq\xf4\xc2d\xbe\x1f\x00\xb0\xa7\x8c4\xd5 s\xf2iP}\x8b\x1f\x14\xd2\xc0\xba\xa5Z\x7f\xb5\xd5\x13W)\xbb\xf6oHW\xfds\xe2\b\x00K#\x1e\xfb\xba89\xf1\v\x1a\xa6\xb6/\x80ݩ\xad5^}\x95\x86\xb7\xfd%m\x14\x00\x00.\xa5\xa3\xff \xce6ު8zB\xfd\xe0\xa5^%\xa5ڸɭ!Ό\xec\xd8˦\xd2\xeb\xd2\x14U\xc0\xde3~\xd7\xf3c[?\xa2\x81U\x03y\x96I3\xab\x1fR'\xf7\"Se\xf5C\xa9vq&\x00|\xa0\x89+4H\x03^\xb8&\x8d}\xa1\xd1`\xa2\xec\xd0\x17\xa9\x7f\xe3/^\xd2:5u\x9b\x00\xf6\x8c\xe9\x91\xef\x8e[w\xaf\xc6\xfa\xdc[ԛ\xb5Z\xb5}\xfd\xac\xeb=$\xb5V\x9b\xb7\x16\xe4e#\xf9\xfe\x96\n\x06,\x03\x00`O\xca\xceO\xc7\xee\xa3b\x1a}J\x85\xb5\x11\x1a5\xb1T6x\xf9\xfc\x18\x00\\&թ\xaf\x8f\x93{\xd7c8\xf96\xb9q)?\xb0r\xaa7U3\xberq\x06\x00\x00\xb8\xa4*\xab\x83\xeb\xfd\xb6\x94\xeaٲ2\xcb\xd4\x1b=\xac\xf6\xdc\x7fRx\xaf\x8b\x9b\x8f\xbe\x8e\x91d\x01`\x0f\x98\x9e\xf8\xc68:|S\xac\xcf\xfeKe\x9b\xa7\xe5\xaa-u\xb7\x98\xf2\x81\xe4WlÖ\x90)\xb8Z>'\x89\x1d\x00\xf6\xaaҽ@!\x94\nM%5Aj-\x96h\x0e)\x9b\x1d\x97\xc6\xef\xd4֣?\xa9\xe6\xe17\x12C\x00X\x1a\xd3\xd9{U\xb6t?\x04v5\x1f\xd5\xeb\xbdl\xf1\x02\x00\x00\\\x12\xef~]\f'~Y\xc5\xf6Q\xf5\xd3@x>\x93\x9a\xab\xa5hm\xef~\x9a\x81\x17ػfY\xb1\xd8\x02\xf6\x96꽷\xc4\xd2=\xa2\xa1U\x05y\xea\xd7\xde\xf4\xad^H\xb7\x92\x83䜲./\x0e\x00\x9e^\x96[\x1b2ےF\xfb\x14\xfcD\xeeЭ\x97<\x89=aFv\xec\x19\xf1\xd1\x17\xc6\xf6\xec\xa3r\xdb}\xbb\x00'R\xd9(n\xafʕv\xd0\xd7\xf3\xd1\xce\xd3\xd8\x0ey\xa6\x98\xcd\xd4\xc4VE\xaa\xdc\x01\x00\xc0\x9e\x12\xadq\xe0\xe2\xc8Zʥ*7U\x19ץz\xa4i\x7fU\xfdW\x9de$G\x00\x97\xcd\xec=\xb7D\xcd,jiϪ\xc8\xcfu\xe3q)\xacX\x19\xb5\xa2&\xd4\xcaK\x92!\x00\x00\xb8\xe4ګ\xad>\xdeT\x88\xe7\xe4\xd3\xe4\ay\xdf*\xeb\xb2{V\xa8\xa6\xd2\xc4\xfe\xcbzR\xd1\x7f\x95\xdc\xca\xe7K7~\x1f1\x05\x00\xec\"\xe1\xa1o\x8d\xcd\xe8\xbf)ԏ)\x8fV\r\xa4>\"~\xbf⤒s\x85m\x0f\xe6uBl\x15\xddL!\x9f\xca\xe5\xad|EG+\x00ؓʾU\tQ\xa1\x89\xf2\xb6\x96k\xe5Rݑ:\x19z\xa7\xc6mٱ\\\xae<\xa0b\xe5Ӥ\x17\xfc<\xf1\x03\x80\x1dm\xeb\xdek\xe3\xda\xc6\xc4\xca0fd\av\xab\xc6W\xca_\xd3\xd2&\x01\x00\xe0R8\xfc\x0fb\xb5\xf1K*\xea\x8d\xf9\xfd\x02?\x95\\H\xb7\x97\xd5\xc6\x15)+\xa5|\xa6\xbcN7\x9d\x81\xbdiT\xee\xd7\xca+\x8e\xd3>\xc5\xder\xd7'\xc5z\xfb\x7f\xaa\xe8\xd9v\xcaqk\xd7l\xc3*\x870\x96\n\xbb\x1cr\xa7\x18Z9B7\x00Ϡ\x19N\xe56\xac-Y[\x13\xf3\x9ak\xe5^\xfc\xc8e)0HdǮל\xf8\x8a89\xf1k\xeamNT\xe6v\xd1e\xebVg7\x9a\xf9\x13\xea\x05\xab\xc0s\xab\xc0]Jd\xaf\x15Uȹ\xa1B\xc8\xd5\xd4Qei\x15;\x00\x00\xd8[\\.ř\xa2ϭ\xad\x9e\x12\xd9\x0fY\x83\xe2\x9cF\x83+\xb8\x01\x06\xe0\xb2h\x1e\xfd\x868\x9e\xbdU\xebǞ\xb0x&e\xc2Y\xb9\x94G5\xee\xacb\x98\xdaf.\xa7\xa1\x9dY\xcd\xdf\x00\x00\x00.\x99&\xb7\xbaX\xfb,\x8eH\x89\x8ac\x856%\xb5[U\xadU\xdbw\x85-g\xba燊\x95\xea\xa2U\xbbz\x8d\xca\xd5ϔ\xbf\xfe\xa7\x89-\x00`\x89\x85#\x7f36\xdb\x0f\xcao=\xa8\xbc\xb1\x82\xbf\xc8m\xb1\xfa@\xb5\xb6\x9b\x89\xca^Tl\x9d\xd5\v^Y,\x95g\x99|\x9a\xa2=6\x8aV'\xb8nd2\x00\xc0^S\xfb\xa9|,l\xd9'gUA\xccFj]\xa5\xd0\xf4\xac\xceXQ\xbf\x7fN\x9aX\xdc0\x1eJ\xbd\xa9t\xa0\xaf\xb8\xfa\xe5\xcao\xf8I\xe2\a\x00;\xd2\xe6{W\xe2\xfa\xa8\xe8\x92i\x00\xecN\xb3\xa2V\xef\x95\rm\x11\x00\x00.\xb2ك\xaf\x8d\xf9\xc6;\x95mڋ\x95U)\x1bK\xaePh\xd7\xd4jj\xaf\xb7\x15\xe3\xaa|s\xb3rwx\xfe&`\x0f\x1a\xad\xbeR+\x1f\xf7\xe7\xb4O\xb1g\xd4\xf7|j\xac\xce\xfd\x91V\xd2\x04\xae!\x93\x9a\xf4<2\xe5ť\xc1N\xea\xf9\xb6\xd2\xf3\xc7-9\xc7D\xae\x00\x9e^\x1ap\x7f<]\x91[[\xd1\xcam'.[=\x9a\xc6j\x02v\xad\xed\xbb\x06\xb1z\xfc75\x9ce*\x8b5\xbb\xf2\xacb\x0e\x8f[]}B\xbd\xe2z\xa9\xa8\x14}\xa36\xb6\xaa\x9dS7\x00M\x16\xe4\v;\x7f@%\x0e\x00\xc0\xde\x14\xa4h\x8d\x02\xd7M\x97e\xeb.\xfaW\xf0̔\x05\xe0ҋ\x87?=\xb6\xa7~Z\xd9\x13OH\x83}R?%H\x9cT\xa5\xb1\x1a\x8bobV\xca\x15\x8d\xedKO\xb2\x00\x00\xc0\xa5\xe64P\xa3M\xb5\xf1\t[\x1a\xb9x\xb5rwuיD\xc5#R\xe3\xed\xa4\x81\xe4WTԭ\xe2\xd9\xc74=\xfe3j\xefz]\xd4C\x7f\xc7*v\x00\xc02\x89\x0f~Y\xdc~\xef\x818:\xf1\xdbʪ\xc3ʇV\x94\xa7q\xc5B\xa68nTW}\xf5\xf2+Uf\xb9\x8a,\xa8\xc8+\xf9b[ޏ\xac>\x98\xd9\a\x04\xb9\x86$v\x00ث\x8a\xd8W\x16[\x85xJu8\xdb\xcd̞\xb5\x03\xe5j\x95\xfb\xd3\xd2,=\x8f8\xa8l\xe8\x94\xf9V\xcd\xe6\xb6fg\x7fJ\xedCo v\x00\xb0#e\xa9\xa3U`6H`7\v9]\x8c\x01\x00\xb8\xa8\x8e}Cl\xee\x1d\xc6x\xfa\x9d\xca*\xabwW\x0f)\x94\xdbj\xebu5\xb34h\xf6Ly^\xab\x88\aU\xb4\xa5r\x7f\xdf\xe2\x8d\xc0\xdeT\xf6^\xb0\xd8\x02\xf6\x80G\xbf$j\xf2G\xeau\xb7^\xf6+\x84\x9e\x9a\x94\xf7\x96\xfa\xa3\x94礼\x92\xda\\\xaa\xd3DH\x00\xf0\xcc|S([\xbbJŕ\x7fo\xb1\xe7\xf2`Fv\xecJձo\x8a\xe7N\xfe\xb2\xae\xaa&\xf6-ߘ\x0f2\x93rϬ\x02o\xa7\xab\xdd,\x18y\xb1\xa5\xda\x02:\xe7sE\x9f:\r\xd9\xc10\xb3\xa5Qf\xb5\xb8O5yJ|\a\x00\x00{\x8c5\x02RG\xb2ܩ\xd2L\xfdx\x83\xd4\x1c\xd7\xd6\xca\xf3\xb4v\xdbC\xc4\xfa\x00.\x89ɓ\xdf\x11gg\xff\xb3\xf2\xcdSZM\x03g\xfa4\v\xfbĢ\x96ƖJi\xf2>g%\x92k{\x16\xc28\x85X\xa9\xf4)\xf0\x01\x00\x00\x97RL\xb3$\xb6\xe9\x96bO.d\xf2\xce^t\x83b\xa5\x0e\x9e\xb96\a#\x15\xf6\xb2\x1f\xecxJ\\\fv<ڎt8F\x9d^\xb9F\xab\a?O\xbdk\x7f\x8cX\x03\x00v\xb03\x0f~F\xcc7\xffP\xeb\xb3T\xe8\xf7\xa4\xc1@j\nխS\xeb[\xb9b\xa2\xcc\xd7\xe9\xc1\xab\xa2\x95\xf7y\xbdfq[\x1at,\xcdL9\xb5\xa2?v\xf5E7\x13\xbbc\xb0D\x00س\xaa\x95n`}\xe5V)\xa8V\b\xb5|\xea\xb3\xe3S\x8c`!Aد&n+Z\x9d\x92\xe7\xdeB\x8b\x9e\xe2t(\xd7\xda\xf9\xf9Y\x9d\xbe\xe2\au\xe8E\xff;\xb1\x03\x80\x9d\xe1\xdc\xf7\xc4\xf1\x83?\xa6\xe1\xc4ʰ\x9e\xb5}\x01\xecJ㕠\xe1Kg\xb4?\x00\x00\xb8\bF\x0f\xbe.\x0eN\xbdC>\xddF.\x0e\xd9Rj좆n\xaa\xa8q7\x88\xb6\x82W\xe6\x82\\\x97\xe7`\xe7\xb5=)\xa7j\xc6\xde\x15\xaf\xfdF\xb9\x1b\xfe\r\x17\x01v\xbd\xfa\xf8\xb7\xc4\xd9\xe3?\xad\xd50\xb1r\x7f\xa80\xe9K\xfd\xda\xea\x87L\xca6\xe5K\xab\x14*\xab\x1a\xb6\xaf\xb0=Q\x1a\x9c\xb6\x17\xe4\xbf\x01x\x06\xd9T\xda\xffe\xd2-?\x7fY\xebP\x12ٱ\xeb\x8c\xefyq,7\xefW\xde\f\xa4\x92\xef7\x00\x00x\xb6\xa6j\xb3u\x85ԙ\xec\\_žS\xd2\xecJͮ\xf9\\\xf5n\xfa\x8f\xdc\x00\x03p\xd1\xd5\xf7|n\xccF\x7f ߎ\xed\x95\x15;\xbeTL\xb9\x0eif\x13\x00\x00\xb0\xab\xcc\xcai\xcagW\x96]\xa3b\xf5\xb3\xa5\xe7\xff4\x15>\x00\xec \xe1\xaeO\x8a\xed\xe4\xed\xcac\xdb\r\x8c\x1c\xe4U\x87F\xbdn\x04e\x00\x00.\xb10\x95\x8a\x83\xd2ڗH/\xfa\tb\a\x00\x97\xd5ֽ/\x8ek\xe3\xfb\xa5ju>\x88\x13\x80%\xd5W\xb06F\xcc*\xb9<Hm\xd6\r\xc8\xe94\xe8\x06b;q\xed\xa7\xe9\xea\x1b\xff\x1f\xda\x1d\x00\x00\\H\xf7\xbe1jrZ\xaaOI~d\xed\xe94\x10\xb6\xeb&\xeas\xea\xd9\t\xb4\xaf\xb1w\x8d\xb2R+irJ\xd7(\xf8\x15\xb5a\xa2\"\xd4\xf6\xba\xaf\xd6O\x95\xbd\xda.\x16`/\xf8\xf3}Q\xe5\xa6\xd5\x13\xfb\xd4ԅb\uf50a\x8aDu\x00\xcf \xafTUW(\x94\x95\xfaV_jfKs\xc0\xea\xcfC\x1a\x17\x875Z\xfb\x01]\xf9\xe2\xef\xbd\xecuh\x9a\xf7\x05\xd8\x15\xaaǿ.n\xdeqs\xccG\x95\xf2\xecji0Y\x1c\x01\x00\x00xv\\ȕfJ\xf4ym\xeb\xf4\xacv\xaa\"\xbfqq\x14\x00.\x8e\xfa\x91\uf223{>#\xce\xc6\x7f\xac&\x8e-bwR\xb6\xa6\xe0{\xaa\xbd\x95K\x00\x00`\xd7\xe9Շԛ\xedW\xdc\xde\xd2\xe4\xf4[4\xbe\xf7\xb6\u061c\xf8\x87\x8c\xce\t\x00\x97\xd9\xc6\x1d_\x187\xdf\xf3\xf1qZ\xfd\xa5|\x9aq=\xb3\x98̯\xdb\xf6@.'>\x03\x00\\\x1e\x8d\xd6l\x19k6\xfb\x19M\x1f\xf8\x04\xe2\x06\x00\x97\x95\x0f\x8d҄_ݳ\f\x00K\xac\x91s\xe9:\xf6\xf3~\x12\x89O\x17we\xd7\xf8LE~\xfd|\x1f\x00\x00\xb8 \xa6\x87?w\x9e\xc4^m\xabm\xd3T\xecV了\xd8\x16\xe7\xd2}h\xd2{\xb0\xb7\xb9\xd4\x14M#\xc1\xdb\x12c\x9bZ\xa9\xf3\xd8\xd3\x047\x98o\x00\xbb\\\xfd\xde\xdb\xec[\x9f:\xaf\xef\xb3e*e\xa7\x94\xb5|\xff\x01<\xb3\x18\ve\xe5\x96\\\x15\x14++7\\\x1a\x98\x7fKUyX\xfd\xc1g\xee\x88$\xf6\x84\x19ٱ+4\x0f~^\xd4\xe6\xaf+\x9bE\xfbR\xf7\xd4\x16}\xd5\xe5H\xfd\x19\x9d\x89\x00\x00\xc0\xb3\xd5*\xfa\x83j\xdd\x19\xe5ipS\xe74͜\xfa\xaf\x9e\xd2\v\x03\xc0E\x13\x1e\xff\xe2؞\xfa\xef\x8ai\x16\xf6 \x15\xe9\x19Uܧ\xa8\x81*?Q\xeb\xb74\xac\x99\xf1\x0f\x00\x80]ǥ\xa0cm\xbe\xad\xb3\xaa\xac\r\xd0\xe4\xa5\xf2\xe23T\xbe\xf4\u05c9A\x00\xe0\x12\xab\x1fxC\x9cNߥ\x10k\x95V\n\xf7\xa2\xe4SQ\xdd\xec\xb7X\xadT\x93\x8d\xd5\xe6\xdb\xea5\xccx\x00\x00\xb8\xf4\xb6\aS\xf5\x9bu\xe5UTh\xb7\xd4\xf6\a\xca\x0f~\x91\xdcM?K\xec\x00\xe0\x92\x9b\xdcq}\x1cL\x8f\xd9\xd6>[R\x02\x0e\x80e\xd4*\xca\xfb\x94\xc4\xeel\xab\x96\xf3\xa1\xdb\x1f\xd2İ\xc1+{C\xbac\t\x00\x00.\x84桗\xc4x\xec>\x15\xe5\x81y\x12\xbb\x9f)˭\xee\xed\x92\xd7miK\xab\x803ۮ\xba\xf3\x81\xbdh\x96\xf5\xd4K\xfd\xe7L\x93e]\"\xbboRB^\xa1\xa6\xffB\xe5/\xbf\x8b\xf6)v\xb5\x8d{n\x8bn\xeb.\xad\xa7\x81\x1b\u009a]\a'\xad\xae\xb0K`\xba.e\xd4\x0f\x00\x9e^ce\x86\xcf\xcf\xcaW)\x8fv>0\xd2\xd4Uj\xf6\xbdP\xab\xb7>\xb0c\xeaN\x86l\xc2\xd2\x1b\xdd\xf3\xb28y\xf2ה\xdb\xc5\xe6\xfcն'͜\xba\xa1\"\\5?\x01\x00\x00\xe0و\x85\xb5%\xa25\x94\x1b\v\xfak\xc5\xd4I\xb9\xec-\x0e\x02\xc0\x857}\xf8\x13\xe3\xec\xf8/\xa9h\x82J\xe5*}&\xe7֭(\xea+\x84\xa0,LU\xc6y\xa7\x11\x00\x00\xb0\xbb\xc4\x14w\xb8\xb3\xb6u\xce\xe2\x10k\aԹ\x86\xd3V\xe5\xe47\x15\xdf\xe3b\xf5\xe0\x1b\x19\x89\x16\x00.\x81\xd9\xd1W\xc5\xc9\xdd.\xba3wkm\xbb\xa7}aE\x83\xb6/_\xf7\xa4jMJ\xeb\x18\xe5\xb3Z\x05\xb7\x89\x00\x00\x97Iެu\xf7\n\xe5*yW\xaa\x98N4y\xf2\xbfj|\xff'\x117\x00\xb8\xe4|;Q\x1a\x987M\x8e\a`yEUr.%\xcc\xe5Rk\xeb\xaeUѪq\xad\xbdL\xfb\x01\x00\xc0\x850\xbbc5\x86\x13\xf7\xa9X\xb1\x17~\xa4\xac\xa8\xe7I\xeci\xfa\xe941e7\x88L\xe8\x16`/\xebfdO\x17\x842\xbb4\x1ayg\xedԘ[\xfb\xd4)\x16/K\a\x81]\xaby\xf8\xa6\xe8\xb7\x1e\xd1J(,D\x9b\xa9\xceO*\xda5\xe0\u009a]\x16\xd3\xc5Y\x00𡂷z3U\x9f\xbd\xd4\x0fm]uS\xa9^=\xb4\xa3\x92\xd8\x13\x12ٱ\xb4\xaa\a\xbf.6\xef\x18\xc6\xfe\xf6\xc3Z\xcb\xfa\xf6m\x1eX#\xd5.8\x7fH\x99]tY{bq&\x00\x00\xc0\xb3\x91\xc9\xc5Z>\xa6v\xbbW\xe3s\xc5\xf2\xda\xf9!\x00\xb8\xc0\xea\xbb_\x10\xcbSo\xd3 \x159\xb3U\xa9\xb1%\xcc\x13\xd8Cض\xf2h[\xb9\xc59y\xba\xc1\x00\x00\x00v\x9d*\\\xab*\x96j\xd2\x13\xf94\xe5o\xba\xc7\xe9\xd6mq\xe9\x97܉\xb7\xa9\xbdw=\x86ǿ\xb6{d\x0f\x00\xb8\xb0F\x8f}j<}\xbf\x8bӓ\xefU>*\x95\xf7\xb6\xa4\x9eS\xdc>h\a\xad<\xae\xca\xf9\x89\xc5̖i\xf7\x1c\x8a~\"\x00\x80˥?\x1b\xcaU\x167t\xb3\xb6Y\xecP\x1e\x92\xedQ\xb1\xf5?ռ\xab\x17Ǐ|\x19q\x03\x80K\xa2=\xfe=\xb1H\x03k\x84La\x9ee\x00`I\x85\x94\xb9\x9enDF/gK\xca\x1c\n\xf62\xb8\xbe\xc5\xc1\xfb\x17g\x01\x00\x80\xe7\xaa>\xf2\x8d\xb1~\x97\x8b\xbds#\x95\x85\xed\xf0C)k\xa5\xdc\xea\xe0l\xde?Q]?\xc5\xf4l\xd0\x16\xb2{\xb0\xc7\xe5\xdd\xc8Ji@\x87\xb4\xd5ئ]\x14\xf6\xb2\xf1^\xc5Kޒ.\x16`W\xda~\xe4\xebb\xbd\xf1\xa8V\xec;\x9f\xb9CV7ص\x90\xaa\x88\xb8\xcf.\x80\x9e\xc5g\xcc\xc6\x0e\xe0\xc3ٶ&\xe6\x01k|\xa6{<\xe7\xd4\f\xaf\xd1p\xdf7.\x8e\xed\x1c4u\xb1\x9c\x1e\xf8\xfc\u061cz\xb3\xf2f2\xbf\xd0\xc2\r\xddM\xd5&?mAݶ\xc5rVI\a2=\x00\x00\xc0\xb3\x97\x1e\xca*\xa6\x1eɶa\xc1\x7f\xc8Ze\x8c\xe4\b\xe0\x02\x9b=\xf1Ocs\xf7 \x16g\x1e\x92o\xd2MG\xa7\xd6oX<\xb3%\xe5c9\x8bk\xf2b\"\xdfe\xb0\x17V\x1e-\x92'\x00\x00\xc0\xae\x12\xf2\xca\xea\xff\xd4ae\xddb\x8fuEk\x13\xa4Y\x90b\x9d[X\xe2T\x1c\xec+\x8e\xb74y\xfc?\xaa\xb9\xfbe\xe9\xa9=\x00\xe0\x02\b\x0f\xbf1\x8e\xef\xbe)\x86\xc7\x1f\xd1\xe0\xf45Z\xf7AE\xcf\xca\xe4\xd9!U\x93\x99\\9\xb2Pl\xdb\xe2\xb3\x14\xa3\xa5\xe5\x9c\xe4\xc6R\xeb\xe4f\xf9\xe2S\x00\x00\xb8\xc4\xfc\t[\xd6m\xe3y\xaa\xed\xbf6K\xfd#,nh{\xcaG\xb5\x8a'\x7fK\xd3\xfb\x9e\x17\xebc\xdfK\xec\x00࢚M\xffB>Nl\xabP\xe8\x92n\x00,\xad\xae\aqJ\x0eJ\xd7rJ\x18\n\n\x16\xfbF\xb7\xaerps:\b\x00\x00\x9e\xab\xfb?9\x16\xa7\xfe\xbd\x8aj \xadf\xea\x06\xb3\xae,dOm\xe8n\xb1}\xc1\x96\xb4N\xb3N\xa7\xc4v\xb2{\xb0\xd7\x05\xbbF\xecR\b\xf6\xa3k\xa2\xa6\x17v\x8d\xb4\x99]'\xc0n\xf5ȷ\xc5\xfa\xf4\x9b\xd5k\xd7\xe53\x8b\xcb\xc2\x13R\x93[\xb5p\xb5\xa2zvY\x8ce+\x00xF\xae\xcb}\xe9\xa9\x1a_\xa9ͬU\xef\xca/Uv\xdd\x0ft5\xe9NBS\x17Kgr\xf7\xab\xe2t\xe3W5L#\xfa\x0e\xae\xb0=\x9bj\x9b\x13r.S\x9e\xf7\xd5d\x13U\x9a\xaau\xab\xf37\x00\x00\x00<\x1b\xa9\x8d\xd1u\xbc(\xa5z\xa8P\xb4\xca3\x12\xd9\x01\\8\xed\xd1/\x8dٓ\xffB\xf9\xb9Zʭ\xac\xc9\a\xaa[)\xeb\xa5\u05f6\x11\x9d\x15E\x85\xadm\xf1\x99-^m7\xda,\x00\x00\xd8m\x06\xd9i\xe5\xd1\xda\x00\xb3\x9e괤A\xe5]#Wx[Vl_%\xdf\x1c\xd2Jܯ|v\xb7\xe2].\xd6\x0f\xbf\x9e\x86\x01\x00<Gᡯ\x89[w\x1d\x88\xda~\x9b\x86\xd33Z+\x9fаwR\xaeY\x91\xaa\xa1b>U\x9e_\xa7\x90\x9f\xb1e\xac\xc6B\xb2\xc6[\x1cו\xbc\x99|>T\xde?\xd0}\x16\x00\x00\x97Z\x9dz\xf8\xf4,hX9cu\x92\xd5S\xd3\xf48#\r\xfc?\x94\xfaQE\xd8V~\xe61\xd5O\xfe\xa0\xd5y\x9fO\xdc\x00ࢩ\xaa{\xe6\x1b>W\x13S\xe2+\x80e\xe5Ҵ\xaf]\"\x9d5\x1dR_\t\x13\xa2\x97w\a\xad}qK\xf7\x1a\x00\x00<{\xd3\a^\x1cg\xa7\xff̂y{1\xb0\xba\xb6\xbdJ\xb1\xb1\xb6\xb3\xab\x15\xdb\xf4:\xb7\xa5T\f\xb6\x8e\xb68\xdb\xe7R\xdb:\xbd\x01ػ\xfc\xf9;Z\xae\x98\xf7\x96K?\x82S\xf0;.\x17\x0f\xb80\x1e\xfb\xee\x186~Y\xfb\xaa\x91\x85f\xfblǢ\x1e\b\xa5U\vΪ\x87-\xfb\xfeO\xecu\x7f\xbe\x1f\x00\x9eF\xd1U\x9a\xa7\x15\xcbU\xf5\xf7\x7f\xa1\xfc\xf5?\xba#+N\x12ٱ4\xe2#\xff8N\u07fb\x12{ջ\xd5\xf7V\t\xfbL\xa3\xe6\x94\xeab[Y\x7fK\xceWݓ\xdb\xd8\xf4\xd5\xf8U\xcdz$\xb2\x03\x00\x80g/\xb5\xe3\xe7\x13\ax\x85\xb6\xd7%\x95\x96\xcf\xdby#R\x01XN\xb3G\xbf*\xfa\xa3\x7f\xd4M\xe6\xa7\"\xb3\xa5\xaf\xca\xe7V֬K\x8d\x155i\x14\xe6ɚ4ZULI\x141W\x9d5\x1a\x17<\xa8\x02\x00`W\xaa\xfa\xf2M\xadܝUY\xda2\x18+KO\x17Zk\x1aL\x1b\x15\xb35\x8bL6\xa5rKr\xa5\xa6\x13\xaf\xd1\xc9;5\xb9\xe3\xb5Q\x8f~M\xf7\x18\x02\x00\U0001134f\xff\xfd8\xba\xe7\xea\xa8S\xffIkg\xce\xc9OW\xad\xa0Mϕ,.\x1b[<6\xb1\xb8lf\xdb\xc1\xf6\xf5\x1fQ\x9d&;H\x83\x1cz\x8bʹ\xae\xd0\x0e\xd5\xd6)fK\xef\x19/>\x15\x00\x80K\xab.\xae\xd7(n\xab\xaa\xb7\x95\x85\xa1z\xfeZ\xb9lf\xfbϪN3\xf2\xc4u\xe5ٚ\x86#\xa7x\xe2WU\xdds-1\x03\x80\x8b\xa2nN\xce\x1f\xaa*SK\";\xb0Ԣ\xb3X8%\xb1\xa7%\xcdȞ\xf2\xdaӬ\xb0Z\x91\x8a+\xbbs\x00\x00\xc0\xb3\xf4n\x17\xb3\xed\xe3j\x87\xa5\xea\xd2^\x8f\xad\x82\x9d\x8d\xe5|T\x95\xb7r*\xba$\xddn&\xf6\xae\xde]\f,\x13\x82چ\xfeA\xc0\a\x88\U000d4dd8\x06`\x02v\xa1\xcds\xbf\xa20~L\xde\xea\x8bI|\\\xc1\xa5\xe7\x93\x16\x8b\xf9L\xad\x9e\x90\xf2-yo\xdf\xff)\x89\xec\x00>\f7T\xd3\xd6\xea\x1d\x88*_\xf0K;6\xef\xc5\xc5\xee\x06\x14\xb0\xb3\xb5\x0f~\x9e\x05t\xbf/5\xe3\xae\x13\xa7ܠ\xab\x98\x15\x9a\xf9\t\x00\x00\x00\x17J;\xb3\xb6ƺ\xb55F\xb6\xdd\xe8t\xb9_\x87^}\x96Dv\x00\x1f\xbb\xbf\x1c\x10\x80\x03\x00\x80\v\xaa\xda\xf7<\x95\xb7\xdeG\xbc\x02\x00\x1f\xce\xed\x7f5\xaa~\x8f\xe47m\x91Z\x9f+\xab\xf3\xc5A\x00\x00v\x91\xd49~V\xcc7\xf2F\x8aSM\xe2\x95\x1a\x1c\xf8\xab\xd2\v~\x8e\xb8\x01\xc0\x85\xf3\xde}\xd6ƞ*\xcd\x0eV\xbb\x89\x8a\x98\xca\x1e\x00\xcb(%\xad\xbb\xbc\xea\x06s\xab5S/X\x93\xa1:\xa4\xb3kO\xe8\xc0\xcb\xe7S\x00\x00\x00\x80\x8f\xd2#\xdf\x19\xebs\xffREM\xb2!\xf0\\5\xc5T\xa1ZSY\xe6҆Ś\aN\xaaj\xaeP\xb5\xef3\xb5\xca\xfd-\xec2\xe1ݷD?~Xʇ\x8a\xf6\x9fst/\x05\xf0\xf4\x1a\xd5\xcau\x83\x95\x17\xa7\xedն\xc2\xcc˻+\xa4b\xcb^O\xba\xf1\xfag+\x7fM\x83\x97\xffᎮ+\x19\x96\x06;^\xfb\xe07\xc7z\xf4\x80ڦ\xb1\x9a:=\xf8XU띪4\x03;\x00\x00\xc0\xc5\xe0\x82-Y\x9aD@\xbd\x9e5\xf2\x01\xe0c\xb4u\u05cb\xb9\xcb\b\x00\x00.\xb8zsM[\xf7\xdd\x1c\xdb\xe3\xdfI[\x03\x00\x9eb\xfa\xc8?\x8a\xb3{\xbf$ַ\x7f|Ts\xbb\xedٔ\xd2#\xdbX*\xb6\x83\xee\x1c\x00\x00v\x9b6\x045if䘒\xd8+[fjۣ\xda:\xfb\x0eUw}AlO|\vq\x03\x80\v\xa3\xb52\xa6I3H:y\x97f$\x01\xb0\xec\x9cKA\xb3-\xdd\xc4XQ9=\x8b\x01\x00xV&\x0f|i\xdc>\xf7\xa3ʛk\x16{\x00<\x17)\x8fץY\xd8c-\x153)X\b\xea\xa6*\xf2\x1b\x16g\x00\xbb\xc3\xe6\xfd\x9f\x1c\xdbv,\xe5\x87\xecK_\xc9\x15\xd3\xc5\x11\x00\xf8PY\xb6\xa2\x98\x1f\x91Rnm5\x90ϭ\x82\xf4Vn\xd4i \f\xab.\xb3W\xec\xf8$\xf6\x84\x19ٱ\xa3\x85;^\x11\x9b\xea\x84b8\xa5LΖu\x05\x97\xab\xf1c\xb5~[CF,\x03\x00\x00\x17Z\xb0\x06\xbe\xb76\x86\xaf\xad\xb5ܨ^\xfbt\x15/\xfa\xad\x1d߰\a\xb0sM\xee\xbd1\xf6\x9f<*\xd7'~\x01\x00\x00\x17֬\xa8\x95M\x83\xb2\xb2\x94[\xff\"酌B\x0f\x00\xcd}\x9f\x12\xb5\xfd?\xe4c\xb0e\xb1\xf3|\a\xfc8P\x1b2e\xaeY\xec\x00\x00`\xf7\xa8\x8b W\xe5\xca\xdbLJ\x1d\x1f\xf3\xba\xcbE\x9b\xb6^M\xbb_\xc3\\\xcanx\xb5t\xf5\xef\x127\x00xΦ\xf7\x7fE\xeco\xfeWi\xb6\xdfʚFm\xb6\xa5,\xf0\xfc\x03XV\u074c셵\x19\x94\xab\xb58:o*\xa9=\xa0\xc9\xc1+5x\xf1=\xb4\x19\x00\x00\xf8(\xc4\xfb?=\xba3o\xb7\xadm\x85\xa2\x94\x0f\x8c\b\x03<W!\x0f\n\xf5\xba\xf2rS\xaa\xad9\xeaZm\x17\xd2\xea+\xd2\v`w\xa8\x0e\xff\xcd89\xfb\xdbZ\x91}\xd7\xfd~E\xf7\x84\x9cK\x83\x06r\x7f\x05\xc03\xe8y5\xcdXY]\xca\xe9\n\xa9<k\x95f\xa5\xa6^\x95+\xa3\xb2Wo,E=I+\x19;֩;>>\xfa\xe9\x9d*\xc2\te\xbe\x95O\xc3|\xfa4\x92\xafS\x1e\x83ʰ8\x11\x00\x00\xe0Br^!\xcd\xc8\x1e\xa3\xa2+T\x94/Y\x1c\x00\x80g\xa7y\xec\x9f\xc6\xd1\xed.f\xe7\x8eʭ\x1cX\xec\x05\x00\x00\xb8pz\xaeU\x9egrc\xa7\xea\xc9\xff[\xd3ïf\xe4Z\x00{Vs\xe4K\xe3\xec\xbdW\xc6\xf6\xccە\xfb \x9f\x9e\x82zgK\x9a)\xb27_\xd4*\xf3Uw>\x00\x00\xbbM\xaa\xfb\\\xf7\xc3꿔\xcc\x1el\xd3V\x83\"h-?c\xb5\xe0\x19\xcd\x1e}\xb7\xf4\xe0ˈ\x1b\x00<gM\xfd\x1e+\\\xac\x18\xb1\xf6uH9\x05\xa4\x12\x00K-\xa6\xb89F\xb9\xd4p\xe8f\xbe\xb4\xeb\xdb\x05\x15\xe5k\x17g\x00\x00\x80\x0fg\xf3\xe1\xab\xe3\xec\xd4\x1fJٶ\x94_mMe\xee?\x03\x1f\v\x17\n\x8b3\x9d5K\xedZ\xca+\xc5`\xb1\xa7\xcf\x17G\x81\xe57}\xf8\v\xa3\xdb\xfcm\xedSO\xb9\x8b\x9a\xba'-\x1a+\xa4&=\xd8\x04\x80\xa7\xd7Ʊb=\x90S9\x1f\xc88䚹VqX*+\xbf|q\xd6\xceGI\x87\x9d\xe7\xf8?\x89\xf5\x1d\xd7\xc7\xf5\xad;\xec\xc2Z\xb3\x86\xe8@Yf\x15\xb3U\xcfэ\xed\xa2\x9b(k\xa3\xf2&\x9b\x9f\x0f\x00\x00pAe\x8a\xaaU\x85\xf4\xd3\xda 7\xfd\x1ft\xbf\x00\xf0\xac\x85#\xdf\x1b\xb3\xb3\xffJ+\x95T\xba\xbeB\x1c-\x8e\x00\x00\x00\\@\xd3\xf4\xc3K\xfdU\x95Y\xa9\xf2\xdc\xedj\uef11\xa4\x14\x00{˱\xbf\x17\x9b\xbbo\x8c\xf5\xc9_\x97\x9fm\xaa\xb72\xb3\x9d)\x0e[S\x13\xf7k\x16\xf6\xa9\nC5m.\xa5\x12ҵ\xdd\xdb\x00\x00\xd8m\xbc\x82|\x16l#\x93R\xbd7+-fpRm1CcGÚ\xcaxZ:\xf1\xb8t\xe7M\xc4\r\x00\x9e\x13\xd7Z\x19\x92z\x1c\xbaL.\r Ei\x02,\xb5\xf3\x97p\x8cA.f\xa9\x87\xa6b\xde*\x7f\xfe\xcf\xd2O\x02\x00\x80\x8f\xa09|M\\9{R\xbdAP\xdb^m\xb5\xe8\t9\x8b\xbd\x01<w.z9U\xdd\xf8J\xb6\xa1\xd69\v?\xaf\x9d\x1f\x04\x96\\x\xf4M1l\xfd\xbe\x8a\xda^\xb8\x81-\xa5b\x9aQ\xd9\xe21i؝\x03\x00O\xcb\xeaŢ+'V\xac0iT\x85-\xcd\n)_\xff$鶟X\x9a{8$\xb2cgy\xe4\xfb\xe2\xecԏ+\x9f\x1dS\xe9\x9dZ\xad+\xc4u\xab\x9cK\x854Zx\x98\xd9\xc57M-T[3\xb2\x12\x00\x00\xb8\x18\xbc\xa2\xb55Z\xe7\xd5\xfar\xb1\x0f\x00\x9e\x85{\xbe6\xfa\x93?(7i\xa5\xe2z\xa9\xcc\xe5g\x8c\xb8\f\x00\x00.\x8e\xb6]\xefrTT\xaeX\x9bcU\xf9\xb9\xa3j\xee\xba\"\x86Ǿ\x8d\xae\xe4\x00v\xb5\xf6\xf4\x9b\xe2\xf6\xbd7\xc5\xe9c\xffE\xf9ّ\x06\xb1T\xe6[Mji\xa2\xa9\xa6\xd9HU6V\xf4S[\xdan\x06\x0f\x854\xa3\x01ϗ\x00\x00\xbbS\xa8\x1b)\xb6\xf3\x9e@y\xdf\xd6+\xf6z\xdd*M[k\xa8\xbeE\bN\xfb\xad*\x9cI\xe7Fһ֣\x1e\xfe<\xe2\x06\x00\xcfJ\xd1V\xa90\x91\xb2\xc6\xda\xd9\xd6\xf8\x8e\xbd\xf9\x01\x00K\xa9\x8b\x95\x15\xe5\x82-i\x80\n{\x19\x99_\b\x00\x80\x8f(\xdc\x7fK\xf4O\x9cPfMb\xa7\x83\x9a\x0eNh\xda\\m\xed\xe4\xf1\xe2\f\x00\xcfI\xbaS\xe5']\xbbTm\xa9\x98G\xe5\xfe\xc5\xdd!`\xd9M\xce\xfd\x90\x86qž\xe3\xa5}\xbf\xcf\xd9\xf7=\xaa\x17\xf6\xcf\xf3\xe4\n\xd2;\x01<\xb3,X\xd9Qnu\xf5d5+5\xcbm\xdf\xca'\xc8\xdd\xfc\xdfR\x8d\xb94(\xe9\xb0c\xc4\a\xffn\x9c\x9d\xf9a\xf5ꑜOWT\xa9\xe0\xceu\xb3\xb0+\xdd(m\xa2b\xe8\x86\xf4\xb5o\xae\xad\xd3(\xe2\x00\x00\x00\x17Z\xb46\x87k\x143ko\xa4\x8e^\x00\xf0,\xc4#\x9f\x1d\xa7[\xffQJ\xa3f\xe6^\xb1\x9dI\xb3Ui0?\x0e\x00\x00pAe}e\xc5)\xb9\xca)̬\xc1\xd1ߖz\x16\xc7lI\xd5\x13?\xaaّ\xaf')\x05\xc0\xae4\xba\xfb\xd689\xf2s*7\xc6\xea\xa7\xc1\x8f\x8b\x91\xd4:\xf90Р\xb0\xa2P\xbeK\xd6\x1bXp\xd6\xf7\x13\xf5\xfc\xacKr\x8f.=r\"\x91\x1d\x00\xb0;u\xb3\"\xb7\xa9\xbe\xab\xa4\xb2\xb5\n\xd1*\xc5\"\xeb\xeeS\xce\xd7\x13\xab\agVK\xaeIC\v\x1af[\x9a\x9e\xfc5U\x8f\xbc\x8e\xb8\x01\xc0G-o\xadlI\x13\x85\xb9\xa9\xa2\xd2 \xbe<O\x05\x96Y\x8a\x93\xbb>\x12\xb2\xb6\x83\xc5\xcb]\xc2P\x96.r\x00\x00\xf0L\xe2\xed+џ\xb5z\xb3\x97[\r\xea\xd543\r\xdbk5X9aq7\x03=\x01\x1f\x9b \x9f\xeem\xa5k\xab\x1e\xca嵊\xece\xf3C\xc0\x12k\xefzC\x1cN-\xeeJ\x034d\x874M\xb1W\xbbe\xdf\xf4\xa0R\x99\x94\xf2\xe6\x00\xe0\x99\x84\xd4\xd1!Տg\xe52\xaf\xfe\xe0\xafh喷\xa5\x92d\xa9\x90Ȏ\x1da\xfc\xf0W\xc6\xe9\xd6\x7fU/l\xcf\xef\x8eƃ\xaatHY\xb6\xd5-\xd6\f\xb5\xaa\xb9\xb4Fi_\xd1\xf7\xd4\xd8E7\xf2\xdc0\x05\x00\x00\x17\x83\xefF\x1dϲB>gFv\x00\xcf\xc2;\xde\x18\x9b\x8d\xbb\x14\xf7Iွ\x8eV\x9eT\x9bV\xac\x9cR\xe3\xd6\xe7\xe7\x00\x00\x00\\@\xc1\xa7As\xfa\xf2\xed)\x8b_\x8ek\xec\xf7i\xcbb\x99\xbc8\xa7\xbe2\x9d9\xf5\x93\x9a>\xf6\xa5$\xa5\x00\xd85&G\xbf6\x9e\xb9\xebڸ\xb2uT\xab\xcd\x11\x95\xc5Y\xa9\xecIy\xa9֍\x14ڱT\x95\xf2\xd5!\xf9٪\xdc4\xb3\xd7AJ\x83\x8c\xb9J.k\xec\xfcŇ\x01\x00\xb0\xcb8WtIhm\x98\xaa\x8d\xb6\xb8\x89*MUő\x9a\xb8\xa5\xda5\xf2\xde\x16\xdbN\x93\t\xd4+\xb6\x1a\xf6\x14\xce>\xa2\xf0\xee42\f\x00|x\xe3#\xdf\x1c}\x9b[\xfb\xda^\xb84\xa2oB\xa2\x0e\xb0\xecbJd\xb7\xc5w\x13\f\xb9\xaeC4\x00\x00xz'\xde\xf3I\xd1mM\xa5\xf2\x88\xea\xc1AU\xfd\x81\xb5\x8dGr\xcdqi\xban\xb1\xf8\xf9v2\x80\xe7&t\x93\xb2;e\x8a!%\xfc6\xca_\xf4\xaf\x97.Q\x0f\xf8\x00\x87??\xceν].\xae\xaa\xad\x8fj\xe2[\xf9\xdeU\xf3{+\xf1\xac\xc5a\x035\xa1Y\x9c\f\x00O\xa3ݖꞕ\x17\x95\xf2\xfeD\xc5K\xfed)\xebF\x97n@\x01\x97\xd5\xfd_\x19\xc7\x1b\xbf\xaf\xa1;\xd9%z\xa4\x11f\x94\a\xb5~\xaa,\x8d\xe2\v\x00\x00p\t5\xbeP\xden\xd9\xc6\xcd\xf6듕_\xf7\xb3\xdc\x04\x03\xf0\x11\x8d\xef\xbd9\x0e\xb7N,^\x01\x00\x00\xec\x10\x8dW\xc8\xc6\xda\xdcw\x9d\xf6\xbf\xf8qb\x1b\x00\xcb\xeb\xe1\uf253\xed\x9fU\xdb\x1eU\x9aO\xbd_3\xeb#\x00\x00\x17Z\fS\xb9\xab\xbfQ\xba\xf9ǈ\x1d\x00<\xbd;\x0fEmmi:\xbcFyu\\y\xe35\xe9\xf74hH\xd6\x01\x96V&\xb5qUYvJ\xaa\xa4\xa6>\xa0\xc9\xf3^\xab\xb5\x1b\x7f\x87\xf6\x00\x00\x00\x1f$\xdc~S\xf4\xe1Q\xdb\xe0\xfe4pф5\x05\xff\xa4\xd2\xc3 _]\xad\xad\xd5\x13Z{Y\x9a)\x13XN\xf1\xbeW\xc6\xea\xdc{\xd4\xeb\r\xa5\x9a\xc9\\\x01<\x03\xb7\xa2\x10\xceu\x83\x15g\x99\xbd\xf6\x03\xa9\xcd\xed\xf5H.M\x04\xdd\xf4\xac\x8e\x9c\xe9T\x7fU\xfd\xab\xbeE\xab\xd7\xfd\xf3\xa5\xac\x1b\x19:\x11\x97\xd7\xfd_\x19\xb77oW\fOHYm\xdf\xc8f\xfe\xad\xf4vչt\xe5\x01\x00\x00\\\x06\xa9iﶕ\x177\xcd_\x03\xc0\x87\xd1>\xf6\xe9q8\xa1\x93\x16\x00\x00؉\xd2<J+\xeaM74y\xf0Ռj\v`)\xb5\x0f\x7fvl\xc6?\xadb6\xd1`z\xa5\x8ajuq\x04\x00\x00\\H._Ws\xf6\xbf\xa9>\xfc\x95\xc4\x0e\x00\x9e^k\xc5C\x8crz\xff,a\x9e\x12\x03Xr\xd5|\xf2\xa1\x94\x1bd\xab\x90\xcf\xd4/_\xbc8\x06\x00\x00\xce۾\xeb属\x9e\x95j\xeeO\x03\x17U\x8a9S\xff\xdd\x14k:\xdb&\xe3\rK\xac:\xf2\x99q\xb6\xf9\x84z:$5\x93\xc5^\x00\xf8Pm3\x96\xcf2ee\xaa\xf8J\xc5*\x8d<\x98\xfa|\xf5\xad>L7lf\x9a\xe6C\x1d\\\xff\xdbK\x9bĞP\xad\xe3\xb2\t\x0f~Z7\x13\xbbk\xefְl\xe7\xdf\xc6`붵_\xad\xea\xb4\r\x00\x00p\x89\xb9\xf4\x90\xd6\xe7\x9ae[ҕ?\xb8\xb4\r}\x00\x97F\xfd\xe8߈\xf5\xd1wY,sl\xb1\a\x00\x00`\xe7\xd8\xce\xd33\xfe\xfd\x1aL+\xf5\xcfޮp\xe7\xf3\xe8^\x0e`y<\xf6\x1d\xb1\xba\xebE\xb1>\xf1\xa7\xca7\xa7\xcaݖ\xb2⌲\xc8\xf3#\x00\x00.\x86V\x95\xb2\xd9q\x15O\xbeY\xe1\x9e\xcf&v\x00\xf0!\xe6M\xf1 \x1f\x1a\xb9\x94Y\x909k\x9fw\x87\x00,\xa9\xe8\x83]\xcf\xde\x1a\x02+]\xff\xcd6\xafT\\\xfdo\xe9'\x01\x00\xc0Sl\xdfsmt\xd3;\xd5\xcbz\xd6\x06f\xa2>ࢊ\xd6>\xf5\xbek\xa3\x06\xd5\xca\xf3[\x17\a\x80\xe5\x12\x8f~{\xacN\xff\xae\xf2\xd9\xc4b\xadLM(\x16G\x00\xe0C\xf98\x95U~\xb6\f\x15c_\n\xa9͙nϤ\x84\xf6Bg{N~\xfdo\xca\xdf\xf2\x1f\x96\xfa\x9e\r\x89\xec\xb8,\x9aß\x15\xeb'\xffHCwR+e;\x1f))=\xec\b\xb9\xa2]x\xdd\x00J\xddPJ\x00\x00\x00\x97V\x8c\xd6\x12\xb1 \xa0\xcei\x8b\x00\xf8\xf0\xda#\x9f\x12\xf3\xe3oSߝ\xb5£\xb7\xd8\v\x00\x00\xb0s\x14y%\xafm\xa9\xe9\xcbՒ\x9f>\xa6\xeaޛ\xe8b\x0e`\xe7{\xf7\x1bc<\xf1\x8b*\xb6\x1fV_[\xf3'\x9a\xed!u\x0f\x94\x06\xccX\x00\x00\xc0\xc5\x10\xd5\xc8e\xabV\xef֪\xb7\x7fS[\xf7\xbd\x90\xd8\x01\xc0\a\x88\x8d-.(K\x03_\xa4\x8e\x94\xd6<\xf7\x81\xee\x87\xc02K\xdd#\x14\xeczn{\xa91\xa0:'\xb9\x02\x00\x80\xa7\x1a\xbf\xe7\xe6\xd8\xdfzB+ir\x9c|C\xea\x11*\x03\x17\x95\xb3k\xcc[\xfb4\xe6j}\xad\"\x7f\xf5\xe2\x00\xb0D\x8e}G\xacN\xff\xa4V'W(O}\xd1\xe3\xa6\\\xc6\xfd\x13\x00\xcf\xcc\x15VV\x84\xa8\xa6q\n\x8arye\xf5\xe1\xc4\xf6\xcd\xd4\xd8\xfe\xf6\xc0kU\xde\xfaKv\xd2r\xa3$\xc4%\xd7>\xfc)\xb1:\xfb[\xea\x15\x03\xab\x90\xed+\x98F\x89\xb0\xebKun?\xfav\xf1\xf5\x94\x17\x85\xca,\xbd\x06\x00\x00\xb8\xb4b\x9bz`\xd8F\xef\xba\xf9\x0e\x00x\x1a\xed\xd1O\x8bٱ\xb7\xc9U\x9b\xf6\xeajU\x84/\x00\x00`\a\xea\xc9\xe2\x9b`\xed\x15WJ\xdeb\x9cLj\xaaGU\xdd\xff*z\xd9\x00ؙ\x1e\xfc\xca8}\xe7\xad1\xce\xde%\x17\x8f\xc9\x15m*\xcc\x14\xb3-+\xd1Ϊ\x0e\xa5\x1a\x06\x12\x03\x00\xe0\xa2ȵ_!\x8bjS\x7f\r/\xf5\xb7\x1e\xd4佽\xa8c\xdfE\xfc\x00@z\xf4\x9b\xa2o\xb3\xae\x9b\x97\x8f\xb5\xedH\xc9\x05\xf6+\xcc\x0f\x03XR]\x0fb\xbb\x90\xebԏ\xd363\xfaI\x00\x00p^\x9a\x89\xbd\x9c\x1dQ\x1e\x0eX]\x19\x14jk\a\xb7+\x8b\xa3\x00.\x8a\xf9HK\xb6.\x14-\xe0̞\xff\xe6\xa5O\xda\xc3\xde39\xf7\x93*\xb6\xb6\xa5¾\xcb\xe5Ⱦҩ\xbf\x067P\x00|\x18Ϋ\t^u\xdb\xcagi\xa6\x92y\x12\xfb\xd4\xe7\x9a\ro\xd0\x157\xffŮ\xa8\x0f\xbb\xdbP\xc0\xa5R=\xfa\tQ\xe7\xfeD\xc3t\xf9\xa4\xd1!Z\xab\x90ۡ\xd4\f\xad\xcdٳ}\xf6:\xcd\xc8\xde֊\r\x155\x00\x00\xb8\xf4\x9c\xb5EZU*\xfb\xaf\\\xec\x01\x80\x0f4>\xf2\xfaX\x1fy\x9bmE\xa9\\S\xd0\x19Uam~\x10\x00\x00`'\xe9\xfa\x95{\xfb5\xb1\xa5\xb2v\xcb>\x95M)\xb7y\x9fڿ\xbc\x8ad\x14\x00;\xca\xec\xae\x17\xc5j\xf3gT4\x0f\xc8eVDu\xa3\x8cK\xc1\xe2\xad\xc6\x15\x8a\xe5T1\xb3}\xd5\xf3\x16\xef\x00\x00\x00\x17RlΪ\x89\xa5*w\xd0b\x87\xbe\xd2x2\x83i\xa5\xf6ԯiz\xf8\xab\x89\x1f\x80\xbdn\xfank\x9c{k\x93۶k\x14c\xec\x92\n\xba\x01\xc2\x01,\xb7\x98f!j\xbb\x9c\xa1\xac|\xd9|\x1f\x00\x00{\xdc\xf6]/\x8fn\xfc\x84rﭮ<\xdbݫ\xf6\xd9>M\xa7\xb6\r\xe0\xe2ɜb\x9a\x94\xb6\x1bH\xad]\xec\x04\x96\xc7\xf6}\xb7F\xb7\xb1)\xef\u05ec\xfe8c\xa1V\xa3\xd8\xdbTl\x0e.\xce\x00\x80\xa7\xd1:\x05\xab\x00\xb3,\x93\xf3i\xf0$\xa9nlտM\xee\xea\xcfY\x9c\xb4\xfcHd\xc7%3}\xe8\xb3\xe2\xf8\x89w\xa4I\x7f\xac\x81y@\xed8HyZ\xa2\xda\xdc\xc9ښ\xb6?(Ʃ\x82]m\xae\xdb\x01\x00\x00pi\xe5ީq\x95\xca\x17\xfc\x12#9\x02\xf8\x10\xf1\xe4\xa7\xc6x\xf2=*\xe2d>#A9S]\xd4\xea\xd7\x14\x19\x00\x00`'J#{\x97j\xb3\x99\x9a\xec\x8c\x14\x9c\xf2\xf6*\xe5Mz\xfa\xff\xa4\xb6\xef~}\xd4\xf17\xd1\xe5\x1c\xc0\xe5u\xe4\xaf\xc6x\x87\x8b~\xe3a\x15\xb3\xa1\xb2\x15+\xa2,\xcej\xebu5\xd3\xeb\x15\xd5*\xcfҤ\x05\aU\x86U\xe5\xfe\xa1\xc5\x1b\x01\x00\xc0\x85\xd5Z]\x9bk\xd0\x14\nM\xab\x98y\xab\x80\xafRV\x1fW{\xfa?\xab:\xf2\x8d\xc4\x0e\xc0\x1e\x16f\xf7\xda\xcfL\xae\xeb\xceUK.\xa8IY\xec\x94\f\xc0R\vi\xae!\xd7\xd8\xe5\xdd\xc8\xe5\xd6\x0e\xe8\xbfz~\x00\x00\x80=,\xdc~S,6\x8fh\xa5\xbd\"e\xaf\xa7\U0005cb2e\xdcg1\xf2!\xab/\xa7\xf3\x93\x00\\\x141M\x8a\x19\xa3Bk\xcdԌ\xfexX.\xf5=o\x88\xd9\xc6\t\xf5\xdbU\xa9\xb7\xad\xe02\x8dc_Mf\xdfg\xee\x9f\x00\xf8p\xac\xfesY6\x1fD\xa9\r\xd6 \xb5\xa6g\xf9B\xf5\xf6\x7f\xb6\x86W\xfe让\x10Id\xc7%\x11\xee\xff\xbc؞\xfc\x1fZ/\xfbj\xdd~UՆ2\x7f\xa5Z?\xb5ו\xea\xb4\xcef\xd6\xf0\x9c*\xaa\x96\xb7\vϕ\xc3Ż\x01\x00\x00.!k!\a\x97\x9e\xd6\x02\xc0\a9\xf6\xe5\xb1z\xe4\x88VB\xa5l\xe5\xa0\xc6\xfe\x986T\xa9,J\xe5ٓ\x8b\x93\x00\x00\x00v\x8e:\xef\xab)\xa2|\x1eU8[k\xa2\xd8l\xc8e\xb9\xb2\xfe\xaa&[\x7f\xa9\xed\xb3\xbf\xb58\x1b\x00.\xbd\x8d\xf7^\x13\xeb\xc7\xffTn\xabPQ\x16rE\xab\xaaZ\x95o\xae\x91\xeffe?\xa5\x98&ܨr\xa9=+5\xdb\xd2le\xfef\x00\x00pA\xb9\xf2\xa0շOZ qT\x85_W]^\xa3\xadl[\xad\xce*վgN\xfd{\xcd\x1e\xfbr\xba\\\x02{U{\xc6~d\xf6+u7\x8c]bA;\xdf\x04\xb0\xd4RF\x85\x05\xdeiB\xa2\xac\x90\x7f\xde\xf7\x91-\x04\x00\xd8Ӟ\xb8糣\x0f\x8f\xaa\xd7e\x1cVVG\x0e\xe4\xcbk5\xadg\x9aU\x0f\xa97\x18\xccO\x04pQ\xa4\xd9\xd8-ܴ\xc5ک\x9e\x80\x13\xcb\xe3\xf4\xe1\uf3f3\xed\xb7k\x10\u05ed\xee\xb0\x18\xab\x8d\n\xbd\\*\x0eh6\xbaE\xd9\xe0\x89ř\x00\xf042\xdf\xe5Ҷ\xad\x95\x1f\xb3T\x11\x96\xd2\xf0V\xe57\xbciWݧq\xe9\xa62pQ\xdd\xf7w\xe3x\xe3\xf75\xf4V\xf1\x06kP\x06\xab\x8c\xf3\xd0%\xb1gm\xb18\t\x00\x00`\x87\x98Mu\xf6\xc0\x8a\x0e\xbcl\x9b\a\xb4\x00ާz\xe0+by\xee\x17\x16\xaf\x00\x00\x00v\x89,W\xe3\xb6\x15\xfb\xb9\x8a\x8f\xab\x89\x81\x00\\:\x7f9\xe0\x01%\x00\x00\xcb\xc6M\xd5x\xa9^\xf9D\r^\xfcg\xc4\x0f\xc0\x1e3\xbe\xfd\xb6\x98ǻT\xc6\xc1|\xa0\xa9b+M\f\xa4\xe8\xaeP\x16\xb6\xe7'\x01X:\xd1gr\xcdXʢ\x9e\x18\xacꚗmQ\xc7\x03\x00\xf6\xac\xe6\xae\xe7\xc5|\xfc\x98m\xf5\xe7;\x00\\zE!M\xb7\xa4pP\xb3\x1b?S\xbd\x1b\xdfL\xfb\x14;^\xbc\xefoG7z\xb3\xd4R\x7f\x00x\x06\xe5>\xc5٦ڬ\xb6\xba\xaeUlb7\xa6\xa0sV͵Qu\xeeUt7[\vU\xbe\xd4h\xedSt\xe0\xd6_\xdbuu 3\xb2\xe3\xe2\xba\xef\xef\xc6\xed\xcd\xdb\x15\xe3\t\xfb\xb6U\xb64\xf3o\x9dO#$Ѧ\x04\x00\x00;\x90\xb5U\xf2\xf2\xca\xc5\v\x00\x98\xab\xaa?[l\x01\x00\x00\xec\x1em\x9d\xcb7N~t\xa5\xea\x87^JR)\x80Kb\xe3\xee\x17Q\xde\x00\x00\xb0\x94V\xa4\x98\xab\x19\xffOm\xdd\xfdJ\xeas`/y\xec{\xa2\xd3x\xbe\x9d\xa6\xc7[t9L\xfd,\x15\xdan\x1b\xc0\x12\x8bvM\xa7~\x12š\xc5\x0e\x00\x00\xf6\xa0\x87^\x1f\x9b\xedVm1o\xeb\x02\xb8LB\xb4`Ӛ\xa8ٶz\xbd\xe7/v\x02;W}\xf2\xbb\xe2t\xf66i\xb6\xb2\xd8\x03\x00Oc\xb2\xa1\xa6iR\x05\xa7,\x0e\xad\xaa\xeb\xcb\xd9Z\xd1ʎ0Tіv\x92W\xab\x99B糧2\x89=\xa1\xa5\x8d\x8b&>\xf1O\xba\x99\xd8]\xb8G\xc3b6\xff\xb6\xc5Vj-ȳ\xa5ny\xae\t\x00\x00v \xd7Sٻu\xf1\x02\x00\xa4\xed\xbb\xaf\x88nvt\xf1\n\x00\x00`\xf7\x88\xbe\x91\xaf\xaeVV\x8d\x15N\x1f\xd6փ/\xe1\xa6-\x80\x8b\xa6~\xf0\xb3c\xfd\xee^\\={x\xb1\a\x00\x00,\x93\x89\x1f)\xcf\xf6i-x\rF\xefQ\xfd\x1e\x06\xa7\x01\xf6\x8c\xc9݊:\xa7\xac\xeb\xfb\x95.\xfdy2{Jd\x8f\xaa\xd2\x19\x00\x96T7\U000d7ccb\xdb;\xf5z/X\xec\x05\x00`\x8fy\xe4\x13\xa3N\xbc[y8\xa9\x99\xcb\x17;\x01\\\x0e!\xcdF\xebWT\xa5I4\xaf\xfa\xa1]\x99ć\xdd%\x9c\xfd?\xe5\xc6'U\xa7\xef,\x00<\x83\xe8je\x99S\xee\xfaRcK[\xaa\x8d\xd6\xee\fi)l)\xed\xa4FU\xff:\xf5o{ۮ\xad\xffHd\xc7E3y\xf2\xff\xd2\xd0?\xa1\x95b\xd6\xdd\xebTjT\x06o\x17\x9f\xb3%\xc89\x9ei\x02\x00\x80\x1dȕ*\x8a\x8f_\xbc\x00\xb0\xd75w|\\\xecONk\xc0\x8dF\x00\x00\xb0\ve\xe5\xb6\xfdl\xec\xd7P\xbdP\xab7\xbaO\x93\xa3\x7f\x8d\x1b\xb7\x00.\xac\xc7\xffQ\xd4{n\x8c:\xf1\x9bʧ\x95\xb2!\x8f'\x01\x00XFY\x8a\x14\xc2\xd4~\xecW\x9er\xde\xea\xc3\x1a\xddu3\xf1\x03\xb0\a4\xd3{\xe5\xb55\x9f\x81=-)\x99=\x16\xb6\x9dٺ\xee\xce\x01\xb0\xacR\xa7\xceL\xad-\xbd\xe2\xa5\xf3]\x00\x00\xec!\xe1\xc87\xc4\xf6̟w\xedڼ\xe8\xcb7\xa9n\x04p\xb9\xc4n\xf0\xb4\x81jƔ\xc0\x12\x88\xf7^\x1d{\xa7\xa7\xea[D\xe5z\xdc\x1f\x01\xf0\xcc\\\x19\xe5\xf3\\1d\n\x8d\x95\x19\xaa\x95\x15\x95T\xd4\nek\x8dҠ\xa6\xb8J\x83C_\xb3x\xc7\xeeDO\x11\\\x14\xb3{\xae\x88n\xeb\x94]H\x99-\x85\xec\xfa\xb2%\xb5&\xfbrEa\x81^\xa6\xd2.@\x00\x00\x80\x9d\xa6VO\xfe\xda\x7f\xc5H\x8e\x00T=\xf8qQ\x9bǕ\xb7\xfb\xe4\xe9\x8e\t\x00\x00v\xa3\xd4\xc6I\xd9(\xe5)\xc9\xe5*\xa7k\xaa\xcf\xfd\xb1FG\xbf\x8d\xd6\x0f\x80\vbt\xcf'\xc4\xea\x89\x1f\x91\xa6GU\xe4\x87\xe4\xb2\xfd\x8a\xddt\x1a\x00\x00`ٔ\xf55\xd2l$\xd5g\xa4\xb0j{\n\x85\xd9\x11M\xeexq\xd4}\xff\x80\x18\x02\xd8\xc5\xda\xeaq\xe5j\xe4\x16M\xf94\x81\x89bf[\x85\xbc\xda\xf9N\x00˩\xbb\x9e]\xba\xc2U^\xf7o\xe9'\x01\x00\xd8[\x8e\xbc)\x86\x8d\x9fP\x98\f\xa5a\xd9\r\xd4ԟ\x0e\x16\a\x01\\\x0eY\xf7\x00\xdb)\x96\a\xe7;\x80\x1djt\xe4\x96\xe8Ν\x93\xa6ϓ\xf2\xa9\xe4\xd3}\x12\x00x\x061t\xc9ꡩ\xac\x96\x9b*+&R1U\xc8Fj\xb2\x99B\xafP\xbe\xf6e\xd2u?\xb0\xab\xef͐Ȏ\v\xae\xb9令\xed\xd3\xea\xe5)\x89=\xb7\xa5\xdf\xcd\xe8\x13c\xcf*h{mߺش\x8a5\x0f2\x00\x00\xc0\xceSe\xd6v\x01\xb0獏\xbc!\x86\x8d\x87\x95\xf7f\x16\xc0\xecWˀ\x99\x00\x00`7\x9a^)\xf5Ow#\xfc\xaa\xb9BrS\xf57\x0fH\xdboY\x9c\x00\x00\xcf\xd1\x03\xff(\xb6\xf7||l\xa6\x7f\xa1I\x93\xab\xf5\xd7K\xb9\xb7rf$W-\xce\x01\x00\x00\xcb%ͺ\xec\x86j\xd5S\xeaj\x15\\_C\xd7S\xbf\xb9_\xda\xfa1\xcd\x1e\xfd.\x92ف]ʇmeq>cP\x97\xf4\x9a\x05\xc5.\xb9 \x97\xb7\xf2\x00\xc0\xf2\n\xa1\xb5:>S\x10I\x17\x00\x80=\xe8ܿS\xbe%\x15\xabc\xd5\xe1*\x85vd\xf5b\xb98\b\xe0\xb2p\xd1ڦ\x95z\xfdW-v\x00;\xcf\xd6\xc3\x7f-\xfa\xb3\x0f[\x9d\xb1.\xf5\xa7R\xb3n\xb1\xd5b\xf4?\x00x\x1a\xb1\xbb\x85\xea\xbb{\xa9\xde[y\xe1\xad\xe8h\xa4\xf1L\xaa\x9a\x81\x9aC\x9f \xbd\xe0\xff\xdc\xf57ZIdǅu\xdf+b\xbe\xfd\xa8\xcavE.\xc5q\xb9]\\yT\x9b;[\xd2v\xa3\x18f\xf3\x11$\x02_?\x00\x00\xb0\xf3\xc4\xde\xdab\v\xc0\x9e\xf5\xd0ߋ\xee\xc4\xdbՏ\x95\x94\x1dTS\x1eQ\x1b\x0f-\x0e\x02\x00\x00\xec\x1e\xae]U\xe3\xa5iا\xa6\xff\x84\xed)U\xfaJ\xc3s\x035w\xdcD\x12\n\x80\xe7$\x1c\xf9\xa6\xa8\xb3?\"\xb7\xf1\x88\xd6\xc2uZ\x19X\x99\x92\x9d\xd4t:S\xa8z\x12c\b\x02\x00\xb0\x94\xda\xf2\xb4\x85\f}e\xc5!\x15YJw۲%\xc8Y\x1c\x91:\x19\x17\xa7\xfe\x93\xf4\xe0\xffN\x1c\x01\xecB\xb9]\xe9\xce\xf5\xe5ڲ\xebt\x19\xb3Z\xb1\xdbH\x13\x9a\x90\xfc\n,\xb3\x18[\xabǽ\\^,\xf6\x00\x00\xb07\xb4\x0f]\x11\xdb-\xdbH\xf9\x0e\xa1P\fO(\x84\x15\xa9\x7f\xb6;\x0e\xe02qQ\xad\xabT\x0e_\xbf\xd8\x01\xec,\xf5#_\x19\x8b\x8d?\xd6`2\x94\xfcDꝶ\xef\xec\xba\xd5%\xdc\x16\x05\xf0̜sVfx\xb9,\xb3uae\x86T\xa5y\xd6\xdak4\x1c\xbcJ\xe5M\xff}\xd7'\xb1'd\x12゙>\xf0\x85\xb1:\xb6-\x8d\xd7\xe4\xfa#5\xb1\xaf\xd6O\xbb\xa5\xee\xd63EkTFUv\xed\xd9\xc5WZ\xc5\r\x00\x00\xb0\xc3\xf8\x82\a\xb4\xc0^6=\xf7\xf5\xb1\xda\xfc\xaf\x1aL\x0fH1%v\x1d\xd34\x14ʆ\xb3\xc5\x19\x00\x00\x00\xbb\xc8\xca\xc3jν\\u\x19\x14\xd3\xed\xda\"\xcd4\x91˵\xdb\xf2ۏ\xea\xf4\xe1\xef\xe7i+\x80g\xe7\xae\xd7\xc6\xf6؏+\xf4\x0e)\xe4[r\xd51\xe5\x93-\xf5\x82S\xbf?Q\xd3o4־\xc5\xc9\x00\x00`\x99\x8c\xf2B#m\xa8\xd51\xb90R\xd6:i\x96+VC5\xed~\xf9ٓ\n\x1b?\xc5\xcc\xec\xc0.\xf3\xe4c\xff8:\x97\xdbV\xa94k\xb3\xfd\x90s\xc1ʂ4\x8b\xb3\xbdt$\xb2\x03\xcb,\xc6t!gr9\xd72\x00`\x0f\xb9\xfb\xf9qr,W\xbbr֚\xb9+\xd2\xd4+o\x1b\xe5+\x1b\xaa\nf\xd4\x05.\xb7\x94Ȯk\x7fhO$\xf4a\xb9\x8c\x8f}G\x1co\xfe\xa6\xfac/\xf9Z\n\x95f\xb1/\xb7~T>\xf4\x16g\x01\xc0\x87\xaa\xdbhe\x86\xb53\x83Uo!\x93\x9aR>\xaeimx\xab\xfcU\x9f\xb88k\xf7s\xf3\x1bQ\xc0\xc7\xe8\xceύ:\xfb\xdbR\x11UgC\xbb\xb6\x82z\xb6\xad\xb6]\x9c\x00\x00\x00\xb0S\xa4\xf6Iiq@+\xe7\x9bn\xb6\x00\xd7\xf4\xe6#\\\x85\x89\xf4\xfa\x197\xc0\x80\xbd\xea\xd87D=\xf9nM\xa6\xf7\xaa\xd7\x1f\xc9\x05+/\x1a\x9f&!\x90|\xe8n\x1c\x00\x00\x00\xec)\x83\xa9f\x83/Q\xef\x05o!N\x02\xf0\xe1\xdd\xfb7b;\xfe]ei\xb6\x81x\xc8v\x8c\xe6\xfb\x01\x00\xc0\xdeѮ\xa8\xf2\xa7-\x8e\x90ʵ\xbf'\xdd\xfc\x1f\x88#\x80]\xa0\xb9몘\x8f\xd3T\x95\x00v\xa5*(\f\xfbj\x0f\xfe-\x157\xbd\x99\xba\x1b\x00\xb0\xeb\x8d\xef~M\x1c\x8e\xee^\xbc\x02\xb0\xe3\xd4Q\x93\xc1L\x83WEڦ\xd8Q\xc2c\xdf\x16\xfd\x13\xffa\xf1\n\x00\x9e\xa5A\xae\xc9f\xad^\xbf\x92w+\xd6(\xdd\xd6tu\x9f\xfa\xaf8\xb7\xa7\xea;fdǅ1}@*j)\x0f\xddl\xebYJ\x04k\xaa\xc5A\x00\x00\x80\x1d$\r\xe4\xe4\xac͢\xacKb\xb7\x17r)A5\xa6\xa4v\x06\xe1\x01\xf6\xb43wh:;j\x91\xf2DΥ\xe4\x8b\\1\xe4R[Z|S,N\x02\x00\x00\xd8;\xda*S[ә\a\xc0\x877\xbb\xff\xd3\xe3d\xfbN+/V,\x86Z\xb1=\x93\xf9\x01\x00\x00\xb0\xb7\xf4\xc6*\xb3\xa1|\xdd\xd7l\xe3w\xa5G\xff\t3k\x00\xbb@\f\xcdb\v\xc0\xae\x94\xf5\x14\xfcDEq\xcbb\a\x00\x00\xbbW\xf3\xf8\xd7EWo,^\x01ؑb\x94\xf3\a\x16/\x80\x9dck\xf3\xb7\x17[\x00\xf0\xec\xc5-\xa7A?\xc87W\xab\xad\xb7U\xafz\xf5\u05ff}qt\xef \x91\x1d\x1f\x93\xea\xcc?\x8e\x9b\xefy}l\xaa\xc3R\xca\xeb\xb0oT\x13\x1b\xf9\x94\xf4\x11\xc2\xfc$\x00\x00\x80\x1d$\xa6\x19ٝ\xb5S\x9c\x93ks[\x9cb֨u35\x8c\xe1\b\xecY\x93#\x9f\x1b\xa7\xe3\xbb\xd5\xc6\x13ʳZ.\xfa.\x89\xbdKdOKd6v\x00\x00\xb0\xf7\xc48\x94\x9b=\xa2\xe9\xc3/%\x01\x05\xc0\x87\x88G\xbf7n\xdeuS\x9cn\xff\xbe2w\\\x85/\x15\xbcW\xedǋ3\x00\x00\xc0^\x12R?\x11\xbf_y\xddS\x98<\xac\xc9ƿ\x97\x9e\xf8Vb\t`\xd9\xd5\f\x04\x0e\xecj\xbeP\x9d\xd5\xd2u\xff\x9c\xde\x12\x00\x80]o\xfb\xe4\x7fQ\xde<\xbax\x05`GJ\xfdz\xcb\x17-^\x00;C\xf5е1\x8c\x1e^\xbc\x02\x80g\xcfi,\xb5WJqS\xb3\xc2^\xaf}\x83\xf4\xfc\xef\xdds\xf7bHd\xc7Ǥ}\xe2\xd7T\xcc\xeeR\xeeSB\x98S\x13\n\xb5m\xb4\v+\xc8v.\xce\x02\x00\x00\xd89bj\x02\xbb\xd4\xe1\xc2\xda\xfemnk/\xe7k5\xf6_\xccV\xbbs\x00\xec1\x8f}{\f'\x7fSy\xb1\xa9~\xaf\xb5P&Sl\x9dB\b\xf2\x99\xc57y\xea\x80I\x7fK\x00\x00\xb0\xf7dyP1k\xe57\xee\x91N~\x1d\r\"\x00\xefwǧ\xc5\xeaěU\x8e\x8ei\xd5J\x872+䲱\xa2\x1bٲ8\a\x00\x00\xec)u\x93+\xb4\x9b\x92\xaf5ȝ\xfaն&O\xfe'ff\a\x96\\ސ\xc8\x0e\xecn\x99*\xbay\x02\x00\xf6\x80\xe6\x8e\x17\xc4}u\xad\xa2\xa9\x17{\x00\xecH\xce\xc9\x0f_\xb3x\x01\xec\x00\x0f||\x8cg\x9f\xd0z\xceD\xaf\x00>\x06\xfd(U\x1b\x8a\xd9X\x83\x95/S~˿ۓ\xbd*\xb8\x05\x85\xe7l\xe3\xd1/\x8c\xf9\xd6\x11\rҷ\xc8\xf7\xec\x82\xeaɻ\x81\xca\xdc\xcb\x05\v\xf2\xac\x11\t\x00\x00\xb0ӄ\xae\x8d\x92fdo\xe5b>\x9fu٥y\xda{*z7\xcdO\x02\xb0\xa7\x84\xd3oհ\nʽS\xa6RjK\xb5\x8dSnŅ\xcb\x1b\x8bw&\xb6a\v\x00\x00\xc0^\x13+\xe5MO\xe5t\x9f\xdaso^\xec\x04\xb0\xd7\xc5\xf7\xde\x18\xd5\xfc\xa1z\xed\x83ꇞ\xb2\xf6\x80T\x17j\xeb\xaa\x1b\x10,s\x16W\x01\x00\x80=\xc7\xf9Fm\x98Xc!\xb7\x17Wȥ\xd1mFۚ\x9d\xfdE鑯'\x99\x1dXB\xf5\x91o\x8c\xae%\x91\x1d\xd8\xcdb\x9cY\xbdM?\t\x00\xc0\xee\x16\x8f|n\xf4\xe3\x87,n\xedI\xed\xcab/\x80\x9d(f\x99\x8a\x9b~\x9cD$\xec\f\x0f~~Թ\xbbԫ{\xcaȏ\x03\xf01h[\xafY\x7f$\xb7\xf2:\xb9[\x7f~\xcf\x16($\xb2\xe3\xb9y\xe4\xbbc{\xe6\xad*R\x12X֗\xc2PMS\xcaۥ\x94\x17i\x96S\xdb\xcfs\f\x00\x00\xb0\x039\xd7W\f\xf3Dvuݦ\x9c\xa2\xad\xa3[\x97뿴;\a\xc0\xde\xd1<\xf0\xf1\xb1\x1d?n\xd7\xff@af\x85A\xed\x15*'\x1f-\xae\xc9\xed\x04_\xab\x8d\x8d\x9aTn\x00\x00\x00\xec1\xaeJASZ\x06\xaa\xcf\xcd4}\xe8S\xbb(\n\xc0\xde4{\xe8\x1bb|\xc7\xf5\xd1m\x1c\x95Bz\x16d;\xf34\xf0ז\x15\x15\xad2++ʸ\xa6\xac\x1d\xcc\xdf\x00\x00\x00\xf6\x94\"\x9f\xaap+R۷ef탁\x06n\x9f\xb5\r\x8e\xab\xda\xfa\xc9\xc5Y\x00\x96I\x18\xbfӮen\x05\x00\xbbY\xed&V\x87\xbf~\xf1\n\x00\x80\xddit\xee\u05fb9\xfbT\xd9\x12\xf7w\xfb\x00\xecLM\x9e-\xb6\x80˫=\xf2\xb5ql\xf5G\xd7_\xc2_\xaf0#A\x0e\xc0s\xb7\x99\x06\v]\xbbA\xfa\xb8\xbf\xd8ӣb\x90Ȏ\xe7dv\xe6\xad:8\xb5\x8d<%\xacwQ\x9d|\x9e\x1e\\L\xa40\x92\xf3^12\xe3\x06\x00\x00\xd8y\xbc\xeb\xcd\xf3\xd7\xd5H\x8b\x11\xf2\x82\xedp: \xbd\xf0\xcd{:8\x00\xf6\x9a\xe6\xe1/\x89\xed\xd6\x1d*2+\x0f\xe2\xc0\xca\x01\x8boRy\x90\x96T>\xf8hqMPh3\v\x9e\xd7\x17\xef\x02\x00\x00\xd8C\xd2X>e%e#e\xedPn\xf4\xa7\xf3\xfd\x00\xf6\x9c\xe6\x91/\x8c\xcd\xd6OX|tL\xea\xdd U\x03\x8b\x9fR\xcf?\v\xa0RLU\xb4\xb6\xd8K\xe7\xbb[.\x00\x00`\xefq\xad\xb3v\x81-\xf9T\xb5F\n\xa9]P\xae(O\x83\x86N\xa4\xd1\xed\xb7F=\xfe\xed\xf3G4\x00\x96B\xac\x1e\xb0\x1f\\\xb6\xc0n\x16\x8bZ\xc3\x17\xbd\x85~\x12\x00\x80]\xabz\xef-\xd1\xc2T\x8bW\xd3\xdd\xec\xd6b\xd6\xd1\xfc\x00\x80\x9d\xa9 \x91\x1d;\xc0\x91\xef\x8f\xf1\xec\xff\xa3~\fRx\xbe}/\x8f(\x86\xb5\xc5A\x00x\xf6\xfc\xf0E\xea\xdd\xfa؞\xbf\xffB\";\x9e\xb5\xcd\a>!\x86ɽ\x16\xc8YE\xec[\xd5\xf5v\xd7Iɧ\x89ٛ\x99\xda*%\x85ٯ\x8c\x197\x00\x00\xc0\x0e\xe4\x8aE\x7f\x8b\xf4\xc3\x16\xb7\x98\x91]\xb4]\x80\xbdft\xee\xb7\xe5f\xb6\xd1o5\x9b:+\x1eR'K\vs\no!\x8d\x15\f\xa1QS\xdb\xf10\x90\xf7\a\xbb\xf7\x00\x00\x00\xec)i\x84\x9f2W\xed\xb6T\xf4GʷWT?\xf0\xc2\x14L\x01\xd8CƷ\xbf4\xb6\xc7ު\x95f\xa8\xac\x7f\x85F\xf9I\xd5\xf9H!+\x15\xddUj\xdaU\xb5\xc1\x82'?\xb2rcKjS \x05\x00\x00\xf6\x9c\xc9~k\vl*\xf6l\xc9\vu\xb7V\xdd\x19k#D\x95ᐊ\xf0\x80\xaa\xd1\xef\xcd\xcf\x05\xb0\x14b8\xdb=K\x05\xb0{e\xf9b\x03\x00\x80]\xa8\xbd\xfb\x8d1\x1f\x9dP\xbf諪\x9c\u00a0\xb1\x18\xf5\xdc\xe2(\x80\x9d\xc8y\x1a\xa8\xb8\xfcf\x1b?\xa6|\xb2\xdd\xf5\x19m\x8b\x875\x1a\xf7\x94\xad1\x927\x80\xe7n}\xed3\x16[{\x1b\x89\xecxV\xc2]/\x8b\xebO\xfe\x85\x06\xe50\xe5tHM_E\xdeO\x1bR]˻\xbe2[\x94Fڎ[\xdd{\x00\x00\x00v\x12\xa7S\n\xd9AM\xd3Hyif\xc18VQ\xf75]yxq\x06\x80\xbd\xa0}\xf7\xf5q\xd0l)/KŪP\xaf\x9cXXSځ4\xed\xa8\x95\r\xce\xd6M\xa1\xc2\xe2\x9bn\xc6\xf6p|\xfeF\x00\x00\x80\xbd$\xf6\x14ک\x1a\xad\xa9\x9d]\xa5\xcc\xd7r\x1bG5;\xc2,\x8a\xc0\x9e\xf0\xf0\xb7\xc6I\x8a\x9d\xfc=\xea\xad\xd8\xeb\xb6Q\x9c6\x1a\xc6}*ԗ\x0fV&\x84\r\xe5VJd\xa1/նD\x8b\xab\xca\x14W\x01\x00\x80=\xa7H\xf7X{r\xb3\xbe\xcaV\xea\xc5F\x16B\xd8>\v\x1f\xb2\x91Jw\xbd\xb2\x8d\xf7J\xef^%\x9e\x00\x96\x84\v\x99]\xcc$\xb2\x03\xcb,\xceG\xf9\x9f\x0fJ\xf1\xd4Ťc\x93\x8a\x01\xff\x01\x00\xbb\xd3졿\x13'\xd3wɗ\xa549\xa4\xb2N\x03\xb3J\xdbY\xca{\x00p\xd9xoK_u\x88\x8aYcKP\f\xb9\x94\x0f\xed`\xab\xb3\xeb_1?\x0f\xb8\x1cN~O\xd4{?%\xc6\xf1L*R_\xd2FY\xb5_+\xb6YWۋ\x93\x00\xe0C\x9d\xe9劥\x95\x1d\x13+0\xe2T\x1aD\x9d\xb5:\ue737\xd7\xfb?C\xee\xe6\x7f7\xbf\x19\xb3ǑȎ\x8fZ\xf5\xc4w\xc5v\x96F˶\x17>5\x1c\xe9\x88\x04\x00\x00\x96P\xf4r.Οͦ%=\xb8u^>;\xd4\x1d\x06\xb0\xfb\x85\xc3_\x11\xab\xe9\x93J\x13\xb0\xfb<\x932\vrboq\x14\x00\x00\x00\xef\x13\xb3\xae/\x81\xf2\xda\xdaLi\xb0\x9fV^3iv\xef\xfc8\x80]\xab\xbe\xf7\xab\xe2ֹ\xff\xa1vv\xba\x1b\xcbXu!\xb5)e}\xa6\xe07\xe6'\x01\x00\x00<\x1b\xf1\x84\xb2pHM\x1ckr\xff\x8bIf\av\xba\xe3\xdf\x13\xb34P\x15\x80\xa5\xe6\x16I\xeb\x1f \xc6\xf7%\xb8\xfb\xe2\x8an\r\x00\xc0n3\xdb~\xafB\xacl+=ߚ\xa4=rV\xfd\xe5\x91ٞ\x81\xcb*\xb6]\xbf\xdd\xcc\xfe\x8b1=\x88\xb6\x17\xce\xf6ٵ\x1ac\xa3+o\xfc\xd7OӀ\x05.\x8dp\xf2\x7fjk|\xbf\x82۲\n\xc3\xea\x0fo\xdfMo\x95\x87\xf7*2\xbe\x9a\x00\x9e\xd9J[\xa9\x1d?_Zݴ\xba\xee\x904\x9di\xe0\x1a\xad\x1ex\xa3\xf4\xa2_\xa5\x00Y \x91\x1d\x1f\xb5\xea\xc4\xcf)V\xa7\xec[c\x17\x94Erѥ\xe0\x0e\x00\x00`ɤD\f\xb5\xb6N\x89\xab).HK\xa1\xb2xAw\x18\xc0\xee֞\xf8\xae\xb8=\xfaEe\xb1\x96\xcb\n+\x02\x1a+\x05lMx\f\x00\x00\xf0\xa1ڬ\xbb\x17\xec\xfc\xd4B\xa8\x995\x99\\\xf7\x9cV\x93w\xa9=\xf2\xb7I<\x01v\xa9p\xf8\xfa8\x1d\xbdUy|\x8f\x86\xe5LΕ\xb6sU\x8a\x85\x95\a\x95\x95\a<\x1f\x02\x00\x00\xcf^L3\xb3\x97\x99\x85\x18CM\xcfޯ\xe9\x9do \xa6\x00v\xb0j\xfc\x17\xea\x12ٻ\xe7\xa9\x00\x96\xd6Sf`\xff`)\x99=\xef\xd3O\x02\x00\xb0\xfb4\xef~M\xd4\xec\x0e\xf5\xba\xae\x81\xae{ƕ\x1eyy{\x9d\a\xfa\a\x01\x97UH\x89\xec\x8d]\x9aQ\xae-\xba\x01&b\x96\x92اjĭ\"\\>\xb3\x87?'\x8e'\xb7\xcbeO\xa8Ls\"\xa5\xea\"Ԋ\xad}gCx\xdf``\x00\xf0tz\xb35e\xbdG\xad&\xbb\xc6\xea\xb9m\xa5\xf1\x94\xfa+\xd7*\xbf巸\xb9\xfa\x14\xb4\xc4\xf1ѹ\xfbsbo\xfb\xa8\x8a\xd4<̃*\xe5|{\x00\x00\xc0r\n֎\xe9\x06\xe4I\x8d\x19[\xa2\xd4\xf8\\\xbd\xde˻\xc3\x00v\xb7\xfa\xeco\xa8\xdfNT\x96)y=\xa8m\x1a\xb5\xc1Yq0\x9a\x9f\x00\x00\x00\x80\xf7K\x0fcCT\x1a\\\xbcM\xc1S\xec\xdb\xe2T\xd6'\xa5s\x7f\xb08\t\xc0n\x11\x8f}_\xac\xefZ\x8f\xed\xf1c\x1af\x1b\x1a\xe4\xad|\x96\x0e؏\x94\xbc\xe22eY\x96&\x1d\x00\x00\x00x\xd6\\\x96\xfa\x99\x9c\x91\u05fa\xf6\x87\x03\xeaO\xde.\xdd\xf7\x89\xf4\x00\x05v\xa8\xd9\xf4v\xbb^Id\av\x9d\xa7$_\xa4\xd9ڋ\x95\x8f_\xbc\x02\x00`\x97x\xe4\x9bc^\xbf[\xab\x8a\xeau\x03\xba\xf4\x15\xd2}\xee4vsZ\x85Н\x06\xe0\xf2\b]\xb2z\x9ax&=\x80N\xfdw\xbd\x9coպF\xc1\xaf\xceO\x02.\xb5G\xbe0\xea\xf4\x1f\xab\xcc'\x1a\xf6\xa4<\xb7}V]\x84\xe0\x17\xdf\xd9\xd0\r\xba\x00\x00\xcfȏ\xe4\xf2V.85m\xab\xb0^H\xb7\x1e\xe5\xc6\xea\a\xa1\xab\t>\xa2\xe9c\xdf\x1c\xdb\xed?Va\xdf\x16g\xd7Q\xa3\r\x85\xd4`L\x0f+\x00\x00\x00\x96M\x9a=L\xb5b\xba\x11\x96\xc2\x03\xe7\xd4f=\xe5\xd7\xfe0\xc1\x02\xb0\xcb\xd5w}Zt\xa3\aTv\x03Z\xac[\\Ӫiӥo\xc1\x8e\x9f\xceO\x02\x00\x00\xc0\xfb\xf9f\u07b95\xd8f\xea\xb8\x1eWl\xe7\x95rm\xael\xf6\xa4\xb6\x1f\xfbN\x1e\xd7\x02\xbbő\xaf\x8d\xa3S?\xa0fkK\xc5`\x9f\xb2i)M3if\xc7j\x8b\x97\x82-Y-\xa7\x9e|\xcb\xf3!\x00\x00\xf0\x1cd\x95\x9aٺ\\h\xe4\xca\xdc\xda\x18\xa5\xe2֟\xabz\xf4Ӊ+\x80\x1d\xc8Ug\xecgT\xdb=P\x05\xb0+|P\x12{Z\xfc\xf5?\xcaE\x0e\x00\xd8U\xda͟\xb7\x8a.ȧQZ\xc3>\xa9)\xacU\x9b)\xebFmM\xf9\x0f\xed\xfcD\x00\x97EH\xa3J\xa8\xb6\xebԢ\xcd8OgK\xabF\xb9\xb2\xf2\xfa\xee5pI\x1d\xfd\x8e8;\xf7\x1b*\xebm\xe5mz\x0e\xbab_Ѿ\xdaz`\xf5G_>/\xad\xfaH\xc9t\xf3\xef+\x00<\x9d\xb6?\x95&\a\xacB;.\xbf\xe6\x94\xddVq\xbf\xe5iP\x92\xe2#\xaa7~Ӯ\xa8m\xa9XOw0\x15\xa3\x05w\x16๘f0\x04\x00\x00X21\xef\xba\\\xc4n&1k\x0e{'\x97\xf5\x17\a\x01\xecj\xed\x1f\xaah[\vr\xaeLw\xbfդgS\xbeP\x96\xee\x8f\xd3U\x12\x00\x00\xe0Ce\xc1\xdaP\xd6^j2\xf9\xd4\xd15\xa6\x06Ti\xb1TJh\xcf\x14\xce\xfdzw\x1a\x80%w\xf8U\xb19\xf9\x9f5\xdc\x1cj\x90\xad\xdae\xee\xa5p\xc0\xae\xff5[\xf7\xba{'\xcak\xbb\xec\xad\fH\xc5\xc0\x8cDv\x00\x00\xf0\xec\xb5a`1\x85\xb5)\xc2Ėq\n)lg\xa6v\xe3>\x8d\x1f\xfc\f\xee\xd0\x02;LѤ\x9f\x93.\xd1\x15\xc0\x92\xeb\xee\xeb}PU\x9b\xaem\xaeo\x00\xc0n\xf3\xd0kc\x9c\x9d\xb6\x8d\xbeśC[Ҍ\x983\x8bA\xad\x1el{\x8b\xfb\xdd\xe9&7\x80\xcb&ͬ\x99\x9e9\xbb\x94\xccn\xed\xd1ES5hMy\xff%\x8b\x93\x80K\xa7:\xfd\x16\x95Mm_M/_eR]\xa8M\x03p\xaa\x90\xcf39\xab7B\xd3(\x92\x93\n\xe0\xc3H9\xb6u\xbb\xa5:\xcb\xe5\x0f}\xc9b/>\x18\x89\xec\xf8\xf0\xee\xfeĘM\x8fu\xedE9\xab\x94\x9b\x81U\xc7R\xe9f\x8a\r_\x1f\x00\x00\xb0\x84\xa2\xb5i\xba\x9b_\xe9\xc1lJdϥ\xbc\xb78\b`\xb7j\xee~y7\x86\xab\x0fC+\x00\x1a+\x06\xb6\x15\\\xdf\xc2\x1c+\x03\xd2\x14\x83\xe9\x81\x15\x00\x00\x00>Pj*\xa5\a\xb41W\xa6J\xca\xceY\x83jk\x9e\xccj\x8d\xab\xe1\xe4\xb0t\xec\x1f~P/X\x00ˤ\xba\xff\x05qv\xe6\xdd\xca\xebV\xbe\xb0\xb8(\xae\xaa\xaa\xceJ\xfdF*\xed\xf2.2[\x97\xf3\xf2 \xa4\xc43+\v\xbaA-\x00\x00\x00\x9e\x9d\x10J\xe5\xb9\xc5\x13!t\xa3\x8c\xb6E\xa5X\xae\xaa7q\xea\x9d\xf9\xbd\xc5Y\x00v\x84\x93\xdf\x13K\xbbf\x15Fݤc\x00\x96X\x8c\xf6\xeb\xfdK\xe7|\x12;\x178\x00`7y\xf0\xef\xc7f\xf3\x9d\xcaS\x0e\xa2r\xb5>ڲ%\x9f\x9dU\xe6\xa7\x16\x87\x16־ͭ\xfe\xe3\xfe6p99\x95\xa9\x89j\x82-\xb6a\xed\xd2\xd66\x9d\xf6K\x83\x97\xa6\x03\xc0%\x13\xee\xb8-\xe6\xd3G\xed\xfb\xb7\xaa\x98\xa5\x99\xd7k[\x1ae\xae\x91\xb2Jʧj\xe2Tm\x1b\x99\b\x16\xc0\x87\xe5'}\xc5\xf2\x80t\xed_\xb7\xe5\xe7\x18\xf9\xe2\x19p'\n\x1fV\xd8\xfcs\xf5\xdbL.[\xb5\nx\xac\xba*\xe5\xba\xd1\xc8jk@r]\x01\x00\x80%\x94RY\xad\x19\x13â-\x93f\x15\xf3\xcc$\x06\xecf\xe1\xf1o\x8d\xd5\xf6\x9d\xe9I\x95]\xfc})\x1f)\xe63y?\\\x9c0\x95\xda\xfd\xf3m\x00\x00\x00\xbc_\x97Ȟ~d\xdd=a\xf9\x99\x82\xdfP\xe3G\xaa\xedu\x1e*USfe\a\x96\xd2#_\x1fGw\xbc6N\xce=\xa4\xd2.q\xf5\x06\xf6c\xdbb\xa6'T\xaa\xb0k\xfd\xb4j\xb7\xa5:\xd6j\x82\x85L\xad-Mc\xc7m\xc9\x19\xbf\x02\x00\x00<\a\xe59kK\xec\xb7\xf0\"\r\x963\xd5\xcc\xf5T\xe5S\xf9\xe21e\x93RO\xbc\xf3y42\x80\x1db6{X\xae]\xb5k\xd6^\xa4g\xa9\x00\x96\xd6\xf9\xe4\xf5\xf7%\xb1\x9fw>\x99\x1d\x00\x80]\"\xd6\xff\xb7\x9a-\xdb\xe8\xed\xd7̇n\xf0\xb4\xac\xd7\xc8\xf7R\x95W\xdb\tN\x81I\xfc\x80\xcb\xcfg\x8bD\xf6$e\xb0/ګ\x1aJ7\xfe\x10\rT\\2[w\x7fr\x8c\x93\xbb\xe4U\xda\x17\xb0\xd1$\r\xe8\xe7\xc7\xf6\x9d\x1cY\x951\xb6\xef\xe4T\xad\xad\xe5Z\xe5y.\r\xf6-\xde\t\x00O\xa3\x9a\xa8\x1cޤ\xe2\x86ߢ.\xfb0܇ܠ\x02λ\xddZ\x85M\x7f\xf1\x02\x00\x00`\x97h\xf6+\xac<!7\xbdZ.?\xa1\xba\xb9B\xc5\xeb\x9f$h\x00v\xb1\xed\xf7\xba\xb8:\xbeA\xcaN-\xf6\x00\x00\x00\xe0\x82pi&\xc5R\xba\xfeK\xa5\x1b\xff#q\x15\xb0,\x0e\xff\x9d\xa8\xb3\xbf\xb8x\x01\x00\x00\xb0Cĩf+\xa5z/\x9b\x11[\x00\x97\xd9\xec\xee~t\xe7\x86\xca\x06g\xe5ڃ\xf2q\xbc8\x02`\xe9t\xc9\xea\x13\xb5ZU\x1d3\xf5\xfd\x86]\xe4R\xeboRu\xddgh\U0003c7e2\xde\x05\x00,\xbd\xf1\xe1\x17\xc5\xec\xdca\xf5\u008b\xd46O*+\xa6\x8b#\x00v\x9c\xba\xa7\xe9\xeaH嬕ϣ\xda\xdc+ۼNa\xe5\xa8\xfc+\x99i\x13\x97\xc6\xf4\x9eO\x8e\xc5\xf4ϔ\xb5\xf6\xa2^\xb5\xb8ɫ\xc9g\xca\xf9\n\x02x&\x83J\xb3qP\x9e\x95\xca\xf2\xfd\xaauRY\xb3.\x9fʍ\xe9Dǯ\xfaL]{\xeb\xafQ\x88|\x04\f+\x85\xa7\xf7\xc8\xe7FM\xd2\xec\x1b\x00\x00\x00\xbb\x8c\x9bu\x039\xc6\xf4\xc34\xde^\x03ص\xea#\xaf\x8f~|Тߓ\x8b=\x00\x00\x00\xb8`\xd2@\xb9\xb1\x90\xaa\xa3\x8b\x1d\x00v\xba\xd9\xc3\x7f+\x9e\xd9\xfc\x83\xc5+\x00\x00\x80\x9d\xa3M\x93\x1f\x9d\xbd^\xed\x83/aF\x0e\xe0r\vNε]\xfe\xebbr<\x00\xcb*\xe6v!\xa7\xbc\x8ct17\xf6\xba\xdf\xf5\x1a\x0eň$v\x00\xc0\xae\x10N~g\xd4f\xa5\xb2ͤ\xfc\x84TZ}\a`\xe7\xb2\x16h\x96&b\xef\x06\\\xb2\xa5M\xfdx[\xdby\xb0;\f\\l\xedc\xdf\x11\x9b\xf6\x88\xba\xdb\x1d\xe9kh\xf1Q\xb4\xef\xa0[\xf4)\a\x80\xa7\x13GCe\xd6\xdcl\xeb\xa1bsR\xc5l(\u05f6\n\xf5\rҁ\x82$\xf6\x8f\x12\x89\xec\xf8\x10\xf1\xc4?\x8c\xb3ɯ\xdb\xc6\xdab\x0f\x00\x00\xc0.\xe2Gra\xdd\"\x89\xf4\x906%\xb2/\xf6\x03\xd8u\xc2\xe3\xdf\x12ۍ\xdb\xd5\xefnv\xa7\xe13\x01\x00\x00pAu\x1d`\xbd\x9aɃ\xf3\xd7\x00v\xb6{^o1\xd2\x7f\xb7\xf8\xe8\xd8b\a\x00\x00\xc0\xceQ\x85\x03\xca\xfd#\xcaN\xde'\x1d!\x99\x1d\xb8\x9cb\x1b\xe5\xb3yb\x81K\x83\xd8\x01X^a\xde!\xc2\xfb \xefj\xbb\xc0\xd3l\x83)\x91}\xdc\xed\a\x00`\xd9M\xb7~R\xe5d\xc3ڭ\xfb\xac\xc2\xdbT\x9bM\x16G\x00\xecH\xd6\x16\xcdӏ\x98ک\x85şig+\xdf{I\xda\x00.\xae\a\xbf2֛\xbf#5G\xd3\x18~\xf3D\xf6.\xa5=*\v\xdc\xff\x00\xf0̜\xb6\x95W7\xc9\xe5\xe7\xe4R\xf2I\xac\x14\xa7C\x8d\xd7\xcf(^\xfd\x8d\x8b\xb3𑐶\x83\x0f\x11F\xffAE\x8a\xe1\n\x12=\x00\x00\xc0.\x94Ur\xedj7\x82^\xba\xff\x10\n\x06\xef\x01v\xabj\xfcf\xf5\xb6-\xf0-\xecGƵ\x0e\x00\x00p\xc1\xa5\x8e\xb0\xd9D\xb3\xe9)m?\xfcU<\xd9\x05v\xb0x\xe7UQ\x9b\x7f\xa9a]\xe8`\xd6\xf5\xca\x00\x00\x00\xd8Q\x8a \xe5\xfdy;\xa59u\x9f\xc2\xe3_L\x8c\x01\\&\xbe\xcd\xe4\xb3v>\x1b{˥\b,\xb7\\1M,\xe8\x83bl\xec\x9a\xee\xdb\v\xa7&\xe7\xde\x00\x00`\xf9\xb5'?/f\x9b画m)\x96\x16LگyV,\x80\x9dʚ\xa1.\xf8\xf9\x8c\xec1\x97K\x97\xac\xb5U\xd5\x7f\xe5\xfc8p1\x9d\xfb}e\xe3{\x95\xdb\xf7.\x8d\xd9ߪP\x9b\x06\xf1K7@\xb2\xc59\x00\xf0tR\x06\xb6\xdbR\x96&\x8d.\xad\xdej\x87jW\x9e\x94?\xf0IrW\xfcKn\xb2|\x94Hd\xc7\ah\x0e\x7fM\xd4\xe9I\xd7\x16Tf\xd1\x1c\x00\x00\xc0n\x93B\x85t\x03,\x06\x05\x97)\xeb?o\xbe\x1f\xc0\xae2:\xf2\xbfE\xb7\xb9%\x97\xe2\x9a\xf6\x80\xedIA\x0e\x00\x00\x00.\xa8\xd4)(\x1f\xcbY\x93\xcb\xcf\xfe`\xb1\x13\xc0\x8er\xf4\x9bb\xbc\xab\x88\xed\xe8I\xbbf\xd7mq\xd2&=1\x00\x00\xc0Γ\xb7\x9b\xd6V\xb9J\x1a\x16R\x95+\x9c\xfc\xe5\xc5\x11\x00\x97R\xfb\xf0\x97ǔT\xa04ss\xeaZ\x98\x92\v\x00,\xad\xe0\xc2b\x92\xc1tM\x9bv>\xe0\x7f\xccn\x98\xbf\x06\x00`\x89M\xcf\xfd\xb1zuimV[\xc2L1\f\xe4Z\x8b)\x01\xecX)ܜw\xe3s\xd6V-\xe4\xady\x1a2\xfb\xf1\xbc\x1f#\xf8\xc4E5\xb9\xfbʨ\xea\x84\n\x8b\x89z!\x97\x8f\x03\xfb\xf2\xf5\xbaG\xa7\xd1\xe2\xa6\xc5\x17\x13\x00\x9e^\xdc'\x95g\xe6eG\xb3\"\r7U\x1c\xfad\r\xaf\xfb\x05\xea\xafg\x81Dv|\x80f\xebw\xac\x86N\x1d\x98\n\x05F$\x03\x00\x00\xbb\x95\x9b\xc9;\xa7\xe8\xbcz\x8c\xe4\b\xecNg\xfe\xa7\xf2v&\xe5\x16\xdf4}\xa9\x9e.\x0e\x00\x00\x00\xe0\x82\x89\x99\x825\xb7\n\x8b\xad\xca\xfa\xb1\xc5N\x00;E\xf5\xe0w\xc4\xea\xd8\xcf\xcam7ʋ\xf4`\xb5\xec\xae[5\xb6\x06\x00\x00\xd8i\xdaܖSj\xc3\xf5\xdd\xcc\xec\xf9\x96\xb5Y\xdes\x03SA\x03\x97XݼKY(ԍZ\x17R\xb6+}1\x81\xa5\xe6k\xbb\x96W\x14B\x90\x97յn\xaa裊\xe2U\x8b\x13\x00\x00XN\x93\x87>3\xeatfu[em\xd6ծ\x8esޫ\xefV\x16g\x00؉B\x9aq\xd3~\xb5\x8a\xb6\x94r\xd1\xcbY3\x15\xb8\x986\xefxi\xf4\x93S\x16\x1fY\xbd\xe1\x87\xf6\xbd[\x97k\av\xc4Y\xdd\xd1(fQ\x91/\"\x80\x0f'\xd5_\x8d\xb59\xb3\x93\x9a\xc6R\x93\xf5\x9b\xa5\x9b\xff\x94\x1b\xa7\xcf\x12\x89\xecx\x9fp\xf8\xafĬ:\xad\xac\x9fF\xdd\x1ct\xcf\"\x00\x00\x00v\x9d\xd4\xf1\u008f\xd4\xcd\x1e`Kq\xe3\xbf'\x88\x00v\x9b\a>3\x0e\xc7'\x95\xe5\xe9\xe6\xf7\xaa\x9a\xfc\xa4\xed$\xc0\x01\x00\x00\xb8\x18B\x90\x8a\xbcV>\xf1\x8a\xf7\x7f\x1e\x8d.`\xa7\xb8\xfb\vb\xd8\xfci\x85\xb6\xb6p\xe8\x1aɍl}\xca\"\xa3\x9e\xb4\x9a-N\x02\x00\x00\xd8A|6\x9f\x01)\x9c\xb4@cMZ\x99\xa9ٚ\xa9=\xfc2\xe2\f\xe0\x12j\x9a\xfb\xe5U(\xc6`ף\x05\xfd\x8e\xee\x85\xc0Rs3\xab\\ה\xe64\xca\xfc\xd0\xea\xdb-\xb9B\xea\xdd\xf2f\xfaI\x00\x00\x96Z>~\xa7\xfa\xa1\xb2\xbaΪ\xba\xacVU\xa6ʮ\x95Ku\x1f\x80\x1d\xabM\xad\xd06Z\xcc\x19\x15|J\x1c\xf6r<\xb6\xc2E\x14\xee\xfb\xb4\x98\x8f\xefQ\xaf^\xb1\xca\xc3v\xa4\xbe\xe3\xd1ɵ\x8d2\xcd\xe4}\xa5\xe8\xd3ג\x81\xc0\x01<\xb3\xca[ݕ\ue576\xfb\x94\x1fگ\xc1-\x0fs_\xe59\xe0N3\xe6N~w\xac\xceާ\"\x9fH\xfd4\x8bNm\r\xc3bq\x10\x00\x00`\x17\x89=k\x05[\x9b\xc7\x04B\b`w\xda8&\x97\xa5$\x8d\\\xb3l\xaaX\xa4\aWaq\x10\x00\x00\x00\x17\x8cK\x0fj\n\xa9\x18K\xd5\x01\x8d\xa6\x7f\xba8\x00\xe0r:v\xc77GM~\xa5\xeb\xc4燍\xea\xfe\x13\xd2Į\xd5\xe9\x15\n\xc5H[\xe5\xd6\xe2L\x00\x00\x80\x9d\xa3\xc9k\xb9|\xa8\xbc\xb5\xf8\"\x9c\xb18\xa3\xaf\xb0rJӓ#鮿F2;p\x89\xc4\xf48%\x8d*\x91\xfau\xa7+/u\xf0\x06\xb0\xb4\x82K\x03\xdcY\x9dj\u05f6w\xa5\x945\xb6,\x0e\x02\x00\xb0\xac\xee\xb8%\x16\xa3\x81\xb2\x81ŋ:\xa8\xba\xb7\xa1\xf6\xfcD\xbaq\xde/\x10\xc0\xce\xf4\xd4>\xbb1e\x15\xa7\x983#\xee\xc4\xc51>\xfcű\xd9\xf8C\r]߾p\xa5b^\xd9w\xb0R\f\xb6(\xf5)}\x7f\xbf\xd2&\x9e\xafH\x00\xe0C\xb5V\x8e\x84l,\xe5\x8d\xf2\x17<D\xc5\xf5\x1c\x91ȎNs\xfc\xd7\xd5\vO\xda\x05\xb5O\xd3\xd9\x15\n\xb1V\xb9H\xf0\x02\x00\x00\xd8M\x1aoA\xc4\xf6Ak\to\xea\\~\xfdb/\x80\xddbz\xef\x17Ƴ\xbaK!\x1fJ\xd5\x15\x1aL\xbc\x8a\x98\xab\x89+\x8b3\x00\x00\x00p\xa1\x04\xe7\x94g\xb5\xb5\xbb\xf6K\xfdJ\x83pNz\xec\xfbH0\x01.\xa7{_\x19\xaf\x9e\xfd\x98]\xa0}[\x82\xca*WQ\xdbva\x97f\xb9\xad\xacʹ6\xb1\xd7\x00\x00\x00;L\x1e3\xa9I\x9dGS[Ŗ\x995_\x9a\xbeV\x8a\x13\xd2\xf8\xedң\x7f\x97X\x03\xb8\x04\xfaΫnS\xa7\xc2b>+\x9eߞ\x1f\x00\xb0\x94r\xfbo\xdb\x1d\x93\x8f\a\xa5\xea\xa4\xed\xf1:\x13\xf7\xcf\x0f\x02\x00\xb0\x8c\x1e\xff\xea\xa8\xe9q\xab\xd2NK\xadŎq\xac\xb2\xeeiP{\x8b)\xedx\xe4\xfe7\xb0\x93\rځ]\xba\x95\xf2X\xaa_=f\xd7\xf2\x01\x8d\u05fe|q\x14\xb8\x80\xee\xfb\xe288\xf7K*-&\x8a\xbeT=<+W\xf5,6\x92\\\x1aP3\xdd\xf3\b}\xb9\xba\xaf\xac\xf1\xea\xc7\xe9\xfc}\x00\xf6\xa6`\x85\x83\x95\x05ݳ\x89\"S\xedfVG\x05)\x1bv\xb3\xb0\x0f\xdaS\x9a\r\xf6kz\xe3?\x98\x9f\x8f\xe7\x84Dv\xa89\xf1\xfd16Oʥ\x11d\xda \xe7\xb7\xe5\xd2\xe8F-\xcf\x00\x01\x00\xc0\xee\xe3\xd2݇4\x82\x9e\x93\xf2\xe2\x8a\xc5^\x00\xbbESݣ\xd2\"]\x9f\xa5;\x8e\x8dm\xccg\x15\xf0Y\xb18\x03\x00\x00\x00\x17\x8a\xf3\x95b\xb3j\x1bS)x\x85\xd4A\xa8=<?\b\xe0\x92\x1b\xdd\xf9\xf2Xmޭ,^\xb9\xd8\x03\x00\x00\xb0{L\xcf\xfd\xd9b\v\xc0\xc5\xd2<\xf0\x8f\xa3k\xf3y\xbc\xaf6=N5t/\x04\x96]\xe13y\xbf\xb8\x96]\xae\xbc<4\xdf\x06\x00`\t\xb5\xdb<\x87\x02\x96\xdbl\xbe\x8aEק/d\x8d\x867\xfd;f\xb6\xc5\x05W\x8d\xee\xb3J\xa3\x94\x05C\x16\a\xb5\x8a\xed\xea\xe2\b\x00<\ro\xf5S\x96:\x9b{\xc5\xd4\xf9)غ\xe9[9b\xfb\xf3\x13\x1a\xfbU\rW\xbeV\xfd+\xff\x7f\xd4Y\x1f\x03\xee4C\x933\xbf!ߞ\xb0\xca\xf9\n\xa9i岱5\f\xe7I\xed\x00\x00\x00\xbb\x8d\xef\x9a\xc0\x95-\x85\x8a\xde\v\xba}\x00v\x87掗GWݧ~w\x9b ]\xeb\xe9\xc6wmK\x8am\b\x7f\x01\x00\x00.8?\x91\xdau)6\xb6\xa4\x9e\x06\xd6\x02\xab\u07fb8\b\xe0\x92y\xe2{\xe2\xe4\x9eC1\x1bߩ\xb2\x9bu&\xc5A\x00\x00\x00\xbbK\xbbu\xbff\x0f\xbe\x96\x19\x19\x80\x8b\xa8n\xef\xb2\x10\xbfP^\x8c\xedUP\x88\xa5\xady\xbe\x02,\xbb,sr\xe9R\xeejQ\xaf\xa2\xff\xa2\xb4\x01\x00\xc0\xf29\xfa\xa6\xd8lݹx\x01`9M\xe5d\xb1f\xecw\xe1f\x93\xf1L\v\x17\xde\xc6]7Ħ9,\x17z\xf6]\x8b\x16\nU\x8a\xad}\xe7\x00\xe0\x99dii\x95f\xf0pm\xec\x06\x05\x8c\xb1U\x1b\xa6\x8aV_ŵ\xaf\x92\x9e\xff\xafHb\xff\x18q\xa7y\xaf;\xf2mQۇ\x95\xa5K\xc9M\xec\xcaJs\xb1\x97VQ[\x83\xd0s}\x01\x00\x80\xddǥH#\x9b\xa9\xceV\xb4z\xf3\x9bi\xf0\x00\xbbD{\xfaM\x16\xc6ܩt\xbb1M\xc6ލ\xa6\x19B\xd7!#ںi\xd2\x00\x16\x00\x00\x00\xb8\xd0\xd2C_\x85\x81m5\xddLmMu\xb4\xdb\x0f\xe0ҙ\x9e\xfc)\x95\xe33\xea\xfbL*V$wnq\x04\x00\x00`\xf7Xɥ\xea\xec\xbb4=\xfa\xf9$\xb3\x03\x17I\x13\xee\x93k,\xbaϪ\xd4\xcf{\x1e\xef\xf34\x15Xn\xc1.c\xdf]\xd0ݯ\xa8R\xe5\xe0\xb6\xf91\x00\x00\x96L\xbd\xf5\v\xca+\xee\x7f\x03K\xcd\xcd\xe4B!\xb5yׯ\xaf\xce\xd2\x00j\xc0\x853\xbd\xf7\x13\xa2o\x1fנ\x98\xda\xf7;gM\xe8\xf2\xe3|7\x01\x1a\x00<\xbd&\xdd:\x89\xb5B3\x93\x82\xd5MVU\x85r\xa4*\xebK\xe5+\xb4\xf2\xa2\x7f\xcb]\xd2\v\x80D\xf6=\xae\xde\xf8U\xf5S#\xd0~)۰\x1fN>\xaeXEm\x17^\x9e\x86\x93\x00\x00\x00\xd8eҰX\xbeV\x9b\xad-v\x00\xd8\rƧ\x7fGy\xb4\xb0\xa6\x1b\xaau\x9f\x05;\x85]\xef\x16\xd3x'g\u05fd\x8b܈\x04\x00\xfc\xbf\xec\xfd\t\xc0m\xd9]\xd7y\xff\xd6\xda\xc3\x19\x9e\xe1N5W\xa5Rc\xaa\x92J*s\b\xa2H\x83\"؎(t\vb+\r\xd8j\xbf\xadv+8\xa0b\xbf6N\xdd\xceh+\xed+\xda\r\xdaN\xf0v\v\x02*4\b\b$$$\xa9TU*5\xcf\xe3\x1d\x9e\xe1\f{X\xab\xffk\x9f]\x95\n\xa9[\xd4p\xef}\xce\xf0\xfd\xd4\xdd\xe7\xec\xe9VRϳ\u05f8\xd7\x7f-\x00\x17\\,\x15\xb23\na\xd7*b3\xe5\xe9\x8dC5\xd7\xec\x89oI\xafx\x00\\d\xf3G\xfe\xebx\xf6c[q8=\xa7,\xeeH\xaeU\xe3O\xab.\xaf\xe9\xef\x00\x00\x00X#e\xae\xb2\x8d\xaa\xcf\xfd\x9b\xfe\x04\x80\v-\xb6OY\xdb\u009a\xf4\xf6ǹ\xf4U*\xba\xd0_\x05\xb0\x92R {L\x11\xec\xad\x1d8\xcd\xfdP\xd9U\xac \x06\x00XM\xed\xec^eD\xbf\x00+-\xa4\xb6f\xb0\x84\x9cꧩ\x9a\x9a]\xdb_\x01.\x80O\xbf7\xea\xf0n\x8d\xed\xf1r\xbe\x94|\xa3\xe8\ne\xb1\x90\xcf\xf6\xfb\x9b\x00\xe0\x15\xc4cj]-\xef,\x03\xb1<#\x86J\xd3\xdc\xf2\x92\xed;\xe4\xde\xf9K\xf4\xa3\\ T\xe57Xx\xf0[b\x9c=\xa4§(\xf6\"\xf5SZE\xd0\x1e\x89\x10\xd3:\xa5\x86t\x06\x00\x00\xd6Pt\x8ayTSl\xf7'\x00\xac\x83\xecܧ,}\x8f\xad=ci;X\x1b'\xb6\x8a\xdd\xea\x02[\xb6\rT\xb8\xa6\xbb\x0f\x00\x00\x00\x17N\x1ad\xe0|\x1a\x04\x9b\xea]\x8b\xfa\x96o\x1a\xb5\xf5\xcfv\xfb\x00.\xa2\xcf|m\x8cg~X\xe3jb\a\xfd{\x1eK\x8ai=\x81:E\x9c\x00\x00\x00\xac\x9b0\xd2 \x93\x06玩\xfe\xcc\r\xa9\x11\x02\xe0\x02\xf3\xa1\xb1v\xfeܚ\xf8\xa5\x9c+\x15cT\xf4u\x7f\x15\xc0*\xea\xd2q\xb7\x1a{\x8a\xe4\xc8\xd5\xe6\xa3\xfe\n\x00\x00+\xe6\xb3\x1f\x8a>ͱ\x94\x0f\x17\xc7\x00VR\x9a;\xad\x1b\xd2\xe7\xac\xed\x19\x9d\xf2\xe2\u074b\v\xc0\x9b4\x7f\xe0\xf7FU\xbf\xa4\xb2\x9d)\xabw\xa4\xc6\xd9\xc3VY3h`ߥ2Gw\"\x80\xf3\v*\xe5]iu\xcd\x14j\xddj\u058c\x14\xf3\xeb4\xbc\xfd\xe7\x19|q\x01\x11Ⱦ\xc9\xf6~\xd8\n\xe9\xc2\x12\xd9\x195ipa\x1c\xcaeΒۙŃ\xd1\xf2x\x00\x00\x805\xd4F\x85\xcc\x1a\x1cٸ?\x01`\xd5Uw}E\x1c\xb7\x13K\xd8\x03E\xef\x15\xf2C\xb5\xe59)\xab\xec\\n\xe9>M\xdcEG$\x00\x00\xc0\x85\x16\x95\x82\xd8S\x95\xeb\x85\xf4V\xc7N\\\xa9,\xd6j\xe7\x8fv\xe7\x01\\\x1c\xd5#\x7f(\xce\xcf\xfeK\r\xeb\x99\xca옵w\xa6\xd6\xee9mWNi\xe0v\x95\x87'\x167\x02\x00\x00\xac\x91y\x9aD\xab\xbdF\xa5;\xa7l\xff\x11\xb5\x0f~\x98N_\xe0\x02\x9a?\xf2\xed1\vC)o\xa4ھc\nd\xaf\xad\xed_\xf5w\x00XI\xde)-l\xd4\xcdC\x99\x17\x8a\x04\xff\x01\x00VT8\xfc\x94\xb5\a\xbd\x1aW\xf4g\x00\xac\xb4\xdcښ\xd9@÷\xfd\x13\x02\x04\xf1\xa6Ň\xbe9\xb6\a\xffTi\xf8\x82\xf7C\xc5\xe8T\xb7\x8db\n\x89s\x99\xd4\xd8\xcebh\x03\x00\xbc2?\xb5\xccdQόn\xa6,\xbbY\xbb\xefx\x8c2\xea\x02#RyS=\xf8'\xa2\xa6OZ\xe5\xef*\xb5~\xaeI\x9bf\u07bcΞ\x88\x98b\xbb\x8c=\x1aL\xa8\v\x00\x00\xd6P\fi\x96=\xfb\xf6e\x7f\x06\xc0\xaa\x9b\xcf>\xbah\xc2Ġ\x90V\x06)gr\x83F1ux\a\xbb\x90\x16\a\x8d\xf4D\x02\x00\x00\\xVǊc)\x9b\xf6\xf5\xae\xe3\xf2\xe9k~\xd8_\ap\xa1\xcd\x1f\xfd\xa6x\xe6\xd9\xefנL/Q\xf7\x15㞥\xc143\xf81K\x87A>\x1c\xaaL\v\xb4\x03\x00\x00\xac\x99F#\xc5vnu\x9f\xd8\xc5\xe4M\xf7?\xa6\xea\x99?C0;p\x81\xd4\xcd㖼\x86\x8bф\xf5@r\x85\xa2\vi\x81<\x00+̹\x94\xa8\x83\xed\xd8\xe6s\xb9\xcc\xd27\x00\x00+\xa6\xfd\xecW\xc60\x9dYyv\xa5&\r\xe3\x7f\x80U\x16bfi9Jy\xbdx\xbf\x05\xbcI\x93\xa7\xff\xbb\xb8\xbf\xffO5N\x13\xf1\xd5Wt\xfd\x19.\x8d_\xf0\xa9\x1dd\xcf[k\xe5F\xd3\xd8\xc6\xf3\x06\xe0U\xf8\x03\xc54\xee\xc9\xf2\x8c\xe8+\x95\xe3\xb7\xf5\x17p!\xb9\x18y\xa7\xb3\x89\xe6?\xef\xe2 /\xac&h\x053\x00\x00\xc0&\t3M\xf3+4z\xff3\f\xbb\x00\xd6@u\u05f5Q\x87O\xaa\x1c[\xdbfƬ\xcb\x00\x00\x00\x97R\xf4V\xffr\x95\\7\xb0=\xa8m\xbcb\xdcR\x91\x9f\x92F\xb6\xdd\xfe\U000f4ec0\v\xe8\xf0\xae/\x8e\xf9\xf4\xe74hG]\x00\x17\x00\x00\xc0Fi\a\xaa\x8a=\xb5~d\xf5\xa1m\xf9\xea\x8c\xe2\xe8\x84\xdc{y\xdf\x03\\\b{w\x1d\x8f\xbbg+i0\xd24;\xadA8)\xaf\xd3Rر\xb6?\xab\xa1\x00\xab\xaaq#\xe5\xeeL\xb7\xa8Q]\\\xafx\xfd\x7f\xae\xf2\xf2\xbfK\xd9\t\x00X)\xf1S>Nf\x97\xabȞUY\xa5\t\x96C\x7f\x05\xc0\xaa9\x8c\x99\xb6bn\xe9\xf8\x9c\x9e\x1b\xe5\xba\xfc\x8e\x9a\xba)\u07b8G\xff\\Գ\x7f\xd9\n\x8a\xb9bv\x95\\{\xb6\xbf\x00\x00\x9f\xefP[\xdar\xfb\x96_T\xddq\xad\\\x95\x1f\xd8g\xa3\x81\x9bKn[j\n\xbb\uf332\xcb~\x9b\x867\xfe\x00\xe5\xd3E\x90\xa6[Ħy\xec[\xe2 \x1e\xb7\x9d\xb4,!\x00\x00\xc0\xe6\x89T\x83\x81\xb5\xe1C&\x9f\xe27\xea\xe1\xe2\x04\x00\x00\x00.\x994Qn\f\xae\xfbN\xd2\x02OQ\xb5B\x98J\x15/\x89\x81\vi\xfa\xe8\x97D\xdf>\xa0\"\\#\xe5\x96\xc6\x00\x00\x006N!眔5\xb6\xa5\x95\xd9kk\x8f\x9cQx蛘\xe1\a\xb8\x00\\상Ҫ\xcd\x00ֆ\xf3\xad\x14\xd3\xf8\b\xaf\xd6\xd5\x04\xb1\x03\x00V\xcf\x03\xbf%6\aǔ\xe5\xcf+\xf7C)\xa7\xbe\n\xac\xb2,\xbdP\x96\xd5Q\xadVZ\xe4W,N\x02oP=\xf9>{\x96\xe6R8\xa6&\xbeП\x05\x80/\x94\xbf8Qg\xb4\x02(\x16\xf2i\xe2?\x95*lS\x1cHM\xa3\xc3xF\xc3\xdd/'\x88\xfd\"\"\x82g\x03\xb5笰\xd6XmJ|\x00\x00\x00\x9bƕj\xbd5:\x00\xac\xbc\x83\xcf\xfeƘ\xb7\x8d\xf2tP\x0f\xbas\x00\x00\x00\xb8t|H}̋\xd7\fiϹ־\xe7j\xc29\xb5\xf5\xf3\xddy\x00o\xde\xec\xfe\xf7\xc5\xf6\xccϪ\xa8\x9f\x93\xcf\x0e\xbb\xbe\r\x00\x00\x80\x8d\x13\xb2E\xa0\xad\xaf\x14\xb3j\xd1\x14\t\xb5\xc2\xe1O\xa9}\xe4[\tf\a\xde$\x1f,\x19\xa5\x99\x83\xad\x81ߍ(\x8b\x8b\xc1\x9d]\xd3\x1f\xc0\xeaJ\x03\xb5\x9b\xc2v\xc6jҲ\xec\x00\x00\xac\x98j\xefS\xf2m\xae\xc2\a\xf9\xaeo\x9c\x85\xfc\x80U\x96\xa7@\xf6\xb0H\xc7e\xf9\xf6\xee\x1bx#\x0e\xee~[\xac\xf7\x1f\xb46\xcfP*\xceY\xf1@x$\x80\xf3+|\xddO\xf47\xb2|c$\xe7K\xe5ii\xc4\x10\xa46j\x1efj\xc7W+\xbb\xed?\xd0\x1bz\x11\x91So\x9a\xc7\xfep\xd4٩\xd5\x00\xcfZrK\x1d\x94\x00\x00\x00\x1b\xc6\x15\xf6\xe7\xb2\xfe\x00\xc0*\xabg\xffQjO/Z\xb6-\xcd[\x00\x00\x80\xa3Ѝq\x8f\xbe[\x95\xdd)\xc8\xf9F\xce\xcd\x14\xc2^\x7f\a\x807cv\xf7\x87b\xb1\xffqmO\xa4<\x8d7w\xe7\x14\x99\xa0\x0f\x00\x00l\xa8Ep\xad\xac\xe5\x91V\xe0+\xe5\xed\xcb\xcd\x1fP8\xf7\x7f\xa5+\x00ބ\xacM+\xe2ٖ\x06p\xa6\x98\xf6\x94\xd8\xec\x8f<c7\x81\x95\x96\x02\xd9cnߥB>\xeeO\x02\x00\xb0\x1a\xc2c_\x175\xdbWV\x1e*\vCk\t\x1e\xaa\t\x8c\x0f\x02V\x99w\xa9\xa1Y+\xb8\x91\x06\xc3\x0f-N\x02\xaf\xd7}\xbf&nO>\xabq8i\a\xdb\xd6֑\x8a,=[\x00\xf0ʼ\xab\xfa\xbdB1\xcb\xe4\xdc\\\x8a\aV\xe1<\xb4\xadR[\x9e\xd0\xee;\x9f\xa4#\xf4\"\xa3&\xbfa\xe2\xe4\xfb\xac!\xb7e\xbf\xf9I7\xa8\x10\x00\x00`Ӵ\xd6\xf8\xc8\a\xd7\xf7G\x00V\xd5\xf4\x91?\x18\x87]'\xc2\\ʎ\xd9\x19\xfb\x06\x00\x00\xc0\xa5\x95^\xe18\xfb\x88\xf6կΞgNY\x16\xe5\xb3Vz\xea;y[\f\xbc\t\x87\xf7\xdf\x1e\x9bÏ(\x9b\xa4\xf7:\xbb\xb6\x95\x8a\xf5P\xc1M\xfa;\x00\x00\x006\x88o壗o\xac\t\xd2d\xf6a\xf5#m+5=\x8a\xea\x19\x1d>\xf2\xe5\xb4?\x807\xa8\xbe\xf7\xebb\x9eV\x1fJ#\t}\xb0\xe6~n;i\x85<o\x9f\x8c\xdf\x04V[P\x88Vn\xfaLy\xf9\x96\xfe\x1c\x00\x00+\xe2\xe0\x17\x94\xc5}\xa9\xb4:i\xdb*\xd6Q.#\xfc\x05Xe>Zz\xf6\xadj?Tv\xf5w\xd1\xe0\xc4\xeb6\x7f\xf8\xd7G\x9d\xfdi\xdbّ\xf2}k\xef\xcc\x15\xab+\xa4\xec\xc5 U\x00x\x05!M\xe0\xe9\xd5:\xabK\xbaZA\a\xb65\xaa3\xa7X\x9c\xd2\xf8\xd47/\xee\xc3EEM~\x93<\xf5m\xb1\x9d\xbc \r\x87vPX\xc3.-\xdd\x01\x00\x00\xb0Y\xe6.j0\xbc\xb5?\x02\xb0\xaa\xaas?\xa2QHm\x1b\xe32Ŝ\x8eH\x00\x00\x80K/-\x7f\x98bE\xac>\x16\xbc\\\x9b\xd9~!\xefre\xb6\xb5\x87\x9f\xe8\xee\x02\xf0\xfaM\xef9\x1e\xfd\xe1\xfd\x1a\xc6R\xca\a\x92/,\xa9\r\xac\xf9\x93Km\xdf\x16\x02\x00\x00\xd8$n\xd6\x05\xd7f!\x97o\x9d\x94\x06>\xbb4\xe1\xcfv\xb7\xef\xce\xfe\x84\xf48\x93i\x01o\x84\xab?\xad<\xa6Y\xea\x1a\x85\xd8\xc8Y\x9b\xde\x1a\x1e\xb6Y{\x9f\xb0\x02`\xa5EK\xdb!\xcdB\x99\xb7\x1a\x95w\xf6g\x01\x00X\x01O\xff\x89\xa8\xfd=\xb9\xa2R\f\x03\x05W+k\xb6\x95\xa5>r\x00\xab+\xf5\xdcx)d\xa3\xc51\xf0:\xcc\x1f\xf9\xc6؞\xfd%\xa9N\xefNӃT\xa9\rS\xf9\xf4>\xb5\x1b\xab\x00\x00\xe7a\xe5O\x9b&\xec\xf4\xa9\x0f\xb4\xee\x8eS\xbfg\x1c\xde&\x1d\xffϤ\xeb\xff\n\xbd\xa0\x97\x00\x81\xec\x9bd\xff'\xe4\xe7^qp\xa8ƍ\xbb1\x86\x00\x00\x00\x9b\xa6\xb5\x06\x88{\v\x8d\r`\xa5=\xf9gb\xde<*U\x85\x14Ǌ:T]\x12\xc8\x0e\x00\x00p\xc9\xc5T\aK\xc1\xec\xe9\xedN\xae\x18r;,m\xbfP\x8c^\xf5\xf4\xfe\xc5}\x00^\xbb\xa7\xfel<\xb8\xfbC\xb1\x98\x9cS9;\xa1\xcc[\x9bgxF\xc1\xb7\n\x96\xd6b>Q\xd6\x0e\xfa\x9b\x01\x00\x006G,jk{\x94ʭ\xbd\xe1\xd3JK\xee@\xf2\xd6&\xc92\xab+\xe5\x1a\xcf\xd2\x04\xa8\xdf\xd7\xdf\r\xe0\xf5\x88\xcdC\x96\xbe\xd20\xc2h\xed\xf94\xa43\xb3\xe3\x14\xc8\xeeԦ\x00w\x00+\xcc˥\xf4\xec\x1b\xb9\x9b\xfe.\xe3$\x00\x00+\xa3\xd9\xffak\xf2\xcd\xe4}\xa9\xca\x1dv\xaf\xa2\xbaЗ\xa6\xbb\f`U\xc5\xd0%\xe5\x901i3^?\x7f\xf6\xff\xd0pf\xcfNn[qN!f]_\xa1s\xcfZ\xf9p\xb2\xbf\v\x00^A\x1a֔\xbebZ\xa4C\xcaRW\x89{\xab\x8a\xad_/w\U000ff83f\xe4\x12!\x90}\x93LN\xcbǑf\xf9\\M\x9a}\xa6eF2\x00\x00\xb0y\x9an\xb5@\x00\xabl\x7f\xff?h\x9cz\x11\xaaF\x8aW\xa8j\xe7\n\x19i\x1b\x00\x00\xe0\x92K\x03\r:\xd9b\x90{\xb4\xadq\n\xadS\x9b\xbe\xab\xe7\xfb\xeb\x00^\xab\x833?\xa2\xe9\xfeG\x94\xd7\xd7+\xf3\xe7T\xbbs\x9a\xbbL\xf3\xc1YU\xaeVS\x1dK\xa34\xfa\xbb\x01\x00\x006GH\xefw\xba\x95\xf7J\xf9\xd8(\xfa\xa9Bvح\xcaW\xa7kѫ=\xb8_g\x1f\xff\x1a:\x8b\x81\xd7\xc9\xc7\xe9bǥ\xe9ꢜ\xb5A\xbaH\xa14\x99\x16\xc38\x81\x95\x16\x83S\xf0\xd16\xa2\xfe\x00\x00\xabe\xb6\xffYk\x03\xa6z\xea\x8dj\x06\x95UU\xb7\xad~z \xcd\x17\xd7\x01\xac\xa8\x10\x17mOO,\x13^\x9f\xa7?\xf9\xeeXL3\xf9\xf2\xac\x9a✦^\xf2\x83\x81\\^*6v\x90\xb3\x10\x12\x80\xf3\xfb\xbc\xb9:c\x9aQe$ﯓ\xbb\xf1o\xd1\xfby\t\x11Ⱦ!\xda{\x7fm\f\xf5\x83\xd2ȩ8\x88\xca\xeaZ\x95\xed\x03\x00\x00\xac\x9b\xe8fR\x11l[\f\xae誼\xfd`\x8b\xa8V\x99\xbffq#\x80\x955\f\x1f\x97&\xb6\x93\xbfM\x93\xf2a\xa5~\xed\xe1\xfe\x15\x8b\x8b\x00\x00\x00\xb8t|\x91F\xb8ۖVFl\xe4ҀXWwA%\xb95Ǌ\x9c`[\xe0\xf58\xfc\xe4\x15qp\xf8\x11]^\xeeHٳv&S\x19\x06\x1a\xces\x8d\xa6C\rۨ\xc2O\xa5Ȋ\xec\x00\x00`\xf3di@j=\xb3\xba\xd0\xdc\xda\"Ckw\xd8f\xe7|\x1d4hs\xcd\n\xa7Qy\xa5Ə\xffP\xff7\x00\xbc\x16\xf1\xf1\xff!f\xed\xd0v\xd2\xd1PE\xc8,mU\xd6ַ6\x7ft\xdaj\x19\b\x0e\xac\xb2\xa2\x19uA\x80/\xe4[\xfd\x19\x00\x00V\xc0'\xae\x8e\xdbn\xd7\n\xb2\x13\x8a\xed\xe3\x1a\xed\x0f\xed\xdbڂ\x99\x93F\xd6.\x04\xb0\xba\x1ai\x12w\xb5s\xd5\xef\xe8O\x00\xbf\xb2\xfa\xd3\xd7ī\xa6\xf7Y9PHm\xa3\xbc\x1dj\x94\xfa2\xaa`\xc7\xf3n\x88x\xd7g\b`s\xb9\x81\xe5\x03^1X\xbe\x10\xdb\xfed\xae\xa0\x81B\xdc\xd2̲\x8f<\x97\xbc\xed\xa7!Ng\xb7\xae\x97\x7f\xcfO\x13X{\x89\x11Ⱦ!\x9a\xe6\xf4b\xf6\bK\x90\x99\x1b-b\xba\x00\x00\x00\xd6Q\xb4VF\xb0\xcaN\x88]c$\xa6\xc6HZ\x89\xc3G9\xef\x94\x17W\xf67\x02XE\xf3'\xbf3Ʃ\xb76Ma\xe9\xfa\xa9>yg\xf6ѯ\x16\x02\x00\x00\x80\xe5\xf1Ҋ\xed\x00~%\x93\xfb\ue32e\xddS\x16Ҡ\x8b\xfe$\x00\x00\x00^\xb3\xacH\xef\x87\xf6U\xea\x98\u0083w\xbc|}\x15\x00\xaf\xa2\xa9\x9e\xe9\xf7\x00\xac\xa54h\xd4\xe5ʋ\xcb\xfa\x13\x00\x00\xac\x80\x17\x03\x90\xfa $\x97\x06\a\xbd(\xad\xa0\t`u\xa5\x05\xa9\xbc\xa5\xed\xe3\xdfED\x13^\x9b\a?\x14۳'\xd4\f\x98\xc8\x04\xc0\xabH\xd1\xe9Y&W\x96Rn\x9b\x957\xc1\x05EU\n\x9aj\x14SlIPh\x1eS3\xbcR\xc7\xdfy/\xe5\xd0\x11\xa0&\xbf\x01\xeag\xfedl\xea\xa7ԥ\xb0\xb6\x95\xf3۶\xd5\xcaxm\a\x00\x00\u0590\x935>Bn\xf5\x9e\xf4N6\xbd\x94\r\xb6\xa5N\xedE\xe5\xa7\x18\xdf\xde}\x03XM\xf5ޏ\xcaϬu\xe3w\x14\xfd\xb3\x96\xe6s\xf9x\u070e\x99Q\x13\x00\x00`ٸ@'4\xf0Z\x9c\xbb\xef\xbaXO?\xa5\xa2m\xe4ub1H\x0f\x00\x00\x00\xaf\x8b\xcbJ\xb5\xedD*\xce)\x1eܭ\xf6\xf1o\xa1A\x02\xbc\x06\xd5\xfc\x81~\x0f\xc0Z\x8aA\xc1\x97*\x067\xf7'\x00\x00X\x01m-\x85\xc6\x1az\xf1\xa5 v\xf7\xe2*~\xdd\xca~\x00V\x96\xf7j\td\xc2k\xf5\xf0\xaf\x8ez\xf6\x13\xca\xf3\xbbU\xa5\x85\x8f\x00\xe0<b\xb4\xba\xa3\xb7\xf2\xc5\xe5v\xe0\x16+\xb3\xbbF>\xab\xe5\xf3\xa9ԜT\xdbNuXJ\xc5\xc9\xdf\xd2\xff-\\j\x04\xb2o\x80\xc9\xc1\xbf\x95\v/Ȼ\xac똌\xb2\x02\xdc\x12\xa8_\x84\xb6\x03\x00\x00\xac\x97hu\x9d\x90\xaa\xb9V\xdbI\xab\xb0\xa7*O\xea\xc0\xee\xb7\xe2-\xdfC%\bXanr\xb7\xf2\xb4\x02\xbb\xab\xbb\xe9)r\x8d\x14beI\xde\xce\x01\x00\x00`\xa9\xa4@\xf6\xfa\xc1oe$\x02\xf0*R\x10{6{B\xbb\xadT\x84\xb1%\x9c\x03۩\xfb\xab\x00\x00\x00x\xadb\xb3\xb3\x18\x05\x15*e\x87v|\xf8\xfd\x8b\v\x00^]\xf5p\xbf\x03`=E\x057\xd4`\xf4\x8e\xfe\x18\x00\x80\xe5\xf6\u0083_\x12\xa3\xb5\xebb\nd\x7fiE\xf6\x17\x87\xfb\xa5o\x86\xfe\x01+\xcd[*.w\xfb\x03\xe0\xfc\xe2\xe3\xbf/\xb6\xcf\xff\xbc\xedT\xdd\xe2\xca٢H\x00\x80W\xd4M~\x94bE\xdaFm]\xab\x89A\xde;\xb9\"\xca\xe7v\xbe\x8a\xaa2i|\xc5o\x97{\xebߤByD\bd\xdf\x00\xe1\xf0^\x95\xe97m\xa5w\x88\x99B߰s\x91_?\x00\x00XCi\xf2\x9e\xd4y\x9dfdͬ\xbe\x93b[\xada\x12\xdb4\xbbV\xbe\xb8\a\xc0j\xba\xfbk\xe3\xa0M\xd1\x1d)\x8d\xef\xa7d.\x1f\v\x05\xed[:g\xc6M\x00\x00\x80e\x93Š\xaaae7\xe0|&\xf7\xdd\x195}B[ia\x99pM\u05ee\x89\xa9}\x93W\xfd\x1d\x00\x00\x00x\xcdj\xa7,\xbd\x13\xaaFV\xb9*\x94\xef\x05\xb5\x0f}5\x13k\x01\xbf\x82\xbc}\xa1\xdf\x03\xb0\xae\x82\xdfRq\xd9_f\x906\x00`5\x1c~R\xb1\v`o\xfa\xefН\x16q\x0f\xc0Zh2\xaf|p}\x7f\x04\x9c_x\xe1{\x15\x1b+\a\xc6֔\t\x97kP\x13\xc9\x0e\xe0U\xa4\xf8\x91\x10\x14\xdbZ\xc1\xa5 v\xab;zg\xc7^M\xed5\xcf*\r\x8e}\xa5\xb2\xb7\xfck\xfaG\x8e\x105\xfauw\xdf\xd7\xc5r^+M\x1e\xa1h\t\xd0g\n~\xa2\x18Raί\x1f\x00\x00\xac\xa3`\x8d\x91\xd6j\xba\xf6\xed\x17\x1d\x171\xd5{\xe2@\xcemw\xc7\x00VS<\xf8\x19\xe5\x1aKym\xe9<\xa5vK\xd7j\x94\xa7n\x85\xd4\xc6\x01\x00\x00\xc0r\x89AM\xf3h\x7f\x00\xe0\xe5\x0e?yE\xd4\xe1}\x1aי\\Z=\xb4\x9dY\x9a\x99\xca9\xdfM\x14\x0e\x00\x00\x80ק\b\xcfJ\xcd5\xaa\xb3\xa9\xe4\x8fY\xfdj\xacz\xef'\xfb\xab\x00\xceg\xd0\xd6\xfd\x1e\x80\xb5\xe4\x9cZ?\xec\x0f\x00\x00XrO|{\x1c\xce\x0f\xe4Ҙ?\x17\xec;v\xdf/I\xc1\xec\x04\xb4\x03+\xadʂ\x86\x83;\xfb#\xe0<>yY\xcc\xd2\xdc\xdf㨺\xbdR\xa19'\xa5\xf7\xa9\x00p^\xb9\xac\xf6\xa8\x98E\x15\xa5WV\xa6\x05\x10\xbd\xea\xbaTSoi~\xfc6\xf9[~\x94\x81\xe6G\x8c\x9a\xfc\x9akg?\xaeq{\xcav,\xadյ5\xe8\n\xa9\x98ȧF\x1c\x81\x1e\x00\x00`\x1d\xb94\xd8\"\x05\xb96R\x9a\x955ͮ\x153\xab\a\x8d\xa5\xf2\xf8\xe2\x1e\x00+\xc9\xe9)\xfb\xf4\n\x9a\xaaM\xadY\x9f)\xbaC;?\xb0\xf4~\xd8\xdd\x03\x00\x00\x80%\x12\xa46<\xd3\x1f\x00\xe8<\xf5g\xe3\xc1\xdd\x1f\x8a\xe5\xfc9\r+\xa7\xa2\xbd\xa2;\x1d\xddi)\x9b\xdb^)\xd70\xc0\x1c\x00\x00\xe0\xf5[\x04\xe3VV\x95\x8a*\xa4\xfc@\xf9a\xa5\xf9\xfd\x1ff\x9a \xe0<\xeaG\xbf9*d\xfd\x11\x80\xb5dI<\xfaA\x7f\x00\x00\xc0r\x9b\x1c\xfe\xa8F\xed8\xcd\xc3\xd2m\xa9u\xd7yy0;\x80\x95ָ(w\xcd\xf7\x10Ȅ\xf3j\xef\x7fkl\xf7+id\a\xd5\t\xc5\xf6\xacBZج\xa0,\x00p~A\x99b\xb42&\xd5\x1b\xf3\xc6\xf6[Ս\xb3\xb3\xc75\x1c\xbcE\xbb\xb7}\x94\xb2g\t\x10Ⱦ\xe6\xda\xe6\x059\x9d\x92\xeaL\xa1il?\x93\xcfB\xb7\xa2\aKz\x00\x00\x80\xf5\x94\x82\xd8[\xab\xeb\xa4\xd5أB\xb0\x06I\xb4\xb6Gz9\x9b3#\x1f\xb0\xd2r)\xc4F\xd36\xaau[\x96\xb4\x83m\x96ֽ\xb5y\xd4,\xee\x01\x00\x00\xc0\xf2p\xce\xdadL8\x04\xbc\xdc\xc1\x99\x1f\xd1t\xff#*\x8am\xf9\x14`\x15\xf6\xacM3\x97+\xa5\x98\xf7\x030\x1c\x03\xcc\x01\x00\x00^7\xabJ\x85\xe1\x93V\x97\xbaJ\xd3l1)j\xee[\xcdN?\xb4\xb8\x0e\xe0\v̫\x87SC\xa4?\x02\xb0\x96R\xff\\z\xc9\n\x00\xc0\n\x98U\x9f\x92\x0f\xdb֜\x8b\x8b\xed\x15\x11\xfe\x02\xac\xb2\xb4>'p^\x9f~k\x9c<\xfb\xac\x9a\xf1\xbee\xf7\xc7\x15\xeb\xb3\xca\xdbJy1VU\xda9\x008\x9f\x98+F\xa7\xa8E\fI\xdb\xd6\n\xadW\x96\x1f\x93\x8e]\xdb߄\xa3\xe6\xd2l\x03XS\xbf\U0010ea1a\x17r\x00\x00`Ä\x99\xd5r\xafP\x93F,\r\x0e\x94G;>8\xa1I\xd9j\xfc\xa1st\x83\x01\xab\xeacYT[\xf6\a\x00\x00\x00X\tn\xa0X\x1e\xca\xddY\xd3\x16\x03\xcc\xfcޫcv\xeei\xe5\xf5U\xd2\xe0l\x7f\x16\x00\x00\x00\x97\xc4\a\xa7\xb4K\x80_f\xfa\xb1\xeb\xe3(>.\x05&\xd3\x02\xd6US̔\xbf'\xcd\xfc\x0f\x00\xc0r;x\xf8\xf7\xc7b\xfa\x0f48w\xb9\x94\x11\xac\b\xac\xae4\xd9\xc4b\xe2\xe6\x14L\xe8\\\xaa\x8a~nr\x8a=w\\\xbb\xef}\x8e\xfa)\xbe\xd0=7G\x1d<\xd9\x1f\x00\xc0\xebS\x153\x95ufE\xceHU\x96\xc9\xe9\x9cr?V\xb8\xf6k\x95]\xf1\xbd\x94;K\x82)\xa9\xd6Y;\xebw\x00\x00\x006H\xaa\xe1:o\x7f\xe6\n!ͪ\x95\xce\xd9wA\x1b\x04Xe\xa1\xa1\xf9\n\x00\x00\xb0\x9a\xa8\xc7\x01\xc9\xe1C\xef\x8f\xcd\xf4\x8c\xf2p\xb9T\x9c\xee\xcf\x02\x00\x00\xe0R9|\xf2ϳ\xd2\a\xf0\xcb\xc4\xd8\xda'\xedv`\x9d\xb5\xca\xfa=\x00\x00\x96[\b\x0fKuڛw\xc7\x00VU\x1f\xc4\xfe\xf2\x05WS0{Xlyqy\x7f\x12\xf8\x9c摯\x8a\xd5t\xbb?\x02\x80ׯlr\xcdm\x93\x1f\xa8\xf4\x87\nͮ\x9a\xcb\xee$\x88}\xc9\xd0\x13\xbdƚ\xe6\xa0\xdf\x03\x00\x00\xd8 \xaeL\xbd`\xf2~\xaa\x10\x82Ԏ\xa5\xbc\xb1\x8d\x95\x04\x80U5}\xf8\xdbbh\xf3\xfe\b\x00\x00\x00+#\x06\xfb\xc3k\b`r\xdf\x17\xc7慏iP\xbb\xc5D{\x9et\x01\x00\x00p\xa9MO\xff\xbd~\x0f\xc0\x8b\\HAB\xb4O\x80uV\xfb\x9d~\x0f\x00\x80\xe5\xd66\xf7\xcaׅUR\xab\xfe\f\x80\x95ԭ\xc0ދ\xd6\xde\xec\x8e]\x1aҫ\xb6\r*\xb6\u07b6\xb8\x06\xbc\xe8\xf1\xaf\x8dᙟ\xd5$<՟\x00\x807 \xa61\xe6C\xb5\xf1@jvU\x9e\xbaZ\xc5[\xfe\x13A\xecK\x86\x9e\xe85u\xf0\xc0\xaf\xb5\xaa\x1e+\xb2\x03\x00\x80Md\r\x91\xd8\xcae\x8d\\ZE\xa0=n\xe7\xe6\xd6>\xb9lq\x19\xc0ʙM\xfe\x832\x15\xfd\x11\x00\x00\x00VFj\x93\x85Lz\xf8;X\xf9\x10\x1bk\xf2\xd9\xf7E?\xf99\x1dk\xc7\xcaݶ\x94\x9dQ\xedX\r\r\x00\x00\xe0R\x1bΟ\x91\x1e\xf9\x1d\xb4M\x80\x97\xf1q\x9a>\x17\a\x00\xd6R\xf4\xd7\xf4{\x00\x00,\xb7\xbczn\xb1b\xb3[\xac\xe6\f`EY\x1a\x8ej?\xb7\"{\nf\x7fi\xcbU\xdc\xf8\x83\x04\x15\xe2%\xf1\xa9?\x19\xf5\xc2\x0f\xab\f\x95\x86~\xaf?\v\x00o@&\r\xca\x03\xc5j,\xedl\xc9\xddr/\xe5\xcd\x12\xa2'z]\xcd?%癑\f\x00\x00l\xa0\x98:\xb4\x9bnק\x15\xce\xe2@QA.\xbf\xb9;\a`\xf5d\xf5=\x96\x86Y\x91\x1d\x00\x00`\xf5\xb4\xd6&\xcbԄ\xa7\xfbc`\xb3L\x1f\xfe\xf2X\x1d|\\\xc3\xf4\xba\xa6\xf0R\xa8\x15f\xdbR\x9eV=\x04\x00\x00\xc0\xa5\xb4\xed\xa4\xd9\xde\x0f\xf5G\x00\xda\xcf~]\xf4\xb2\xb6I\n&\x00\xb0\xb6\xdc\xf8\xa6~\x0f\x00\x80\xe5U=\xf6\x1bbQI\x85\x1a\xc93\x11,\xb0\xea\x9csr\xfeŹ\x04S\x9b\xd3۹\xc26\xd27>_x\xf6\xbb\x15\x0esi\xe05l\a\xfdY\x00x\xfd&)\x86\xb6-\x94\xef8\xe9\xf6G\tb_R\xf4D\xaf\xa9\xb2\x9aY;\xae\xed\x8f\x00\x00\x006G7\x91\xa3o\x94\xfa\xb5]\x9a^\xcb\xcfU۹\xf1\xad\xff\x17\x8d\x12`E\r\x9b\xa9\x94\xd7\xfd\x11\x00\x00\x00VG+\x17r\xb5\xe1\xf9\xfe\x18\xd8\x1cͽ_\x19ݹ\xfb4N+Ȥ\x89\xf6\x9aJ\xb1\x9e(\xaaV\x11\xb7\xfa\xbb\x00\x00\x00p\xe9\f\xd4\x1eT\xd2C\xbf\x93U\xd9\x01\xd3\xcc\uf475\xd8\x17\x93\x84\x03X[\x83\xd1\xdb\xfb=\x00\x00\x96W\x98\x7fRê\xb0\xbdh\xd5S\xc2[\x80\x95\x16C\xfa\xe8\x82\xd9\x17Ǳowz\xf9\x8c@e|N\xfd\xe9kb\xb6_\xcb\rj\x85v\xc7\n\x83\xf4\xec\x00\xc0\x1b3m/W[nKW\xfc\xe7\xfd\x19,#j\xfa\xeb\xe8\xf1?\x12\xf3j,\x17\x17+\x91\x02\x00\x00l\x1c\xdf(4\xa5\xb24\x83\xa3\xdfWP\xea\xe8\x06\xb0\x8a\xa6\xf7~Y,\x835]\xe3\xac?\x03\x00\x00\x80\x95\xd1\rPH\xc3\xe2\xcf-\x8e\x81\r\xd1<\xfe\xad\xd1\x1d\xfc;\x95S\xa72\x9eT̃\xea4\xa9C\x96)\xcb\x0fd;\xfd\x9d\x00\x00\x00\xb8dB\xa1\xd2mi>\xf9\xd1\xfe\x04\xb0ٚ\xfa!u1B\x91\xf6\t\xb0Ί\xab\xff\n\xb3U\x00\x00\x96^88X\x04\xbb\xb6\x99B\x9al\t\xc0ʊ\xddJT&\xad\xcan[\x17\x9b\x9c\x02\xd9}n\x95S\x02ٱP?\xf0\xee\x18\xf7\xf6\xd2\nGra[\xa1=\x94\xb2\xaa\xbf\n\x00\xaf\xdfHoQvկ\x95\xae\xfa'\xf4\x83,1\x02\xd9\xd7Q\xf5\xb0|\xb3\xbdX\x8d\x14\x00\x00`\x13\xf9\xa0\x18\xf2Ŭ\x8enb\xdfy\x7f\x01\xc0\xaa\x99\x1c\xde-\xb5C\x89\x89\xba\x00\x00\x00VP\xe8\x06&\x848폁\xf5\x17\x9e\xf8\xa3\xf1\x85\xe7\xbeGYS\xcag\x8f\xab\x8d\x13͛\xe3\x8aè|hm\x1bw\xdc\xee:\xbd\xb8\x19\x00\x00\x00\x97L\b\x85\x8a\xad\xa8\xf9d\xaa\xc9\xe3\xefdD\r\x10\x0e\xad}\xf2\xb2 \x03\x00\x00\x00\xe0\x88\x84Y\x1a\xdb\xd7HmI\xfd\x14Xu.tA\xec\xddև\xab\xc5\x17\x03\xd93&R\x834\x7f\xf0\xabc\xf6\xcc'U\x0e\x0f\x15\xb3\x93\x96\xf7ϕ\xfb\x99\xaa\x01\x81\xec\x00\u07b8\U000685ae\xfa\x97\xa9\xf0\xc1\x12sT\xf6\xd7\xcf\xec\xee,\x16{\x97K\xc53\xca°?\v\x00\x00\xb0!\xc2X\xcd\xd6i\x85\xbd\xabU\x0e\x9e\x92\x0e\vM\xae\xbeL\xe3[\x9f\xa4q\x02\xac\xa0p\xaf\x8b\xfe0S\xac\xb6\xe5\xf2y\x7f\x16\xc0%\xe7g\xb6m\xabm-]\xc6JYz\xb9\x142\xb9\xb6\x92+j\xa9\xd9\xe9o\\W\xfdd\x1a\xa9\x1b-\xbdl\xeb\xba\xd3\xfcK\xfb\xed`/\x8d\xf9T\f\x8b\xea\x86Wz\xf9\xe6\xed\\_\xfdh\xd2\xf7\xe7\xee_诙6KSP/\xb8\x18\xe4_\xfc\xdf\xe9\xa4\tzҡ\x1d\xbft\xae\x17c\xf7\"\xbf\xbb\x06\x00\xcb(oU\a\xaf\xa2\xb6|\xf1ÇdVX\x7f\xf7\x7fs\xac\xce\xfcC\x95\xe9i\x8f\xbc\x9f\x01\x00\x00X*\xd3B\xedp˶\xa7U\xa4n\x96w\xa5Q\xd4\xc0\x86z\xe4OG\x1d|\x97\xe2\xe1@sWj\xa8\xba\xbf\x00`\xd5T\x83B夑\xfcX*_P[\x0f\x94E\xdb\xf7gTŷ\xa9\xfc\xe0g(\xef\x00\x00K\xed\xe0\xbe[cq\xf0\xa0\x8a\xf9@\xbeL/ʃ\xfda\xadF`e\x85\xd6ꥅ\xaa\xf9\xae4~Ze\x1anSI\xf3|W\x83\x0f\x9c\xa3n\xba\xe9>\xfd\xa1\xa8\xea\xacT?o\xb9\xfd\xbe|ny~\x9a\xe4 \xe5\xfbm?\xa9\t\x00\xbc\x82f0\x97\xaf\v\xcb+*\xf9TU\xb4\xbccfyF\xac\xae\xd6hp\x99\xf4\xdeORƬ\x00j\xf9\xeb(D\xfb\xc5~n\x004\x00\x00\xc0FI3:\xc6\xd4>\xa9\xed\xdb\x1a,\x99\x94\xe7\xd7\xf5\x17\x01\xac\x9a\xc5B\xec\xb9\xdc˂<\x01\\z!\xa5\xc5\x14\xc5\x1e\xbbH\xf6n\xb6\xe4\x10r\xd5q\xac\xa6\xddR\x9b\x1f\xae\xf7V4\n\xb6\xb5EmǕ\xdaln\xdbTA\x87\n\xeePYؕow\xed{[Y\xb3-W\x0f\xe5\xaaa\x1aA&\xcdK\xfbj\xba\x99\x83\xabr\xae\xaa\x98u[\x9dO\xba\xad\xca\xec\xef\xc7\xd0o\xad|\x97\xf1\xcd\xed\xe7<]l\xedb\x12\x8fn2\xcaW\x98\x90\x92 v\x00\x00\x96C\xf3\xf0w\xc4j\xef\xe7U\xe8\n;\xa2|\x06\x00\x00X:\x83V.?'\x17ʮ\xebE\xf7~\xd5\x17v\xb4\x00\x1b\"\xcc\x1f\xefƆ\x87\xe0\xe5<\xef_\x80U\xb6X\xc8*\xbd\xbf\xe9\xc7I\xa4\xd1\xdc\xe9]\x8es*\x86\x97/n\x02\x00`\x995Se.Mޞއ;\xdbX\xb1\x19Xe\x96\x9a\xadz\x9a\xd2t\x1a\xf3b'B\xd1E\xad\xc54\xae\x17\x1bm\xfağ_\x04\xb17\x13k\xc7ԩ\xc9\xf29\xc1\xf5m\x1b\x00xe\xf94\x93\xcfZծPl\x8fK\xaeQ9=)\x7f,'\x88}\x85\x10Ⱦ\x86R?\xa4\\-\xefҌ4\x00\x00\x00\x9b\xa6\x91\x8bCy\x7f \x85\xa1Tڟѝ\xfd5\x00\xab\xe4\xf0ޯ\x8f\xaeN3nږ\xd3Q\t\x1c%\x9foKE\xa6,\x8b*|\x9a'\xa6U\x9e[\x99\x9b\x055q\xa0\x10ʵ\xde\x1a\x17U\xdb\xd6:˒|\xec\xb2%\x97\x96\xee\x1a\xd8\x0fö\xba\x9d\xa9\x0e\xb6Ź\xddS\xa9\xf5\xb5\xfd\x90\x9aŖ\xb7r\x95\x97\x9f{e\x95\xfd\f\x9b\\e\x9b\xa9\xb0\x7fI\x11\n\x95\xd1*+3\xab\xb3\xa4m>\x90\xea\xa1b;V\fc\x85h\x9b\xec\xf8|/k\xd2[\x1d\x02\xd9\x01\x00X\n\ag\xfe\x8e\x9a\xf9]r\x1aY\xf9}\xa2?\v\x00\x00\x80\xa5QL\x14\xfd\\y\x18ʅ\\\xb3\xf9\x8f\xf6\x17\x80\xcd3\x9d\xdd#ՙԦ@vVc\aVZ\bR\x17\xf8פy\x88\xe5\xdc\"\x90\xbd\xd5Hn\xe7\xb6\xfe&\x00\x00\x96Xs\xa8\xccG\xab\x97\xa6\x89Y\xd2{\xf1\xe1\xe2<\x80\x95\xe4\x94V˵\xef|\xbf\xab\xaa*\x1c\xef\xa2\xd6BN\xe8ڦsO\xfe\xf5n%\xf6\x18\xce\xd931_\xe4\xfbI\x97\xf7\xa7v\f\xe3\x9f\x00\xbc\x8an\x11&/\xef\xafV\xc8\xf6\xa4ɖ\xfc\xf6@\x83\xb7?J\xe6\xb1B\xa8\r\xac!\xdf:\xab\x00\xa6\x19j\bd\a\x00\x00\x9b\xa8\x96\x0f\x83\xae\xa3CMn\x8d\x15\xab\x13]\xf5\xbf\xd1H\x01VP5\xfb\x98|S\xa6\xa8QE\x06R\x01G\xaa\xaeJŹ\xa5\xc3jf\a\xe9\xdb\xca\xd9x\xa0\"\xdf\xd70;T\x11ڵ\xde\xca6\x05\x9f\xa7ͩh\xbc\x8aʪ\x1aU+7o\xba-\xbd\x87K\x9bO]1y\x94\xcb\xdb~\xd5\xf6\x14\xd8>S\x91\r\x94\xe7\x852\xab\x97d\xfe\xe5\xc1穃\xd5\xfe\xfe־m\x87\xd2xj\xdfv<\n\n\xe3\xa88\xf6j\xb7r\xbb\xfdeU\x19f \x06\x00`\xe9L\xeezG\u070e\xe74\xb6\xfa@\x8cO\xcb\xfb\xed\xfe\n\x00\x00\x00\x96E\x9d\xbaT\x82\xd5\xd3\xd2\xea\x10\xde\xc9Wv\xee\x91\xf7\xd1т\x8dT\xd5\x0fXZ\x18\xca\xc7\\\xce7\xfdY\x00\xab\xc8+\x93\xb2\xf4\xde\xc1\x92u\xf0\x8b\xf7\x0f\xed\\\x8d\xb6\xa4\x1b\xfe\xe1\xcb^.\x00\x00\xb0\x9c\\=[\xbc>\xb7\xf2\xac\x9b\xe0=\x94\x8b\v\x00V\x93\xcb--;\xf9<(t\xabEl\xa7J\xab\xda\xfcx\x7f\x036Q}\xef5qXO\x14\xb4oσ\xe5\xfb\xa9/\"Mȕ\x84ԘI\xab\xf8\xd3|\x01\xf0*ҢCն\x8a\xfc\x19\xf90R5\x98I\xc7\xfe@\x7f\x11\xab\x82@\xf65\xd3>\xf4-ѧ\x82\\\x95mYw\x0e\x00\x00`\xa3\xf8ؽ\xa0u\xa9c;֚\xbb\x9d\xfe\x02\x80U\x13\x9bG\xba \xf6\xd4Q\xd9vS\xb4\x028*\xc5\xe0r\xb9\xcc\xcaTw\xcc\xd2\xe5u\xb6])5\xc5b\x04\xf0|\xfd\xfb\x1f\\uL\xae\u07b5\xff\xe6\xe3Rk?\x83\x98\xb6\xe3r\xf6\xf3p\xb2m\xd6(\x9b\xb5\xca꠬\x89J\x93\x06g\xf6\xa3\xe96\x05͚\x81mC\xdbJ\xd5ah\xdb@\xad\x06\nnd\xd9\xdc\xd8\xfe]\xf6\x17Bm\xff\xee\xc6~\xa6\xadB\x15\x14\xe7^qfy\xe0aֽ\xac!\x98\x1d\x00\x80\xe5\xb4\x7fׯ\x8e\xd9\xfc\x1eu\vI\xa4r]\x95\xa2\xcev\xd7\x00\x00\x00\xb0<\xda8\x94\x8bc\xc5v&\xd9w\x19F\xf2\x87\x1f\xef\xaf\x02\x9b%Km\x96\x90&\xd0L\xfd\x8c\xbc\x7f\x01VY\x96\"س\xb4*\xbb\x1d\xf8\xf4\xbe&(\xaaU\xed\xb6\xba\xeb\x00\x00,\xb3\xf6ޯ\x8be\xd3ڎ5\xd3\\*\xcfl\a\xc0j\xebV\x80XTM\xb3X.Ʒ\xd8\x1f_\xdcԝ\xc7\x06\xba\xef]Qg\x9f\x92\xcac\xdd\x04\a\xaek\xbf\xa4\xfe\x884.\xd4\x1a2i7\x8d\x89\"\xba\x11\xc0\xab\xa8\xb3\xe3\x96W\x9c\x91&s\xb9\xf2P\xfe\x8a\xdf!\xdd\xf0g^6\xa0\x12\xab\xc0u3WamL\xef\xfbp\x1c\x9c\xfdE+\xc3\x1b\xa9ؑ\x1aV-\x04\x00\x00\x1b\xc6\x05\x057\xb6\x8a\xeeY\xb9&\xd7\xc1\xe8m\xda~\xf7\xa7i\xa8\x00+\xe8\xe0#.n\xb7#Kיf\xe5LCK\xd3\x00\x8e\xc8h\xa6\xb4\xf0x\x9a;/\xd3\r\x8a\xb1\xb0\xfd\xc7\xe55\x95\x9a-\xc5l\xbd\x83\xd9\x17\xfdgi\v\xf6\u07fcx\x81\xee\xe2b0X\x92\u05fb\xf6s\xb1\xeb\xe9\xa5\xcaK\x83?m\xb3{^\x92^\xbcw\x7f7\x9dO\xc7\xdd\xd9\xc5\xfeK\xef\xe3\xbb\x7f\xc1b\xd7\xfe\x8d/\xe9\x06\x9f\x99W\xea\xc7K/s\xe8\xdf\x03\xb0\xac\xf2Vu\xf0*j\xcb\xc7>|H\xbb\fk\xe7\xe0ޯ\x8a:\xf8Qm\xa7\xa7\xbb+ӏYٜF朶b\x7f\xd8\xdd\x03\x00\x00\x80\xe5P\xbb\x13*\xfcTUsV>\\\xad<\x7f\xdeN\x16\xd2տN\xba\xe1\xffO{\x05\x9b\xe3\xecw\xc4\xe9\xfd\x7fI\xa30N3<\xa8\x1e\x1f\xa8\xa8h\xbf\x00\xab*f\xa5\x9c\xf6\x15ÎZ_)\x8fNq6\xd5\xe4\xd8{\xb4\xf5ΏS\xbe\x01\x00\x96\xda\xf4\x13\xb7\xc7\xd1\xf4\xd1\xee}RH\xef\xd9]#_\x9d\x94\xb2\xc3\xfe\x0e\x00+\xc7o[[s_\x1a\xcdUM\x8f\xa9\f\xa5T\x9eQs\xd9\x1fV~\xfd\xffL\xfdt\xd3\xdc\xf3\xbb\xa3\xf6\xbe\xcf\x1a.\xb9B1\x96\xcff\x8b\xf3i\x9cS\xb7\x12{\xda\xf7\x8b\xb1O\xe9\x1dkh\x16\xd7\x01\xe0\x979\xf0\x03m\x87\xb9\x951\x96\x8f\\\xf5N\xe9\x86OQ\xa6\xac \x02\xd9\xd7\xcc\xdeݧ\xe2\xee\xbeU\xfc|m\x8d\xb8]K\xa0iev\x00\x00\x80\xcd\x11|\n\x12\x1bȧ\x88\xb0l\xa2\xc3\xe2+\xb4uǿ\xa7\xb1\x02\xac\x98\xe6\xe9?\x14\xc3c\x7fWe[v\x01\x9c\x93\"hܐ\x94\x81#㝪\xd8\xca\xed\xfc\x1a\x15\xb7Q\xae~\x81g\xbfc\xd1\xc1\x16\xac\x1e\xd2u\x98\xd6j\x9a\xb4U\x8a\xa1\xd1(\x7f\xa0\xbb\x16۹\xdaP)ԕ\x9av\xd6\xed\xc7\xd6\xfe\xce\xe0\x84\xd5]\x1ay;\xf6\x9a\xcbǩ\x9cfr\xb1\xb6\xfd\x94\rn-^\xe2\xf4\xdb\xcb\xfb\xf3>o\xa5v\x00X6\x04\xb2c\x9d\xdd\xfb\xb5qv\xf8o5l\x0f\xad@\x1e[Q\x1fԺB\xe9\x1f\xc5\xd3V\x80\x13\b\x02\x00\x00\xb0L\x82?)\xaf'UG\xa9\xd5q\rݾt\xb0\xa5\xf6\xd4@\xd9;\x9f\xa5\xbd\x82\x8dq\xf0\xf4\x97\xc6\xe1c\x9f\xb0&\xfb\xbc\x1b(ތj\xe5sk\xc7\x00XI\xd1\x17\x96\x94\xf7\x15⮢\x8b\xcab%5s\xcdN~\x99\x86\xb7\xfe\x04\xe5\x1b\x00`\xa9M>Z\xc6q3\xb6\xbdFM^)\xcfki\xba-\xe5\x042\x02\xab*\xca\xd2t8'\rZ\x85\xea\ne:\xad\x90\x8d\xe4߿G\xddtô\x0f\xfd\xe1\x18_\xf8{\xcag\xb94\n\x9a\xda\xd31rޞ\x8f\xf4(\xd8w\x1a\xfbԍy\xea\x17\x05I\xbak\x00\xf0\x85\xea|&W\xbfC\xd9e\xa5\xdcMLܷ\xaa\bd_3g\xeer\xf1\xc4\xc1\xc0\xca\xf5\xb9\x94\x1d\xb3\x82ܾ\x01\x00\x006Ȣ\x8f\xc3\xc9k(\x8d\xceh\xee\xbeF\x83\xdb\xff\x15\r\x16`\xc5L\x1f\xfbMq\xf8\xf4\x8fɵ\xb5T\f5\xf5^\xa3\xa6\xef\xb0\x04p\xe9ř&\xce˝\xfaz\x8dn\xfa\xdf)W/\xb6\xc7\xfeǨ\xfa\xb4Ԝ\xb3\x9f\xfd\\\x9a\xfcߋ\x178)P\xbe\x0fdOۋA\xec\x04\xb3\x03XZ\x04\xb2c\x8dM?\xe2\xe2(۶\xf2\xda\xca\xe74aMyJ\x8d\xdbS^\xef[;\xe62{\xfe\x0f\xfa;\x01\x00\x00\xb0\f\xdaX\xca\xc5=\xb9Q\xa9yQi89.\xcdϪvW\xe9\xe0\xad_\xae\x13\xd7|\x1fm\x16l\x84\xe7\x1f\xb9\"\x9e|:\x93o\x9f\x91\xb2B\xcd0*\xaf\xac\xdd\x0e`%\xb5\xca\xe4\\\x9adoG\xd1J\xb2,\x8d\x17\x8d\x95\xea\xcb\x7f\xa3\x8a\x1b~\x88\xb2\r\x00\xb0\xd4\xf6\x7f\xc1ŝx\xb9Tϭ^:W^Z9v8\x96r\xc6\a\x01\xab\xaa\rC9\x9d\x95/\xa4\xd0^-矲z\xea)\xf9\xf7=O\xddt\xc3L>zE\x1c\xb5\xa7\xe5\xf2\xa8:\xad\xcc?\xa8U\xcc\a\x8a\xa9\xe1b[7\xd6\xc9ۍ.\xc54֊\xa1\xb5g'\xef\xfe.\x00|\x81l\xa6Y\xb9\xa5\xe1;\x0f(OV\x18\x81\xeck\xa6\xfe\xe8N̳48\xca*\x80\xb5\x95\xea/\xceL\x03\x00\x00\xb0!\xaa\xa1S9\x9fK\xb3\xa8z\xbbP\xf1\x9e9\r\x16`\x05\x1d~ꚸ5?+5\x95҂\x86\xb5\xdbQ\xd1\xce\xfa\xab\x00.\xb9f\xa6\xc9`K\xe3\xf7\xd1\x11\xb8L\xf6\x9f\xf8S\xb1\xadΨ\x9c\xfd\xb8\\\xd3t/\xf8˶Rf\x9bB\xbd\xd8\xdaV\x1a[F\x9a^\xfc\xb8\xa0\xe0Z\x85\x98\xa9M\x99\xab+\xa5\x98k\x10\xf6\x94\xde\"\x06\x9f\xab\x8eA\xad\xfd\xbd,x\rd\xd7\xdd\xc0\xee\xb1\xfc\xb8Ⱥ z\xd9\xffNk\xdf>\x1f\xcb\xd9\x1dJ/\x91\x82]\a\x80WB ;\xd6\xd1C\xdf\x1c\xf5\xdc?\xb42\x92\x15\xd7\x01\x00\x00\xd6\xca\a\xa7\xb4Y\xb0\x11\x0e?\xbd\x1bG\xd3}\xf9\xf6\xa4\xa2\x9b\xaaʂ\x06\xacx\x06\xac\xae6\x93ʹZ\x8d\xe5\x9a=\xf9\xcc٩L\xd9{j\x126\x00`\xb9=\xf9\xc7c\xf5\xf4\xff\xaa,;P\xd6\x1c\x93fci\xf8\x94f\xda\xd50T\xfdM\x00V\x8f\xd3\xd4>\x87Y)W\x9f\x93\xfcH\xfb[Wi\xe7\x1d\x0fR?\xdd \x93O^\x13\xc7\xedSR\xf0Ru\xa5\xa2\x9b\xab\x19\x9dUQ\x95\xfd\x1d\x00\xf0ˤ!\x88\xedL\xce\xed\xc8eCU\xfe\xb94ǅ\xcaTO\xcc\xce\xe9\xb9\xf2-\xba\xfc\xceG)KV\\\x9a\xbf\x04\xeb\xe2\xf1\xdf\x1f\xa5\xf4'\x15\ue582]\xb38\x0f\x00\x00\xb0A\\L\x13\xf9X5\xd7\x1a11c\xf5\x00`euiٸE\xb3ՋI\xba\x80#\xe5\\\xb7\x92\a\x96\xcbε\xdf\xe5\x8e\xdf\xf8\xf7\xdc\xf8\xed\x9fq\xa3w=\xe0F\xef{\xdce\x1f|ֵo\xfd\xfd\x9a_\xfbeڻ\xeaF\x9d\xb9\xe2\xa4\xdabލe\x8b*\xe4Î\xf20\xd4 \x04\r\xeas\x1a4/X\xbdiKmpj\xaaZy\xe35\xf6\xbb\x1a\x14#\xcb|\xf7\xec/=\xbbȋ[\xfb\x1f\xb4{\xa2=\vYW\xc7j\xec\xef\xeck^=\xd7\xfd\x7f\x01\x00`ST\x93\xff\xc7\xcaĭ\xfe\b\x00\x00\x00\xeb\">\xfagY\t\x04\x1b!4Q]W\xaf\x9b\xd9w\xa9\x8cER\x80Ֆƈ\x86\x81\xa2f\xf2)q\a\xb7\x98\xc8\x16\x00\x80%WW\xcf.\xd6\xebk\xad\xf8\x8as\xc9\xdbN̬<\xa3~\n\xac:\xffb\x94Z\xeaiI\xe9:?\xb58\xc6F\x98\xdf\xfb\xb51\xb6{\n]\x1e\x9f\xda&\xd6f\xf1s\xfa\x1f\x00\xbc\xaaP7V\x0f<ayG\xa5\xaazNe5RٜR\xedjU\x85\xd7eW\xfd\xbe\xfeN\xac2\x02\xd9\xd7\xc8|~W\xb7\xb8V\x9b\n{\xab\xf0\xc91\x1b\x19\x00\x00\xd8@!\xf5n\xe7] {\xc8F\xfdI\x00\xabƧU\x84S\xe7\xa5Om\x1bgm\x1d\xc6\x10\x02G\xca\r\xd4zV\x1d]\x15\xd9\xd5\x7f\xd9\r\xde\xfaCn\xf7\x96ϸ\x13o\x7f\xcee\xef\x89.{\xef\xdcͷ~\x83\x0e\xf2\x13\x9a\xba\xa9bL\xfdF[Vo\xbaBa>W\xa6\xa0\xb2l\x94e{R\xf5\x9c\xe2\xf4\xb4b\xeac\xf2\xa9\x838X\x15\xab\xb5-*\x86\x94/\xe7r\xf6\xe5\xf3ڶ\x14\xe1\x0e\x00\xc0f\x98\xdd{*\x86s\xfb\x8a\xc5a\x7f\x06\x00\x00\x00\xebb\x7f\xef{\xfb=`ͥ\xd7/.\x05\xbdN\xec`(\x97\x06\x94\x03X])\xe8/l-\xfa\xfc\xd3{\x9c6W\xf0;\xfdE\x00\x00\x96\xd7|\xfa\x90b\x1b\xac\x1c+Ժ\x99\x95cV/m\xad~\xea\xad\xc2\n`\x85Eks\xba4\xdc\xcf\xd2w:Ε\x0foN;\xd8\x00\xd3O\xdc\x1e\xdb\xc9O(\x8b\x87]l\x9b\x94\xc6\x18\xcd-o\xaf\b^\x04\xf0\xaaB\x8a\xff\xf0ۊΫ\x1b\x8eX\xa5\x890\xf64\x1f\xe6jO\xfc\x16\xb9+\xfe<\xcb0\xad\x01ʂ5\xd2\xd4\xf7X5/\xa5\xcbL!2\xab&\x00\x00\xd8L\x8b`W\xab\xe6\xfaB1\xbbfq\x12\xc0jy\xf4\xcfG\x1f*\xa9K\xcfyw\xaa\vl\apt\\!\x97\x9f\xe8\x0f\xb0\xaa\x86\xb7\xff\x80\xdb~\xcf\xc3n\xf4\x81ƹ\xeb\xbe]\x87'o\xd1\xf3[\xcf*\xe4\xb5ںU8p\xd2\xdc\xeaQ\x96\xf5\xbaq\xae\xb6\xdc\xd2\xcc\xedȗ1=\x02\x8a>\xdaf\xf7\xa4\x97\x8d\x96E;\x97\xabȳſ\x1c\x00\x80u\xf7\xc0\x95q\xf8l\xa9a\xf6\x82bٟ\x03\x00\x00\xc0\xda\xf0\xedc\xfd\x1e\xb0\xbeڇ\xbe%\xfa\xd6Inح\x91\xa2\x98+\xeb\x96\xc8\x03\xb0\xb2\xd2\xc4\xe0\xb1\xe8F\x8d\xa6\xb4]Y\xe2\x8e\xf9u\xdd%\x00\x00\x96Y;\x7fDyZ\xa9Y[\x8b\x1ai\xd6v\x13\xb2t\xc1\xaf\x00VW\xf4ʻ(\xb5TO\xb5O7\xd0\xe0\xfa\x7fF\xca\xde\x00\xcd\xfd\xbf9\x96\xd5g4\x8a/hh\xb9\xbbˆ\xd66\xb1\xbc=\x9f-n\xa8x\xc1\n\xe0\xfc\xb2<\xf5YN\xac.8\xb2\x1cd\xdb\xf6\xf7\xecd\xad|\xe7C\x1a]\xff\x03\x94#k\x82@\xf65\xe2\xaa3\xf6\xe9\x15S\xe7d\xc2o\x17\x00\x00l \x1f\xac\x12\x14\xa3Չ\xa2\x9c\xbf\xb3?\v`\x95\x84駔\xc7\xc5\n !\r\xbb\x88\xa9q\x93\xa6\xd8\x03pT\x1a\x9f+\x1f\\\xdf\x1fa-\\\xf3\x17\xdd֭\xbf\xe4.\xbb3\xba\xfc\x83\xb5\x8b\xd7~\x8b\xe6\x97ݪ\xc3A\xa9*\f\x14\xe7\xdb\xca\xdbJC\xff\x98\x82\x0f]p{V:e\x99\xb3\x1c9\xaa\x9d\a\x85\xb9\x9d\xacG\xfd\xbf\x10\x00\x80\xf55y\xe2\xba\x18\x9f\x9dw/N\xe5\xad,l\a\xfd\x15\x00\x00\x00\xac\x8bq\x94\xe6\xf7~\x90\x88^\xac\xb5\xb6\xfe\xa4\xca4\xec3f\n\xe9ۥU/\xbbK\x00VUz\x8d\x1a]?Tԩ\xf1^Yy[w\x04\x00\xc02\xcb\xc3\xe9\xae\xfcrn\xb0\b^O+\xb2\xfb(\x97\xc6\xfe\x01XY\x96\x8a\xed3\x05\xb1\xdbf\xbbuΘ\x92MP=\xfe;bs\xfa?\xa5\xf5\xd7\xe5\xd2\xccya\xac\x18\xa3\x82\x9b+fiA\xa3B\xb1\xdaY\xdc\f\x00\xaf\xc0\xc5R!\x1cX\xfe\x11\xed\xc0\U0008dd3e\xf3\xce\a4\xbc\xf1ߥ\x82\x05k\x82\x9a\xfe\x1a\xc9\xd2\x02\x85\xa9з¾M+\x17\xf6\xf1\xec\x00\x00\x00\x9b\xc4\ak\xb9\xf8F\xb5\x9fix\xeb\xf7\xd1x\x01VPUݭL)\x90=\xda?\x8b\x15\xd9\xd5\a\xb6\x038\x1aU&\rGo\uf3f0\x8e\xf2\xb7\xfe\x1d7z\xfb]n\xeb\xfdS\x97\x9f\xfaU\x8a\xe3\xa9\x14\x0e\xa5\x83\x81\xe6s۪\\Mkٱ\v\xf2Y\x90sN.ZU+\xa6^c\x00\x00\xd6\xd7\xe1\xe3\xbf)꙳r\xd99\xa9\xb0\xf6I\xf4\n\r\x83n\x00\x00\x00֍ow\x14\xf6>\xda\x1f\x01\xeb\xa9m\xefW\xd1-\x89\xd7\x0f*s\xa9\x0fp\xb1\v`5\xb5\xca,Uϔ\xe6\xa3Mi;f^\xe5-\xdf\xcf8\t\x00\xc0r{\xea;\xe2@\x95\xed\xb4V'\rr\xdd\xc4,i\xdf\xea\xa7-+\xf6\x02+/\x05\xb1\xa7\xb1~>W[l\xf5'\xb1\xae\xe6\x8f\xff\x7f\xe2\xec̿\xd6\xd0\xcd\xec\xf7\xbem\xf9\xb8\xfd\xce\x1bo\x8f\x80\xe5\xeb\xe9QH\xdd\x10)\xa3w\xfd8P\x00x\x05m,\xe5C\x94\x8b\xfb\x9a\xf8J\xd3\xed[\xa4\xdb>B\xffƚ!\x90}M\xc4\xc7\xfex,\xd3*\x85\xa9p\xcfk\xfbNA\x1e\xfcz\x01\x00\xc0\x06J\x13\xfb\xf8V!O\x9d\xdd\x00VQh\x1f\xe9^Tu\xfb\xdd,\xad&\xf5h\x0282\x8dk\xe5\xae\xfd;t\fn\b\x7f\xf3\x8f;\xff\xae\x99\xd3\xd5ߪ\xfd+Oj0\xb8U^W\xa8\xae\x87j\x82=\v\xbe\x91/\x1a\xb9\xdc\xf2\xea\x8c\xfc\x19\x00\xb0\xbe\xea\xc7\xfe@t\xcf\xfd\x94\xc6MZ\x8d}W*O\xabi\xaf\x92\xf7g\xfb;\x00\x00\x00\xb06\u008e\xca4\x89\xdf\xfd\xbf\x89\xce\x0e\xac\xadP\x9f\xee\u07bf\x846\xad\x96RJ~\xdfN2Q%\xb0\xcabZ\xd9\xd0\x1d\xd8\xce8\xbddՋs\x84\x03\x00\xb0\xcc\xc2\xe1\xbdݪ\xbd\x8aV/\x8d3y\r\xed;H\x85\x1d\x87Aw\x0f\x80Ք\xc6\xfa\x854yZڲ\x81b\xce\xe4\x14\xeb.\x9e\xfb\x87\x1a\x1c\xdaNj\x8bxg\xed\x93Vѷ\xf6$d\xcaR{%\xe4\xf6\\عr\xda\xdd\x0f\x00\xaf$\xa4\x05uBk\xf5\xc2F~t\x93F\xb7\x7f\x96\xb1\xaak\x88H\xe75Q\xd5\xf7˅4[\x91\x97\xb3\x96]L\xb3\x92\x89\x17\r\x00\x00`\x03\xc54\xe8\"u\x82\xa5\x01\x18\x00VQl'z1~\xbdC\x10;p\xe4\xda~r\tl\x98\xb7\xfc}\xb7\xf3\xb6'\x9c?\xf5n\x95[7(\xf3\xdb\xdd\v\xc7\xd0͆\xdf\xd86\x97\x82\xe5\xd9\x00\x00\xac\xa9ɹ\x1f\xd1p\xb6/\xe5iŀ\xa9\x0e\xab\x93\x8a;\x8f*떇\x01\x00\x00\xc0z\xf1\xca2\xaf\xc3\xe9\x0f\xf5\xc7\xc0\x1aJ\xc3\xc9\xec\xa3\r\x8d\x9c\x06\xf6ا\xf7/]\b\x11\x80\x15\x95V6\x8c\xddʇCEK\xdb\xca\x18\xe7\r\x00X~\xf3ٓ\x8b\xf5-\xdaTAM\xc1\xec)\xd0\xd5\xea\xa6\xde>\x99h\tXiιE,S\x1a\xef睜'\x90}\x9dM?{y\x1c\xee\xcf5H\x13\x82[s\xa4.\x0e\xd5\x163\xb9\xa2\x95\xcbGv\xc7X\xaeM\xcf@c\xe7\x18_\x04\xe0\xfc\xb2l\xb1\x90a\xaa\x0f\x0e\xc7_՟źa\xa4͚(\x0e\x7f̚o\xfb\xb6\xd5\xcag\xe9UÁ\xda\x14\xd1\x0e\x00\x00\xb0i\x9a\xb3\xaaۑ&\xf9\xed\xfd\t\x00\xab$>\xf1\xc7b\xeeK5iB\n\xed\xaa\x98o\xdb\xc9ZU\xc6\xf2\x01\xc0\xc5\xd4\rr*,\xdd\x15.\xbdU\xb23\u07be\xb3n?v\xa3\x1b\xaf\xe8\xeeÆ\xba\xee\x1f;\xbd\xfd'\\\xf9\xc1\xc7\xdc`\xf77\xc87\xb7J\x93ˤzl\x8f\x8a=!Y\x96\xe6N\xb6\xe7\xc5\xf2jg\xe7\xe2@\xa1mU\xfbV͘\x81\x06\xc0F\xab\a*\xfc\\g\xb7\xee\xecO\x00+\xe4#\xa3xl\xfa\xb4|>\x94\xda\x14\xe0\x91i+\x9b\xa8\x98\x0e\xad,d\xd0\r\x00\x00\xc0\xba\x89١\x142mW\xb6\xff\xe9\xff\x8a\xd9U\xb1v£\xdf\x12\xb7\x1a\xdb)\xe6\xd2บ*,F\x0e\xfa\xdd\xee:\x80Ք;\xaf\xacٶD~Z\xcem\xe9\\q\xbc\xbf\x02\x00\xc0\xf2\x1aM?n\r/\xab\x8c\x0eJ+ìJڤ\x86X&\xd5C\xe5\xd9\v\xfd]\x00VQ\x8cQE\x9a\x90\xc2\xd2\xf6\xa48\xd4\xf8m?\xc7LKk*~\xea\x1dqt\xf6y\xa9\x1dJͮ\x82\xcfTT\xa5\xf2\xc6~\xff\xa9\xff\xa1M\x13n\xcd\xe5}Pny\xbc\x9b3\xfe\x13\xd8h)\x9fh\xa2B1S\x1cT\xaa\x94)\x84\xe3\x92;feF\x90\x9f\x9f\xd6ď4\xbfꛥ\x9b\xbf\x9b\xb2cM\x11Ⱦ.\xdaЍ3O3\x18-\xb8\x97\xed\x03\x00\x00l\x90\xacPȢ\x06\xe5u\xfd\t\x00\xab\xa4n\x9eO=\xdarѾ\\eM\x9b\xb4\xe3\xe5\xba\xc0v\x00\x17M̥\xe0l\x8b\x8a!,fGN\xe9\xcf[z\xf4Nyqe\x7f#6\xdem?\xe4\xf4\x96\xaf\xd3\xf4\xb2\xab4ͣ\xe6U\xa1\xac*\x95\xf9\xb9=/\aj\xc3\xe9\xee6?\xb8L\x85F\x8a{\a\xdd1\x80\r\xd5-\xa7\x91\xfa\xady\x15\x81ղ\xf7\xc0o\"p\t\x00\x00`\xc3t\xfda\xdd\xd2Q\x99\x9a\xec\x9e\xc5I`\x8dT\xf5\v\xf6\x9c\xa7>\xe0L!\x1eZ[ݚ=i\x91<\x9fF\x97\x03XUi\xa1Ke}7F\x1eT\x16\xd7.\xf6\x01\x00\x00\x80\xa3\x90ښ\xb2\xb6\xa7/l#\x9ei]M\xef\xffͱ\x9a?&\xb5\xdb\xd6\x0eٕ\xcas\x8a\xd9^\x7f\x15\x00^\xc1\xe0Y\xf9\xbc\x90oN)ԥ\x95\x14s\xdbҸ\xc2C+/*\xcd\a\xd2h\xeb\xab4\xb8\xee{(<\xd6\x18\xa3\xc7\xd6Dlӯ\xd2\xd2j\xb7\b\xbb\xedw\x81\x1f\xfcz\x01\x00\xc0\x06J+9\xbbV\xa3\xe1{\xfb\x13\x00VI=\x7fDY\xf0\xf2!\xef\x06R)\r\xa0j\x87r}\x10\x14\x80\x8b\xc3)\xcdt\x9e/\x06.\xa6QO)\xcdYyjG\xdd\xf5|t[\xf7\rt\xae\xfa\vntۧ\\q\xc57h\xb6u̞\x953R\xb3\xad؞T\x9bIU~\xce\x1e\x1d\xcbÛ\\E\x9a \x01\xc0\xe6JeJ\xf4ʳq\x7f\x02X~\xf5\x13\xbf.\x8e\x9f\xf9\xa9\xfe\b\x00\x00\x00\x9b\"Ʃ}\x8cloKmx`q\x12X#\xd5\xf4Q\x85\xd4y\x97VJӁ2om\xf6&\x972\x02فU\x16c\xb0t\x9cޣ\x16\x8a\xf6\xbd5`\x9c\x04\x00\x00\x00\x8e\x92\xb51c\nj*\x15}\xeag\xc1\xba\x99<\xf6\xbb\xe2\xf4\xe0\xdfد\xfa\xc0~\xd7[R>\x95\x06\xfbi\x11~\x008\xbf\xdc6+\x1e\x82e\x16\xbe\x8d*\xb2L.k\xbbq\xe2\xa9؈\xc7>,wۿf\xa0\xe1\x9a#\xd2yM\xb8\xda\xd2j\xb7\x02{Z5-}{;$\xfd\x02\x00\x80\r\x14\xa5\xd6\xe5\xf2W\xfd%*C\xc0\nj\xe7\x0fwc-\x9c\xc6jS\xf7f\x1a@\x15\v&h\x05.6Kg\n\xa9\x9b\xc8˥U\xd8S\x9a\xeb\x82\x0f\x17\xdb\xe8\xc6\x7fD*\xc4\x17ȯ\xff\xfb\xeeػ\x9ft\xda~\xbb\xea\xfcr5M\xa92l\xab\x8c^\xb1\xd9SL\x01\xeeC{\xb6\x00l.\x17\x14\xa2\x93϶\xfa\x13\xc0r\x9b?\xf7\xdfE=\xff3\xca\xdb\xfd\xfe\f\x00\x00\x006\x86\xab\xed㔔UR=չ\ao\x8f\x8b\v\xc0z\b\xcdS\xf2\xae\xb4g\xdd6\x939)\x86\x81\xed\xa4g\x1f\xc0\xea\xea^\xacZ\x82\xceT\xd9\xf7\xe8\xba\xef\xe6}\x0e\x00`\xa9M\x1e\xf8\x13\xb4\xb5\x80u\xd6-\x9a\x91\xc6\x1feVS\xbd\xb2;\x855\xf2\xd0\x7f\x1d\x9b3?\xa5a#\r\xb2\x1d\xfb5\x1fد\xfb\xdc\xe2Z}|\xf1\r\x00\xaf\xa0mv\xd4j\xaa\xa8\x17\xe4\xd2\x02gY\xae\xe0*\xcdU\xaaͯ\xd5\xf0\xd6\xffD\x7f\xc6\x06 \x90}\rT\xf7\x7f}L\xab\x15\xa6\xca^\xd71\x99z&S\x13/\xd5\x01\x01\x00\x006M\bj\xddv\x7f\x00`\xd5\xf8\xe6y\xb9\xb4r@H\xed\x1b\xe3*\xfb\bL\xd4\x05\\l\xce\xd2\\Jg.ڮ_t1Ĩ\xd8:Ů\xcf\x01x\x15w\xdc\xedt\xeaר\x1a=\xafXO\xa4ꤜ/\x14\x8a\xa8\x03{\xa6\x00l\xb0.\x0f\xc8Td\xbb\x8bc`\xc9e/\xfcm\x15\x87V\x11\x1a\xf2\xfa\f\x00\x00`Ӥ\xa0^\xe5Q!\xcb\xe4\xd3\xea\xec\xd3\xcf,.\x00k\xa2\b{r\xa9\x1f\xd8;\xb9\xae\xc9c\xdf\xd1/&\x15\x06\xb0\xba\\\xf3\xe2\x8e\xea8\xee\xf7\x01\x00X^\xae\xba\xbb\xdf\x03\xb0\x8e\x16c\xfcj\x05\xd7Z\xf3\xf3\xce\xc5I\xac\x85\xc9\xe9?\x17\xebs\xffJ\xc3\xf99\x8d\xd3d\x90>\x8d';TLqk\xed@\nLn\x0f\xe0\xfc\xda6\xe5\x11a\xb1\xa8Y\x1c\xdav\xa8ìU;\xbaY\xf9\x9d\x8f3@|C0\x12g\r4\xf5/Z\x02N\xab[y\x85\xd8(:\xdbO/\x1e\x00\x00\x006\x91k\xe4sfr\x04VU\x11\xe7݄\x14\xa9\xc3b\xb1z\x80}g3\xa9]\xac\x10\x02\xe0bIi\xae\x95\xbc}\xfb\xc5\xccx1\xad\xd0\x1e\arL\x10\x83נ\xb8\xe1\x1f\xbb\xad˾Y\xf5\xf6\x96\xda\xecy{v\x86\x8a\xd1\xead]\x9f\x15\x80\xcde\xe5J̕\xe5\xa3\xfe\x18X^\xf5=7\xc6\xfc9kw\xe4\x87V/\xda\xe9\xcf\x02\x00\x00`c\x84\x815_\x9eW\f\xdbʝ4\x9aع\x87\xbf\x9d\x19\xfa\xb0\x1e\x1e\xff\xefc\x99&\x9b\xeb\x02\n\xaa\xc5\xc4\r\xa9\xff\xd7\xd5\n\x91\xe1\x83\xc0JK\xefv\xdaB1\xabl\xffT\x7f\x12\x00\x80\xe5\x95\xd7\xf7\xf5{\x00\xd6Q7\xd6(k\xd4\xfa\x99\xb6\xde\xfe\x03\x04&\xae\x91\xea\xf9\xbf\xa3\xbc:\xa72\xf5#t\x13\xda冀}\x17OJ\xe1\x842\xbf\xb7\xb8\x11\x00^A\xc8\xceɻ\x91\xe5\x19\xa7\xa4v\xacY#5\xa5T\x9e\xf8\x8a\xfe\x0el\x02z\xa2\xd7@h﷊\x80\xa5\xde\x18\xad\"\x90fش_\xab\xe7W\v\x00\x006\x94o4\x18^\xd7\x1f\x00X%\xf1\xc1o\x8ceזi\xad\x8d3\xb3\x96MZ5 \xa6\xb7X\xdd B\x00\x17\x91\xb3t&\xdb\xd2\xca\x1di\xaa\xdc\x10\x14c&\xe7-\x1d\x96\xc7\x17\xf7\x00\xbf\x92\xeb\xfe\x9e+\xae\xfeo5?\xfe\x16M\xbc\xb3jY\xd0v\xe4\xbd$\xb0\xd9Z\xab\xcdy\xb9\x8c@v,\xb7\xe9\x83_\x12\xf3\x83'$\x9fV'\x94\xe6\xa9M\x02\x00\x00\x80\xcd\x12\a\n~\xa6\xd6U\xdd\\\x8fe\xd8V\xd8\xfb\xbf\xfa\x8b\xc0j\x8b\xfb\x9fT\xa1\xbc\xeb\xf7mu\xb8X\xf9(\xd8Gz\xe6#\x8b\xa5\x00\xab,\xa6>\x8cv W\xb6\xca\xf3\xab\xfa\xb3\x00\x00,/\xdf<\xde\xef\x01XO\xd6\xf6̬\x8e\x9a\xcf\xfbc\xac\x83\xe6\xae_\x1b\xb7\xf6N˩\xb4߯\x9d\x88\a\xaab+W\f$7\xb26I#\xef\x0e\x167\x03\xc0+pn*\x17҂8)\x13\xb1\xfd\xec*\xed\x1e\xfb\xbd*\xaf\xfd[\f.\xdc D;\xaf\x81\x90V(tV\xe1\x8bN!\xa4@\xf6\xc4~\xb5̘\v\x00\x006Q\x1eU\x96'\xfb\x03\x00\xab\xa4\x9e?+\xd7uR$\xed\xa2\xe33Ʈ\xdfµ\xac\xe8\v\\\\)\x88\xbd\xb54\x97\x82\xb6\xa2BH+要\x8c\x03+[Y\x91\x14\xaf\x9d\xbb\xfc\xbb\xdc\xf8m\x8f\xba0ޖs\xcfI\x15/\xaa\x00Xy\x92&b\x05\x96T\xfb\xc4o\x8ez\xfe.+\xb7\xac\xe1\xb1=U\x1d\x87V\xff\x99\xf6W\x01\x00\x00\xb01\xa2\xef\xa63\n\xd9\x19i~\xad\xe4[M\x0f\x1eX\\\x03V\\5}\xb2{\xc6S {P\x94K\xc1\xebi\xf1\xb44\xb4,\xf2\xfe\x05Xu1X\x9a\xb6\xa4\xec\xf3c\xfd\x19\x00\x00\x96W\b\x87\xfd\x1e\x80uԭ\xc8n\x7fRx\x13\xd6ù\xcf\xfe\xaaX\xed\xff\x94\x8a\xe6\xca\xf4\v\xb63\xb5\xd2t\xf6\xce~\xc9~`\xbf\xec\xfc\xb4\x82\xce\xda5~\xe9\x00^E\xea\x9bL\x1d\xf0z\xc1\xb2\x8b\x03\r\xcaw)\xbb\xf6\x1f\x11ľa\\LA\x01X]\x0f\xfe\xbeX\x9f\xfd\xa8\xea\xf6n\r\aA\xae\x19(\xcc\ve\xdeRw6\xb5\x84>\xeco\x04\x00\x00\xd8\f\xb5\x9b\xa9\xf8\x00K\x7f\x02+\xe9##\x1a\xa8\xc0\x91I\xbd\x84\xa5\x1a\x1f\x15|\xae\xd2\xedIնf\xd9L\xc3\xf7ה\xabxC\xe6\xf7\xbe7\xea\xec/i\u0bd4\xfcD\x8d\xdb\xef\x16z\xcaT(kGR7`֞=_\xf5\x7f\x03\xc0\xda\tCU\xf9Y\x95留\x86\xe5\x14\x1f\xff\x93qz\xee\xbb5\x9e\xef[\xc1u\xb2\x9bwAš*ߨl\b\xe6\x00\xf0F\xa5\x88\xb04\x98˸\xfe;E\x8aY{+i㶝\xb7zp\x9c[\xdd8}\xdb\xc9\x14x\x12K\xbb\x98\x06\xf9\xf5+դ\t6\xba\xe5RӨ\xbfŖ\x02\xcf|e\xed\xb5\x14\xa5\xd2M\x14c\x9b\xdd\x13\xb2\xc6\xfej\x9a\xa0\xacV\xde4v\x97\x9dwǬ}w\x99|q\x95|iu\xf2\xc2\xf2\xb9lK\xba\xfe/\xbd\xeer\xb9}\xfc\xcf\xc4\x18&\xf6\xff\xb1V\x88\xffQmS)\xa6I\xabl\xf3\xcd\xc4\xfe\xdfT\xca\xd2\x7fk\xfa7\xa7\xe6e\xe2\xec\xc0ڗ\xdd\x7f\x87]\bm\x9a4\xcdvG3۷\xff\xe46\xfd7\x0f\x94\xd9\x7f\xb7\xb3\x7f\x83K\xf7\x9blj\x17\xf3ƶ\x99\xfd}\xfb߳\x7fo7\xcfa\x17d\x97&w\xa7\xfb\b\xc0E\x12fj\xeb\x13j\x8fM-o\xb3\xfctz\x85\x9a\xf2\xb4\x0ew\xbfH\xc7\xde\xf6\x93\xaf;\xef\x04\x96\n\xef_\x805\xb6c\xd5\xed甪\xd7\xd9{\xe9\x83\x03\x00,\xb9\xc7\x7fw\xd4s\xff\\jR\x7f\x11\x80\xb54\xb3\xe6\xe71\xafg\xb3]]q\xc7\xd3\xd4OW\xdd}_\x11\xb5\xff\xe3\xdd{\x8c\xda_\xa3\xa2=\xdd_\x00\x80\xcf\x17\xb3\xb2{\x7f\xe8b\xad,\xb7\xec?m!M}\x91\xde\x15\x16*\x9aC;ضbb_\xf3\x93\xefӱ\xdb?B\x19\xb1\x81\bd_u\xf7\xfc\xc6X\x1dޣV\x8fjP\x06\xf9f\xacX\x97r\xaeR\x1a L ;\x00\x00\xd84\x8d\xf7\xca\xdf\x7fH\xe3\x06XE\f\xa4\x02\x8e\x8e\xab\xa5v[\xa1\xa8TǠ\x81\x9b[\xa1\xba\xad\x89\xaf5\xfe\xc0\x8cr\x15o\xd8\xe1\xc7\xde\x1eG\xf5\xbdrږ\xcb\v\xc5l\xa2д\xca\xc2Ȟ\xbb\x14\xe0\xb3o\x1b+5\x03k\xab)U\x0f\xf6T\xbc\x8fA\xb4XN\xd3\xcf\xfc\x86\x18\xe6?\xa6q\x8a\xfd\xac\xfa\xb2)\x0f\x8b\xc1\xdf\r\xcd\x13\x00\x17\xc0\xcb\x03\xd9_\x94\x02\xb6Ӫ\x03)0;拐\xf7\xac\xb5Ss\x05תpc;\x9f\x02\xdcmk\xed|\x1b亢4\r\xf0\xcd4\xdd\xf2\x8av-ż\xcbo\xab\xc8ޢA\xf1\x0e\xb9\x9b\xfe\xf7\xa5(oۻ\xde\x19\xdbf\xa6\xa6\x9e\xd8\x7f\xfeT\xb9\xab\xec\xffc\x932V\xfbo\xb7\x9f\xc3\xe4-v\x97\xed\xa7\x80}7\xb5\xf3\x95}۱IC\x17b\xfa\xef\xf4\xce~L\x8b\x9fY\x17\xfc\x1e\xbc\xb24\x11V\xf73x1R\x1e\x00.\xb4\x99BsJq{\xaaXU\xca\xe7'\x14\xcb\xe7\xb4?~\xa7v\xdf\xf1)\xda4Xm\xbc\x7f\x01\xd6W\x1c\xd9Ǟ*k^\x94\xefm(\xaf\x00\x00Km\xfe\xe0W\xc7\xc1\xe9\x14\x10\x99:\xb6\x00\xac\xa5:\x97vj\x9d\x1b\xbeG\xc7n\xfd9\xea\xa7+\xacy\xe4\x8f\xc4\xf6\x85\x1fTY?!\xe7\x83\x1a\x97)\xef\xfa\xe9\x01\xe0\vE_(\xc6Z.\xb6r\xcal߶`\xe7\xd3k?\xcbC\x9ckT\u05f5\x9a\xd1;4\xba\xf3Ӕ\x0f\x1b\x8aV\xc0\xaa\x9b?\xa8\xa8\xb3\xdd\f\xf7.\xcdԯ\\.\xb3\xef\xb4\xcbo\x17\x00\x00l\xa0X\xa4\x81\x90\x00\x00\xe0u\xf1A\ne\xf7\xe2!\xa6 \xf6\x14P\x91V\xfb\xf3;\xfd\r\xc0\x1b\xb3\xf5\xbe{\x9c\xcaRMZ1R\x039\xdb\xfc\x8b\xf1\xac)\x90%={\x00\xd6Xz\x19\xc5\xcbl,\xa7\xea\x97~u\x8c\x93\x8f(\x8f]\xb5\xc7ʥ\xba\xaf\a9\xf9v\xb0\xb8\t\x00ެ\x97\x06\xe5\xbaE\x90\xb6m\xa1\xaa\x14CZm|*\x15s\xf9\xa2\x96\xf7Ѫƹ|]h:\t\x9aWQ\xf3`[.\xcd\x06\x99fÑ\xaa\xf1I\xd5\xdbWHW}\xab\xc6wN\xdc\xce;m{ǳnx\xdb/\xbae\tbO\xb2w\xde\xe5\xca\xf7\xdc\xef\xc6\x1f|ҍ\xbe\xe8\x8c+N}\xbd\xaa\xd1{5\xd1eگ\xed\xfff\xfe\x82\xdd\xf4\xac\xddi\xdfq\"5\xd6.H\xe7\xeb\x91\\\xb5\xabC\xb5\x9a\xda\x0f\xaajK\xb5\xedخo-\xf2\xe5\xeeg\xb9\bx\a\x80\x8b²\xa2h\xf9\xb1\xb3\xfcf1\x81H\x93N\xa9\xa8\x1e\xef.\x03\x00\xb0\x8c\xd2 \xf14AV\xebO\xf6g\x00\x00X^\U000d9d6fj\x02\x1c\x80\xb5\x96g\xaac\xa3\xf1\xf8\xfd\xfd\t\xac\xa4\xa7\xbe-\xce\xce\xfc\x03\xc5\xeay\xc9\x1fWtAy\xb0\xb6\a\x00\x9c\x87k\xa3|\x9a\xa4:sJ\xa1\xecm\x1a\x8b\x1aKe)\xb0=N%\xcbC\xe6\xa3]\x82\xd87\x1c-\x81\x15״OZB?P\x96~\x93\xc1)X\xc2\a\x00\x00\xd8dM\xf1\xb6~\x0f\xc0*\x99=\xf4\xa7i\xcc\x00Gɧ\x88\x8a\xbc[\xf1\xaeK\x8cq+}ةk\xd2\x11\xf0\xa6\xf8ݯV(\x8fi\x1e\x9e\x93\xc2\\.\x0e$\xd7-}+\x16\xbb\x05\xd6\\\x8a\xd6\xf3\xdb\xfd\x01\xb0<ꇾ>\x16\xd5\xcfh\x1c\xceh\x90\x02#\xdd\xd0\xea=\x99\\\xe6l\xdfɥ%\xd9\x01\xe0\x8dJ\xab\xb0\xbf\xb4\x12\xbb\xb1|&\x86.:Ҿ-\xaf)\xaf\x94\xcbw\xed\x9eƎ'\x96)\x1dH\xf3}\xab\x1e\x1f*\xab\x83\x86\xf9LŠ\x96\xdf*\x14vn\x92.\xfb\x1d\x1a\xbegϕw>\xe9\x8aw>\xe4F\x97\xff/\xab5\xc0\xe3\xe6\xefq\xe5;~\u038d\xdf\xf3\x8c\xdb\xf9@\xeb\xea\xdd\x0fk\xb6\xfd^M\xc7ש\x1a\x8c\x14\n\xfb\xcf\xf1\xb9\xfd|\nk/\fU\xb6\x03\x95\xcdP\x83f\xacܶ,\x0e-kNyujC\xcc\xfb\x7f)\x00\\\x04\xd6|I}ci\x11\tצz\xe1bpn^\x9fU\xf8\xd4W҃\x81\x95u\xf0\xe0\xb7\xf2\xfc\x02k,\r\x0f\x0fi\xa5\xb3\xec\xad\xfd\x19\x00\x00\x96W[=m\x9f\xf9\xe2\x00\xc0zJ\x93\x94\x06\xaf\xe2\xda\xef^\xad~l|\x9e\xea\x99\xff]\xa3\xe9T\xc32\x97+\xab\xd4\xf0\x90\xc2xq\x11\x00^Iz5\xda\xf7\xb1˷\xca\xf2V\xaehm\x7f\xd6]\x9e\xe9\xa4F\xc7\x7fO\xb7\x8f\xcdE \xfb\xaa\v\a\xca}\xd3\xfd\"C7&\xc2\x12|h\xba\n \xbf^\x00\x00\xb0\x91\x06w\xf4;\x00V\x89\xab\xee\xe9\xf7\x00\x1c\x89\xb4ʔK+ۅEoBH\x81\xc6Q\xf9\xe0\xf6t\x04\xbc97\xff\xa0\xf3\xbb\xef\xd04oU\xb5)\xf0$W\x9a<!\xaaV\xe8&M\x00\xb0\xaeB\n\x02\xf1;\xfd\x11\xb0<\xeas\xfft\xb1\n{\xaa\xf3ԧ\x14\x9b\xa1\xd5}2)K\x01\xecv\xc1U\xdd}\x00\xf0\xa6\xbc\x14\xcc\xee\xe5R\x1ec\xf5\xe0\xf4\xed\x9a=\xa9\xa9\xa5v\xdbnٲ\xf2rG\xa1<\xa5\xb0u\x95t\xec\n\xb9\xcb\xff\x84\xfc\xbb+Wܾ\xefF7\xdf\xebF\xd7}\xffZ\r\xf8+n\xfb\xf7n\xf8\x8e_p\xa3w=\xe6\xea\xe3_\xa7\xc9\xf8\x9d\x9a\x0eƪ\x8b\xa9BqZ\x03?W\x91V\xabw\xe7\xfa\xcd~^)\x80\xdd~\n\x8dc\xa03\x80\x8b'\r\xb3I}\x15\xb1\xb5vLW7l\xbb\xbc\xa7H\x83\xef\xe6\x9f\xea\xef\x02V\x8f\xaf\xee\xee\xf7\x00\xac%+\xb2Z\xe7\x94\r\xee\xecO\x00\x00\xb0\xbc\xb2\xb0o\x15T\xe2\x1b\x80\xb5\x16\xe6\xaa#\x01ϫlz\xcf\a\xa2\x0e\x9fV\x96&\xa0ͦR\xac\xe5\xc2\xf1\xfe*\x00\x9cGz\x9b\x99Ơ\x86`սJ.\xb7z\x9fm\xc1\xaa~i\xca\xd8\xe2\xf8\xd7*\xbb\xfeo\xaf\xd5;O\xbc~\xb4\x04V\\\x96\x06\x02\xba\\.\xad\x9a\x96\xc6B\xb8VQ\x8dژ\xa2\xda\xcb\xc5M\x00\x00\x00\x1bdt\xd3_\xa4\x91\x03\xac\xa0\xac\xfaL\xbf\a\xe0(\xc4Xv/\x1fb\xeaHL'\xbaN\x86V\xa3\xdb\xfeO\xcaU\\\x10\xc5\xcd?\xe4\xdc\xe8&\xb5n,\xf9\xf4|5\xf6\xdc9E\r\x167\x00XO)\x1e8cEv,\x97\xe9ݗ\xc7q\x9a\v8m\xed\x8ebU(\xd4i\x82\xe0ֶ\\!]\xc8\bd\a\xf0f\xbc\x98\xc9\xd8g7\xf9\xb8I\x01\xec>\xb7\xcd\xda^\xb3Q7b#\xc6\x03\xcd\n˗\x8eݪ\xe6\xaa\xdf'\x7f\xe7SNw<\xeat\xc3\xffwc\xdaa[7|\xaf۾\xfd\x93nt\xe7Yמ\xf8\xad:\xbbu\xad*?Tc?\xb7\x98&oogRH+5̻U&\x9d\xa8W\x00\xb8xRoEL\xb5\xc16\xc89ˊ_\x9c\x90$\xe5\xca\xf1y\xd5\xcf\xfc\x99>S\aVKQ?\xdc\xef\x01XON!k4\xb8\xf5\x1f\xf2>\a\x00\xb0\xd4\xc2S\xdf\x1e\xf3\xd4\xceJ\xed-\x00\xeb+MJ\x9a]\xd1\x1f`\xd5\xcc?sK\x8c\a\xf7\xa8\xf0'$?\x92\x9aJq\x9eY&~ʎ'\xfd]\x00\xf0\n\xb2\xda\xf2\n\xab\xe7\xd5E\u05f5\xdeƨY\xdaܕj\x8b\x0f*\xbb\xe5\x7f\xa5\x12\b\x02\xd9W\xd9\xde\xe3\x7f \xba4м\xcdmK3\xf9;\xb9<Z\xbao\xba\xd5\xd9Ӏ+\x00\x00\x00\x00X\x05\xae~\xac\xdf\x03p\x14b;\x94\xb2\x03\xc5\xe0\x94idg\xe6j}Z\xa1\x1d\xb8pv\x8e\xfd\x1e\xf9\xfc-\x8b\x97[.\r\f/\xe5\b\x14\x04\xd6[\x96\"ٷ\xfa\x03\xe0赟\xf9\x8a\xa8\xc3\xe7\xad\xf2s\x99bZ\x92\xdd{Eoe\x91Ճ\x9c\xb3\xfaO۪\xb1-\xe6\xf3\xfeo\x00\xc0\x1b\xd0\x0f\xc6}1\x88=M\xe0ԭ@Э^R\xe8\xec־f\xc7o\x93\xbb\xea\x8fk\xf8\x9e\x03\xb7\xf5\xb6_t\xe55\x7fu\xe3\ao\fo\xfa\x17\xee\xe4\x1d\x0f\xbbx\xd9\x1fU{\xe2wk\xb6\xf5\x01U\xe515\xf6c\xeb^}\xa76\x84g\x8c\v\x80\x8b'Sa[Z\x85=v\xf9MH+܆Ԟ\x19\xcb\xf9V\xf5\xb9\x1f_\xdc\b\xac\x18W?\xdb\xef\x01XK\xd6\xeehR\xdf\x06\x00\x00Kn2\xfb\xb8\xf2\x98\x82!)\xb7\x80\xb5fɼ(\xae\xed\x0f\xb0Jڇ\xbe1\x0e\xce<\xa0Qz\x9fQ\f\x14\\k'\xd5\xcd\xf1(wFMF|\x1a\x80Wэ\xb1H\x9d\xea\xdbV\xdf[,\xd6ܸ\x91\xfc֗i\xf8\xee_\xe0\x05\x1f:\x04\xb2\xaf\xb0\xbaz\xdc\x12x\xb1\xd8B&\xd7\r\xba\xb2\xb4\x9d^⧁\x11\xa9\xb1\a\x00\x00\x00\x00+\xa0m\xf6\xfb=\x00G\xa2[\x91ݾӊ\xecn \xb9V!\xbd\x8d\x00. \x7f\xf5\x9fsy~\x9d\xed\xa4Uo\xbd|(\x941c3\xb0\xde\xd2\xe4\xab*\xfa\x03\xe0h5\x0f\x7fSl\xf6\x7f\\\xa3\xf6\x98\x1dd\xaa\xb3L\xb1\br\xe5\\\x99U\x85\xba\xbaP\x1b\xac:\x14\x16\xfb\x00\xf0F\xa5\x91\x19\xbd.\x88=q\x96\xb1\xa4\xcd{\x1d\xff@\xe5\x86w|\xdc\xe9\xfa\xbf\u00a0\x8dW0\xb8\xfe\xbb\xdc\xe0\x96\x7f\xe2F\xef\xfa\x88\xcbw\xbf\xd8N\\a\xf9u\x9ax\xa4\xb6v\xeaA\x7f\x17\x00\\\x04>\xef\x16\x90\xc8\xd2\xf0\\\xef\xd4\xd8w\x15\n\xb5\xda\xea\xae5\x87w\xf77\x02\xabŇ\xba\xdf\x03\xb0\x96\xd2jgZL\xa2\x05\x00\xc02\xab\xab'䣷b\x8b\t\xf5\x81\xb5\x96Iyq\xa2?\xc0\xaa\xa8\x1e\xfb\xc3q\xef\xf9\x7f\xae\xb4Ȫ\xf3\xa5\xa6\xd9\v\x9a\xa4v\x86\xbf\xce~\xa1\x13\xfb\xbd\x9e\xb6\xf3\x97\xf5w\x03\xc0\x17J\x13\xc3v\x85\x80\x1b\xdb\xf7\xb8\x9b\x04#\xe5\x1b÷\xfd3އ\xe2%\x04\xb2\xaf\xb0\xe1\xe4\xc7-\x8d\xa7)nj\xfbM\xa6\xc0uK\xdbuT\x163\x95i\xa5\x9b\xc0\x8a!\x00\x00`\xfdt\x83?\xb3\x99\xd4\xee(\xa4x\x88\xc2\xf6\xe7)\xd0n\xa8\xc9\xf8\xbd\xdd=\x00V̳\xbf/\x96y\u05cb\x01\xe0\x88x\xd7h\x1eS\xec֮4\x9dH\xf5\xa1\xaa\xf1\x8d\xfdU\xe0\xc2\xc9\xde\xf9\xef\xdd$\xc5\xf5ԥ\xdc\xe8@\xdd>\x80\xb5\xe5\xb5/\xf7\xf6\xff\xc4K),\x87\xe7\xff\x99\x06i|ܨ\xd0a\xf6\x8c\xca&\x93k\x0e\xe4RPG=\x94\xe6Ce\x99\xd30wrS;\x06\xb0\xb1^\\I\xbd[Y\xfd\xe5\x9bI\xd7fYT\xe3잔\x7f\x84\x99\x9d\xb5\xccŕ\xaa\xb3\xa1\xe5/\xb94\xd9^\f\xf6\xca\x1aՃ\xb9\xcem]\xa1\xbd˿Az\xef3N\xef~\x8ar\xf1u\xf0\xb7\xfe[\x97\xbf\xeb\x19\x97\xed~\xa32\x9dR>M?\xf3\x14\x8c7S\xc8\xe6֬\xf0\xaa\xcaJ\xb5O\xfd\xc5v\xba:\xf6+\xfe\xfe\x00\xe0\xbc^\xca#\x1a\xab'6*-_\x19Y\xfe\x92Y\xbb&M\x84\xb4[\x9fU\xbc\xff\x8f\x91\x91`\xa5\xcc\xef\xff\x9a\xe8\x19\x1d\b\xac\xb78\xb3vȻ\xfb\x03\x00\x00\x96\xd7v}\xb7\xf2|\xa26\x1b\xf5g\x00\xac\xa34_\xf4\xe0\x96\x7fM?\xf8\ni\xee\xff\xe3\xb1y\xfao\xe9D\xac\xa52\xb76\xc6T\xe3&\xd3v\xea+\x8b\xcf\xdb/\xb5\xb4m\xa8\xac9\xdb\xff\r\x00\x9b\xe9e\x9d\x8ciR\xefnke\x99C\xb7\xb51S\xed\xe7V\b<\xae\x18\x0e5\x8d\x97k\xeb\xceG)\x0f\xf0y\xe8\xaa^a.\xf0~\f\x00\x00l\x9e\xe8\xadM\x93\x02\x9e|\x1a\xb0\x98\x94R\x1e\xbb\x81\x8b\x83\xc1[\xfas\x00VI;\x7fAj\xe8\xaf\x00\x8eTl\xbbq\xfd>\xcd)\x91\xcaZ\xdb\xc9\xcb\xcb\x17׀\vl\xbc\xfd\xb5\x92\x9bI\xd5@\x19\xdd[\xc0z\xa3\x8a\x87%qx\xcf\x1d1ŜJ\xd7H\xd3Y\xeaI\x00\x80\xf3J\xab\xf1~\x81\x18_\n\x82\x1e\xb6\x85\xb2\xacP\x18\x9eT\x18\\\xadP\xe4ֆ\xdaSќ\xd5ּ\xd2\xfe\xf8\xac\x0eG\x95\xea\x9d;5xOp\xc7\xefx\xd0\xed\xbe\xf5oS*\xbe\x197}\xafs\xef}\xce\xf9\xab\xbfUU1м\xbe^qv\xcc~\xe6\x13\x95Վ\x8a\xfaZŁ\x97\x8e?\xd3\xdd\xde\xfd\xae^\nH\xfd\x9cW\xfc\xdd\x02\xc0k\x15\x9c\x1a\xf7\xa9\xfe\x00X\rM}f1\xd9\v\x80\xf5\x95\x95\xda\x1a_\xdb\x1f\x00\x00\xb0\xc4R\xbd4\x8d\xf9K\x01O\x00\xd6V\xf0iu*\xac\x94\xc9\x0f\xa8\xac\xadM1d\x00\x0f\x80W\x93*r\xaf \xb8E\xdfy\x9d\xa9H\x8b4O\aj3\xaf\xc1\xa9\xdf\xd5\xdf\x00|\x0e\x81\xec+\xcc7\xe7\xc9\x04\x00\x00\x00\xd6]k\xd5X_)\x84\xd6\x1a?\xc7l\xbfU\xe3*e\xb7\xfc\x9f\x8cD\x04V\xd0l\xfa\x98\xa5kVd\a\x8eV\x94\xf7\x99\xb2\x14Ğ\xa2\xbc\xb2\x91\xb2\xf2\xe6\xfe\x1ap\x81\xbdퟻi\xb9\xad\xaa\xf6\x1a*\xefO\x02XG-ﺱ\x04\x9a\a~K\f\xe7\xeeVf\xf5\x1d\xb9\xb1\xda։!4\x00^\xd5\xcbV\xf0\xfe\xe5R\x80t\x1c\x1d\xa8\xae\vՇ\x03\x85\xf6\xc0\x9aP\x95e6\x03)lY[ʾ.\xfb2m\xddٸ\xe2\xd6_\xa2\xaf\xf2B\xbb\xf1\xef\xbb\xf2}\x87\xce_}\xa3ڭ\x03i6\x96\xecǯln\xb9|\xaeC\xfb\x89\x9fw\xd5\xf5W\xf9\xbd\x02\xc0k\x12\a\xaaۏ\xf5\a\xc0j\xa8\xeb'\bd\a\xd6\xde@㝷\xf5\xfb\x00\x00,/\xd7\x0e\xfb\xb1A\xcd\xe2\x04\x80\xb5\x14\x8b+\xfa=\xac\x82\xfa\xaeS1?x@yq\xa8\x037\xe8\xcf\x02\xc0\xaf \xbe\x18\x8e\xec\xbay\xa5\xd36\x90\xd5\xf5\xeaV*\xa6ʷ\xbeNٍ\x7f\x93\x97r\xf8\x02\x04\xb2\xaf\xb0\xac\xe5M\x03\x00\x00\xd8@i\xb6\xae4\"\xd4\a\xc5\x10\xa40\xe8\xceU)\xf0\x0e\xc0Jj\xaa\xa7\xa4H #p\xb4Ze\xb1X\xf4\x14\x85V\xd1m)\xbf\xee\xff\xa0p\xc5E\x13\xb7?\xac:\x9b\xda#G(!\xb0\xce\x1a\x8d\xfa=\xe0hTO|K\xd4\u07bfюK\xe5M\xa9\xe0\x9f\x91\x1fdJ\x01\xed\x00\xf0\x9a\xbd,0:\xad\xe8]\x05k:\xf93\x1a\xe8\xb4\U000895db\x05\xcd\xcbJ\xf5e\xbfN\xfa\xd0\xdc\x1d\xbb\xfe'hK]dō\xff\x8f+N}\xbd\xc2)\xfbe\x143\xa9>\x94\v{\x1aN\xc7\xdd\xef\xe8%\xe7\vj\a\x807\xc2[\xb62\x7f^\xf3G\xffG2\x17\xac\x8e\xf6Y{p\x19\x88\x0e\xac\xb36\x8e\xe4\xae\xfc\xeb\xb4A\x00\x00Kmr\xef\xd7\xc4,\xa4@\xf6\\\x91@v`\xad\xd5\xc5\r\xfd\x1e\x96\xdd\xde=\xb7\xc489\xbd\x18+\x96\x9fU\xd6\x1c_\\\x00\x80W\xe2\xc2b{Q\xf4\x8ai5\xf6\xee;\x93\x8f\xa5\xeaT\xcf;\xf1N\xe9\xed\x8c;\xc5+#\x90}E\xd5\xf7\xfd\x17\xb1 \x8e\x1d\x00\x00l \x97V\x89\xed\x1a>E\xb7\xbaN\x8c\x95\x82\x9dj\xfc\xc9\xfe\x0e\x00\xabƇ})cEv\xe0H\xb9`e\xaa\xb72\xb6V\f\xad*\xbf\xd3_\x00.\x8e\xf1\xad?\xe6\xda\x14\xdfJ\xff\x16\xb0\xd6ڌ\xf2\x04Gk~\xe6\xdf+\x9bG\xab뜴zN\xa1\xe0\xf7\xe5R\xc0\xe3\xcbޯ\x02\xc0\xab\xfaeA\xeci\x1b\xc4m\xe5Ma'&\xd2\xf1s\xd2U\xef\xd7\xe0=\xc1\x15\xb7\xfc \x832.!w\xdd?v\xfe\xf6\xa9\xab\xaex\xbb\xea\xed 7\xdfQVO^\xfa=\xbd\x84`v\x00\x17JV\xc9OǊ\xcd\x0f\xf4'\x80\xe5W\xa4\xfa\x8a'\x90\x1dXg\x13&\x92\x04\x00\xac\x80\x10?)\xa7\xb4\xc0\x05\x93\x9c\x03\xeb.\x94\xef\xe8\xf7\xb0\xd4>\xfb\xebby\ue074\xa4\x98\xa25)BsL\xa3\xe6\xdc\xe2\x1a\x00\xbc\x9a\x97\x82ٽ\x9cK\xb9H\xbe\xf8\xae,\x0f9~\xb5\xf4\xb6O\xf1\xbe\x14\xe7E \xfb\x8a\x9aWwY\x8d\xa1?\x00\x00\x00\xd8 \xc15V\x8b\xb56N=\xb2ʬ\xb7\xe33j\xd2^qK\x7f\a\x80\x95\xf2쟊Y\xec\xd35\x80\xa3\xe3\xa3b\x93\xba\x89RzL\x81\x87\xbb\x8b\xf3\xc0ET\x8e\xdfe\x0f[\xd5\x1f\x01XG\xaed\xd6v\x1c\x9d\xc9=\xb7\xc5\xc1̞ô\xfa`\xfb\x9c\x9d\xf1\xca\xe3HM\x98\xa9\x8eD\xb2\x03\xf8\x15\xa4\xe0\xe7_\x1e\x00\x9d\x82\xa3Ӷo\xfbE\xab\xfa\xb2ۤwE\xa7\x1b?J\xa7\xc6\x11*o\xf8\xb8+.\xfbcұR\x95\xe5\xfb/\xfd\x9e^\xee\x95~\x9f\x00\xf0zeAE\xb5\xad\xac\xbe\xab?\x01,\xb7\xfa\x89?\x14K\x9fVJ\xa2\xaa\x02\xac\xb3X\x1e\xeb\xf7\x00\x00X^.>\xad4\xa9\xbe\xedX\xe1\xc5B\x17\xc0:۾\xe9\x1f\xd0\b]r\x93\xbb\xbf1\x86g\x7f\\\xc3,\x93+JM\xc3\tymY>\x9d:\xd8\x01\xe0|\xd2{\xb6Ż\xb6\xb4\x10a\xc7Y>\xe2s\xdbJ͊\xb1\x8a۟\xa4\f\xc0\xab\"\x90}EU\xd5\xfd\xf6ɯ\x0f\x00\x00l\x9e\x98\x02^S\xa7v\xbb%\xefrżV\xf4\x03\r\x06o\xef\xef\x00\xb0Jf\xf3_R\x16\xacm\x13\bd\x04\x8eRt\xa9\x8c\xb5\xb4he\xac+\x9c\xfc\x80@v\\|Û?\xe9BF !\xb0Ί1\x81\xec8\x1a\xb3'\xbe)\x96{\xf7\xa9\xd4Tm1T\x9b\xca\x1b?\xb7+N\xa1\x95\xea,\xad\xfc\x02\x00\xe7\x11c7\x00\xe3ŭ\xf3bp\xb4\xf7:\xb3cǗ}\xbb\x8a[\xefe0Ʋ\xb8\xe6/8\xdd\xf1\xbc\x9b\\\xfb\xfe\xeew\xf4\xf2`\xf6\xcf\xfb]\xbe\xf8\xfb\x04\x807\xa2)\x94\xabU6\xb1\xfd'\xfe\x04\x19\n\x96\xded\xf2\x8b*\x9c\xb5}\x1ak\x04\x01X[\xc5\xf6e\xfd\x1e\x00\x00\xcb˅\xa9\x14S\x1f}#\xe7X\x95\x1d\x00\x8e\xcc\xc3\xdf\x16\xdd\xe4_+\x06ˋ\xcb\xf4\xbe\xd4\xf2\xe5\xb9}\xe7\xcfX>\xbd\xb8\x05\x00^\xd1\xcb\u07bb-\xbe\xed8m\xde\xf2\x90\xacP\xbc\xe6\x8fv\xe7\x81WC$\xf4\x8aj\xbb \x8fE&\x00\x00\x00\xb0I\xba\xe6O\x17\xc8^\xca{\x9f&\xf3\xb2\xe3R\x83\xf2\x9at\x05\xc0\x8a\xa9\xea'\xfa@\xf6\xa6?\x03\xe0h\xf4\x83\xfaӀ\xff\"WQ\x8e\xfb\xf3\xc0\xc5\xe5\a̸\x0f\xac\xb3\x9c\xf2\x04G\xe4\xcc3\xffJy\xb3e\x05\xcd3\xaa\xcbC)\xf5\x19x\xfb\x0e\x13e\xdaN\xa3\xbc\xfb;\x01\xe0\v}n\x00\xc6\xe2\xfb%}p\xf4\x89w\xef;\xbd\xf5\xbbxQ\xbb\x84\x8e\xdf\xfaQ\xf7\xe2\xef\xe9\xe5\xce\xfb;\x05\x80\xd7#\x94\x96\xbf\x1c\xc8\xcfNh\xde\xfeT\x7f\x12X^\xf3\xeaa+\xfc\xbc\xd4\xd6\xfd\x19\x00\xebh0N3m\x01\x00\xb0\xdcb\x1b\xacM\x95\xea\xa5Q\x8e\xf0\x15\x0082\xe1鿪Q\xdcQv\xacQ\xdb6r\xd5X\xc3\xfc9\xa9n%\xcfD#\x00^\x85\xfb\xdcb5]\x10{\x92\x828\xd2\xe6\xbdF\xd7\xfe9ޝ\xe2W\xe4xY\xbb\x82\x1e\xf8\x83Q{\x7f_\xca-\x13\x98\xb2B\x1a\x00\x00\xd80͞4\xf2\xaa\xad.\x947^\xae)4\x1b\xce5|\u05cb\xad\"\x00\xabdr\x97\x8b\xe3\xd6K\xb3\x13\x8b\xc0\x12\x00G\xe42K\x83\x8fK\x95t8\xdc\xd2\xd6{\x0f(WqI<\xf7ٯ\x8a\x97\x1f\xfc\xa8T\x1fW\x1bse\xc5Ğ\xc5Ǝ\xd3\x04'\xb9\x14ǒ\x9b-n\x06\xb0|Ҁ\xf8X\xa8\xb5t\xeb\xb3Z\xce\xd9q3\xb4\vV\x8c\xb4\xfb҇k\xca\x13\\r\xf5]o\x8d\xc5\xf4Q\xdbK\xcf\"\x00\xbc\x82h\xf5\xcb\xe8\x14\xb5\xa5\xd6\xe5V\x9c5\x8aY\xa5ܶ\x14\xff\xdcή\xb0\x9ah\xb4\x1d\xdb\xea\x03Mʙ\xeaS\xd7\xeb\xd8-\x8fP\xae\xad\x88\x83\xa7\xbe=\xe6\x8f\xff#\r\x1bkW\xe4Nm{Z\xa9V2\x1ce\xd6\xee]\f\xa8Q\b\xf6\x1c\xd8\xef\xd8\xf6S\xf3\xc3š\xf2\xd11\xbb\xfel\xffo\x01\x80\xcf\x175V\x9b\x9f\xb3\xfc\xa3U^\\'\xdd\xf9\x18\xe5\x02\x96Z\xf3i\x17s+\xe3\x9aَrO0;\xb0\xaabf\xf5Vk\x9b87\x94\xac*\xdb\xd4sk\xaflu\xab\x9d\xa9\xb6\xba\xeb\x87\x19'\x01\x00Xn̓\xbf1\xe6{?j{\x99\xaa\xf9@y>\x95O+\x01\x03XI\xd3l\xa4Q8cu\xd1\x1di8Q\x1d[\x15\xb3\xb1\xed\x0f4\xd7U\x1a\xbc\xefn\xea\xa7K\xaa\xf9\x98\x8by\xcb\xfbS\x00oL=\xa8U̽B\x93[\x9e\x9f\xc9\xfb\x83\xb4\x8e\x80\xe6W\xfez\x8dn\xfa1\xf2~\xbc&\x04\xb2\xaf\xa0\xe6\xaeo\x8a\xed\xc1\xbfQ\xebk\r\xf2\xfd\xfe,\x00\x00\xc0fȦ\xc7\xd4\x14C\xd5\xc5Y\x15\x8dS\xd6J\xb3\x91\xd7\xe8\xdd{4\x82\x80\x154\xf9D\x19\xc7!\r\f\x1fY\v\x95\x81T\xc0Q\tqG\u07bd`;)\x90}\xac\xad\xb4\xc2 p\t\xec?\xfd\xc7\xe3Γ\xff\xb3ԤU\x9b\aRneA*\x0fҬ\xfc\xdd\xf8;;'\xca\a`\xb9\xa5\xc1FQ\xc15r\xceɥ\xe3\xd0ڟ\xa9\xfc\x87[\xca\x13\\RӇ\xfe\xf38:\xf7\x94B\xfdqy\x02\xd9\x01\xbc\x1ao\xf5L\xef\x14\xe3ĚAU\x9a\x82E\xbe\x1d*\xb6c\xb9\xad\xd3\n\x93\xb1j\xbbV\x1e+\xe4N|\xadt\xd5\xf7Q\xa6\xad\xa0枝\xe8\xce\x1c(\xcbOvU\x96\xc9\xecP\xe3\xcc.d\xf6\x91\x02\xd9\xd3\xea\x11ޫi\x1b\xb5MT\x9e\xe7ʺ\xa7\x01\x00^\x81/ո\xa9\xa2\xe5\x1f>?\xa1\xec=ϑa`\xa9՟p\xb1H\xf1\xaf͖\x95om\x7f\x16\xc0ʱ\xaak\xb4\xbaj\xf4\x85U]\x83\xb5Yj\xb9\x98\xda3v\xa1=\x94\xbe\x88\x89$\x01\x00\xcbm\xf6\x99/\x8bý\x9f\x94\xf2\\u=P\x96U\xf2!u\xd0\x00XESKˣ\xfa@\n;\x8a\xc3}\x85\xe0\x94U[\xd2 \xb3\xe3\x0fȽ\xfd\xdfS?]Bսw\xc6\xf2ܓ֎`\xa1!\x00oP\x8cjb\xae\xbcHc\x84\xcej^9\xb9\xcboUy\xf3g\xc8\xf7\xf1\x9a\x11Ⱦ\x82&\xf7\xbe#\x16\x87\x0f\xaaͣ\xb2\x9a\x86\x1c\x00\x00\xd80q\xa4\xa0\x1d\xf9\xe2\x8c\n\x1d*VA:v\xa7\xdcm\xbfDC\bXA\xb3_\u070e\xc3X[\xda\xce\xed\xc8\xd23\x80#фB\xb9f\x92+u0*\xb5\xfd\xaeӔ\xab\xb8dڏ\xe5Q!*\xb3z^\x1a\x95\x17\xb3\xb9}G\xb9\xd6w\xd7\x01,\xb3\x14\xfcUZ\x92u]0\x87sm\x17$\x16ں\x8b\r\xcb?\xd8P\x9e\xe0\xd2y濏{\x8f\xfe\rm\xbbSjݾ\x8a\xc0\xe3\a\xe0\x95\xa5\x15u\x9d\xaf\xac\xfd3Ql\xac\xfc\x92\xd5;}\xaa\x8b\xdaw\xd3jf\xe7\xd3b\x87\xc5\xf8C\xf2\xb7\xfe<\x99Ɋk\xef\xbd-f\xfb\x8f\xd9\xceI\xb5\xe5\xbeTͻ\x05\xd9_\x1c'\xe1Ӏ\x1b\x17\xacI2\xeb\xcee\x81\x89P\x00\x9c\x87\x8f\x8b\xa9\xf6BZ\x15\xd7\xf2\x8bc\xdf\"w\xebwSN`iտ8\x8cEl\xd4F&j\x01VZ\xaa\xbb\xb6\x99\xa2\x95C\xce7VuM\xf5\xd8`u\xd8\x14̾#\xbd\xf7i\x128\x00`\xa9\x1d~\xf2=qt\xf8\t\xf9\xc1\xc0ꦙ2\xab\xa3Z\xc1\xd6_\x05\xb0j\xaa©\x9cY\x9d\xd4\xe7j\x8b\x03K\xd3\xdbR\xe3U\x17\xad\x8a˿U\xba\xee\xafQ?]2\xcd\xfd_\x1c\xab3?\xa7q{\x85\x94\xed\xf5g\x01\xe0u\xaa\xbc\xc2`[>;#͂\xaac\xbb*\xdf\xc1\x18S\xbc>\x04\xb2\xaf\xa0n\xc5\xc2IP,[\xb9\xb6\xec\xcf\x02\x00\x00l\x88a\xa5\xd8\f\xe4\xf2y\xf7~v>\x93\xf2S\xbf^٭?Fc\bX1\xd5\x03\xdf\x18\xfd\v?\xac\xdc\xcd\x15<3.\x03G\xa9\x89AyJ\x83\xd9X\xfb\xe3Sڹ\x83\x992q\xe9T\x9f\xbc6\xaa~R\xa5+\xeca\xdcR\xeb\xa6J\xbbj\n+\x1b\xd2\x04\vi\xb2\x13\x00K\xc9GK\xa3\xf6\x1dK\x85\xb9\x93/\x1a\xa9\x9c\xa9n\xd3\x04E\xbb*\xdew\x8e\xf2\x04\x97L\xb8\xe7\x9a\xe8ϞQ\xa5\x99\xb2\x9d\xab\x95M\xcf\xf4W\x00\xe0\xf3\xd5\xd1\xda>\xeeP\xb9\x95RN\xa5e \xa7lK瞖\xf2F{ٶvv\x7f\x8b\xdcM\xac¾.\xa6\x9f\xf8\xd2\x18\xe7\xffQ\xe3|KQ\x8d\\\x17\xb8\xde(\xb4\x99r\r\x17+\xb4\xbb\xb9m\xd6\xfe\xa8\td\ap\x1e\xf9\\Mض2#(\xb3rdR\xbeK[w~\x92\xb2\x02K\xe9\xf0\x9e\xdf\x1eG\a?\xd1\xf5\xad\x85<\xf0\xfe\x05Xi^1\x94\x8a\x85\xd5WceGV\xf4\xf8F\xad\x06\xca\xca\xf7Hw\xfc\x1ce\x11\x00`\xa9\xcd>vS,\xa7\x0fɏ\x86j]\x94\xaf%\xe7(\xbe\x80UՔQ\xf9\xa4\x94\x8aV\x95\x9f\xa8\xd4I\xa9\xaeuXDm\xbdo\x9fĽd\xe6\xf7\xfc\xae8\x98\xff3\xfb\xc5\r\xa5\xd62`\x9f\x06\xe3\x00\xc0\x1b\x10-\x8b\xcfk\xc5i\xaev\xdbv\xdf=%\xcf\xc7\xeb\xc6tV+hЭV\xe8\xad\x11ן\x00\x00\x00\xd8$i<\xe1<E\xb0[e\xa8\x19+fǔ\x95\xbf\xaa\xbf\b`\x95\xc4\xf0I\xe5\xa9s\xc3\x04\x9f\x82\x9d\x00\x1c\x15\x97ҠK\x03\xf7\xbd\xb2\xfc\x1d\xfdY\xe0\xd2\xf0\xe5\x1dV\x0e\xa4n\xca\xc6\n\x84\\A\xad\xa2wi\x813{&\x99\x84\x13Xf\xb1{Ő\xd6$\f\xf2i\xf5\x8c\x94p]JŅ\xf2\xf2\xfa\xee\x1e\xe0R\x98=\xf8\x9f\xc5x\xda\xca\x11?Pi\xf5\x99\xe8\x0e\xfa+\x00\xf0\n\xfcD.\xfd\x93\x06l\x15#+\xbb\x0e5i\xce\xeaP[j\x06Wk\xf7=\xfb\x8e \xf6\xf52z\xf7O\xb9l\xf7KU5\x87\xd6\xd6\xc8\x143\xab\xc5䱋_o\x82\xb5=\xe6VvT\xb9Uk\x18>\x01\xe0U\xc4h\xa5G!o\xf9H7^\xa7\xfe\xec\xe2<\xb0\x84B\xfc\xa4\xb5\xd3S\xf0za\x8f.\xfdk\xc0j\vV\x06Y]\xd5;E\xb5\x8b}K֕,\x8d\x8f\xee\xe8\xef\x01\x00`y\r\xe2$\x15cJ3\x99\xa7q\t\x8e\xd5\u0601\x95\x96\xa5\x89\x96\\\x1a\xe7\x17\x15\xd3W\xb0\xefت\xf6\xbb\xddu,\x8fç\xbe9\xd6\a\xffL\xda?f퉑m֞\x00\x807\xa8\x1e̥\xaa\x95+gr'\xffP\x7f\x16x}X\x91}\x05\x1d~\xecژ\x1f\xecj>zDe\xc1\x8c8\x00\x00`\xb3\x84T\x7f\xb5-˼2\x7f\xbd\x0e}\xa3cw\xdc\xc3\xc0R`\x05՟\xb9\"\x16g\xaan\x96\xbe*\x9b\xablh\xdf\x00G\xa5u\x8d\xb28P\xc8J\xf9\xf7\x9d\xa6\\ť\xf5\xf0w\xc4\xe9ٿ\xadQ\xb3'5\x97\xa9ΟW\x18dr\xd3]\x95n\xdfn`Ev`Y\x05_\xca\xc5=9\xfb\xd6d,\x95\x95\xa5߉\xa6\xcdIm\x1d\xffr\xe9\xe6\x7fA\x99\x82\x8b\xef\x89?\x1b\xe7\xcf\xfd\x1d\r&i\xf0[+\r\xb7T\x85'TFV\xd4\x05p\x1eYe\xd9Ŷ\x14\xd3\xc0\xad=\xedǩ\xe6\xfe\x98\xb6\x8e}\xb5F\xb7\xfcSʮ5V\xdd\xf7\xee\x18\xcf>c\xa5\xc5\v*\x8aFE\xe6\x15\xe6[\xd6\x0e\x19\xc8[\xbbX\xfeP\xdd$o\x00\xf0J\xdcL\xad\xbbL>\xab\xe5\xda=Պ*\xde\xdf\xcf\xd4\n,\x99û\xb7\xe3\xd6A\nv\xad\xd5\xfaVYZ\xc1\x19\xc0j\nVO\xd5I5\xa3}\xc5j\xaa\"\xecZ\x9bfO\a\xf9e\xda~\xf7s$n\x00\xc0Rk\x9e\xf8\x86\x98?\xf5\x83\xb63\x97\x06۪\xfd\xbe\x8a\xf9\xd8\xdaW\xa9|\x03\xb0\x8abVZ\x12\x9e\xa8u\x85\x1a7\xd5 n\xa9\xb54~\xb8\xf3n\xed\xbe\xf3\xa3\xd4O\x97\xc8\xde=yܝ\xb5\xd2\xe1[\x16}\xdf\xc3=\xa9e\xfc\r\x807\xe6\xb0(\xac\x1ew\xa8\xf2\xf2\xaf\x92n\xfa!\xf2{\xbc!\x04\xb2\x03\x00\x00\x00\x00\x8eD{\xf7N\xcc\xce\xd4\xd20\xa8.\xbc\x8a9}\x1b\xc0Qi]\xab\xccy5Y\xa1\xfc=\xfb$F\\r\xb3O\\\x1f\x87\xf3\xc7\xeca\xbcB\xcd\xe0Y+\x17\xa4xxB\xe3b\xd6\xcd\xe0\r`9\x05\xb7\xad\x18\x9fW\x96\x96Ҙ\xedJe\xa3\xa68Լ\xb9Q[W}\xbdt\xdd_\xa0L\xc1EW\x7f꺘\x1fL\xe4\x06g4\xaf\x8fkP\x06i\xba'\x15\x04\xb2\x038\x9f\xdc\n\xb14WfP\xe5\xa5f|\x9d\xf2\x13_\xa1\xc1\xb5\x7f\x97rk\x13|\xf2\xcb\xe3d\xf2\x19E\xff\xa4FVT\xf8f\xdc\x05\xb2w\xabѸC{6\x98h\x11\xc0y\xb8\x99\xb5\x81\xae\xb4\xfccOYդ\x11W\x9a^\xfe\xab5\xba\xf1'(?\xb0t&weq<\xb1\xf2\xcd*=\xad˕\xa5I\xbf\x00\xac\xa60\xb32\xe7J\x85\xf1\x81\xda١\x8a\xe6\xa4T\x9c\xd6^y\x83v\xdf\xf5\x10e\x10\x00`\xa9\xcd\x1e\xf8P\x1c>\xffQ+\xcfr\xb5\xc5Pm\xbe\xafr\xbe\x9d:d\xfa;\x00\xac\x9a\xa8\xb1\\vZU=V̼\x06~\xaa\xd8\x04\xcdO\xfd6\ro\xfe\xd7\xd4O\x97\xc4\xe4\x97n\x8e\xc5\xfcA\x15E\x9a\xd4w\xa6\xf9l\xac\xc1x*5L\xe4\n\xe0\x8d\xa9\xfc)e\xa7nPv\xc3O\x93\xd7\xe3\rK\xcbS\x00\x00\x00\x00\x00pɹ\xb6\x95ڹ\xed\x05\xfb\x87\xbe\r\xe0(\x85\x10\xec3*\xfazq\x02\xb8\xc4\xd2\xcbN\xfb趴\x96Y\xb7\xa5cG\xf9\x00,\xb3(oe\x88\xed8K\xb0i\x8b\xa1K\xbb!\xadpK\x10;.\x81\x17\xee\xffʘ\xed\x1f\xda\xe3wFm,\x15w\xceiV\xefI\x9e v\x00\xaf\xa2έ\xdc:\x90+'*\x87\x97i\xeb\xf8\xd7\x12ľI.{\x8f\xc6[o\x91w\xa3\xbe\xcd\xd1Z\x9d\xa6\xb2z̴\xdb\a\x80\xf3JM\x1e\xcb8b\xb4\xbc\xa2I\x1d\x17CͪO\xf5\x17\x81%\x93&\x86\f\xa9\xaf7=\xb8Ts\x80\x95\x17\xbd\xfdq\x8b\xfak\\L\xcc%\x8d\xd2\a\x00\x00K\xad\xadO[ٕ\xea\xa6Qm\x1b\x17\xf3\x97;\xc2W\x80U\xe6\x82\xd5G\xb3\x94\xb4\x9d\xbc\xdb\xea&\bu.\xaa,N\xf5w\xe0\xa8\xed}\xf6\xfd\xb1\x98<\xa6\xa2ّ\xea\x89j\xd7(\xdbݳ<\xb8\xec\xef\x00\x80ׯ\xccO\x13Ď7\x8d\x96\x00\x00\x00\x00\x00\xe0\x92\xab\x1f\xfe\xaf\xa2K\x03\x84w.S\xddx\r\xaan\xc4\x05\x80#RԖ.c\xa6\xbd\xc1M\xfd\x19\xe0Ҫ\xcbF\x8a\x85Ҽ&\x85\xfdS\x1enik0Qp\xcc\xc6\x0f,\xb3\xac\xae\xd4䗫II\xb5\x98XZ\xaeUL\xc7\x1a\xea\xbe\xc5\r\xc0\xc5t\xdf\x17\xc7S\x87\x0f+\x94N\xed\xa0\x94\xcfZ\rf\x99\n_(\xe4y\x7f\x13\x80MT\x97\xd2<kT\xfb\x99U1g\xa9\xc0\x92U,\xa5fh\xdfc\xc9\xefi\x9a\x95\x9a\xee\xfe:\xb9;\x1fqL\xbe\xb2a\xae\xf9kN\xef\xfa9\x97om+\x9e+\x15\xed\xf1h\x06\xadfy\xad\x96\xe2\x03\xc0\xabHEIV{e\x95\x97J۲\x03\x9d\x98N\xfa\xab\xc0\xf2\x98\xdc\xf7\xe18\xaeS0\x81Ջ|-\xc7D\x91\xc0Jk\xf2\\U\xf6\x94\xb2ɮJkި<\xa7\xb9ۖ۾lq\x03\x00\x00Klx\xf8B\xf7\n8\x14W\xab,\x0f4hl?E\xc0\x02X]a/%d\xe5\xa9od~\xd6\x1a\x9e\x03\x1d\xe6\xd6\x04\xbd\xfe{h|.\x83\x8f\x8c\xe2\xeeٻU\x14\x96ז\xb5\xfdbJ\x15\xedP\xf9l(\x9f\x16\x1d\x02\x80\xf3h\xc7\xc34\xed\xb3\xe5\x1b3\xcb\xe4[E7\xb3-\x9d\xb9R!\x9d{\xf7\x84|\x1eo\x1a\x81\xec\x00\x00\x00\x00\x80K\xae\xaa\x9eO\vvJa&\xef\xd3(a\x02ف#\x95\x15j}Р\xbc\xae?\x01\\Z>\xdbZ\xec\xb8T\x1ex\xf94\x1b\x7f\x8aj\a\xb0\xdcR \x87\x1auc\xe2\xbdS\x96v|\xa6|x\xe5\xe2:p1M_P[\x1f\xa8\rs{\x16c\x17\x9c\x916\xefrkc\xf0\xfa\v\xd8dE=Ӡ\xddV\x16\xaeTӌT\x87V1\xb3\xbc\"߷\xed\xb4\x0eGC\rw~\xbbF\xb7\xfc;*\x9c\x1b\xacxǳn\xb6Ӫ\x8a\x85\x8a\x98\xd9VH\x95m\x00p\x1e\xdee\xf6a\xed\x9f\xdc)Z;(-t\x9d\x96\x13\xac\x1f\xf9ή\x17\x03X\x161Tݳ\x99\x1a\xebΞ\xdb\xd8-\xe1\f`Uy\x1f\xe4R\xc0\x9f\x9fJ\x99\xb7Dn\xe5\x90o5(\xaf\xe9\xef\x00\x00`y\xa5\xce7\x17\xadN\xea&\xa9\b\xb3zj&\x971\x919\xb0\xdaZK˩odn\xd5\xd34\xe6\xcf\xea\xaa\xccO\xb1\x14&\x8f\xfeV:\x00\x00\xbca\xd9a\xab,\x9fi֞\xb0\xac>\xb7:\u070eR\x1c{=>-\xff\xfe\xae&\a\xbci\x8c\xe4\x01\x00\x00\x00\x00\\r\xd5\xec1\xa9\x19\xab\t\x87\xca|)1\x90\n8Z٠\vd\x1f\r\xdf۟\x00.\xad\xcc_&\xa5\x01\xe1\xddL\xaei\x9c\xad\xeb^~\xban\xd6\x13\x00\xcb+*W\xdbO;ai8\r\x94\xf7\x99\xdc\xf0\x96\xee\fp\xb1T\x0f}Ml\xea\xe7\xad=qΞ\xbf\xb9\x9dI\x81D/\xb6)|7\x86\x06\xc0\x06\vV\xb7l-oh\xceʇB\xde\x1d\xb7\xaa\xa5\x95X\xb9e\x0eY\xa6\xfc\xcao\x90{\xdb?c\xc0\x05\xe4O|\x93\x9a\xd1T\x9ao)k\xc6\xca\xdaa\x7f\x05\x00\xbePT\xb9\b\"\xf4N\xadK\xf5ϱ\x955^U\xf5\v\xfd\x1d\xc0rpM\xb5\xa8\vy\xdfM\xf2\xe5\xbbY\x17\x00\xac*\uf0b2\xb0k;\x87R\xbe\xd5\x05\x00\xb6v\xae\xbc\x966\r\x00`\xb9\xb5\x8f|g\xf4!tm\xa7֝K\xf3,Y9f\xed(\x97\xfa\xf4\x01\xac,_[Zޱ\x9dJ>+,\x8d[\xbb\xb38\xb9\xb8\x86#\xd3>\xf1\x15q\xf8ԏ\xf4G\x00\xf0\x06\xd4\xfb\xf2\xcderyPlF\x96\xcd\xe7Ri\u07fb\xdf\xd0\xdf\x00\xbcy\x04\xb2\x03\x00\x00\x00\x00.\xbd\xfai\xf9\xb8\xa5\x90\x82M\x8a|\x11\xf4\x04\xe0\xe8X\x12l\x94)\xbb\xfa/3\xf0\tG\xa2̯\x95\\\xea\xaal\xecq\xb4\xc7\xd0\xd9C\x19\xad|\xe8Vh\a\xb0\xb4|Z\xfd\xba\x92K\xe9\xd76צ\x01I\x96\x86\x87\xef\xeeo\x00.\x8e\xfc\x85\x9f\xb1\xe7\xeePy^+˃=\x87q\xb1\xca`\n`\xb7\xe70\xa6\xc1q\x006\x97\x1fZy4\x95O+\xc2\xd8g\x16+\xa5\x7f\xe6\xc5\xdb\x15w\xfe\x90\x06W\xfd\x03\xda=\xe8\x8co\xf8\an\xb0\xf3k\x15\xecyQ;Z\xac\xda\x0f\x00\xe7\x11\x95\x06gW\xddH\xabn\x0e\xa5\x14Th;m\xfd\x89\xc5\r\xc0\x92\xc8\xeaC{>\x1bk\xa7\xa7\xbe5\x86\x06\x02+\xcf\xca\x1c\xa7\xb1\xedX\x19\xe4\x06v\x9c&TI+_\x02\x00\xb0\xdcf\x93O-\x1aO\xb1P\xe8&\xa3u\xb6\x8d\xe4\\\xdd\xdf\x01`%\xa5f\xa6\xa5\xe5žWm\xb5\xd5,c\x92\xf3\xa34{\xf2\xdbb8\xf3\xe3\xf2\xf5b\xe1\b\x00xCJ\xc9\x05\xaf\x81\xedF\xedk\x9eޙ\x9d\xfc\x9d*\xde\xf2\xf7x\xaf\x8a\v\x86\xdej\x00\x00\x00\x00\xc0%W\xc4Ck\x91\x16i\xe4\x85m)ڄ\xe6)p\xa4BP\xf0i\xc6d\xe0hd\xc5uV\x1eX\xa1\xe0kŘٮ\xed\aϊ\xec\xc0\x92\x8b\xdd@\x854\xeb~\xa16\xad\xc8^[y\x92\x06Ҿ\xe5o\xf2\"\v\x17M{\xff\xbb\xa3\x9f?/\x9f\xd9S\x97E\xdb\xd2\xe3feF\xe8\x1a\x17]\x19ҭ\xec\x02`c\x1d\xf8ǭhڶJ\xe6\t+\xa7\xceZ\xc61QȮ\xd7\xf8\x9dw;w3e\x14>_~\xe3\x7fpq\xe7J\xabü@\xf7\x14\x80W\x15SЅ\xac\xfdcm\xa0.\xfe\"K+\xb4G\xf9\xe6\xc9\xc5\r\xc0\x92(\x9a\xa9=\xa0\xb6\xd3\x05\xb1[[\xa9;\x00\xb0\xb2Bz\x95\xea\xd5u\x95[\x01\x94\xa6\x82U\xbe\xbb\xb8\x06\x00\xc0\x12k\xe7\x0f\xd8gc\x9bK\xaf}\xad\x04+\x17\uf029\x9f\x02\xab-\xb3-\xe6\xa9K$UTU\xd9wV\xdc\xd9]\xc2\xd1\xf0{\x7fU\xc5\xe9k\xd4E\x9f\x02\xc0\x1b\x14\xf3m\xcbן\x95&Q\xae\xacU_ny\xfbM\xdf\xcb{U\\P\xbc\x8a\x05\x00\x00\x00\x00\\R\xf1\xb9\xff&\x0e\xbb\x17S3y\x97fh\x9d\xda\xc6\xca\x01\xc0\x91r\x8d|~e\x7f\x00\x1c\x81k\xbf\xcb\xc9g\xf6,\xa6\x01\xe1\u07be\xad\x9c\b\xe9\r(\x80e\x163K\xab\xb1\xed\x82\xd8[\x97-\xe6(J\x93\x15\x01\x17I|\xe2w\xc7\xe6\xec\xc3R\x11\xac\x9c\xb0'\xae\xdb\xec\xd9k\xad̈\xa9\x1c\xb1vE\nl\xe7\xed\x17\xb0٬L\x92\x1f\xd9\xf7^7\xa8.\x8e\xef\xd0\xd6{\x1ea\xa0\x05\xce+\xbb\xe3\xb3n\xb6[\xab\xa9\x8f\xf7g\x00\xe0\v-V\x10\xb4\xfaf\x9b&\xe1\xf3\x8a>\xadx]\xab\xac\xad\x88y\xe8\x7fH\x1d\xde\xc0\x91\x9b?\xfa_F\x1f\xe7\xf6l\xa6#\xaf&\x05\xbe:&\x8a\x04VZ\xea~K\xab\xb1'\xa1\xed\xba?\xb2\x92\xf79\x00\x80\xe5\xe7\xdbg\xad\x10\xabmg\x9e\x16mVh\n۷vTZ\xeb\x02\xc0\xcajS\x83\xd35\x8buk\xe2L!M:}\xe3\xf7\xd0\xff~D\xea{wb\xf9\xbc5\x12\x06\xcf\xdb\xef\x83v\x02\x807.ML\xa28\x96\xb2=ŭ۴}\xd3/\x92\xb7\xe3\x82c(\x0f\x00\x00\x00\x00\xe0\x92\x9aM?\xa1<\r\xf8\v\x87\x8aaKM;\xb7\xb3\x04<\x01G\xca7\x1a\f\xaf\xeb\x0f\x80\xa3\x92^x\xf6\xbbI\x1a\x91\a`\xa9\xb5)\xcdv\xabAe\ni2\x8a\xb4\xd2[^\xa6K\xc0E\xe1N\xff\x88\x06a*\xe5^!8kO䶕\xddw\x17Tԭ\xe6\x92\x1eʺ\xbb\x1f\xc0f\xdanw\x14\xdb=\x1d\x16^\x87\xc7?,\xf7\xae\xbb\x18h\x81_\xd1\xf0\xe4\xef\xd0<;\xd6\x1f\x01\xc0+Ȭ\x9e\xe9\x06V\xef\xb4}?R\xc8gvܪ\xacw\xa4\x83\x9fY\xdc\x03\x1c\xb1f֯z\xe9\xedQM\xc1\xaf\xe9\xe3\xf3:\xdc\x00\xac\x9e\xb4z\xed\xbcK\xc9A\xb5\\\x1eU\x0e\xae]\\\x02\x00`\x89\xe51-j\x91&\x00\x9b\xca[A\x16҄\xb4\xb9\x9dK\xfd\xf9\x00VV\xec\x16\xab\x99Y\xfdtlm\xce9C\xfe\x8eP\xf3\xd9\xeb\xa2;\xddJ\xfe\x985\x1b*ͺ>\x00\x00xc\x06\xedD!\x9eT}\xec&\xf9\xdb\xee\xa5C\x11\x17\x05\x81\xec\x00\x00\x00\x00\x80Kj^=\xaa\xc5ʉs\xc5v\xa0\xb6[\x11\x84\xe6)p\xa4\xd2\xc0\xa7\xf2d\x7f\x00\x1c\x91\x14|h\x7fx\xb7\x06\xac\x8e\xb6\x8b\xe00\xd1\xear\xdd\xea\xb7\xf6\x9d3Z\x01\x17\xc9=\xbf&\xeat#\x95\xb5\xe6nhEFaE\x87m\xc1\x9e\xbd4\xf9Iz\x0e\xbbv\x8650\x1a\x02ف\x8dV\x9fUl犃/\xd2\xd6-\xff\x89\x81\x16xM\xfcU\xff\xd2\xc5\xe1\xac?\x02\x80/\xe4|\nd\xb7\xf6\x8e5\x83\x9cJ\xfb\xb0:g*e®\x9ai\n\x1e\x06\x8e^h^\xb0\xe7\xb2]<\x9b\xe98\xbd\x7f\x01\xb0\xe2\xbc\xfdIA\x80C\xc5\xd8v]pE\xc1\xfb\x1c\x00\xc0\xf2\xf3\xddr\xcdI\xfa\xf6\ni2Z_Y%\x95\xf1A\xc0*k\xa3W\x94\xa5\xe5h\xf5S;\xf69]\xf0G\xe2\xc1_\x15\xf5\xf8Y\xe5\x99\xfd\xfc\xb7\xa6\x9aէ\xe4\x87\xcf\xf6\x17\x01\xe0\rh2\xb5\xc3\xe7\xe4v\xff\xab\xfe\x04p\xe1\xd1\x12\x00\x00\x00\x00\x00\\R;\xfb/(\x84R\x1a\x0eU\x14OiФ\x97U4O\x81\xa3ԄF\xfe\xba\xef\xe3\xed\x12\x8e\xd4<\x05!N\xc7\xca\xf2}5E\xabP\x1e\xda\xc3I@,\xb0̆\xf3\xa1\xe6V\x8d˷\xcei89\xa7\x99U\xeb\xe6'~w\x7f\x15\xb8p\xe6\x8f\xfc\x0f\xb1\x9a\xfe\xb44\xb6\xeaJ=\x90\xcf'\xf21HmeW+\xb9n@\xb7m1mvOf\xed\r\x00\xeb\xcb\xcd-\xad\x8f\xd4z\xaf\xb6\xa8\x14\xf3ԯ\x90\x82\vg\x96/8=\x11\x87\xaa\xaf\xfczm\xbf\xfd?\xd2\xc6\xc1\xeb\xb2}\xc7Ӯm\xad-\x12v\xad\x82c\xcfSuʞ)+[\x14\x14\xbdӼ\xb0g\x0f\xc0\xc6ʫT\xac\xa4U؇*\xc3T\xf9\xbc\xb6<b(\x15\xcf+w\a\x8b\x9b\x80#\xb65۳2k\xaa\u0bd6\xd39\r\xecYmà\xbf\n`%Ź\x9aܶ6*\x1b֪\xeb\x89t\xcd?\xa1\xad\x03\x00Xn\x9f~\x7f,uR!n)\x04gm\xa8\xa0A\xf9\x82\xec\x845\xae\xfa{\x00\xac\xa4,\x0fr\xb1\x95\xda3\xb6]\xa5\xe9\xf6\xb0\xbf\x82K\xa5\xbe\xf7\xf7\xc6\xfd3w\xcb\x1f?\\\xbc\x1b=\xdc\xd5\xd0\xf2֜E#\x00\xbc\x8a\xd6ݰx\x97\x9a\xd9\xe6\x8f\xe9\xc0*e\x93,\xbdc-\xed\xe2L\xcf\x0e\x87ʮ\xf8#ʯ\xfb\xb3\xf49\xe0\xa2!R\x00\x00\x00\x00\x00pI\xa5\x15@Ң\xbb\xf1\xc5%w\xbb\x83\x17gb\x06p\x14b\x1c\xf5{\xc0\xd1q.-i\xd6\xf7\x85\xa7Uu\x01,?\xdfʻ\xdcҬ\xed[\xfa\x8d\x99\xd7\xe0Z^j\xe1«'\x1fQf\xff(\x9e\xb5-\x93\xaf\x99\xe8\x04\xd8du{\\\xb18\xa7,x\xf9Y)\x17\x0e,o\x18)\xb4\xd7h\x9eMu\xf2\xea\xaf\xd1\xe8F&\xea\xc2\x1b\x93\x9d\xfc\x06U\xa3\xe7\xad\xec\x19K\x83\xa7\xedĶ\xd5sj\xab\xee4*\x82\x9d\x03\x00`\x99\xc5J\xaeͻU\x9bS\xf7\x9a\xb3v\x94g\"a`\xb59k\xf7X:v\xa9\xef\xbc[\xd0vgq\x1e\x00\x80e\xd6\xecKan\xe5X\xec\xeb\xa3V\x8e\xb9\xd4\xc7o\x9b\xe8\xdf\aV\x9a\v\xf6a\xe9ڗ\n~\xa2\xe1\xe0\xf6\xc5y\\\x1a\x0f\xfd\xe18\x9b~\xaa\x9bTѧ,\xd5[#\xa1\x9b\xfc\xb7Q\x1bh\xff\x038\xbf\xe8\xee\x97\xda+\xa4\xea\x98\xd5՞\xd1\xd8W\x1aŁb\xbb\x98\xc4\xf9ĕ\xbfK\xfe\x9a\xbf\xc4\xfbU\\T\x94T\x00\x00\x00\x00\x80K*֭\x9c\x0f]\xbcS\f\xae\x7fQ\x95:\xb9\x01\x1c\x956\xbb\xb6\xdf\x03\x8ePz\xe1\xf9b {\xa7\x1f\xd4\x00`ye3K\xa5iv\xe6\x81\xed;Ŝ\xe0.\\x\a\xf7~u\xcc\x0e\x7f\xd6\x1e\xb1\x91=gv\xc2\x17ʪ㋋\x006R[\xcc\x14C\n.v\xf6\xc7ꐕ\xd4\xe8\x9c\xe6\xc3Z\xcd\xe8\xc3\x04\xb1\xe3\xcdy\xdb?rat\x9bڐV]n\xbb:Nk5\x9e\xf4\xa8yV\xb4\x05\xf0*\xa6\xf7\xfe.ֽ\u0091j\x1e\xfdcѻ\xa9\x14NX\xd95\xefℜ\xcb\xe5\bd\aV[\xf4\x96\x8e\xa3|\xea\x14\t\xf6\x9d\xbf\xa5\xbf\x00\x00\xc0\x12\xab\xce*\x86\x89\xd5G[)\xd5G\xbb \xf6\xb4\x14{a\xdf\x04\xb2\x03\xab,M\x9c\xa6\xb0X\xc1\xb7v\x87\xda\x1e~i\x7f\x05\x97\xc2\xf4ܿ\x94\xab>\xadA\xca_\x93.\x9f\x9d\xdawݏ\xc1\x04\x80W\x96\xab\xb1\xcf\x1d\xcb\xc7\xc7ݸ\x8b\xaeǰ\xd9\xd7\xd4y\xcdw߫⚿\xcf\xfbU\\t\xf4T\x03\x00\x00\x00\x00.\x99\xe6\x81?\x10\xb34\x9c\xcf7݊\xec)\x8e]\xae\\t\xaa\x0282my[\xbf\a\x1c\xa5\xd0\x05\x89\xa4\x99NR\x19ѭ0\x03`\xb9\xb9J.\xbd\x10o\xd2Kq/W\\\xb78\x0f\\@\xc5\xc1OkT\xa7\xf6BZ\xf5\xbfX\x04\xb3SD\x00\x1b-\x96S\xfb\xf4iǾ\x87ݹY\x1a\xffz\xe2Vm\xbd\xf3?\x91C\xe0M\x1b\xdfz\xb7\xf3ǭ}\x92\xc6\xf4\xb8Ck\xa9\x8c\x15\x83=d\xc1\xce\x01\xc0y4\xb3O\xf4{\xc0ј\xcf~\xd1\xeaGVx\xc5-E\xa5\tYl7X\x03*2\xc7\x02\xb0\xcab\xccS\x8fH\x9aZImp\xca\xcb[\xfb+\x00\x00,\xaf\x18\x0f\xac>:\xef\x16\xb9\xe8\xa4ziZ)\xb8\v\xb2$|\x05Xy]z\xf6\xaa\x9d\xb57\x8f\xff\x05\xfa\xe4/\x91\xc9ǯ\x8b\x03\xf7\xa4\xb6\xf2\xb9|z?R\x8f\xa4\xd6~\xfc)\x88\xdd\xf2[\x97&\r\x01\x80\xf3Iղ\xecY\xb9\x91\xd5ӊ\xa1\xe5\x1f\x95ꦒ\xdb~\x97\x06\xef\xf8\x18y9.\tZ\x02\x00\x00\x00\x00\x80K&\xd6\x0f(S\u07bd\xb0JA\x8a\xddH\xaa.\x12\x85\x81T\xc0Q\xf2\xa3w\xf5{\xc0щ1X\xb1\xb0(\x0f\xba2\"\xa6Ѷt_\x02K-\xb6\x96^]\x17\xd3\x15\x9dWQ\xbe\xaf\xbf\x00\\\x18\xf3\xcf\xfe\x9a8\x88i\x15\x01;\xa8\x1b+&.\xb3\xe7mbM\x883\x8b\x1b\x00l$\x17r\xb9\xfc\x9c\xd2j\x84jZ\xb5C)\xdfz\xafF\xd7\xff\f\x83,p\xc1\x94ǾT\xf3\xcc\x1e\xb1&ȷǭΓ&U!\x90\x1d\xc0\xf9\xe5\xf1\xf1~\x0f8\x1a\xa1~p\xd1vrN\xad\xab\x14^\x9c?8\xa4\x99Y\x00\xac\xac\x14\xf0\xe7\x16\xf5\xd0V\x99\xfc\xe8\x9d\xdd>\x00\x00\xcb\xcc\xf9F.\xadr\x91&.\xb7zil\xbb\x17I\xb6\xa5w\xc0,t\x01\xac6߽\x1fN\x8bش:ޟ\xc3E\xf7\x99\xf7\xc6l\xf6D\x17\x00\xe8b!5[R=\xb4<ud\xbf\x8b\xbc\xcb[\xddK\x1d\x01\x00\xf0\nڱ\xa2ߗ\xb2\x89\xd2\ndm\xea2\x1cݤ\xd1\xdb\x7f\x9e\xf7\xab\xb8d\x18\t\n\x00\x00\x00\x00\xb8db\xfb\xa0\xb5DS\xe0\xfa\\m\xb7z\x80\xed\xa7\x99\x97Y\x91\x1d8R\xa3\x9b\xfe\"\x1d\x928r1\x8dbX\xect\xba\x15ٙ1\x1aXj!\xd5\xe7b\xb0:\xdd\\\xc1\xd2l~\xe3?\xa6<\xc1\x85\xf3\xe0\x1f\x8d\xf3\xfd\x9f\xb6\xf6Ca\xe5\xc1\xaeT\u05f6ejB\xad\xda\x11\x88\x01l2_[\xbd\xb1>\xa6\xc6\x1d\xe8\xa0<T=\xf8\xa0\x86\xb7\xb0R\x00.\xb0\xeb\x7f\xd2ջc\xb5\xed1eAʲ\xb4\xaa\r\xfdW\x00\xceo\x98&`\x02\x8eP֜M\ru+\xaf\x0e䳨\xd0x\xb94idZ\xa5\x1d\xc0\xca\ni\x82\xf0\xd6\xea\xa1)9{/\xbd\x85\x15/\x01\x00\xcbm\xfa\xc8\xd7\xc7.\x80\xbdߺ\xd8\xf5\x90\x8a/\xab\xac\xa6\x15\xda\xd3\xca\xc1\x00VV\f\xb9BzO\xe7f*\x8b\x9b\xfb\xb3\xb8\x98\xea\xfb\xdf\x11\x9bswkP\xd8\xc1<\xadĞ\x02\xd9\xfb\tB\xb24\xee\xd2\xda\f\xa9\xe9_\xd3\xfe\a\xf0j,\x8fH\xf5\xb2:Ӭ\x9ek:<\xa9\xf2}\x0f\xd0ǀK\x8a@v\x00\x00\x00\x00\xc0%\x13\xc2\x13\xf6\x99f\x01M\ufadc\xb5Jmkmc\xc6e\x00\xd8x\xa1[\x1d*\x8d\xb6\xb5b!\x8dh\x00\xb0\xf4\x9cJ\xfbh\xe52\xab\xcby\xdb\a.\xa43\xff\\\xa5=ZU\n\x1a,\xbc=c\x03+&\xce\xc8\xfb\\\x95\xe3y\x036Y\x99\x06ʵ\xb9ꁕA'n\xd3\xf0\x1d\xbf\xc0 \v\\\x14\xc5\xf6\xd7[='\x8d\x0e|\xda\xea<cMs\xfa\xaf\x00\x9c\x9fk\xa2\xc2\xd3\x7f\x94\x0e\r\x1c\x19\x9f\x06\xb1\xa7\xae5\x7f\xd6\xdaMRlv\x94\xe6\x12\x8e\"P\bXe\xce\x15\n\xa9t\xe9V\xbd\xa4?\x04\x00\xb0\xfc\x9a\xc9\xfd\xfd^\x1a\v\x94*\xa4i\x82%\xdbO\xf3\x97\xfb\xc6\xea\xa7\xd5\xe22\x80\x95\x14Ca\xe9\xd9\x1a\x9fE\xab\xd1\xf0\xed\xfdY\\4\x8f~yt\xfb\xf7(\x0f\x96w\xba\xcb\x14\xe3X\xb5\x8fj\x8a\x03\xc5\xc1A7\f\xb3[D(xe\x8e\xf0@\x00\xe7\xd7d\x85\\k\x99F\xdd\xca\xe5\xd7ix\xea\x1b\xfb+\xc0\xa5CI\x05\x00\x00\x00\x00\xb8dB=\xb3O\xd7\r\xa6J/\xaa\\Zi7\xd8AL\xa3\xab\x00\x00\x9b\xec\xc5\xe0\xf5\x97b\xd8S\x19\x91\x065\x00XZN\x99%SK\xb4)\xb9\xfa\xf4\x96\x1c\xb80^\xf8\xec\xff\x145{RC\x7f\x8d\xaa\xacVU̥b\xcb\x1e\xb4C\xe5\xc5H\xc1\x1d\xef\xef\x04\xb0\x91ګ\x15\xf3\xe7\xa5r[[\xb7\xdcK\x85\x11\x17\xcd\xe0\x9a\xefq\xd9\xc0ʠn\x85\xa1c\xaas\x02\x01\x01\xbc\x8aV\x9aU\xf7\xf5\a\xc0\xa5\xe7\xa2\xef\u07bd\xc8[y\x95\x02\xd9\xe3\xc8\xda\xeb\xce\xda\xee̯\x00\xac\xb4\xb8Xɶ\xfb\xa0\xbf\x1c\x00\xb0\x02\x9a\xea9\xc54\vKZ\x85\xddʱ4.ȥ\x99\x96\xd2B\x17>\x95g\xac\x18\f\xac\xb2\x18\xbc\xbc\xb7\xc6gnIzp]\x7f\x16\x17\xc3\xf4\xc9\xff2N^\xf8i\xe5)\xdb,\a\x8aSk\xe7\xe7\x85|\x9a\tܶXT\xf6K\x98[~kyk\xea\x13\xb0k\x00p>1\xdf\uef9dk5\x18ߡ\xfc\xad\x7f\x83N\x06\\r\x04\xb2\x03\x00\x00\x00\x00.\x8d{\xbe8\x8eݭ\xaa\xbdW\xe3\a\xcaR\xf0z\xac\xd4\x0e\x0e\xd4j\xab\xbf\t\xc0\xc5д\xa7\xa4b\xa6\xb4jn\xc8\xcbE\x8f\xd0<\xcd\xd6\x1b4\x1d\xbdwq\x13p\xc4\x06iЂ\x9f(\xc4+5\xf0Aq~\xdaʉa\x7f\x15\xc02\x8a\xf3̊\x94\xa80\xbbQ\xd3\xe3_ٟ\x05\u07bcSg\xbfC*\xad\f\b\xa7\xb5=\x1f\xaa\x9c\xa5\x01\x18{VNعy\xad\x9d\xc6\xf6\x01\xac\xad\xc6\xcf\x14\xda+m\xef\xf8\xa2\x1dc\xc7]TV\xfa\xce\xd2\x00\xadG5˯\xd3\xe8\xedg\x19`\x81\x8b\xee\xf0\xdaߥ\xc3T\xfe\x84'\xb4;c\x05L\x00\xaf\"\xb72ꙏ\xf7\a\xc0%v\xd7uq\x90\r\x15ݮB=V9\xb7\xad<k\xe5W\xa3\x98\xeaO\x00VV,\xa6\xca\xe6\xb6\xe3\v\xb5\xc7>\xb88\t\x00\xc02\x8b{\xddD\xc8\xdd$,1\xd5E\xd3\xd8 ۯ\xd3\xe6\xe5x\xff\v\xac\xb4A\x88rVA=L\xdd\xf6o\xfd\x9f裿X\x1e\xfa\xb68z\xfcG5\x9eՖ\x8d\x1eSێu\xb8\xfb\x98\\\xd8W\xd6x\xe5\xd5P~f\xf9\xa9\xe5\xab\U0008d525<\xb7\xff\xbb\x006\xd4\\M;W\xeb\x83\xdaAZ\x81\xdd\xea`Y\x9au\xa4\xe8VaOs_\xec\x87F\xa7O~\xb1t\xfb\x0f\x93\x7f\xe3H\x10\xc8\x0e\x00\x00\x00\x00\xb84\x9as\x8aq\x92v\xba\x95;\x17+\xb2\xa7\xfe\x10/G\xf3\x14\xb8\xa8\\Z\x85't{\xf6\xa7\xb2\xefBʲnB\xde\xc1\xf0\x9at\x018ZO}gL\x13\xf0/ʄ\xf4\x82\xad/\x17\\\xf7\xe0\x02XR.\xbd\x14\xb7T\x1b\xb2Z[\xd7\xffc^t\xe1\x82h\x1f\xfcp\xd4\xecd\x7f\x04`\x13y7\x90\x1b<fu\xc2Ci\xbe\xbd\x18|\x95Vm\n\xbb֬\xa95\to\xd5\xf6\x89߹\xb8\x19\xb8\xc8vO}\xb7+\x8eo\xa7\xb9\x18\xedYdE\x1b\x00\xaf\"\xb5\x88\xe8\xc7\xc0Qi\xdb.h=\xbd{Y胅\xba\x8d\xf7/\xc0*\x8b\xd6\x06\xeaʘP\xa9(y\x9f\x03\x00X~\x19\xf5O`\xaduc\xfd\xba>{\x16\xad\xb9\x98\xa6\xfb?\xa3\xb6I\v\x96d\x96\xb1\xce\xedk&\x1f\x16\xab)\x03\xc0+\x891*\xf7Ǖ\x85\x93J]\t!T\x8a\x8d\xedD\xcbG\xf2\\U\xf6\x8c\x06;_\xaa\x93\xb7\xfc,c{pdh)\x00\x00\x00\x00\x00.\x89ؼ\xa06\x1cȹ\xb9|\x96\x02\xd9?\x17\xcc\xeey\x91\x05\\\\~.Յ伂ҋ\x8e\xa1m\x85\xd2\xfc\xe7~\xeb\xf6\xc5=\xc0\x11\n\xcdi\xfb\xec_x*\x05\xc6.&Z\bi\xb0-\x80\xe5\x95Y\x99b\xe9\xb4.\xea\xfe\x04\xf0\xe6\x84'\xff\x9b8\x9f\xfe\xbc\x82\xe7\xdd)\xb0ɢJ\xc5\x14\b\xe8\x0e\xed``g\xd2*\xd8\xc1\xfe\x89jc!\xbf\xfbM\xd2\xf5\x7f\x9d\x8c\x02\x97L\xb9\xfd՚\xa5\x18\xf60Z\x9c\x00\x80W\xe22\xf9\x90f\xbd\x00\x8e@=O\x1dl\xf6\x1c\xc6n\xfe\x9f4p\xb5\vb7\x8b\t\x85\x01\xac\xac\x98\xd2\xf6@M۪\xb8\xfe\x1f\x91\xa0\x01\x00K->\xf9\xdfĜ\xf1?\xc0zs\xadb\x90\xf2\xe2\xc6\xfe\x04.\xb4\xc3\xcf|Q\x9c\xcd~V\xcaj\xdb\x06ݘ/\xa7ZE;\xec\xef\x00\x80/T\a\xcb#|i\xf9t-\x17\xe6ʣ\xb3\xbc\xc3΅\xd6\xce\xcf4\xdd9\xa9\U0008e7e4_\x01G\x8a\x96\x02\x00\x00\x00\x00\xe0\x92h\xdbs\xf69\xe9V\xeeti5\x90\xe0\xd28\xf4E\x10;\x03\xa9\x80\x8b+\xab\xa48\xb2\xb4\x96u/\x94\x16\xab\xf0d\x9a\xa7\x80\x90\xab\xff\x02\t\x10G\xaei\x9f\xb5\xf2 \xb3=\xaf\xa8ʞ\xd3|Q4\xb0\x92\x19\xb0ܲ40>S\x18\xec\xf6'\x807\xc7\x1d\xfcS\x15Sk&\f\x0e\xfa3\x006Q\x883\x85\xca\xda*\xdeꂃ=+k\x06\xd6v\xa9Ԕ\x85\x8a\xc1\xef\xd4\xf0m\x7f\x966\f.\xadk\xfe\x89\xd31\xfbNAD\x00p>\xae\x90\xb3\x12\v8\n\xa1MϞ՝Ҋ\xec\xfd\xfb\x96.\x98=M\x1c\xe9\x18\x1e\b\xac\xb2\x98\xea\xa0~KS\xa5\x99\x95\x00\x00Xn\xb3\xe9}*\xd3l\xfa\x00֗\xb5;\x1be\xca\ao\xefO\xe0\x82\xba\xe7\xed1\xec\x7fD\xa3\xb4\x10\xfbVn\x1f\x85\xda*W\bN9\xfdN\x00^E\x8c'\x14\xfd\x19˧_\x90\x8fQ\xde\xedvC\xb4\xe7\x96k\xd7\xf9\x8e\x86\x97\xfd\xb7\xfd\x9d\xc0ѡ\xa7\x1a\x00\x00\x00\x00pi\xb8\xba[\x89ݻ\xd8M\xf2\x17\x9b\xa8\xae\xa7\xa4\x1bTE\xa0\"p1\xc5\x14\x00\xd2M\x1e\x91w\v\xf1\xc48\xefR]\xe3O.n\x00\x8eX\xd3<c\xcfg\x96\x1eV+\x17R \xbb\xed\xdbnt\x8ct\x00\x96Ztj\xac.\x97\r\xdeџ\x00\u07b8\xf8\x99\xdf\x19uf\xa6B\xb9\x9cg%K`\x939\xab\x13\xfan\xe5끔5\xaa\xfd\xbe&\xa9\x9a\xb8\xf5\xab\xa4w~\x7f\xeaD\x00.\xb9r\xeb\xabպ3\xfd\x11\x00\xbc\x92L^s\xd5\xf7\xfd\x17)z\x18\xb8\xa4blm\xabm/\xf5\xfa.\u07b7\xc4\xe0\xec\x1c\x8f#\xb0\xea\x16\r\xa0R\xb18\xd5\xed\x01\x00\xb0̚\xf9\xc3\xcaӀ \x00\xeb\xcb\x05ku\x0e\x94\xdd\xfa\xcf髿\xc0\xce=\xf2\x9b\xa3\x0e\xefըq\x1a\xfa\xb4\xb2rj\xefW\naWYؖs\xfb\xfd\x9d\x00\xf0\x85|>U\xb7\xd8Q\x12\x06\xf6Qi\xe6\xcfi>\xdaU\xbe\xfb\x9b48\xf5\xe7ɷq\xe4\bd\a\x00\x00\x00\x00\\t\xf5c\xbf?f.\xb7Fh\nR\xcc\xe5\x82W\xb4\xad\x93\x96\x87fE+\xe0\xa2\xea\xc6+f\x96\xceڬ\x1b\xf4\x14t\xa8Ƶ\xca\x06o\xed\xae\x03G\xadi\x9e\xb5O+#R\x91\xa0\xca>\n9\xe7\x14Y\x91\x1dXnqdE\x8b\xd3\xf0\xe6\x1f\xe4\x85\x17\u07b4\xd9\xe1\x8fK\xf3\xa1\xedm)\x90\xfd\x03\x1b-\u05f6|~`\x95\xc4B\xa1\x1ai\x9e\xdb\xc9\xf1\xbbT\xdc\xf2\x7fS\xde\xe0\xc8d\xd7\xfd\xb0kʲ?\x02\x80W\x103\x15\xaeUS\xddӟ\x00.\x8d\xfa\xa1\xdf\x16\xe5\x9a.\x98\xe0\xa5ͤ\xbe5\u05fd\x93ax \xb0\xf2\\\x94\x1f]\xd7\x1f\x00\x00\xb0\xbcb}\xda>X1\x18XoA\xc1\xa5\x89hqA=\xf2{c\xfbܿ\xef\"\xfcr\xbf+5;\x8a\xb3\xa866ʲ\"E\xa8Z\xbb\xa0\xbf\x17\x00^\x81/Ϧ\xee\x03\xa9ݶmG\xf3v\xaa\xb9e\x1f\xd9\xf1/\x96\xbb\xe9\xfb\xc8A\xb0\x14\xe8\xa9\x06\x00\x00\x00\x00\\t\xf5\xf4\xae.p=\x86\\)8Q\xb1\x94s\xf6\xed\xec8\xb3\x1b\bT\x04.\xaa\x18R\xaf\xe4LiE\xf6,\xa6Y{k\xb9\xcck0\xba\xb1\xbf\x038ZM{\xda>SW\xa5\xb7\xf2\xc1\x9e٘)z\xfaЁ\xa5\u05cc\x14s^3\xe0ͫ\x1e\xf8\xa2\xe8\xdb=\xb9\xd1a\xd7VP\x9b\xa2V\x01l\xac\xb4\x9a\xa8O#-\xe6VO\xb4\xa6\xcc\xe06\x9d\xb8\xed\x93T\x0eq\xe4\x06\xe3/\xeb\xf7\x00\xe0\v\x85`E\x95\xfd\t\xcd\xc3\xfd\x19\xe0Ҩ\x0f\xefS\x96-\xfaԬ\"\x95:\xd6>\xff\x9d\v\xab\xb2\x03+-\xb3\xb4ݨ\xd2p|C\x7f\x06\x00\x80啅4ay\xbf\x12(\x805\x15\x14}Z\xe9\x17\x17\xcc#\xdf\x1e۳?\xac\x13\xf5\xcc\x0e\xb6$\x1f\x14ډB\xe5\xe4U\xc8\xe5\x87݂%R\x9a\x10\x1c\x00^Y\x17\xc4^\xefZ6m\xf9H\xb6\xaf\x98\x95\x1al\xfd:m\xbd\xe5\axǊ\xa5\xc1\b3\x00\x00\x00\x00\xc0E\xd7\xd4O*\xf4\xab\xb0\xbb\x14P\x1b39\x97I\x99m\xdd\xe8\xaavq#\x80\x8b\"\xa4Uw\x9c\xa5\xb3\x90ɻ\x81\xa5=K\x86v\xaa(\xaf\xe8\xef\x00\x8eX\x98\xdaC\xd9\xef'\xf6\x80\xa6U\xa3\x98\xe8\x04Xrm\xd9M\x8c\x02\xbc)O\xff\xc98?\xf3\xa0\xf2\xd2\xea*\xe3Tg\x99\xa8p\xdb\xfdE\x00\x1b)\xecu+\x05(\xafm\x9bj\x94\x7fy\x7f\x018b\xb7\xfd[\x06\xfb\x008\xaf\xd8\x05\x0f\xdbw\xd8\xef\xcf\x00\x97FS?\xb7\x98\x04(m/\vZ\xef\xfa\xd6:\xb4ہU\x96\x92rk\xff䃓\xfd\x19\x00\x00\x96\x97S\xb0:)\x81\xec\xc0z\v\x8b1\x7f\xb8`Ξ\xfbW\xd2\xc1sJù\x9a*W\xf0\xfb\x8a\xfe\xd0\xf2\xd4R\xde\x17\xb6\xbf\xaf&L,\x7f\xdd\xed\xff\x06\x00|\xa1X\x9f\x90\xaa\xe3\xb6s(\xe5s\x15\xe5u\x1a\xdf\xfc\xefx\xaf\x85\xa5BO5\x00\x00\x00\x00\xe0\xa2\v\xe1i\xe5.X#\xb4\xb6\xa3\x99\x9cO/\xaelk\xe6\xb65\xb6O\a7p1\r\x9b=\xfb\x1c\xa8.,\rƉ\xb2֩\n\a\xd25\x7f\x95\xceJ,\x85Az\xd19\xb0g2\xec*\x06{V\xb7\xceh:\xd9Qަ\x19\xa7\x01\x1c\x19wLU\xdb(d\x96\x16S\x90qp\nuie\x89m\x8a\n\xdbOip\xc7\x19\xca\x12\xbc9/\xfc\x1b\x8dg\xcf+\xab\x8f\xa9\x9e\x8f4\viUv^_\x01\xeb\xac-\x1a5\xca\x14\xa3\xd5\x01S\xff@1\xb7r\xc6\xca\x15+k湕9\xf1\n\xbb\xa9Q\x9dJ\x98\x93_#\xdd\xf2w)k\xb04\x0e\xb7\xafWS\xecJ\x8d=\xab!mW\xaai\ajS}\xc9\xda3\x006W\x967V\x86m\xa7\"Mz\xfc\x7f\xfc\\41p\x91\xb5\xa9>\xd5\xe6\x8b-կ\xd2\x04\x91\x9f\xf7΅\x89\"\x81\x95V\x95:p{\xd25\x7f\x93v\x11\x00`\xa9\x1d|\xe6\x03q,k\x17\xe5\xe9\x1d\x12\x80\xb5U\x97jw\xdf\xd6\x1f\xe0\xcdj>q<\x8e'\x0f(ˏ)\xda\xcf6\xcf\xe6\xf2\xed@\x99\x1b\xcag\xb5\xb5\xf5\xed\xb8\x19\xaa\xb4c\xb5i\xec\x17\x80\x8d5\x9ai\x1e\x1a\xb5\xb5\xe5\ai\xe6\v7S\xc8g\x8aÙ\xaa\xbcUU\x9c\x91\xca\xca\xf2\xe9\x03U\ue132;\x1f\xa0\x1f\x01K\x87\x91@\x00\x00\x00\x00\x80\x8b.#\x10\x058b\x8b~I\x97V\xe5Iҗ\x1f.\xf6\x81%\xb0\x98\x9d\xbf?\xe8\xf9nE\xf6\xfe\x00\xc0\x11i\xe4}\xaa\xc7YbL\xc9Ծ|f;yk;\xb5j\xfb\x02ެ8?\xa3,\xe5\xf7qڭb\x99\xa7\xd5\xd8\x1d\xabX\x02\xeb\xccE\xbf(OR\x9cU[(\xccG\xd2|(_\x15ݼ)]\x19S\x1eʍ\xbfX\xe5\x8d\xff\x92\x1a!\x96\xca\xd6\xf8+5w\xcf\xda\xf3[\xdaÜ\xda\xd5O*χ\x8a]\x8c\xe0<}\x00\xd8PAV\x88Y\xa3\xa9+\xb8\xda'\xbbs\xc0\xa5\x901m\x02\xb0\xde\\T\x9e\xb1\x1a;\x00`\xf9\xb9\xb6Z\xbcHJ\xefx\x01\xac/\xd7hP\xde\xd0\x1f\xe0͘\xde\xfd%\xb1\x9d\x0fU\xe6#)?'\x97\xe7\xfd\x15\x00\xf8B\xa1\x1aɩ\x90+\x1b)\xab\x16\xc3\xec\x1a'7\x1b+\x9b\x8d4\xd2\t5zZa+Sy\xea[\xba\xbf\x03,\x1b\"\t\x00\x00\x00\x00\x00\x17]\x9e^V\x018:\xcew/\x8c\xfd\x8b\x91\xc2\xd1++\xae_\xec\x03ˠ}1\x90\xbd\xb6-(\x97\xb3G\x96\bY\xe0\xa8EWYZ\xb4\xf2#\xe4\x964\xfb\xd7\ti\x84\xbc\xb7\xb4\xea\x82*\xf7\xf6\xc59\xe0\r:\xb8\xfbñ\x9d=m\xcf\xd4\xd8\x1e\xb8\xaa{i\x95\xfb\x1d+\t\xaa\xc5\r\x00֒O\xf3\xa1؇\xf3\x8d\xdaXZ]p\xd7ʙ\x91\xe5\x03Y\xaa\x05ZYsF\xed\xf8\xa4\xf2\xdb\x7f\x96\xce\x04,\x9f\xb7\xfemW\x15\xf6\x9d\xf7+^\xa4zQf\xcfn\xb4}\xca/`\xa3Ŵ\xf2\xa0\x95e\xb9\xb5\x9ff\xf5\xa7\xfb\xb3\xc0\xc5\x15\x9f\xfc\x96\xc8\xfb\x17`\xcdŠ\"\xbf\xa9?\x00\x00`y\xf9v.\x85\xf4\x0e\x89\xfa)\xb0\xd6|\xab\xe2\xfa\xff\x8d\x84\xfe&\x1d>\xf4\xa5\xf1\xe0\xf0g\xe5C+\xb9]\xfb\xb9Z\xd5?\x9f\xf6W\x01\xe0\v\xb9j\xa4̧\xc9\xc2kE\xcd\x15Cny\xc8\xd8\xea_Ce1\x97Ꙧ\x85T\x9d\xfc\xcd\xd2\r\x7f\x99|\x1aK\x89@v\x00\x00\x00\x00\xc0E5\xfb\xcc\xd7\xc7A\xec\x83g\x01\x1c\x91\xac\xfb\xecV\xbd\xb6\xcfF^e\xf9\x8e\xee\x1cp\xe4\x1e\xfc\xd6\xe8S1\x916gO\xa7si\x91\x19e\xf4\\\x02G\xceŹ\xb2\x94 \x95\x02\xd93E\xeflk\x14c\xa3ڊ\x14\xb7\xf5%\x8b\x1b\x817 >\xf8\xdbc6\xf9\x94\xb2\x98\x06h\xd8\x16\x86݊\xec\xf2\xd3T]\x01\xb0\xce\xda\xd4>i,\xadW\xdd\xc0V_X9\x93\xa7\xfdI*|4\xb7\xc3\xe1\xed/\x90\x13`i\xe5\xe5\x17i\x16f\xb6\x97\x9ec\xfb\x8ag\xe5\\\xd1Փ\x00l8\xab\xcf:\rT\xcd\xef\xedO\x00\x17\xd7l\xfeI\x95-\x9dh\xc0Z\xf3Q\x83\xc1\x1d\xfd\x01\x00\x00\xcb\xcb7\aRp\xb6ӟ\x00\xb0\x96\xe6Y\x9a\xe5\x13o\xc6쳿5\xce\xcf\xfdG\x1d+\x9c\x8a|\xaaX\u05ca\xedq\xb5/.N\x02\x00\xaf\xc0e\x8d2\xdf(\xc4Jm\xf0\n*\xadޕI\xd9\\*\xcfع\xa9\x86ۿU\xc3\x1b\x7f\x80w\xacXZ4\x15\x00\x00\x00\x00\x00\x17U\xdb|V>\xa4\x15v\x01\x1c\x19\x97\xdbG\x1aH\xbbX\xe1\xbaq\xa5\xb2ỻ}\xe0\xc8ͬ\x9ch\xd3\v9o\x7f\x16Ϩ\x0fQ\x99K\x13/\x008R!E\xab\xa7w\\\xbe\x1b{d'\x14bT\xdd\xdaq̴}\xeb\xf7\xf0\x02\foX\xb3\xf7\x93\x1a\xb9y\xb7\x98\xad\xec;\x86]{Ҝ=kg\xed{\xbc\xb8\t\xc0\x9aJ\x81\xecA\xd1\xea\x80]\x1b%?\x94\x8aӖ\xfem\xbf\xcd\xe5\xb6\x7f\xe7\xe26`I\xed\xdc\xf2sn\xe6j\xc58\xb1jҮ\x1a\xfbviB\xae\xae\xde\x04`S\x85nUlkCEk/\xb5\xcf/N\x02\x17Y3\x7fTyK\x1f\x1a\xb0Κ4&\xfd\xd6異\t\x00XzEH\x81\xeciT\x02\xe1)\xc0:k\xb2S\xfd\x1eސ\a\xfe`l\x0f\xfe\x83\x86\x8dT\xba\x1d;1P\x88\x8d\xdah\x19(\xcd{\x00\xaf\xc6\xefI\xae\x96ks\xa9-\xe5]aǭ\x9d?\xb4-ʍ?\xa4\xe2\x96\x1f\xa4\xff\x00K\x8d\x96\x02\x00\x00\x00\x00ࢊ\xcd\xd3\x12\x81\xec\xc0\x91j]֭\b\xa5\x90\x82\x843;.\xa4\xb7~'\x1d\x97X\x0e\xf5\xc3\xca\xec\xf1\x8c)\xa0\xe9\xc5\x15\fC\nl\xb7\xfd\x90\x82\x9c\x00\x1c\x99\x14\x8c\x95\"\xd8Ӫ\x82ζ<*\xcd;\x11\u008e\x8a\xfc-\xfdM\xc0\x1b\xf0\xd0\uf25a\x9c\xb1|\x7f(\xa5\x85\x1b\xec1s>\xb7\xaf\x14\x14\x98n\xb0\xf3\x00\xd6V\xf0\xa9|I\xfd\x05\xd6:I\x03\xb3\xfc\x81\xd2\xda\xd6\a1W5\xf8\x90ʛ\xff\x05m\x15,=W^\x9bJ-\xdb;\xbeh\xbe\xa4\x87\x9a'\x17\xd8p\x99\xe5\x03\xa9_\xa3\x91g\xe01.\x91X\xeduE\x10\x80\xf5\xd5fT2\x01\x00\xcb\xef\xf0\xfeo\x8c>\xa6\x1e>o-\xa2\xae\xa3\x04\xc0\xba*o\xe8w\xf0FTg\xfe\x81\xb6|\xa6q\x8a\xe4\x9b\xed[\x9b~KY\x16\xd5jO\x99N.n\x02\x80W\x90z\x9eӜ\x17.\f\x94[\x8e\xe1}\xab\x99\x9bk?\x8cu\x98_)\xff\xae\x9f\xa7\x03\x01K\x8f@v\x00\x00\x00\x00\xc0E\xe5\xc3~\x8aN\x04p\x84>7\xeb\xf9bTc\xcc\xd2\n\xed\xc0\x92h\x9eR\x16\x9d\xa2+\x14\x9c=\xad)`6E\x82\xa4\xdew\"A\x80\xa3\xe5\x17\xe5G\b\x8d%\xc74\x93s\xb4\x92$W\x96\x1d\x97\x1f\xdf\xd4]\x03ސs?\xac\"\ue907Kj\xbdZ7P,\xf6岙|\x9a\x03\xab+\x03\x00\xac\xab`\xe5\x89%x+V\x06vPZ\x9ao\xd5%\xfdѝ*\xdf\xfd3T\x00\xb1\x12vF_$\xe73{\x86gi\xb8\x90\xb5\xbb\xed)N\xcf6\x80\x8d\x15eeZ\x9a\xa0/V\xd6jb8\x16.\x8d\xac\xb5\xaaSjW\x01X[!\xa7L\x01\x00,\xbfj\xf6`\xd7\x16J\x13$\xa7\t\x91\x01\xac\xafrps\xbf\x87\xd7+~\xec\xfd\xb1[\x80$\x8c\xec\xe0\xb85\xe7S\x86\x99&\xfe\x0eJ\xf3W\xc5\xf4N\x1e\x00\xce#\xadA\xe1\xe2\xd02\x8b\x17Wb?P\xa3\x81\xe2\xf0\x83\xda:\xf5M\xfd]\xc0r\xa3\x97\v\x00\x00\x00\x00pQ9\xa5\x15\xa0\x01\x1c\xa5\x98\x06ק\xe5Mc\x9f\x1e\xd3\n\xed\xc0\xb2h\xe7\x8b\uf61e˗\r\xbcu\xe9\x99%\x8e\t8R}y\x11\xbb%\xb2S\xfa\xb4\xcdҥ\xcfv\xa4\xc1U\xdd5\xe0\xf5\x9a\xdd\xfd\r1N_\x90\xb2\xab\xacj2SU\x0f\xecY;.\xe5\xfbi\x8c\x9be\xff[\xf6\x9c1P\x03Xg!\xadV\x9b\xca\x18g\xe9\xdf[\x9awe\xb7\x88\xed`\xfb\xce\xfe\x0e`\xf9\xf9[\xfe\xa5\xf3~he\xd9Ye\xee\xa4Bh\x17\xc1\xec\x006Xn\x99C\xaaǶ\xb67Z\x9c\x02.\xa6\xb3\x7f*\xba`\x95\xa8\xae\xcd\x0e`]EO\x1f9\x00`\xf9\x85\xe6\xdcb\xa7\vd\xa7~\n\xac\xb3\xbc\xbc\xb2\xdf\xc3\xeb\xd1~\xe67\xc4z\xf21\x95\xf9Ha\xb6'5#\xf9\xe1X1?P\xd4Ty\xbcJMk\xe7\x01\xe0|\\\n`O\xfd\xcei\x1cϤ;\x15\xb3\xb1F\xdb\x1f\x94\xae\xfd.:\x0f\xb0\x12\bd\a\x00\x00\x00\x00\\4\a\x0f~I,th\xadOV\x7f\x06\x8eR7\x1b\xa7\xab\xa5\xd66W\xaa\x19\xbd\xaf\xbf\x02\x1c\xb1\xc7\xfeL\x94\xb3\xe7S\x95\xbcj\xf9P\xa4^\xf6ŵ\xd4\xf1\x9e&a\x00pd\x9a\x14\xb8ގ\x94\x15V~\xe4^n\xe24\xac\x86:\x17\xee\x93n\xf8>^\x84\xe1u\x9b=\xfb\xe7c]}\xbf\\>\xb6l\xfe197P飲xNΞ-ն\xf94{8\x81\xec\xc0:+\xe7\xa3.\xbd\xb7\xc3=\x85\xed\xc75\x994\x1a\x96\xbfZ÷\xfe#\xca\x16\xac\x94s־vJ\x83\xb3'\xaa\xdbVn\xce N`\x93\x95iI\x9cP(x\xa9\xa8\xb7\xb4\xf7\xc0;\x89\xde\xc0E\xb5\xff\xf4\xbf\xd2\xd8\xedus(\x00XeC\x85\xd4\x05\xe7*+FfV\x8eԊ\xe9D\x18Hq[\xfb;\xbf\xad\xbf\x0f\x00\x80\xe5u\xca\xdfo\xe5\xd6\t\x85|\xa6\x92\x89ʁ\x95v\x98\x95i\x90\x91d\xf5Ґ\x97\xaa\xd3{;\xcd\xec\x9c\xd5Y\xb3\x99\xdcu\xff\v\x89\xfcuj>\xfd\x9e\x18\xce\xfc\x98ʭ\xf4C\x8c\xf2y\xfa\xf9\x9e\xb1\vA\xae\x1dڏ\xdb~\xe6:\xab\xb2\x1b;\x03\x00\xaf\xac\xd5I\xcd\xf3\x17\xa4с\xe5ɹ&A\x1a_\xfe%*n\xf8\xab\xe4\xcbX\x19\x04\xb2\x03\x00\x00\x00\x00.\x9e\x144\x9b\x06\xf0\x018R!\xa6\xb4軸\xe0\xe0*\r\x067\xf6W\x80\xa3\xd5\xd6\xcf\xf7{\x00\x96\x91S\x1fP\x9c\xc2/R\x9d.\xb3\xcd\x1fjk̄(xc\xeaß\xd4P\x83\xf4\x96\x15\xc0&\vV\xb0\xe4\xb5\xc2\\\x9a\x1fnkp\xd9)\x15o\xfb\x8ft\x1e`\xe5\x8c\xc7wZ\xfd(M\x18\xd7ʧ\x91\x17<\xc5\xc0f\x8b\x8bJ\xae\xb3\xbc \xda~l\x99\x9c\t\x17\x97\x0f}{\x9d՚\x81\x15\xd7Xّұ\x97\v\xfd\xcc\x14>%\xee\xca\xd2\xf8\\E~\xed\xe2\x1c\x00\x00\xcb,\xf5\xf7\xa56Q\x18Z\xb9V\xf7'\x01\xac\xa2\x14î\x98\xd2t\xec\xfa7\xac\x96\xbah{\x9aЭ\x04\x8cףy\xf47\xc7v\xf6\xa4U\xf1\xed\xe7\xd8\xec\xf4g\x01\xe0\xf5\x8b\xf1@\x83\xf9\x15\xd2|\xa6\xd65r\xc7\xff3e\xd7\xfe\x00\x1d\x83X)\x04\xb2\x03\x00\x00\x00\x00.\x1a\xdfN\xe4\xd3\v+Ǌ\xba\xc0Q\nqb\x1fi\xd6\xe4\xb4\xe6\xf5\\\xa3뿛NL,\x85\xbaz\xb0\xdf\x03\xb0\x94B\xbb\b\xceJ\xb3\xc0\xa7:]\xe6\x15\x8bJ\x83\xdb\x7f\x86r\x04o\x88;\xf7\v*\xe2Ȟ)\x1e!`\xa3e\xd6>\xf1Q~vL\xd1o)\xbb\xf5i2\x05\xac\xa4\xe1m\xff\x8f\x9b\xe7\xa9X\x9b\xa7j\x92\xed0S\v\xb0\xd1R\xf0\xba+R\xf7\x9b\\\xac囪\xbf\x00\\\x1c\xbe\x9dY[\xddv\xd2C\a`e\xb5\xaa\xad\x8dd\x7fR\xff[\xeb\xba\x01\xbd·nR\xe26\xcet\xf2\xea\xbfF{\t\x00\xb0\xd4\xe6\x0f|S\x8av\xb5=\xdb\u0096\x15dL\xea\x05\xac\xb2\xac\x9bd\xc9\xd2s\xcc쳕\xeb\xc6\xfcٹ\x18\xe4r\x16\xcdx=\xe6\xf7\xff\xb1X\xbd\xf0#*\xea\xd3\xca\xfc\x96\xda\x17'\xae\x02\x807`\x90\xea[i\fO\xb0,y\xe7\xad\x1a\xdd\xfc\xe3\xf4\x17`\xe5Г\r\x00\x00\x00\x00\xb8h\xf2\xfa@\xbe\xa5\xbf\x048j.\xad\xa6۽\x10)U\xdb\x06,\x8b0#\x90\x1dXf>\xbd\x01\xcb\xd2\no\x03\xb9\xc6\xf6]\xaeI\xbe\xd5_\x05^\x9f\xd9gn\x8b\xe3ɡTϭ\xa1\x90\x06\xb5\x01\xd8TqXIU)WN4\xday_\x7f\x16XM37R\xaa&uC\x10]\x8a&\x04\xb0\xb9\xac\x9ekm\xa6nu\xecXY\x1bʎ\x81\x8bȷ\xd3n\xe0j\x8a'\x00\xb0\xba\xa2\xac\xcc肃\xac\fi\xed\xbb\xeb2iո\xd6\x0e\x99(\x1c\x00\xb0\xfc\xea\xf9]V/\xb5\x8a\xa9m1\x0e\xba&\x11\x80\xd5խ\xc8n\xf5\xd14\xdbR\x8c\x8d\xa5i\xab\xa7\xc6\xdc\xea\xa7N\xb1\xb8#]\xc4k\x10\x9f\xfc\xc3\xd1\x1d|\xbfƓR>\xd5\xeb\xf3B\xc1\xef\xf5W\x01\xe0\xf5\xcbj\xcbK\xb2\x17\xd4\x1c?\xa6\xfcm\x0fS\xe3\xc2J\"\x90\x1d\x00\x00\x00\x00pѸz_i\xb5E\xc2T\x80\xa3\x95V\xef\x90\xcfl\xf3\x96$\xaf\xea\xcf\x02G\xcf\xd7\xcf\xf5{\x00\x96\x91\x8b\xe9\x15B_\x93K\x83\x90T\xaa\x1d\xbfsq\f\xbc\x0e\xcd\x13\x7f:\xba\xe9}V\x1d\x19\xa4\x88?{\x94X\x9d\x12\xd8h\x96\r̚=\xc5ݑ\xdcm?\xcc@\v\xac\xb4\xb6\xbcM\xed\x8bA\x84\xa9\xed\r`\x83\xd5\n1\xb3\xfc\xa0\x90s\xb5\xb2\xba\xee\xcf\x03\x17^\xfbԟ\x8aEH+\xb2g\n\x8b(\x03\x00+*\xa4\xbe\xb7\xb4\xeae\xf4\x8b\xbe8K\xd3\xc1\x0e\x83\x1bJ\xc5\xf1\xfe.\x00\x00\x96W\xac\x1f\xb5\xf2\xab\xb5\x12\xcd\xca2\x9f\x82_\x01\xac\xb2\xbc{7\x9c\xfa9\xd3^Z4\xc3\xea\xa8v\xd8x\xaf\xe2\xf6\x7fN\x7f\xfekT\xef}\x9f\xca\xe6)\xcb\x1fG\xb6\xa5\t\xaa\xa6rL\xf4\r\xe0͘\x05ͷ-w\xbe\xfd,y1V\x16\x81\xec\x00\x00\x00\x00\x80\x8bb\xf2\xf0\xb7Ƣ9\xec;c\x01\x1c%\x97\x06A\xf94\x18\xaaR\x91\xddޟ\x05\x8e^\xd9\xcc\xfa=\x00K)\f\x15B\xae\x90\x06\x1eŠ\xa0\xb1\x06\xdb_\xd9_\x04^\xbb\xea\xe0\xff\xa7|\xee\xa5©\t#\xab\x974\xfd\x15\x00\x9b(\xce/W5\x96\xb2\xf17\xf5g\x80\xd5U\x8e~\xadՑҰ\v\xb7\bB\x02\xb0\xb9\x9c\xd4\xc6\xccr\x82\x14\xccު\b\xacȎ\x8bg>\xfb\x05\xf98\xb5\xbdB!2v\x15Xi\xdd\b\xde\x14\x1c\x94\xd2r\n\x18\n\n\xadSt\xbbV\u05fc!]\x04\x00`\xa9e\xeda\xf7휵\x87\xb2\x03\xf9Pv\xc7\x00VTHc\x8bR\xad\xd4-\xaa\xa8\xe9 fjӪ\xe2xMf\xf7\xbf?\xba\xb3s\xa9ͥ\xc1\vRS\xab\x0e3en\xbb\xbf\x03\x00^\xbff<\x92\xdb\xe1\xdd*V\x1b\x81\xec\x00\x00\x00\x00\x80\x8b\xa2\x9a=(\xa5\xc1z.S\x88\f\xe4\x05\x8eR\x97\x04\xd3,\xe8\xbeѰ|\xf7\xe2$pĪG\x7fO\xf4\xb1\xe8\x8f\x00,\xa5P\xa8\xb5\xcdga1F\xc1oip\xcdwvC\x16\x80\xd7\xec\xc1o\x8b~\xffien\xd7\x1e\xa2Bq8Wl\x19\xec\x02l2\xafJ\xe3\xed\x0fH7\xfcu\xca\x14\xac\xbc\xed\x1b\xfe\x86\xf3~l{\x99\xa2cEv`\xa3\xa5\x18\xc4\x10\xbb`\xf6\xd4~ʂ\xe5\tO\xfei:\xc6qQT\xd5=\x8b\x1d\x9f\xab\x89\x94?\xc0*s\xce\n\x904!Ez\x91\xe3\x16\xc5F\x88^ޝ\x94\x867u\xc7\x00\x00,\xb32\xf4\xfd\xfd\xaeP\xf4\x87V\xa6m-\x8e\x01\xac\xa4\xb4FF'\xa5\xe9\xf4\x9d>\x82S\xf0t\xe7\xbf\x16\xf3\xbb?\x10\xb3\x83O\xaah\x06\xf6\xb3K\x93{KӬPk\xedw\x97V\xb8\a\x807\xa8\xbe\xf67\xaa|\xcb?$3\xc6J#\x90\x1d\x00\x00\x00\x00pQ4ՙEgvZ\t\x9a\xe6'p\xa4b\xbf\x92GLI\xf1\xf6\xbfD\x87&\x96BU?f\x9f\xf9\xe2\x00\xc0r\n\x85\x95!\x99U\xe5\x1a\xab\xd3ٗ\x1b\xf5\x17\x80\xd7n>\xfbn\xe5\xfb\xbb\x96\xe5\aͫ\xb1\xf2\xad\x03\x85\x8ag\t\xd8h\xa3s\xcao\xfe\b\xed\x12\xac\x8d\xcc[\xb9f\r\xee\xc8D\x8e\xc0f\xb3\x92\xed\xa5\x95\xb1\xed˥<\xa1\xd9_\x1c\x03\x17X\xdd<\xbbx\xff\xa2L-\x81\xec\xc0J\x8b.\xb3\x0fK\xd0]]\xd2\xd2s\x8akO\x93\xa2hK*.\xef\xee\x01\x00`YM\x1f\xfeS1W\xbf\x02{\x1a\x1b\x94\xc6#\xc4\xc1\xe2\x18\xc0z\xe8\x06\x1a\xd9W\x9a\x80\t\xaf\xea\xb9{\xfeXT\xfd\x8b*\xa6ǭ.\xff\x82\xe5\x89\xfb\xaa\x9a\xab\xa4r[.۱f\xfca\x7f'\x00\xbc~\xa3k\xff1\xefV\xb1\xf2\xa8M\x00\x00\x00\x00\x00.\x8a\x13\xf9Ǥp\xabTL\x95i֟\x05p\x14\x8a&\x8d\xa4\x95f\x8c\xa9\xc7\x12\x19L?\xaa\xd8V\xfd\x11\x80eԔ3\x95\v\xa6\\$\x00\x00\xff\xf4IDAT\xfe\x9ct8\xd6\xc1`$w\xe7\xc7y1\x86\xd7ez\xff\xef\x8f\xfep\xa2|{_js\xc5\xf2\x05\xfb\x9627\xef\xef\x00\xb0\x8efEa\x95=+2\xaa\x994?\xae\xca\x0f4+\xadA\x92_\x99f\xb7\x90\xde\xf5b\x94\x1f\xb0\x1eB\xf9.+߶\xe5\xc3A\x7f\x06\xc0Fj\n\x8d\x8a\xb9ڴ\xb8\x96\xdb\xee\xfaś\xfd\x8f.\xae\x01\x17\xd8\xe5\xed؞\xb3R\xf2\xad\xb5\xdby\xff\x02\xac\xb2\xa2\xb6\xb4\xec&\n\xc3F\xf32Z\xbd\xb2Р=\xa9I\xf1\x8b\xd25\x7f\x83\xb6\x13\x00`\xa9\x8d\xfe_\xf6\xfe\x03\xee\xb6\xec\xbe\xeb\xfb\xbfk\xedr\xcaSn\x99\u07bbfFcI\x96,Ʉ\x10\xdb`\f\x06c\b\xcd\x14c\x93\xf8E\xf1\xdfT\x1b\b\xbclp\x01\xe2ĉ\x1dH \xa6%@\x00\x83)\xa6\u0600m\x1c\b͉mْG\x9aQ\x1bi\x9a\xa6\xcf\xdc\xfa\x94sv[\xeb\xff[\xfb\xec\xab:#\xdd\xf2\xdc\xfb<\xe7\x9c\xcf{f\x9f\xb3\xf7>{\xa4\xd1\xd5^}\xfd\xd6\xda\xfd1+\xc7\xf6\xec\xb8N\xa1;\xa7\xdc\xdaCm`R\x02\xb0\xcc\xdarGuؒϣ&;7J\xa3Ӫ\xa7\x1b\xf2\x93\xaf\x19\x9e\xc0\xeb\xe9>\xfa\xd5\xf1\x86\xf9\x0fhT\x8f\xa5|\xd7\xda\xeb\xf6\xed\xc6*uV\x93zG\xa3f&\xd7\xd8=\x00x\x03\xf5\xb4\xb2|\xa3R\xb0\xbc\"\xe6\xb9\xe5%s˔窶\x1e\x92\xde5\xa3\x7f\x00+\x81@v\x00\x00\x00\x00\xc0U\xe1\xba\xf49\xb3\x83\xddv\x81\xc3\xe6\xd2\xea\xe71\xcae\xa4G\x1c\x1di\x97g\xc7+\t\x1ci\xa9\xf8H;\tʝW9z\xb8\xbf\a\\\x92\xe6)\xf9~s\xc0\\Q\xbe?\xfa\x9d\x1b\x98\xa8\x01\xac4\x1f:K\xe7v\xe4i\xc2\xd6\x19\x95\x96\x11\x14\xc1\xa9\t/I[\x8f\fO\x01\xabc4\xbe_\xcaf\xf24p\x80\xf5\xe6\xa2b\xea\x7f\xeb\x1bR\xc6\xca>E\x16\xf0\xc3U\x92\x16\x87l\x8b\xb4%\x9e\x95?\xfd`\f\x80%\xb7(?Rّ\x82\xff\xa2rf\xf6\x02\x00\x96@\xec*\xc5Ф\x13+\xc6\xfa\xc1\x00k\x1a-\xbe\x01,'g\xd5Q\x97\xc6\xf2\xa2\xa5\xed\xa2\xea7\xcd\xe8\xdc\\E~\xfb\xf0\x04>\xdb\xfc\xc5?\x11\xab\xf9c\xd6Nφ;\x00p\xe9\xcaY\xae\xba=!?\x99[\xb5\xaaScY\xf0|\xfb^\x8d\x1eb\xc3\t\xac\x0e\xba\xbb\x00\x00\x00\x00\x00\a\xae{\xf6OF\xb5\xb9\xfa\x95\x97\xc3H\xac\xb7\f\x1c.\xe7}?\xda\xe4\xfd\xbd\xc3\x1d\xe0p\x85\xe7\xbf;\xba4\x88\x973\xd1\x168\xca|?S\xa1PUH\xe5\xf4ˆ\xbb\xc0Ez曢\x9b\x7fHY?gm\xa4`\xad\x02g\xef\x94\v\xf6Bu\x04\xb2\x03\xab,뢪\xb6\xb5\x93\xad\xc5D7\xed[\xba\xef\xb4\xe7\xac]\xf2\xb6ǘl\x81\xd5s\xdf_su\x9e\x16s,\x17\xd7\x00֘S\x96J\xba\x14\xc4a\x7f\x87ngq\x1b8@\xf3\x8f~cTgu\xac\x96r\aX\x19}\x10{Pf\x7f)\x05\xff\xb9Vy\xfe\xd0\xe27\x00\x00\x8e\xb0\xd8T\xd6\xfc\t\n\xa9\xfc\xf2\x8b\x99An\xb1\xba-\x80%\xe5TZ\xf5\xb4\xb0\x93Z*\xd2n\xc0\xb9:?\xd7\xe8\xae\xef\xa3o\xffuė\xfe\xbb؝\xfaA\xf9\xbd\x99\x02\xf3_\x00\\\x89j\xa2\"o\xed\xa4\x94\xba\x99\xdaіƏ|\x9c\xbc\x17+\x85@v\x00\x00\x00\x00\xc0\x81\x9b\xcf> \x17&\nn߮\x8a\v\v/\x038,.\xaa\x95S^\xbeu\xb8\x01\x1c\xae\xd9\xec?J\x8d\x93\xb2\xd4\x01\x0f\xe0\xa8ri\xa5}MT\x17\xf6u\xd7_`\x80\f\x97$\x9e\xfb)\x95\xcdKv\x96I\xa1T\xeb\xec}\xf2v\xa4\xdd\x02\xd3\"\t\x00VV\x16\xbc\xa5\xf7L\xc1[]/\x95\x1eiwv\x05\x15Ǿ\xbc\xff\x1dXEU^*\xa6ݗ\x01\xac\xaf\xbe\x8e\x9b\x16\x93L}\x1d\xa1\xff[\x91@v\x1c\xbc\xb6yt\xf1\xbe\xc5Q\xbf\xf1\x7f\x1f\xff\n`iEo\x898Fk:Y\xc1\xd1\xef|\x99\x16\x96\xb4\xf6S\xf9\xce\xe1\t\x00\x00\x8e\xb0\xae\xb6\x8f\xd4\x16\x1a\x0e\xfbۋ\xf1_`\x99\xa5\x05\xa9\x9d54c\xb4\xf4\x9d\u05ca\xc1ڞ>\x1f~\xc5gkN\xffEM\xce\xeek\xec;\xfb\xf3\x1an\x02\xc0\xe5ȣ\\fyou^M&\x157|\xcb\xf0\x03\xb0:\bd\a\x00\x00\x00\x00\x1c\xb8P?)\x97fP\r+.\x038lQ\xc1ye\x0f\xfcc\xa65\xe2H\b\xd5G\xe4\xbb\xce^MV\xa4\x06\x8e\xb6N\xad\xa6\xf2c\x16B\xc1\xa5\xd9\x7f\xee\x8fGW?'\x1fR\xd0\xfa\xd4\xee\xe4r\xb1\xee\xe3Y\xfb\xc0\x9e<-x\x05`e\xb9LEV\xaaK\xc1{\xddq\xa5\xac\xa0+o\xd2\xc6}?N{\x04++d\xf7(\xa4\x97\x1d\xc0\x1a\vV\xe6y\xb9>\xc0\xd8.\xa3W\x8c\xe7\x16?\x01\a\xc8u\xcf/f\xfcY\x9d+\xedv\xc90\f\xb0\xdc.$\xe1\x18\x83\\̬4\x89\x8ay\xa7\xfc\x9e\xbfK\xfb\t\x00p\xb4\xbd\xf0\xadѻZ>\xd5M\xadR\x1a\xd2\x0e\x17\xb1\xb4zjZ$\x19\xc0\xb2r\xd1˩\xee\xd7WJ\x03{\x9ds\xd6\xfc\xbce\xf1#>\xc3\xf9'o\x8b\xf9\xd9F>\xdc,\x95\xe7\xd45\xdb\xc3/\x00p\xe9\xbab_\xaa\xab~Q\x8cv\xfb\x1b\x94\xdf\xfe?\xd2/\x80\x95C ;\x00\x00\x00\x00\xe0\xc0\xf9.\xed\xbc\xd8ȹB1\xed\xb8\x18Yr\x148T.*\xa4]=\x80#\"\xeb\xce)S5\\\x018\xb2bP\xd0T\x93\xe9\xd7\f7\x80\x8bӝ\xf9\xd1ŉ\x1f\xd9Q\xda\xd1\xca[\xbb mF\xd0\xcf\xd0\xce\xd3.-\x00V\x96\xb7\xbf\xa3\xb7:_\xdbO^m\xca\x1b䶿r\xf8\x11XM\xd9\xf8\x97\xcay\xca7`\xad\xf9\xa8\x18ӎe\xfdV\xec\x92\xcb\xed\x16\v8\xe1\xe0\x15i\xd7\xcb\xd4՛\xb5\x8a\xbe\xb1\xd7\xcd\xda]\x00\x96V\xda\xed2\x95\x1b.ؑ\x16\xa8\xb0˘-~\x03\x00\xe0(\xeb\xe6\x8fY\xa1\xd5\xf6}\x81V\x90\xf5s\x12\x14\v\xfbf\xa1?`\xa9\xa5q<?\xeb\xeb\xa5\xeaJ\xc5<*\xf7\x0f\xf6?\xe1S\xce?\xf3\xf6\x98\x9dyA>\xde&\x8d\xf7\xa5f\xac\x90\xba\x84\x00\xe025\xdd8-m'm\xfdJM\x1e\xf8;L\xf4\xc4J\"\x90\x1d\x00\x00\x00\x00p\xe0\xf28\x93\xdcLQ\xa9s\xa5Z\fV\x018<\xb1S\xe8\x97B\a\x0e\xdf\xec\xa5o\x8d\xa3\xb4\xc0\x89KA\x1e\xcc\xc8\x03\x8e4+;\\\xb6)\x7f\xc7\xf72H\x86\x8b\xf7\xec\xb7\xc4\xc9\xfe\x13\x8a\xbeT̊E`\x85\xb5\rr\xe7\x94w#u\xddD!-#\x0e`u\xa5`\xde4\x875M\xda\xf2g\xa4\xcd/Vq7\xbb\tb\xb5m\xde\xf37\x9d/\x99\xa9\b\xac\xbb\xd0E9\xab\xed\xa6\xdd\xd9S\x9fG?\xe9\x1b8`yW,^17WT\xea_\x1b\xf7\xf7\x01,\xa7\x98ʊ\x98\xa6\xaa/\x16\x06\xefˎ\x8cz%\x00\xe0諫'\xac\xecj\xad^\x1a\xac(\xeb\x16\xbb7\xa7\xd5X\bd\a\x96\\\x90\xef\xe7rx\xb5\xcdT.oTd\x8f,~B\xafz\xea\xb7\xc4\xe2\xf4/j\xa3ޔ\xca=\xfb\xa3\x9a\xab\x8b'\x95e\xe7\x87'\x00\xe0\xd2\xe5\xc1+\x1e\x7f\x87\xf4\xe0Oѫ\x8c\x95\xc5\ff\x00\x00\x00\x00\xc0\x81\xcb\xd3j\xcb\xd6\xe2t*\x17\x83T\x91\xe6'p\xa8\xfa\x9d<\xe8\xe3\xc4Ѱ_\x7fHY\xda)*E6Q>\x00G[J\xa2i7m\xe0\x12\xec\xcf~Ly+\xb5.S\xe3\xa2B\x9a\xec\x92\xcd\xed\x17'\x17&\nm\ndg\xc7@`\xa5\xa5\x05,\x1a\xab\xeb\xa54\x9f7\xca7\x1e\x1a~\x00V\x1c\xeb8\x02\xeb\xcdI1\x05\"\xc6!h#-\fF\xb7\a\x0e\xd8\xfe3\x7f0\xfa.W\x8aw\x95\xb3:W\x8f\xf6\x15\xb0\xec\x16\xe5G\x94\x95\x1cV~8\xb9\x8c\x02\x04\x00p\xf45ͫV\x88]\xa8\x93\xa6\xf2,}Z\x19\x96vf\a\xb0\xc4B\xda\x0f\xd8j\xa6\x99bH\x8bV\xb7\xca\x1f\xf8~&\x1c\rο\xf0\xbb\xe2\xfe\xb9\xffG\x93\xf9D\x1aW\xf6ǵ\xa3\xbdzKq\xfa\x8a\xf2\x8c?&\x00\x97/\xf7;\xf2\x0f\xfe\x02\x19\tV\x1a=^\x00\x00\x00\x00\x80\x03U\x7f\xf8\x8bc\x16ƒ;\xa9}wʾ[)n\x0f\xbf\x028\x14aC\xfeė\r\x17\xc0\xe1\xda|\xed\xa7\xa4\x9dT6\x94\xaaG\xec\xc8\v\x1c*\x17\x14\x82\xd4(\xa8-;5\xa5W\xe77\xed\xfe\x86\x94y\x9di3\x15oy\x0f\x03e\xb8h\xf5\xf3\xbf=\xba\xfd\xe7,\x8f\xbfAE\x1bUv\xedb\x13\xb1vd\xf5\x11{\x95\xb2\xb9\x8a|_y\xac\x16\xff\x00\x80\x95\x14\xeami;jo\xdci\xef\xc4C\xcan\xff\xdf(K\xb0\x16f\xedM\xc3\x19\x80\xb54/5ތ\xca:kO\xf9V{\xbe\xb6f\xd5u\xd23\xbf\x81(\x0e\x1c\x98\xe9\xce?\xb0\xca\xd6\x19{\xdd\xeeP\xeb\xec\x1d\xb3\xf7n\x96͆_\x01,\xa3,\xf5̹\x93\xf2\x85\x15\x17\xe1U\xb5\xf3\xe3\xda\xdd\xfe\xd2\xe1W\x00\x00\x8e._ߣv\xd2X\xf95U6\x1b\xa9̥Vg\x15\xe2\xf5\xc3\x13\x00\x96R\xd8TZ\xa3/X=\xb5ȤyL\x03}Hڧ\xbf-n?\xff#:\xd1\xecK\xb9\xd5\xdf[\xfb\x03\xf2\x856\x8a=\xe5s\xcb\x04+\x16\x9a\x03\xf0y\xd4'Ն\xb9\xe6Y\xa7&\x1b+ع\xa2\xe5\x1d\xd9qU\xce\xce\xdf\x15\x19O\xc5\xca#\x90\x1d\x00\x00\x00\x00p\xb0\xbaV\n\x8b]g\xdc'WZ\xa6S\x1b8\\\xad\xca\xfc\xae\xe1\x1c8\\>f\xf6\x99V\xe7\xf7\x8a\xa9\xcc\x00phb\f\xf2\xdeۑ\xcbY\xf5ͷ\xad\xa5\xcc\x14`l\x87\x9d\x97[o[<\b\\\xa4v\xf6\v*R՟\x1dW\x80\xb5\xe6}c\xf9A\xa6b<\xd2\xd6]\x1fb\xd2\x05ֆ\xcbO\x0eg\x00֒s\x9fڍ\xddx\xbbVh\xa5\x8eE\x9cp\x80:kk\xc5(\xa7O\xf5\xa9y\x9a_\xc0\x92\xab-]{;\xacܰ\xaf\x90W\x1a\x97\x0f\x0e\xbf\x01\x00pD=\xf7\xa7\xad\xe4\xda\x1f.R\xf7_\nI\xf1\xa9Ydeڧ\xdaE\x00\x96Pjs\xf6i\xd9\x0eg\xe7D\x9c}\xd2\xceο\x1a\xce\x00\xe02\x94/(ϯ\x97\xef\n\xf9\xb0+_\x8c\xa5lWu\x164:\xf6\xfb\x86\x87\x80\xd5F\xb5\x02\x00\x00\x00\x00p\xb0\xd2ļ\xb4\xb5g\fr\xa9g;un\xa7\xc8(\x00\x87\xc7\xd5r\xb7\xfd\xe5\x94\x1a\x81CU?\xf3\xdfĴ\x13\xbbܬ\xdf\xed\x99@v\xe0p\xc54\x01!-\x14\xef\xbd\xd5ἲ\xd0\xca\xc5\x1d\xfb\xa1\xb2\xaa\\\xae\x8d\x87~\x8e\xb2\x03\x97$?\xf7q\xe5\xe9\x84\xd1'`\xbdY\xfb\xa3\xb5\xfa^>\xfa\x95\xc3\r`=d\xa3\xfb\x863\x00k\xc9ZO\xa1\xef\x17\xb7\x13kc\xf5}\xe3]\xa5\xd0^\b\xee\x00\xae\xdc\"&(ȧ\xf6{z\xc72\xa7\x8c@v`\xa9E\x9f\xc6SS\xdf\xdcFߟ\xd2嵊\x9b\xfe\x12}r\x00\x80\xa3m\xf6!E\x9dMý\v\xfd\x06\xa2\xb9\xbc}\xc5~\xc1d\x00K+\xcd\xf7\xeb\xfb5|\xbf+{\x9e\xbfi\xf8a\xbd\xcd?z\x7f\x8c\xf3\x8f\x0eW\x00p\x19\x8a\xf4\x11T\xc6R\x99k%7\xd7n\xeaB\xde\xf8\xa5\xd2\x03\x7f\x8d~\x00\xac\x05\xa6\x12\x01\x00\x00\x00\x00\x0eTl\xe7\xeawd\xef;\xb6\xedH3\xf8R\xc7\v\x80CSg\xe3\xe1\f8\\\xf3\xd9\x7f\x90O\x81칕\v>W\xd6G\xd1\x028,N\x99\x82\xa2U\xdb\xd2\xc4\xf7\xcc\xee\x04)U\xe3\xa2W\x18ݲx\b\xb8H\xed\x87\xde\x14\xcb=\x9ff\xa9\xa9-\x9a\xe1.\x80u\x14C\xadb{,\x7f\xdf?e\xd2\x05\xd6J6yd8\x03\xb0\x96|\xb4\xe6\xd4\xd0\xcf\xe12km\xd9yh\xd4U{\x8b{\xc0\x01\x88\xad\x1d.\xd8\xfbU\xdbaU-k\x82\xf9\xc0\xf4?`\x99\xf5]\xe4!\xcd\\\x1f\xf5}*M\xde\xcfl\a\x00\xe0H\xebf\x1f\xb6\xaa\xe8\xf9T#\x1d\n3;b\xb1Xl)\xd6\xe9.\x80e\xe5,=\xf7\xabR\xe4\xea|\xa3\"\x7f\xc7\xf0\xc3\x1a\xfb\xc8ۣ\xce}\\\xdbi8\x1d\x00.S\xd0H!\x9c\x96r\xab+\xe5\x99\xe6iM\xd4ѯ\xd0\xe4\xfe\x7f\xd6W\xa9\x80u@O6\x00\x00\x00\x00\xe0\xc0\xb4/\xfe\xf1\x98\xa5\x1d\xd9\xd3@U\nd\xb7#\xa6\x80EO \vp\x98By\xc3p\x06\x1c\xb2\xf9\xd3\xfd\x04\x86\x98\xb6\xeb͜<ݓ\xc0\xa1r>\xb7\xbaZ\xa7.X]\xcd\x15Vg\xb34\x19\xa4&;)\xbf\xf5\xee\xe1)\xe0\xe2t\xfbO\xd8{\xb4\xd9/\x86\x10\n\x82u\x80u\xb6cG\xb6\xfd5\x8b\v`\x8d\xf8;\xfeG&\x1b\x01k.\xa6~q\xb7\x98ռ\b\xe0\xb0\xf6V\xbb\xdb_\x03W\xec\xd9?\x10}\x97)\xd8+\xe6c\x1asI\xc1\x05\xf6\xb7\xb5\xe3\x01,\xb1\xbe\x8b<u\xc8-\xfa\xe5\x94ݚn\x00\x00p\xa4u\xcd'\x94\xbb\xb42\xb2\x95_\xd6\xf4\t.Eb\xa5n\x91\u008a6*\xa8\xc0R[\xac\xb4d߅%\xf1\xa0\xec\x9e\x7f\xb0\xd6}\x9e\xf1\xe3\xbf*\xc63\x1f\xd6ز\xbc\\l\"\x02\xe0\xf2\x85\xb0m\xb9k\xcaR\xf7\xed\xbbS\x9c\xbeE[\x8f\xfc۵\xcec\xb1~\xfan0\x00\x00\x00\x00\x00\x0eBU}@yZ]9u\xaf\xa4\x81\xaa~\xb0\xaa\xb0ovd\a\x0e\x93\x1b\xdd3\x9c\x01\x87\xe8鯏#+\x0e\x9cZ\x85\xdc\xf7\x03\xa0Y\x1f\xd1\x0e\xe0\xd0X\x1a\x8c\n\xfd \x99R\x90\x85|?Ϩ\x1d\xdf+w\xff\xdfg\xc0\f\x17m\xf6\xf4\xdd1\x9b\xdbɨ\x91\xba\xeb\x142\xea\xff\xc0:\xab\xc67J\xb7\xfe0\xe5\b\x00`\xbd\xb8\xb8\x88\xdbH\xbb\x96\x854%1\xf5\x8f\xa7\xd3\xfd\xc5\xef\xc0\x95\x9a\xff\xa2\xbdP\xd6nOk%\xb8\xb6_8!\x05\x15\xa4xv\x00K\xae߹\xb6\xebc\x86\xb2\xf2\x91\xc5=\x00\x00\x8e0\xd7\xed)\x97ULCaWV'\xed\xc7\x04R\x83\xa8\xb4O\xba\x05\x81\xa5\x96\xb9\xbe\x7f#\xf4\v\xa9u\xc3\xcd\xf5\xd4~\xe27\xc5٫\x8f\xc9\xe5s\xa98\xa9P\x11~\a\xe0\xf2\xf98W\xeeo\xd1ܲ\xd6\xdd\xec\x98&\x0f\xbf\x9fJ\x13\xd6\x0e%)\x00\x00\x00\x00\xe0\xc0\xd4\xcdǕv\x99Y̜Z\xf4\xb3\x844x\xe5\u05fbc\x1b8lEy\xefp\x06\x1c\x9e\xb0\xff\x9f5jS\xd0l\xad\xe0\v\xb5\xc1ʆP\x0e\xbf\x028\x14\x9dS\ue77c\xb7\xba[giҾBY(n<8<\x00\\\xa4\xf3\xcf(\xcfR4E\xab\xce[\xdeN \x05\xb0֦\xd7}\xcbp\x06\x00\xc0z\xe9waO\x875\xaf\xdc\xd0E\x1eCZ\xf1\t\xb8r\xa1\xfa\xb0}fæ\xff\x8d\xbd_\xc1Z`\xf6\xa2\xd1\xfe\x02\x96Z\b\xf6\x91\x16\x04\xcfZ\xb9<\xd7d\xfc\x8e\xc5\x0f\x00\x00\x1cai\xdfu\x1f'\xd6\xf6)\x15R\xbb'\xcd\tJ+\xb2\xa4\x1d\xda=\v\x99\x03\xcb,:\xd7/\x9c\x96\xa6s\xb8l1\xf7o-=\xfb\xad1\x9e\xfe\x17\x1a\xb9\x17\xad\xd2~\xb3\xd5\xd7O\xdbM\xcb\xf7\x00\xe02y\xed*\xaa\x92/ߤ\xe9\x89\xdf;\xdc\x05\xd6\v\x81\xec\x00\x00\x00\x00\x80\x03\x13\xda\xd7ҧ\x16;z\x86\xc58U\xbf\rM\x9a\x85\x01\xe0\xb0\xf8\xe2\xfa\xe1\f8<u\xf5\xac\x15\a[v4V*8u)h6-v\x02\xe0\xf0\xa4d\x98\xe6\x14YR\x8c\xd1\xeak]\x94\xcb7\xe4Ʒ/~\a.\xc2\xecc\xb7E\xbfs\\\x1aKM\x93\xab+抝]\x00X[\x1b\xb7~\xd7\x1a\xcfn\x03\x00\xac\xaf\xd4\xc0\x1a\xf4Q\x89\xc6\xda[.\xb5\xb5\x80\x83ХI\xf3ր\xcf\xd2t\xbf\xd8\a\x16t\x8bS\x00K\xcdҵ\xb32$\xb7\xf2\"+\xe4\xef\xa4=\x05\x008\xdaN\xbf\xf4\xa7\xe2bu\xa5\xb4\xa8m*ǂ\x9c\x0f\xd6\"\xb2\x8aiH\x15\xd4\xf4\x1b\x80e\x95\xa6\xf9-\xe6\xfbYZN\x8b\xa1\xaf\xa9\xf9\xa9\x7f\xaa\xe2|P\x96\xd6\xec\xc8Oi\x7f\xf7z\xf9cg\x87_\x01\xe02X\xe6Z\x85S*7ޡ\xfc\xb6\xff\x99\xb6?\xd6Rj-\x00\x00\x00\x00\x00p \xf2\xea\x84f\xa3}kmn(\xed\xea9V\xa6PW\xea\x8a\xcd\xe1\t\x00W\xc3,\xb34\x97\xcd\xd3\xe8\x89:\x1fԤ\xf3XX\xa2\x9c\xab\xf5v~\xdb\xf7\xd2\xf9\x89C\xb5\xfb\xd1\xdf\x18G\xce\xdeɲR(\xd2d\x86Vy\xe3\xed=e27p\x98f\x1bs\xd5\xed\xa6\xc2^)7\x9e\xabj\xb7TM\xa7\x9a\xde\xfc=\x94\x1b\xb8(\xcd\xf3\xdf\x15'\xbb;\x1a\x8d\xceK\xf5D\xddt\xae\"\x9fi2?6<\x01`%Y;#\xea&\xd5y\xa1\xb4D\x91\xac<\x91\xb5;v\xd2Ķ\x1b\xbeux\bXO\xd5u\xbf\xc9>S\x9b<\xd7܍\xfb\xf4\xa2\xce\xd2F\x9e\xfaʈ4\x04VY\x95\a\x8d\xd2.\xd9s/ec\xf9h\xe7\xadS\x1c[\xfa\a\x0e\xc0<>bͮ\xe7\x94\xd5#\xbbؒwQeݪ\x1b\x15\xc3\x13\x00\x96Q\x16\xac\xcc\xe8f\x92\xdb\xd5K9\x81\x7f\x00\x80\xa3or\xf6\x9f\xdbg\x8atݷ\xef\xb9|\xf0vd\xca\xd2\xe2^n\xde/j\x0e`y\xf9\xbcQV\xa5\xa5*,\x9do\xfd\xc6\xe1\xee\x1ay\xe1\xff\x17\xf5\xe8\x7f\x11c}^a\xbbP\xec,\x8f뢦\x93\xb3RE\xff.\x807\xd6e\xa9.dGZ\xe44\x06\xedyi'\xb3|$\x8d\x9f\xc6Zg-[u\xb7\xfen\xe9\xfe\x7f\xc0|\x1c\xac-\x02\xd9\x01\x00\x00\x00\x00\a\xc6\xc5\xfaS\r\xcd~'v?l\xce\xfei\xbb\xd1\x008p\xfeB0\xb0\xa5\xb7袥\xbb\xd4\x01\x9a\x96H\xb6\xbf\xb3\x13\x8b߀\xc3ԼjeDc偽\x94]n\xefl\x9e^\\{g\x99\xc8\x00\x1c&\x97͕5c\x15E\xdb\xc7[\x85\xad\xb3\x9a\xdc\xff<\x83f\xb8hm\xf5\x8c}\xa4`\xbdT\t\xc9,\xaf\xb7:\x88\xb3z\x89'\x7f\aVZ\x18Y\x19rF\xbe+\x95\xa7\x05\xb4\xf6s5\x96\r\x8c6\xde!\xdd\xcd\"ZXo>\x1b\x16sI\xe5a\x92\xbeR\xe7X\xba\xbep\x0f\xc0J\xfa\x8cM\xca|*\x0e\xd3\x02~Q\xa1\xa3n\x8c\x03\xf0\x89o\xb7VW\n\x142\xc3\xd8K\xc2\xf8\v\xb0\"\xa2\xa5i\xfb;/\xae\x1bn\x00\x00ptŦ\x1a\xce\x00\xac\xa44\xa7\xc3ښ1\xdb\xd5ht\xcfps\x8d\x9c\xfe\x80\xe6\xd5sV?\x9fY\x9b;M\xba\xca\x15C.u\xa5Բ\x90\x1c\x807\xe6R\x10{\xb4\xbc\"\x8d\x13eNE\x9ck#n\xc8\xf9mu!h\xb4\xfd\xd5\x1a\xdd\xf6\xb7So\x1e\xb0\xb6>\x19_\x00\x00\x00\x00\x00\xc0\x15y\U0009b8cb;\x8b\x89Si\x96n\nRT&\x7fa\x95A\x00W\x8d\xeb\xd3\\:Y̘\xf5\x96\xf6\x14\xd3\x04\xc64\xf3\xe9\xae\xfe\x1ep\x98\xb2\xea\xe9\xc5;\xda\a\xb2\x97}0{\xcc\xec\xbd\xf5L\xb4\x05\x0e\x95\xd5۲P\xdbI\xae\xb6\xb3\xf4\xb8M\x99\x81K\x13\xf7>$5MZ^\xdc.,\x7f\xb7#\xa6\xc5t\xfclx\x02\xc0J\xeaRp\xc5y\xe5m\xa6\xb4\x88V\xd7LU\xe5R\xf9\xc8\x7fd\xf2\x05\xd6^q\xd7\x0f.ҁ\xebRU\xebS.,@\a`e\xa5jp\xffq!\xa2\xbd\xbfN\xd9\x01\x81\xec8\x00\xb3\x0f\xda\xebtVY\x9a\xe9\u05ffl\xa9\x94Y,$\x1c\x95\xda\xf5\x00\x96\x95\xeb\x17=J\x81\xecN\xa3\xd1}\xc3]\x00\x00\x8e.W\x0f\v,\x01XI\xfd\x14?\xbf\xa1\xda[[\xf3\xc6\xf5Z\xb8\xb6z\xfaW\xc5\xf9\xfe\a\xd5ŗ\x95g\x8d\\\xf4}\x10{\x1fȞ\x8e4\x16\n\x00o e\x981\xb3|\xc2-\xfa\xeaʰ)\xdf\xee\xab\xf3{\xaa&\xc75y\xe0\xc7\xd7*O\x05^\x0f\x81\xec\x00\x00\x00\x00\x80\x831\xfbE\xb98O᳦\xed\xe7\xe9\xf5\x13\xf5|P올\v\\M\xbe\xdf\xe2-\x9dط\v\x8bݮ\xed^\x13\v\xf9\xd1\x17-~\x03\x0eIx\xfc\xbf\x8aE\xf7r_&\xa4\xc5\x16|(\xed(\xac|\xe8\xec\x9a@v\xe00\xf9\xceʋ\xec\x94T\x15\xea\xc6\xd2\xe4\xee\xa7\x198\xc3Ek?\xf651\xab\x9fX\\\xa4\x9dؕ[\xfe\x9e\r\x81\xec\x04\xeb\x00\xab,\xb6բn\x17\xed\xdbJ\x8e\xaeؗ\xa6o_\xfc\b@\x9d\xb7\x8aU\x9a虤\t\x8e\xe9\xcbY\xd9\xc8\xec\f`\xa5y\xab\x0f/\x02\x8c\xbb~\xc3\xec\x98\x12\xbds\xcaS\xfa\a\xaeP;\xff\xb0\xbdQ\xc3B\xc2\xe9H\xefZ,\xec<-*\xc6;\x06,\xb74\xbe\x93Y\xe9\x91iT\xbcyq\v\x00\x80#\xachY\xc8\x16Xe\xfd8\x9f&j\x16ݚ\xeb\xe3\xe9\xdf\x10\xdd\xe9\x9fV^\x9c\xd7x\xd4)\xf7\x99b\xe7\x14B\x90\xcf\xec\xcf$\xb7\xe3\xc2\xe2\x85\x00\xf0:\\,\xd5\xf9N3\x9dK\x9d\xc3\xe6:\xa9;\xaf:\xcf4}\xdb\x19\xe6\xe2\x00\x86\xa1R\x00\x00\x00\x00\xc0\x81h\x9b\x8f\xabH1\xb4\xa9\x13\xc6u\x8b\xbe\x18'ei\a\xaaH\xf3\x13\xb8\x9a\xfa\x05$R:\xf3q\x18T*\xec\xbaS\xebKe\x0f\xfc]:Bq\xa8\\\xf5\x81\xb4\xd7\xf3\xe2E\xcdc\xbfk\xa7\x82\xb3\xef4A\x8f\xd7\x138L\xbe\xd9X\xa4\u0378\xabl\xfa\xc0\xe2&p\x91\xb2\xd9\xfb\xac\xfe\x7ff1Ҕ\xa7zH\xb0\xd3lQ\xf7\x8f\x96\xef\x03X]\xe5)\xfbH\xf5\xb8sRW)L\x83ʍ_\xd7\xff\x04 \x85\xb0\x8e\xfa\xf6y\xaf\x0fdw\xd6V\xb7\xb21->\a`ee\xa9\xbf\xa3\x17\xfae^\xbbTV\xfa\\eJ\xfb\xcf\x7f\xe7\x90)\x00\x97\xa7\xab\x9f\xef\xfb\xd7\xfa\xee4\x13\xd3I\xbf\xa0Xa\xed0\x16\x8a\x04\x96Z\x9f\x9e]J\xe1*o\xfdKt\x98\x03\x00\x8e\xb4\xf0\xfc\x1f\x8e\xb9\xaa\xe1\n\xc0*\xca\xfa\x19\x7fN\xb1<\xb9\xb8\xb1\x0e\x9e\xfd\xd6X\x9d\xfdW*\xeb}\xe5\xdeٟAi\r\xf1R]\x9b\x16(\xb4?\x8d<\xf5\xed\xce섅<\x00|\x1e!W\x8c\xe7S\x16j\xed\xfc\x89]\xbf\xa4n4\xd5\xf8\xf8\xef\\\xfc\x0e\x80@v\x00\x00\x00\x00\xc0\xc1\xf0ᔲ\xb0\xe8ȕk\xfb\x9d\xd8\x15\xd2\x04\xaa\xb4\xfbn\xb1x\b\xc0U\xe1\xfaɊ\xbe\xef\b\x8d1Mz\xca\xed\xbcS\xe7'\x8b\a\x80C\x12\x9e\xfa\x96\xa8\x90V\x9a\x1d\xdbU\nrl\xe4\xb2`\xaf\xea0\xebV\xe9>\x80Ò7i\xf76\xa9\xde\b\xca\x1f\xfe(\x13eq\xf1\x9e\xfb\xef\xa2k_\x93\xf7\xf6\xda\xf4G\xb4\xa3\xee\xf3wߦ@v^'`\x95\xb9>.\xf7x\x7f^Y\xb5\xceM\x1fVy˟%\xe1\x03\x83.Mt\xb4\x14\xe1Ҷ\xb9\xa9}\x9e\xee\xb9F\xe1B\xf4!\x80Ք\xd2{\x8c}\x80\xf1\xa2:\x9c\xa6d9\xab.\xb7\x96\t\x9cO7\x80\xcb\xe6î2{\xc7b\xc8쵲\xf2$\xb3\xf7\xac\x0f.\xc8\xedM\xa3\x1a\x06,\xb3\x90\xc6Rc\xa6Я6\t\x00\xc0\xd1V7\xefO\x9f\x8b\v\x00\xab\xc9E\xab\x9b\xd6\x1a\x8d\xdf>\xdcX}ՙ\x7f\xa8\xb2\xb5zyQ(T\xd6\xd6n\xbcB\xed\xe4\xd3\xe2ݩ{\xd77\xeab\xab6п\v\xe0\xf3\xc9ҾC\x9a\xe8\x06\xcb7&:\x97Ur\xc7~\xb3ܝ\x7f\x99\xce;`\x90FM\x00\x00\x00\x00\x00\xb8b^QyܒkK\x05\x17\x15\xb3\xb4+{\x9a|\x91\xfa\xb8G\xc3S\x00\xae\x8e4i\xd1Ra\fi\xbe\xecb⬜\xa2g\x11\t\x1c\xae\xee\xfcO\xd9g\nh\xdcV\xe8r+\x1b\xec\x05\xcdҾd\xad|\x1f\xd41]<\b\xe0p\x04Yj\xb4\xe3\xd8}\x8bk\xe0\"\xcd\xcf\xfc\xcb4\xd3\xda\xf2\xf3\xb1B6U\xe7;\x05\xbfo9~\xaa\xff[\xbe\xcfBV\xc0j\vV\x87K\xf3\xb5\xac:\xd7\x15[\x1a\xdd\xfd\x18\x130\x80O\x13\xfd\"\x90}q\x91\x02\x0eӎ\xec)\xb855\xd8\x01\xac*\x97\xea\xc1&xK\xefv\x84\vI>\xd4R\xbd3\\\x00\x97'\xb7\x82Ź\xb1\\W\xf6\v%Ĭ\xb1\xb2%\x9d\xe4\x92'\xf8\x15Xf1\xcdrw^.\xa7/\x05\x00p\xf4U\xf3'\x16\xfd\x82\x00V\x97\x8b\xea\\\xadr\xfa\xee\xe1\xc6j\xeb>\xf0@,\xaa\x17\xadս\xad\xe8G\xf6m\x99\\\xec\xff\x18\x16\v\x95\xa6~\x9e\x18\x14\xbaLޞ\x01\x807\x14\n\x95\x9d\xb7\x8ceO!s*\x8e\x7f\x8d\xfc\xfd\x7f\x871T\xe0\xd3\x10\xc8\x0e\x00\x00\x00\x008\x18}\x97\xcbD1\x14i\xbeE\x9a\xa3\xdbw\xe4.,v\x9f\x02p\x15E\xd7Ob\f}ZL\x93\x17-\xfd9&>\xe1p\xb9\xf9\xc7\x14U\xaa\r\x9b\xaa\xba\\m*\x1b\\+\xd7\xef2\x93\x9e(\xfb\xe7\x00\x1c\x12+8\x1a\xab\xb7\xe5\x93/\x19n\x00\x17\xa7\xde\xfb\x90\xbd?\x99\xd5\xf7'j5Rc\xf5\xfeN\x95\xfd\xd2\xda}{\xa9\"u\x10`\x95\xb5\xf3\xe3\xd6ܰ\xf4\x9e\x15*\xa6\x8b\x9d\xd9\x01|J\xbf\x9b\xa6\xb5}|\xea\x17\x1b\x02[\x01\xac\x81\xb4\xbad\xbf\xc2d\xea\x1b\xef;\xe8\xfa\xeb\xd8\x06\xc5zoq\r\\\x86W?\xf1ǣsi\x8c\xa5\xb4w*\xf5\xfbF{\xc7R\x1bl\xe8_s\x04\xb2\x03\xcb,-x\x94\xc6t\\NZ\x06\x00\x1c}]\xfb\x8a}\f\x17\x00VV\nd\xd7-\xdf;tn\xac\xaes\x8f\x7fI\x8c\xb3\x8f\xc9\xf7\x9b3t\xda\xef\n\xb9\xc2\xfeg\xa75\xe3\n/\x97\x1aݡU\xdb\xd8\xc3a\"\xefO.\xfeA\x00x=\xa1\xb4\xcc\xe3v\xa9ݷ\xea\xd2L\xd3\a\xfe\xe5\xca\xe7\xa3\xc0\xa5b\xd4\x14\x00\x00\x00\x00p\xc5\xe6\x1fy{T;\x96\xb2\x17\xe5\xf3\x99\\\x93v\x06Iݼv/\xa6\xfbg\x86'\x01\\\x15\xcd\\q\x145\x8f\xb5F\xfbwK\xdd\vR\xb9\xa5l\x8b\xc0D\x1c\x9e\xbd\xc7\xef\x8eyH\xc1\x1bR\x9e\xbf\xa0\x89\v*\xe6V>\xb4A.-_\x1d\xd2\x04\xdcS\x8b\x87\x01\\\x15\x8d\xcb\x15]\xaa\x8f\x15\x96\xe6*u\xa1Q\xf0c\xbb\x1c\xab\xce;++^U7zX\xe5m?\xcc\x00\x1a.\xda\xfe\x87n\x8f\xdb}\xec\x84\xd5\xf7\xe3\xbe\xcan_\xe3.S\xd1ٻ\x96\xf8\xd6\x0ef\xb2\x01\xab,\xcf_\xb6B\xa6\xd3~t\xaan\xf9/\x87\xbb\x00.(\x8f\xbdEڽNy\xdcS3:cu\xb0\x91Fqde'U.`\xb5\xa5:\xf0\xa6\\\x88\xca\xebNŤV彵\xbdn\x97˟Y<\x02\\\x86\x13\xe7\xff\x8e}Z#\xacݗ\xb3\xf6V\x1a{q]\xae\xc2[\xb9\x12\xe6v\\XP\x18\xc02ʛR]\xd9*\x9b\xfe\x9a\xe1\x0e\x00\x00G\xd7dnu\xcf\xc90\x16\x00`5UQq\r\xc6\xf9£\xbf$\x1e\xdb\x7f\xaf\xf2\xdcγM\xb5\xb1\xd04\xb5\xb1\xdbR\xeaR;\xbb\x96\x9c}\xb7\x85\n7V\x91\xb5\xf6\xe0\x8b\x8b\x7f\x18\xc0z\n#\x85\x94Odv\xe4\xa9O.\xb3|b\"\x15\xa5\xeat\x7ftJ\xfbݳ:u\xfc!\x15_|\x9a\x01!\xe0u\x10\xc8\x0e\x00\x00\x00\x00\xb8bm\x95v_\x04ph|&\x172\xe5>m\xc0\xb3/eN\xc1U\x1a\x15w\x0f\x0f\x00\xd7V\xf3\xd2wŬ\x1e\xf5sl\x01\x1c\x9e\xdc\xd7ri\xc5|\x1f\xac\\\xc8\xe4].\xaf`eF\xab\xac\x8b\nY\xa1\x8d\r&\xc9\xe2\x12U3\xfbH\x8b\x91\x00X[~C\xea2\x95\xdb[\xda<\U00077648\x01|\x16\x9fM\x17\x93\x1c\x9d\x93\xb3ڗK\xb32\xd2F\x9bL\xcf\x00\xd6\xcab\x87ݔ\xf2\xd3Dg;\x80\xcb\x14y\x7f\x80Ֆ\x8dԹ}\xe5\xe5}\xc3\r\x00\x00\x8e\xa8\x97\xbe#\xe6J\v\xf5-\xda:\x00V\x94\xa5q\xe7O\f\x17\xab)>\xf5m1\x84\xe7\x16ݵ\x17F8\\ڐ\x81\xe1\x0e\x00\x9f\xc7h?\r\xfbHM\x1a'\xbd\xd1\xf2\x90\xa8.\x9e\xb1\xeb\x99\xca\xfc6u\xf3J\xf9\xe4M\xba\xee\xe1\x0f\x91\x99\x00o\x80\x91R\x00\x00\x00\x00\xc0\x95\x9b\x9f\x1fN\x00\x1c\nW(\x86RE\xe6$\xbfk7\xa6\xaa\xed\xaf\xec\xd6\xef\xa7c\x14\x87\xa29\xfd\x8f\x94ו\xbd\x8f\xe5p\a\xc0ap\xfdJ\xf1\x9d:;\vn,\xe7Ӑ\xc0L\xeaf\xca:\xa7\xf9\xf4\x11\xb9\xbb~\x80\xb2\x02\x17\xed܇~]t\xf5\xdc\xceX\xa9\x04Xkq\xa2\xaa\xdcQ\xbe\xc5b(\xc0\xeb\xc9\xfc\xf1\xc5\x04\xc8\x14\xcc\x1eS\x99\x99\xbe\xa3\x1c\xf3\xbc\x81\xd5֧\xf9\xd4\xfa\xb2vW\xf4\x96潝\x05\xbb\xb6\xc4ߥ:4p\x99\x9a\xd5\xdf\t\x0fXk.W\x93Y:\xbf\xf9\xcf\xd1G\a\x008\xd2\xda\xd9{\xb4\bd\xa7\xc8\x02VZZ\x9c\xb3|`\xb8X=\xf1c\xbf+6\xbb?\xa5\x10\x9fO\xeb\xf5\xa6.\x9c>[s1د,$\a\xe0\xf3i,\x8b\xdcR\f\x9b\n\xa1\x91\xac-\x9f\x963V\x97\xeaF{:=\x1a\xa9<\xfe\xf5\x8bG\x01\xbc.\x02\xd9\x01\x00\x00\x00\x00W\xachӮ\x8c\x00\x0e\x8fWh\x9c\\t\x8an\xdf.Ǫ\xb2\xd1\xf0\x1bp\x8d\xbd\xf0\ab\xbe\xf3\x9cr\xbdf\xefc1\xdc\x04p(B\n\x9crj-E\x067\xb1\xf2!J\xb1\x91\xbaξ'\xf2\xc7~\xc3\xe29\xe0\"\x85\xd9O\xab\xec\xf2\xc5{\x04`}\xb5A:n߷\xfd]f\xad\x02\xaf\xc3\xe57*\xca\xca\xca>\xa8\xb5\x94w\xa9\xdc\xec\xac*\xc6B0\xc0j\xb3\xf6\xd6P2\xf6;x\x85\xd8\a\xb1\xbbX\x11Ȏ+\x92\xb7\x04\xb2\x03+-z\xd5\xcc\xe2\x05\x00,\x81\xf9\xec\xbd־)\x14\td\aV\x9bs\xf2\xd3/\x19.VK\xf7\xc2wF\x9d\xfb\xbf\xe5\xe6\x1f\xb6\xfcl\xb8\x19G}\x1fN\xda7\xe4B\xbf\x0e\x00\xbc\x9e\xc5\x14\x89\xb1\xe4+\x85\xecL괓\xd7M\xeab\xa9\xe0\xcej|\xe3\xd7Kw}'9\t\xf0y\xd0\x05\x06\x00\x00\x00\x00\xb82/\xff\xc98R5\\\x008\x1cQ\n\xa1\x0fXLc-\xc1\xb5vz\xe7\xe2'\xe0\x1a\xebv\x7fL\xa5r;\x9b)\xa4\xe0\r\x00\x87\xa7\x99\xf6\x83\uf765ɘv\xd0N\xc1T\xa6\xcd2\x85\xf1}\x1a\xdf\xfa\xdd\f\xa2\xe1\xa2\xd5\x1f\xff\xcdq3\x9eU\x96\x16EH;\xcb\x02X[\x8d\xb7\xbc\x80\xdd\u06017v˟w!- \xe4R\x10ki\xdf\xd6.\nv\x9d\xb6\xf7\x01\xb0\xba|PL;\xb2\xa7\x9d\xcb,\xbdǘ\x82\u0603\xbc\xb5\xc8\xd4\xee\r\x0f\x01\x97\xa6y\xe6[\xa2K\x8b\xd1\x01XY!-x\xe2\xee\x18\xae\x00\x008ªS\xf6\x11\x15\x1c\xfd\x1b\xc0*\x8bY\xa6\xe2\xae\x1f\\\xc91\xe4\xea\xcc_\x97\xab_S\x11Ң\xa3ގ\xb1U\xc8\xc7\xca\xfaaO\xfb\xf0\f\x9d\x03xc\xa1K\xf3$\xf6\xe4ܮ\xb2~\x82f\x9a\x19wJ\xf3\x91\x97۾C[\xb7\xff\x9fd\"\xc0\x17@K\x02\x00\x00\x00\x00pE\xea\xfaQɵ\xc3\x15\x80\xc3й\xa6\xdfoW]\x9e\xe6̪u\xb5\xf2\xf2mï\xc0\xb5\xd3=\xf3;c\xbb\xf3\x8a4J\xe5B!E\xca\a\xe0Pu\xa5bZN>묜H\xbb\xb07v-\x85\xd1=\xf2\xc7\t@ĥ\xa9w~<\xe5\xec\xfd$\x8e6\xed0\t`}M7\x95\xdf\xf6\xaf\xc9\b\x80\xcf#\xa4\xba\x97\xa5\x92\x98J\xcf\xd4P\x8f\x04\xb2\x03\xab.\xa6\x85%/\x94\x8e.\x93\xb3t\xef-7p\xaa\xa5v\x7f\xf8\x01\xb84a\xff\x17\x16e\b\x80\x95\xd5e\x95\xca\xe2K\x87+\x00\x00\x8e\xae<\xad_\x1e\xf7\xfbŻ\x00\xac\xae6φ\xb3\xd5r\xf6\xb1\x9bc>\x7fE\xca\xec\x7f\x9f\x9fJnۚ\xdb\x1br]js\xa7\x05\xe4\x82\xfdE\xff-\x807\x16\xf3R\x8dK\xfd\xbcA.ު\xb6δ\xeb\x1bu\xdb\xf7\xca\xdd\xff,\x15$\xe0\"P\xd2\x02\x00\x00\x00\x00\xae\xc8|\xf6a)\xed\x16\x00\xe0\x10u\xf2\xaeN3\x9e䴡6\x9fi\xeb\x81\x7fL\a)\xae\xb9\xf6\xec\xcf\xc9\xc7B\xcaf\xea\xbcW\xd6\xef\xcc\x0e\xe0\xd0X\xd9\x10\xb3=E\xb7c\xe5þ\xd2N\xa01M0\x9a|\xa9t\xf7\x7fO9\x81\x8b\x16^\xfc\xaeXt3\xcb\xe8\xed\xc2\xef\xa9\xce6\x16?\x00XK\xc5\xf4W\x0eg\x00\xdeHt\xfd6>V\a\xb3\xf6QZ\x002\xed\xc8\x0e`\xe5Eu\x8a\x17v\xef\x8a>\xadga\xdfQ\xa1\xab\xfb[\xc0\xa5\x8a\xf5\x13\xfd;\x04`uŢ\xd1ƛ\x18\xcf\x01\x00\x1cq\xaf|{\x1c\xc5\xd2\n\xae\x14\xc8N\xfd\x14Xi\xc5\xea\x05\xb2\x87\x8f~Y,\xf7_Vٍ\x94\xb6Q\x0eޫU\xa6\x18Z\xcb\xd7\xe6\xf6\xc4LrAMX\xcd ~\x00\a\xa3)\xd2d\x89\x94OX^by\x87\xd3X\xd3\xc9[\xb4}\xff\xfbh\xd3\x03\x17\x89@v\x00\x00\x00\x00\xc0\x15i\xaaW$Ʃ\x80Õ\x85~\xb0E1\x93\xdcT\x81\x1e\x1f\x1c\x86'\xbe1\xea|\xe8\x17\xb0\x9e5A!\xab\xa40\x1d~\x04p(\xb2\x99\\ڍݷ\x8aa\xbe\xa8\xb3\xb9-\x95\x0f\xfc=\x06\xd2pI\xce\xee\xfd\x98F\xbe\xb0ʿ]\xa4U\xc6\xed=\x02\xb0\xc6\xee\xfbG\x94#\xc0\xc5\xea\xdb驽\x9e\xcei\xac\x03\xeb\xc1\xd2z\xb4\xa2\xf2\x93\vX\xa4\x1dzRE\x1a\xb8t1\x9c\xb1r\x84\xaa\x17\xb0ʲ\xfcBy\x01\x00\xc0\xd1ն\xcfZ\xbbf{qqa\xf1.\x00+\xc9\xf9\xd5ڬ`\xfe\xf4\xaf\x8b\xda\xfdO\x9a\xea\xb8\x14:\xcb\xc3\x1a5n\xaeVs\x85\x98\x02\xd8\xebE\xff\xadiӢ\xa4\x00\xf0\x06:͔\xeb\x06\xcb3R \xfbie.\xd7ƛ\xdfO\xc5\b\xb8\x04\x8c\x94\x02\x00\x00\x00\x00\xae\xc84̤1\x81\x8a\xc0a\xca\xe6RhOXZ<'\xf9\xb3j-Y\x02\xd7R\xf3\xecw\xc6p\xfe\xc74\x1a\x9d\x91\xaa\x13rqKy\x9a\xc4Я^\r\xe0\xf0\\\xa74\xfe^tR9\xedTwۊ\x1b\xff\xc5\xf0\x1bp\xf1N\xce\xde'\xb5\xde\xea\x19\xb7\xf7/Դ\xb6\x97\n\xc0ꪏYZ\xafՖs\xb5\xce\xd2{\x18)\x86\xa0\xae\x98\xaa\x9b\xbc}x\b\xc0\xe7\x13\U000b1d4d$W\x9c\x95v\xac\f\x1dO\xec\x1e;2\x03\xab̵c\xe5\xf6\x97K}!\xb1\x92K\vAE\xcb\v\xa2\xdd+Vk\x128\xae\x1d\x97v\x83\x1b1\x1f\x16Xf1\x0e\x81\xeaiQ\x8aO?L\xfam\x7f\xce\x18+\x00\xe0\xe8kw\xfe\xbd\xe6㙚\xc2\xcb\xcd\t?\x01\x96\x9aO\xe3}c5!*f\xad\x1dA1\xe4R\x9e\ua95d\xcel\x7f\xe3\xe2\xb9U\xf0\xe1\xdf\x1cǧ\xfe\xb5|W(\xb8Nը\xb1\fm\xacQ\x97i#t*\xfap\xba\xf1\xe2\x88^\x1bi`\x1d\xc0\xfaʝ\xba.\xaa\r\x99BiyF9\u05fc\x98+\x14\xd1\xf2\x90M\x15\xf5\xadr\xd9K\xd2\xec\xac:\x7fB\xb3\xbb\xbfi\xf8\a\x01\\,Z\x12\x00\x00\x00\x00\x80+\xe2\xd3\xfc\x8b\x10\x16\x17\x00\x0e\x87\xcf?\xb51\x8fwʊ\xeb\x87\v\xe0ڈգr\xf1\xac\xe4\xe6R^)f\xb5\xbd\x93\xf6R\xa6\x01O\x00\x87h&\xb5\xd3~\xed\xf8vn\x9f\xd3Meo\xfa\x89\v%\x06pq>\xfe\xbbcl\xed;Z\x9e\x9eY^ߔ\x8a\xda_\xfc\x06`5\xe5\xfb\xfd\xc45\x1f\xbc2\xd7X\x1b#\xeb\xebv\xb5\xab\x94\x1d\xfbU\xc3C\x00>\x1f\x97j`\x17fc\xb8\x90n\xf4\x93!\x01\x00\xb8h/~{\xccb9\\\x00XV}?\xf9g\x8b\xf1\x93\x01\xee\x8e\xf1\x1c\x00\xc0\x12\bm\xad.4\x8b\x8b\xd7+\xdb\x00,\x8f\xd8\xf5}\x95\x99\xfd\x15\xfb\xfeJ\xbbH\vڪ\xb6\xebV7\xdc\xf1\xfd+\x91\xc8ó\xdf\x13\xab\xbd\x8fH\x9d\xb5\xab\xb3L>\x8brqs\xf8\x15\x00^G\x97&E\x04e\xb9\x97\x8fc\xf9\xc6r\xca\xda\xf2ɶ\xb0l\xb2\xd5h\xfc\xa2\xb4?\x95,+ɮ\xfbrMn\xf8\x9f\xa9\x14\x01\x97\x88\x91R\x00\x00\x00\x00\xc0ek\x9e\xf8\xa6\x98\x87L\xed\xb0\x99\x00\x80C\x92\x02K|\xa7\x18Ҽx\xa7\xd1\xf8\x81\xe1\a\xe0\xea\xeb\x9e\xfd\xad1\xce\xfe\xdf>.#\rp\x06\xb7o\xafd\xdaz\xd0\xee\x84\x14>\v\xe0\xb0D\xdf\xf4A\x88j7\xd5D/\xb7\xf5\xe5\xc3/\xc0śU?\xa3X/v\"\x90ߵ\x8c\xff\x84ݝ/~\x04\xb0\x92B\xbe\xaf\xae+\xe4\xc2H.\xb5\xf7]P\x9b5jF7Iw|7\x932\x80\x8b\xe0]ieg:\xb3\x86z\x9a\x95\xd1\a*1=\x03\x00p\xf1\xea\xfd\x9fS\x1f\xc8\x1e\xa9~\x01K-\xf5\x93\xbfA\xc0_\nf/&\xf7\rW\x00\x00\x1ca\xdd\\\xbe\x8br!\xb3r\x8d\tB\xc0R\v)\x90\xbd\xb5*\xaa\xa5\xe94\x0e`I:mT\x10\xe3\\\xed\xa2Cs%\x9c;\xf7\xd7Զ\x1f\xb3\xff\xbd\xa3E\xdflZ\xb47L\x86_\x01\xe0su\x96\a\xba\"5\xe1\x83\xd4z\xe5Ͷ\x8an[>͵\xf13\xa9\xe94\xcf\xf7Um\xffr\xe9\xde\x7fF\x87\x1dp\x19\x18)\x05\x00\x00\x00\x00\\\xb6j\x9e&Rm(\xa6\xd5Z\x01\x1c\x1e\xe7\x14|\xa3\xd0\xe5\nΫ,\xde>\xfc\x00\\}\xf1\xec\x8f+\xaf^\xeb\xe33\x14Kuj\xe4\xd3\x00g\x1a\xe3d\xa2-p\xb8\nK\x83\xf9\xbe\xd4eʷnTq\xcf\xdf'Q\xe2\x92T\xcf\xfe\xd1\xd8U\x1f\xb7\xfc|19-\xf8\xf4\xed\xdeh\xfe5\x80\x15ѺNN\xa5\xd5\xefZK\xffS;v\xd5\xf8\xa0\xd1\xe6\xaf\x1d\x9e\x00\xf0\x85\xf8l\xb1\bL\x9a\xf8$K?va\x7f1=\x03\x00p\xf1\xaa\xf9\xfb\xac\xe4 \x90\x1dX9\xc3N\xecIڭ\xbd\xd8x\xdbp\x05\x00\xc0ѕu\x95\xb2\x18\xe4\xfa\x05o\x87\x9b\x00\x96R\xe8'r\xb4ri\xab\x82\xce\x12t\x1f\xa0٩s\xad\x82_\x8d\x1d\xcb\xf7?\xf8\xae\x98\x85\x175)*\xbb\xca\xfa\xe0\xd3\x10k˾\xea\xc5\x03\x00\xf0z2\xcb/|\x9a\xef\xd6X\xfe\x98\xc6J\xd3\xf8\x8e\xe5\x95i3\x97\x94\x9f\xcc\xed\xf4\xd8\x03\x1a\xdd\xff\xef\xe8\xac\x03.\x13M\t\x00\x00\x00\x00\xc0es\xcd3\xf6\x99\xc9e4/\x81C\xd5\a\x97U\n]\xd6\xc7\x12\xeb\x9e\xff\x9d\x0eS\\\x13\xa7\x9e\xfa\x8a\x98\xcf\xf7\x94\xf5/ަ\xbd\x8b\x93\xc5J\xfc\xe9:\xd8GZ\xd5\x1a\xc0\xa1\x89\xbe\x92r\xa7y^\xa98\xf1\xb5\xc3]\xe0\xe2\xb9\xfa\xdf\xf5\x93;\\\n\xc3\xcb\xec\xdbmK\xfe\xbc\xfd`y=\x80\x95\x95\xe2*\xb2<_L\xd4\b\xd7+v\xad\xbab[\xa3\xdb\xff\x12\xed\f\xe0\"e~j\xe5e\xb9\xb8H\xf3A\xfb\t\xa2\x94\x9f\x00\x80\x8b\xe7\xea\xd3\xf6\x19\xad5F\x15\fX\x19\x9f\x15Ğ\x0e\x7f\xdb_ \x91\x03\x00\x8e\xb6O\xfc\x81X\xbaN\xa5\xf7Vv\x15V\x9e\xb1\xd1\x05\xb0\xccB\xdfG\xd9X\x85\xd4Z\x9biq\n\x93\xbeZ\xe5\xca\xca\xdb\xfa\xeb\xa5\xf6\xf8ۣ\x9f}X\x1bA\xf2Yi\x1fi\x9f\xf9\\>\xe4ri\x8c\x13\x00\xde@\x90\xb7f\xbb5\xd1\xd3B\xdfڗ\xf2\x99\x94\xedZ&Y)Z\x9e\x12\x8b\xbbT<\xf4Q\xda\xf0\xc0\x15 \xd2\x00\x00\x00\x00\x00pي.\xed\xce\xd6\xc9\xf5;K\x018,i\xeeS\xf4M\xea1U\xc8\xe8/\xc55\xf2\xfc\xef\x88\xee\xd4{\x16\xe7n\xc3>R \xbb\xef\x17\xa7\xcdڬ\xdf\x01z\x88p\apHBש\xf27\xa9;~\x97t\x13\x8b\x9c\xe0ҹ\xe6\tM\xec\xcdI;1D\x17컴\xbc}f\xbf\x10\x88\a\xac\xb2\xa2\xaf\xc2Y{\xdf\xe5v\xb4\xaa,ɗӯ\xe8\x7f\x03p\x91|ڑ\xdd\xd2PZ\xe5\xcb\xd2P\xb4\x86;;\xb2\x03\x00.E\x91\xe6\xccj\xd6\a\xba\x02Xr\xfd Χ\x82\xd8{)m\x93\xbe\x01\x00K\xa0\xa9?(\x97\x82\u05ed\xd8\xf2)\xda\xf5\xb3\xcb4\x00\xcb\xe5\u0082\x14iS\x82T\x1f\x1d\xaa\xaaA[\xca\xc7\x0f\r\x0f-\xa7\xfd\x8f\xfcƨ\xfa\x175\n\x95\xb2v{\xb1㼫\xe4R@\xbb\xfd\xef\xce\x1c\xf9\x17\x807\xd6\x05\xcb\x17S\xbe\x11,\x9fL\xf3\xa1\xf3J\xd1\xf2\xca\xfdn\xacY\xb8I\xee]Oӈ\a\xae\x10#\xa5\x00\x00\x00\x00\x80\xcbV\x86\r\xa9\x9b\xf7A-\x00\x0eOPڹC\xf2n,\xe7\xd3Dy\xe0\xeak\xcf\xfc\x1b\x9dl\xf6%{\xef\x94\x02\x1b\xd3\xce\xcf\xfe\xbcr\xd7ȧN\xfd\x14\xad\x917\x8b\x87\x01\x1c\x8a4\x16ߕ\xd7i\xe3\xfe\x0f3\xa0\x86K\xf7\xe8W\xc7lV)\xebcֽB,\x15\xfc\x9eB?\xe9\x83\xfc\x1dXe^\xa3\xbe\xad\xef\xfc\xf5\xaa\xfd\vr\xe5\x8d*\xef\xfd\x17\x94%\xc0\xa5\xf0\x13+/\x9d\xb5\u05edB\x96\xe6\x83\xf6}gL\xcf\x00\x00\\\xa4W\xbe=\x96\xa1\x94\u009e<\xc5\a\xb0\xdc҂F\x9fv\xf4.\x04\xb1\x93\xc0\x01\x00K`>\x7fRJ\x9b\xb0\xf7\xc5X\x1a0`~\x10\xb0̜\xca>p}\x91\x96SߥSg\xa7Nǥɛ\xd3\x0f\xcb\xe9\x89o\x8c~\xffG\x17k\xf4\xda\xffFE\xa7\xb6k\x15\xd3f \xce\xf2\xae\xb4\x11\x03\xd9\x17\x80\xcf#K\v\xfb\xf7\xf9\xc7\xd4\xf2\x8d\"e&\xaa\xac\xee\xe3\xf2G4=\xfe[\x87\xa7\x00\\\tz\xc2\x00\x00\x00\x00\x00\x97\xa5{\xfe\xf7G\x85\xa9\x14j\xbb\xa2\xa7\x178T>K\x7f˥\xae\x1e\uf19b\xc0\xd5s\xf6#\x8f\xc4\xee\xb5F\xa9\xff\xbe\xf5^\x9do\x15ܞ\xbd\x7fs{\x11Spc\x9aĐ+漏\xc0a\xca⦲\xc9d\xb8\x02.M;\x7f\xaf|e\xefO\xbf\x99\xac\xe5\xe9\xa1P\xf0\xbb\n\xdd\xc8n\x10\xc8\x0e\xac\xb6T\xc9K\x8b\x14\x9d\xd4̚\x18\xd9\xe8\x86\xe1>\x80\x8b\xe6\xd2T\x8c\xd4.2v\xda\a-\xa5]\xcb\x00\x00\xb8\bU\xf5\x94\\\xb7\xd9\xc7\x14\xd0\xdf\v,\xb7\v\xc1\xeb\x9f\fb\xbf\xe0B0;\x00\x00G\\ݼ:\x04\xb2\xdbG\xb4\xb2\xeb\xb3\xcb4\x00\xcb\xc5g\x9f\x96\x8cS\x04\xfbP_\xd5T\xba\xe3{\x97\xb2\x82ڼ\xf4G\xe3\xce\xee\x8fh\x1c\xed\x7fOu\x9d\xfdoJ\xbb)\xef[\x96\x95v\x9eO\x01\xecv\xbfKy\x98\xfdo\x04\x807\x90\xf9 \xefr\xcb+\xc6vX>bYGЖ&\x9bo\x93\xee\xffK4\xe0\x81\x03\xc0H)\x00\x00\x00\x00\u0cb4\xb3\x1fQ5nԦؖjs\xb8\v\xe00\xb8\xb4\xeb\xf5\xbe\xe4\xbb(m\x7f\xedp\x17\xb8J\x1e\xbf;\x1e?\xfdA\x8d\xf2\x99\x14\xefV\x1e\x82\xb2.\xed\xc2\ue950:\xf3\xedH\xb3\x19b#\xd7Џ\x0f\\M\xf5䤂\xb72\xa0K\x8bH\x8cԌ\xa2\xf6'v\x9e\x16\x95\xa8\xa6zn\xfa\x0e\x8d\xee\xfdY\x12\".ݓ_\x15s\xbfc\x15\xfd4\xe1\xc3\xf2\xf5\xacSa\x95\x8d|6V\x9ev\x94\xed\xf3z\x00\xab\xaaK\xb3\xd8|!͟\xb0+\xa7\xfc\xa1\xc7(K\x80KԴ\xc7\xed\xf3\xacFnCav\x9d\xf2zCο\xba\xf8\x11\x00\x80/d矩VTZG,\xb4V/\x03\xb0\xb4RcʹJ1+U\xfb\x89\x94ͭ\xb28Shn\xd0\xec\xba߾x\b\x00\x80#l\xbbhU\xd57K\xa3\xb1:w\xdaʲ\xb4\xfa-\x80e\x95U\x8d\xea2WH\vS\x8cwԕ\x95\xca\xd9\xed\x1a\xbbǇ'\x96\xccS\x7f&\x16\xcf\xfc\xa0\xb6\x9a}\xabx\xdfby՞\x14\xad\xce\x1d3\x15q$\xd7\x06\xbb_-\xc6<S\xa0;\x00\xbc\x81\xb4n\xcf<\xcds\x1b\xb5vV(Τ\xc9\xf6\xedқ\xfeO\xc6I\x81\x03B ;\x00\x00\x00\x00಄\xa6U\u05f5,\xb6\f\x1c\x01\xfdN\x1eξ\xfd\x8e\xca\xe2\xde\xe1.p\xf0\xea\xa7\xfe\xeb\xd8T;RVJ\xfdD\xdaS\xc3/\x00\x0eC;\x7fU>\xcf%\xbfe\tt\xae\"T\x1a\xb7S5\xed\tK\xa3\xfb\xba\xfd\xcd\xff\x81\x015\\\x960{e8\x03\xb0\x8e\xb2\xb4\x8btV\xd9Y\xa1\xd1\xf4\xae\xc5M\x00\x97\xc4\r\xbb\xb1/v\xdeL\x13$#\x1bn\x02\x00.^pVnt\x8b\r\x9b\x19\x83\x01\x96\\я\xdf8\x1f\xadn\xd8X\xfa\x1e\xf7\xb3vC\xb1\xa7ɝ\x7f\x83\x1a\"\x00\xe0h{\xea\xcfD\xb5\x99|^+\xed\xc8N\xc1\x05\xac\x00K\xc8Y\x8a\xed\xee;+\xed\xe8R\xdfeg7O\xf6?/\x9bz\xff\x87\xac~\x9d\xea\xd9\xc7\xec\x7f\n\xf3W\x00\\\xbeX]\xa7qy:E\xb3\xabmϩ=y\xb7\xdc\x03\x1f\xa0\xfa\x03\x1c \x02\xd9\x01\x00\x00\x00\x00\x97\xa7\xed\xe4\xc2|1\x89\x8a\xd6%p\xa8bZ\x12\xd4m\xa9\xce\xe7\xd2M\xdfC\a*\xae\x8a\xf8\xe47\xc4\xf6\xecO(\xd4\xfbR\xbe)\x15\xb9bZ~\x16\xc0\xa1\xc9Cc\x893M\x86\xb5og\x85A\xbb)_\xb7j\xb2L\xd5\xf1w\x0fO\x01\x97\xae\xde{~8\x03\xb0\x9e\x16\xbb\x92\xcc\xf3}\x8d7\x7fC\x7f\x0e\xe0Ҹl\xdc\x7f\ak\xa1GO\x04\"\x00\xe0\xd2\xc4.\xcagV\x9e8'\xc7j\xc2\xc0\x92\xcb-1K\xde\a\xfb\xaa\xad\x82\xb8\xd1\xdf\r\xc5~\xff\r\x00\xc0Qִ\x8f+\xb6\x85\xf2l/])\xa6\x95Ι \x04,7\xab\x9b\xe6\xe9#\xa6\xb4\\,\xe6\x1b\xa9\x93\x1f=\x94N\x96\xca\xee\xe3\x0f\xc4v\xe7)˖,o*\xcf\xd9\xff\x16\xf2'\x00\x97\xaf\x8cS\xcb\x0e\xedpgUO\xc6*\x1e~\x8a9\x98\xc0\x01\xa3\xa4\x06\x00\x00\x00\x00\\\x96\xac\x8b\xca\xd4ȅܮ\xda\xc5M\x00\x87h\xa4z\xb1\xe1\x1bp\xe0\xe2\xf3\xbf+vg~X\xd3]{\xd32\xafʟS\xd3:eCp\x06\x80\xc3Q\xe6\x13\xc5\xce)dsK\x9c#\xa9۴\xbb\xb5\xfc\xf1m\x8d\x1e`7v\\\x9e꙯\x8cYwz\xb8\x02\xb0\x96\xe2\\]\xdc\xd2lb\xe7\xb7\xffE\xca\x13\xe02d\x99%\xa04\x1f4\xedV\xe6\xbb\x14\x91h\x97\xa9\x0f\r\x00\x80/\xccw\x99|feG\x8aa\xef\bd\a\x96\x9b\xd5\x01\xfbE\xc1\x87\x9d.\xbbԧ\xee\xd4\xe64\xb5\x00\x00G_\xdd>nE\x98\x93\xcb*\xc5\x10\xe4ݢ\xbf\x03\xc0\x12\xb34\xec\x82_\xec\xc8\x1e\xf3~\xad\xf4\xbe\xae:\xfe\xe2\xc5\xef\xcb\xe2#\xbf,n\xee\x7fL\xd3x\xd2\xfe\am)M_\xcc3\xda\xcf\x00\xaeĎ\xb5\xd9[͏Iō\xdf<\xdc\x03p\x90\bd\a\x00\x00\x00\x00\\\xbag\xffp,bT\xd9\xef\x1e\x90&\\\xf4˳\x028$.\xa6\tPN\xb1\xbcqq\x038@\xed3\x7f*\xb6\xe7~By\xdd\xdak6\x92|)\x85h9\x7f\xb4\xf3\xb4\xea>\x80\xc33Uh\xe6V\x0e\xa4\x9d\x9cR\xda|I\xed8\xd3\xf8\xd8o^\xfc\f\\\x86\xb0\xfb^\x15L\xf4\x00\xd6[\bj\xfc\xa6\xe2\xc6[\x86\x1b\x00.\x99\x9f\xa6\x0f\x85\xbeݔnDE\xc7\xf4\f\x00\xc0\x17\xd6=\xf5;b\n*\x90k\xec*}\x13)\x04,\xb3\x90\xc6P\xfbn\x96v\b\x16\xb2\v\xfb\xbbs\xb7\xf6\xbf\x03\x00p\x94\x85\xf6ye\x8b\xed\x9a\x17\\\xa1\xe8\xd8\xe8\x02Xf\xa9\xb9i\x95T\xe3\x14,M\xa758Cf\x1fw\xfe\xefK\xd3\xf8\xac\x9e\xfe\xaa\xa8s?-\xd5[R\xb9k5\xeeJ\xb1\xbeQʫ\xe1\t\x00\xb8\f\xcd9U\xd3Z\xedu\xbfIŭ\x7f\x81\x0e9\xe0*`\xa4\x14\x00\x00\x00\x00pɚ\xe6Q\xb9ԩ\xed[\xf9PH\x91\x81*\xe00\xa5\xc9O\x9d\xabU\x8e\xdf6\xdc\x01\x0eF\xfd\xd27\xc7\xf9\xb9\xef\x93\xdb\xf3R>\x92\xc6\xe7\xfb\xb5KF\xe1\x84\xca,\xa8\x8b\xf3\xe1I\x00\x87\xa2\xa9\x95\xb9V\xae\xbd>Uд_Z\xf5l\xeb7H7\xfcO\f\xaa\xe1\xf2<\xfd=\xd1\xcf\xceZ\x9eo/\x13\x80\xf5\x15\xbcB1\xd2\xc9{\xdeOy\x02\\\xaelj\x8d\xf5\xb4\x03{\xb4\xefv\bX\xca\x16\xbf\x01\x00\xf0y4\xed{\x95\xa5q\x97T~\x84T~P%\x03\x96Yt\xb5\xa5\xe5\r\xc5\xd0)s)m\xcf\x15}TQ\xbc}x\x02\x00\x80\xa3˅Z\xceWR[\xc8\xc5\xd4\xcfa\xc5Z*\xdb\x00,\xad\x90v_\xb7\xbf\xd3\xc6\x05\x9dJK\xdb~э\xb9$\xe6\xcf|Cl\xce\xfd\xa2\xe5Ki\x13\x06o\x15\xeeZ\xa1\x9b\xc9\xc7\xd2\xee\xd1\xff\n\xe0\xf2\x85\xc22\xc3c\xbfL\x9b7\xfd\b\x9dq\xc0UB ;\x00\x00\x00\x00\xe0\x92U\xf5Ǥ6E\xb2G)\xa4\xde\xec~\xa9V\x00\x87Ļ\xa8F\x95Ɠ_2\xdc\x01\x0e\xc0S\xdf\x1a\x9bW\xfe\x966\xe6R\xdee\n\xfe\xb8\xec\xcb\xec[\xb6\x1f\xe4CZ\xa1{\xaf\x7f\x14\xc0\xe1h\xfdLrc;;k\xe7{\n\x1b\x8f\xc8\xdfà\x1a._3\xfbq\x15i\x0e\x9aK\xbb\xc8\x02X[\xd1\xc9\xe5\xd7\r\x17\x00.K\x96\x16\x85)\x14c\x94\xb36{\xbf\"\xa4c\"%\x00\xe0\vkۏ\xca\xf7eHP\f\xa9\xfc`z\x1f\xb0̜O\x1d-\xdbJ\x9b\xd9:\x8d\xed\xe3|\x1f(4\xbe\xef\x87\xe9\xc3\x03\x00\x1ci\xdds\x7f&\xfa\xae\x94\xb2Vj\xd2X\x94\x15`i\xb1%\xf7i;\xb4\x03X:]\xaa\x85v\xb1\xef\xb7\f>\xcd\xf9\xf3K\xd3m\x19_\xf9\xae\x98\x9d\xff!M\xe6\x96'\xe5v\x8cΩ\xb3\xbc)s)\xafz\xd5\x1a\xd4'\x87'\x01\xe0\xd2\xed\xdd\xf0\xab4\xba\xfb?\xd1V\a\xae\"z\xba\x01\x00\x00\x00\x00\x97\xac\xaa_\x92\xba\xae\x8fcO\xd3.\x00\x1c\xb6\xa8\xe8\x1b\xe9\xd6?K\x82ā\xa8\x9e\xfe\xa6X\xed\xfcUm4\xb5\\\xdcT\xcc_\xd1n\xacT\xc7\xeb\xd4G8\x863R\xedԏk\x0284\xaeH\xbb\xb3mK\xfe\x9c\xf2q\xd4\xe6C\x8fQ\x0e\xe0\x8at\xed\xa3\xf2\xdd\xd8\xde+vd\a֚\x0f\xf2\xee\xa6\xe1\x02\xc0e\xb9\xed\xcfY\xbd\xcc+\xd8gL\x81\xec\x00\x00\\\xa4د!l\x05H*CR\x11\xe2h\xea\x03K-k,1\x8f\xd3ڰ\x96\xac\v\xab\"\xb6vo\xf8\r\x00\x80#\xac\r\xcf+\xb32,\x95[\xb1\xb52,\xe6VM\xed\xfa\xaa*\x80\xe5\x95\xfa+/\x88\xfd\x02\x15v#[\x8e\x84\xfd\xdaK\x7fKžWV\x9eU[\x9c\xd3,\xfd\xab\x8fF\xf2yڍ\xddKyZD\n\x00.\xcf\xd6}\xff\x92Z\x0ep\x95\x11\xc8\x0e\x00\x00\x00\x00\xb8d\xe32X\x8b\xf2\xb84ߒ\xfa\xce\xe1\xe1\a\x00\x87\xa3\xaeU\x94\xac|\x8e\x03\xf2\x91_\x13\xf3\xd3\x7f[e5\x97\xda\x13\xfd\xc4:\x17Kmǹ&ݞ\xddK\x13\x16\xd2\n\xd7\xf6կ\xbe\x0f\xe0\xaa)+\x85vS]\xb5i\t\xce\xd2\xe4x\xaeP\xccU\x17\x99f\xa3\xa8\xac\xbd\xd5\x1e\xb2kg\x9f\x93\u07fc\xf8g\x80\xcbT?\xfe\xe5q\x9c\xf2\xfe\xb2T\xd4l\xb8\v`%\xf94D\x9c\x16\xa7\xb3\xb6}f\xdfY\xab&\x8c\x14\xfd1\xcb\x03\xbcΌr\x8d\x1e\xfaWL\xd6\x00\xae@\xf5\xc2wǶ\xa85\x99WrU\xa10m\x94֟\x03\x00\xe0\v\x19;\xafƪhiW\xf6~W<\xbf\xbb\xf8\x01\xc0R\xf2\xd1i?\xee\xc9g\x13\xb9\xf6\xac\xdd\x19\xeb5V\x88\x05\x00,\x81\xf9\xb9\x9fҨ9%U7i\xb6yZ\x9d\xab\xe4\xebJy3\x19\x9e\x00\xb0\x8c&\xddDݸV\x1eK\x8d\xebOX\x85\xf5\x84\xf6\xb7~\xc7\xf0\xebѵ\xf7\xfe2\xdeP\xbf`\xff\xbe\x85Զ\xca۱&i\xee\xca\xdc\x1a\xd0\xcd\\.-&\x1a\xaa\xe1i\x00\xf8\\1\xaf\xd5L\xe6\xda\tS\xab\xd7Xޑ\xa7\xf98v=\xbaYzWZ\x1a\x03\xc0\xd5F ;\x00\x00\x00\x00\xe0\x92\xa5~\x1c\xa5\xe0\x16\x1f\xa4\xe0\xadqI?\x0ep\xa8\xa2\x93ˮ\x1b.\x80\xcb7\xff\xe8W\xc7\xd9\xde\xe3\x8aݖ\x9cߔJ\"-\x80\xc3\x14\xdb(炲~\x97&/\xb5v\xafِ\xabre\xb5UȢԌ\xce\xc9m\xbdU\xe3\xfb\xff\t\x152\\\x91\x18v\xacnߟ\xc8\x05\xf2\x7f`\x95\xa5\x9d\x93\xfa]V\x9c\x95-i\xfb\x95\x10T\xb8\xb9\xa5\xfd=\xab\a\xb6*7\xdf6<\t\x00\x00\x80k\xa9}\xe2\x8f[U-\xb7jZmM\xfen\x18yaz\x1f\xb0\xd4b\xb44m\xe99\xadL\x91\x16\x13\x8bNYq\xc3\xf0#\x00\x00GXl\xec\xb0o\xab\x94\xa6a*\xd7oŞ\x06\x11\x00,\xb7!\xd8;\x16}\xe2\x0eY\xab\xe9]\x7fy\xd1\xfc<\xa2\xe2\xd3o\x8a\xe5\xec^Ջ\t\x8b\x00pY\xba4\x0fb\xe7Nm\x94\xa7-\xfb\x9b(\xb6\x99\xe6\xe3-m\x1c\xfb\xea\xe1\t\x00W\x1b=\xdd\x00\x00\x00\x00\x80K\xb2\xff\xe4\xef\x8cY3\xb5\x16\xe5\\\xcaS4U)\xa7r\xf8\x15\xc0\xa1p\x99|\xf9\xf0p\x01\\\x9e\xf3\x1f\xb9?V\xfb?)\xdf=\xaf\xcc\xf2\xf5\x18\xbd:\xb1\xe3\x13p\xa8R\x9ca\xd6X\x9d\xabV\b\xa5\xbaf\xd3\xd2\xe8TE\xb0\xdaWL\x81쯨\x1e\x97*\x1e|\xf4HO.\xc0r\x88\xddi\xfbH\xafR\xb4\x17\x8f\x89 \xc0*\x8b1((\xef\xdb\x11)\xa8BV\uf4ef\xectW\xa1s\xdax\xf0g(W\x00\x00\x00\x0eA\xd3=\xae\xd8\x16ʋ}\xbb\xb2:[Lc/L\xef\x03\x96\x9a\xb5\xb7\xbc\x8fr)J(\xb5\xbf\\\xaeb\xfc\xe0\xf0#\x00\x00G\x97\xeb\x1a\xfbH݄\xcdbM\xcc\xfe#\xf5%\xd2u\b,\xb7\xf9b\x9e_\x1c\xf7\xcd\xcd6\x8dE\x1fa\xdd\xc7\x1f\x8a\xee\xe5'U\xe4\x9f\xd0<l\fw\x01\xe0\xd2\xc5\xee\xb8\xf2\xec%\xf9\xe6\xa4]\x9d\xd7^1R\xbc\xe1\xcb\xe4\xef\xfa+Tn\x80k\x84\x9en\x00\x00\x00\x00\xc0%i\xebG\x95\x87Rr\xad\x94١\\.м\x04\x0eW)7\xfe\x92\xe1\x1c\xb8DO|ml?\xf0\x96X\uefa4\xcd\xe04\xca\xed}\xca\xf6չ]ui\x95}\x00\x87ƹ\xa2_8(\xf8\xb9ڐ&\xbc\x16}̡\xf2\x99\xd5âfE\xab\xd1\xf8\xdb\x16\x0f\x03W\xe0쇿\"\xc6xZ\xd1ޱ\x85T\xcf\a\xb0\xb2\xac=\x1fS\x10\x85w\x92\x95/\xea\xacM\x9f\xa6h\xa4\xa6}vs\xff\b\x00\x00\x00\xae\xbd6|ĪjV1\xcb\xea>\xdeUa\xb2\xa8\xa7\x01X^\xc1\x92\xb4\xaf\xa5\x98:\xf5\x9c\x82/4\x1a1\x9e\x03\x008\xfa\xb20\xb7\xf2\xcbK\xbe\x93s~\x98\x17\x14\xd4\x11~\x02,7WYz.\xa4.\xb74.5\xd9\xd1ݼ\xa6z\xe6\xd7Dw\xf6c\x8b\x7f\xd7PiR0~\t\xe0\xf2\xf94\xcf\xc6Y#\xbdnն\xc7\x14o<\xae\x8d\xdb\xff%=o\xc05DK\x02\x00\x00\x00\x00pI|\xfb\x9c\xb24@\xe5\xed\xc8B\xea\xd3^\f^\x0184ї\xd2m\x7f\x91\x8eU\\\xb2\xeeC\xef\x8e\xda\xf9\x97\xf2\xb3\x0fi\xdc:e\xedI\xbbY*v\xb5|Z\x87;\x1f\rO\x028\x1ci\x82k\xab\x10\xd3d\xd7F>o\xac\x0evN1V\xfd\xc4\x02\xb7\xfd\xab\x95\xdf\xf3?\x90\xff㊹\xf9\xa3*\xe2\\1MVɇ\x9b\x00V\x96\x8fQ1d\n}\tb\x05J\x1fP!\x05\xef\xe4&o\xed\xcf\x01\x00\x00p\xed\xc5\xeeEe)\x82=\xb5\xf9\xad\xae\x16U*\xa6\t\xb6\x00\x96VZD\xcc\xf9\xc6\x1a\\\x8b.\xbc\xa6\x18\xa9\xb8\xe5\xfb\xe9\xcf\x03\x00\x1ci\xed\x13\xbf3\x96J\xbb4\xa7\"\xab\xb1\xcf\xcc\n\xb54O(X\x91\xc6\xfc `\x99\xa5jiL\xf3\xfeb\x97֦P\xccn\x1b~9b\x9e\xf9cѝ\xfa\t\xb9\xe6\x984ڐ\xba\x93*\xd2\x189\x00\\&\x9fY\x1e\x12\xd2\x18\xe9T\xfeƑ\xb6n}\x8e\xb69p\x8dђ\x00\x00\x00\x00\x00\\\x92Q\x98Ii\xe5em)\xb8\xa8\xe8\xdbE\xe76\x80C\xd3\xe5\xd3\xe1\f\xb88\xdds\xbf7\x86\xf7\xdf\x14\xb3W\x1f\xb7\x8b\xa2ߌS\xde\xf2w\xbf\xdb\xe7\xe9.\x8c\xe5;{\xaf\xea\xf1\xe2\x1f\x00p(\xea\x14h\x98\xf6\xb6\x88Neڊ=k\xd4\xf8Z;\x1a\xabr\x0fk|\xdfO0\xb0\x86+\xf7\xd4w\xc7q{V>\xed\u009e&\xa0\xf9h\xf5\xfd\v;\xb3\x03XI)6\xcaʖh齏\xa5\x88\x8b\x1dW\xea\xf2f\xf9\x87\x7f\x94\xb2\x05\x00\x00\xe0\x90\xf8\xd0Z\xb3\xac\x92\xdaRΕVgKc0)\x80\b\xc0Ҋi\xc5\xc04Y>\xebW\xa8h\xf3\xa3\xbb\xe3%\x00\x00\x17\x84\xf9c*R \xbbK\x8baZ\x1d\xb5_ei\x11v\x12X\r\x17Xji\xed\xb44\x14\x98vfWtʋ\xb7-~8j\xce\xfcM\x95զTT\xaa\xba\xeb\xa4\xfclZW\x03\x00.\x9b\xb3<dn\xf9\xc9\xec\xa6Z\xfeޗ\x19\x0f\x05\x0e\x01\x81\xec\x00\x00\x00\x00\x80\x8b\x16^\xf8S\xb1\b\xb9\xd4\xed\xdb\xc51\xb5\xc1\x1a\x96Y\xea%n\x17\x0f\x008\x14\xfd\xee\xa9\xc0Ej\x9e\xf8\xe2ؼ\xf8/\x14\xab\x1d\xe9\x98\xe5\xe7qS\xca6\xa4<\xb7w\xc9\xf2\xf4\xb4\x02m\xd6\xd9}{\xb8b\xa1\x12\xe00\xb5i\xc1 \x97\xc9Ǒս\xc6vD\xd5r\xf2\x93\xb7i\xf4\x8e\x0f2\xb0\x86\x83\xb1\xfb\x13\x1a\xa5z\xbd\x1di7\xe6~\xb7\xbf\x8e\x89h\xc0JK\x81\xec\xc1+\xfa\xae\x0ff\x97R{\"SS\xdc\xde\xff\f\x00\x00\x80k\xafz\xe6O\xc6,\xb5\xfd\xf3Vj\xec;\xa6@\xf6ƪn\xf5\xf0\x04\x80e\x14}\xd6\a\v\xa9\xb3\x0f;\x0f\x19\x8b\a\x02\x00\x96@\xfb\x8c\xfa\xcd\xd8S \xbb\xd2xq\ndO\x05\x9a\xb1\xf2\f\xc0\nȭ\xad\x99\x8d4~\xd3\xdf9rcγ\x0f]\x17\xbb]/\x8dw庉\x9c\x7ff1\x96\x11F\xc3\x13\x00p\x19\xea\r\xf9c[\x9a>x\x8a\xb96\xc0!!\x90\x1d\x00\x00\x00\x00p\xd1\xe6\xf5SR\x1fHe\x17q\xd2ϹPf\x171\xdd\x00pX\x1c\x83Ÿ\b\xf3'\x7fyl߳\x19\x8b\x97?\xa0qwV.\xef\xb4\xefGj\xf3=u>W\x1bO\xa8\xae\xa7j\xba\xd6\xf2\xf6\x99\x1d\xfb\xf4\x1e\x02\x87,\xe6\xce\xf2\xf8\xdcNJ\xab\x7f\x8d\xa5.\x93Ӷ\xc6[o\x19\x9e\x00\xae\xdc|\xfe\x98}\x16\xf6\x8e9E\xab\xe8Gg\x95\xfc\x8e\xb1[`\xa59K\xef1\xed\xc6nm\xf94\xf9+\xa6\xba`\xa9\x90\x9d\x18\x1e\x00\x00\x00\xc0\xb5ִ\xcf)\x8b\xd6\xf6O\xfdq\xcd\xc8\xealE\xbf\xd0X\xa4y\x06,5\x97\x12u\x1f\xfbg\x1fi7[K\xdb\x00\x00\x1cu.\x9c\xef˯\x14Ȟ&\b\xa5a\x83\xbebj\xc5\x1a\xb3\x83\x80\xe5\x16\xa2\xa5딨\xf3\xb4\xc9\xc1\xd1\xdb4\xa3}\xdf\xf1(˂\xba\xe9i\xfb8f\xff\x8e\xaf\xa9\xcck\xabJ\xe7\xaa\n&\xb0\x00\xb8\x02ٞ\xca\xe9W\f\x17\x00\x0e\x03%9\x00\x00\x00\x00\xe0\xa25\xe7\x7fV\xaa\xceK\xe3\xdb4\xcb>\xa2Q\nj\x9fE)\x1b\x0fO\x00\xb8*\xfa\x1e\x9cB\x9d\x9cb\xd6ڑ\x86\x87K\xbb\x9fV\x1b\ue53f\xf5ci\x18\x19x}\x8f\xde\x12\xf5\xbe\x9b\xe2\xf8\xf4\xa3\xca,\xcb\x0eEi\uf43d>]Դ\xb1\xf7\xa7\xf3ʚ}\xfb>\xab\x91o\xedM\xb3<\xbdM\xbb>\x15\x8b\x1d\xa0\x00\x1c\x9aQ\xf0j*\xcb\xeb7\xf6\xad\x188\xa5n\xb7\xd2t\xfa_)\xbf\xfbo\x90\xef\xe3@\x9c}\xf2\xd7\xc7\xf1\xc8^\xa7p\\Ma\xf5\x8bq\xa3nv\xc2\xde7\xf2\x7f`\xa5\x85\xa8\xbcذ:_m\xf5C\xab \xba\xa0\xb6\x9b\xe9\xf8\xfd?I\xf9\x02\x1c\x90l\xf7?)\x9f\x95j\xad\x9cue%_\x8dU\xa5\xb2\x16\xc0\xcaj\xf3J*s+W\xd3B\xb0\xa9H\xb5:\xb5\x9b+t\x8d\xfa\xc5a\x81/ \xcc\xfe\x95\xca\xfd=\xa9:\xa9\xd9\xd6+\n\xadS\xe9w\x94\xd7[\xc3\x13\x00\x96Qt\x13\xe5\xa9\xd9\x15Ϫζ5:\xfe\xb5\xc3/\x00\x00\x1cQ/\xfc\xa1X\xa4ŕ\xd3x\xb1k\x94\xb7#\xf9\xb4\xf8mfm\x9bn\xacI\xd8\x19\x1e\x04\xb0\x8cꔾCf\x15\xd5Z\xaf\xa6\xcd\r\x8e\x92Ƕc\xdeV\x9a\xf8}\x95\x96\xdf(V\xf6\xefj\xdfMʓ\xd2عU\xac\x01\xe0\x8d\x14sͻB3ݖ\xd6\xf06s\xcb\xf4\xa6\x96\x7f\\\xaf\xfdh\xe7\xef\x8eN\xf7\xfc\x1f\x8c\x85\x02\x87\x88@v\x00\x00\x00\x00\xc0Esꆓ\xd8o\xdaF\xaf\x0ep\x8d\xa4\xe0\x12\x05\xa5}xc\x1c\xbas|\xe8\a\x96b$\xd0\f\xaf\xaf~\xfa\xb7\xc5S\x8f[\x86\x1dһb\xf9w:\x8c\xeb\x97\xcc\x1f\\x\x9f\x00\x1cM\xcd-*\xf2\xb3Ү\xd5\xc2,-\xc7\x1b\xdf,=\xf8cT\xc1p`\xb2\xe65\xa9\xab\xac<h\x17;\xaatV\xd7Ho\x18\xc5\x03\xb0\x06\xd2\x04\xb5!\xc1g\xe74\x9a>\xd2\xdf\x05\x00\x00\x97'\xa6\xfe\xbb\v\xc7\x05\xfd\ueec5\x15\xb7Go\x873\x1c=.\x0e\v\x9e8\x16>\x01VIT\x93\x86w,m\x97\xeaT\xa9\xbc\xe9\xfb\xe8\xdb\x03\x00\x1ci]sf8\x03\xb0\x8a2\x97\x06\x01\xbb~x\xa0\xc8o\\\xdc<\x02\xe2\xb3_\x11\xdb\xfa\xe4p\x05\x00\x97.v\x1b*\xcb\\\xde}\\\xae\x9b\xdb\x1d\xcbS\xfcLM~^\xd3c_\xbex\b\xc0\xa1r\xfd@\n\xae\xaa\xf0\xf4\xaf\x88:\xfd\xac\x82\x1b\xa9v\xaf)k\xa2\xbc*\xcd\\\xae\xedpnx\n\x00\x00`=\xbc\xb61Q;\xbaG7\xdf\xff~\x06h\x81%\xb4\xf7\xe8-qc\xf6\x9aT\x9cP\xad\xd7\xfa]{]HA\xb4i\"\xde\x10\xe4\x0e\xe0*\xb0\xf4\xe5\v\xa5m\xb4c\brή\xf3N]\xd7\xf51\xcaŻ\xd3\x12\xe8\xc0B\xf5\xf1\xaf\x8d\xda\xfd\xf7\x1au\xbbi\x96\x9c\x1d'\xec#͔\xb3#v\x8av\xb8\xb4\x1aI\xffc\xfa\xca\x16\xdf\x00\x8e\xa0\x9b-\xe9\xbe,\xb53\xd5ǶU~\xd19\xf2{\x1c\xa8\xfd\xc7\xee\x88\xd3\xea9\xa9\xd9V\xccϫ+7\x14f\x13\x95\xd9y+\x1f\x88f\aVVl\x15|\xae\xe0Z\xe5\x96\xd6\x1bk\xe1\x17\xd7\xff1\xe9\xee隆\x01\x0eH\xfbѯ\x8c\xf9\xe9\xf7\xa8-+\xe5i\xb5\x98:SUz\x8dZ\x82\x13\x81U\xd5dV\x9ej*uV\x8f\x0e\x95\x94\xb7}\xd7K\xd0D>;&\xbd\xfd9\xcaY|^{\x8fnō\xbd\xb4\xc5\xdcXswVe\xb7%\xefv\x14\xa2}\xa7@X\x00K\xa9\xb5\xb6W\xde\xce\xed\xe4\xb8\xf66\xe7\xdax\xdb\x0e\xe5\x01\x00\xe0H\x9b\x7f\xe4+\xe3\xf8\xfc\xff;\\\x01X5\xad\x1b)o*\xa9\x9ck\xff\xe4Wjz\xcf\xff}\xf8\xf5ӧ~Cl^\xf9q5㉦u=\xdc\x04\x80K\xd3jKYqZ.m\xf4Ҏ\xa40U\xe5Ϩ\xdb~\xa7\xa6\x0f\xbe\x87\xb68p\x04\x10\xc8~-|\xec\xadQ\xa7? e\xd7\xdb\xc5k\xea\xc7\x16\x9cS\xf0Q\xdeo\xf7\x8f\x00\x00\x00\xac\x8b\x10ϫ:v\x93&\x0f\xbcD\xa3\x10XB\xd5/\x9e\x8c\xa3\xf9\xaeTl\xa8q\xe7U\x84\x91\xfa\x1d\xa1݆50\xe9H\x06\xae\x96\xa8\x14x\x9c\x8aΑ%9/\x977R9S\xddFŸ\xa9\xd1;\x99\xf8\xb4\ue697\xffL\xdc\xdf\xfd\a\xcag\x1f\u05f8\x92\xb2ٶ\xbd8\xd1N\xe6\x8a\xf9bׯO\xed\xc4\x1e\xec\xe2ӂ'\bT\x04\x8e\xae\xb4{\x9fe\xf9\xd5\U00059293\x7fZ\xfe\x96?G~\x8f\x83\xf3\xc4\xd7\xc5f\xe7GTtV&t\x13+3Z\xb5\xc5H\xa1.T\xe4;r]><\b`\xf5X\xba\xcfju\x9d\xa5y\xe75\xb3\xb6\xc5\xf6[S\xa1\x03\xe0\xa0\x10\xc8\x0e\xac\x9fַ\xca4\xb2zt*R[)\xf5\xdf\x05o\xa5\xee\x96|y\xbd\xf4֏P\xd6\xe2\xf3\x9a\xbfw\x1aǕ\xbd;\xe5T\xb5Ω\f\x9bvw\xd7^\xa3m\xf9\xc0\xf8\v\xb0\xac\xba<(\x9b\xa7\xbe\xf9\x1bt~\xab\xd2\xf6\x17\xbdFy\x00\x008\xd2\xf6\x7f\xf1\xc18m\x9e\x1d\xae\x00\xac\x9a\xe0K\xf9zOaT*\xde\xf2G\x95\xdd\xf2\xbd\x87Z?\x8d\xcf\xff\xc9\xe8^\xfa_\xa4:\xaa\x998\x15\r\xe3\x93\x00.O\x95\x15\xf2aG\x85۔\xda\r\xd5\xe1eͷ\xaf\xd7\xf6#\xaf\xd2\x0e\a\x8e\b\x02ٯ\x81W\x1f\xbf;\x8ew\x9fѸ\xd8V\xe7\xcek\x14\xb6\xe4\x94k\x96EM\xda\xfd\xe1)\x00\x00\x8051;&\x9d|\x87\xf4\xc8O\xd00\x04\x96͓\xdf\x14ù\x7f,_\x05\xa9\xf4j\xe2|\x11\xc8\xee\xf6\xd4\xea\x98\xf2X\r\x0f\x028h\x9d\x9c2\x9f&+\x8e\xa5y!\x15\xad\xe2hW\xb3\xa6Ш|@\xd9[\x1f\xa7\\]S\xcdӿ1f\xf5O\xabٝ\xc9\xcf\x1b\x15\xceKy\xbe\b~\x8d\xc1\xf2g\xd7\xef\xd8t!\x88ݹO{U.\x04\xb3\x13\xc8\x0e\x1c]\xf3\xb9\xeam+\an\xfe\xbd\x9a\xdc\xf4\xd7\xc9\xebq\xa0\xe2\xa3\xf7ŮzR\xfdt\x90\x98\xea\xf5N!+\xd4\x05\xabw\xe4\xbb\xf2\xedb!\x14\x00+\xc8e\xf6\xb1\xa7ؕ\xaa\xf2\xb1f\x9b\xb7\xebă\xb4)\x80\x83D ;\xb0~:Ee\xce\xea\xd0\xc1Ҽ\xab\xa5\xac\xb3\xd3L\xc1\x9dP>\xb9Ez\xf8}\x94\xb5xC͇\xbf.\xba\xdd\x1fU\x9e\x16\x1a+\xc7j\xc2LEH\xad\xb5Z\xb5\xdfR\x99v\xf9\a\xb0\x94B\xd9I\xfb\xb9\xbc\x95\a\xbbǯ\xd7\xe6C\x8fR\x1e\x00\x00\x8e\xb4\xf9\xcf]\x1f\xc7no\xb8\x02\xb0r\xdcH\xeaΩ\x1a\x9d\xd0\xe8\x8bO\x1fzݴ{t3f{Q\x1a\xb7\xd68\xb6v\xb0\xa7\xff\x14\xc0\xe5\x99\xfbJe;\x92\x8fiqȳڟl\xaa\xbc\xee\x8f(\xbf\xfd\xbbi\x87\x03G\x04\x81\xec\xd7@x\xf4d\xf4\xf5\x19)\xf7R\x1a\x98\x0e\xdbv̭\x92\xe5U\x97T\xb4\x00\x00\xc0z)ӎn7\xffN\xe9\xf6\xbfA\xc3\x10X2\xdd\xe3\xef\x8aY\xfd\xa8T\x8f\x14\x8aFAQySJٮjwBe\x98\rO\x028h\x9d\x1b\xc9뜜\x1fK\xfbv\x14\xad\xc2hW\xfb\xedu\xda<\xfe+\xa4\xfb\xff\x11\xe5\xea\x1ai\x9e\xfd\xb51\xec\xff\xb4\xfc\xfeye\xcdT~\xbeo\uf13d\x02\xa5ճ\xd2\x02#MT\x1d[\xc5i\xa7l+*;\xbb\bT\xffd\x10;\x81\xeb\xc0\xd2h۹⭿\\\xc5]\xff\x8e|\x1e\a\xae\xfb\x85Qtm-\x9fޮ4i%\x05\xd9x\xaf\x98\x16:q3e\x9d\xdd\x03\xb0\xa2\xac-\xef\xce[F\x90k\x7f\xb4-\xdd\xf0\x8d\x9a\xde\xf6\x17(k\x80\x03D ;\xb0~\x82\xf3\xf2\xca\xec$\x05\xb2WR\x1e\xd4v^\xcanV>\xbdUz\xf0g(k\xf1\x86\xda\x0f<\x12\xf3\xf9Ǭ]\xd6(\xe4\xe3\xc5\xf8K\xd7\xf5\xbfU\xd9T\xa3\x8e@v`Y\x85\xb2Q\x9c\xe5r\xd9q\xc5\xeb\xbfJ\xd9=\x7f\x97\xf2\x00\x00p\xa4\x85\xffo\x1c}Nq\x05\xac\xae4\xfewN\xb3\U0006d6bc\xe5\xf9CM\xec\xcd\a\xaf\x8f\xc5\xf9\xf3\xd28Sh\x8e\xc9W\xbb\xf6\xaf\xb7h\v\x03\xc0\xa5j\\ژ\xebn5\xed'4\x1fGm\xde\xf8\xa7\xe4n\xff\xef\xa9\xd4\x00G\b\x81\xecW\xdbs\xdf\x1e\xe7/\xfe\xa0\xc6\xfe\x9c\xdaL\x9a\xd7N\x9b\xfeF)\xbe\xac\x98{\xb9v2<\b\x00\x00\xb0\x1e\xaa\xe6\x84F\xbf\xf4\x134\f\x81%T\xbf\xf7\xbaXꌝl\xaa-\xe7r\xce+\xabJ)\xdf\xd1\\'5\x8e\xfbÓ\x00\x0eZ\xf4\xc7\x14\xba\x97\x95e\xa9sa\xdb\xd2]\xa3v\xb4\xab\xaa\xbdO\x1b\xb7|\xbdt\xdb\xf7P\xb6\xae\xb8\xe6\xa9\xdf\x13\xf7f?\xa9\xae~Ni\x03\x97\x8d\xb0%\xdf\xef\xba>\xb3|\xf9\x01{'^\x96\x8aS҅\x18u\xbf\xa9.v\xaa\xbbJ\x934\x89\xfa\x93\x86W\x85`v`)\xec\x9c\xf8*m\xdd\xff\xa3\xe4\xf18p\xf5Ǿ.\xfas\xff\xb8_\x8d\xdc\a{\xc5\\\xb3(\x1b\xac\x8e\x1f|mOtv\xbfX<\f`\xe5D7\x96K\xf5\xc8Xi\x7fz\x8b\xa6_\xf4\x02e\rp\xc0\bd\a\u0590/S!+\x85\xceέN\x9d7\xaa[\xabSg\xb7\xa8ܼKz\xe0?P\xde\xe2\r5\xef\x9bƢ\xb3\xf2\"\xcc\xd5eiѡBYZ<8\xe6\xda\xf7cMCj\xa7\x01XF]\xd1JU\xb4b\xe2\x84\xdc\xdb_\xa5,\x00\x00\x1ci\xe1\x13\x7f8\xfa\xe7\xff\x8a\xb5c\xf2\xe1\x0e\x80\x95\x13S_Ŏ\xf6&\xf7j\xe3\xcd\x1f?\xb4\xfai\xf3\xe4\x9bc|\xe5\t\x95\xa9\xbe\xecN\xaam\x1a\xe5\xb2sO|\x1b\x80˕\xe6<LU[=Ɲ\xf8\x15*\xee\xfd'\xb4\xc1\x81#\x86@\xf6\xab\xed\xe9o\x8c\xf1\xd5\xff(W\xee\xab\xf1\xafi\x7f\xbe\xa9c\xd9u\xe9\a\xa9\xc8Ҳ\xb9\x8b\xe7\x00\x00\x00\xd6\xc4\xee\xc4i\xf3\x919\x8dC`\t\xd5\xefq\xb1LM\x98zK\xedh_>\xe6\xf2uim\x9b\x1d\xed\xc7\xeb5\x8d\xbb\x8b\a\x01\x1c\xb8\x98]\xa7\xaey^y\x1a/\x9e\x1d\x93\xcaVM\xbe\xa7*\xbcE\x9b\xef|?\xe5\xea\nj?\xf1Gb\xb5\xff\x1e\xd5\xf5\xd3:\xb6\xff\xa2\xbc+\x16\x13\xa2}\x9a\f=_<\xd4M\xedc\xaa\x99\x7fM\xb9\xb7ۡTg\xf9\xb2\xeb&*2{-\xb2\x94/\xef\xdb\x0f\xc3D\x83\x14\xa8\xf89\x81\xec\xe9\x9b@\n\xe0\xc8z\u05cc<\x1eW\xc5\xee/\xde\x1f\xc7\xddǕ\xb9\x9b\x15B\xb0\xd2a\xd7\xea\xf7V&D+\x13\xb2V]l\x95E+w\x00\xac\xa4\xa0\x91\xd5\x02S\x1d\xd0\xda\xf3\x9b\xb7i\xfa\xf0s\x947\xc0\x01#\x90\x1dXC\x9f\x1eȞ\xcd\xed\xe84o\xad\xcc\xcdnV\xb9y\xb7\xf4\xc0\xffCy\x8b7Խ\xd7\xc5,\x8c\xed\x1d\x9a\xab\xf1\x852?\x91\x0f{v\x9dkϏ\xb4\xd1\x11\xc8\x0e,\xabƷ\x8am\xa7|rL\xfe\xadg(\v\x00\x00G\xda\xfc\xc9\xdf\x1aǯ\xfe\xf3O\x8d/\x03X=\x9d\xa5\xefrW;\xd37i롏\x1cJ\xfd\xb4z\xfa\xabc\xf9\xd2O\xcaY3\xb8\v[rUg\xd9N\xabf\x14U\x10_\x05\xe0r\xb9Fuө\xdcz\xa7\xf4E\xef\xa1\xfd\r\x1cA\x04\xb2_m\xefۊJ\x7f\xc6!(}\xa7?\xeft8\xb7\xc8\x13/|\x03\x00\x00\xac\x8a\xe8\xe6ry\x9a\xec\x9e)-\x90\xd8O\xda\xe9\xeb<\xc1\xeaA\xadΖw\xe8\xc4\xdb\x0eo%G\x00W\xe0=\x13\x1a\x90\xc0a\x19uR}R\x9d?\xad\xaan4M\x8b\xe4\xb9S:]ܮ\x93o\xfb\x04\xe5\xea\xb2{\xf1\x9b\xa3\xe6/\xa9\xd9{Rm\xfd\xac\xb2\xb0\xab\xb2\xaf>\x8d\xedH\x01\xecC\xe0:\x80\x95\xd3v'\xe4\xb6_T\xb4l>OI}~\x83\xba\xfc5\xed_\x7f\x97\xb6\x1ex\x8a\xfc\x1dW\xcfϻ\xa8h\xe5\f\x80\xf5\xd4M\xacmqF3\x7f\xbd6\xef\xf8\x16\xe9\xa6\xef\xa1\xcc\x01\x0eX\xfb\x9e\x1bc\x9e\x9dR\x88\x9b\xf2\xa1\xd6|\xea4\u07b3\x1fRP;\x80\x95\x14\xdc\xd4\xd2\xfbY)N\xa4I\x90\x15\xb4\x96\xe6k\xb5Y\xa3\xb8\xfd\xbbU<\xf0\xb7)o\xf1\xba\xe2s\x7f,\xba\x17\x7fp\xb8\x02\xb0r\xbaRm\xb1\xaf\xb3\xd3\x1bt\xfd\xc3/P\x16\x00\x00\x8e\xb4\xfd_\xb8#N\xe3sVIe\xfc\x00XYU\xae\xfd\x89\xd7\xf4\xbe? \x1d\xff\xdek_?\xfd\xe0\x9dQu-\xd5su\xa1\x96sQ>K\x8b\x7fF\xfb\xab\x93c\xa1m\x00o\xa0\xed*\xe5\xe5=\x96]\xbc\xa4\x18\xf6\xe5\xfc\xc8\xceOZ\xbb\xfb5)oTϤ\xec\x86_\xa5\xec\xc1\x9f\xa4\xed\r\x1cQ\x17\xb6^\xc2Ւ\x82\xd8\xd3\xf1i\bb\a\x00\x00+-\xe6i\xb6\x8e\x1d\xd1\x1a\x8a)x\xbd[L\xce\xf3\xd1\x1a\x8dNyq\xd3\xf0 \x80\xa5\xf2\xecw3\xcb\x168D\xed<Sh\xba~\x00'/\xac\x9cuU\x7fߗ\f /\xa3\xe6\xc9\xdf\x15\xf7>\xfaH<\xfbX\x19O=\xeab\xfd\xf2\xcfhv\xfa\x03\xaa\xe7\xcfY\x1djW\xdeY\xfd)I\xf5(\r\xe7\x00VR6\xdaQؙ(Oc\xf3\xe1a\xb5\xe5\xabj\xb6G\x04\xb1㪪\x9f\xf8\xc6\xd8o\xc4\f`}e\xb5\x9cߔ\x1b\xcf\tb\a\xae\xaa\xa1\xc0u\xae_\xec\x1e\xc0z\b\x9f\xdc\xe8bѧ\xe3\\\xa1\"\xdf\xe8ρ\xd7\xd3\xd6/\x0fg\x00VR\xaa\a:\xaf\xbc\xb8n\xb8\x01\x00\xc0Qf\xed\x19\xc7n\xc8\xc0JK}\x95\xbe;\x94 \xf6\xdd\xe7\xfe\xd8\"\x88\xbdk\xec*\xc8{o\x87\x9d\xa6\xb8\xaatD\xc2\xdb\x00\xbc\xb1||L]\xfbdꀵ,cSj+;ߓ\xb2\x93\xea\xac\nSL\xdfA\x10;p\xc4Q\xd2_m\xc3\x00U\x7f\x98\x14\xbc~\xe1\xe8+[\x00\x00\x00+Ʃ\xb4:P\xde\xc7\\\xf5\x93\xf3\\\xea\xe0N\x93u\x16\xf5\xa1|\xf2`\xff\r`\xb9\xec\xcf\xde7\x9c\x018\fy^\xca;'\x9f\x17rY\xa1\xb6\xcd\xd4u\x99J\xff\xe6\xe1\t\x1c5ͳ\x7f8\xce>\xfe[\xe2\xdeG\xbf*v\x8f\xb9\x18\xde\xefb\xf7^\x1f\xe3{\x8aX\xbc\xf6ϴq\xe6\x05\x1d\xdf=\xa6\xeb\xf6o\x94\xea\x0f*\x8f\xcfh\xec\xceh\x9cu\xca}\xb6\xe87J]G\xec\xd6\a\xac4gm\xa5\xc2ͤ\x9d\xfb-\xb3\xff\xb0\xf6&7\xca]\xf7\xf5ï\xc0ձ_\xfd[k\xa2o\x0eW\x00֒k\x14\xb2\x91\xb2ɱ\xe1\x06\x80\x03\xd7\xf7\x8b\xa7>r\xfb6\x04\xb2\x03\xab\xcf\r\x8bW\xa4\xe4\xde\a\xb3/\xae\xe4|!\x15Կ\xf1\xc6\xea\xea\xe3\xc3\x19\x80\x95d\xf5\xc1\xe0F*\xc6\xf7\f7\x00\x008\xba|\xac\xadqC ;\xb0ҼW\x97\x1dN_e\xf6\xe2_\xeawb\xef\x03\xd9]\xea3\xb1\x9b\x9f\x16S\xc5F\xa1\x00>\xaf\xacU\x9b\xb2\x89~\xbc\xe5\x84\xe5g\x85\xa2v켵\fdK\xee\x1d\xbf@&\x02\x1cq\x04\xb2_m)\x83\xfc\xecA\xe9~\"2\xf9#\x00\x00XQ\xb1\x90B\xaafz\xb9\xb4\v\xfb\x85F\xe3pL\xee\xf9[T\x84\x80%\xd4U\xef\x1f\xce\x00\x1c\x86\x10ju\u03794\xabQ\xd1\xca\xd9\x107\x95\x15o\xd2t\xf2\x8e\xe1\t\\SO\xff\x91\xd8<\xf9[b\xfd\xb1\xaf\x88\xd5G\xdf\x19\xcf=\xba\x15\xcf\xff\xe2V\xdc}\xdfV\x9c\xbdo;v\xbfp\"\x16\xaf\xfe=M\xce\xfc;m\x9c\xfb\x05e\x95Ռj)\xeb\xacn\x94:\xcf\xfd\xbe]\x9c\x97\n\xfb\xff\xb4<\xa32oUdQ\x99/\x16\x8b\x02uy_\x9f\x8ai\xf0\xb0\xb0\xe7\x01\xac\xae8\xb3\xfc\xe0\x16;>\xa6]kC\x95\xb7\xfcJ\x8dn\xfe?h3\u1a8a\xed\vV\x16\xf1\x9a\x01k\xcd9ծR1\xf9e\xc3\r\x00\a\xado\xfb}\xb2o<\xb0F\x19\xb0\x06bJ\xf7\x96\xf03\xfb\xab\x9f\x89\xedS·\xbb1\x97n\xff>*\xe0xc\xf5\xd3\xc3\t\x80\xd5\xd4)\xb8\xb1F\x93/\x1a\xae\x01\x008\xba\\طO\xc2K\x80\x95fIܕ\xdb\xc3ŵ\xd3|\xe4\xe68i;u\xa1VL\x8b\x01^\xe80\xfd\xe4\xdcb;\xef#\xdb\x01\xe0\xf5\xb5\xed\xaeJ\xbfeg\xa5\xe5\x19\xe7\xa5l\xa2\xdaG\xcd|P\xb6\xf9\x8d\x8b\x87\x00\x1ci\x8e\x95\xbf\xaf\x9e\xf0\xfc\x1f\x89\xee\xf9\xbf֟\x7fru\xa0\xcf\x0e`\xe7\xcf\x1f\x00\x00\xac\x9c\xa9\x1di\x92^#Y\xe3\xb0\xdfy&\xed\xce~!\xb8\xfd\xdd\xfbL\xd6\x01\x96\xd0\xec\x17\xcb8iXu\x1984E\xa6\xb0\xbb'_Z)\x9b\x17j\xbb\xa9ƣmi\xfb+\xa5\xbbY$\xe6\xa2}\xe2{\xa2bk\xf5\x92J]kG\xd7(\x84V\x93\xf6g%\xfb\x96\xddW\xb0:\x8cj;\xd2u\xad\x18;U\x1b\xe9^\xaa\xd6D\xf9Ύ`\x7f\xe4\xc3y\x7f\xdf\r\xbbj\xa5~\x9eT\xf7\xb90Ж\xce\x13\x7f|\x18\xef_ԍ\x82U\x8eZ\xfb\xf7Hg\xe9z\\\xa7\xbaS\xcacs\xfb\xe7\xd2\x7f\xb4\xddO\xf5\xa8<\xc8g\xf6\x0f.\xfe\xeb\x01\xac\xa0\xe0;\xf9\xe8\x14\xb2V\xddɯTq\u05ff!O\xc7UU?\xf5\xabb8\xf3\xef5\x8eV\xbe\xa4\xf2\f\xc0Z\x8a\xbeЙ\xbc\xd1ɷ\xcd\xc8\b\x80\xab\xa4\xfb\xf9\xed\x98\x15;\xeaꉬE\xaf\xfd2j:\xb36_6\xb4\x13\x01\xac\xa0\xd4\xf9SK͆\xb4\xd9(\xb6\xad\\[k?;\xa9關\xa2\xcc\xc5\x1b\xaa\xde[\xc6Q\xc7\xf8\v\xb0\xb2\x1a\xab\vnݬ\xe9[\x9e\xa1,\x00\x00\x1ci\xdd\x13_\x17\xbb\xd3?\xa22\x1b-ƺ\x01\xac\xa4\xd6M\xd4\\\xf7\x80&\xf7\xfc쵫\x9f~\xf4\x91\u061c\xfe\xa0\x8a\xe2\x06\x85v'm\n\xaf>\xae\xca\xf2\x9a\x10\x16\xfd\xa5\xfd\xa6Y\xe9\a\xc6/\x01\xbc\x81&\x9b\xab\x887Y\xa5%\xe5\x1f;\xaa\xf3=\xcd\ni\xbc\xf1\xeb4\xba\xef\xc7\xc8<\x80%@ \xfbUT}\xf4\x97\xc7\xe2\xcc\xff\xdb\a\xb1\xf7\x81\xec\x9f\x1dĞ\xf0\xe7\x0f\x00\x00V\xce\xd8\xea=)\x88\xbd\xb5#E\xb0;\xc5\xc6\xdb\xf7HΏ\xa4w\xbdBc\x11XB\xe1}.\xfa\xd6\xd27\x80\xc31ٔ\xf6v\xfb\xf25\x16Q]\xecRȳ\xea\xec\x98λ\x874m?\xb8xn]\xc5\xd8w\xbb8\x97\x06\xb6\xa2|\f\x8b\x15\x9cC\xeaw\t\xf2i\xa7s{\xc0\xa7~\x98\x14\\\x1e:\xfb\xa9[t\xcb\xd8e\x96\xddh\xe7v\x1d\xec\xcf\u05fe\x9d\xecH\xab?;\xab˘*6\xfd?\x9f\xa5\xff\feii\x1e\xbb\xeeG\xd6\xfa\xdf\xd5\xcc\x16\xdfí4\xae\x16\xbd_|ۭbV\xda\xf3\x99\xfd\x17\xd9\x03\xf6ϥ\xfe\xb8`\x0f\x86\xfe_\xc0\xab\xa8*\xfb\xcd\xfe\x1fM\x83r\xf6\xdf\x11S\xb0\xbd\xec\xc8\xd2\x7f\x7fT\x96v\xee\x02\xb0\x92\x82\x9bjW\xa7\xb5q×+\xbb\xeb\xdf\x0f\x99\np\xf5\xcc?\xb4\x1d\xc7\xfb\xd66\xf7g\xa4\xb6\x18\xee\x02X7\xc1\xea\xc7{ӻ\xb5\xf5\xf0\xfb){\x80\xab$\xfc\xfcF\xf4ž¼\xec\x17(۷\xf6ݴ\xb2\xb2\x97@v`u\xf5\v\x1aF\xa9ْ6\xe6\x8am-\u05f5\xdaq7j\xeb\x9d/S\xe6⍽'uD2\xfe\x02\xac\xac.jg\xeb.m=\xf2\x11\xca\x02\x00\xc0\x91V=\xf6\x96X\xec=&\xe7&v\xa4ql\x00\xabh?\x1fir\xd3o\x95\xbb\xf5o\\\x9b\xfa釿.\xea\xfc?\xb6zq\xa1X\x1e\x97\x8b\xe7\xfay1\xbd4w&M1\xb6o\xef\xfd\"\x90\xbd\x9fG\x03\x00\xaf\xa3,\xa4z\xcf\xf2\x89\x1b\xd5\x05\xcbZ\xf2\x97\x95\x1f\x7f\x97\xb6\xee\xff9\xda\xdb\xc0\x92 \x90\xfd*\xda{\xff\x9dq:\x7f\xa5?\xff\x9c@v\xfe\xdc\x01\x00\xc0\xaa\xeaw\x13m\xa4>\xf0*\x05\x8ay\x85v$\ufded\x11\xb9%\xbd\xed\xa34\x18\x81%\x13^\xfc\x13\xd1\x7f\xe2\xfb-}3\x91\n8,{Y\xab\x8d:\x95\xab\xa5T\xe4\nٮ|\xeaZ\xb0R\xf5\x9c\x1d\xc7:\xbb\xbfκv\xf1\xdd\xf7\xbdX]\xe4\xb3\xfaa\x9az\x11\x90\x9e9\xdf\xf7\xd1\xf4\x83_\x17\x9e3\xc1/\x02\xd1\xfb\xdf\xfa.\x9b\xf4\xfc\xf0\x9faG\xecwhO\x03f\xc3?\x97\x02\xcbc\xaa\xf3,\xee\x05?\xb7s3\xfc祠\xf5\xe8\x87\xff\xfe\xe8\x94\xcd\xd3\"\x04v}\xe1^\x9aԜ&7\x0f\xff\xf9\xa1\xcb\xec+\xfd{-\xfa\x8bR\x7f]:\\ZA\xd6\xf4\xfdJ\x00VR\xf0\xc7ԝ\xb8_Ž\xff\x99\x84\x8ek\xa2yo\x16\x8bv\xd3\xca$+\xbb\xfar\r\xc0:\x8a\xa3Zn\xebOH\xf7\xfc\x8f\x94?\xc0U\x12~~\x12}9W\x98-\x02\xd9\xf7|j\xd7[\u06dd@v`uŴ\xc0\xb3\x15\xad\xf5\xb6\xe2ƾb\xa8䚠\xdd\xfcvm\xbd\xe3\x13\x94\xb9x]ͳ\xbf'\x16/\xfem{wXh\fX]Q\xe7\xb7\x1e\xd0\xf6C\x1f\xa0,\x00\x00\x1ci{\xefی\x1bݞb\xb7)\x976\xb0\x01\xb0\x92\xce\x17\xa5\xb6\xbf8\xcd4\xba\xfaڧ~\x7ftg\xff\xba\xb2ن4\x92f!\xd7\xc4\xed/\xe6\xca$\xd1\xfeNqUq1\x97\a\x00>\xaf\xac\xb4\x8c弝\x8c\xd4\xf9\x93j6\xee\xd5\xf8a\xe6\xda\x00˄YJWQh^\xeb\xbf\xfbJ\xd5\xebT\xacXD\x00\x00\x00\xac\xa6\xc6\xea>i\x99\xc4\x14\x00\x16\x15B\xb0/\xab\v\xa5\xdd\xd8\xf3\xad\xc5#\x00\x96ʼ\xf9\xa8\xc4\x18\x15p\xa8Bn\x890Oe\xea؊\xda\xe3\xf2\xd5\xf5R\xbdiisS\x1bi\xd5\xe2\x148\xbd\xc6G\xc83-v4O\xc1\xe9)\xb8ܾ\x83]\xa7\xc0\x7f;\xfc\xc8\xf5G,\xed\xc8S\xe0\xf9\xd0%\xd6\xf7\xcd\xf8~\x95\xd6(g\x7f\xd9?\xe3\xd2?7\xb2\xaa\x8c\xfd\xb9\xb6vޔr\xd5Ԏ\xb1\\m絗k\x83\\\x9a\x8c\x1cf\x8aaW\xc1\xea?鈡\x96\xef\xechf\xca\xe6\xfb\xcafv\xcc\xf7\xec_\xc1\xfe\xf3\x8a\xc2\xfe\xab\xf2>\xc0\xbd\xb3\xff\xb6\xce\xeaG}ϐsj\xb2\xb9\x1a7S\x15檻\xb9\xfd\xfbT\xf6C'\x97er\xc5$=\x05`Eu\xc5\xcb\x04\xb1\xe3\xday\xf1\x9bbV[\xbb\xbc;o\xe5ܚ/\x82\x03\xac9\x97\xda\x16\x04\xb1\x03WW\xbf3s\xea!_\xf4\x9337\x00X\x03C\xbaO\xfdMil\xac_\xa80\r\x8f\xa5\xfe*\xe0\rT\xf5\xd3\xf6\xce\xe4\xc3\x15\x80\x95䜕\a\xa4s\x00\xc0\xd1\x17\xbb=+\xb7R\xd1E\xb7!\xb0ʆ\xfd\x14\xae\xbe\xe7\xfft\x9c\x9f\xfa{\x8bu=\xa7s\xb5!S\x96\x87\xbe\xbf4\xa6\xc5\x00S\x7f\xa9\xe57i#\x8a\xc5f\x14\t\xe1m\x00\xdeX\xac\xce[61\xb5\\\xa4R\x9b\x9d#\x88\x1dXB\xec\xc8~\x15\xb5\x1fp1\x9f\xb3c!\x00\x00X7ib^\xa9\xd6G\x05\x9f\xabt\xd6p\xac75\xcf\xe6\x1a\x7fIC\xa3\x11XB\xb3G\xaf\x8f\x93fO\x8b\x88K\x00\x00\x00\\\xb4<W\xdd\xec\xca祼?џ\xe7\xbeR\xae;\xacn\xf5\xaa\xf4\xce\x1d\xdaH\xb8f\xe2\a\xb6\xa3\x9b\xed\xd8\xd9q\xedd\x95\xb6\x02\x15|`U\xc5.\x93K;¦\xfe9K\xef\xadk\xed\xdeD#_Z\xd3\xfe\x9cvˇ\xb4\xf5ȇ(\x83\x80\xab(\xbe\xb7\x88\xaek\xa5\xecf\x85\xf0\x92%\xc7mɥ\xfe5v\xdc\x05VU\x8c\x95\xe6\xd9H\xe38\x96\x8bV\xef\xb6s\xb9}\xed\xd5'\xb4\xf1\xa5\xa7)w\xf1\xbaf\xef\xbd3N\xe2s\xfd\u009a\x00VS\x9bu\xca\xdfQS\x0e\x00\x00\x8e\xb6\xa7\xbe'\x86\xd3? \x1f[\xd5Vv\x95-\xc1\xa4\xc0\xf2J\xe9\xf7S\x8bl.\x16\xa7\x88\xfdxAr\xde\x1d\xd7\xf6\xdb_\xbd\xaa\xf5\xd3\xf8\x89?\x1bgg\xff\x8a\xa6\xddKRe\xed\xdd\xeeF\xa9\xd8Q]\x9eUY\x13_\x05\xe0\r\xb8\r˫vյ\x95\xd20\xa7+\xed\xba\xf1j\xda\x1d\x15\xa9묝\xa8\xabg:\x7f\xec\xa4N|\xd1)\xda\xd9\xc0\x12\xa2\x95q\x15\xa5qi\x00\x00\x80\xb5\x93v\x9c\b\xb95\"\xa3b\xdaMt\u0600\"\xa4\x9dQ\x01,\xa7\xb4\x00\x1a\x8b\xa0\x01\x00\x00\\\xb2\xbdnW\xe5\xe8V\xe5͍\xf2\xb3\xd74\xce\xf7Tw\x9b\x9ao\x16\x04\xb1\xe3\x9a\xeb\xba\xce\xea\xf5鵫\xe5Y\xa5\nXi\xfd\xbc4\x9fv\xfd\xb3\xef\xe0\x94\xd9Q\xa4\xc5'\xbbZ.\x9do\xbe\xb5\x7f\x0e\xc0\xb5\x10\xd2ff\x00ր\x95\xb8\x8b\xefT\x10\xdb\xd1o,\x92\xaa\xdd\xecȎ\xcf#ƴ@8\xd3\xf7\x80U֑\xc4\x01\x00ˠ}Q\xae\xefL\xcc\xed`\xfc\x00XnC\x10\xfb\xa7\xcf\xf5K}\x15aq\xe4\xc5\r\xc3ͫg\xbe\xfb^\xc5\xee\xd40ݰ\xb1c\x96N\x94\x91\xbd\x00\xf8<b\x98\xa7\xccKY\xe9岑\xe2\xdc\xf2\xad֩ȧv?\x9d\xcf\x146\x8ei{\xf3\xb7\r\xff\x04\x80eC7\xd9U\xb2\xff\xc4\xd7Eװ\x9a:\x00\x00XC>\x05\xb2\x97r\xf6\x1d]e\x8dG\xab\x139\xafෆ\a\x00,\x1b\x17S\aw\x1a\xb0\x02\x00\x00\xc0\xa5\x18\x95\xf6Q\xb5\xd6F\xb2\xb6\x91\xeb\xd4VN\xd9u'4~\xf0#T\xaepM͞\xfcob\xec\x1a{\x17\xd3$\xb4Z\x85\xb5\xd3\x01\xac\xb0\xccһwr.\xb3\xe2\xc7+\xebr\xf9\x14\xc8\x1ek\xc5n\xaa\xf1]\xff\x90r\b\xb8\xca\\\xbf\xb0kZ:&MԴr\x97E\"\x81\xb5\xb0\xd8\xe5,\x9d\x04u\xa9_ݒ\xbe\xcf&\x8b{\xc0\xebp\xa9\xbf\x80\xe9{\xc0Jk\xb2\xd4A\b\x00\xc0\xd1V7\x1f\x96Ks\xfc\xba|\xe8\xcb\x00\xb0\xb4.\xf4M$\xd1ڛ\xfduZp/-z\x1dTl\xbci\xf1\xdbUR?\xfa\xa51\xce\xfe?喗\xf4\xff\xd5i\x1e\xb1\xdf\xef\xfbG}\xb7\xb1x\b\x00^\x87S\xa5\x18\x82\x94m\xda\xc5TizC\xba\xabԮn2\x9d.\x9d\xb2\xad\xafVv\xf7\x0f~ZF\a`\x99\xd0\x13~\x954\xedϩ\x88\fF\x01\x00\x805\xe4\xa3\x14s\xb9\xb4#{\xba\x8e\xa9\xf3\xc9\xce\xf3[\xd3\x15\x80%\x94\xf5\x13\xee\x98l\v\x00\x00p\xa9rw\\\xea^\xb1vҫ\nٖ\xc2\xc9\xfb4\xba\xefI\x06\xd5p\xcdU\xf3\x7f\x9b\xa6\xa8ػ8\x92\\\xab4\x15\r\xc0\n\xb3t\x9ev&H)\xbd\xdfu\xa5+\xec$\x05\xb2wr\xfe\xf6\xc53\x00\xae\x9a\xf8\x89?\x11\xfbI\xa2iwf\x97\x16\x92I\v\xe0[\x150\xf5\x9d\x03Xa\xde\xfe\xb2\xb4\x1e,\xad;g_vn\xa7Y\xc6$m\xbc1\x1fӮtL\xdf\x03VY̮\x1f\xce\x00\x008\xba\xea\xf6#\xf6\x99\xe6\x06Q7\x05\x96\x9e\v\x8a\xea\x16c\x03IJן<r\x15\xf7\xfc\xf3\xab6V\x1d>\xf4e\xb1\xa8~N\xd3\xf8\xaaFJ\x8bk\x97V\x1f.\xe5\xf2֚\xbeA\xaee\x91'\x00\x9fG\x16\xfbi\xcam\x1d\x15B\xa7\xbcH\vwϤn.\xbb\xa5\xec\xe6\xffB\xfe\xbe\x1ff\xbe\r\xb0\xc4hm\\-\xf1\x1997\x1a.\x00\x00\x00\xd6H\x9a\x98\x93&\xcb*,*\x9b!M\x92\x8f\xcaG\x0f\xa5+\x00\xcb\xe6鯏\x99:\x05\x02\xd9\x01\x00\x00.\xdd\xf9=ib_\x85t\xee\xe4u*\xdf\xf4\x04\x83j8\x14\xbeyN\x99\x0fv\x92Z\xea\xa5|Z\xc9\x1c\xc0\xeaJqs!\xb34\x7f\xa1-\x9f&\x8cI\xc1\x8f\xa5\xe9\xdb\xfbs\x00WO\f\xafY\x82\xb3t\x17s\xc5\xd8X\x92\xb4\xb4\x97\x16\x97p\x94\xbf\xc0Js\xce\xfeN\x85pן\xf7\xa2\x97'\x90\x1do\xa0{\xe2\xeb\xec\r\xa9\xfa\xf7\x04\xc0\xear\x93\xfb\x873\x00\x00\x8e\xaeؾj\x1f\x8d\x1d\xc3\r\x00K-\xf5O\xb8O\x8e\x0f\xa46\xa7\xb7{\x85\x1d\xd9\xe2\xd6U\x10\x9e\xf9\x1d\xb1;\xf7\x9f\x16]\"1\x97\x9ac\x96\xad\xa4]\x95K)K7S\xdf(\xfd\xa3\x00>\x8f\x10\x15-\xafj\xbaΪ$s\xa9L\xfdf\xb5fѩݺK\xc7n\xfb\xe9\xa1\xd3\x15\xc0\xb2\xa2'\xfc*)\xfb:Vן\x03\x00\x00\xac\x93\x18S\xc7\xd3L1\f\x81\xec\xfd\xe4\xbcN\x93\a\xff!\rH`\t5\xf5/\xf6A.W\xb3#\x1b\x00\x00`eŨ\xa6\xbbQ\xd9\xcd\x0f\xe9\xc4}O\xd1&\xc2\xe1x\xf6;\xe2Ț\xe6.\xb5\xcfS\xb5>lػ\xc9\xf8\x05\xb0Ң%uK\xf2!\xed\xbc\x92\xa5\xbe\xb9ŐpS\x1c\x97\xde\xfc\x0f(\x8f\x80\xab\xac\v\xa7-\x1d\xa6\xa4\x96&\x88\xa6I\xe0)\xa8=\xda9\xc9\x0fXmY\xbf\x86L\xbf㙋\x8a\xde*\xdf\xce\xd2\x7f>\x1e~\a>S[}Hy\x9a[֗\x19\x00V\xd5h\xfc\xc5\xc3\x19\x00\x00GW\x1eR\xc0\xebn?\xcfω\x1d\x93\x81\xa5\x16Ә\xc0\xa7\xf5E\xa6~\x8a\xa1\xaf\xd2gWg\xa3\xce\xd9\v\xbf3\xcew~X\x85\xd2\x18d&u\x13\xc5\xc6)4\xf6cX\xfc{\x84\xd4OڏW\x00\xc0\x1b\xe8,\xbfp\x99\xbc\xf7\xca\\\x9d\xb22u\xc1\xab)߬\xec\x96_?<\x04`\x99\x11\xc8~5\xbc\xf0\x1d\xb1\xe8\n\xabm\xa5\x9a\x17\x00\x00\xc0z\x89\xddX\xcav\x15\x83S\x96\xb6\x1eT\xa5Χ\x1d\xda\x01,\xa3\xb6~\xa2\xef\x10\x1a\xfa\x94\x01\x00\x00p\t\xea\xcdG\xd4\x1d\xbfG\x1b\xb7~\x88\xda\x14\x0eM\xd8\xfd)\x8d\xd2pE\x1a\xe8u\x95\xddشs&\x8a\x00+-MJ뜢\xef\xe4\xf2Vr\xbe\xdf\xe7\xa4*\xae[\xfc\x0eઊ\U0006c577i\xf5\x98\xcc\xceS\xea+\xfbd\x19]\x9a\x14\x0e`e\xa5y\xe11*\f;\xb2\xa7\t\xe3i\a!\xef\td\xc7\xebk\x9b\xa7R5\xcd^\x1c\x16\x12\x06VYq\xcb\xf7\xd3/\b\x008\xd2\xf6\x9f\xffm\xb1Դo\xd3ȵ\xf6\x9d/~\x00\xb0\x94\xfa\x05\xf6\x92\xa1o\"-z\xdbwNzK\xdb\xc5U\bd\x7f\xe1OG\xff\xda?S9\xb7\xf3|bYɸ\x8fR\x8b~f\xdf{ri\xfepר\r\xb5e/\xf5\xe2\x9f\x01\x80\xd7\x11S\x9ee߹O\x99\x88\x9d\xa4\xf5/\x8a\xfb4\xbd\xee\xd7kt\xf2\x7f\xa1m\r\xac\x00\x02ٯ\x82\xa6yR>L\xa4\xae\x1a\xee\x00\x00\x00\xac\x91~Gv\xfbN;\xb2\xbb\x91\xe4:kX\xb2\xd3\x1b\xb0\xacBZ\xa0\x8bI\xb6\x00\x00\x00\x97e\xefx\xa3\xf1}?À\x1a\x0e\xd5|\xfeX\x1f\xbc\x9e歴\xd1\xea\xf7q\xbc\x18\xf8\x05\xb0\xba\x82W\x8a\xa1K;\xb2_\x18\rN\xcbLv\xd9tq\x01\xe0\xea\x8a\x17&dZ5\xd0\xfe\x8e}\x94\"S3\x80\x95\xe7R\xd3\xcf\xca\xe0~G\xf6ŵs\x99\x1dE\xff3\xf09\xc2ޢ\x9c\xb8\x10d\x00\x00\x00\x00\x1c\x82\xa6}J\x99\x1b\xfa\r\xd3\u0098\"\x90\x1dXji\\ \xf5Q\f\xfd\x14I\xbc\x10Ȟ\x1d\xfcBj͙\xbf\xa9\xd1N\xa5\xbc\xbbY\x9d{MM\xe6\x15K\xcbKF3eee\xff\x9dM\x9a\x80\xb8\b\xa8\xf7\xb4\x7f\x01\xbc1W\xe4\x96W\xa4\xfc\xc22\x8c\xce\xf2\xab\xd6\xf2\xad\xc9\x03\xcao\xffΔ\xa1\x01X\x01\x8c\x96^\x05a\xf6\x13\xf2U\xa6\xb6L[\x9c\x00\x00\x00\xac\x17\xefZU\xd1ڐa[\x9a\xedK͞\xea\xe9=ï\x00\x96\xcd(u\"\xbb\x82\x05)\x00\x00\xc0Z\x8a)\xec/\r\xa8\xa7\x81\xfd\x14|\x10\vŐ+\xf6\xbb\xa5y5\x9bs\xfb\xaa\xac\x01d\xcf\x04\xa7\xfd<\xealqRu\xfe\xb0\xb4y\xbbN\xdc\xfd8\x03j8T\xed\xd3\xdf\x14\xa7iNH3\x91\x9b\x1f\x93˃\x9a\xe9S\xf6>o,\x1e\x00\xb0\x92\x1aK\xe3n\xa3V9\xf3\xf2\xbb\x13\xbb\xb3\xab\xcaʭ\x13\x0f\xfe\x1c\xe5\x12p\r\xcc\xce~Lq|F]1\x97\x9f\x9d\x90sϫ\xcaw\xe5±\xe1\t\x00\xab(Z\x9b0/\x1b\xc5Q-UN\xe5\xdeD\x9d\xb72x\xfa\xee\xe1\t\xe0\xd3<\xf3\x1dq#\xad1֍Ty\xaah\xc02\xabF\xde\x1aai%1K\xd4V\xff\v>\r\xae\xday6\xb7\xb6\xd9\x1d\x8b\x87\x00\x008\u008a\x9d\xe7\x14ڳVvY\xf9\xe5\xacMӏ\x81\x01XV.\x05\x7f\xfa\\u}\\\xf5h_\xbeؗ\x8b\xe7Uus鋞;\xd0\x06h\xf7؛c>\x7f\xd1\xf2\x8fR1Dun\xac24ru'ז\xf6\x80\xe5+\xcdH\x99\xdd\x1f\xfb\xb1\\E\xf8\x1a\xb0ֲcRkِ\xb36\xf4\xb8Qmm\xe8\xd6\xdaΩ\xfd\xac8W\xfak\x94\xed\xabh\xb6T\xf9\r\x9d\xbd\xe9+\xa4G\xfe\x15\x1dg\xc0\n\xa1&p\x15\xc46\x05xX\xe5\xab_\xc5\b\x00\x00`\xcd\xc4T\x0f\xb2\x8af\x1fױ8\xc9\xcb\x1b\x16\xbf\x01X.\x1f\xfbs\xd1\x05K\xcciUV\xa5I\x17\x00\x00\x00\xeb\xc5\xf5\r\x1b\x93\xb6\xb5M\xf5!g\xed\x9d,\xda};ς\x8a=\xa7\xa6\x9b\xa8ˏI#iZE\x8d\xea\xf3\xf2\xd7\xdf\"=\xfc\x04\x1d\xc48tM{\xca^ݺ\x7f_\x95\xaa\xf6\x8b\xe1\vk\xab3<\x04\xac\xbc\xd8X\xb9\x95\x16c\xa9,\x1f\xd8P>\xa1]\x0f\\+^i\x15\x99\xcfŎ\xbb\xc0\xeaK\xc1\xec\x8b\x13\xfbN\xe5\xb0\x19\xdf\xf6=\xb4\r\xf19B\xf5\x9c\xd2\xday!\xf8E\x1f\x03\x80\xe5\xd6w!Z\xbeo\x7f\xf7sFS\a\x8c\x9d\xe7\xe3\xeb\xfb\x9f\x01\x008\xcaB\xd7\f\xe5Wj\xc78\xf9H\xfd\x14XfQ\x96\x96;\xfbt3K\xe0v#\x14\xa9\xc3Ғ\xf8\xc1\xa6\xed\xea\xa9_\x1f\x9b\xeaY\xb9&-\xa6\xbb%W\xec*\xcb\xe7\x8b\x1f\x01\xe0\xf5\xd4\xe7\x15\xad\xbd\xdcE\xaf\x18\xc6r\xdd\xc8\x0e\xcbC\xba\r˫&\x1a\xc7c\x96Ym\xab\x1d\xbf(7}X\xc7\xef\xff)\xfaU\x81\x15\xc3L\xa5\xab \xb6\x8d}\x04\xab\xfc\r\x93\x1c\x01\x00\x00\xd6J\x94\xf7\x99\xb2\x14Ğ&\xe9d\x13e\xe5}\xc3o\x00\x96IU?\xaa\xac+\xa4~\"\xd5p\x13\x00\x00`\xad\xa4]\xd8\xd3\xc8~\n@h$\xdfZ\x1b\xa7\x96\xf2\xce\u0383B3Q\xe1\xad͓\xef(\xccKi\xe45\xb9\xe9W+\xbf\x83U\xa1q4\xb4\xf5\xf3ꂽ\xafY:b\xff*\x87\x14\\C ;\xb0\xe2R\x1a\x9f\xdbW\x8a\xa2\b\x8a.W\xbeA\x00\x05p\xad\xf8\xb4\xf8\x91\xd5!\xfbx֔\x0eSR\xb4z\xa5c\xa1H`\xa5\xf5q\x1f\xa9\xe9x\x81\xaf-\xd5S\xef\xc6\xeb\x9b\xcd?$5\x99ԥ\xf1\x97f\xb8\v`\x19\xf9\xd4w\xd8g\xf7Q!\xd5\xfbҠjר\x8d#\xb9\xe9\x03\xfd3\x00\x00\x1cimcuR+\xbf\x86n\v?,\xca\x05`9\xa5~ȴ\xa8\xb5K\xe3\xd7} \xfb\xf1\xbe\xbe\x1a\xf2\xbe\xd2z \xe6\x9f\xf8-\xb1\xda\xff1\x15ݞ\xe5!\x9b\xf6_6\x93\xf2=zA\x00|~y\xabP8\xabw\x8c\x14ڑb[\xc8u\xb95\xa7\xd3\x1c\xe5\x91\xd4\xd8a\x19X\xd8\xd8T\xf9\xe6\x9fa\xce\r\xb0\x82\xa8+\\\x05\xbe\xaf\xf9u}\x00\x17\x00\x00\xc0\xfa锥Fe\xaai\x86N\xd1m(\xbf\xfd\xefѠ\x04\x96мyl\xd1I\xd4\xf5\xbdڋ\x9b\x00\x00\x00\xeb$\xed>\x91\x1a7i\x85z\x97\xfa}[\xbb\xd7X\xd5(m\x9b\xd6*\x96c\xbb>-\xed\x05\x85\xa9\x1d7}\xabt\xd7?\xa1\xfd\x83#õ/\xdaG:\xb3\xf7WM\xbfAX\n\xac\xfb\xcc\b\x1b\x00+)\xf5ͥ\xf4\xaeBU\xbe\xa7\xac\xf8\xf2\xfe6\x80\xab/K\xf5EK\x84V;\xb4\x82\u05ce\xbe\xec\xcd\xfa\x9dF\x00\xac2K\xef!ոS_\xfa\xa2\x1d\x19Y!\x16o\xa0n>ne\xc3X>\xe6ri\xd1<\x00K\xab\x8f\xf5\xeb\xb3\xfbt\xe2\xeco;B\xabV\x1bҽ\xff\x17\xfd\x84\x00\x80#-|\xec;\xa2\x0f\xb5\xd2\xe0A\x18\xca1O\xff\x05\xb0\xdc\\\xae\x18--\xe7A\xa1Ku\xd3;\xbe\xda\xe5Ǉ\a\xaeГ_\x17ù\xffG\x93ʲ\x0e7\xb5\x8f=\xfb\xef\xb4#U\x87\xe7\xb7,\x9e\x01\x80\xd73\n\xf2\xa5\xe5Q\xb2\xa3\xb3s\xd7Z^\x95\xea!\xcdb3\x89\xb0\xa3\xba,T\xbei\x87\xb64\xb0\xa2\x1819`\xf5\v\x7f(\xa6l\xb5\xef\xa1\xec'\x87\x00\x00\x00\xac\x9941'ՃB\xa3\x18:\xd5~k\xf8\x01\xc0\xb2\x89\xdd\xf3\xf6\x91&\xddur\xca\x177\x01\x00\x00\xd6I\xaa\n\xa5\x80\xdf4L\xd6\xcfJM\x81H\xce\xeaI^\xa1\xf5\xca\xfciU\xcdH\xd5\xf6\xb6\xf2\xb7\x9ew\xfe\xd6\xefe@\rG\xc7G\xfe똅\xd3\xeacgBj\xab\xb7\xca}\xa6\xccYݾ%P\x02Xe.M8M'\xc1[\xb3~\xaaf\xbc/\x7f\x1b\v\xad\x00\xd7J\xee\xd2\xc2Gâ\xf7}\x1d2\xb3tY\xd8)\xe5/\xb0\xd2,\xbdG+{{\xd1\xea\xdc\xfd\x8e\xec\x14\xbfx}\x99\xceZY\x91[\xf90\xf45\x00XZ.X^\xdf/\x82\x99\xba_\xd2Nr\xe9\xefN]vbx\x02\x00\x80\xa3\xab\xad?\xa0B\x8d\x95cN1\x8d\x1d\xf4\x05ZZ\xa0\x0f\xc0\xd2\xf2\x8b9~iO\xce,\x96\x8b\xb1n\xfb\xdb\x17\xf7\xf6\xf7\xaf\xc4\xfc\xf4\x9f\x88\xe1\xfc\x8f\xaa\x9c\x9dS\x91\x02\xe4S\xbe\xe1\xf7\xfb\xacC\x9d]7\x93Ń\x00\xf0:\xea\xaeS۶\xea\xdaڮ\xe6\xcaG\x95\x94\x8e\xb4 \x86\x9f\xab\x1a\xe7*\xb7~\xfb\xe2a\x00+\x89H\xeb\x03V5\x1fT\xd6\xefl\x92\x0e\xfex\x01\x00\xc0\x1a\xf2Q\xb1M\xf5\xa0\xb6\xaf\x0eu\xd9\xf6\xe2>\x80\xa5\x93\x85\xb9\xe4\xeaő:\xb6\x01\x00\x00\xd6L\xec\x83\xd8]\xdf\xceY\xdcp\x8aiBj\x18[sg+\x8d\xad\xc9\xdfx\x9fFoy%\r\xcf\x03G\xcb\xfe\x87UX\x9d\xde{\xaf\xae\x1f\xb9Ha4\xd9\xf0:W\xfd#\x00V\x93\xf3\xf5\xb0\xfeJ\xae\xe03\xc5\xf1\xe6\xe2\a\x00\xd7D\xe6Z\xab7\xe6\xea\xfa\xbadP\xb4\x128\x95\xc1\x04*\x02+.\x15\xbe݅E,\xac\x89\x98\xb5\x96\xfe\x997\x84\xd7q\xf6O\xc7\"\xda;\x92\x02_=e\x03\xb0\xec\\JϱS\x8ag\x97+\xac\xcag\xb5\xbf\x94ċ\x03\xda\xf1\x12\x00\x80\xab\xa8\xeb>\xa2\"5[Bg\x85\xd7О\xe9c \x00,-\xab\x8c:o\xe98uMjl\x1fi\xc7\xf4\\\xa3\xf1\x97\f\x0f\\\xbe\xe6\xa5\x1f\x92\xef*\xe5\xb2zo\x1f \x7f^1U~u\x83\x9d\x1f\x93\xf2\xd7\x16\x0f\x02\xc0\xebH\xdd`>x\xe5֎\xceӤ\x05;R\x15d\xaf\xb1\xa3\x1b\xcb_\xff+\xa5{\xfeJ\xcaT\x00\xac(FL\x0eXU}\xbc\xef\x8cL\xbb\x16\xf6\x93\x1c\x01\x00\x00\xd6L?N\x9bvdwQ\xaep\xf2#\x02فe\xb4\xff\xfc\xb7\xc6ҍ,-\xa7\x95\x97\xed\x88\xc5\xf0\v\x00\x00\xc0\x1a\xb9\xb03Z?\x00\x9f\xba}3\xbb\x1c\xc9\xf9cRq\xbd\xea\x1b\xbeJ\xc5\xfd\x8f1\x90\x86\xa3\xa9yIY\x8a\x89\xb0w6\xfa\x91|\xdaz!\xed\xcc\xde\xd9͌\xf1\v`\xa5\xf9=\xa9ݲ\xc3[z\xafU\x96o\x1e~\x00p-\xb8hem\x1c&\x7f\xa7\xc9X1\xed\xb8\x9b\x16Cb\"8\xb0ڢb\x1a$\xfb\xa4\xae\x1f3\x03>\xdb\xee\xfc?\xaa\x88\x1bV.\xd4VN\xb4VF\\\b\x18\x02\xb0\x94\xd2\\\xd1\xc4g\x8b:_\x9a3jM1\xe6I\x00\x00\x96A\xec\x9eS\xbf\b_\xd7\xf5e\xd9\"05\r,\x00XV1\x8d\x05\xc66\xad\xb5\xa4L#K\xdbg\xac\xca:Q~\xe7\xf7_Q/Ex\xec\x9dqz\xfeE;)\xec?\xb3P\xcc\x1a\xd5i\xe8\xb1(\xed:W\xd4L\xae8?<\r\x00\x9f\xcbZ\xcci\xd9\xfdż\x85\xd4~\xb6|j\xd6\xd8W\xbcE\x93\xad/Qq\xe7?\xa57\x15Xq\x04\xb2\x1f\xb0\xb69e\xb51;I\xab\xe6\x02\x00\x00\xac\xa54Q'\r\xceZU\xb3\xc8U\x94\xd3\xe1>\x80eR\xb7ϨpiUV\xbb軇R7\x12\x00\x00\xc0zq)\x80\xfd\xc2n\xec&5u\\\xdaY)\xb7vNy\\\xe5\xfd\xff\x86\x814\x1cas\xa5\raC̕\xf9\xb4\x00\x83_L^iӄ\xb4\xe1\x11\x00\xab)mi\xd0m[\xc1\xe5\xe4\x8aJ\x99{\xd3\xf0\x03\x80\xab-|\xe2\xdb\xd3\x06\xec\x8b\xf3ŶG\vi\xf1W\x00+\uf4c1\xec\xfd\x82ϖ\x0f8\xd2>>\u05fc\xfa\xb0Uצ\xf6\x82\xd4v\x95\x16?\xe1=\x01\x96ݧz\x0fM?W\"\xcag̓\x00\x00,\x81\xb0o\x1fQ]\xd7Y\x13\xe6\xc2\x02K\x9fQ\xb2\x01X2!\x8d\x05\xcaҴ57\xd3\xc2i1k\xed\xa4\x1c~\xbd<\xe7>\xfa\xceX\x9f\xff\x05eqK]\x9b\xea\xbb\xf6\x1f\x9e\x15v\x94\n\xf6\x1f]\xfbW\xd4\xe8\xb4]3t\x0e\xe0\xf3\xb0\xecHi\xd1\xfd\xfe\xdb۷\xe5#aK\x9b\x9b\x0f\xca\xdf\xf2\xcb\xfaG\x00\xac6Ǯ\xe1\a\xec\xd1iLK\v\x05\xab\xf9\x85\xacR\x9e2V\x00\x00\x80\xb5r\xbd䟓jio\xbc\xa1\x8d\xb7\xef\xd2;\x05,\xa1\xdd\x0f\xdc\x187\xbbW\xfb\xb4\x1c]\xaeڍ5\x8a\xa9\a\t\x00\x00`\x85dSŶ\x92\x8b\xf3a\xdd\x1ek\xbetѾ\xbc]\xe7\xaa\xc2L\xa3\xb0e\xf7Nر\xa7ڟ\xd2\xfe\xe6\x8d:\xfe\x96\x97i\xe7\xe0h\xfb\xc5\a\xa2\x1ak\x9b\x03XK\xf3rC\xe3ݴ\x9b\xc1\xab:\xb7\x19u\xec\xad\xec\a\v\\3\x8f\xbf=j~ZMwJ\xdeϔ\xe5)@ђ`\xb0\xfaeڥ\x9d`E`e\xa5 v7\xa9\xd5\xedN\x94e\xadB\x1e\xb5{\xd3\x17i\xfb\xb6\x9f\xa7\x1c\xc6g\xd8{|;Nf;\xf2\xddI+\"f\xaa\xb3\xa0Q\xe05\x01\x96VSH\x93]\xc5\"J;\xc7\xe4\xf2s\xaa]\xa6\xf2\x9d-\t\x1b\x00p\xb4\xbd\xf0'b\xfd\xd2_\xb5\xf6ˮ\xb2\xf6\x984\x9fJ\xe3\x175\u05f6\xc6\xfd\xa2K\x00\x96\x93\xd3\xcc>\xc7Y)ל\x93\xfcD;\x1b7k\xeb\xcdO^^\xfd\xf4\x89\xb7E\x9dy\xbf\x14O\xa8\x197*j\xe6\x0f\x02\xb8L\xa3\\{;A㍹2wBqvJ\xf5\xc6\r\x1a\xbd\xe5\x15\xda\xcf\xc0\x9a`\x94\xf4\x00\x85g\xbf'\xaak\xa4v\x18\x84V\xb7\xf8\x01\x00\x00`\x8d\x84X-&\xe5\xa5\x1d\xd9=mK`Y\xb9\x98&٦3\xdf\xefD\x9a\xa5\xddH\x01\x00\x00V\x8dۑ\U000f58b5]BgG,\x15\xb2\xa9Z?V\xddz\x8d4\xb1\x87R`\xfb\xb3\x9a\x17\xa7\xe4O\xbe\x9b v,\x87\xd6\xda\xe6\x00֖OeW\xb6cg\xb9\xf2\xd1u\x8b\x9b\x00\xae\x8d~'\xb3Ǝ\xd8\xf7\xa9\xa5\x89\xa3} {?\x7f\x80E\xf0\x81u\x926\x16\xf1~k\xb8\x02>%\xb4VF\xa4\x137\xb7\xefRY\xda&\x0f\xc0\xf2ri@5W\x1aZu\xb9\x9d\xc7Ҫ}\x17v\xb4\x05\x00\xe0\xe8j\xeaW\xfa\x1d\x9bS\xb8C?\xdf\xcf\xdbI\xcc䩟\x02K/M\xdd\xed\xa5\xaajJ\xd7\xf9\xe5\x8d\x13TO\xff\xeeX\xef\xecZEw˪\xbcg\x14\xe6\xf3\xe1\x17\x00\xb8\f;\xb96&\x95\xb2\xfa\xee~1\xe0f\xba\xa5ѱo\x19~\x04\xb0\x0e\bd?@\xb3\xf9{\x15\xdb\xd6\x1at\xb9U\xf8\xf2OU\x00\x01\x00\x00\xd6HH;\x19\x86\xb4\xe3\xd3D\xd131\x0fXV>4\x8b\xcel\xa5\x89\x16\xce\xfeb\xa1.\x00\x00\xb0z\xda\xda\xea<y'7\x9aX3f\xa2y\x17\xd5\xc4EБO\x1d\xbca\xac.\xeej\xa7\xb4\x1a\xd1\r\xbfF\xf9\x83?K\x10;\x96B\xd3\xed\x0eg\x00֑Om\xf8bf\x85W\xa6\xd1\xe4\xed\xc3]\x00\xd7Dw^A3\xb94\xf9\xbbguʸ\x98?\xa0H\x7f9\xb0\xeaR\xf0z\xbf\x88\x85}\xa75,r\x7f\xcb\xf0\v\xf0i\x1a\xab\xa6\xb9\x91\xa2\xd2\xe2'c9\xb1\x9b\x1d\xb0\xd4|-\x05Kө\xfa\x97[z\x0e[\xd6\x1e\xdbX\xfc\x06\x00\xc0\x11V͞\xb2\xf2+X\xd9U\xa8ss+\xd3R\f\x84\xd5O}Z\xa0\x0f\xc0\xf2Z\xf4M\xf4kl\xf6\xebR\xe4\xca\xc7\xf7\xa5\x93K\x12\x9f\xff\x96X\x9d\xf9{\x8a՞\xe5\x0f\xa3~\n\xe1(\xcd\v\x06\x80\xcb\xe5\xceK\xcd]\xf6\xfd\x94*\x97ɝ\xf8mҝ\xdf\xcd\x1c\x1c`\x8d\x10j}\x80\xba\xfa\xf1E\x85/\xd5\xd2\\\xd9\xdf\x03\x00\x00X;\xaeR?)ύ\x15\xfd\r\xc3M\x00\xcb&S\x97\xe6\xda)\xa4\xd9v\xe9:]\x00\x00\x00\xac\x98\xbe\xa6\x93\x16&m3\xf9\xb4\x0fZ\xd6j\xe4\xf6\x94\x85]\xe5ݾ\xeax^\xf5\xf8\x1e\x8d\xae\xff6\x8d\xee\xf9\xd7\f\xa0a)̟\xfeuVyO\x01\x11\x00֕O;(Y\xa9\xd5\x16\x9d\xf2;\x7f\x8a\xf2\v\xb8\x86\xba\uef22\xe6r>\xf4\x13Fc\x9a\xdc\x19R0{\x9a\xe4\xc9\xf4\f`\x95\xa5\xe5`\xd3v\xbc.\xed̛\x02\xd9\xed(\xb2\xbb\x87_\x81\x85\xee\xa9\xdf\x1b}g\xefJ\x1aGMEC̕-V\x15\x06\xb0\xac\xb2`i\xb9X\x04\t\xf9Vm\nj\xcfo\\\xfc\x06\x00\xc0\x11\xd6U\xcf(O;5kc\xd8\xe7\xa2\xeb\xc7\xcc\x16\xb1\x10\x00\x96V\xf4\xca\xfbn\xc8TO\xb5O7\xd2\xe8\xce\x1f\xbe\xb4\x94}\xfa\xcf\xc4p\xf6\x1fj{\xee4*g\n\xf9)Ŵ\x06[>Y\xfc\x0e\x00\x97\xa3L\xe3%\xcf)Zecr\xeckT\xdc\xf17\xa8u\x00k\x86\x91҃Խ\xb0\xf8\x13-\xfa^\xc9E\xe7$\x00\x00\xc0\x9aI\x13\xf4\xd2nOv\xa2,\x7f\xf3p\x17\xc0R\xf9\xc8\xef\x89yX\xec\x00\x12\xfbE\xba\xd2l*vd\a\x00\x00\xab'+7\xd5ե\x9a\xbdN\xbe\xa9\x95\xa7\xdd&҈~k횺\xd0މ7k\xf2\xd6']y\xc7\x0f0\x80\x86\xe51\x7f\\>c\xc7\x14`\x9d-vR\xb2\xe2l<\x1e\xee\x00\xb8VB\xbf\x90D\xd7\a\xb1\xa79\x03\xfdڐ}\x7f\xb9]\xf7\xdbt\x02Xe)\xcd_H\xff\xc1\xbe\xb2뿗\xb6$>C\u05fc_ez+bֿ#ri\xd7\xcb\xfe'\x00\xcb*\x05\xfd\x85ª\x80V\xe7\xb3\xfa^m\xe9\xdae\x8f\f?\x02\x00pt\xe5\xe1t\x1f\xf6\xe0\xdch\x11\xbc\x9e\xc6\xc8|\x94K\v\xf2\x01XZ\x96\x8a\xed3\xd8I\x9a\xc7+5\x97\x11|\xbe\xff\xda\xff%\xb7\x7f\xda\xf2\x84\xeb-\xb3\x98\xf7\x8b\xf5\xb9pl\xf8\x15\x00._]\xd4r\xc7\x1eQv\xef\xbfH\x99\x15\x805CK\xe3\x00\x15m\xd3W\xf6\x94U\x8b\x86\x1c\xd9*\x00\x00XWV\x17\n\xbe\xd5\xf4\xc1\x7fF\x8d\bXBU\xf5x\x9afןGg\xcdƴ+{`&\x15\x00\x00XA\xad\xb7zO\xa1\xc2狾\xdd\xc6\xda2M!\xa5E\xb9\x8e\x7f\x95N<\xf0~\xda4X:E}v\xb1\xc8\x1c\x80\xb5\xe5b\xa9\xb4ѧ\xca;\x177\x00\\S\x8b V-\x82\xd8c\x9a\x92\x91&\x8dvv\xb0\xd0\f\xb0\x16\xfa\x85+\xacm\xd9g\x02\xc0g꺏\xa9\xe8\xb7\xc4\x1b\xdaln\xd6\x17\x13\x00\x96W\xf4\x96\xa6-\xcf\xf71\xb7\xf4\xec\x15\x8b\x99\xca\xfb~\x84>E\x00\xc0\xd1\xf6⟎#\xd5v\x92\xfa+Bگ\xc6ʳtn\xf5\xd3.mx\x01`\xa9\xa5 \xf6\xb4\x85\xba\xcf\xd5\x15\x1b\xc3͋3\xffȝ1\x9e=-\xd7M\xa5\xe2\xac\xd4\xd6\xca\xda-\xcb\x1f,oh\xf7\x86\xa7\x00\xe0ҝm\xad\xa9|\xfc&\x89y8\xc0\xda\"\x90\xfd\x804/\xff\xa1\x98\xb5V9K\x83\vٞ\xa2U\xfeX\x91\f\x00\x00\xac'k_\xfaV\xc13)\x0fXVm\xfd\x8c}.\x16\xe7\x8a\xca\xed\xdc\xda6\x04\xb2\x03\x00\x80\x15ԅ=\xb9\xac\x95ʴm\x9eW\x95mk\xbe\xf5n靏;}я2x\x86\xe5\xf3\xdc\xef\x8fYcuybf\x80\xb5\xe6\xc3F\xda\xe4Sy\xf1\xf6\xe1\x0e\x80k\xc5{/\xa7t\xe4\x8a\xfdV\xbb\xe9\xa6\x15̾U\xec'\x88\x03X]}\x87z\xff\x95\x02\x1a\xd3ne\xc0g\v\xcdi{G\x82B\x97\xc6QK+\x1fv\xecf\xb1\xf8\x11\xc0R\xea\xd7\x03\x0f\xad\x9dY\x9a\xee2\xb9\xb1\xa5k\x00\x00\x8e\xb8\xb0\xf7ae\xe9$Z\xbd4\xce\xe55\xb6\xef \x15v\x1dF\xfd3\x00\x96S\x90\xb3\xfa\xa9\xa5\xe7td#\xc5\xfc\xe2\x17\xa7\x98\x7f\xe2\xeb\xe2x\xe7\x13\x9a\x86\x89\xfds\x9b\n\xae\xb2:\xae\xb7#\xb5[窳\x14,\x05\x00\x97'۸G\xe5\xfd/1\x0f\aXcDZ\x1f\x90y\xfd\x11\xf9\xd6\x1an\xa9n\x96f\x88\xb9tr\xf1\x95>\x00\x00\x80U\xd1w\x82)*\x12\xc8\x0e,\xad؝I\x9f\xfd\x84;\xb7\x18\xba\x02\x00\x00XI\xd9$\x05\x15U\x8aՎ\xaa\xae\x936o\xd7\xf8\x8e\xaf\x18~\x05\x96O\xa8\x1f\x93\x9a\xfc\x93\x9b\xfb\x01XSq,g\xcdy\x9f\xdd?\xdc\x00p\xadxK|Ύ\x98\"\x9a\xe4\x17\x81\xac\xe94\xcd\x1fp)\xc0\t\xc0Z`:&\xdeH\xbffp\xa7.\xb4\xf6\x9a\x8c\xac\xa8H\v\x1e0\x0e\x03,\xb3~vD\xbfx\x89\xa5\xe5`uA֦\x00\x00,\x81j\xfe\xc2\"\xd4!\x8d\x8d)\x05\xb3\xa7\x98\x874ff\x9f,\xb4\x04,5\xe7\x9c\xd5O-m\xa7:\xaawr\xfe\xe2b\x9a\xeag\xbf9\x9ey\xe5\x1fۉ\xfdg\x8cZ5E\xa5yj\xb7\xe6iq\x8b=\xab\xeb\xee+\x1b\x8d\x17\x0f\x03\xc0e\xd8\xda\xfa\xd5\xc3\x19\x80uE \xfb\x01\xd9<\xff\xb3\xca\xc7g\xedO\xf4\x06\x85\xc6\xc9\xc7\xd3\xeaDC\x0e\x00\x00\xac\x9f\xb40k\x133\x9d\x1f\xdd;\xdc\x01\xb0L\xe2\xf3\x7f<\x16E\xa1֧\x0e\xedM\xe5M\x9al\xbb\xab:\x9b\x0eO\x00\x00\x00,\x11\x97\xf7_u,4\xf7\xa5*k\xb0\xb4\xc5\xdc\ue9c99N\xaaƊm\xad\xdd\xedcrw\xff~\x8d\x1ez\xdc\xf9\xed?O\xc8\x01\x96Vw\xfeC\xf6j\xdb;\xee\x99H\x02\xac\xb5j\xae\x97\xbc\x97\xbf\xf3\xbb(Ӏk\xe9\xa9o\x88}\xacz\xd3\xc9w\x9d2E;,\x19\xa6{\xad\x9dE\xcag`\xa5\x8d2\xf9\xfa\x845@w\xacNު\x9a\xbci\xf8\x01X\b\xcf\xfe\u07b8\x91ʄ\xa2\xb2\xf7\xe5\xb8\xda:,f\xee\xf9\xed\xfew\x00˩\xf5\x85\xb2\xd4\x0f3?k\xe9\xdb\xebLE\x9a\x06\x00\x1c}\x93\xd9\xfb\xa4h\x95\xd1Qٯ\xca\xe2\xdbڮ3\xa9\x19+\xcfN\rO\x01XF\xbbYm\xd5\xd2hi:\xa8\xf2\xad\xa6o\xfa\x99/8N\x10\x9f\xfd\x83Q\xaf\xfe5\xddҷY\xadn\xdb\xce5\xaa+M[\xfbG\xbbE@\xbcR\xbd\xb7\xeeWg\x03\x80\xd7u\xbe\xb4<\xa2\xac\xa4\xd9\xc4\xea\x17s\xabgtz͵:\x95mH\xd7\xfd&\xe9\xee\xff\x95qK`\xcd\x11\xc8~@bg\xad\xb8\x10\xad-\x17\xfbU\x8c\xd2\x01\x00\x00\xb0\x96\xb2B\x9d\x0f\x1a\x95\xb7\x0f7\x00,\x93\xa6}\xadoۤ\x16M\xec\x97XM\x1d\xdb\xf6\x95\x02\xdb\x01\x00\x00\x96L\xebw\xa5r\xa2\xb2l\x94\x85\xf3\x1a\xb5S\xe5\xc5\xf5ҨP3\x97\xf6\xfc\xbe\xdac\xbfT[o=\xe5\xca[\x184\xc3\xf2\v\xb1\xb2\xca{\xdaF\x05\xc0Z+w\xb51&x\x0e\xb8\xd6b\xbb?\x9c\x01XG1\xa4z\xb8\x1d.\x932\xaf,;\xb6\xf8\x01\x18\xd4\xcd)\xc5\xe8\xec5ɬ\xed\xb6'\x97\xc6_Қ\xc2>E\n\x00XZ\x9d\xa5k\xd7\xf4y\x7fJ\xd4\xd3\xc9}\x8b\xfb\x00\x00\x00\xc0!\x18ei3δ\xd8{\ua7f0v\xe7\x17\x10\x9e\xfd\xce؝\xf9\x0f*\xe3\r\xea\x16k\xc4\x03\xc0e\xd9H\xfd\\{w\xd8\xc9\x19;9)U\x8d&.\xd7\xe6\x89_*\xdd\xfbC\xcc\xc7\x01@ \xfb\x81\xe9\xbcb\xaa\xe7\xb9V.U\xfaR0\xfb\x17\xae\xf7\x01\x00\x00\xac\x9el\xd4\a\xb2O\xc6o\x1fn\x00X&M\xf5\x8c2k\xdc\xf8P*\xc4\xdaZ\x8di\"U)\xe7\xed\x1c\x00\x00`ɴގ挴\x7f\xa3\x8ap\xab\xd5mv\xa4\x9d]\xd5{\xc7\xd5m\a\xb5\xd7\xff~\x15\x0f\xff4\x03fX\t\xb3\xe7\xfe`T\x9c\xf7\x81\xec\x8cO\x00k\xae\xd8\xd3\xe6\xf4ˆ\v\x00\xd7JW\x9d\x1e\xce\x00\xac\xa3\x18\xad:\x9e\xa2\x92S\v\xd3g\x1a\x95\xd6\x06\x05>M={V\xa1ˬ\xc0\x18\xdbծ\xb24\xfe\xd2\xe6RF ;\xb0\xcc\xfa\xf5\x04}cǤ/\a66\u07b5\xf8\x01\x00\x00\x008\x04ykm̶\xb0\xb6g\xa6\x90vR\xff\x02\xe6\xa7\x7f@\xd9\xeecv\xe64+'\x8b\x9b\x00p\x19\xb2\xfd\xeb\xa5\xf2YE\xbfim\xe4\xb3R\x9dkc\xeb>\x8d\xee\xf9Q\xe6\xe4\x00\xe8\x11\xc8~@\\Z9$\xa6\xa3\xb3L7屮\x1f\xa4\x02\x00\x00X;i΅2e\xb7|\x1f\rO`\tu\xd5\xd3\xcab\n|\x99\xa4)wִ\xb1\xa4\x1cF\xf2D\xc2\x00\x00\x80%4\x8e\x85\xf2j\xdb\xceNY[e.\xed\x1f\xd797Wu\xa2\xd0\xf8msw쮿J\xbb\x05+\xa3\x9e\xfdg\xab\xb7/vcw\xb1\xaf\xcd\x03XS)>*\xbf\xe5\xafQ\xc6\x01\xd7XW\xbd:\x9c\x01XG.\xf5\xab\xfbT\x1fOEp\xa6\"\xbf\xa7\xbf\x0f\\\x10\xda\x17\xad\xcdV\xda+b\x87\xc9\xecU\x89ad'M\x7f\r`I\x85\x94\xefW\x96\xb63\xcd:\xaf\xe2V\xdab\x00\x80\xa3m\xff\xe3\x7f\x8a\t@\xc0\n\xf3)\x90=m\xad\x1e\xbdj\x1d\x1b\uefbe\xfd'\xde\x1c\xa7ڗK\x8f\xc7\xd3*\xe3\xf1\xe1\x17\x00\xb8\fY#WX\xf38Hm\f\x8aǶ\xa57=F\x1b\x19\xc0'\x11\xc8~\x00ڧ\xbe!\xba6\xb3?L\xa7\xe8:9\xb7\xf8c%\xb7\x05\x00\x00k)\x04\x05\xbf5\\\x00X6\xbe}\xcd\xda2Mߙ\x9d\x16\xa6\xb8\xc0\xa5\x80v\x00\x00\x80eS\xdf(\x8dj\xab̴\xeatZՉ\xf3ں\xf5\x1b\xb4\xf5\xe0\xd3Tn\xb0r\xb2\xfa\x89>\x10\"\rN\f\xf1\xec\x00\xd6T\xe57\x863\x00ג\xef^\x1b\xce\x00\xac#\x97v\u05f6\xb6g\xaa\x90\xa7e\xa5\xfc\x9d\xdfO\xbb\x13\x9f\xa1\b\xe7\xe5\\f/\x87\xb3\xf7%ݱ\xef\xe8\xed}\xa1\x01\a,\xb3,\x8d\xa7FKǾU\xad\xb4\xa0&\x00\x00G\x9b\xab?8\x9c\x01XE.ϥ\xdcڞ\xa3LY\xf1\xe6\xe1\xee\xe7j\x1e\xfb\xeaX\x9c\xfb\x90\xfd\x03\x99b\xb1\xdd\xf7e\x94M\xbd\xf8\x11\x00.C;~M\x9a\x9fPlv\x956ew_t\x8a\xfeQ\x00\x9f\x81@\xf6\x03\xd0\xd4?\xbf\b\xf2H\x81\x1d.(\xa6?V;w\x81\x05\xcb\x00\x00\xc0\x1ar\xad|~\xd3p\x01`\xd9\x14\xb1JۂXZN\xddә\xb5u\xac\x9d\xe3\xeb\xc5vn\x00\x00\x00\xcb&{^\xedl\xacY\xbe\xa9\xeeƷk\xf4H\xe7\xfc\x1d\x7f\x87\xc12\xac\xa4\xb2ٗ\xb7:|\xe8#\xd9ýu泷\fg\x00\xae\xa5\\\xe7\x873\x00k)FE\xbf\x983Ԧ`e\xe0\xd3=\xf7m\xb1ti\xb1\x83\xd4V\xab\x17\x8b\x90\x854\xbf\xacQHs\xce\x00,\xad\xb4\xf9\x91bZ\xa4b_\xa1\xb8y\xb8\v\x00\xc0ѕ7\x1f\x1d\xce\x00\xac\xa2\x18Ҝ\xbfNm\x1e5\x9d\xfcW\x8b\x9b\x9fe\xf6\x91\xdf\x1a\xf3\xf3\xffVE\xe7\xd46\xb9\xf6\xba\xb1\xb2x\x83Ԟ\x1a\x9e\x00\x80K\x17\xfdX\xb3n_M^ʟ\xfc]\xc3]\x00\xf8\x14z\xc2\x0f@\xdb~\xd8r\xdc\\i\xb9\xdcn\xb1\xaer?@\x05\x00\x00\xb0\x96|\xab\xd1\xf8\xf6\xe1\x02\xc02\x89\x1f\xfb}\xb1\xf4\x17\x9a\x89sk\xd9l\xdaM'\xe5\x95}\x8f\x86\xfb\x00\x00\x00ˣ\xd3-jn\xe8\xe4o\xfa\xf5*\xef}/\x91\xbdXYቯ\x8fe\x9b\xd9K?R\x97\x86'\bd\a\xd6\xdah\xf2\xce\xe1\f\xc0\xb5\xe4\xfcl8\x03\xb0\x96B\nbo\x95vێ\x04\xb2\xe3\xb3ĝ\xf7\xabP\u07bf'\x9d\xf6\xd2kb\xe7\xf6\xe1\xe7ֆ\xe3}\x01\x96\x99W\xb0\xcfR\xa1\xb0\xcfɝ\x8b\x9b\x00\x00\x1ca\xbe}n8\x03\xb0\x8aZE\xd5q\xae:\xab\xe5\xef\xfc\x9e\xcf\x1d0|\xe2\x1bc\xb6\xf7\xef䲴\xd1ͦbȔ\xd9\xf3\xceU\xc3\x03\x00py\x8a\xd6˕\xb7\xc8\xdd\xf4[\xa4[\xfe.\x13\x16\x00|\x0e\x02\xd9\x0f@\x9b\xfa\"?\xb9\xc3IT$\x88\x1d\x00\x00\xac\xb3<\xaa,O\x0e\x17\x00\x96IS\xbdb-\x9b\v\x13\xa6j;\x1f\xa7&N\xbf1\xbb\xebh>\x02\x00\x80\xe5\xe36\xef\xd0\xe4\xa1\xf3nt\xe7\xdfd\x90\f+m>\xff\xa0ԥ\xfa{\xa6~\xa3\x854f\x01`m\xb9{\xff\x12\x99\x00p\x18\xe8>\x03\xd6Z\x88\xed\xe2\xdby\xb9\x8c\f\x01\x9f\xa9\x9e\xbd`\xed5{/BPZ\xf2\xc0\xa5\xe0\xf54\xfe\x92^\x95X\xf4\xcf\x00XR\x96\xae\xe5,\x1d[\xb2\x1e\x95\xd7\x0f7\x01\x008\xbaB\xd8\x1b\xce\x00\xac\"?*\x14\xf3\xa8\x90\xf5\x03\x86\x9f#\x9e\xfd\xbb*\xfdL\xa1\x18\xa9q3\x15ٖ&Y\xda\xe4\xe6\xb4\xfd\xc3\x1b\xc3S\x00p\x19\x9a}\x8d7\xefQq\xc7\x0f1N\t\xe0u1rr\xa5^\xf8\xa3q\xda|\x89\xaa\xcc*nY\xa3Q\xed\xe4\xe7\xb9U\xe4\xa6j\x8azx\b\x00\x00`}\xb4\xa1\x95\xbf\x9dF(\xb0\x8c\xca\xfd\x9f\x94\x9a`m\x9b\xb1\x14\xc6\xca\xe29)߱\x84m\u05ee\x19\x9e\x02\x00\x00\xb8\x86\xe6]\xdaV]\xf2Q\xa1\x98\xab\xce\xedp\xf3\xc5o:\xaefd\xf7}i\xcflX\x9debG\xa3*\x97\xea\xed/\x95\xde5s\xfe\xa1\x9f\xa5m\x82\xb50j\x9e\xb0:\xfb\xbe\xba\xae\x90+R\x10ąt\x02`\x15\xcd\xf3VJ\vk\xa7\xf6z^\xf5\x01si!\v\x8d\xe7\xdau\x9b\xc3S\x00\xae\xa5\xf6\xc9\xdf\x17\xfb\x80D\x00k\xcb\x15\xa5\xfc|\xac,봿Iy\x8c\xcf4Ꞓ\xba\x99T\x04\x15i\x11\xb2\xd6\xdamycu\xb8\xb1&\xe9>\x80\xe5UZ\x9a\xeeN)v\x96\xc4\xefa1M\x00\xc0\x11\xf7\xdc\xef\x8aE\xc6BJ\xc0*\xcb\\\xa5\xac\xbdQ\xb1\xecw\xeb\xfcL?\xe7b\xbf\xb1M\x13\xe5;\xa7B\xb9\x14\xce\xd9a\xd5Xg\xf7\xfb\x81y\x00x\x03i\xceNs\x8bv'\xb5\xaai\xad\x90\xe6$tV\xaf\xe8\x8e)TQ/\x1e\xfb\x06\xe9\xa1\x7fG\xbb\x18\xc0\x1b\"\x90\xfdJ5\xaf\xdaǞ\x9c\xb3J[_׳?Ҵ3\xbb\x1d.\xf5N\x02\x00\x00\xac\x99\x18'\xc3\x19\x00\x00\x00\x00\\\xa1͓\xfdnF\xf3\xb6RSOU\x84;T\xe6\xb7\xf7\x13\xbfC\xf6\x92\n\xbb\xefÎ\xa2;\xa7f|F\xf3\x13\xc7\xe4\x8e}\xb3\xca\a\xff=\x83cX+1\x05\xb4\xa6]\xfd\x9c}\xa7\xf1\n\x02\xe9\x80\xd5\x16\xad\x98K\xe9>\x8dIڷK\xdf\xe9v\n\x9c(o\xea\xcf\x01\\[m{z8\x03\xb0\xb6R\xf9<p\xee\xf8p\x06\x00Xy\xb1\xed\xa7\x8cvb\x9e\x04\x00\xe0\xe8\xab\xeaSVh1\x84\x06\xac\xb4Y\xa6ƿ$\xd7}\xd9pca\xf7\x89;\xa3\xba\xbb\x86+\x00\xb8\f\xbbw+\xdbzJ\xc5\xec\xa4\xfc<ȇ\x91\xd4\xcfO\xd8Tw\xec:\xdd\xf2\xf0ߡ\x92\x01\xe0\xf3\"\x90\xfdJ͟T\x88\xa7\xe5ݰbQ\x1a\x98\xea\x03٣b|\x9dU\x8c\x00\x00\x00V\\\x97\xdd6\x9c\x01\x00\x00\x00\xc0\x95\xd9\xcf^V]8\x95\xf9\xcd*\xb5e\r\x8eW\x14\xdb\x17\xa48\x92Om\x8f\x99\xdd\xea\xa2\xeai'\x7f\xe2\xab4~\xe8\x94+\xef\xfd\v\f\x8ea\xad\xec?\xfd͋\x90\x19\xfb\xf0Y\x90Wk\x17\xec\xa8\x02\xac2\x9fVyI\x13C\xbcS\x1a\x8et\xe9#F5\x9d\xd3h\xeb\x91\xe1)\x00\xd7R\xd3<o\xe90\x1f\xae\x00\xac\xa3\v\r\xd1h\xb5\xf32\xbbs\xb8\x02\x00\xac\xbc\xae\xb3\xb6\xd9X\xb5X\xc4\x04\x00p\xf4U\xf3礆\xf0\x11`\xb5\xb5\xeaB\xa6\xcdO[\xf8\xfd\xec\x93\xef\x8e\xed\xf9OX\xbdu\x7f\xb8\x03\x00\x97.d;\xca\xeaR#\xbd\xa6\xa2ږ\xb2JM7\xd1\xfe\xf1J\xc5\r\xbfgx\n\x00\xde\x18-\x91+TϞ\x96\x8b\xe7峴\xbdI\xfa\xe3\xb4ç\xf30\xec\xd0\x0e\x00\x00\xb0^\xba\xf2\xc1\xe1\f\xc02\xa9\x9e\xfa\xceԐ\x01\x00\x008R\\8\xae\xb4\xbdl\xd4Kr\xd9\xcbv89\x8d\x15\x9bZU\xf5\x82\xce\x157\xca\xdf\xf4_j\xf4\x96\u0cbb\xff\r\x01\xecXK\xcd\xfe\xcfJ\xa1[D\xcedѾR\xd5~\xdc\xff\x06`5e)\x90=m\xbf\xee\xa3\xda4\x1e\xd9/\xb2\x1dT\x87-\xe9\x9e\x7fHy\b\x1c\x82\xaeM\x81\xecL\xbf\x00֞\x95\xc9\xc1\xb2\x82Q\xf1\xe6\xe1\x06 \xed>\xf9\xfb\x18\x7f\x01VXT\xa7\xe8J\x05\xb1\xbb%\x00\xe0\xe8\xeb\xea\x97쓅\xf8\x80\x95\xe6-\x8d\xa7c0\xff\xd8\xd7\xc6\xf1\xee{t\\7I٫\xc3]\x00\xb8\f\xe3S\x96\xa9\xdc(5[R\xb9k7F\x9am\xbf\xaa\xb8\U0005b95b\xbf\x87\xf1I\x00_\x10#\xa9W\xaa9+\x1f\xe7\x8b\xd8\xf5\xe8\xec\xef\xb4\xed\xc1b\xd7\x03\xff\xc9\xf5\x96\x01\x00\x00և\x9f\xbce8\x03\xb0L\xc2\xec\x03\xc3\x19\x00\x00\xc0\xd1QVS\x95\xedXY\n\n\xea\xd7\x0f\xad\xec\xc3)\x94\x1b\xca&\xd7\xe9ػ_v\xee\x9e\xffLG,֚\x9f\x7f|1F\x91RB\xe8\xe4\xed\xaf\xe8\xb3\xfe7\x00\xabɥr1\x8dGZ\xe1\x18]\x1a\xeeM\xe7A1\xbb1\xfd\f\xe00t\xa7\x86\x13\x00kk\bU\x8e\xce)\xbb\xe5\xfbh\xa7\xe2\x93|\xfd\xc1\xe1\f\xc0*r\x96\xff\ak\x97\xf9\xf2m\xc3\x1d\x00\x00\x8e\xae,\xecX\x05\x95\xf0\x11`\xa5\xd5[*\x8a\xd9\xe2\xf4\xc9\xff6\xd6;\xffR\xe3\xb9]\xf8B\xea\xfa\xdb\x00pyR\x8f\xa7;c\x8d\xe0Ҏ\\MY\xa98\xfeeڸ\xfd/\xd2\x17\n\xe0\xa2\xd0\x12\xb9BY\xd7)\x8fiB\x98\x1d)\xeb\xcdR\xed\xaeU\x8c\x9d]Z\xe6\f\x00\x00\xb0f&\xf7\xfe\x0f4H\x81%\x945\x1f\x1d\xce\x00\x00\x00\x8e\x8eL\xafJ\xae\xb6\x93\xa8\xdaK;\x99\xd3|\xfa\x88\xb2/>\xe5\xf2\xb7\xbcJ\xdb\x030e3\xb3t\x12\x14\xd2\xee\n\xb1\x95\x8b\x85\x82\xda\xe1W\x00+)m\xf5:\xec\xc2\xee\xfa\xe1\xde~\xb5\x17\xe5c\x02فÒ\xa9\xb2\xa4\xd8\fW\x00֖\xcf\xd41\x13\v\x9f\xa5h\x9e\x1e\xce\x00\xac\xa4\xe8պV\x9bo\xfe\xab\xf4U\x02\x00\x8e\xb4\xf0⟌y\xbfa\x1fE\x16\xb0\xd2\xdaFY\xbb%=\xf9\xdbc\xb5\xfb\xa3\xda\b\xe3E\xd4\xd8\xec\xb4}\\\xd7?\x02\x00\x97#\xa6\xbc%\xcd\xdf)N\xa9\xd6H\xd5\xf4K4\xb9\xfd?P\xb1\x00p\xd1\x18>\xb9\x02\xa7_\xfc\xb6\x98\xb9\xb1|,\x15\x83\xef\xf7:H\x13*\xbb\x941wi\xb0\xba\xe8\x9f\x03\x00\x00\x00\x80\xa3.\xeb\x9e\x1b\xce\x00\x00\x00\x8e\x90|S\xf2\xb5\xf6\xbc\xd3l\xe3-*o\xfev\x8d\xdf\xfc\xb3\f\x84\x01\x83\xee\x99\xdf\x18Gq\xd4\xef\xfe\x15\xfdH\xa1K\x01\xec#\xc5l\xb1\xd3\x02\x80\xd5\xe4\x82\x15\x85\x99\x14b\xe8\x03\xe6\x14\x17[\xc0\xe6\x93\x1b\xfao\x00\xd7^\x9e\xd2\xe2\x85\xed\x98\x01\xac\xb5\x10i\xb2\xe23\xb9\xe6\x95\xe1\f\xc0Jr^\xad\xea\xe1\x02\x00\x80\xa3k\x7f\xfe\xbe\xc5\xe6}\x81r\vXi\xe3\x99\\}\xcc\x12\xfd?Ը\xd9Q\xa6\x93i\xe8PuSXݕ-\xd9\x01\\>\x1f\vuY\xa3\xc6]/w\xfd\x1dڼ\xff\xe7\xe9\b\x05pI\bd\xbf\x02M\xfb\xbcU\xe6\xacV\x97v^O\x13FL\xf4i\x92XP\xec'\x8c\xe4\xfd=\x00\x00\x00\x008\xea\x9c\xce\x0fg\x00\x00\x00GGמ\x91\xfc=\xda\xd8\xfc\x0e\x1d{\xf0\xfdnt\xeb\x9fc \f\xf84u\xfb!)\x8e\xed,*:\xaf\x10B\x1a\xa8P\x97V\xdb\x05\xb0\xb2\x16\xe3\x90)P.\xca}\xda\x0eJy\xbe1\x9c\x01\xb8\x96\xba\xa7\xbe+\xe6V\x0e\x03Xs\xace\x817\xe0C3\x9c\x01XI1m\x80\x94\xe6\x8c\x02\x00p\xb45\xf5\xf3\xf2Vn)Rn\x01+mZ\xca\xf9\xe7\xa4}\xaf\xc2M\xd4\xe9\x05K\xf7\x13\xe5㑔\x9d\x1d\x1e\x02\x80K\xe7ʮ\x0f\x9d\f\x93FŽ\x8f3w\a\xc0%c4\xf5\nl\xed\xfcsˉw\xedO\xb1\xb2ʞ\x97\x0f\x85|3V\x16K٥\x99\xf7\xcf\x01\x00\x00\xac\x92\xb6\xbbN*\xac\x9e\xe3\xacA\x9a\x97\x8b\x1aeU\xdbu\xd0l\xf2\xf6\xc5C\x00\x96\xcb+\xbf#\xfa\xb0=\\\x00\x00\x00\x1c\xa0\xd6\xda\f\n\xea\xf2\xb9\x9at\xf8\xb9Bl\xa4\xae\xb0cS\xe7&\x99\xe6\xa3V\xb2\xdf\x14;{~Îcj]Ծ\xb5;\xb2/\x8dN_\xfc1\xa7\xfb\xfe,\x83`\xc0\xeb\b\xfb\xe7-\x99\x9d\xb2\xf4s\xbd\xf2vG\xd9\xd4i\xdet\x1a\xa5\xad\x15\x00\xac,_\x9c\xd7~[(wcK\xfbV\x86v\xb5^\xc9K\xb9;~\x88\xf2\x128\x04q\xff'UT\x96\x163\x16\xba\a\xd6Z\xeb\xd5\x14\xbb\xf2ۿb\xb8\x01H\xd5\xc7~S\\\xcc!\x03\xb0\xb2:\xa7\xd9\xe8\x9e\xe1\x02\x00\x80\xa3k\xb3\xf9\xa0\xf2|_]6\x19\xee\x00XI\xb3 \xef\xc7)\xe2\xb4\x1f;Ȃ\x9dW\xd1\ue757\xd29\x00\xbc\x81.Ԋ1\xb3\xbc\"\xcd5\xb0\xef\xb4x\xbeK\v\xe9O\xec\xfe\t\xa9=\xa7\xbd\xd1\t\xcdo\xff\xc3\xfd\xf3\x00p\xa9\xe8*\xbf\x02.\xb0\x9c2\x00\x00X?\xce7)\x0e%\x9d\xd9ߵ}\x17\xd6^\xcd\xd2B\xe3\x1a\x8doM?\x00X2m}*\xf5B\rW\x00\x00\x00\a\xa8\xd8鿲v[E\xbc^Eq\x93\xfcx[M1\u05fe{M\xdb\xed\x9e\xc6i\ai\x8dU\xc7F\xe7\xf2S\xda;\xb6\xa1\xfc\x9ds7\xfd\xe2H0\x1e\xf0\x05\xcd\xfb!d\xa5\xed\x1f\xfb$\xe3\xb5\u061c\x99\xfa=\xb0\xea\xf24\xca{a7v畗\xd7/\xce\x01\\{i'\xb34u\x80\xda+\xb0\xdeb\xb4Z\xb8\xd5\xc73\x16\x8dŧ\xb4͙~\xce/\x80\x15\x96ym\x8c\td\a\x00,\x81T/MC\a\x8e\n*\x00\x00\xf8\\~\x14Ґ\xe3B\x1a\xf7H3\x11¦}\xb7\xea\xfc\x8bj\xc2q\x1d?\xf6\xcd:v\x82\x8d(\x00\\\x1e\x02ٯ\x80o\x99\b\x06\x00\x00\u0590\xaf\xa4\xa6\xe8'\xc8\x06\xa5\x9d\xd9\xd3\xea\x8dE\xdf\xd7\xed7\x1eZ<\x03`\xa9T\xb3\x97\xa4n\x11\xfe\x02\x00\x00p\x90\xba\xdc)\xe6\xb95\x16\x9c\x14\xf6\x14뗭=qF\x85\x9fjZ\xdc%\xd7f\n\xb3B\xb3\xce\xda\x14'߮c\xef\x8an\xe3\xcd\x1fg\xd0\v\xb8\x18O\xff\xe1\xe8,]-\x06\x93\x83\xba\x98)FgɍEx\x81\x95\x97\xd2z\xda\xda3\xa5\xf7>x\xb6\xd0h\xf2\xa6\xc5o\x00\xae\xbd8S\xec\xa7\x0eп\x06\xac\xb5\x18\xadn^(\xcbn\x1bn\x00R\xd3<O ;\xb0\xea\xbc\xd3t\xe3\xed\xc3\x05\x00\x00G\x97\xeb\xc6\xc3ܠ\x14\x98\x06\x00\x00\xf0\x99\x9cU\x13bځ=\x1di\xe5\u07b4Ý\xa2Z\xdf*\xd8o\xdd\xe67Hw}/\xf3y\x00\\6\x02ٯ@\xd61\x19\f\x00\x00\xac\xa1\xac\xb6v\xe9\xa4o\xb1\xf6\x93\xf3\xfa\x86j\xa6J\xa5t˟\xa7\x81\n,\xa1\xae~\xd5\xd24\xc9\x17\x00\x00\x1c\xbcY\xbe\xa9YV\xa9u\xe7\xa4\xe0\xe5\xeaۥ\xeaf\xa9N\xd7Ϩ\xf1\x0fK'\x7f\x89&\xef<\xef&\x0f\xbc\x97\n\tp\t\xea\xd9\xfb䂵\xd1S\xcaq!\xc5\xcd\xf4;@\xfa\xcc\x1a\xeb\x81\xf1\v`\xa5Eg\xcd\xf8\xa1\xd8\xecR\xda/UN\u07ba\xb8\x06p\xcd\xc58\x93\xb3t\xb9(\x94\x01\xac/\xa7\x90\x8f\x95\xdd\xf6}d\x06\xf8\x94\xee\x15+(F\xc3\x05\x80U\xd4\xc5B\xee\xe6\xff\x89\xbc\x1f\x00p\xa4\xed\x7f\xf87\xc5,\xa4@\xf6<\x85\xa3\rw\x01\x00\x00>\xa5m\x9d:U\x8b\xa1\x8el\xd2o|7\xd7\x19\xb5v^\x8e߭\xf1\xc3\xff\x1bm_\x00W\x84@\xf6\xcb\xd4=\xf1\xdfĜ\x15s\x01\x00\xc0\x1a\x8a>M\x88\xb7\xb6h\xc8\xfbI\xf21VJ\xf1\xec\xad?\xb9x\x00\xc0\xd2\xf1ݾ\x94\x11\xe8\x02\x00\x00\x0e\xdet\x165m\xb6\x94\xc7mk<Ԋ\xee9\xb5\xf9i\xc5\xc9\xdd\xd2\xe6\xbbT\xbc\xe3\x03\xce?\xf8\xef\x19\xec\x02.CW}lQ\x8d\xb7\xf6\xb9\xd4X\xfa\x8a\xd6F\x8f\xf2i\x85\xf4\xc0\xf0\x0f\xb0\xca\xd2\xe2\x92.}\x846u֩Φ*n\xfd\x01\xcaS\xe0\x90\xb8\xb0\x97>\xed\xa0\xfc\x05\xd6Z\x1a;\xcb\xc6\xc3\x05\xb0P\xb8}+\x1e\bd\aVپ&\xc3\x19\x00\x00GW\x88\xef\x97S\x1aK(\x167\x00\x00\x00>K\xd7m)\x84 \xf9\xb4\xe1]\xa6*v\xdaOՇ\x8d\x87\xa5\x87\x7f\x96qH\x00W\x8c\x91\xd4\xcbTU\x8f/\x06\xa1\x00\x00\x00\xd6L\n^W\xd6Z\x8b5\xeb\xa7\xe6\x05\xed\xa9u\x9d\xb2\xd1]\xfd\xef\x00\x96OڰQ\xaeY\\\x00\x00\x00\x1c \xbf\xbb#\xcd\xceY\xfbaGݨ\xd1\xfe\x89\x9bT\xdd\xf2[\xe4\xde\xfa\x94\xd3C?G\a+p\x05\\8\xa5\xa2˥\xb6\xe8\xeb\xf3\xbe\x1f\xf1I\x95{k\xb3\a&\xa3\x01\xab\xcc9\xdf/\\\xd1wԹB]\xda\x15\x01\xc0\xa1\x98\xbd\xf8\x87,!\xce--\xa6\xd9\\L\xbf\x00\xd6Zj\xe1\x12ȎO\xd3<\xff\ab\x99\x16\bwt\x7f\x00\xab,\x167\fg\x00\x00\x1c].\xbe$\x85&\x9d\xf4\x81i\x00\x00\x00\x9f-\xd7XY\x1a\x83\f\x9dڶQ\xad\x89\xdc\xe4N\x8d\xdf\xf4\x9f\xe9\xdc\x02p \x18I\xbdLM\xfb\xa45\xe6\xf8\xe3\x03\x00\x00\xeb'\xa6\xc9\xf0\xd9\\iǷ,\x8e\xadF\xd9\xc8e^\xa3\xc9=\xc3\x13\x00\x96I\xf5\xec\xd7E\xdfe\x96\xa6\xab\xe1\x0e\x00\x00\xc0\x01ڼ^q\xba\xa5z\xf3Mr\xd7\x7f\x9b6\x1ez\xc9m\xdc\xf1C\fr\x01\a\xa0t\x9d\\\xd8X\x04\xb2\xab\xb5\xb6y\n\x90\b\xfd<4\xfb5}\x00XU>\x97O\x01Q)\xbdg\x96\a\x94\xd3\xc5}\x00\xd7\\]?#\xaf\xca\xca\xe0\\\xa1O\x94\x00֖\x95\xcd1\xa3\x1e\x8eO\xd9\xdf\xff\x05\x15i\xa1\x93\xb6\x1b\xee\x00XE\xc5\xe6M\xc3\x19\x00\x00G\x97\v3)\xa6yA\xad5]X\b\x17\x00\x00|.\xe7f\xca\xdcḇ\xb5\xf3b\xfa\xb0N<\xfc\f\xf3{\x00\x1c\x18\"\xb1/S\u06dd\xb6\x06\x1d\xf91\x00\x00X?!Z\x15\xd2uv\x92ɻ\x91\x94Y\xb5\xc8n\x15\xe5\x8d\xc3\x13\x00\x96IU\x7fBYZ\xa0\"0\x91\n\x00\x00\x1c\xbcٍ\xbfT\xee\x1d\xe7\\\xf9\xe6\x0f;\x7f\xfb\x0fС\n\x1c\x90\x97\x9f\xfd\xa3\xb1߁=Z\xbb<\xedʮ \xe7S\xf0\xdc\"\x98]1\xdd\x03\xb0\xb2\x16\x19\x80\xa5w\xfb\xcas9\x82\xe6\x80C\xd34g-)\x0e\xbb\xed\xa6\x8er\x00k\xccY\x9d\x9cz8>\xa5\xaa\x9f^\x94\r]3\xdc\x01\xb0\x8aF\xe3\x93\xc3\x19\x00\x00GW\xec\x82\xfa\x1d\xd9\x15\xad\xe5B\xff\x05\x00\x00\xf8\\Q;\x92+䜗+k\x8d7\x1e\x1c~\x01\x80\x83AK\xe42\xc4\xe7\xfeX\xdc\f\xd7K\xe3z\xb8\x03\x00\x00\xb0>\xc6\xedy\xfb\x1c)\x8c\x83\xda漲Ωծt\xeb\xffLP\n\xb0\x84ʽ\xf7ɅV-s\xde\x01\x00XO!\x93\xda\\j\xac2Ў\xa5nj\xf76\x145U\xf0v\x9d\xee\xa7g|\x90\x8a\xd6N\xa3\xea,\xb7c\xaa\xaaز\xe7\xe7Rl\xed?\xa8R\xa3\xb9\xce\xe6\x9d\xcen\xbfCz\xd7̥cr\u05ff\xa0\x9d\x00\\\x05\xf9\xee\xff*Ֆ>3k\xa3\x8f\xf7\xedN!7\x1f\xab\xa8-\xdd\x06Kv\xd9\xce\xe2A\x00+\xa9\xb2\xf2\xd6\xc5N\xda?\xaf\xae\x98k\xfa\xa6\xf7Q\xde\x02\x87dz\xfe\xc3꺑\xe2dO\xbe\xb6z3\x80\xf5\x95ϭ|\xfe\x1d\xc3\x05 \x9d\xd4\xcbR\x9c\xa9-\x19\x80\x01\x96Y\xeb\v\xa9L;\xd8nHn\xae\x18\xe7Rw\x9d\x9d\x8f\xa4:\xc8\xdf\xfe\x0fh\x8f\x01\x00\x8e\xb4\xf6\xc9_\x1b7\xd2\x02K\x13\xaf:Z\xddԧ1\x05\x00\x00\xb0n\xf6\xdc\xc4ڲ\xc1ά]kG\xa3\xa0=\x9f\xa9ʺ\xbeo3\xebn\xb4\x8aC\xa5\xbak\x14\xb7\x7f\x9bt\xf7ߧ\xbd\v\xe0@\x11\xc8~\x19\xba\xe6\x15\xe5i\xe2f\xe0\x8f\x0f\x00\x00\xac\xa3E\xbb4\xaaS\x96\xa5\x89yv\xed\xa7\xfd=\x00\xcb\xc7u\x852\xdf\xca9&\xda\x02\x00\xb0\x96\x8aV\x1aW\xd2dϾ\xcf\xd9qF\x1a\x9d\x95\xb2]\xab\x1f\xcc4+\xf74\xf7^m\xb7\xa9XM\xe5\x9bZe\xb3\xab\xb2=\xadQsN\xe7\xdd\xf5:_\x04\xednM\xe4o\xfc\xf5:\xfe\xf6\xda\x1d\x7f\xf0\xa7\x19\xcc\x02\xae2\xd7\r'\x00֒\x8bi\x92\x89\xb7\xf2\xbaT\xec\xfb\xe7\x00\x1c\x16\x17\x87\x13\x00pA\xa3{\xff\f\xeda|R\xbf\xee\x9fU\xdb\\\x9ac\x06`i9\xa5N\x18\xcb\xde\xfb\x1c\xde\r_\x8d\x1d\x95B\x9e\xa7+\x00\x00\x8e\xb4\xb6ٗ\x1a\xab\x93\xc6(\xd7wd\x10\xff\x00\x00\xc0:\xcaem\xd9$Z\xcb6\x96\xf2nb\xf7\xc6*bZ,\xbf\xb0*B\xad\xb9\xf6U\x9e\xfcJ\x8d\xef\xfba\xfa9\x01\x1c8Z\"\x97\xa1\xae?\xaa\xccw\xea:˨\x01\x00\x00֍\xb3*\xa4\xb3\xf6i\xe8\xe4\xd2D\xd9\xe8\xed\xfb\xf6\xe1G\x00\xcb&\xb6֮\xc9\x1b91\xd1\x02\x00\x80u4\v\x8d\xaa\x90\xab\x8b\x1bvu\xc2*\a\xdbv\xa4*\x7fmG\xa5\xc9\xf8f\x8dʠ\xe0O\xabu\xbb\xf6\x83\xfd\uebf3g\xac\x0e\xd1\xd5\n\xb7\xbeS\xdbo\xef\xdc\xe6C{.c\xf7u\xe0\x9a\xc9\x02\x81\xab\xc0Z\vݢ,\xceƊ9\vL\x02\x87)\x8bA.\xbaE@\xbbK\x11\x8b\x00\xd6UȘ\x82\x85\xcfҎ\xa4.\xb5\xdd(\x1f\x80e\xe6\xd3j\x82Vߋi\xa2\x7f\xea\x8f\xf1\xb9\xd5\xfb\xe6\niw\xf6\xf2\xd8\xf0\x14\x00\x00GWW\x9dUHUҘ\xc9gQ>\x95i\x00\x00`\xed\x14>\xb5oS\x1ffڙ},g\xed\xdb\\\x9d|\bVap\xaa\xddY\xb5\x1b_$=\xf0ST\x16\x00\\\x15\x8c\xa2\\\x860\x7f\xd2>\x1b\x85~\xb0\x01\x00\x00`\xdd\fu\xa0h\rZ\x9f\xf5\v\xb6fك\x8b{\x00\x96\xcb3\x7f0\xfaΚ\x85>\xad\xb4H ;\x00\x00\xeb\xa8\xccF\xca\xfb\x9d\xc1f\x8a\xedy\xa9\xaa\xectSڻŎ\xbb\x15N=/W\xcdU\x8e&\x8a\x9bN\xa7\xa6s\xbd\xb6}\xb7\xba;\xbfC\xfa%\xd1\x1d\xbf\xe3\xc7\x19\xc0\x02\xae\xb1\xf6\xe3\x7f,\x8e:\xea\xef\xc0:\xeb\axӄS_(x+\xb3\x01\x1c\x1a\x1f\x1be)=\xf6i\x92\x1dw\x81u\xd6f֖\x06\x06{\x1f\xfa\x8d1\vc\xc9\xdan\xec\xc8\x0e,\xb7\xb4#{\fn\xb1a]\xfc\xff\xb3\xf7'\xf0\xb2]\xe9]\xdf\xfd_k\x0fUuι\xa3\xa6\xd6\xd4j\xf5<\x0fn\x0f$N\x1c\x83m\x12\a\xc3'/\xbc\x80\x03\x81\x04\x82\r/\xc1\xc4y_\xdb\xd8\x06c\x9b\xc1\x84\xe0\x17\x13\x02\t3!\x84\xc4\x04\b\x10'\xbc@H\xc0\x18\fv\xdb\xee\xb9\xd5\xddR\xb7Zj\xcdҝ\xcf9U\xb5\x87\xb5\xde\xe7ٵ\xaf\xa4\ue5ba\xa5+\xdd{NU\xfd\xbe\xba\xfb\xd4\u07bbJӽ\xb5\xf6z\xd6\xf0\xac\x95\xed\x87'\xb2\xb7j\xada\x16fw\x8f\x9f\x02\x00\xe0\xf8*|\xfco\bI\xbd\xefbU\xaf\x01\x00\x80\xed\x13\x87\x80\xc0G\x19\v\xe5\"(\x84\x03k\xe3z\x9cp(\xf5\xbd\x16\xe5\x19\xed\xbd\xe3\xa3\x04\n\x00\xae\x1b\x12ٯA\xec\xce\xdb\xcfV\xd9w#\x05\x00\x00\xd86\xb1\xb2\x1fY!w>RkQQ\xa5\xb2~\xd7\xea=\x00k\xa5i?\xa2b\x98p\xd1\r\x9b\xb9\x01\x00\x80\xed\x93\x0eZ\xc5e\xb0\x98\xa0R(\xa7Re\xb1A\xb5/Տ\xdb\xf19\x85S\x13\x1d\x96\xb5.绕f\xbfC7\xbds\x11n~\xcb/\x84\xe2\xf6\x1ff\xf0\n8\"m\xf7!M\x86\x95\xd2\x01l\xab\x98V\xcf\x00_\x9b.\xc4w\x0f\xe7\x00n\xbc\xfc\x99oϥ\xefT2\xf0\x02I\xa2\"\xb0\xcd\xfa\xe2\x8e\xf1\f\x90R\xfe\x88b\xf6\xc5\xc1\xabU\xe2+\x80\xf55\xc4{Ő\xc8>\xc4{aLd\xb7\xcb8c\x9e\x04\x00\xe0\xf8\x9b\xe4Cš\x1e\xab\x86E\x96\x02\xe3\v\x00\x00l\xa7\xe4}Tq\x18_T\\(\x05?\x92\xdahm\xde\xfaf\xed\xdc\xfc]\xc3\xc7\x00\xe0z\xa1%r\r&}\x92\xfaV\x91\xdf=\x00\x00\xb0\x85R(\x86\x04\xf6(k\xd0\xf6v\x1dk\xc57\xfeQ\x92X\x805\xd45\x0f\xa9\b\v/\xd8\xe3\x1d\x00\x00\xb0m\xaaY\xa5P'\xa98T\xab}\x1dj\xa9\xfdj\xaa\x83\xd9\xed\x9a\xef\xbdYW^\xf3[\xb4\xf3U\x87\xe1\xe4\xbb?\x15\xa6\xf7\xfe9\x82\x06\xe0\x18H\xdd\xfd\n\xab\xedS\x00l\xa9\x98*\xfbѩ\x8d\v\xd5o\xf8\xeb\xd4\xcf\xc0\x11Y6\x9f\x1e\xfaʇj9\x95\xf6\x83\x95\"\x81mV\xd6o\x19\xcf\x00\x9f\x90\xf7\xa4\xfd\xf4z\xc1*\x89!\xa1\x1d\xc0\xda\xf2q\xd4\\*\xfb\x0e\xb6\xc3\x02\xe1\xd1\xeee\xf5a&\xdd\xf3\x97h\x8f\x01\x00\x8e\xb5\xee\xd1ߜC\xbeb\x01\xaaǤ\xd1\"T\x8bQ}\xee\x1f\x00\x00\xd8>>\xed\x7f\xc8e\xb7\xa6lX\xaeny\x93w\xfaV\xe9\xd47\xab\xbc\xf3Gi\xe3\x02\xb8\xaeH\xc5~\x99\xba\xcf\xff\xf6\\\x84\xc90\xd6\x10+&\x8a\x01\x00\x80퓆\xa5ƍE\x92\xd9W\x1f/}\x87v\x00\xeb\xa8k\x9f\x1e\x92\xd6|\x02\xc60f\x05\x00\x00\xb6P\xb2P\xa0ӡ\xc5\xf7\x87\xf5\x19\xb5'\xbeA{\xef\xdd\x0f\xbb\xefz,\xcc\xde\xfe\xa9p\xf25\x7f\x91\x81*\xe0\x98\xc9\xe9)\xf9b\xbb\x00\xb6\x98'C\xc5^\xa9l\xc6\x1b\x00\x8eB\xd7=b\xe1\xb4O\x00\xf7\x90َ\xc8\xfc\x01`\x9bU\xb3\xb7\x8eg\x80\xd5\nin?-V\v\x9e\xcc\ue2dd\x00X[\x9e\xb8>,H\x91\x86\xd3\xd5u\xb6\xab\x13\xc3\xdb\x00\x00\x1cg\xdd\xe2~\xa9\xb7\xd8\xd4\uabfe\xb7\xba,\xfbBK\xe3\x9b\x00\x00`˔\xca!*X,\x10,$(</2ܩj\xef[\x14\xde\xf8?27\b\xc0u\xe7]kx\x19\x9a\xe6s\xf6\xc4\xde\x19\x1aq\xc1\x9f\xde\x00\x00\x00[&Y#v\xd8e\xc6b!_q<\x16$\xb2\x03\xeb*u\xf3U\xab0Gk\xdf\xd0\x0f\x05\x00\xc06Z\xe6\x89b\xfd\r\xdayw\x0e\xa7\xdeq>\x9cz\xf3O\x13\x14\x00\xc7\\\xd0҂y\x12倭\x96\xeb!a6\x94\xddx\x03\xc0Q\xe8\xfb\vV\x1e\xc7:\xd9\xfḃ\xad\xd9\x01l\xad\xbb~\x8c\xf64\x9e\x93\xac^H\xbe\x00\x99\xbd^]$\x1c\xc0z\x1aB\xbc(\x15v\xf2lq\x8eJ>\x87\x14\x00\x80c\xaeoϯ\xfa,,>\xed\xfb<\x84\xa9\xf2\xb9\x7f\x00\x00`\xeb\xf8\x9c\xff\xd5\nm~a\x875sc\xbcK\xe1\xde?I\xe7\x15\x80\x1b\x82\x96\xc8\xcbT\\\xfe\x97\xf6\xc0\x9e\xab/&\xcaM=\xde\x05\x00\x00\xd8\x1e\xc1w\x0eȕ\xd4/\x14\xcb\xd3jO\xdc5\xbe\x03`\x9dt\x0f\xfd'\xf9\xf4\xc4N\xe2MJ\xa1Th\x17\xab7\x00\x00\xc0\xb1\x92\xc2B9Z=]t\xab\r\xbc\x8a\xa8T\x04u!\xabɭ\x9d\xdb{\x1e\xa3\xfb\xa24\xb1\x1d>\x9b\xe4\xf5\xba}8\x9d\x91\x9a]\xff\x87\xa8\xb7\xfbM\xb5\xd0r\xb2\xa3v\xef\xbd\xd2M\xbfU\xfa\x9ay\x98|\xf5\xa5\xa0w\xfcS\x06\xa5\x805qp߿\x91w;+ߥ\xef\x04\x06`{\x9dSJ'\x94f\xdf8^\x038\n\xb3iTן\x95\xea\xceb\xf0\xa5\xda8\x1d\xdf\x01\xb0\x89\xe6\xf1\x84\xb5ɭ\xbd\xddW\xd6\x16\xcf\xea\xca\xe5j\xbc\xac\\X\xf9\xa7\x7f\x1d\xcf9\xfc\xf4/\xcb;\xed\xee0+\xaf\x8d-\v\t\x03k\xae\xaf\xa6ꊋ*\xed\xf9_\x1c\xfa\xe0j֢\xecU\x9d\xbci\xf5\x01\x00\x00\x8e\xb1\xe9\xc1\xb9\xa1ْ\xaa\xdbU\xd7\xfb\x9at\x9e\xb3\xc6\xf8\x02\x00\x00\x1b\xa9,\xd4\xd9_mnV\U000c818cQ\v\x04\xb2\x8f]\x9cԡ\xb5e\xcb\xd0*\xfa\xe26\xf6\xf6\x95\x93\xefR|Ͽ\xa6\xe3\n\xc0\rC\"\xfb\xcb5\xec>\x1a\xc6A\x06VT\a\x00\x00\xdb'[#Wiա\xdd\xe7CU\xe5\xbd\xc39\x80\xf5\xb2\\>\xad\xe4\x1b\xb7%\x9f`gM\xc3\xe0K,\x02\x00\x80\xe3&\xc6]\x858\xb3@\xbcP\xdfw\xea\xfa\xa5\xbd\xfa$謲\xac}3V{\xcf\xea\xf2\xfef\xa9\xb9G\xa1\xb9M\xb1ٱ\xf3\xb9\xd4>\xa9\xc5\xf4\xbc\xfa\xbd\x9bU\xdc\xf4\xabU\xbf7\x87ɻυ\xeam\xff*\xe8\xf5\x7f\x9e\xc1(`\r\x05+\xff\xc3n쁉f\xc0\xb6\xebB\xa3\xaa\xa0_\x0e8*\xed\xc3?\x94S\x1b-V_Z\xdd<\xcc\x027L\xbf\x006\xd9P½\xac[{|\xb5\xc1\xb6\xddɾ\xf8\xb37\xcbO\xfa\r`\x90Scߕ\xab\xf3ˊ\xd5nW\x00\xd6V\x88i\x1cF]HE1\x94mŬIł\xff\x00\x80\xe3ϛ.![L\x1a\x0eW\xed\x98T(\xf8\xe2\xd9\x00\x00`\xe3t\xddr\xc8u,\xcb\xcaڭ\xd6~\xb5\xca?\xa7\xa5R?W\xee\xf75\xf3\xa4\xf6\x90\xd4\xe5+\xea'\xf7\xe8\xc4[>¼!\x007\x14#\xa9/W\xef?\n{\xa6g\x06\x1a\x00\x00\xc0v\n\x9d5nˡ\xa7\xbbѡv\xee\xfc\x9b4d\x815\xd4,>\xaf\xdc\xce\xd4\xe5C+\xd3$\xc1\x00\x00p\\u\xf3Ri黫\x7f\xe1\xe2\x9a9Uv\xd4\x16\x97ߪ\x14gj\x8a'5\xaf?\xab\xc3ٓ\xea\xcf\xecI\xb7\xfe\xdb\xd2\x1d\xff\x91\xa6\xefO\xa1x\xc7\xc3A\xaf\xfb߈ہ\r\x10\xfa\xfd\xe1y0\f<\x03\xd8^\xf6\x18\xe8b\xa3\xfa\x9e\xbfL\xfd\x0e\x1c\x91\xb6\xf9\x98Ԕ*j\xef[\xf3D\xf6Z\xb1\xa7H\x02\x1bͷ)rC\xdb<+\xfa.\x86c\"\xbb\xca{\x86\xb7\x00\x17\xbaF\xea\x97\xd6n\x8b\xf6ˎ\xe1K\x02`]\x05-\x15Ӟ\x95i\x8b\xfb\xca\xe9\xd0=\xdb[ٞ\xcc\xde5~\x02\x00\x80\xe3\xa9\x7f\xe8Gr\xf4\x85q\xf3\x8e\xfapiՔI;V\xb9Y\xac\n\x00\x006N\xcei\xe8\x8b\n\xa1\xb2+\xef\xbb\xf4\x059-\x16\xf0Elʅb\x17\xadI;W3\x99\xaa<\xfd\x9bV\x7f\x13\x00\xdc@$\xb2\xbf\x1c\x0f\xff\xc1\x1c\xfa<L\x0eQa\x0fx\x06\x1a\x00\x00\xc0\x16\xcaj쇇\x91{\xea\"\x13\xf3\x80\xb5\xd5>a͚\x1d+\xd3֮\xf1\xb2L\xf3\x06\x00\x80c\xa9\xac\xa2bi\xf1\xf7\xf0\x9a\xe5;y)\xd7\nyW1\x9fV\x9f\x9fR\xa8J\xd5g\xbeQ\xb3\xd7\xfe>\xed\xbc7\x87\xe2\xedO\x06\xbd\xe9\x9f\x06\xbd\xfe\xaf\x11\xb0\x03\x1b&\xf4\x97\xa5>\xd9\t\xc5\x1b\xd8j1*\x85z\xbc\x00p\x14\xfa\xee\x13\n\x9d\xc5\xe9\xa5/\xfcꉬ3\x15\xf4\xaf\x01\x1b-jܵ0\xa4a\xe3\x8b\xe8\x8b>[\xf9oU)N߹z\x0f0E{ %\xfb\xbe\x04_\x18\x9c\xa9y\xc0\xba\v\xfe\xfc\xef\xf7ƱT\xef\x9bM\xd6\x1e\x9bJg\xff0\x9d3\x00\x80cmq\xf8Q\xab\xb7\xac\x02˕\x92\xd7g\xbe%{\x9e)\x84v\xfc\x04\x00\x00\xd8$U,\xac\r\xeb\xf5\xbd\xd5\xff\xc9~\xd9i\xac\nŉݭ\xfc\xde\\m\x19\xb4s\xd3oUx\xed\x8fѦ\x05p\xc3\xd1[\xfe2\xa4ŇTxg\xa4\xf3UI\xc6ݏ\x00\x00\x00\xb6\x8e7_C\xa5P\u07b2\xba\x06\xb0vʴ\xef\xbdTCy\x0e\xd1\xda6>`\x05\x00\x00\x8e\x9d\xacC\xfby\xa8\xdc\x1d\xaak;\x85\x10UMO\xab8u\x87\u00adoP\xf1\xd59\x84w?\x1d\xf4\x86\x7f\x14t\xcb\x7fI\x85\x0el\xb0\xee\x91?\x94+\x8f\xe3s\x1cƞ\x01l1{\x0e\x84\xe2\xf6\xf1\x02\xc0Q\x88\xfd\x93\xaa\xbcB\x0eAiX$\xd2\x13Z\x99?\x00l\xb2\xe8e<E+\xf7\xc9\xda\xea\xbe;\xbb\xefl\xe4\xcb?W*\xdf\xf4?\xd1\x1eǳ\xaan\xee_\r\x1f|\xb1\x1f\x16\xb7\xad\xb2_\x01\xac+\x0f\xf9\xfaz\f\xf5zu\xb1\x93\x8a\xb3\xc3[\x00\x00\x1cg\xfd\xf23\xf6\xd3\x17\xe4\xf2\xbe\v\xaf\xd2j\x85a\x91\\\xe2S\x00\x006\x92o\x8c\xe1\xd9\xeb}\xafd\xedWߝ\xdd\xfb\xa7R.\xd5\xf5\xa5\x96\x93N\xf5\xa9o\x93\xee\xfe\xf3\xf4e\x028\x12$\xb2\xbf\f]\xfb\t\x15\xa1\xb4\xe6\x9b\xff\xd5[\xb3\x8eg7\x00\x00\xd8Râ>Qu\xf9\x96\xd55\x80\xf5r\xeew\xe7\x997g\xc2\xd2Z53帔\xf2\xb8h\x17\x00\x008V\xc2\xd4*m_{\xc6\a\x98rP\xdf\x05u\x8b\x85tpY\xba\xf8\x94\xf4\xd9o\xce\xfa\xdcw1\xe3\x04\xd8\x02\xcbÏ\xaaȇ\xf6@(\xd4\xfb\xac3\x00\xdb+D\x95՛\xc7\v\x00G\xa1\xe8\x1a\xc5`qy\xaa\xed\xca\x0f\x13<\xb1\x15\xc0\xa6*\x86\xcd.\xa2r\xf4\x1d\xd9=\xa9\xbd\xb0r\x9f\xd4\xc7\xe9\xea\x03\x80Y>\xfc\xed9\xe6\xa5}7\xfc*\xaa\xf3\xaf\xcd0\xae\n`m\r\xe5\xd9\xca\xf1\xd0\x03\xdb(\x17\xadby\x87_\x00\x00p\xac\xc5\xfe)\xab\xbfZ;Yʇ\x19SW\xd9y\xe7\xeb\xb2\x00\x00\x80M\x94*eObϝba\x8dؘ\xd5\xf5\x85\x96M\xad\xa6\xd9Sw\xfa\xfd\n\xf7\xfeo$B\x0282\xcctz\x19R\xff\x90\xfd\x8e\x15\xf6P\x1f\xd7\xcae\xa0\x01\x00\x00l\xa1\xe0Y4E\xa7\x1cZ\xd5\xd5[ǻ\x00\xd6\xc9\xc1\x95_T\xe9\xbbDin\xed\x9c\x1d+ح\x9d\xfb\xee1\x00\x00\xe0\xb8\xe9\x0f{\xa5EP\xee*\xc50\x19VL\xceZ\xa8i\x9fP\xb3\xf8\x9ct\xe1\x03ҹ\xbf\xae\xf6\x97\xce\xe4\xc5Go\xca\xf3Oݛ\xfb\x87~E\xd6\x13\xdfMr;\xb0a\x1a\xdf=\xc5\x13\xe4\xec9\xd0%\x8a8\xb0\xedX`\x128ZE\xe3\xabM\xb5R\xbbk\xafޯ\xe6s\a|\x973\x00\x1b+X\f\xee\xf3\x85r\xb6\xc3oX\xd9\xf7\xf3\x82\xbeu<\xa7[\x8c\xbb^\xfaz\x84\xfe\x95\x19\xbe,\xcc\x0f\x06֚\x15\xe3\x10\x92U\x033\x8b\xf8\x1a\v\xfd\x92\xaa\xfa\xee\xf1M\x00\x00\x8e\xaf2\xcf\xed\xa7Ŧa\xaeh!i\xea\v\xbbi\xf7\x92\xcf\x17\x02\x00\x00\x1b'\x17ʾ#\xbb7d\xa3\xefʞ\xd5\xf6QE<\xad\x9d\xbd{\xb4{\xef/\xd0I\x05\xe0H\x91\xc8\xfer$\x1fh\x88\xc3 C\xf2q\xe8a`\n\x00\x00`\xdbx;\xd6\x13\xd9-\x18*o_\xdd\x02\xb0V\xe6\x9e\xf4\xe6\x1dVy\xa1\xdcOƦ\r}T\x00\x00\x1cGE\xb1\xab\x18w\xac\xa6\x9e\xdaQ\x0f\x89\xeceݫ\x9e-\xecط:\xfd\xc0\x8e\x85\xaa~\xa9\xe9\xe2\x8af\x97>\xa7\xf0\xc4?U~\xf0\xbf\x97~\xf6\xee|\xe5\x83!\x1f|\xf4\xf6<\x7f\xe0[r\xfb\xd8\x0fУ\t\xac\xb1\xb6;\xbf\n\xdbC\x1c\x06\x9d\x01l\xb7p\uf7e2!\x0f\x1c\x91\xc5#\xbf3\xfbBSC\xbdܖ\n\xa1\xb4s\x9f\x18\xceB\xf8\xc0\xe6\x8b\xc3\xf8ذ\x81\xa1\x87\xe4C|N\";\x9e\x93\xbas\xf6\x9d\xb0o\xc8\x18\xa9\r\xf3\xcb\x00\xac7\xcf\xfc\xf3\xddk\xc3\xcc\x1e\xfd\x9dB\x91U\x95g\xc77\x01\x008\xbe\xa2ǥ\x03\x7f\x8dJ\xbe\xe1El,H%}\x04\x00\x80\x8d\x14j\x85P(D\xef\xb8앒\xc5\x00V\xff\xd7Sk\xc3\xde\xf4\xfa\xd5g\x00\xe0\b\x85\xd5ʯ\xf8J\xf2\xe7\xbf3\xa7\xcb\x7fYڗ\x8a\xf2\xb4\xd4Yc\xae~\xda^'\xe3'\x00\x00\x00\xb6D\xae, \xba\xa2}\v#\xf7\xbe:3a\x16XC\xcb\x0f\xdcd%\xb9U\x9c]Q\xb3?U]\xd6\xca\xf1P\xa1g\xd5e\x00\x006N\xe1\x93,\xbd\x0f8\xad~\xf5\x85bؓ\xaa\xd3v\x9c\x90\xde\xf1\x11bz`]|x\x9a\xd56v2U\x1f\x17*z\xc6'\x80m5/Z;\xaa\xa3\x0e\a\x8e\xc8\xfe\xc7_\x9f\xf7\x0e\x1e\x94\xca۴\xc8\xcfhb\xf1uh\x17\x16w/혎\x9f\x02\xb0q\xd2Ryw\xa2\xc3\xe5B\xbb\xcb7؍\xcfH;\xa7tp\xf2Wh\xf7\xf5\xff+\xf52\x06\xe9\x97n\xce!\x9fSN\xb7+\x16\x8f+\xb5\xbe\xe6\xc1i\x15ֆ\x03\xb0\xa6\xb2O\x11\xb52\xdc쪌\x95\x16\xc5EM\xdf\xcb<\t\x00\xc01\xf7\xf1\xf7g-\xceY<\xfa\x8cT\x1c*\x96V\xa1\xf9pa.ƃdv\x00\x006N\x88\xcaUV*\x97*\x16V\xd7w\x85RU\xea\xe0\xec\x7f\xa4\x13\xaf\xfbs\xb4c\x01\x1c9Z!/\x91\xeft\x92\xfb\xa0\xa0\xd2\x1aosٓ\xdd\x0eo\xd1\x01\x00\x00l\x97a!$\xfb\x15\x8a\xdd\xf1\x0e\x80u\x13r/_tыs\xf0\xc46\x168\x03\x00`s\xf9\xae\n\xa9\xb0\x13_\xb0&Z\xdd\x1f\xac\xee\xef\xa5~)5\x97\xf5\xc4GB>\xf7\xf1\x90/\xdf\xff\xae\xbcx\xe8\xb7\x11\x14\x00\xc7Y\xea\xac\xecVV\x86\xbd\x1cS\\\x81m\x16ʛ\xc63\x00G\"\x0fY\x89\xc6\xfb\xd8\xc2j\xdd(\x17<\xee\x06\xb0\xb1|7#kc{\xb3Z\xf1\xc0\xae\xed5/4)\xef\x19\xde\x06\x06\xb9\x19\x16\rι\x1fr\x83\x82\n\xc5\xc8\xf4<`\xad\xd9\xf3ދ\xf1Я\x9a\x92]\xb008\x00`\rtW\xac\xde\xf2\x05\xf7\xf2\x18\x8fZ=\xe6\xfd\x16\x9eĮj\xf8\b\x00\x00\xd80\xa9W\u05ee\xa6\x15\xa8\xf7\xeb\xa0h'e\xbe0\xbc\r\x00G\x8d\x9e\xf2\x97\xa8m>\xaf\xdcF\xfb\r\xab\x95\xc3\\y5\xda\x00\x00\x00\xb0}B\xefm]\x95ս\xe3\r\x00kǓ\u05ecI\x93SPQ\xac&]\x04;\a\x00\x00\x1b(\xd7V\xd7\xfb\xa2\x9c\xde\x15\\\xae&\\ZL\x9f\xbb+\xca\xcd\x05\xbdf9\xd1M\x87\x13\x9d\xbc\xf8\x80\x8a\xa7~R\x8b\x0f\x9c\xcc\xcb\x0f\xbe&\xe7O\xbc1\xeb\x81w\x91)\v\x1c\x13\x8b\xfb\x7fs^-B1Q\xf6Dv\xc2w`\xabŚ~9\xe0(\x05\xdf^\xd7e\x9f\r\xe6|\ue007\xceL\xbf\x006Z(\x95\xfaJ\xb5\x17\xf5pŎ\x89\x16Z\xaa|\xedO\x10\x9dc\xd0=\xfc=9\x06\xdf\x1c\xe5\x8cz\xfbnx\x9eP\xb0\xefM \x91\x1dXo9\x0e\xdd0\xd1\xe3\xbd\xd4+\x16,`\x02\x00X\x03\xcdE\xe5th\xf1\xa8\xcf\x0f\xf2~\vOb\xf7\xc5X|\xb1\\\x12\xd9\x01\x00\xd8DY\xd1\xea\xffR\xa9\xb3\xba\xbe\xb76\xac\x0f[\xa4\x85\xca\xe6\xe1\xd5\a\x00\xe0\x88\xd1S\xfe\x12\xa5\xee1\x05\xdf\xe9D\xb55\xe6\xfc\x8e\xfd\xd61\x8d\x13\x00\x00l\xa1`aPg\xb1P9y\xdbx\a\xc0:Y|\xfa\xf7\xe4R\xc9Β:\xdf=Ʒfw\xc362\x00\x00`\xf3x\x17\xb0\x1d\xbe+\xbb/\\\xe3u~\xf4I+K\x8b\x06\x0e,\xb8\xf7\xfb\xf6\x91ܨJ\vM\xbb+\x9a̟T\xb8\xf49\xe9\xdc\x03:\xff\x91\x90\xaf\xdc7\xcb̓\xbf,\xeb\xd1?H\x8f(pD\xba\xe6#\xab\x934S\x8e\x81\xf0\x1d\xd8r\xc5\xec\x9d\xe3\x19\x80\xa3P$OR\xb4\x93\xdcZ\x9d\xec\x13\xc1-\xde\xf6\x05`\xad\x8e\x06\xb0\xc1\xbc\xbc\xf7\x85|m\xd8Վ\xecS\xb5\xc5t\xf5\x1e`\x96\x8b_\xb4:\xa1\xb3cWY\v\xfb\x8eة\xf7\xc7d\xbaS\x80u\x96\xed\xaf\xa0\u008e\xa0\xde\xcaxY\xbd}|\a\x00\x80\xe3+\xe7}\x8bG\x97\n\xd1;0\xcc0N\xe8\xb9\x0f\xf6J\xfa\b\x00\x00\x1b)\x14A1֪\xe2Ī\xfb\xd2\xea\xfd\xe0\x13\xfe\xa5\xc3\xfb\xa5\a\x7f'\x1dT\x00\x8e\x1c-\x91\x97\xaa?\xaf\xd2w.\xf2Nɡ\x1d\xd7\f\x03\x0e\x00\x00\x00['fk\xdbNU\xbc\xe9\x7f!\x1a\x02\xd6P\xea\xeeS\f\xc9\x1a5\xbd\xb2O\xb2\xbd:hE\x03\a\x00\x80\xcd4\xf6\x00\x0f;8\x0f\x17\xc5P\xed\x87\"\xab(-\x0e(캬\xa4z*MwVG}\xd2>z\xc6\x02\x87\xd3:{X\xe9\xc4\xc5J\xe1\x89\xfbu\xf0\xf8\x9f\xd6\xfeGo\xce\xf3\xfbߗ\xbb\x87\xfe\x13\x06\xb9\x80\x1b\xa9\x7fd\xf5\x1a\v\x85\xe0k\xa9\x13\xbf\x03۬\xb8\xe7/\xf1\x10\x00\x8eH~\xe0;s\x99\x9a\xe1<\x05Od\xb7\xe2葱\xd5\xcf\xc9'\x12\x00\xd8XC\xb3:e\x05O\x00\xb1\xe2\xde\x17I\xb9bW^<'\xb5\x9f]\r\xb5X\xddЇ\xc67n^I>c\x18\xc0\xfa\xb2\x87\xbe\x8f\xa9\x9a\xde\xfe\x8a\xd3\xf7\x0e\xe7\x00\x00\x1cg!v\xc3X\xe0\xb0*\xaeť\xb9\xf7yBއa\xf7\xf2\xd5@\x15\x00\x00l\x94\x90\x14\xac\xfd\xea\x7f\xf9\xd4 \x9f[0X\x9e\x93.\xfd\xdc\xea\x1c\x00\x8e\x10#\xa9/\xc5\xf9\x1f\xca1\xfbJ\xb9\xfe\xdbe\x8d7\x1ft(\xf6\x95\xe5\x89\xed\x00\x00\x00\xdb&)ǝ\xf1\x1c\xc0\xba\x89z\xc0~\xfa\xec\xdadm\x9bj\xf5\xea\x8d\x1cR\xd1\x00\x00\xd8L\xc1\x17\xe4\xf4\t)\x16\xc7\x0f\x13T\xec\xf0~\xce致\x9c\\QS\xed\xab\x89\x87j\xb5P\x1b:;\xef\xd4\x15\xad\xda\xd2\xfe\xde\xfen\xfb\\\xaf\xaa>\xaf\xddxI\xf5\xe2\x9c\xe2\x85O\xa9{\xfa\xa7\xb4\xfcЭ\xf9ʇO\xe4\xfe\xd3\xdfH$\x01\\ge\xb7\\\x9dxy.|\x87?v~\x04\x00\xe0(\xf4\U000cfa94\xc7\u0605\x85ֽ|w\xceaG\xb3\x90,\xe2f\xfe\x00\xb0ɲ\xb5\x97\xa3oa\xd4{\xbfz\xa966\xaak\x92\x19\U0005c8bb\xb8\x1ar\x89\xfb\x8aEV\xea\xe2\xb0\x10ٰK;\x80\xb5\x95|\x1c\xb5\xb7xϋsa\xe7\xaf\xfdC\xf6\x03\x00\x80\xe3k\xfe\xd0o\xcaC\x02\xfbx\f\xb9\xebɫ/\vV}\xb3\x8bЮ>\b\x00\x006K\xbfP\xe8\xbcs\xaa\xb7:\xdf\xea{\xcfc/V\x9b\xf9\xaa{\xcc?\x01\x00G\xca\x1fG\xf8\n\x96\xf3\x8f\xaaH\xdex\xb3\x8bna\xed\xb8\x1d\xa5\xe8\x89\xec\x93\xd5\a\x00\x00\x00\xb6I\xee\x95B=^\x00X;\xe9Q;\xac}\x93\x93b(\x95\xbc\xd3\xca\xf9\xea\xcb\x00\x00`\x03\xad\x12\xd9}\xe2\xf4\xb0[\xa4wr\xfa\xeeq>a%\a\x95\xed\t\xc5\xe5\x9e\x1d\xbb\xcami\xe1\xbe\xef*y\xa8\\^\x92\xea\xf3\xeaˇ\xec\xef\xf7I\xfaw\xd8\xdfz\x9b\xeaj\xa2I=\xd74\x9eפ\x7fZ'\x0e\n\x15\x17~V\xdd/\x85\xbc\xff\xb1S\xf9\xca\xfd\xff6I\xed\xc0uP\xf5S+\xb3v\x12\x97\xf6\xb2\xb4s\xc6'\x00\x008\n\xb9\xfd\xdcЯ6L\x04\x1f\xe2\xeca[\x93\xe1\xbdD$\fl\xb4\xecI\xec\xd1\xda\xd8ަλꊥ\xa6o\xf8Ioh\x03\x83\xe8\x13\x85}\xa8%^\x1c\xd6\x0f\xcc݉a\xc2p\x16\x89B\xc0:\xf3>\xd5\xd4\xfb\xe3\xde\x17\x17\\\xdd\x03\x00\xe08\xeb\x0e}\x83\v\xe7c\x81\x1e\x90\xfa\x02Kv>tat\x16\x9fZ\xbb\x06\x00\x00l\x9elU}j\xed\xb5U\x1f\x92Z\xef\x9f*v}]^{c\xa1\xf9}\xdf\xcc(\x06\x80#\xb5\x1aQŗ\xb5l\x1f\x19'v\xda3\xbb\xf7$\x8f\xe9\xb0qQ\xf6\x95\xd5\x01\x00\x00\xb6M\xf6x\x888\bXW\xd9'Yx9\xb6#\xc6q\xb6\x85\x0fXyr;\x00\x00\xd8@^Ƿ\x16\xc2\xe7U\x9d\xef\x1d\x9bv+\xf7iȿ)t\xe0\xfbȩ\xccQu\x7fҎ[Uu7\xa9jO\xaajv4\x9f\xf5:\x9c]ҼzL\xcb\xfc\xa4\xda6(-NI\xcb[\xed\x1f{\x9b\xfd\xb3|\xe4kb\x7f\x7f\xa5\xbd\xe6\xb2v/\xff+\xf5\xbfxS\xce\x1fzk\xd6G\xbe\x8eA0\xe0Up\xf8\xd0\xef\xcdE?\xb1\xb2f\x17\xa1\x1d\x86*\x94|\x81\t\x00\x00p\xc3\xf5\xe7\x87y\xe0\xab-L\xec\xc5\xeb\xe51\xea\x1d67\x03\xb0\xb1|\xf1\x8aa\xf7¡\x1d<\x1b6\xe7\x05\x9e/d\xfbRx7\x8c\xefx\xe5_\x13\xfb\x9e\xa8\bVm\xd0=\x02\xac\xb7h\xe5\xd9ʱ\x1f\u07bf\n\x00\xc01\xd75O+\xfbj{\xe3\xa2־\b_\U000155a2]\x0f\xe3\x85\xdd\xf8I\x00\x00\xb0Q\xbc\xbfr8\xd2\x10\x06\xb49\xa9\xf3\x8d+\x86L\xf6\xa4\xc5\xe5\x7f\xed\x9f\x02\x80#\xe3\x8f(|\x05\xed\xe5s\x9av\xfe\xe0\x0e\xeaw\x96\xea\x8aVe\x7fZE\xbbX}\x00\x00\x00`\x9b\xf4\xa5\xe2\x99_6^\x00X+\xf7\xfd\x1by־Imy\xda\xda5\xb5B\x7fŎJ}h\xachO\xc7\x0f\x01\x00\x80\x8d\xe2\xbb8\xa7\xc98Y\xc5w\x00\xf3]\x16:\x05\x15\n\xb9\xb6{vx&N\xf0I\xd6\xfb\xf6zɮ\x0f\xc6\xcf%\xed-\xa7\xdaYL5\xb3\xd7I7\x95\xa7\xce\xc6b)\x95\x97\xa5\xc2>;\x99\xdbkg\x1f-\x86\x7fWL\x95\x8ath\xff\xb8\x87\xa4\xe5Gt\xf8\x81\x90\xfb\x8fܛ\xf5\x99\xefb\xd66p\x8d\xe2\x95\x7f\xac\\\x9c\xb3\xb2vFˢW\xe1#;M\xb9z\x13\xc0F꽮M\v\xab\x97\x83\x961(\xa5\xd3vn\xd7Ӆڝ\xdf6~\n\xc0Q\xa8|\x8a\x85\xc7\xd8!\xaa\xb4\xf88\xf4\x167{\\\x9c,n\xee\x99\b\x0el\xb2\x1c\xf7\xac\x9d\xeb\xc1\xb8\xb5\x99\xe3\x13j\x96\xafY\xbd\x01\xb8\x8fݕ'\x85o\x8crR\xa9\xddQ\xbd\xb4\xa3\xbeh\xf5Cg\xed9_$\x1c\xc0\xba\nu\xa3\xa2\xb76Z\x98\xa9?\xf9\xf5\xe3]\x00\x00\x8e\xb1|y\x18\a\x1c\x16a\x196\xac\xf1\x15\xae\xed\xbc\xf5#*d\xe6\a\x01\x00\xb0\x91\xbc\x8e/\x9b\xa1\xbe\xafکv,\x06\xa8\xba\x03k\xd3V\xf2M}O\xc7\x03->\xf6+\x98\xbb\x03\xe0Ȑ\xc8\xfe\x12\xc4|h?}\xc9\\\xe3\x8f\xecaYe\xbb\xe6w\x0f\x00\x00l#\x8b\x87\xea\xea\xf6\xf1\x02\xc0Z\xe9.)\x0f\xed\x9bN!d;|\xe5\xe5`\xd7Q\x81\x06\x0e\x00\x00\xb8\x0ev\xf2\x9b\x14\x17s\x1d\\\xfc\xab\xba\xf8\xd1[\xf2\xa5\xcf|Cn\x9f\xfa\x11\x06ƀ\x97!\xe4\xb4\xda\xed\xf5\xf9\xc28f\x01`CY\x1b\xddw{\xce\xdef\xb7g\x80\xb7\xdd\xed9\xd0\xdbQT\xa7V\x1f\x01p\xc3\xf5\x9f\xf9n\xe2X`\x8beO\x00\t\xa5\x1dva\xc7tz\xcb\xea\r\xc0\xf5\xf6\xfdH\xab\xb1\x97\x951Yh8\x18\x7f\x01\xd6Y\xf6v\x99?\xfb\xf3\xa1\xea\xfau\xab\x9b\x00\x00\x1cc\x05\xf1'\x00\x00x\x01\xc1b\x84\x90\x9f\x18\xaf\x00\xe0ƣ\xa5\xf2\x12D]\xb1'\xb6wH\xa6qn\x98O\x1e\xf1\x01*&\x8a\x01\x00\x80\xed\x93r\xa1x\xf7\x9f\xf6\xa1Z\x00k&w\xe7ԧ}k\xde,\x15\vOd\x7f.\x99=2\x90\x05\x00\x00\xae\x87\xe2~\x85\xf8\xa4v\xf2\x81v\x96Ϩ\xba\xfc\xf3J\x8f\xff5-?\xf6\xf5\xb9\xfdĿO\"\x10\xf0\x12\xc4\xd4[0o'\x9e\x00qU\xf4]T\x00l\xb4\xe0\xbb&\xd9K\xee\xed\xd4N\xac\xd8{ɏo\xfec\xf4\xcb\x01G\xa4m?5\x9e\x01\xd8F9uV1{?\xba\xd5\xcbq\xaa\xe9ΛWo\x00\xae]\x0e\x89\xec\xbe\nY\xb4\xafI\xbe\x9a\xc4nV\v\n\x03XW\xa9\xb5Bmͳ>/U\xde\xfdW(\xd0\x00\x80c-?\xf6\xbbr\xc9\xfc\x1f\x00\x00\xf0BҎB\xff9->\xf7\xed\xabN+\x00\xb8\xc1h\xa9|%\x0f\xfd\x8e\\\xe4\xb9\xfdN\x8d\x89\xec\x9e\xe4\x91\n;\xef\xec\x1e\xcfn\x00\x00\xb0}\x9abo<\x03\xb0n\xfa\xfe\x92\xfd<T\x88\x9d\x82\xef\x06\x92\xac\x9dc/C\x12;\x13\xa9\x00\x00\xc0\xf5\xd0\xd9a\xf1\x86\x87\x1au\f\xdaɝ&ͣ\xaa\xf6?\xa0p\xe1\x9fH\x1f?\x9b\xf5\xc07\xd0\xd1\n|\x19!-퇟YQ\tI)\xf9\xd0\x0e\x89\xec\xc0f\xf3rnG\xceV\xfc\xbd\x9aL\xc3\xcf>\x9c\xf47\x01\x1c\x91\xb4\xfc\xe4x\x06`\x1b\x15Î셝e\xb5\x9a\xa8\x9a\xbds\xf5\x06`Ro\xed\xb6\xa1\x03\xc4\xdbm\xab\xf1\x96!\x99\xdd\x1bs\xc3\x02\b\x00\xd6U\U000bd38aJ˂\xb2\f\x008\xfe\x16\xf3O\xabf\xf8\x00\x00\x00\xbc\x90\x10\x14\xfb\xb9ڃ\xffs\xbc\x01\x007\x16\xbdk_A\x9a\x7fT\x95\x9fd\xfb\x99;;\xe20\xe60L\x12\xf3%t\x01\x00\x00\xb6L\xaao\x1a\xcf\x00\xac\x9d\xd0\x0e;\xb1Gk\xd4\f\x9b:vָ\xf1d\xf6aR\x95\xcf\xc2\x00\x00\x00xu\xa5x\xbb\xfap\x93\xfa4S\x1e\x16\xd1Ye\xb5\xc7jW\xe5䌴\x7fA\xe9\xe9_P\xff\x81[\xb3>\xf9UY\x8f}\xff\xd0\xfb\n`\xa5{\xfc{r\xe8[\v\xdeW\xd7yH\x8a\xa8\x87\xd8\x1e\xc0\x06\xcbVg\xfa\xa2sø\xa4ם\x9dէV\x8d\x96g\xc7\x0f\x008\n\xb1}b<\x03\xb0\x8db\xb0:9\x17\xc3zm\x8b0\x95n\xff\x11\xefX\a\x069\xf7vx;\xcd\xc7ZV\xe3-\xde\x0f\xb2Jf\a\xb0Ά6\x99*\xdac\x00\x80\xb5\xd0-?\xa7\xd2'\x04\x01\x00\x00|\xb1ب\xec\xa4Yw^\xdd\x03\xdfE\xa7\x15\x80\x1b\x8eL\xec\xaf \xcf?\xaf\xca\xfb\"=\x91=t\x1avd\xf7yb\xb1W\x96\xaf\xb4\f\x00\x00\xb0]\xe2\xec\xae\xf1\f\xc0:i\x1f\xfa\xee\\\x84\xd2\x1a\x81֎ɥB\x8a\xca\xc3N\x8e\xc6'`\xf8\xc2]\x00\x00\x00\xaf\xb2T\x9c\x93\x8a\x85b1\x95\xc2\x19\xbbs\xd6nN\x94\xfa};\x9e\xd2ay\x97\xc2\xf4\x84E(s\xe9\xc2\a\x95\x1e\xfd\xe3\xea>Ue=\xf6\x9f3h\x06\x98E\xf3Q\x85\xbc\x1cc\xf6\xa4\x10\xb2z\x1f\xaf\x88\xc4\xef\xc0&\v\xbe۫'\xb3\xfb\xb8d\xccV\xfc;\xe5hm\xfa\xea\xe6\xf1\x13\x00\x8eB\xdd5\xe3\x19\x80m4\xf4\xa6\xa7\xa0ު\xe9\xbe<9\xdc\x03\\\xfb\xe0\x7f\x90}N\x99|\xb1\x83\xab\x87\x19\xe6\x98\rc2L\xcf\x03\xd6Y,<\x19\xb0R\x9c\xbe~u\x03\x00\x80c,\xb7\xe7\xed\xc7r\xbc\x02\x00\x00xN*W\xed\xdb2I˃\xbf\xbd\xba\t\x007\x10=\xe5_Ah.)\xe6\xa95\xea\xecH\x9d\xfd\x8e\xf5\xc3D1\x9f8\x922\x89\xec\x00\x00`\xfbT\xd3\xdb\xc73\x00\xeb\xa4[~xH\\ϩ\xb4\xf6MeGm\xcd\x1a_\xb0ˮ\xbdi3N\xac\x02\x00\x00x5\x95m\xad\xa2M\n\xdd\x15\x8b?\xce)E;\x8aC{\xc3\xe2\x90\xf2\x84ʸo\xf1\xc9\xc2⑥4\t\x8a\xe1\xb4\xcaKw\xaa\x7f\xf4oh\xfe\xc1\x90\xf5Yvh\xc7vk\xbb\xcfjؑ\xfdy\xb2\xc5\xf3}`G\x15`\xb3E\r\x1by\x86bh\xb2\xa7\xdc\xdbi\xa9b\xc2\x0e\x80\xc0Qi>\xff\x1bs̓\xf1\n\xc0V\n\x1e\x8b\xdbK\x15\x15\xeb\x13\xe3M\xc0\xdam\a\x9fVQD\x9fJf\xecK2|Q\x9e7\xe6®\xec\xc0Z+\x8aV}*T\xd7o\x1e\xef\x00\x00p|\x15\xa9\xb1\xf8\x93\x85\xf8\x00\x00\xc0\x97Z\f\xfdU'\xa4\xb6R\xe8\x9eX\xdd\x04\x80\x1b\x88D\xf6\xaf v\x9d5\xe8&vT>\xd4`|\xc0a\xf5ʎ\xec\x00\x00`\x1b\x15\x95\xef\xa2\b`\xddt\xdd\xc3J\xe3.\xec!y\"{\xa1a\x87\xb7\u008eav\x15\x890\x00\x00\xe0:\xf0EA}\xb6\xbf,\xf6\xb0\x18$\xf6\x16\x87\xc8\u2458\x14\xcaVu{Q\xb1\xbd,-O\xa8kΪ\xd1\x15\xb5\xf5CRuA\xb3|\xabғ\x7f\\\xe9Cw\xe6\xf4\xd9\xef`\xd67\xb6Rם\xb7\x9f_\xbc\xe8\x94\x0f\xedP$\x80\x8d\x97\xc6r>$\xcd\xf5\x8a1*\x96\xb3\xd5=\x007\\\xd3\x7f\xda\n\xa3ǲ\x00\xb6\u0590\xa4l\xedٲP1\xd9\x19n\x01\xaek\x9f\xb6\xaf\x86\xc5n~</i\xddwd_az\x1e\xb0\xd6bR\x97\xa2\x8a\xea\xd6\xf1\x06\x00\x00\xc7W\xf0\xf1\x04\x12\xd9\x01\x00\xc0\vh\xbd\x8f*\xd4R_jb\xa7O}\uaad9t\x00\xe0\x86\n\x99U__Tz\xe4?\xcc\xf1\xf1\xbf7^\x01\x00\x00l\x87y<\xa1YxZjN(ՍRhT\xb6{RuEm\xb2\x97\xf7竳.\x00\xac\x91\xcb\xf7\x85|r\x7f:^\x01\x00\x00\xac\x89\xfe\x16\xfb\xf1\x94R\\\xaa\xafO)\xec~\xa3\xca7\xfd=\xda$\xd8\x1a\x87\x1fyc\xdeY|FjoV;9\xa7\xbcS+_\x99iR^\x94\x12\xf1=\xb0\xb1\xbaR\xda\xdbW\u07fcN\xb9}H\xe5\xac\xd0A\xdbi\xf7\xab\xe9\x97\x03\x8e\xca\xe5_\n\xf9d\xbfgg\xdd\xea\x06\x80\xed\x13z\x8b\xc1\x93\x0e\xca^\xbb_E\x9d\x8c\xe7\\\xf8\xf0\xa9|\xa6!Y\b\xd8X!i\xd95\x9a\xfc2\x9e\xfd\x00\x80\xe3m\xffS_\x9d\xf7\x0e?$\xb5\xbe\xa1\x05\x8b)\x01\x00\x80/\x92\xceH\xb3ǥ+g\xed\xc2b\x85\x9d+ҙ\x1f\x90^\xf7ôw\x01\xdc\x10\xb4R\xbe\x8c\xe5\xc2w:\x01\x00\x00\xd8.C\x80蛽\x85\xac\xd5P\xac\xddɽ\x1d\xf6+\x9e\xf4\x1b\x00\xd6P\xec\xcb\xf1\f\x00\x00`\x9d\\\x92\x8a\x13\x8a\xc5\x19Uݡ\x9aK\xff@\x97?\xfe\x9e\xdc=\xf6\xfbX\xa1\x15[ba\xeds\x7f]%\xcd\xe5\x14\xbc\xb9>\xde\x03\xb0\xb1B;t\xc7%\x1d*\x86\xca;\xe5\x94C1\xbe\t\xe0(\f\xfd\xe6Կ\xc0v\x1bv\u05ce\xd6>\x9d\xad\xae\x81QA\x0f\x05\xb0\xd9rV(o\x1a/\x00\x008\xbeB\xdfX\xbde햡\xed\x02\x00\x00\xf0E¾\xd4[\x9cP\x9f\xb7\xf3R}[)\xf5\x1f\x1c\xdf\x04\x80\xeb\x8fD\xf6/\xa3\xdd\x7fr<\x03\x00\x00\xd8\"\xa9_\xbd\x0e\x9d\xda\xd9\x02\xc6\xc2^V\x89\xec*\xef\x19\xde\x02\xb0~\xa2\xef\xe6\x06\x00\x00\xb0f\xba0\xb3\xb6\xc9\xd2\xce.X\x9b\xa4\xd5N\xd7jo\xff#*\x9e\xfcK\xd2\xc7\xee\xc9\xf9\xb1\xeff\xba86[>\xb02`\xafEg\xcdtk\xa3[\xf3\xdc_\x876:\x80\r\xd6I\xbd'\xc9]P\f\x13;\x8fJ$\xcd\x01G\xaa\xd6t<\x03\xb0\xed\x8a\xf2\xf5\xe3\x19`M\xb3Ǿ#\x97l\xd2\fl\xb8\xac\xa2\xe6\xd9\x0f\x008\xfeb\xbf\x94R\xb6\x13\xe2S\x00\x00\xf0\xa5r\x9eۏ\x1d\xa9\xb2\x8b\xa2Q\xee\xb2\x0e\x0f?\xb2z\x13\x00n\x00\x12ٿ\x8c\xd0>=\x9e\x01\x00\x00l\x8f8\xee\U000a642cњ\x15si\r\xd7^\xad\xb5\\\xe3\xf4\x9d\xab\xf7\x00\xac\x95\xf9\xa7~}\xaeّ\x1d\x00\x00\xac\xa1X5\xeau \xfb\xe1\xdb\xd2Z;e\xd7\xda,g\x15\xe6\xfb\xea/<\xac\xfd'\xff\xbc\x16\x9fy')\xbd\xd8H\xf9\x81ߔ\x95\xc6D\xf6\xd8+\xe4h\xe5`ܑ=\xb333\xb0\xd1bV\xe8O(\x86\xd6\xce'JV\xfeCy\xf3\xf8&\x80\x1bm\xf1\u0fdb+\xd5V\x0f{@\n`k\xe5\xa0\u0382\xf3r\xf2\x8e\xf1\x06`u\xc4\xf2#\xaa{\xa6\xdf\x01\x1b\xcd\x17\x16\x9c\xf1\xec\a\x00\x1c\x7f\xb1\xdb\x1f\xc6\x10\xc8\x0e\x01\x00\x00/$x>@<P\xf6Ŵ\xcbˊE\xaf\xbc\xb8\xa0\xc5c\xbf\x8197\x00n\b\x9a*_Ǝ\xe6\xe3\x19\x00\x00\xc0\xf6\x889I\xc9\xc2DOd\x1f\xb2E|鵬\xc6^\xcb7\xfdO,\xd9\n\xac\xa1\xbe\xfb\xa4J_u\x19\x00\x00`\xdd\x14\x17\x14\xa3\xb7In\xb5f\x89\x1dCLsE\xaa\xa7*v\xef\xd4nX(?\xf3\t\xed\xffR\xc8\xfd\xfd_O\xc0\x83Ͳ\xbcOE\xf6-\xd8\xed<&\x85P\xd8uT\x11\xec\x1e;\xfe\x01\x9b\xad\xf02^\xaf^\x14\xd5ۃ T\xf7\xf8\x05\x80#\xd0\xcd\xef\x1b\x16\x92\xc9y\\\x04\x16\xc0v\xb20\xbcW\xa1\xf8ƿI0\x8eguˇU\xf6,t\x02l\xb2\x1c\xad=\xf6\xa6\xbfʳ\x1f\x00p\xecU\xc9\x13\xd9}\x96\x1f\xe9!\x00\x00\xe0\x05x\x88\xd0\x17\x16.dk\xebv\x8a\xb1P\x19.K\a\x1f\\\xbd\x0f\x00\xd7\x19-\x95\x17\x91\x9f\xf8\xbe\\\xf8.\a\x00\x00\x00[ƛ\xa8\x1e&\xe6\xe8;\xb2{R{1$\xb5\xf7q\xba\xfa\x00\x80\xf5\x93\x9e\xb4\x1fL\xb4\x05\x00\x00khi\xed\x11_\x15\xba\xec\x94\xebVm\x15\xd4\x14Y]5\xb7\xebs\x8a1j\x16\xa5\xbd\xc5L\xc5şU\xba\x7f\x92\xbbG\xffS\x12ڱ\x11R\xfb\xb0\nk\x93\xe7\xdeʀ\xb5Ӄ\xcaaW\xf6`mtk\xb0\xaf>\x04`#\xe5\xe0\xe5~\x1c\xa7L\x9d:\xab\xfb&ӷ\xae\xae\x01\xdcp\xb1\xbdb\x05sae\x93DE`\xeb\x95^G\x03\xcf\xc9\xcd\xe5!Y\b\xc0\xe6\xf2=\x00\x00\x008\xee\x0e\x1e\xf8-9慝Eub\xfc\x00\x00\x00\xbc\x80ЩknR*\x16J\xb9\xb2\xeb\x99fE\xb2@b_z\xe0;\b \x00\\wt\xb3\xbd\x88C_Q\x84\x1d\v\x01\x00\xc06\x1a\xb6\x96\t\xca9\x8f\xf3⭱\xea\xe7\x85\xef\x82\b`\x1d\x15\xc9\a\xab\x96\xab\v\x00\x00\x805\x12\xfb\x9b\xa5\xaeU\xaf\xf3\x16\xd4\\TUV\xaa\x8b\x93v\xafW\xb3\\(w\xc9\xda0\xbb\xd2\xe4\x84}\xfafu璖\xe7\xfe\x8a\xfa\xcf~\r\x9d\xbbX{E\x7fAe\x9e(婒\xefƜ\xeb!\x91]\xbe#;\x13р\x8d\x96ra?\xac\x1d\xefE\xbd_*\x94I\xd5=\x7f\x8e\x1d\x00\x81#R\xb6\xde7\xde(F\x8a!\xb0\xd5bT\xa8\xea\xf1\x02X)z\xab\x1b\x12\x99\xec\xc0&ˁ\x18\x10\x00p\xfc5\x8b\xcfZ\xa5\xd5HVo\xf5\f\x1f\x00\x00\x80\x172,\xa4\x9d\x86L\xd2!]2u\x16?DU˅\xfa\xf9?\x1e>\x02\x00\xd7\x13\x89\xec/\xe2`\xf1\xa0\xd43\xd0\x00\x00\x00\xb6UT\x0eY>5~\x980\xebc\xb3\x81Dv`]\x85lm\x1b\x06\xaa\x00\x00\xc0:\xaa/*\x84R\xb1\xddS^T\xca\xddEɓ{\xdb]M\x9a\xdb\x15\xfa\xb3j\xfbV\xcb\xfe)u\xf1\x19U\xd5D\xbb\aw\xa8x\xfc\xf3\xd2\xcf\xd5\xf9\xdc\xfd\x7f\x94(\b\xeb\xcbW\x97˥\x1dS;\n\xbb1\x0e\xe9\f/\x8c_\x00\x9b\xacOA)/\xad\xa8\a\xe5\xd4+\x14$N\x00G\xa5y\xfa\xf7\xe42ի\xbe5\xaf\x8e\x01l\xb1B\x05\x8b>\xe3\xf9.\xfe`\x0e\xc9*\x87\xd5\xca\xe0\x006\x15\x8b\x19\x01\x00\xd6@\xea.\xadN\x86Dv\xe2S\x00\x00\xf0\x02\xfa\x99T^P̷(\xab\x95\xf2\x81\xd4ޥ\"\\Tj\x1e\x1e?\x04\x00\xd7\x0f\x89\xec/b\xb6|P*h\xc8\x01\x00\x80\xed\xd3Us\xa5zb\xa1\xd0D\xb3\xfd\xb3\xd2\xe4I-\xab\x9b4=\xf5-\xe3'\x00\xac\x93\xfd\xcf\xfc\xf2\\\x86\x03)\x9c\x1c\xef\x00\x00\x00\xac\x91\x14,\x8eI\n\xb1S\xf4\xdd\uf184މT\xb4\xc3\x00\x9bt\xa8*\x06M\xc2Te\x9a\xdaG{\xbb\x7f^\xaa/I\xb1\xd0MO\xff\x01\xe9\x83o\xc8\xfa\xfc\x1f\xa4\xb3\x17\xeb\xe5\x91ߟ\x87\x04\xf6\xb0P\x11\xf7\x15\x87\x81\xe4\xb9\x1d\xab\xc4V\xd9w\x1e\xc0\xe6\xaas\xb4z\xcc\xea4\xab\xf3\x82\x16Z\xda#\x00\xc0\xd1HO\xff\x94\xe2\xc4\xe2\xcb|R\xea(\x8c\xc06\xeb\xfbV\xdd\xcd\xff\xc1x\x05HW\x9e\xf8;\xda\t\x97%\xdf\xcc\n\xc0\x1a\x9b*%{·F\xa9Z(\xc5V\xd9o\xa4\x89ŀ{z\xfa\xe4\xaf\x19?\a\x00\xc0\xf1uS|\xc0\xea\xad3J\xe5Buf\x11\x16`\xad\x85\x9b\xd4w\xb5\x9a\x9ce/\xea\x8a^\x8a\x16\x9f\xb2\xe0-\x80W*\xceU\xaaR\xd1\\Q\x9d\xa6Ü\x1aŧ\x86\xb9\a\x95]\x1f~\xea&\xe6\xd5\x00\xb8\xaeHd\x7f\x11\xd1G\x19\x02;\x9a\x00\x00\x80\xed\x13\xac\x19\x1a|\xa7\xb7\xd0)\x94\xad|[\xf6>,T\xc6;\xc7O\x00X+ia\x87\x9f\xd0\xc7\x04\x00\x00\xb6P\x15\xd4w\x8fjq\xfe\xaf\xa8y\xe0\xdb\t\x88\xb0>\xda+\xe3\t\x80m\x15\xc28)\xcd^\x8axju\x0e\xe0\x86K\x9e\xbc\xee\x89L\xde\xc1F4\tl\xb5\\\x15\x9a\xdc\xfa\xdf1k\x1cϊ\xa9[\xd5\r\xec\xd6\f\xac\xb9nl\x7fE\x854\xaeL\x11\xbdp7VƗ\xaa\xab\xbbV\xf7\x00\x008Β\xd5]\xb9\xb7ש\xd5k,\xc4\a\xac\xb5|^\ns\x15EV\xa1\xa0l\xc5ۛ\x9f\xea줵r\x0e\x00\xd7I<`\xb5F\x00\xd7\x17\x89\xec/\xa2TmA \x89\xec\x00\x00`\vy\x12{\xf6\xc6h'U\v\xa9/\x95\x8aF\xc5]\x7f\x98Y\x18\xc0\x1a\x8a\xddᰉ\xdbj\xc2\x05\x00\x00\xc0vi\xa7y\xd8\xcd}:\x7fT\xf5\xb9\xffU\xfa\xe87\x12\x14a=,\x9e\x1cO\x00l\xada\xc1\xedh\xaf\x85J\x12'\x80#\x93\xdbViHd\xf7\x8b\xd5\v\x80\xedԗ{\xe3\x19\xb0\x12\xfbq!\xe1\xc0\xf4;`\x9d\xf5j\xa5\xc2~\xe5\xdaW\xf8\x1f&Ԇ\x98\x94B\xa3>/t\xd3\x1d?\xc1<\t\x00\xc0\xb1\xb6\xfc\xcco\xcfC\xa6\xabw\\\xa4]\xab\xc8<\xe3\x15\xc0\xda\nsŲU\x11-2MA!Ge_@ͣRڟ\x00\xae\xa3\xfa\xb0P\xfb\xd9_\xc1H\b\x80\xeb\x86H\xe6\x05\xec\x7f\xe2w\xe5ғ\xb7ؑ\x1d\x00\x00l\xa1\xe8\x03\xb4&{\xa7v\xd9*\xa7\x89r\\\xdd\x03\xb0~\xca\xf6\x8a\xa2\x8fQ1P\x05\x00\x00\xb6PWΤbb\r\x9d\xb3\xd6șJ˟\xd6\xfc\xa3'\xf2\xfc\x91\xdf\xcb\xe0\x1b\x8e\xb5~\xf1\xc8x\x06`+\x85\xa0\x14\xfaaRZ\x0e\x95\xea\xea\xf5\xe3\x1b\x00n\xb4\xd8u\xca9+\x17\x1e>\xb2\x1b\t\xb0ͺ\xe9\xed\xe3\x19\xb0\x12\xfb\xf9\x98Ⱦ\xba\x06\xb0\x9e\xb2\x1ak\x82\x15vf\xb1^\xef\x8b\xfe\xfb\xdd^\x9d\xb5\xc9\xfa\xc2\xef\x03\x00p\xbc\xb5ˏY\\j\x81\xa9\x1d9O\xe4\xf9\xae\x00֘\x85\xa0!\xd4J\xbe\x01U\x17\xacəTԽTgY\x11\a\x80\xeb&vK\xa5\xfd\x9f\x1d\xaf\x00\xe0\xd5G\"\xfb\vH͇\x15\xb35\xe6h\xc8\x01\x00\x80-\x14|A\x9f\u070eW\xbe\xe8x\xa1X\xde9^\x01X7\xa1\xbbde\xbaT\x1efS\x01\x00\x00l\x97\u061cP\xd37\xca\xd9b\xa2\xc2\xda9\x16\x12\x95\x87\xfb*\x9f\xfc\x9f\xa5\x8f}\x13\xc9\xec8\xb6r\xf3\xe8x\x06`+y\r\xe5\vn\xe7\xa8.\x16\n\x937\xaf\xee\x03\xb8\xa1\xf2g\xbe=WÎf&\xda+;\x1e\x01[-\xd5\xef\x1c\xcf\x00\xa9\x7f\xfc\as\x95|G\xf6B)н\x00\xac\xb3\xe4\r\xb0\x10\x86\xf6\x97\xefvi?|\xe3K;\xa6Ruz\xfc\x14\x00\x00\xc7Wn\x1f\xb6\xfa\xab\xb7\x1a\xcd\xea\xb2؏w\x01\xac\xadP[0j\xb1h[Yю\x1a\xd6V\n\x9d\xfaܪ\xa1\xf9\t\xe0z*窖K\xe9\xa1_\xcf\xd3\x06\xc0u\xc1H\xeb\v\x88zH!7\n\xbe\x9c\x11\x00\x00\xc0\xb6\xb1\xe6g\xcas\x85\x18\x95\xfb\x89R̪\xcbw\x8co\x02X'\x87\x0f\xff\x96\\uW\xecl:th\x03\x00\x00l\x9b\xd0?\xa5\xd2w\xb5\xad\v\xf5\xe5\xc2nLU\xe5[U\xb5s\v\x96\xfeo]\xfa\xf4W1\x00\x87c)\xe4\xa7\xc63\x00\xdb)\x0fy\x14)F\xf5\xa1\x94\xee\xfa\xc3,\xbf\r\x1c\x81~\xf9a\x8b%W\xe7\xd9\x17\x97\x88\xcc\x1f\x00\xb6ى{\xffG\xeac<k\xb9\xf8y\xc5<\xb7\xb3J\x89\x9dR\x80\xf56̠\xb5\x1f\x9e\xbd>,\f\x9e\x94\xfa`\xf1\xdfIճ\xd7\xf9\x9b\x00\x00\x1ckE\x7f0\xbc\x86P(\x17\xfb\x8a\xa9\x1e\xae\x01\xac\xa7\xd4K9\xf9\"K\x85\xa2Ǫ\xa1W\xd7I\xf3&\xa8K\xa7V\x1f\x02\x80\xeba\xd2(v\xb5\xba\xfd\x9f\x1eo\x00\xc0\xab\x8bD\xf6\x17P\xeb\xa2E\x80\vk\xd91\x10\r\x00\x00\xb6QR\xd0\xd2^+u\xfd\xaeB٪\"\x91\x1dXK\xcd\xe2\x01_z\xd9\xcej\x9fr\xb1\xba\t\x00\x00\xb0EJ߁]\x85\x8a\xb8k\x87\xc5D\xd1\x13\x90\xfcfmM\x9e\xa0\xfa\xc2\a\xd5|\xf8-Y\x0f\xff0\t\xed8V\x8a\xe0\t\x11\x00\xb6\x99\xe7C\xf9.J=;@\x03G\xa6o\ueddf\xab2\x98\x92\xf7\xad\x95\xc39\x00\x00Ms\xdf\xea$\x96\xea2\xe3/\xc0:\v\xde\xe6\x1a\x1a`\xd9W\x16\x1c\xee\xa5\x1c\x15\xc3Yi\xfa\xfa\xe1\x1a\x00\x80\xe3\xacNc\xbeC\xa8\x94\xe3\x81\xd5i\xbb\xabk\x00k)\xabQ\x88\x9dB\xd1[\x9b\xd3\x0e%\xb5\x9d\x9d\x86۵\xbb\xf7\xeeՇ\x00\xe0z(\xacm\x1c\x82\xe2\xe2\x92\xf4\xd0\xeff\x0e\r\x80W\x1d3\x1f^@\xa1\xb9\xc7{\xf6\x00f \x1a\x00\x00l\xa7\xa1\xf5\x19\n\xa5\xae\x96\xca^\xba\x97]\x9f\x80uԵϬ\n\xb4\x95g\x00\x00\x80m\x14\xfb7I\xcbFyq\xc1\x82\xa3\xa0\x98N\xa8I\x97\xb5H\x17\xd5Ǔ\x9a\xc5B\xe5\xfc\xd3z\xe6\xfc\x1fѥG~\x13\x03q8>h\x85\x03\x18\x9f\x03\x9e\xcc\x0e\xe0h\xa4\xae\xb7\xb2\x98\x95s\xaf\xde'\x10xr\x13\x00\x00\xa6\xed\x9e\x1a\aT\v\xf5$\xb2\x03k-\xfb8\xaa\xc7yC\xacg\xe5\xd9\xf3ڳ\x8f\xad\xeeJ\xd5-\xc3g\x00\x008\xae\xe6\x9f\xfb\xc1\\j܁=\x84UfH\x9e\xac\xae\x01\xac\xa5!\x85\xa9h}\xa0\xdb\x0e\x8bO\x87\\\xf6\x89\xa6\xf5\xed\xd2\xd9w\r\x9f\x01\x80\xeb\xa1\x1b\x02\x89F\xb1\xd9\xd1\xe1\xfc\x1f\xaen\x02\xc0\xab\x88\x99\x0f_\xa4}\xf0\xb7\x0f}\x93*n\xf2\xed\vW7\x01\x00\x00\xb6Iߩ\xc8g\xa5\xe5B\x93\xfa\t]nY\xdc\aXW\xa7\xd3\x03V\xa6\xef\x94fO\xab\xe8g\xe3]\x00\x00\x80-\x12??\xec\xbe\x1e\xe2TJ\x9e\x11x\xa0\xba\x88\x9a\x86\x89\x8af\xa96\xf7\x8a\xe5\x1d\xba\xb9;\xa1\x13O\xfd\xcf\xd2g\xee!;\tG\xef\x91ߕ\xfbxj\xbc\x00\xb0\x8d\xbaY\xabb\xff\xb4\x8a|^\xed\xce\xe9\xf1.\x80\x1b\xea\xb3\xef˳\xdecH\x8b\x1b\xe3YUeg\xe7\xed\xf8&\x80M\x94b-\xd5K)G\xf5ݭ\xf6\xba\xb0\x9b;j\xe3B\xe9\xa6\xffh\xfc\x14\xb0rK\xbf#\x05\xfb\xce\xc4^\xb5}G\x00\xac\xaf\xca\xe7C\x84}\xa5i\xa3E\x95\xacAVk\xd2߬y\xf5\x8b\xd2\x1d\x7f\x8a\xa5\x06\x01\x00\xc7\xdal\xff\xa7\xac\x1e;\xb0\xe3&\xa5\xfe\x92\xbc\xfb\xa2K\fu\x01\xeb,\xa5\xa0\xc67\xa0\xaa{頒&R1\x99\xe8p\xefM\xd2m\x7f2\x9c\xbb\xed\xdb\xd4\xf9\x82js{/G\x1d\x94\x16\xb2Vv\x1e\xacm\x1aO\x8e\xff\x14\x00x\xf9\xca&\xab\x9d\x16\x9a\xcf\x0eU\x1d<(=\xf4\xbd\x04\x15\x00^U$\xb2\x7f\x91e\xf3\xccjբ\xec\xab\x18\xf1\xdb\x03\x00\x00\xb6\x90\xaf\xce\x1a\xac\xed\xe9\xab\xfbد\x9aUƁ\xb5\x15\xbd\x1b)$\rI[\xdeY\r\x00\x00\x80/P\xc4\x1d\xfb\xf9\x8c\xc5J\x9dbwJ\x97ϟӹ\xfb\xde\xc9`\x1c\x8eTߝ\x1f&\x9e\x00\xd8^æ\x9e\xbeӊ)\n\x12ف#\xd1z\x02kkqbo\xd5r\xb7ڠ3\xb3\xe8+\xb0ɲW\xc0\x9d\xc7\xe1^\a\xfb\xc4!\x13\x0f\x94\xecV|\xfd_$\x91\x11_\xa8o\xec\xfb\xe2I\x03A\xd1\xea\n\x00kn\b\xf6,\xf4\vW\xfbcz\x15\xcc\x1d\x05\x00\xac\x81\xdc/\x95\xbd\xff\"[L\xea\xf3\x83L\x18:\x17\x01\xac\xabaꮏ\x13zY.\xec\xb0\xe2݇FUy\xd7\xf0\xfeM\xaf\xfd[!Lw\xed\xbd+v\xb5\xa7\xca\xca~J~^Z\x13u9|\x06\x00\xaeI\xf0\x9e\xd1lO\x93\x99J\x7f\x0e-\xff\xf5\xf8\x06\x00\xbc:\xe8m\xfb\"\xcd\xfca\xc5a!\xf5\xc6\x1e\xc0\xfc\xf6\x00\x00\x80m\xe41\x90wn\xdbK!M\xa7\xaf\x1f\xee\x02X/\xdd\xc3ߓC\xb2B\xacΊ\xf4Ie\xfb\v\x00\x00\x00_(j\xa2>7J\xe9\xd0\xda@3Uj\xd4\x1f|\\\a\x9fx7\xc1\x13\x8eL\xdb<\xa2\"{,\x0f`[\xe5!\x89\"\r\xfdsu}\xe7\xea&\x80\x1b\xaa[\xfa\xe4\xcf\xde\x02\xc6fx\xf5\xf9\xe0\n$\xb2\x03\x1b-\xf8օ\xe3<!?\x8f\x16\x93ǥzv3\xc3\x17Y|\xfa\xb7f\xf5K\xfb\xbe\xd4\xe3\x1d\x00k\xcf\xda`\xd9\x02\xbe\xd2\xe3=O\x02\xb4\xf3\xa2x\xcb\xf8&\x00\x00\xc7Wn\x97Vm%%Ox\x1dv\xbb\xb0\xaal\\ \x13\xc0z\n!\f\xc7\xd07YuR[\xaa\x0f\x9d&\xf7\xfc\xf1g\x17\xd9\v{\xef\xb0\xf7\xfc$\xab^e\x9e\xda\x03\xe1f\xfb;\x0e\x87\xf7\x01\xe0Z\xac\xd6ڏ\xf6x\xb1'K*\xb5\x9c\xff\x8b\xe1>\x00\xbcZ\xc8\xd4\xfe\"\xb1}\xc2\x02\xb9\x1d\v\xea\xfaa%\x11\x00\x00\x80\xad\x13\x82\xd208k\xbfB\xa1\xaa~\xfb\xf8\x06\x80u\xb2\\\xfc\xe2ju\xd6\xd8[a\x9e\x8dw\x01\x00\x00\xf0\x05r\xa7h\xb1R\xf0U\xbc\xaa'5\xab\xb3n\t3M\xf7\x1fR\xfe\xb93y\xf9\xd8w\xd3I\x8c\x1b\xae\xed\x1e\xb3\xef&\x13̀m6\xb4\xe7\x87q\xcaRUu\xefp\x0f\xc0\x8d\x95\xbb\xb9\x15F;)|\xd1W\xdf\xd9\xcc\xcae\xcfB3\xc0&\x1b6\xdeM\xc3,p\x85\xd8\xd9\xcbd(\xfa\xa9\xbc{x\x1f\xb8\xaak?\xec\x01\x9b\xd5\r\x13%\xab+\x86\xfc\x02\x00k+G+\xc49\x0f\xa1_\xbc\xbapQL*\xab\xf7\xaf\xce\x01\x008\xcez_\x80\xcf\xfb\x11\xc7\xc3~E\xdf\xf0\x02\xc0\xda\xf2\xf1\x01Od\xcf)IEV\xee\xf7\xac\xed\xf9\x85\v\xa9\xc5\xd7\xffLX\xf8\xad\xfc\xb4\xbca\x1a\xac\xe4\xe7<S\xbf\xda\xd1\x13\x00\xaeI\x17\xa3\nM\xec\xb9r`W\x95\x82\x85\x19\xcb\xfb\xff]\x0f2\x00\xe0U\xe1\xc30x\x9eZ\x97}\x14\xca\"\xc0\xa8\x9e\x81\x06\x00\x00\xb0\x8dB\xa1\x9c[5)\xa9\xf1\x1d\xe0\xee\xfe\xb3DE\xc0\x1a\xea\x9b\xfb\xadm\x13\x95}\xe7\x18Gw\x12\x00\x00\xc0\x97\x8aW\x14\xe2\t;N\xabOY\xa9\xe9\x14ړ\xc3n\xd8!^\xd4\xc1\xb9\xbf =\xf4\xbdDR\xb8\xa1\xfa\xfe\x9c\xfdd\xa2\x19\xb0\xcdB\xf0\x1d`\xad\xfaI\xa5\x8a\xbb\xfe\x14}s\xc0\r\xb6|\xe4w\xe6\"{\x12\xab]\x04\x8b\x11\x87\x85_+;g\xa1\x19`\x93\r\xbb\x9dyr\xb2\x9f\xc7V9F5Ѫ\xe4)\x89\x8c\xf8B\xa1\x7ft5\xe3\xceb6\xdf\xedr\xdc\xf8\x12\xc0\x9a\xca϶\xb8\xb2U\x03\xbep\x91\xc5\x7fe\xaf\xfa\r\x7f\x9d\xb6\x18\x00\xe0x{\xec\xbf\xc814\xabE\xb9,(]\xf5_\xd4\x16\xa7\x92\xc8\n\xac\xb3!\x91]\x9dE\xa5\xbd]yNSi\xcd\xcf;Vo>O\x9a}\x95\x1a\x8fX-\xa0\r\xf9\x16\xa5ܪ\xa4\xfb\x12\xc0+\xe0\x8f\x90\xd2b\x89\x9c\x0f\xed\xf1\x13Tg{&-\xfe\xe5\xeaM\x00x\x15\x90\xc8\xfe<\xedc\xbf3\xcf\no\xc4y\xd07\x19\x1au\x00\x00\x00[\xc7á\x90\xd4\xfb\xc2>\x85\xc5D\x00\xd6R\xd1_\x1a\xdb6I\xb98\xbc:\xff\x0e\x00\x00\x00\xcf\xe7\x83\xfb\xfd\x81rS(\xb6\xa7\x15s)ŧ}4\xce\xde8\xad\xd9|\xae\xf9\xc1_\x90\x1e\xf9\xddDS\xb8ab\xb6\xef_f\xa2\x19\xb0\xf5B7LP\x03p\xe3\xb5\xcd/\x8c\x13)\x8aa\"\xa8'\xb0Gy\"\xbb\xf7\xb5\x01\xd8h\xd1\xca\xf9\xb0\xc5v\xbb\x1a+\xcbS\xed\xbcᯑȈ/P\xf9\xae\x97\xfe\xad(:e\xdf\xed.3\x9e\n\xac\xb3\x9c\xb3=\xff}\x0f\xcb$\xdf\xf4\xd2w\xbe\f\x16\xfa\x01\x00p\xdc\xf5\x8b\x8fY\xc5\xd5Y=f\x17\x9e\xc4\xee\x13\x83\x86\x85\xf8\xe8\xbf\x00\xd6Z\xb2r\x1c\x97\xab\x05\xf7R\xa1\\6*\x8b\xb7\x8co>\xa7<\xf9k\x86\"\xaf\xde\xca~ر6\xea\x15o\xa6\x02\xc05+<\x96\xf0v\xb1\x87\x14~\x1enV\xbd<P~\xe8W\xd9\x05\x00\xbcr$\xb2?\xcf\xc1\xc1\a\x14|0:͇\x000\xf3\xbb\x03\x00\x00\xb6Q\x0e\x16\a\xf5JE\xb0V\xe9t\xbc\t`ݔ\xc9{\xa6=\x89݊u\xbcl?(\xcf\x00\x00\x00_\"\xfb\x14\xd5\x03\xa5|i\xd57\\ΤIRW\xb4j\xfbR3\xfb\xabZ\\\xd6\xfc\xf2\xdf`gv\xdc0\x95\x0f݄\xe5x\x05`\x1b\x05O\xa0S\xb6\xbf|7@\x007Z\xd3|\u008b\xa0\xd4\xd7R\xb7\x9a4\x10=\x9b)6\xc39\x80\xcd\xd4\xfb\xc4\xef\xc2\xcayou\xb1\xf7\xad\xe7N!\xdc9\xbe\v<\xa7\xec\xadNHv\x12\x16V]x\xdd\xc0\xf8\v\xb0Ά\x0e?O\x12\xb2\x82\x9d\xac\x0e\xf0\xc4\xf6P\xfa5\x00\x00\xc7[\xb3\xbc\xdf*\xae\xce;\x13\xad\xfeꇤ\xb3a\x92\x10\x89\xec\xc0\x9a+\x14\xb5Z\xf0\xba\xebJ\x85\xeaPU\xf1\xd6\xe1\xfa\xf9\xea\xdb\x7f8Lf_\xb3*\xffq\xaeP\x1c\u0603\x81\xf6)\x80kWz?W\xe7\x9b>쩍>6R\xca\x1fG\xdd\xc1\xcf\r\xef\x03\xc0+E\xaa\xf6\xf3\x1c\xce?c?\xad\x01\xd7\xfb\x12\"\x95\x9d2/\x11\x00\x00l\xa1\xa1W\xdbB\xa1X)\x96\xf5p\x0e`\xfd\x94ަ\xc9>\x93\xcax\xcb/\xef\xac\xce\x01\x00\x00\xf0\x9cvOqR\xa9\xd8](\xc7sꖭ\xda椺b\xa2\xe5\xec\xbc\x14\x1a\x95\xddLڿ\xac\xf3\x97\xff\xac\x96\x8f}7\x9dƸ\xeeB\xb0\x00\xfej,\x0f`+\xa5a\x1b@\xafr\x18\xca\x05\x8eB\xdb.\xac\f\xdaI\x8aV\x1e\xe3s\xc9M\xbe\xbb\x19\x80\x8d\xe5\xd5\xefP\xf5&/\xf5Y\xbd\xfd\x15\xc3\x19\x7f\vx\xd6\xe1C\xbf'Ǿ\x1c\x16<\xf0\x9d\xfbWؑ\x1dX{C\xacgG\xf2\xa5L\f\x89\xec\x00\x805жO[\xd3\xe5jL:v'z\xa3\xc6wP\x05\xb0\xbeR)_\xe6\xd6wd\xcf~^v*\xdf\xf4\xe3/\x1c\xa0Nޯ6'u\xe1\x92b\xec\xa4nw|\x03\x00\xae\xc1\xd07\xbaT\xcc7\xab\x8b\xf6\xd8\xc9\aC\x1fXn\xce\xe9\xf2\xe3\x7f\x90\x00\x03\xc0+\xc6\xec\x87\xe793\xb9l\xad\xba$MO\xabO\v\x95\xe9\xb9\xc6\x1d\x00\x00\xc0\xb6\xc8Ӥ\xaaI\x9a\x1eN\x14w\xbfa\xbc\v`\x9dt\xf7\xbd;\x17\xcdR\a\xd3S\x9a\xc7f\xd8,*\xf74\xff\x00\x00\x00\xbe\x84\xef\xb6\xd7\x16\xc3\xea\xf4AS\x95\xa5\xef\x86\xddh\xda\xf6\xdakj\xf5a&\xc5B\xb3\\\xe9\xec\xc1\\\xe5S\x7fC\xfa\xdc\xef`\x80\x0e\xd7\xcf#\xbf6O\xdb\x13J%\vQ\x01۬\xf2\x1d\xd9\xeb^\xa9\xbam\xbc\x03\xe0\x86\xf9\xe4\xd7\xe5ۺ7\xa8UT7m\x14\xa6\xfb\xaazOf?P\x8e\xech\x04l\xb2\x98n\x96\xbaZڙ\xdbU\xa1e\xd7kr\xf3\xb7\xae\xde\x04F;W\xfeg)]Т\xbe[]hT,j\xcd\v\xff\xce\x00XWU\xcc\xea\xd3\x19\xaf\bT\xc6\vV\x17\x9cѕݯ\x1e\xdf\x05\x00\xe0\xf8\x8aͽ\xeaf\xadŧ;*\xe6\x13եUc\xba\xa8\x94\xadm\x03`}\xc5^\xa9\xb7\xa2\x9d\x92\xaaz\xa6\xe5x\xfb\x05\xbd\xee\xbf\v홓\xaa\x9aN\xc3F\xcag\x0e\xc67\x00\xe0\x1a\xb4S\xa9\xac\xec\xe4\t\xed4\xf6P\xf1E\x1c'S\xd5v\xff\xe4#\x7fb\xf5\x19\x00x\x05\xc8dx\x9eaQ2\v\xfc|\xc9\x10_\xc1\x88\xdf\x1c\x00\x00\xb0\x8d\x86EY}!\xb5b_Uu\xcf\xea&\x80\xb5\xe2{Ÿ\"Y\x13g\xdc\xc9\xd17\x11\x00\x00\x00\xc0˓}K\xbe\xde\x1aI\xbe\xdat)\xb5\xfd9\xed\xef\x7fp|\x17\xb8\x0e\xba\x85\x94\x9aa\x8c\x02\xc0\x96\xb3\xe7@(O\x8c\x17\x00n\x98\xe6\xa2R\x9a+\xabU\x18'\f\xe4\x9c-.\f\xc3+\x80\xcd\x15˹\x15xk\xf8y\xd9ϵBm\xafw\xfc!\x02s|!\xef#\xb0\xfa \xa8\x1bo\xf88\xccx\x02`M\xf9\x8a\xe0\xf6\xf0\xcf\xf6ȷ\x97T.5\xad\xdf2\xbe\a\x00\xc01\xf5\xc8\x1f\xb0\x9a\xebp\xbc\xf0f\x8b7d\xe2jnP^\xcd\x19\x02\xb0\xae\xb2\xa2\x15\xe9\xa0\xc2N\xad\xed\xf9\x15z&vw\x7f\xbdre\x7fC\xb7\xa3ح\xe6\t\x02\x00\x00\x1cG\xdej\xc1(/NH\x857\xde\x1a\v\xfe\xcaU\x12\x17\x00\x00\xc0ֱ\x101\uea89\x8d\xc2m\x7f\x8c\t:\xc0\x1a\n}c?\xb3\xa2'^\r7\xfc`\xa0\n\x00\x00\xe0\xe5*\xc3Մ%k'y>C\xcc\xea\x16\x1f\xd6\xfe\xc7ޟٙ\x1d\xd7Cj\xf6\xed\xc7j\xb1]\x00\xdb\xcc\xda\xf39\xa8,\xef\x18\xaf\x01\xdc(}\xfb\x8c\xbatIAK\xab\x8f\xadN\x1e\xef{<\x18\x13\xf53\xb0\xc9By`\xf5\xaf5\xf3\xac\xa8'\x7f\xd9\xd9\x1d\xdf\x01\x9e\xb3\xca\tJV't\xabv[\x11T\xd0;\x00\xac\xb5\x1c\x93\x95\xe7h\x81\xa0=\xf7\xfd\xa5lT\xdd\xf6\xdf\x10\xf8\x01\x00\x8e\xb7\xf9}ʺ\xa8\xe2j&\x88/ȢrX\x979\x7f\xf9\xfd\x9b\x01\x1cw\xd9\xe3\xd3`Gi\xe59\xa9,_7\xbe\xf1\"\xee\xfas\xa1\x9f\xbc\xc6N\nŞ0\x16\xc0\xf5s\xf0\xf1w\xd2\v\x06\xe0\x15\xb9\xda|\xd9z\xcdg~]\x0e\xed\xcc~G\x92R\xf0\x95\x8b,\xf8c \x1a\x00\x00l\xa1䉯aGm9\xde\x00\xb0~\xfaV>\xe1\xae\xec}W\x90l\xed\x1co\xdb<\xb7;\b\x00\x00\x00^\xa2*(\xc4h\xed\xa4hQU\xa5\xd2\xdaI;E\xafr\xf9A\xf5\x17\xff\xfe\xf8!\xe0\xd5\xd3-/\xdaϥ\xcf4\x03\xb0\xd5ze_t\xbb\xbew\xbc\x06p\xa3\xa4tY!,,\x06\xec\x14\x94\x86E\x8dB\x8e\xec\xb6\vl\x85\x85\x1d\x8d=\bΪ\x89\a\x8a\xd5{W\xb7\x81\xe7\xf1\xcd\xf0rH*\xec\xbbR\xf8\xaa\aѧ\x9a1\xfd\x0eXg\xc3\x1a\x96>O\xb4\x9f\f\xfd1mY\xad\xde\x00\x00\xe0\x18\xeb矴P\xf4\xf2j\x01\xbe\xa12\xb3#WC\xf2\xab\xb2o~\x01`m\xe5\xa4a\x95\x8a\\\xaa\x0f\xad\xaa\xf2\xfd\xe3\x1b/.\xec|\xbd\xfa\xfa\x8aŵĲ\x00\xae\x9f~\xf9\xf1\xf1\f\x00\xae\r=\xe9\xa3\xdc\x7f\\\xb5\x05{\n\x85\xb2\xef\xca\x1e[{\xca\x16\xe3\xbb\x00\x00\x00\xdb#$\x8b\x85\xbco\xbb>;\xde\x01\xb0N\xd2\x13ߟCZZaΊV\x9c\v{M\xa1\xb6wؑ\x1d\x00\x00\xe0eK\xcd\x10W\xe5P*u\x13\x85\xbeR\x1d\xa4i\xce*\x16\xcf\xe8\xf0\xe37\xfb\xec \xe0U\xd3w\x97\xed\xe7ja*\x00\xdb˓\xa3z\x9ftz\xefO\fsQ\x01\xdc\x18\xdd\x03ߕcѫ(\xb2\xca\u008b_\\-~\xefղ\x97IJ$\xb0\xd1V!xo\xe1\xf8IuU\xa7\xd9\xeb\xfe\x05\xa5\x1e_\xe8\xe1\xff,ǾP*\xac\x86\xc8\xd6n\xf3\nbHd_\xbd\r`M\r3h\xad \xb7v\xe2幸\xc3o\x00\x00p\xac\xf5\xed\xe7U\x06\x9f\xe3g\xf5\x97\xb5\\R\xb0Jlؕ\xbd\xb2\xaa\x8d\x00\x15Xk^\x9e}|:\x95\xeaժx\xdd\xdf\xfe\x8a\xfd\x13Ž\x7f#t\xd3\xd2\x1e\x06\xe4?\x01\xb8~fv4\x9f~\x1f\x13\x19\x00\\3\x12\xd9G\xa1{Je\\Z\xf0f\x01\x9c\xff\xae\x04Od\xe7\xb7\a\x00\x00l\xa3d\x7f5\x9aξj\xbc\x06\xb0N\xfa\xc5G\x15\x93\xb5g|r\xed0\xcbV\xea\xa2'\xb2\xb3#;\x00\x00\xc0\xcb\xd57\x8dET\x8d\x86<&ߕ}YI\xed\xd4\xc2,\x8b\xafB\xa5bqN\x97?\xf6V\x06\xea\xf0\xeaI\x87\xab1\n\x12ف\xad\x96sR\x97\x99p\x06\xdchy\xf1\v\x16\xf7\x95\x8av\xf8\xc4\xef\xd0\x17\xca\xc3.\xbbV\x1e\x83\xd7\xcdL\x04\a6\x99\xe7\x80\fr\xab\\\xf9\xb4L\xe0\x8b,>\xb4\xea\x1b\xf00-t\x16\xb3ee\xcfb\xa7\xf9\x06\xac\xbfa\xe7\xda~\xe8\x8e)\xeaw\xac\xee\x01\x00p\x8c\x85\xfe@\xa5\xf7W\f\xbb/[LZ\xf8\x9c \x1f̪\xed\xe7W\xccy\x05p\x9cy\x11\xb6#\xf5\xa5R\xf12\xfa#w\x7f\x99E\xb4\x8c+\x00\xb8~\xaa\x10\x94\xae|h\xbc\x02\x80\x97\x8fL\xed\xab\xda\x03\xfbݸ\"u\xa5o\xcan\xe7\x9e\xd4\xee\xc9\x1e\x00\x00\x00\xdb%X\x84؇F\xd5\xeck\xc6;\x00\xd6IZ<\xa0\xe0\x89\xec1\xdbE\xb0F_V;L\xbee\xa2-\x00\x00\xc0˕\x8bbH&̚\xab\b\xbd\xa25\x98\xb2/\x80\xea\x13\x83\x8a\x89&}\xad\xea\xf0SJ\x9f\xfe\x06\xa6\xad\xe3U\x11\xec\xbb\xe6#7\x89\xf0\x1d\xd8j\xbe\x83R\x8a\f\xe3\x027\\\xfbQ\x8b\xfd\xac\xece\x8b\xf5R=\xecz\xa4lǰ`\xa4'*z\x82\x13\x80\x8d\xe5e_\xa7\xad\xad\xe7\x1ba\xbcau\x0fx\x9e\xb4\xfc\xa4\xfd,V\xf3\xca\xe4\v\n'u\x9e\xc5N\x8f\x00\xb0ֆ>\x98\xd0\r\t\x80\xa1,5\x9b\xb2\xe0?\x00\xe0\xf8\xf3}\xd7c\x9eI}\xedS\x83\x94\xa3\xef\xce\xee\xb1i\x94\xa2\xcf\x11\x02\xb0\xaer\f\xc3\xc2i\xa9\xb7\xc2]\\]u\xef+\x9b\xbc\xeegB;\xdb\x19\xaf\x00\xe0:H7\xa9\xb2\xe6sz\xe0[\xe8\r\x03pM\x98\x01qUkA^h\x94\xbb0$o\r\xbb\x15f\x1f\x90\x06\x00\x00\xd821\x0e\x89\xec\xba\xfd\xc7\b\x86\x805\x94\xba\v\xfesuaB\b\xcaC&\xccK\xef\xd8\x06\x00\x00\xc0J9\xdb\x19&\x00\xa5\xbe\x95\n;|#\xf6\"\xa8\vY\xab\xe8j\xaaY\xac\xd5_\xfa\x19\x1d>\xf0\xeb\x18\xac\xc3+\x16\xfc\x9bU\xf8|3\xbeN\xc0\xb6K\xec\x9c\x04\xdcp\xb1?P߅\xd5$Q{\xf5-wð\x8bѸ\x93Qf\xa5\x19`\xb3y\xe3\xef\xb45\x04[;\xbb{\xbc\a<O\x7f\xde~X\x9dP\xf8Ĳ<\xb4\xdb|\xad;\x12فug\xe5:\xf4\xf6\xfc\xb7X\xaf\xa8\x14_\xfb\xc34\xc6\x00\x00\xc7\xda\xf9'\xbe?\xafVW\xaa\x87\xbe\v_`)Ĥ\xde\x03\xd3\xe4\x01\xea؏\x01`=\x8d\x89\xec\xd9s\x99\x86\xbc\xa6\x97.\xec\x92\xc8\x0e\xe0:\xeaJ\x15\xd6t>\x9c\xff\x93\xf1\x06\x00\xbc</3\xb4\xd9L\xcb\a\xbf-\xd7à\xf3DibA\xdf\xf2\xb4rg\x8d9\x16$\x03\x00\x00\xdb\xe8p\xae+>H\v`-5ݮ\xe6վ\x9d\xedJE\xa3\x98\x83\xaa\x83C\xf5'N\xac>\x00\x00\x00\x80\x97nުT\xa5\xa2\x98j\x98\x9d\xdevv\xb3W\x19\x92\x8aܩ-/Ki\xa2\xca\xdeڹ\xfc\xf7\xa4O~\a\xd3\xd7\xf1\x8a\x84nG)NT\x94\xf3\xf1\x0e\x80mT\x86\x89\x9a\xd5V\x9f\x00n\x90\xc3O\xfe\x8a\\X\x1d\\\xfa\xfcP\x8b\xf3\x14Z\x85课\xbdH\xeb\xabK\xd8\x1b\xbe[3\x80M\x95\xd3\x19\xab\x84?\xa7\xc5\xfc\xa4\xa6o\xf9\a$1\xe2K,\xf2;\xd4\xcc\x1eQ\xd1L\xec\xe2\x84bȪ\x9bN\xfd\x84\xfa\x01Xg\xb1\xb9I1\x1d\xda\xc9\\O\xef\xd0\x1f\x03\x008\xfef\x17\xff\x9e\xfd\xb4&K\xb6\xfaK\v\xabǢ\x1d\x85\n_(7,V\xfd\x18\x00\xd6V\b\x85\xa2\x15\xe5:t\x8a\xa7\xfe\x1f\xe3ݗf\xf2\xe6O\x85\xc6\xfff\xed\xd93\xa2\x94\xfa3jퟷ\x98,\x94Cm\xcf\a\xe6\x05\x03\xb8vM\xbcl\x0f\x9a\x9bU\xdbc\xa6{\xe0?en\f\x80\x97\x8dDv\x93\xfa\xf9j\xe0\xd9w*\xf4]NRf\x8f\x03\x00\x00\xb0\xbd\n\xa9\xacn\x1b/\x00\xac\x9b\x90\x9b\xe7\x1az\xbe2\xab]YS\xc7\x1b>\xc3-\x00\x00\x00\xbczb<+ՇR_+53u\xdd?\x1b\xdf\x01\xae\xc1\xc3?l\x11\xfc\xd5\t$\f\xdf\x00[-[\xfb>\xee\x8d\x17\x00n\x84>?ce\x8fyW\xc06\v\xe5\x81\xd4MT\xee2c\b/\xe0\xf3?h\xed5O\x142\xe3؋c\xfc\x05\xd8\x00\xe1j\f\x98\x15\x8b\xb3\xe39\x00\x00\xc7Wn\x97\xe3\x19\x80\x8d\x94|\x80\xc0\xcaz\xb1\xaf\xc9\xe4\xde\xf1\xe6KWOޫN\x17\xad\xd9\xea\x8bt^Q(\x16*\xf3Dm\xbf?6b\x01\xe0\x1a\xf9¿\xe1\x9c\xeaP\xa8m>=\xde\x04\x80\x97\x8e\x99P&7\x975l\xc8^D\x8b\xf9zE\xbf\xb0\x18-\x11\xa7\x01\x00\x80md\x11b=y\xe3x\x01`\xad|\xf6w启\x8c}\xce֮\xf1\x95UU(\x86\xde.\xaf&\xc4\x00\x00\x00\xe0U\xe3\xf1\xd6p\xd4C\xdfr\xdb=\xa0˟|\x17\x19P\xb86\xfdy\xfb\x1eu\xab\x05w\x19\xbe\x01\xb6\x9b\xb5\xeb\xcb\xe2\x96\xf1\x02\xc0\x8d\x90\xba\x87,\xa6c\x82\x00\xb0\xd5\u0081\xfa<Sy\xea\xce\xf1\x06\xf0<\xf3O(\xeb\xa2O-\xb3\xfa\xc2\xdbl^g\xac\x16\x12\xcej\xfc\x13\x00\xd6T\x18\xc6Q\xfdWP\x19\xdf4\xde\x05\x00\xe0\xf8\n\u0378\xc0\x12\x80\x8d4L\xf1\x8b\xbbj\xa2\xb55o\xfd\xb1\x97\xdfa\xf9\xce\x0f\x86y\xe1\xc9\xf0\v\xa9\xdcW\x8c\xbd\n\x9dQ\xdf\xdb?\x98\xe1G\x00\xaf@\xf4D\xf6\xa1_\xec\xac\xfa\xf9\x875\x7f\xf2;\x99\x1b\x03\xe0e!\x141\xb9\xbd\xb8Jd\x0f\xbe#{k\xd1\xdfj\x92X~v\xb5M\x00\x00\x80mRkR\xbfg<\a\xb0V\xe6\x1fR\xc8\v\x15\xc3E\xe7\x1b\xb8\xf9\f*\x85\x98\x94\xbd3\x1a\x00\x00\x00\xaf\xaa\xd4_Q\xeev\xa5\xa2Q\xa8\x16*}\xde\xeb\xe1Ǵ\xbc\xff[\xe9\\\xc6˗\x9eTT\xab<$ѭ\xa2z\x00\xdb*\xa8*\xef\x18\xcf\x01\\o\xcbG\xfe\xf3\x1c\xd2e)3}\x02\xd8j)kY5\xd2엏7\x80\xe7t\x8bOZ{m\\H\xd8\x0f\x9f\xb4\x9b+;\xb7\xb6\x9b\xcf5\x03\xb0\xb6B\xd1\xd9\xcfJ)DM\xab\xf7\xaen\x02\x00p\x8cU\xdd|<\x03\xb0\x89V\v^\xcf\xd4\xfa\xfe5\xd7j\xf6v\rK^X\x93\xd5{<Sש\b3\xbbX\xfa]\x00\xb8&\xc1W\x81\xeb\xed\xa4/T\xf6W\xd4_\xfcg\xab7\x00\xe0%b$\xd6\xc4\xfe\x82\x82\x0f,(۫\x0f.\xf8r\xb9\xf6[\x13\x99k\b\x00\x00\xb6O\x1f\xa6*\xef\xfe3/\x7f%G\x00G\xaek?\xa3*Y\x8bƛ2\xa1_%\xb2[i.\xec<0\x11\x17\x00\x00\xe0U\x17\xd5)\x84\xb9\xc5\\\x8d|\a\xcf*\x9f\xd0\xcc\xe2\xb1t\xf0\xf3\xd2}\xff1\x1d\xccxy\xba\xc7,|\xf7\x1d\xd9\xfd\xe2\x95\xccN\x01\xb0\xeer\x88\xaaʻ\xc7+\x00\xd7[\xb7\xf8\x80\xeaጅd\x80\xad\xd6M\xd4\xed\xd8\xebm\x8c\x91\xe1K\xf5ͣ\xd6J\xeb\x14\xc65\x83\xb3\x9fd\xaf7*\xc5a\x06/\x80\xb55\xf4\xebM\x94b\xd4\xe4\xce?G\x1d\x00\x008\xd6ң\xbf7\x97\"\x11\x15\xd8d\xc50\xe3/(\xd7gW7\xae\xc1\xf4ķi\x19-\xcc\xf5\x8b\x14Զ\xe7TW7\x0f\xf3\b\x01\xe0Z\x15\xb9Rno\xb2\xb3\x03MC\xa5\xe2ʣ\xab7\x00\xe0%\"\x93\xe1s\xffI.\xf3\xbeB\xac,R\xcb*\x86\xe0\xcc~\xe4\xe01\x1b\x00\x00\xc0\xd6i\x8a\xe9x\x06`\xdd\xc4tNE\xaa\xa5ގ\xd0\r;\xb1+\xf9\x04\xaal\xefY\x9b\a\x00\x00\x00\xaf\xaaB\x13\xa9\x9a[\xcc\x15\x95ڳʱQ\x1d'\xf2M\xfc\xd2\xfc\uf31f\x02^\x9a\xd4?5&\xb2g\x8b\xe0I\xa4\x03\xb6Y\xf2\xa7\xc0\xeb\xfe[F*\x81\x1b$/?\xebQ\x9d\x9d0}\x02\xd8j}\xadb\xe7\xae\xf1\x02\xf8B1\xed\xabȥr\xb2\xb6\x9a'\xb1\x17\x1e\xb1yJ@\xa9H&\x00\xb0ֲ\x1a\xfb9\xb3P\x90\xbe\x18\x00\xc0\xf1״\x1f\xf1\x9f\xab\v\x00\x9b)d%+\xe7\x93\xe9\xfb\xc6\x1b/_u\u05cf\x85\xaa~\xb7\x9fY\xc0\xebq\xae\xb5_\x8b\xa0~\\\x9c\r\x00\xae\x89=OR\xac\xd5\xc5C)\xee\xa9n\xecy\xf5\xa9o\x1a\xd6\xcc\x00\x80\x97b\xebGb\xfb\xfdO[L\xb6\xb4\xc0\xacR\xce\x16\x99]Md\xef\xf3j\xf5\\\x00\x00\x80-\x93k\xdfn\x02\xc0:\x8a\xca*\xf3\t\x85\xaeV\n֦)|W\xf6~\xe8\x8b\x0ey\x98\x8e\v\x00\x00\x80WSn%\xfb\xa5\xbck\xb1Wk\x91\xd7\xd2\x1bU*C\xa1\x90\x0e\xd4~\xfak\x18\xb4\xc3K\x96ҹa\xd4&\x0f\xab\xec\x92H\al\xb3\xcc3\x00\xb8\xb1\x9ag,v\xab\x869\x02\x00\xb6YҤ\xfe7\xc7s\xe0\v\x95\n\na\xaa\xd0\u05fe7\xca\xd0\a\x90\x87\x93Қo$\xbf\x02\xeb\xccӄ|\xb9J\x12\xd9\x01\x00\xeb`\xb9\xb8ߛ.\x006Y\xc8\xeaC\xa3z\xe7k\xc7\x1b\xd7fo\xf2\xab\x95S\xf4\x95\xb45\xf5ͭ\xe2S\xca\xdd\xee\xf8.\x00\\\x83\x1c\x95\xebs\xea\xeb\xd5\xe6Z\x85*\xe5\xf9\x87\xd4?\xfd\xc3\f\xae\x00xI\xb6~\x16DלS\xf4߅P\f\xbb\x9c\f\x89\xec\xc1\a\x1a|\x00\xc2/\x00\x00\x00\xb6K\xacّ\x1dX[C\x13f\xa6\x9c*\x05k\xe7\fM\x1b_\xb0kP\x8e\xaf\x00\x00\x00x\xd5\xe4\xa54\xbf\xdd\x02\xaf\x1d\x15;\x97\x87\xc4\xc3\xe4\xfd\xccՁB\xda\xd1b\xff\x17\xd4=\xfa\xbd\f\xda\xe1%Iy>\x9e\xf9\xb0/I\xac\xc06\xf3e\xe9\x00\xdc\x18\xed#\xbf#\xc7\xde']\x9d\xf0UeV7\x01l\xa7ة\xbc\xf3\xaf2Q\b_\xe2\xe9\xcf\x7fO\x0e\xc1\xc7Xjk\xac\xadv\xb2\v!Y̶ZH\xd8\xe7\x9c\x01X_ޕ\xe7\x8bR\xe4H\x15\x00\x008\xfe\xfa\xee)\xef<\x04\xb0\xe1<\x91]\xb7\xff\xd8+\vP\xdf\xf8G°\xfe\x9a\a\xbc\xe5\x19uy\xa1\xd4\xef\x8do\x02\xc0\xb5(\x94\xeaF\xa1\xf6y1\xf6\x9c\xb2vt\xe8\xce\xebҥ\x9f\x1a\xdf\a\x80/o\xebgBM\xfa\x87\xa5vj\x11\xda\\\xd1W\x1c\xf2\U000f842aBU\xc3@5\x00\x00\xd8@\xb1\xb2\xd8g\xaa\xde\x1b\x94\xb1\xb7\xc3c\x1e\x8b\x81\x82\xefĞue\xe7W\r\x1f\x03\xb0^\xf6?\xf1\xf6\xac\xce\xcar\xf1\xb8b9W\xb0\xb6M\xe8\xa35\xfa\xbc\xbd\xe3\xf7/\x8c\x9f\x04p=\xf4\xc5R\xb9\xcaRݩ\x8b\x8d\xbaa\xf1\b+{\xc9^\x9b\xa9r\x91\xb7\xfb\xc8a\x18\x1c{\xf6\x18Rsz\x8b?VG\xaf0\x1c)\xc4\xe1\xf0\x9dO\x9e\x7f\xf4\xbe\xcb\xf1xx\xfc҇\xee\xd9\x7fFʝ=\xe7,\xbe\xf1\xdf\xeb\x1c\xedX\xfd\xf3Sn\xed\xcfa9\x1cj\xed~W\xd8\x1f\x94}\xc6w\xd9{\xf6\xb0\xeb\xde\xeeG\xfbLa\xff\x9c2\x0f\xb7\x971\xe8 F\xedۿ{\xbf\xacW\x7f\xc8\x00\xf0B\x82=\xeb\xa7\x1eg]\x92\xe6SU}\xad\u009fK\xad\xb5\xb3B\xd2\t\xdfY\xfb\xfc\x9fY}\x16\xf8\nR\xf0D\xf6\xb3\x9a\xf4;\x8a\xbd\xd5M\x00\xb6V\xa5f<\x03p\xbd5W\xfe\xaev<y\xa9\x8f\xea&\x16\xc7\x01\xd8\\\xb5կ\xe5B94\xcaU\xaf\xa6l\xb5\xd0\xc4\xe2\xf0=\xbb\xdf\xebJ\xf5\xc6\xf1\x83\xc0\x17:s\xf9\x7f\xb0\x9f\x85\xd4\x1d*\xc4n\x18{\t}\xa9ʓ^\xd3\xc2\x0e\xe6\x97\x01\xeb\xacXL\xd4O/\xa9\xdac\x9e\x04\x00\xe0\xf8\x9b-,\xf6\x9cM\xc7+\x00\x1bi\x99\x95㫳bEq\xf6W*\xb7{R\xfd\xb8]\xbdFE8X\xbd\x01\x00נ\vWT/j\xd5\x17f\x8a\x9dϳ\xbb\xa2y7\xd5\xecp&\x9d\xff}>\xd2\x02\x00_\x16[z\x00\x00\x00l\x9dޢ\xc0\xa4BA\xd9\x17\xf2\xf1\x84/O\"\xf3\t\xb2\xb9\xd7-w\xff8K\x8d\x03\xeb\xa8g\x92;p\x94b\xb0곏V\x14+{\xadU\xf8$\xc6z)\xed\xecK'.*\xf9\xa4\xd8m>b\xad\\\xcc\xc6cb\xe1\xc7L)L,*Y\x1d\x85\xf2pĜ\x86#\xa4~u\xf8\xb9\x1d\xca'\x9e;4S\xf0\x05x\x82\xbd\xda?/\x96;J\xd5rX\xf15\x95\xbd\x9dWʥ\xfd\xf3\xab\x93\n\xd5M\n\xe5-ʓlG'MZ\xfbsY\xd8\xeb\xa1\x1d\xf6g\xe3\xc7\xf4@J\x13;<\xd1\xdd\xfe\x1b\xbaV\x934\u05ee\x0e\xb5\x17\x0f츼\xfaC\x06\x80k\x91\xb2\xb48\xad\xe5C_Ǡ\x1d\xbe\xa2a\x9d9\xff\xce\x04\xbe.\xc0\xb6\xf3\xe8\x18\xc0\x8d\x91\xfa\xb9\xfd\xf0i\x13֎\x1f\xfa\xc9\x01l\xac\xde\xe2\xecT\xc8G\xc8r\x1fT\xdak\x1dZżJD.\xf7\xde2~\x10\xf8B9\xb1\xd0\t\xb0ъ\x89\xfap\xa8\xb2~\xc3x\x03\x00\x80c\xea\x89ߟKM,@e\f\x01\xd8hV\xc6C<3^\xbcB\xf7\xfco\xa1;٨?\x9c\xa9\xd4e\x15\x91\xe7\a\x80k\x17|_\x9f8\xb3\xe7TmG1\xac\xfbX\x16\xadr\xfb\x84t\xf1\x93\xab\x0f\x01\xc0\x97\x11|\x17\xacm\xd5<\xf2\as\xfd\xf8\x9f\x18\xaf\x00\x00\x00\xb6E\xb2\x86\xa47\"\x83R\xdf+\xfaLy\xdf}4u\xea\xfb\xac\xeak{\x12ف54\xff\xa5\xd7\xe6Y\xff\xf4x\x05\xe0\x86\v\xfd0\x016\xa4\x1d\xa9\xf0\x8eڹ\xfax8\xec\xa3苢\x9f\xd9\xf6\xb9\xf0W\xa3\vO\xf8W\x1e~}\xc1\x11+\xfb\xf1E\x9e\xdfg\x15\xf7\xec\x87'\xb4\x0f\x19~\xf6\xdaz\xaf\x96\xbd\xae&\x91f\xef(\x1fNV\xff\n\xdf\x009\x84\xd5\xfa\x8d\xc1n,=\x19\xc1b\x9f\xe0\x1f\xf4\x8e\xf4\\\xa9Pe\xffY\xfe\xef\xb5\xfbZ\xad:\x1d\xfco\xf4\x1d\x94\xfc\xcf\xcbϯ&\x10M\x16\xabW\x00x\x99z{\xfe\xf8s\xa8\xad\x1a\x15'\x7f\xbb\xca\xd7\xfde\x7f\xb8\x00/\xa8\xfdp\x91\xab\xe5\xeeP\x99e\xab\xeb\x02\t\xed\xc0\xd6\xea,>.\xdf\x7f\x99:\x03\xb8\xce\x0e\x1f\xfd\x81\x9c\x9e\xfe/\xb5\xb7\xb0\xb6bqZ}\xf9\x8c\x8a\x8e]̀\x8d\x15\x97\xeaSm'\xe5j!Ő\xec^'\xa5^\xd9\xfb\x89\xbe\xa6\xa1\xee\xc5\vj\x7f\xe9T\xaeXL\x18\xd8\\yG\a\xd3\xf3\xda}w\xa6\x1e\x00\x00\x1ck݃\xbf2\x17\xe7>\xa0\xe0\x8bq\x01\xd8\\m\xd6\xf2\xcc{4y\xdbϽ*\xf1\xe9\xe1\xc3\xef\xcf\xf1\xb1\x0fkZg)\xf9\x9c\x19\xf6B\x05pm\xda*\xabL;\xca\U000e8a39\xb4\xd3*\xabբ\x9b\xa9\xa8oS\xfd\x9e\aiW\x03\xf8\xb2\xb6:\x91\xfd\xca\xfd\xbf>\x9f\xb8\xf8\xbf\x8fW\x00\x00\x00\xdb!\xabW\xf0]bs\xad\xd4Xc\xb2ꆝI\x9b\xce\x13\xc3N\xa8f\x92,\xb0\x96\xba\x9f\xbf%\x97a\x7f\xbc\x02pÅ\xca\xea\xd6C\xa9\xdfY%e\xc7K\x9a\xe7=\xa5\xc9k\xd5\xc6R;\xcd}\xe3\a\xb7S\xccqH\xe4\xf4C>I\xd8\xf8\":)%y\xdfԤ\x1a\x13\xd9\xc7~\xaa/\xee\xaf\n\xfd\xc4~\xfa\xdfg\x87'\xa5\x871\x91\xfd\xaa\xe6\x0e\xfb1\xbe\xa7\xa5\xbd\xda!\xff\xcc\xeaߥjw\xf8g\xe6~\xf5\xef\v\xd9w{_\xbd\xe5\xaf]\x95\x14\xed\xcf)\xfa\"\x04aL^\xbf\x9a\xd4\xees\xd7zv]\x02pm\x9abGuy^\xbabO\xa5\xd3gT\xbd\xe3<\xed-\xbc\xa8\xf4K;9Z\xf5\xa5\xd2\xea\xc5aǿ\xb1\x1e\x03\xb0u\xba\xe2\xb4ʯz\x9c:\x03\xb8\xce\xce\xdf\xff\xb5y\xe7\xca\a4\xf5\x85d\xcaZ\xa9\xba\xa0ؒ\xc8\x0el\xac\xe0\x89\xec'\xa4\x18\x14\xfaV\xd1W^,\x0f\x86\x05\r{\xbdF\xc5\xd7R\xf7\xe2\x85\xe5\x9f\xdf\xcba\xe8w\x04\xb0\x91Ҟ.\xec>\xa33\xef$\x91\x1d\x00p\xbc\xed\x7f\xe2\xe6\xbcw\x10\x95\xf3>\v\xe1\x02\x9b\xac\x93\xda;~\x9b\xaa{\xfe\xdbW->]~8\xe4ɼ\x90Jk\xdbf\xfa?\x01\\\x9b\x83r\xa1*\xefI\x8bBE\xeeT\xec\xd8\x03+\xb6\xea\x9al\xf1\xc9L\xfd\x1dߩ\xe9]?A\xdb\x1a\xc0\x8b\xda\xeaD\xf6\xfd\x8f\xbd?\xef\xcd?1^\x01\x00\x00l\a\xdfW\xa2\x88\xbek\xc0\x8e5&+\xa9\xb2\xf3\xe9\xa1\x0e\x9bB\x93\xfa-*\xde\xfdq\x1a\x91\xc0\x9a\xc9O|O\x0e\x9f\xfd\vV\x9e=\xeb\x05\xc0\x91(|\x12\xec\xd3V\xb7\xfa\xd6\xe0\xa5\xfa\xb8P?\xf9Z\xd5\xefyuVH\xde\x06\xf9\xb1\x1f}\xb6\x93\xeaj\x7f\xd5\xd5ע\xffi\xe5ԩOK\xf5\xddR]\x7f8\xbc\xfa\xb5'\xc3\xef\x8e\x13I\x9f]7:\xf5ϝ\x9b\xa0+\xabd\xfadw\x87\xb9h\x9e\xb0\xee\x7fV\xfej\u05fe\xe1ְ\v\x97\xfds\xa2\xbfvJ\xf6\xcf\xccv/\xdb_e\xcf@\x1e\x80k\xd3\xd4IuoϚ\xc3^}1U\xba\xfb}\xaa\xee\xfag\xd4\r\xf8R\x8f\xffH\xd6#?au\xd8\xdc\xe2\xfa\xda\xea\xc0dU\x13\x13рm\xd5\xd7w\xabxϧ\xa9/\x80\xeb\xec\xc2\xc7\xf7\xf2\xe9\xe6@\xa1=\xe5\rG+{\x97T4\xb4\xff\x80\x8d\x15\x1a\xa5t\x93T\xfa\x8c\xf0\xa5b.\xed\xfc\xb2ot\xa6P\x7f\x93\xca\xf7\xfc\x13\xea^|\x89\xf6\xa1ߝ\xab\xc7\xfe\xaaw\xf9\x02\xd8P\xa9\xaft\xe9\xc4i\x9dy\xc7\xc3\xd4\x03\x00\x80cm\xff\x83!\xefu7\xab\xd7\\\x85\xaf\xc8\x05`#\xe5\\(|\xed\xfe\xab\x1a\x9bv\x0f\xbe3\x97O> \x15>o\xd87\x92\x00\x80\x97\xef\xd0\x13\xd9cTh'\x8a}P,\xd3j\xbe]\xef\xf3\x1a\n]\x99\xbdI'\xde\xf9\x11\xda\xd6\x00^\xd4V'\xb2\x1f|\xf8μۜ\x1f\xaf\x00\x00\x00\xb6C\x1f*E]Q\x88\xb54ߕ\xeaFyr\xa0\x83\xe6\x8c\xf6N\xfdr\xe9M\x7f\x87F$\xb0f\x16\x0f\xff;y\xfa\xf9\x9f\xb3\xf2L\xf1\x05\x8e\xcaR{\x9aL\x9e\x91\x0e\xfdj\xa2\xa6X*\x9c\xf8\x8d\xaa\xde\xfa\x93\x14\xcc#\xd2<\xfe#9\xa5\v\xcai\xae\xb8\xff\xaf\x86]\xd8s\xb2?\x17\xbb\xce\xf9px\r\xb9\x91\xb2T\xf9\x8e\xfa}\xbf\xeaX\xf7\xcdoC\xf4\x19\xccR\xe1\xe9\xf0\xbeC\xbb\x0f\xe6\x01\xc0\xcb\xd7Ņ\xca\xf6N?S\x9f.\xab\xdd+4}\xf7\x15\xea\x06|\xa9\xcf\xffgY\x8f\xfdm\xab\x97\xce[(Q*\xe5<\f\xfe\x02\xd8R\xbb\xef\x90\xde\xfe\v<\x04\x80\xeb\xec\xe0c!\xef.\xa6\xd6\x0e\xb4#\xf4\xea\xaa+\x16\xbb\x91\xc8\x0el,ߑ\xbd\xbfI\x9a.\xa5\xa6Q\xd1\xeeH\xf5\x05\x1d\x16'\xb4s\xe6{\xa5\xd7\xfd\x10u/\xbe\xc4\xf2\xbe\xaf˓\v\x1f\xf6\x0e\xc4\xf1\x0e\x80M\xd3*\xa99\xf5k\xb4\xfb\xe6\xbfEA\a\x00\x1ck\x8b\x9f\x0fy\xaa\x1d\xa5\x18\x15s7\xde\x05\xb0iڢV\xf5U\x97^\xf5\xd8t\xf9\xe1\xdb\xf3dyN\n>\a\x06\x00^\xbe\xbeZ(\xda\xd3)\xa4\xc9j~]\x1a\x1fU\xd97\x96\x89:\f\v\xed|mK\xdb\x1a\xc0\x8bz\xfe\xe6T[\xa7\xec/\x8fg\x00\x00\x00\xdb#h\xa2զn\x9d]\xac\x165\xea}Q4\x9d\x96fo\x1b\xae\x01\xac\x97\xa6\xf9\x84\x94\xbdg\b\xc0Qi\x92\xef\xe4\xedg\x13\xabT\xadN\xad&\xca\xd3\x13\xc3{8\x1a\xf5\xed?\x12\xa6w\xfe\xd7av\xf7_\b\x93\xb7}4\xd4o\xffD\x98\xbc\xf33\xa1~\xf7ca\U0009e2e1~\xdf2\x14w\xfe\xb0\xc2\x1d\xff\x85\xae\xec\xbeU\x97v\xdel\xc7kuev\x9b\x0e\xeb\x13\xea\xaaB}h\xec\xf1z0\xfe\x13\x01\xe0\xe5\xf3\x8d\xfe\xb2\xe6\xcaU-M\xe7*\x0f\xecƧ߷\xbd\xab\xcb\xe2E\xa5|\xd9~\x14\xf6\x85\xb1\xefH\xcc\xca\f\xef\x02ۭ\xd8\x1dO\x00\\/\xf3\xcf\xfd\x8a<\xf19\xdf\xddtկ\x16<pc\x12'\xb0\xd1|\xa3\x8f>)\xc7v8|!C\x8f\xbb\xdb\xc9m$\xb1\xe3E\xe5\xe6\xfe\xd5w\a\xc0\xc6\xcaUK\x12;\x00\xe0\xf8{\xea\a\xf3$\xd7Vq\x1d*\x8c\xf3\xfd\x00l\xa8\xea\xfa\xf4Q\xf6'ޥT0\xbf\x10\xc0\xb5+\xfa\x89Bk\xf1\x88'!x\xeez\x91\x95\xd5\x0f\x87b\xa7\x1d;\x9aO|#\x81\n\x80\x17\xb5Չ\xec1/\xc63\x00\x00\x80-\x12\x8aq\xbeŰ\x1c\x9a\x1d\xab6c֎t\xd7\x1fa\x80\x16XC}\xf7\x8c5p\xe8h\x06\x8eR9\x8dRe'yf\xd5\xeb̪ۉ\x8a\x1d\xbf\x81\xe3,\xde\xf6#\xa1\xbc\xfdO\x86\x13o\xf9H8\xf5\x8e\xfb©w\x7f.\x9cx\xdf\xe3a\xe7\xae\xff\\\xe5M\xbfR\xday\xbb\x96\x93[\xc6O\x03\xc05\xc8q\x98\f\xbb\x98<\xadPZ}\x11\x16ꟹ\xac\xf6\xc9\xff\x17\x83w\xf8\x029\xcd\xed\x87}I\x06I!\xd0<\a\xb6\x9a\xb5'\x00\\_\x87ˏ\xa9\xf0]B:\xab\x7f\x87]\xccz\xab\x81Id\a6Z\U000b8ef3\xd2ޮ\xfa\xd3CToaw.ό\x1f\x00\xbeTN\x17\xec\xbbB\xfb\f\xd8dEI7\x1d\x00\xe0\xf8뺇\x15\xf2\xc9Յo\x85\n`c\x85xu\xbc\xf0Օ\xa7\xefR.\xfb\xf1\n\x00\xaeA\xbb+-K\xa5֟%I\xa1\xb0\xf6t\x11\x94}\x91\x8c`\xf7*\xe9p\xf1\x81\xd5g\x01\xe0\x05lm\"\xfb\xa5O\xbd/W\xbe\xbb\t\x00\x00\xc0\x96\x89\xf1\x82\xca|Vގ\xecgK\xbbq\xa8r^\xa9\xaf\x1f\x18?\x01`\xddL\xad(\xab\xaeW\x17\x00\x8e\xc4l\xbfQ\xbe4Q_]\xb6\xf2\xf89\xb5Z\xa8\xb8\xf5\xbfe\x04y]\xdd\xf6#A\xaf\xfb;\xa1x\xfb\x87\xc2\xf4\xbd\x8f\a}\xcd|8\x0eo\xfe6]ٻE\xf3\xe9\xc2\xfe\x9c\xed\b\xad\x05T\x85\xd4\xcd\xecص\xc3W\xc1\xf7\x9dt\xed\xbdj\xa1\\\xdaQ\xf8N_\xf6ޤ\xb1\a\xb6\xdd/\xec\xfd~*%_\xe8\xc0;\xf2\xfd!\x0e`S5eR\xd4\x15\xcd\x0eJ\xc5+g\xac\u0098h1{P\xf3\xc7>9~\x02X)\x96\x9fR\x9a\xf9\xae\xecS\xb5!Y\x15\xc3D\x12`\x9b\xedO\xdf3\x9e\x01\xb8^n\xea\x9eVط\xb6\\8\xad\xe5\xf4P]ѪfGv`\xc3M\xd5\xc5\x1d\xe5\xbeW\xd9{\x02\xc8ӊ\xe9fMN|\xdd\xeam\xe0\x05\x04\x9f[6\xa1\x9b\x17Xgy\xb5ʿ\x15h+\xcb\xcf?\x8c\xbfw\xb8\xd8\x19\xce\x01\x008κ+\xffL\x8b\xe9\\m\x15\x15\x16[\xbd\x8f!\xb0\xfe\xa2\x95\xe18U\x9b\xb2rѭ\xe6\x94$_\x11\xdd\xe3\xd2^\x17N\xfe\xd6\xd5\xe7^e\xbbw\xfc\x7fÓ\xbb\xaf\x1f\xe6\xad\xf4:aϓ\x85R\xac\xed\x01s\xbb\xc5ǾAh+%bc\x00_Fq(\xd5I\xb1\xa8\xec\xd9QH\x8d\xc5%}T\xf4\xfe\xb3\\\xa8\xef\xce\xda\xd3e\xae\xe6#o`\xc58\x00/hk[2\xc1'\x15_\xed\xa4\x04\x00\x00\xd8&\xc9\af\xbfh\xe7\xe6X\xa8\xaeo\x1d/\x00\xac\x9b\xe8M\x1b\xda7\xc0ъA\xa1\xb0\x97qe\xe4P\x9c\x1e^\xb1Yv\xee\xfd[\xe1\xc4\xdb\x1e\x0e\xb3w\xe5\xa0\xf7\xe4pp\xf2\xdf\xd5S\xb3\xd3Z\x9c\xbc\xa0ŉsZ\xce.\x0f\xfd\xf4\xeaOH˳\nͮB\x9b\xd4\xc7sj\xe7'\xd4\xeeߡ\xd4Ϥ\xba\x93\xca]\xe50\xb5\xf7x~\x03\x9b\xac\xaa\xac\xcc\xc7ʎ^*\x1a\x8b\xd9\x1a\x15\xca\xca݃\xd2C\xffo\x1e\x00xN\xee-\xa4\xf7\x05N|\"5\x93Ѐm\x17\x03\x8b\xd5\x01\xd7\xd3\xf2\x91\xff\"\xf7\x16\x9a\r\xf5n9\x97\xb7\xe4\x93w\x99\xaf\xf2\x99\x00l*k\x97ե\xb5ϼ\xbc\x97\a\x16\x83\xd7JU\xa7\xd9\xed\xff\r\xa5\x1f/\xec\xf1\x1f̅}O\x00\xac\xb70&\xad\x7f\x81\x9c\x87$v\x17\xaa\x9b\x87W\x00\x00\x8e\xb3\xd45\xeaS\xbb\xbax\xa1\xba\r\xc0\xfa\xc8\xfd\xd0\x0fY\xf8\xa8q\xf61A\xbb\xf0\x9d\x8c\xd5\xd8u\xa7[\xee\xfe\xf1\xebV\xc8ww\xff=\xf5\x9d\xfd\xbbc\xa7\xca\xfe\xfd!\x1dJ\xe59\xfb\xf7\x97J\xbd\xfd\xb7\xf8x6\x00\\\xa3`\xb1J\xe8\xa2\"s\x99\x01\xbc\x88\xedMd\xf7\x91iO\xe2\x02\x00\x00\xd86\xde\xf9廻\xf9_\x9eq7$\xb6W\xaa'o\x1a?\x00`\x9d4\x9f\xfem\xb9ʥzf\xda\x02G+X\xfd\xea\xc9\xec!(\xf9pO\xf9\xba\xf1\rl\xb2\xdd7\xff\x1f\xe1\xd6\xf7<\x12\xa6\xefȡ8\xf5}\x9aOާ\xcbUֲ\xbe\"\u0557Wߋ~Ge\x7fBe<\xb4\xcbKJiǞ\xd9Y}\xfd\x84\xda\xf2\xb2\xba\xf6\xae\xf1\x9f\x06`\x13\x85\xe8\xbb\xfcY\xbb+\xb4VO,\x86I\xb2S\xbbS\xe6\x87\xd4]\xf8\xa9\xe13\x80K\xfd|5\x81\xda\xda\xe9\x1eO<\xbb[\x18\x80\xad\xb4s\xe7\x1f\xa5\x91\x0f\\G\x8b\xf9ϩ[\xdaIQ\x0em\xb7\x18v\xad.\xf6U\xc9\x00l\xb4\x90\x14\xcb\xce\xdacv^\xf4\xea\xf2D\x99E\x9e\xf1e4\x87?\xaf!\x91=\x13\x9a\x01k͓\xfd^$\xe1\xcf\xfb_\xaa\xd9\x1b\xc6+\x00\x00\x8e\xb1~\xa1\xd8gk\xd6\xf8\x98\x13\xe3\a\xc0ZK\x9e\xc8\xdeY\x88je\xba\xaf\x86\"\x9d\vOb_\xa8\xd3\xf5-ߧn\xff\xb3A\xe9\xf5R<\xb4\xff\x8e\x99\xfd\xfb=\xab\xdd\xe7\xb6\xcc\xd4\xfa\x82ۅ]\x03\xc05\x8a\xa9\x1bvg/ҁ\xf6?\xf3\xcb\tX\x00|\x89\xadMd/\x92O\x1a\x1c/\x00\x00\x00\xb6I\xae\xa4\xd0hHb\xf7\xa4v\xdfy\"\x97*\xeaw\xae\xde\a\xb0V\x9a\xe5\a\x14\U000eecaf\xd6\n\xe0\b\x05)\xfa\x80NPo\xf5jY\xbdmu\x1b[\xa3\xba\xfb\x8f\x87\xd3o\xfb\xa5p\xf6\xdd]\x88\xa7~\xa3.W\xa7\xb4_\x1d\xaa\xf5\x8c\xd5tE\xa1/T\xda\xf7\xa3\xac\x9fRQ\x1e\xf8\xb8\xa4\x8aT\xa9\xae\x9fY\xfd\x03\x00l\xa4\xae\r\xea:O\x8a\xcaVEX]\xd1\xfb\xee\x7fA\xbb\xbe\xbeX\xf3\x80\xd2\x13?@/5\x06i\x18\xb3\xb0\x98\xde\xda\xe9\xd1w@\xe0\x9b\x01\x00\xc0u\xd3/?\xac\x18-F\x8b\x9e\xd0d\xed\xb5<UH\xbe/;\x150\xb0цŝ/\xab\f3{\x10H\aE\xa3r\xf6\xcd\xe3\x9b\xc0\x97Z.>h\xed3\x12ف\x8d\xf3\xbc\xc5\x03}1\xc1j\xf7=\xe3\x15\x00\x00\xc7W\xd1/U\xe4\xa4\xe0s\xfd\xb66\xfb\x03\xd8\fi\xe8\x83\xec|\x1b*\xc9wA\xb7r\x1db\xaf>tJqo\xf5\xa1\xeb\xa8\xd8\xfdf\xb5\xb1\xb5\xff\x04\x9fkX\xae\xbaD\xfd\xba\xf4\xeb\x89\x7f\x04\x00\xae\x8do\xac\xe7\xd3c\xfag\xd4\xcc\xff\xc5\xea\x1e\x00<\xcfV6e\x9a\xa7\xbf?\xc7~ng\xb4\xe4\x00\x00\xc06*\xe5\xbb\x01F\x9fta\x8dF\xef\x18\xf3\x9dcu\xe7\x7f\xcd,\f`\r\x85\xf6\xa1\xa1C\x9byT\xc0\x11\xcbY9u\xc3\xc2\xc9^\xaf\xc6{\xff{J\xe5\x16\xab\xee\xfd\xc9p\xf2\x9dτ\xbd\xf7uᙛ\xde,շ\xaa\x8f\xb5\xda0\xb7/H\x96:\xfb\x8et3\x15\xa9U\xf0\x1d\x9a\x01l\xac\xd4\xcd\x15B\xb4\xa0mb\x87\xb5ź\xa9\x1dg\xed\xfc\xe40~\xb7x\xe6\xff\\}\x10[\xafO\x87\xe3\xe2T\x16\xdb[U\xe1\x13\xa9\x01\x00\xc0u\xf0\xb9\x1fȥջU\xe9\v\xbez\xbcf\xf7\xac\xee\x1d\x12\xdb=\xb3\x15\xc0\xc6J\xa1P\xee\x0e\xac\xc0\xd7j}\xbe\xf6\xb4Vxݟ%\xf0Ƌ\n\xcdy\xfb\x99\xadv\xe0k\x02l\x8c/Jb\xf7#\xde\xf9\x13\x14r\x00\xc0\xf1\xf6\xf9\xff,סW\x1d\xa3\xd5]\x95\xd5g\xf4_\x00\xeb,\r\xa3ĭ\x05\xa4\xd6\xda\xf4\xc5)\x8c\xbft\xf2ͨ\xee\x1c\xae\xaf\xab\xb7\xfe\xf9\xd0\xc4[\x95\xbb\xa5]\xec\x0e}\xa39,\xec\xdfm\xffm=\x89\xec\x00\xae]\xf6\xe7[\x9d\xec\xf9\x924MY\xf3\x87\xbf\xe3\xb9F8\x00\x98\xedLd_|\\1\xfb$a\x0f\x02\x01\x00\x00\xb6K\xf6\x1d\xd9\xd5+'\x1f\x98\xb5p\xd0\x13`#\x1dP\xc0\xba\xaa\xfan\x98q\x1bb\x1a\xef\x008\n\xd9\xea\xd4>[q\xb4\xa2\x98\xa3o\xc1\r\xac\xdc~\xcf\a\x82\xde\xfbd(n\xfdUZ\xeeު\xfd\xb0\xa3\xa6/\xec\xbb2\x97\xba \x1d\x9c\x1d?\t`\x13\xc5p\xa0\u0093ص#\v\xd8찊\xa2\xf7\x95\xedw\xed\xbcV8\xf8\x90\xf4\xb9\x1fa\xf0\x0e\nZX\x1b\xddg\x8aD\xfb\x9a\xf8\xfci\xe6P\x03\x00p]\\\xfcY\xcd<y\xbd\U0003ed3c\x9a\xfb\x9d;\x8b\xd9\xca!U\x11\xc0\xe6ʪ\x94\xbd\xe8\xf7^\xf6KU;oZ\xbd\x01\xbc\x88\xca\xeb\v\xf9\x02u\xb4π\xb5\xe7\t\xec\xcfKb\x1fx٦|\x03\x00\xd6@\xdb|B\xc1;0\xacڊ\x9e\xed\xfa\xc5u\x1a\x80\xf5ruA\x8a\xe0\xc9\xecV\xb0\xc7P5\xe9\x84\xca\xe9[\xc7\x0f]_a\xf7\xdfQ[L\xed\xc4\xff\xe535\xf6\x12<\xb5\xca7f\x00\x80kԗ\xfe0\xb1\xe7Z\x92v\xd2I5\x97\xff\xfe\xf8\x0e\x00\xacle\"{\xdf<d\r9_A\x88\x8eH\x00\x00\xb0}\xb2o\xdb\xec\xbf|r\xbc\xffU\x14\n\x15\x89\xec\xc0\xba\xaaӮ\x94\xac}\x13\xe9H\x06\x8eR\x8e\x85\x8f-\rʊDv\xbc\x80\xd7\xfdOa\xef\x1d\x8f\x85\xfa5\xbfR\xf9\xf4]j\xf4:\xa5\xf6N{~\xfb\fj\x00\x9b\xaa\x88\x9d\x95\xf3\xa8\xd4E\xf5\xa1U_\x1f\xa8\x8dW\xec\x9df蝟\x85^\xcd\xc1?Y}\x18[-\xe7Vч,\xacZH\x9eCg\xdf\x1b\x00\x00p\x1d,>\xa5\xaa\xad\xacµx,$\x8b\xd7NXE<\x1f\xba֒\xafN\a`c\xf59+\xfaZ\xcf\xed\xa1bYhg\xf7\x9bWo\x00/\xe4\xa9\x1f\xccu\xf2\xed\xe8\x0eh\x9e\x01\xebΞ\xff\xf9y\xc7\xe0j\x12;\x05\x1c\x00\xb0\x06\x16\x8b\xcfZ\x83\xc6N\x86jl\xc84\xf5\x13\x00k*\xa8\xf6\x10\xd5xY\xb6\x13\x8bK{;\r:-\xcd\xde\xeeo\\w;\xaf\xffɠ\xea5\x16\x0f_\xf6\x01m\xf5]eϙ\x13\x8a\xc1Ǳ\x01\xe0ڤ\xbaQ\xee\vE\xcd$ߕ\xbd\x7fZ\xfdC\xdf36\xc4\x01`\x98*\xb7}\xfa\xee\xbc\xc5|4\xe2\x00\x00\xc0vJ\x9e\xc8n\x86A\xdaP(ĨX\xd6\xc3=\x00\xeb\xa5\xfd\xfcwd\xa5\x1d+Ѝ\x86\xdd\x1b\x01\x1c\x99P\\\xedb\xb1z\xb5`\x81\x18\xbc\xb8\xfa\x8e\xbf\x1b&o\xba?Ľ;\xd5\xee>)\xcd.\x8e\xef\x00\xd8DWC\xb4\xaeK\xeas+Y\x15\xd1ׇJ\xe5\\\xa9h\xa4j\xb5\x93\x06\xe0_\x96a\x97\xbf\xab\x93\xaa\x99H\r\x00\xc0\xf5\x91\x9e\x19&e\xcaB\xb3Ay\xd2\xea\xe1\xce\xea\xdf~\x9c@\n`S%\x9f\x1c^\xedIM\xa7\xa2L*\xef\xf8\xe3\xab\x013\xe0\x05,\x97\x0f*\xf4\xf6}\xf1\xbaaXu\f\xc0\xba\xba\x9a\xbc\xfel\x12\xfbUW\x93\xd9\x01\x008\xe6\x9a\xf6\xe91\x91\xdd~\xf8\xbc?:0\x80\xf5\xe6\x1be<[\x8c=\x83}\x8cW\xb5#\xdd\xfdc7,@-\xea\xdbW\xd9d\x85?^\xbc\xbf\xe4\xa4T.Vo\x02\xc05\xe8\xcbFMS\xdas\xed\x16\xbb\xb8\xa2I\x9c\xea\xca>\x1b;\x00x\xceV΄:\xdd\\P\xee*\xb5\xf5|\xbc\x03\x00\x00\xb0=\xcaШ\x0f\x16\b\x96\xd6XL\xe7\xa4\xee\x94\x0e\xf6\xbeq|\x17\xc0:\xe9\xe7?\xa5\xc5켺\xbaVѐ8\v\x1c\xa9x\xa0\xaa\xb1z\xb6\x9b\xaa;\xf9\xd5\xe3M\xe0\xc5\xd5\xef\xf8\x17a\xf2UM\xf8|\xf1o\xd9\xf7g!_t\xb1\v3e\x8f\xd1\xfaSRsBm\xb1\xd0\xc1I\x06\n\x81\xb5\x96\xa7\xd6溬\xba\xdeW\x9d\xa6*\x0e\xa6\x9a\xf6S\xc56\xd91\xb5\xa2\x7fB\xbb\x8b\v\xca\x1f\x7f-\xb3\x8e\xb6]\xbaM\xa1\xeb\xa5\xd3\x17\x95C͎\xb0\x00\x00\\\x0f\x1fzkVUIա\x14,N\x935\xe4\xfbsv\xd4\n\xb1S\xe9\xb1\x1b\x80\x8d5\xcd\viY(\x95\xd2\xe5\x9d7\x8ew\x81\x17q\xe5\xefZ-\x91\xd5O\xac\xb9\xe6;\xd3\x01X[\x9e\t\x14\xc2R\xb9\xa8\xd5ęTX}\xd0Ε\xda[4\xbf\xe9\xdbW\x1f\x02\x00\xe0\x18;YuZ6\xaf\x91&S\xf5\xe1\xbc\xd5e֨\x01\xb0\xb6\x8ae\xab\xa6.W\x1bRM\xaf\xa8\xaf\x97\xaa\xe7wi\x1a>>~\xe2\xc6(\xde\xf9/\x82&\xa7\xd5/\x0e\xec\xf9\x92\xd4L/H\xdd\xf8&\x00\\\x83ٕ\xdbTO;-\xa6\x8fXC|G\x16\xc0\xe8t\xfbY\xb5\x8f\xfe0\xf3a\x00\f\xb62\x91=\xa6Ҏ\xa0\x10y\x16\x02\x00\x80-\x14\xdbqa\xf1z\x18\xb5\xed\xedz\xf7u\x7fv\xb8\x03`\xbd\x844\xee\xd6\b\xe0\xc8\r\v$[m\x9a\x8b}U\xd5=\xab\x9b\xc0Kp\xf7;\x7f&h\xfa\xdb\xd5\xcev\x95uA\xa1\xe9-L{FirEe\x7f\xabv\x97$R\x00\x9b,\xf5V\x81T\xf6\xea\xabRc\xbb\x05O\\\x8f\xcf\xed\xc00\\\x03\x00\x80WS\xea/\x8dg\x00\xb6\x93\xc7\xd8\xd9\u05ee\xd0N\xf5o\xaen\x01/\xc6畅~\xb5a3\xc30\xc0\x9a\xab\x86\xf1\x1b\x9f+\x9ask\xe5{:̚MՁf\xaf\xfd\x8b̓\x00\x00\x1co\x0f\xfePVW(\x96\x8do\x99<,\xd0\x02`\xcdYA.|#\xf6a\x12\xaf\x1d\xbd\xf7W\xf4v\xf3\xec\xf0\xf6\r5}\xa3\xbc\xc9;ɥJ_\xc9-\x17\xab\xfb\x00p-\xa2\xb5\xb9CR\xf0g\xc9p\xd83\xae[\xa8[>4~\x00\xc0\xb6ۺD\xf6\xfc\xd9ߝ}y\xe5<\x04YL\x04\x03\x00\x00[(6\n\x9a\f\xbb\xccx?XWX\xc3\x11\xc0Z\n}\xa7\x98\xfa1\x81\xd6;\xb7\x01\x1c\x9dh\xbfv\xd5x={\xdb\x1f\xa3@\xe2\xe5y\xc7_\x0e\xd5\xd9ߣbrV9̕\xec\xb9\x1e\xab\xda\x1e\xedW\xa4\x8b\x16\xb3\x01\xd8XâD\x9eȾ̺\xf8\xe0ofj\xfc\x16\x8b9\xd9\x17\xc2N\xd2*\x8c\x88,X\x05\x00\xc0\xabj\xf9\xd8\x0f\xe7>\x9d\x1f\xaf\x00l'\x9f%\xbeT?\xadT\xdd\xfb\x97\xe8\xbf×\x95\xfb\xacX\xf8\xd0KP\xa0}\x06\xac\xb9r\x98\x17\x11c\xb2\x97ƪ\x83\xdd\xe1n\xaa\x0e\x87W\x00\x00\x8e\xb3\xb6\xfb\xb8rW\xa9,\x0e\xfcJ\xd9\xe7\xfcm\xe7>\x86\xc0\xe6\xb0ش\xf4\x1f\xd9\xcbr\xe5kT\x98^q\xf2V?\xb9\xb1\xde\xf8\x81\x10\xcaZe\x93U\xf5\xbd=c\xd8h\x01\xc0+\x10\xe7\xab90\xb9^\x1d}a\xe1\x8b\xc5/\a\xf7\x8d\x1f\x00\xb0\xed\xb6\xae%3o>\xe2\xd9Z\xf6?\xee+\xe72.\x05\x00\x00\xb6PXX\xe3\xb0\\\x1d\xd6^l\v\x92\xa3\x80u\x15\xfbV\x85\x95\xe30tl\x038J)\xf9D\xd8\x1d\xb5l\xa8\x8bku\xe7\x1f\n\xf1]\xe7\x82\xf6\xeeU\xdf\xef\xc8\xe7\xd3)Z\xdcV^^\xbd\x0f`#\x05\x0f\xe3\xbcm\xa6}\xe5ß\x1e\xeea;\xe5\xe1\xc1o\xaf\xa9\x90\xffŎ\xec\x00\x00\xbc\xba\x9a\xfd\x7f\xa8\"\xac\xea[\x00[*K\x8br\xa9\xbe~\xc7x\x03xq\xb1/\x14\x8bq!\xe1\xde\x7f\x00X_\xaby\x11\x8a\xde\xd7\xd2\xdb/O\xce\t\xeaJ\xe6\x8e\x02\x00\x8e\xbf\xa6\xfb\xb8UaA\xa1X*\xa7\xa4\x18f^\x8d\x01XgV\x86C\x8a\xab\\\xa6\\*x\"\xbbǪ\xd3\xf7\xae\u07bf\xc1\x8a\xdd\xd7[\x8c\xec\v\x80.ԱP\x06\x80W\"\xb6C\"{L\xbe\xf1\xb0\xb7\xc5\xfd՞3\xcd\xfd\xea\x1e\xf8Ut\xb0\x01ؾH\xa3k>/\xa5\xde\x1arCķ\xba\t\x00\x00\xb0E|\xaeEN\x16\a\xf9R\x8ev\x1e\xaa\xbb\xc7w\x00\xac\x95\x87\xbf+\x17}\xafr\x98y\xe1\x86\xe5Y\x01\x1c\x91\x90V\xf5j\xaeώw\x80k\x13\xde\xfe\xd9PMߩܴvL$\xbeR\xc0F\x8bE'\xb5\xbb\xaa\xca\v\x9a5OJO~/\x83w[*Ⱦ\v\xbe\xb2\x815\xdaW\x13W\x88\xef\x01\x00xU->\xa2\xc8Do`\xbb\xa5\xa0\xbe\xac\x14&\xdf2\xde\x00^X\xff\xe0\x7f\x98=\xa9@\xa1\xb5+\x7f\xa5\x02\x01\xd6Y\xf21ԡǭ\x1b\xfb\\|0\xc7\xcaz\xb8cx\x1f\x00\x80\xe3,u\x8f\xaax\xfexA\xa8\x94C7^\x00XG\xdeܴ \xd5\x04%+\xd3ъx*\xec\xc7k\xff\xec\xd14>\xf7~\x83\x92\uf155K{\xbex;\x18\x00\xaeQ\xb6vw\x8e\x8a\xbep\xbf/\xd0Q\xda\x03\xcf~U邊\xf9\a\xc7\x0f\x01\xd8f\x1e\x06m\x95\xd8\x1d\xdañ\xb1\xb8\xcf\x1aq\xb9\x1a\xef\x02\x00\x00l\x97\x90\xb2\xfdX\xdaYTY\xbdgu\x13\xc0Zi\x9a\x0f*\xf4ޮ\t\xab\x8e\x1f\x9ft\x01\xe0\b%\xfb\xab\xd1t\xf6U\xe35\xf0\n\xbc\xfbgC8\xf9>-\x8a^\xfb\xf3\xd5\xea\xb4\x006\x93\xcfFh}Gv+\xef\xd36\xab\xbf\xfc\xafVo`\xebdOd\xf7yԩ\xb0\xf8\u07be\x19\xe4I\x00\x00\xf0\xaa\x9a5\v\xabhi_\x01[͇\xc6\xea;\xb4s\xcf\x7fE\xb4\x8d/\xab\xed~IE\xaa\xec\vc\xed4\x1fS\xcd|e\x80u\x96CceyW9\xf5*\x82\x97\xed\x85r̪\xaa\xf7\x8d\x9f\x00\x00\xe0\xf8\n\xa9Q\x88K\xa9\xab\x14r9\xdcK^\xb7\x01X[ɓ;\xedW\xaflG=$}\x86U\xf1>\x1aw\xfdh\xd0I{M{\xf6\xbcY\xac\xee\x01\xc0\xb5\xf0\xb9̝\xcfv\xb0\x87\\\xb4x\xc5\xda\u07be\xc2p\xb4#t\xcfH\x8f|\x1f\x93\x9c\x81-\xb7u\x89\xec\xa5<y\xfd\xd0NZ{H\x92\xc8\x0e\x00\x00\xb6ϰʸ\xf3N\xa7Pi\xf2\xa6\xbf\xc6\xec\v`\r5ݧ$\xdf\x01\xdaK\xb0w\xef\x90\xc8\x0e\x1c\xa9a\x03\xd5Ш\x9a}\xcdx\ax\x85\xde\xf5/\xc3\xe4\xccש;\xb8m\xbc\x01`#\xa5\xa4P\xfaN\x1aV\x91t\xbd\xd2\xe5GV\xf7\xb1u\xe2\xb0#\xbb\x05\xf7\xc9\x03|\xfb>\x10\xde\x03\x00\xf0\xaai\xee\xfb\xfa\\\xb6\x85\xc5[;\xe3\x1d\x00[\xa9\xc8*\xaaw\x8c\x17\xc0\x8b\xeb\xbaO[\xab\xacR\xceI\xd9\xda\xedC\xe7/\x80\xb5\x15|\xf2\xbcN\xca7\xb3\r\x9aڏ\xcbC\xa2\xd0\xf4\r?\xc9<\t\x00\xc0\xb1\xd6?\xf2C9\xf6\xb5\xb5e:\xa9\xb5:LV\x81\xf9bK\xc1Ǖ\x00\xac\xabޣ\xd0>[\x9b3+E\xcf`\x8f\nG\xbc\xfef\xdc\xfdn\xfb\xd9\x0e\x99V\x00p\xcd|a\xc8aG\xf6ޞo\x87ꣽ\x16;\xca\xde\x16OI\x8b\v\xff\xfb\xf8A\x00\xdbj\xabzڛ\xa7\x7fw.\x86\xe4u\x1fd\xf0\xc1\x06V\\\a\x00\x00[(T\xab\xf9\x16\xbe\xb0O\xa4\xeb\tXW]\xff\xccx6α \x91\x1d8Z1\x0e\x89\xec\xba\xfdǘ\xf8\x84WM|\xe3τ\xd3'g\xe3\x15\x80M\x94S\xb4&\xdaR\xeaw|n\x80\xfa\xf9R\xe7\xcf\xfd^\x02\xbb\xad\xb4\xfac\x7f6\xac'\xa2\x00\x00\xe0U\xb3\x98\x7f\xd0\x02\xad\xdda\xf72\x00[\xac\x90\xaax\xcfx\x01\xbc\xb8\x9c\xfc\x875\xca\xec\xd7\xd0F\xbb\xbaH8\x80\xf5T\xf8\x86GS\x9f3o\xc5\xda\xe2\xc1\xd8\ru\x02\x00\x00\xc7]\x97\x1eUau\x98\xd7[\xd9\xfb4riaj?\x84\xaa\x00\xd6װ\xa6\xf5(\x0f\vT؍\xe2\x88\v\xf6\x9d?\x11\x9ap\xc1N|\xd1\f\x00\xb8F\x9e\xaf\x99=A\xa1S\xaf\xa5ڜ\xecl\xa2\x9cgv\xbfPsp\xdf\xeas\x00\xb6V\xf0\x95|\xb6\xc5\xe5\x8f}m\xde랱\xff\xeb\a\xd5\xcf\xefRq\xea\x11\xc5\x03&\x03\x03\x00\x80\xed\xd2\xd5\xf3!\x87\xdd;\xb7\xe7'Oi\xf6\xe6g\xe8\xde\x06\xd6\xd0\xfc\xbe\x9d<\xbbb\xc5wX\xa0+\xa8\x9f^V1\xac\xc0\f\xe0H,\x17z\xfaD\xd0-\xefy\xfe\x90\x13\xf0\xeaX~\xfc\x9e\\\xcc/\xa9\xd4%\xbb\xdaSS\xef\xabO\x95\xa6\xa1U\xe8\xbc.\x98\xac>\b`\xed\xf4U#\xb57\xa9\xa8\x9e\xb6\xc2~\xab\x14\x9f\xd2|\xf75\x9a\xbd\xfdq\xea\x93m\xf2\xc4\x0f\xe7\xee\xe1\xffJ\xa5\xc5\xf6y/k\xb9l4m\xed+P0\xab\x1a\xd8T9\xd4\xea\xf3\x81J\xdff\xa5\xb7\xa3\xe8\xd4Yl\xd7峚\xee\xbeSz\xdbOS\x0f\x00\xaf\x92\xfc\xc9\xff8\x87\xf9OZ\xacuF\xed\xecIU\r\xfdg\xc0\xa6J\xb1U\xf4\f\x8f\xce\xe2\xea\x10\x95|\x83\v\xedYX\xed\xd7O\xe9\xb2ծ\xa7\xdf;\xec{\x06|Y\xed'\x8a\xac\x8b\xb7\xa8\x9a\x9dWέB\xefs\xcbXs\x0eX[\xe5R\x87\x8b[4\x9d\\Ql}\x17\xdbB\xcf\xcc:\xdd\xfc.\xef|\x01\x00\xe0\xf8\xba\xf4ѻ\xf3\xa9\x83G\xa4\xe26\x1d\x86'5\x89\x95\x8a6\x0e\x8b\xec\x13\x9f\x02k,\x9cT_<e\xe5y\xc7\xce\x0f\xa5t\xab\x0e_\xf3\xeb\xb5sϟ9\xf2\xf84\xfdːӴ\xb4G̮\xb5\x87\xb3\xa2\xfd\xf7\x15\xbe\x10\x94/\xaa\x91\v%\xf5\x8a<~\x00\\\xa3N\xad\xbaS\xaf\xd3\xf4\xcd\x0f\xd0\x1e\a\xb6\xd4V%\xb2_\xfc\xd8\xedywyAUXJ\awY<\xf5\x88\xba\xaa\x1e\xdf\x05\x00\x00\xd8\x0e\xe1`Oe뻱\xefiy\xd3)M\xdeq\x1f\rB`\r\xcd?6ͳ\x838L\xb6P\xcaʓ\x03\x85\x8e\x89\xb8\xc0\x91\xe9\x16\xbap\xea5:C\xe2!\xae\x93Ïݝ\xeb\xfd+*g\aj\x17\x95T\xcfU\xe9\xf5V\a|\xd6\x0e\x9e\xff\xc0\xba\x1a\x12ٻ\x13*\xe2%\xa9\xb9Y*.h\x7f\xb2\xab\xbdw_\xa2>\xd9&O\xfcp\xee?\xff'T\xf4Qi7\xa9iZM\xbb\xab\x93\xd1\x00l\"\x12ف\x1b\xa7\xfd\xe47\xe4\xea\xca/y\xc1Ӳ\\hҗ\xe3;\x006\x8d'\xb2[\xa5\xaa\x98j+\xf3\xbe\xed\xae\x1d\xb9\xb2\xfbY)\xec\xab;\xf5oh\xfaƟ\xa5\x8eŗ\xd5\xdd\xff=Y\x8b\xffF:\xd8U1\xb9\xa4\xe0ߥ~\xd7\xde\xe9W\x1f\x00\xb0~\x8a\x85\xe6\xedM\x9a\xd6K\x85\xe5\xdc\xea\x87Z\x17N\x9d֙\xb7>F\x9d\x00\x008\xd6.}\xe45\xf9\xd4\xc1\x93Ru\x9b\x96zR\x95\xd5a\xb1\xcbR\xf4\xbe\r2I\x81\xb5\x15&\xea\x8bK*\x9aSV\xbe/)\xa5\xb3\x8a_}\xeeXĦ\xfd/\xbe)g=\xa0({\xde$\x9f\x97\xe8\xfd+\xfe\x9fV\xd9S\xa7\xb7\xa3\xb3\xfb\xc5\xea\xc3\x00p\r\x16;\xa75}\a\xf3+\x81m\xb5U\x89\xec\xe7>\xfaƼ\xd3=\xaeYu(\x1d\xbe\xc1\x1ar\x9fWb\x9c\x01\x00\x00l\x99XϤ\xd6b\xc0\xe2&\xe5\xb3w(\xdc\xfb/h\x10\x02kf\xff\x81o\xcf\xf5\xc1\xdfU}he\xb9.\x95\xda\xce\xcav%\xf5i\xfc\x04\x80\x1b.-tp\xf3\xbf\xa5\xdd7\xfc\f\xf5*\xae\x8b\xf6s?\x90\xfb\x8b\x7fU\xd3\xf6\xa2\xe4\x13\x14\xc2i\xab\x03|\xa8\xf0\xa2\nO~\x02\xb0\x96R\xd9)\xa7\xa8»\xe9\x1bk\xabU\x9d\xae\x14\v\x157\x7f\x97v^\xfb\x13\xd4)\xdb\xe2\x89\x1f\xca\xe9\x91?\xa9\xd8Gu\xd3V\x9d\xb5٧v.\xf2\u0601\x8dE\";p\xe3,?\xf1\x9a<\xb9t\xc5⬥\x16\x9ah\xea\t\x89\x006R_,\xad}5\x1dve\x8f\xc3\\(\xdf1l\xe9\xeb\xc0*\x97\xb5\x8a\xf7/\xa9_\xf1\x15\xcd?\xf9\xad\xb9\x9c\xff\x8c\xaad\xed\xf5\xe8\xdf)\x9f\xbc?\xb1w|\xa1\x04\x00k)6Z\xa6Ӛ\x94\xbd\xb4\xb0\xb8\xb0\xd8\xd5\xfe\xad\xef\xd7\xde\xeb\xfe)\xf5\x02\x00\xe0X\xbb\xfc\xc1\x9b\xf2\xc9\xe5\x05\xa9<\xa3&\x9cW\x15&\n\x9d'>ԫ\xe4R\x00\xeb)d\xa5\"+.\xcfH\xd3'\xd5\xe4\x13\xaa\xdfw\xf9XĦ\xe9\x81ߛ\xf3\xe5?3,\x9a1\xe4\xafǙݬ\x95\xec/o#{l]\xb0\xd1\x0e\x80k\x95+-\xab^˝\xf7\xeb\xe4[\xfe9mr`\vmU\"\xfb\xf9\xcf\xfe\xc6<K\xe75\x89\x87ʋר\x8bOhR\x9c\x18\xdf\x05\x00\x00\xd8\x0e\x8bP\xaa\xec\xa2\xca\xf2f\xf5'nVq\xdb\x7fEc\x10X3\x17?\xf1\x96|b\xf99\x85EP\x9cfu\xcb\xd6\xca\xf4i)\xcf\xc7O\x00\xb8\xe1RRw\xc7w\xa8\xbc\xfb\xcfP\xaf\xe2\xba\xe9\x1f\xfc\xd6\xdc=\xf3\x0f5\xf1\x1dػ;\xd4\xcd>\xab\x10nU\xd1]\x1e?\x01`턤\xbeh\x14\xda3V\xae{źԁ\xcek\xb9\xfbn\x9d}ۇ\xa9S\xb6\xc5\x13?\x98\xf3\xa3\x7fJ\xa1\x0fj\xaavX\xdc`\xe2\x89\xeca{\xc6o\x80mC\";pc\x1c<\xf8\x1d\xb9\xba\xfc߫\x9ewRUj\x99w4\xc9\xcd\xf8.\x80M\xe3\x89\xec\xa9?\xa1\"f\xc5\xe4I\xec\x1eO/<\x9d\xdd\xdaZ\xf7*\xbe\xf7\xb3ԯ\xf8\x8a\xae|\xe2\xf5yv\xf0\xb4\xca\xea@\xc9WA\xe8O)\x0e\xbb\xfb\x93\xc8\x0e\xac\xafF]ܱ\xf6W--/)U\xa7\xd4\xdf\xf9\xdbT\xdd\xfe\xe3\xd4\v\x00\x80c\xed\xe0\x17w\xf3n\xb3\x94&{juE\x95/\xb0\x94\x96굣\"xK\a\xc0Z\x8a\ve\xed*,NK;\x8f꠸I\xbb\xef~\xe6\xd8Ħ݇nɡyF\xc3v\n\xc1\x13\xd9OX<\xbd\x90\xca\x03k\x1f\xf7\x8a-\x89\xec\x00\xaeQ\x8a\xeac\xd6\xfed\xaeS\xefδɁ-\xb4U\x89\xec\x00\x00\x00\x00\xb0\t.\x7ft\x96O\xb6Rn\xa2\xc2N\xabfީ\x8eg흃\xd5\a\x00\xdcp}\xaeU|\xed%:Xq\xdd->\xb5\x97\xebg&\x8a\x93\x8bRL\xab\tx-+\xee\x03k+\x97Jվ\xfa\ueb0a\xbeU\xacj\xb5:\xa7\xfdj\xa63\xef>\xa4^\xd9\x12\xf9\xd1\xef\xcbz\xf2O+\xf4\xa5\x96\xbe\xb0\x81*խ\xfd\xf1G\xdfY\x05\xc0&\"\x91\x1d\xb81\xae|\xf2-yg\xffA\x15\xa9\xb5r\xb6\xa3N\x85J?\a\xb0\x91R\xb4\xba\xb4?\xa9X\xb7*\xbb\xc6\xea\xd8\xd2b\xeaC51\xa8>\xf1\xeb\xa47\xff-\xeaW|E>\xferb^(T\x87\xca\xf6W\xeanQ\xb4\xefQH\xb4πu\x95\xd5+\x95QE\xbf'\xb5\x17\xb5ܹU\x93\xf7<F\x9d\x00\x008ֺ\xfb\x7fSΗ\xff\xb6\xaa&K\x93Z\x9d\xac\x9d\xd3\xfbN\xec\aj\xf3IUb\xa1>`]\xa5b!\xf5'\x14\x97\xbb\xd2\xec\t\xedO߭\xbdw\x1c\x9f\x05\xce\xdb\xfb\xbe%\xe7+?\xa7\xba\xbf\"\x15S\xfb\x0f>\xa9Eq\xa0\xaa\x9a+\xda#)t\xf6,\x02\x80k\x90S\xab\x10N\xa8\xf39o{ߤ\xf2\r\xff\x84\xb69\xb0e\xe2\xf8\n\x00\x00\x00\x00X\x13\x93\xbe\xf5\xacY\x858\x95\xef\xe2\xa9P\xda\xdd\xc5\xeaM\x00G\xa2\xf1\xc1\x1b\xe0\x06\x98\x9e\xfe.\x1d\xccZ\x9fF+U7+\xf5\x87\xe3;\x00֒\xef\x9e\x11\xec%4V\xa6}]\xfbFU.Tus\xe9\x91\xefg\x15\xda-\xd1u\x8d\x86E\x87C\x18^\x83\xbd2|\x03\x00\xc0+W\xb4\x0f\xa9\xe8-\xde\xf2j5Ǳ\x8e\x05\xb0\xa9\xa2\xb5\xa5\xe4\xc70\xab\xdaW\x82\xf5Dv\x8b\xb7'w\x92Ď\x97\xccw\xf3\x0fqi_\x9c\xda\xea\x8dzh\xa3\xe5\xc8\"(\xc0Z\xf3\xfa@V\xae\x93\xd5\x11\x16\x0fv%\x897\x00\x80\xe3/->\xa6J\x16\x87\x86B)X\x8c\xea}\x1ay5n\x90\xe4u\x1b\x80u\xe5C\x82\xdeu\xa1`1j\x0e*\xab\xf7\xac\xde8&\xaa\xb7\xfd\x9f\xa1\xaf\xdfd\xff}~\xd5)\xfb1.\xbe\x1d\xfajx\x05\x80kі\xab\xb9\xce\xfe\xb2\xbc\xf4\xcfƻ\x00\xb6\t3\xa1\x00\x00\x00\x00`\x9d<\xf5}\xb9J\xb5\xd4y\xa7\xce\xc4\xf7\x10P\f>\xe1\x82DF\xe0(\xe5zg<\x03\xae\xb3\xdb\xfeX(N}\xb5\x9ap\x93\xd4_Rٍ\xf7\x01\xac\xa7<\x1d\xe6\x00\xa4\xb0X\xcdX\xe8\xe7v\xafԴ\xb3ze\xff\x1f\xad>\x83\x8d\xd7vK\xa5\xe7\xed\xeeG\x92\x1d\x00\x00\xaf\xdc\xfc\x81ߘ˅O\xecn-\xd8\xf2\xbe3\x8b\xb3\x00l\xb88\xb4\xa7rLꂵ\xaf\x86\xa4\xf6J}u\xf7\xf8>\xf0\xe5-\x1f\xfa}\xb9HS\r\x1dn\xad\xbdfOd\xf7\x05%\xd9\xed\x12Xg9\x16C\xb2\x90/\x12.;O\x05\xc97\x00\x805\xd0=\xb4J\"\xf5Dv\xf9\xf8\x81'\xb2{\x85f\xac>\x03\xb0\x01Jkk\x16\x13M\xdf\xfc?\x1c\xbb\x81\xc1\xb8\xf3vu\x85\xfdg\x85N\xa9\xb4vq\xf4\x055\xec\xd9\xd3N\xc6O\x00\xc0\xcb\xd7\x14\xb5=W\x92\xb4\x98\xa9\xeaz\xb5\x0f\xff\x9e1\xb8\x01\xb0-Hd\a\x00\x00\x00\x805\xb2l?\xa9\xe8;I\xf5\x9e\xc8\xee\x03V\xd9^K;\xe8\xd3\x01\x8eR\xacّ\x1d7\xce\xce\x1b\xfe\xef\x90\xeb\xacv\xd1*\x86\xdd\xf1.\x80\xb5\x94\n\xdf\b\xca*\x12_\xc9^\xea\xfanH\xbc(\xfb\x1du\xf3\x0f\xaf>\x83\x8d\x97\x92E\xf5>\x01;\f\x9e\xc4>L\x04\x01\x00\x00\xafȼ\xfd\xe7*\xdbSV\xbf\xfa\xae\xba\xa7\x95}\xd2\xf7\xb8s\x19\x80\r\xe5]\xe6\x16V{\"\xbb\x9d\x9a\xc2\xcek\xa5\xfa\x96\xe1\n\xf8J\xda\xee\x11\x15y\xba\x9aM\xe7\x93\xf3C\xa5\x1c\xac\xbdv\xecR\n\x00\xbc\x1c\xc1\v\xf5\x90\xfb\xe7\x898~\x90\xc8\x0e\x008\xfeB\xba<\xd4_>/\xc8\x1b;Ô \xafˬZ[\xb5w\x00\xac\xab\xe4\v\xefy\xa1.[ɓ:\x8f\xa10\xb9{H\xb2\x97\xfd\xa7\xe6\xd0\xdbk\xbfz\x0e\xb1#;\x80W \x95\xbep\xe4RjvT\x87\x93\xbax\xf8w\xc6w\x00l\vFj\x01\x00\x00\x00`\x8d\xb4\x17?&\xe5\x8b\xd2\xce\xcdZ\x84Ǉ\t\xb9e\xbf`\xa4\n\xb8\xdebeeo\xaa^\x85R\xec\xed\xf0B7\x95\x82\xefĞue\xe7W\r\x1f\x03n\x94\xe5Mo\xd0\xdc7\x17\\\x9c]\xdd\x00\xb0\x9eꧤf\xaaY7U\xd1,U\xfa\xc0\x9dO\x06(\xf7Uv\xach\xbf-f\xe91U\x16gt:Pm\xaf\xc5\xf2\x92\xda\xcag\xa8\x01\xd8T\xd9\x1a\xf11z2E\xa9\x9c\xfc\xa8\x15Be\xf7|\xa1:\x1a\xf8\xc0+\xb5\xff\xf8\xf7\xe4\xd3\x16[\xc5xE)\x15\xeafO+\x14'-\xde\xea\xc6O\x00\xd8H}mm\xacg\xa4E\xa5\xda\xeaW\x85KZ6Q\xa7\xee\xfd\xfb\x04\xd7xI\xd2\xfc\xffP}x -\xcfj~\xe2)\xa5.\xa8\xb6\xba\xa4lN\x8c\x9f\x00\xb0\x8er\x98\xa9\xf4\xf5\x03\xf3E5\x16\x13NN\xff\xea\xf1\x1d\x00\x00\x8e\xa9Ǿ+Wi*u>f\xd4\x0e\xe3E\xb1\xb7fM\xd1Z\xbbg\xaaY\xba2~\x10\xc0:j\xbc|'\xcf\x10o\xf4tq8\xde=^\xea{~,\xe4\xc9M\xf6\xdfy\xbb\x8a|\xa8I\xacu0?\xad|\xea\xdc\xf8\t\x00x\xf9N\x1eNVs0\xf7\xecY\x12\xf7uӕ'\xa5\a\xffC_&\x03\xc0\x96 \x91\x1d\x00\x00\x00\x00\xd6In}ƻ\xe9\x15sX\xadv\xeaص\x11\xb8\xcez)&\x15\n\xca)Z9\xf4\xa4\x13\xbb\xa7\xc6\xce{\xddr\xf7\x8f3!\x167ԩ;~>\xc4Ҿv\xe5b\xbc\x03`#=\xf4\xdd\f\xda\x01\xc0\x86\xca\xd9\x1f\xf1\xcf%\xad\a\xdf\x19\x10\xc0\xabc\xf9\xa8\xfa\xc6'u'\xc5\xc2W\x00\xf3v\xbc\xbd\xb0P\x04\xb0ي\xac\xc2\x17\x8a\x19\xb6϶B\x1f[Mw\u07bbz\x0fx\tB\x1e\xeb\t\xea\v`\xa3d\xb5\xab\xa6W\xa8\xd5k\xa9\xfa\xb6?N\xe3\v\x00p\xac\xf5\xed\x85\xf1\f\xc0&*\x82\xa7p\xf5\x16\x9fJUy\xeb\xea\xe61T\xed\xfe\x0e5\xf1\x82BY+\x1c\xeehVZX\xdd\xfb\x7f;\x00\\\xa3a\xb2s7\x8e\xd7\x04\x85\x14\xd5\xcc\x1f\x1f\xde\x02\xb0\x1d\x88$\x00\x00\x00\x00`\x8d\x84ԮN\xf2\xd5\x1d\xa4\xacY7t\xf0м\x03\xae\xabde\xce'0\x0e\x9d\xa8\x85\xa2\x17\xbb\xa2Q\ns\xb5\xcfK>\x01n\xa4\xba\xfe\x1a\xfbqq\xbc\x02\xb0\x89\x9a\x83\x8f\x8cg\x00\x80M\x92\xb3/\x8a5\x9c\f/\xe1\xd9U\xeah[\x00\xaf\x86x\xf8i\x05\xdf}\xdd\x17\xa0+|w\xa3B}\xb2r\x16)c\xc0F+\x1aEUVԳ:\xf5\xea\xaa \xbd㟓\xac\x88\x97̿;Cǯ}k\x86/\x8e/,l\x12\xdf\"`\xade_\x90\xb8/\xedd\xaaT\x8cm1\x00\x00\x8e\xb1vAB\x17\xb0\xc9JOd\xf79H\xa6\xae\xdf6\xbc\x1eK\xaf\xfb\x91p8;c\r\xe4\xac8\x8f*b\xab\xae\xdb\x19\xdf\x04\x80k\xe1\xcf>\xefo\x9b\xd9\x11\x14\xecy\xb8\x9c?\xac\xc5S?tu\xa0\x14\xc0\x86#\xd3\x01\x00\x00\x00\x00\xd6H\x91\xe6\xaby\xed\xb9U\xf0]\xd8\xc7]\xa1Sd&\x15p=\xe5a)\xd0f8\x1b&<y\x97\x8a\x0fҨW.v\xfd#\xc0\rW\xbf\xfd\xe7BW\x8e\v\x9c\x00\xd8H\xa9\xfd\xe4x\x06\x00\xd8$\xbe\x1b\xfb\xb3\xc9\xec\xcf\xee\xf8y\xf5\x95\xb9\x1a\xc0+\xf2\xd0w\xe6\xaa\xf9\x8c\xbc\xe5>,\x16ae-x\xff\xd9Ў'q\t\xd8l\xad\x95y+\xeb)\xab\xb7צ>\xbe\xbb\x9a\xe1x*z\xab'|\x11\x14\x8b\xc7<\x9f=z\\\xe6\xa1\x19\xe3/\xc0Z\v\xb1\xb1\xba\xc1\xcaq\xac\xd5\x17\x93\xf1.\x00\x00\xc7W\x9a?2\x9e\x01\xd8DqXض\xb5\x10u\xa6\xc9\xf4kW7\x8f\xa9\xeaԯV\xef\xfd-\xe1\xb2]-\xad\x89<\xf4\xba\x02\xc05\xf2\xbe7\x7f\xa6L\x87>\\\x85B!?\xa1\xf9\x95\x7f0\xbe\x0f`\xd3\xf9\x88-\x00\x00\x00\x00`\r\xe4\a\xbe3\x97\xc9\x13i}\xbe\x85'\xb2\x87\xd5$\xaa\x90\xed\x9a\xe6\x1dp=\xa5\xa1\v\xc5;R}\x12\xa3\x9d\x0fe/\xa9˅\x8a\xea\xee\xe13\xc0Q8,o\x1f\xcf\x00l\xa2:==\x9e\x01\x006˘\xb4n\xcd\xfa\xa1m\xffl\x12\xbb\xbd\x8e\xbb\xb4\x03\xb8F\x17\xff\x95\xaa|I\xaa\xfc\"+\xf7ޗ\x16\x15C9\xb4\xe3\x01l\xb0le\xbc\x0f\x8a\xa9S\x1f\xf7\xa4\xd9{\xc77\x80\xaf\xac\xfd\xe4o\xc8e\xb2\xef\x90w\x03\xc7da\x9aO\xce\xf7]\xa2\xa2\xfd$\x91\x1dXg!fkiy,h%\xba\xbcs\xbc\v\x00\xc0\xf1\x15\x9bs\xe3\x19\x80M\x14\xb3\a\xa6\xbd\xda8Uq\xfb\x8f\x1d\xeb\x06\xe7\xee\x1d\x7f>,\xf2\ti\xd6Z\x13yn!\xf5j'y\x00\xb8&\xa9\x95?\x02=\x81\xbd\xeb<\x91=j\xa7\xb4g\xcb\xe2\xbe\xd5\xfb\x006\x1e\x99\x0e\x00\x00\x00\x00\xb0&\xfa\xf9GU\xfa\x8eСP\x0e\xbd\x82\n)Y\xb3.\xa4\xd5\x04\f\x00\xd7O\xa8\x87]\x13\x87UA}\xb6S\bC.{\xd2I\x15\xb3\xb7\x0e\x1f\x01\x8eB\xd8\xfd\xf7\xc73\x00\x9b(\xe6N\xe9\xd1\x1f%\xa3\x11\x006\x8c'\xaf{2\x85\xb7+\x86\xbc\xa81\x99}hs\\ݩ\x1d\xc05ɇ\x9f\x1fքP\xe9\xc9J\x85\xbaa\x87\xa34\xee\xcaN\";\xb0ɲO\x81\xb2\xba4\x16\xbd\x8a\xf2&\xed\xbc\xfe\x1fz\x05\v\xbc$\xa1\xfd\xb8ʡ\xff\xd7\xda\xe1\xd6\x16\x0f\xbe\x00\x8a\xef\x12%\x1f\x8f\x19>\x02`mY[\xcb\xc7R\xad~\x98M\xde=\xde\x03\x00\xe0\xf8\xaa\xfb\xfd\xf1\f\xc0F\xf2\xee\xcahQj1[]\x1fw;\xbf\u070e\xd5i\xc5F;\x00^\t_\x88Ԥ\xb0P\x9f\v\xbb\xee\x15\x834kz\xa5\xfb~\x8d?\x1d\x01l8\"\t\x00\x00\x00\x00X\x13\xb9\xfdܪ3\xc7\x13h=\x91=\x14vwլKt\xe3\x00\xd7U\xd0d,g\x9d]\xac\n\\\xef\xc5Q\xa7\xa5\xd9ۆk\xe0(\x9c\xb8\xf7/1\x95\x16\xd8dV\xed\x1c\xcc\xff\xaf\xf1\x02\x00\xb0)\x86DvO^\xf7d\xf6\x91'\xb1\x0f\x89\xec\xbe\x13(\x80k\xb2|\xe0\xd7\xe6\x10=\xe90*\xf53u\xb1P\xf4|v/oֈ\x1f\xca\x18\x80\x8d\x15r\xbd\xea(/:\x95\xf5\xad\xe3]\xe0\xa5\xc9݃\xf6%\xf2\xf1\x16\x8f\xc9ƅ\x84\x83\xd7)A=\xf5\a\xb0ֲ\xafF\x11:\x85\xb2Wq\xef_\xa7?\x1d\x00p\xac\xa5\xcf\xff\xde\x1c\x878\x14\xc0\xc6\xf2\xb9\x7fC\"\xfbt\xbcq\xbc\xed\xbe\xf9\xef\x87.\xbeA]i\xff\xd9\xfd\x9a$\xdf\x038\x9eb\xb4G`P\x1f\x0f\x15\x8bڞ\x87\x16\xf3tQ\x93\xf6\x8c\xfa\xf9O\x8f\x1f\x02\xb0\xc9Hd\a\x00\x00\x00\x80uџ\xf79S\xe3d*{\xf1\xb9S\xe3\xfc\xa9Ĵ\v\xe0\xfa\n\x85V\xf3\x15=\xa9ďU\xe1˾\xec\xf0]\x7f\x84\x12\b\x00\xb8>\xba\xa8\x83\xe6c\xe3\x05\x00`c\x84\xb4J\xac\x1d\xce\xedծ]\xf6]?I\x94\x02\xaeY\xb3\xff\vRUY\xb3\xbdVӕ\xeac\xa5P{1[-\x141\xaeK\a`Ce+\xf3C\x97]a\xbf\xaa\x93\xab\x9b\xc0K\x14\xf3|ub\xa1Y\xb2/Ұ\x90\xf0\x10\xa7\xf9\xc2«\xb7\x00\xac\xa7dͬ\xdeKvэw\x00\x008\xbe\x9a\xf6\xf1!&\x05\xb0\xc1|\x11>o{\xfa\n\x9ckb\x99ߠ\xe4\xff\xb9\x89Dv\x00\xaf@\x8c\xc3z\xde)$\x15em\x8duk\xa7\xb7\xf6@\xecN)\xa4\xcb\xe3\x87\x00l2\x12\xd9\x01\x00\x00\x00`MTބ\xeb\xa7\xf2D\xf6\xb2\x9b*\xf4\x8dT\\\x92\xd2T;ީ\x03ຉ\xe1\x19\x95:\xadƊZ?[ڍC\x95\xf3J\xa9~p\xfc\x04pt\xf6o\xfb-j\n\xab#\xec;\xe93\xb6\xf3n\xa1\xf9\xf2f;\xf7\x99\xdb\xf6}\x05\xb0\xbe\x8aR\xb7\x10\xe6m\xbc&\xbcF)\xceU\xe6\x99\xda|\xa8T\xed\xa9Z\xb6\xe3\xbb\x006QLQ\xa1\xabW\x933d\xe5=\x05\x15\xfdD\xb3l\xe7\v\x160\x01\xaeE\xfb\xd9\xffg>\xb1<o\x8d\xf6U\"ⴜk\x92\xe6\xd6|\x9f\xfa\x06\x9c\nš\xdf\x1d\xde\x03\xb0\x99\xbal\x85\xbd\xac\xb4\b\xb7Ko\xfcǤ~\xe0%ˏ\xfc\x7fr\xe1c/Â'SU\xa9P\xec\x1a\x8b\xd1|q\x84\xa0]\x1f\x8b\x01\xb0\xb6\xaavG\xb1,t\xbe\"\xe9\x06\x00p\xfc\xa5\v\xffZb\xf1\x15`\xb3Y\x11?\xcc'u\xe25\xbfn\xbcq\xfc\xed\xbe\xfd\x1f\x85a\x0eJ\xf1\x90\xfd\xe7\x17\xd6\\\x8ej\xeb\x85\xf2\xeeRm\xccj\x9a\x1d\xa9\xb4C\x8b\xd5\xdf\x00\x00/$\xf7*Ui\xb2\x9c*\xfaXN9\xb5\xe7Je\r\xf7\x87U橚\x8f\xb1\x1c1\xb0\xe9Hd\a\x00\x00\x00\x805\x90\x1f\xfaQ:i\x80\xa34l\xbb\xe3\xbb&\xae.\a\xa1RU\xdd2^\x00Gg\xef\xb5\x7f!\x04\xdfu\xacHR_+\xa7F1\x1e\xdaW֮\xd92\nXs\xbe\"u?\x9ecS\x15E\xb1:\xb9\xba\v\xb3\xef\xcc\xfc\x05A\a\x00\x00\xf8J\xfa\xf4\x98\xff\\]\x00\xd8J\xa5\xc5\xd5I\x174\x99\xbd\x7f\xbc\x03\xbc4]\xf3\xe4x\x06`#y\x7f\x8b/\x12^\xdd4\xde\x00\x00\xe08\xf3\xf1\x81q\xcc\x00\xc0f\nA9\xf6\xd2\xe9\x1f[\xab\xc1\xc0\x9d\xbd\xaf\xb1GTP\xa9\xac\x98\v\x15]\xa5\xd0ګ\xfd_\x14\xf5\xd2\xfe\xbf\x16\xfe6\x00\\\xb3\xb2%\xc5\x15\xd8t\x94r\x00\x00\x00\x00X\x03\x87\xf3\x0f\x8eg\x00\x8eD.\xa4\xd0\x0f9e\xc1\a\x8es\xb4\x93R\xf5\xe4M\xe3\a\x80\xa3\x95\xcb])6v2S߷\x8a\xf5%\xe5!\x89\x9d\xee?`\xady\x9d\xd3/\xc7\vl\xaaPT\x8a\x16W8\x9f\xe0\x91}\x82u\xe4\xf9\r\x00\xc0\xcb\xd1/\x9f\xb0\x9f\xec\x98\vl\xb3\xa0^K\xef\xb2{\xf3O1m\x1a/K\xb3\xfc\xccx\x06`#\xe5\xa4\x14&\xaa\xa6\xf7\x8e7\x00\x008\xbebnVcC\x006W\x8c\xea\x8b\xf5\xdbϦz\xfb?\b\x87\xe5i)\xf9\xbc\x94^\xb1+\xa5e\xad\xa8\xa0\xa2\\سk\xe1Kq\x00\xc05\x8b͞\x0e?\xfen6\xfc\x026\x183\xa1\x00\x00\x00\x00`\r\xa4\xe6\xe3\xe3\x19\x80#1$\xb2\xb7\xf6\xea\xd9eqX\b\xdd\x13ً\xe9;W\xef\x03G,\x15\xb7H\xbejw\xa8\xe4=\xfaE5\xeeDȎ\xec\xc0z\x1b\xea\x9dN\xed\x03\xbf\x85\xc1\xba\rV\x96\xb5\x85\x17C\x06\xbbB\b\xabI\x1e\xbe+;\x00\x00xI.>\xfcu9,\x0f\xacMD\xfd\tl\xb3ܵʳ\u05ceW\xc0\xcb\xd0|n<\x01\xb0\x99z\xa50\xd5d\xc6x\x0e\x00\xe0\xf8\v\xe9\xd0~\x92\xde\x01l4_\x84\xaf>9^\xac\x97~\xefmR\xea\xa4\xec\v\xb1g\xbbQڵ\xff\x0f\xf5\xea\xfb\xa0\x9cO\xaf>\b\x00ע\x97\xdaÏ\x8e\x17\x006\x11-\x1d\x00\x00\x00\x00X\x03U~t<\x03p$r%\x85FqHb\x0fJ\xc9^r-\xdd\xf1\x13d\t\xe3X\b\xd5\xeb퇝\xc4^\x85}O\xa3\xaf\xbd\x10\xbc돯(\xb0\xde\n+ӽ\xfa\xe6S\xe356\x91\xefȾz^\xdb\x11\x83\xb2\x8fВ\xc8\x0e\x00\xc0K\x16\x0e>\xa8*]a\xf6\x03\xb0\xe5\x9a\x14U\xed~\xebx\x05\xbcte\x7fn<\x03\xb0\x99\x92R1Syӏ\xd1Y\x0e\x008\xd6\xfa\xfb\x7fC\x0ey1\xccG\x00\xb0\xb9\xba\"\xaa\x9c\xac\xe7B|\xf5\xd9oV\x1ff\xf6\x9c\xea\xa5\u009eW\xde\x1f\xdb\x15v\x04\xbbe\xafZ\xcf\x04}\x00\xc7D\xd9h\x9a\xa5\xe5\xfd_\xcfF\x0f\xc0\x86b(\x17\x00\x00\x00\x00\xd6@\xdd\xcf\xc73\x00G!\xa9\\\xe5\x97]M2\xf3\xa3\xa8\xfd\x06p,T\xf5;\x95=q=\xee+\xe4\x1d\xff\xd2\xda\xd740\xcf\x01Xs\xd9\xea\x9ba\xa3\xee\xf6\xb3\xe3\x1dl\xa4X(\xf9\xee\xfb~j\x8f\xf2\xdeW\xccɌ\xcd\x02\x00\xf0\x92<\xfa\x83y\xbaom\xa20\xb7v\xd0t\xbc\t`\x1b\x85굪\xee\xf9s\xf4\x84\xe0e\x9b\xf4\xedx\x06`#Š>\x12'\x02\x00\x8e\xbfny\x9fJ%eֹ\x056ZS$M'\xef\x1e\xaf\xd6\xcb\xe4\x96\x1f\t\xc5\xce{\xb5\b\x16_\x97v#\xfa. \xc5p\x04\x866\x01\xbcRu\xa3:\x94\x8a\xf3\x0f\x8c7\x00l\x1a\x12\xd9\x01\x00\x00\x00\xe0\x98\xcbO|\x7f\x8e\xfdx\x01\xe0H\xe44v\xa1$O(\x8c\n\x85\x1d\xd5du\x0f8\x06\xe2k\xffP\xe8\x83oþ/u\xbbJ\xcdtHd\a\xb0ޒw\xe1[\xd1\xce\xec\x0e\xb7ᢲ'\xae\xdb\x11\xecٝ}5\x12\xdf\xc9\x00\x00\x00|E\xcd忩IS\r\xe7\x99Dv`\xab\xd5{_5\x9e\x01/]\xfb\xf0\xef\xb0&\x98\xef\x1a\a`cy\xdf\x1a\v\x13\x03\x00\xd6@\xd7>\xe8\xeb\xdezŵ\xba\x01`#u!+\xdc\xf1\x17\xd7w2\xc7\xd9\x7fO]\xbcCK?/z{f\xd9\xff\x8a\xfd?ŲQ\x88\x17\x86\x8f\x00\xc0\xb5X\x16\x85=G\xa2\x8aC{\xb6|\xf27\xb1<\x06\xb0\x81Hd\a\x00\x00\x00\x80cn\xd1|J\xea\xc6\v\x00Gb\xd8\xe9z8\xb1\xd7\xe0\x9d\xa6\x95\xbd\x90Ȏ\xe3%\xab\x1c\xbe\xa29\xd5\xca-\x13\xf3\x80M\x90\x87\x81\x7f/\xdf\xd8tß\xb5\xbf\xfa\x16\xfc\xab\xb3\xf1\x15\x00\x00|9\a\xfb\x9f\xb5\x9fK)\xed\xa9\x1f\xebS\x00[j\xf7\xad\xe3\t\xf0\xd2-\x9b\xcfY\xf3˷\x91\x03\xb0\xb1|\xd1\xc0@9\a\x00\x1c\x7f\xb9?\x18Ƅ|\xc1[\x00\x9b\xab_\xf7\"~\xd7\x1f\f\xa9\xb8E\xcd0\x94\x99숫_\x16rg]\xf1\x9b\x00pM\x16\xd6vϱQlNi\xb9\xf8\xc7\xe3]\x00\x9bd\x9c\x85\r\x00\x00\x00\x008\xae⥟\x1b\x06\xab\x00\x1c\x9d2\x06u\xa5\x95\xc7└\xcfٱ\xab\xf9ޯ\x1e\xdf\x05\x8e\x87y}J\xa1\x93\xda\xea\xf3\nӅ\xd4'%\xea\x0f`\xad\x95E\xa3y*4M{\xd2\x13\xdfIf\xf3\xa6\xba\xed\x0f\x87P\xdas\xdbw`\xb8\x18\xb4\x13n\xb6?|vd\a\x00\xe0+\xba\xff\xabr\xe5kxM\xefUj\xf7UVV\x9f\x02\xd8\\}%\x15'\xac\x8dde\xdd\xcb{\xbe\xd5\xca\xfeB9߮v\xb6#\xdd\xf9\xa3\xf4\x82\xe0e+.\x7fھO\xcdx\x05`\x13u\xb1ש\xb7|\x90:\x02\x00p\xbc=\xf8\xa3y'\x9c\xb0v\xceLM5\xecs\f`m=\x97\xa2\x95\xd5K!\xd9a\xafE7\x1cEZ\xffM\tN\x9e\xfd\xb7u\xa2\xa9\xa4r\xa9\xc3ɞ\x96\xbd\xfd\xff\xa5\x13\xca\xfd\xa9\xf1\x13\x00\xf0\xf2\x9d\x9a\x17\x9a{7\xdd\xeeiM\x9asҧ\xbf\x8592\xc0\x86!\x91\x1d\x00\x00\x00\x00\x8e\xbb\xe4\x9d\xda̯\x00\x8eT\xf0\xdd\xdd\xec5\xaf\xfaG;5\x9a\xbd\xee\xc7(\x988VB\x9c\x8dg\xcfG\xf7\x1f\xb0\ue097c\xaf\x7f\xfa\xf9x\a\x9bi\x15V<\x1b\xf63$\v\x00\xc0W\xd4-\x9fҤ\xb3j3\x9dW,}\xcb\x1f\xbb\x00\xb0\xb9\n_\xb4\xafP]\xd6\xde9'ŋ\x8aq\xaa\xa6|ZՉo[}\x06x\x99r\xf6E\xc4\xe8?\x036YO\x11\a\x00\xac\x83\xeeq\x85\xec\x03\x04\xa5\x1d\f\x10\x00\xeb\xcd'\x17y{\xf3ye\xd9\a\x00}\x17\x02;\xca\xea\x96\xf1\xe6\x1a\xbb\xfbO\x04\xed\xbcFi.M\xe3\x13*\x8b\xb3\xcaኪ~:~\x00\x00\xaeAʪ\xcaҞ\x9f\x17V\x8f\xd2ţ\xab\xfb\x006\x06\xddt\x00\x00\x00\x00p\xcc唤\x82\xe6\x1bp\xa4\u0081Bڵ\x02\xd9\x0e\x97M\xf4\t\x8e\xc0\xf1R\xc43\xab<\xc8\\\xacnx\xaf~\xa6\xfe\x00\xd6ZH\x8a\xb1\x94\xfaNZ^\x1cob\x13%\x9f\x9c\xe6\x13Z\xa2Oj\xb1\xe7\xf7Մv\x00\x00\xf0\x82\xda\xcf\xfeڜ\x16O\xa8\xea\xefP\xca\xe7\xa5\xca\xda\xeca\xd5f\a\xb0\x99RѪ\xcfIE\xb1#u\x160\xc7\xcbv\x9cT3\xb3X\xfa\x9e\xbfF\x04\x8dk\x12\x92\xefvI\xff\x19\xb0\xc9\xdab\xfdw\xbc\x04\x00l\xbe\xa6\xfd\xa4B\xae\xa4\xbeT\x16\xfd\x1b\xc0Z{\xfef5>_c\xb8\x0e\xabu\xcb\xfb\xa4j\xf7ͫ\xf7\xd6\xddޯ\x1c\xd6\x15\x8dy\xa9\xa2\x0ej|x\x93\xde\x19\x00\xaf@\xb2\x18\xa8*O\xd8\xd9E{`ަn\xf1\x19\xcd\x1f\xfd\x8fY\xe1\a\xd8 \xf4\xc4\x03\x00\x00\x00\xc01\x17}Gv]MJ\x04p\x14Rl\x14\xfa\xbdq\x87\x1e\xa9/v\x87W\xe08)\x8a\xd5\xca\xddA\xb5B\xf6$\xf6\xd6^\xe9\xfe\x03\xd6[R\xb480\xd8k^\x9e\x1b\xefa\x13\x05Od\x0f\xf6+\xf6\xfe\xa7m\xcf\xf0\xf1\r\x00\x00\xf0\x82\x9a\xf9\xff\xad\xaa\xb36z*<d\xb2\xba\xb3RK\xf3\a\xd8h\xdeő\xe2\xe1\xaa܇\x1d\xc9\x1e\x01K\xbb.v\xbei\xfc\x04\xf0\xf2\xc5<\xf7\x9f\xab\v\x00\x1b)\x177\x8fg\x00\x00\x1c_M\xf7)\xfb\xc9\"\xe5\xc0F\b>\xd2\xd7?\xb7#\xbb\x97\xebg\x8fRս\x7fo3ҽ\xef\xfdK!L\xceJ\xbe>\\qN\xa9\x9b\xd9\xff\xfb\xe5\xd5{\x00p\r\xba\xd8\xd9sr\xb6\x9a*aO\xca\xd87J\xfb\xff\xbf\xe1=\x00\x9b\x81\xd6\x0e\x00\x00\x00\x00\x1cc\xe9\x81ߖcj\xad\xf5ƒ\xa5\xc0Q\xcbi\x95H\xe8\xbb]\x87\xea\xb5\xe3]\xe0\xf8(\xe3\xad\xc3kV5\xbc\xfa\x8c\ue429?\x80uf\x81\xa0\xfd\x8c\x8a9\xa9'\x91}\xa3\xe5aGv\xfb\xf3.|G\x86,\x05\x16\xb2\x02\xb6Y\xfb\xe8\xf7\xb1\x9c\x05\xf0e,\x1f\xf9\xae\x1c\x9bK\nѓY\x9f\xb6\xd7R)%v\xfc\x016\\Ў\x95\xf3}\xa9\xe9\xa5j*uVg\xd6v\xf7\x9e\xbfM\xe9\xc75\xe9\xef\xff\r9\xfa\x8c{\x92\x85\x80\x8d\x16fo\x1c\xcf\x00\x008\xber\xf7\xb4\xfdh}\xb0\x00\xc0\x06\b!(ī\x05\xdaۜ\xd1\xeeUvl\xd6\xf8_\xdc\xfb\xf7\x87\xc7Vo\x8f\xaf2\x9d\x95\n_,\x0e\x00\xaeM(\x92=P\xb2b\x9e\xda\xf3\xe4\xbc|\bh\xbaxZ\xf9\xd1\xdfG\x84\x04l\bz\xe2\x01\x00\x00\x00\xe0\x18k\x96\x1f\xf2\x94\x16\xe9\xea*\xad\x00\x8eD\xb2\"\x18\xc2R\x8aq8\xaa\xc9;\xc7w\x80㣈\xb7\xf8\x17UA\x93Ս\xb0J\x80\x05\xb0\xce\xf2\xaa([\xd9\xce\xfd\xc5\xd5-l\xa4\xa0z\xf5\x1a{\xf9_$R\x00\xdb-w,^\x02|9\xcd\xc1\xff\xa1\xda\x17\xed\n{v,\xac\xd9S[\xd4ԪH\xab\xfa\x14\xc0f\x8a\xfd\xc47\xe2\xb1hyie\xbfS[\x9cP9\xa5\x8f\x0e\u05ee[ާrh\x7f\xb1\x16\x02\xb0\xc9&\xd3\xf7\x8eg\x00\x00\x1c_\xa5OHо\xb5uҳ\xe3\x05\x00֔/\\\xedc\xbcWW\xdd\xf49\x7fC\xbb3*\x16\xe3\\\x8eM\xf1ֿ\x1e\xfa\xddS\xca\xf3\xa0*\xce\xed\x196\xde\a\x80kP\x84R\xea\x16\xf6Ȝ\xaa-}\xf3\xaf]\x15K\xa9\xbd\xf4w\xc7O\x00Xw̄\x02\x00\x00\x00\x80\x16L\xafi\x00\x00\xff\xf4IDATc,\xf5\x9f\x19:\xb3\xbd\x8b\x1b\xc0\x11ʥ\x14\x0f\xec$د\xa0\xc9\x1b\xfe\n\xc3/8~\xde\xf0'V\xdf\xcb|uGv\xfbڒ\b\t\xac\xb5\x10\xb2Ńv\xe2\v\xa9\xf4\xfb\xab\x9b\xd8H)؟\xf10\x87e\xb5\x80U\xba:\xb9\x05\xc0V\xca\xdd\xe5\xf1\f\xc0\x97\xf8\xdc\xf7\xe5r\xf9\x98\x8a\xde\xda\xe9\xd6\xde\xe9\xbd\xca,\x1a\x15\xb1WhN\xac>\x03`3ua(\xfb\xa1\xea\xd4\xeb\x9249\xa5\xe9\x9b\x7f\x96\xc0\x19\u05eck\x1f\x947Ŕ7kG<\x00_\xa8\xba\xfdǩ+\x00\x00\xc7\xda\u18ff1\xd7ڱ\xb8\xd4.Bg\xaf\xc3v\x17\x00\xd6T\xbe\xbaY\x8doB`G\x1a\xf2\xda-$\x8dV\xb6\xab\rKd7\xf1\xe47\xa9\xcc3;;\xafe\xb4g\x19\x00\\\xa3\xd8W\xf6\xec\xf4D\xf6^M\xe1\xf1\x90=3\x1b\xa9\x98\x7f~\xf5\x01\x00k\x8f\x99\xac\x00\x00\x00\x00p\x8c\xa5\xfe\x8a\xff|\xae\x93\x1b\xc0\x91Ȟ\x18\x1c\x17R(\xec\x959O8\xbe\x92}=\x83\xec{:l\xe1\f`\xdde+\xcb\xc3\xe4\x06\x9f\xbd\x94\xdb\xe1\x1e6\u0530\xf0H\xf29-+,D\x02l\xb5\x94\xe6\xe3\x19\x80/\xb6\xb8\xf8OT\xa5q\xc2g\xea\xad\xf6,\xad\xda줢\x97\x9a\xe9\xea>\x80\xcd\xd4[\xbb\xa8\x9f(V\xbd\xe6V\xe4\xe3\x84\xc9\xd1x\x85ҁw\xa41\xfe\x02\x00\x00\x80#\xd5v\x0f\xaa\bc\xfb\xc6\x17\xea\x13\x89\xec\xc0Z\xf3\xb9\x1a>\xe07\f\xfa\xad\xc6\xfb\xf2\xd5D\xf6b\xf3\x16R\x8b\xf7\xfe\x1d{l5C\xfb\xba\xf3\xa4S\x00\xb8V\x9e\xc8\x1e\x1b\xf5\x9a+\xc5\xd3\xf6\xec\xb4{\xc3\xf0O\xa1K\x9f\xf9\xb7\xe8\xc0\x036\x003\xa1\x00\x00\x00\x00\xe0\x18\x9b$_\x8d\xd5;g\xbcs\x1b\xc0QiBV\xec\xa6R\xb8\xa0s\xe9\x8d\xe3]\xe0\xf8\xe9R\xa1\xba}J\xa1;\xadn\xe2\xab{w\xe3;\x00\xd6Qh&\xaa\x8b};\xb9Ii\x87\x05*6Y\x9f\x9eV\xeb\tx\x87\xb5b\xb9P$\xfe\a\xb6Z\xbe\xf2\xd3\xe3\x19\x80\xe7[>\xf9\xfdy\xdaާ\xb2m쪰_\x8d*\x95\xf6b\xed\xf5\xc6\xea\xceٹ\xd5\a\x01l\xa4\xb4wA˪\xb2\xf2\x9e\xb4ןQq\xf6[\xc7w\x80k\xf0\xd0\xefϻV}\xe4~\xa2%\v\x97\x02km9\x89R\xdb[Ea\x85\xbaZ(E\xefC\xb3\xf3b\xa16ܽ\xfa\x10\x00\x00\xc7Xu\xe5\x11\xa5\xee\xa2\xd5]>\x1f\xa1UΛ\x97\xe8\nl\x93\xd0\xfb\x06\x19\xa5\x9a洚ɡbu\xa8\x90/k\xd9/\xa4w>\xb2\x91\r\xd0\v\xb7\xfc\x9bڷ\xff\xed\xddEV\x0e\xcdp\xd8o\x84\xfd>\xe4\xd5\xf1\xecJޤ\xaf\x01\xf82\xa2=;r\xa5*\x95:\xb1\xdcWС=X<>\xeau\xea\xfc/\x8e\x1f\x02\xb0Έ\x04\x00\x00\x00\x00\xe0\x98ʟ\xfd\xde<L\xca\xcdQ!\xb0\xa0 p\x94\n\x1fT\x19w\xe6\x89\xe5\xa9\xe1\x158\x8e³\x03\x80+_|\r`\xcdX\x19\x1e\xcaq\xee\x14\x12\xf1\xe0&\xcba\x1c\xae\xb9\xba\x13;;\x02\x02[-\xf2\f\x00^Ps\xc0D%`\x9bž\xd2$'k\x1e\xed(\x9f<)\xdd\xfa\xa7\xe8\xf4\xc05K\xcbG\x86\x1d\x9dR\x8a\nC\xd2+\x80\xb56\xe4\xfbY;\xca~\xad\xfa\xd2\xfaἜ\xde<\xbc\r\x00\xc0q\x96\xfav\xac\xbf\xec\xb0\xd7h\xed\x1e\x00\xeb+\xfbbս\xfd\fs+\xe0v#\xf9\x0e\xc3^\xc47\xb7l\x9fy\xddO\xdb\xc3\xcb\xfe'Cc\xff\xf7\x85\xfd\xbf\x97\xc3\xf3\xcc\xc7;sJñ\xc2\xf3\r\xc0+\xf0\xb9\xdf\xcc\x00*\xb0\xe6Hd\a\x00\x00\x00\x80cj\xbe\xfc\xa8b?\x91RTdG\x10\xe0H\x15^\x04=\x99$Ԫ\xa7\xaf_\xdd\x04\x8e!\x1f\v\\\r\b\xf6\xabkz\xff\x80\xf5\xe61\xa0'6\xe7\xd6bB\xe2\xc1MV\x14\x95\xc20dÃ\x1b\x80\xc5p\xe3+\x80/\x94/}h<\x03\xb0\x95|\xe2wꭉ4Q>\xfd\xf5\xe3M\xe0\xda\xcc\x17\xf7Im!\xf5\x9e\xc8nmn\x00k+z\xdf\xd9Н\x92\xe5\xeb@\x06\xef\x14\xef[uy\xa2\xb0\xf3\xa6\xe13\x00\x00\x1ck]k1\xa9\xd5_c~gd\xa3\v`\xad\x05U\x16\x8f\xdakyEC\xfev:=ī\xa9\x1c\x82֍UNޮ\x1c\x0f\xedl\x98\xb42\x1e\x16\xa5\xa7`\xbf\x0fIy\x98s\xc5\xe8\a\x80k\xb7\xbc\xfc\xcf\xc73\x00\xebj\xb3\xa3!\x00\x00\x00\x00Xc\xcb\xf6c*R9tn\x038Z\xe50\xc0\x92\x94\xc2T\xbbo\xfc댬\xe0\xd8Z\xed\xc0~u\x15k\x06\x02\x81\xb57\xec\xc8k\xe58,Ǻ\b\x9b\xaa\x88\x13\xfbs\xb6\xd8?\xc7a\xd3\x15m\xf0\xae\f\x00\xbe\xb2\xe8ۃ\x02\xf8\x02\xf9\x81_\x96w\x16\xfb\xe3\x15\x80\xad\xd4\xf7Rw\xa0~\x1a\x15o\xff\x1b4\x90\xf0\x8a4\xedg\xacr\x99*fkmGb/`\x9d\r\xb9~\xc3,ث\xfdhv\xa4\xceZU\xbb\xd2\xeb\xff\x1a\xf5\x05\x00\xe0XK\x0f\xfc\xfe\x1cS#\x15>»\xaa\xc7\xe2\xb8`9\x805\x15J\xe5le\xb9LJ\xbdǦ{C\xbcڗ\xa7\xc7\x0fl\xa6\xe9ޯ\xd5ܟe\xbdg\xed\xdb\xff\xf7\xb0X\xfb*]m\x98\xc7\xe2c\x9f\x8c\x7f\x02x\x05\xf2\xe2Q\xf5\x9f\xff]\xde\xf8\a\xb0\xa6V\x91\x01\x00\x00\x00\x00\xe0\xf8IOh\xc8b\xf1]fƎ]\x00G#xb\xb0\x15\xc36\xee\x8dw\x80\xe3g\xf9\xd8\x0f\xe7\xd5\xe0\x9f\xd5\x1d\xcf&\xb3\x03Xg}\xb6\xb2\x9cK+\xd7K\xab\x86J\xe5G~\x80A\xb9\r\x15\x8b\xe9\xea\xcf\xda\xe3\xff!\x93\x1d\xc06+\x86\x04\f\x00Ϸ\xb8\xf2s*\v\xfaǀ\xad\x96\n\xff\xa5~\xef=\xe3\r\xe0\xda\x15\xbahߩRaȀ\xa5\x1f\rXg\xc1\x93d\x86~q+;@\xb8\x15\xeb\xac^}qf\xfc\x04\x00\x00\xc7W\xd7|T\x95\xdaa|7\xfbb\xb7C\x85f\xd7\x00\xd6W\xf4\xb2\xecc\x7f\xd6\xf6̵\x05\xa7C\x80\xaaX\xbd~\xb8\xbf\xb1\xee\xfaѰ\xa8\xef\x19\x92\xf8s\xb6\xffy\x9fd\x15\n\xc5\x18\x87ߋզ\f\x00p\xed&i\xa2\xee\xf0\x7f\x19\xaf\x00\xac#Fz\x01\x00\x00\x00\xe0\x98*}\x95\xe5\xe0\x03V\xad\xb2O\xbc\x00pt|.c\xccJ%\x89\xec8\xbe\xfa\xde\x17>\xc9>\x028\\\xaf6r\xf6\x1f\x00\xd6U\x1a\x12\x19\xe3\xb3\x13\x1c\x94\u0605tS\xf9\x8e\xec9\xd4v\x16\x87M\nz\x12)\x80\xad\x16\xed\xb9\xdf=\xfe\x83\x04r\xc0\xa8{\xe8\xbdY\x87\x85D\xf7\x18\xb0\xe5\n\xb5\xbb'4}\xfd\xff\xc5\xccg\xbc2\x17\xff@\xae|\x011O|\x8d\xb4\xbd\x80u\x17\x86E\x01\xfb\xa1?E\xa1\x92R\x1e\xba\xc8C\xb5\xd9;^\x02\x006C\xdf\x7fJ\x95gs$\x9f\x1f䉟\x8e\x1dف\xb5f\xc1h\x88V\x8e\xb3\x9djj?\x0e\xec(5\x99\xbe\x7f\xfc\xc0\xe6\xaaN\xfd\x1a\x15\xb1\xb6\xffo\x1f\xdf\x1e\xbbo\x86\xe0\xdc\x0f;\xf7E\xdc\x01\xe0\x1a\x85xBq~Az\xec\x0f0\x86\n\xac)\x12\xd9\x01\x00\x00\x00\xe0\x18:|\xf4\x87r\xe5M\xb6\xb0\xf4\x19\xec\ny6\xbe\x03\xe0H\xa4le1(\x15\x93\xf1\x06p\xfc\xf4}\xab\x94\x92\xd5\x1d>\":\x0e\x04\xfa5\x80\xf5eq\xe00\xc8?\x94g+\xe7\xdd|u\x1f\x9b'V\xf6\xe8.\xec\xcf\xfb\xeaD5\x00[-'\xb5\xfd\xe3\xe3\x05\x80\xc5\xe1\x875-gRG=\tl\xb5\x90\x95O~\xebx\x01\\\xbb\xfd\xc5?W\x95w\xad\x9d\xddX[\xac[\xb5\xc5\x00\xac/\x1f\xbfq\xb1\xb0\xf2\xec;\xb2\xfbx\x8e\xfd\x9a\x9c\\\xdd\a\x00\xe0\x18\xcb\xfd#\xd6\xd6I\xf6\xda\x0fu\xd9jac\xc6w\x81u\x96\x93\x95\xe9\xdc\xf9ZK*4\xb1\xb2}\xc1B֙\xca\xd7\xfe\xb8\x8f\xf8n\xb4\x13\xaf\xfd\xd3A\xf5\xd4\xfe\xc7+\xfb\x8d\b\xab\xc7ٰ\x03\xc3ʰ1\x03\x00\\\xabثZJ\xfd\xc5\xffk\xbc\x01`ݐ\xc8\x0e\x00\x00\x00\x00\xc7P\xb3|H1\xfb\x8e\x8c\xed\xd0r\x8b\xb1Z\xbd\x01\xe0h\xf8`\x8aO\x96-\xbc\\\x02\xc7\xd3j\xd0Ͽ\xab\x9e\xf4\xba\x1a\x00d \x10Xo1\xfa\xc2FV\xa6\xbd(\xa7\xac\xbe[\xae\xde\xc0\xe6\x19\x92د\x0e\xd90t\x03@\xea\xf3\xfex\x06l\xb7\xcb\x0f~Sn/\a\x85\x13\xfbj\x16,.\al\xb7N\xd3{\xfe\xe6\xc6O\xfa\xc6\xf5\xb7X~R1\xed\xac\x12\xd9}ոg\xdbb\x00\xd6\xd5\x17\xf4\x82{\x9fx̊\x85\x95s\x00\x00\x8e\xbbth?\xb2\xfa\xbeW\xd0\xd5\x05\x96\x18\xdf\x05֙o>`\xa5\xdaר\x18\x16N\xcbEg'[4ר\xb6\xffWOdW\x1c~/\x86\xbd\x17<F\x1f\xe6]\xb1P\a\x80W\xc0\xc7N\xdb[\xd5\x1e~z\xbc\x01`\xdd\xd0\x13\x0f\x00\x00\x00\x00\xc7Pu\xf8\x8fT\x87\xc5Љ\x9bs\xa5N\a\xe3;\x00\x8eD\xd7\xe8P\xa5\xf6\xde\xf2\vL\x96űu\xb2\xff\x90\xa2NX\xbda\xf5G\xd7ٯ\x9b\x14J\xea\x0f`\x9d\x15}\xaf\xde\a\xf4\x8bZ)\x06\xc5\xe2\xfc\xf8\x0e6M3\xfb6{v\xb7\xd2\xe4\x9c&\xfb\xbdr\xcdBV\xc0VkN\xaa=\xfc\xa7\xe3\x05\xb0\xddf\x97\xfe\xb9\xcet{ҥ[\xad\xbe,ǻ\x006R;\xb3\xb6O\xa3f\xbaP\xab;\xa44\x91\xbc\x8f<\x9dQ3Y\xe8\xb1S\xef\x1b?\b\xbc2\xb3}\xfb^\x15O\xd8qF9\x14\xea;\xa6\xcf\x01\xeb,\xb6V]Ġ0\xb9\xa8\xb8\xf4!\x9c\xa5\x9aX\xa8~\xfd\xff\xcex\x0e\x00\xe0x{\xec{\xf3\xff\x9f\xbd\xff\x00\xdc,\xcb\xeb:\xff\xcf97<\xe1\x17*t\xceaBwOO\x9e\x01\x191\x80\x80\xca:\x82\xe0\xee\xfe\r\x98P\x10\x17\xd7\xec\xca*\x8b\x18\xfe\".\xba\xb2\x8a\xbb\xab\xa2\xbb\x8a\x18X\x10q\xc1\xac#\"+L\x8e\x9ds\x0e\x95~\xe1\t7\x9c\xb3\xdfs\x9f[\xcd0t7\xdd\xd5U\xf5{\xc2\xfbUu\x9f\x1b\xab\xfa\xd7\xf5\xdc{\xd2=\xdfsrm\xab͢\xf2bW~\x96ʥs\xcd\xf2\xdd\xc5y\x00+)\xcb[+\x8fn)\xc4\xe3\xf2\xcd3rͶ\x0e\a'\xfa\xb3\x1b\xe0m\x8f\xbb3\x83\xe7\xec\x1fbOY\xb3\xa3\xa6\xbeB\xf3\xa1\xd7a\xa8\xe5\n\x02\xd9\x01\\\xb8CWI\x83\x91\x86\xb3\xd3\xd2\x03w2\xf2\x0f\xb0\x82h\x89\a\x00\x00\x00\x80e\x14\xfav\x16\xe7\xe5\x9cKc\x94.\xf6\x01\x1c\rW*Ҍ\x82e\xd7\xcc\xec#\xaa\xeb\x9d\x17\xed\x8eM\xb7,\xcd\xf6\x00\xb0\x12\xd2,a\xf1\xfc,\x04V\xfe\x8fiV\x02\x00\x9b\xcb\xd2\x01\x17i\a\x00\xf6\x9f\xfc\xf2\x18j\xab\xe1\xa4l\xd1\xefi<H\xb3\x94\x01X[>\xcdX\x96w-p\xce=c\x1f\x96\x17\xc6\x1d۫\xba\xb6\x8e\xeb\xef\xfci\x02\x12qQ\x84\xa6o?s3[\x97ʘ\x11\x0eXm.\x15\x16s\xa5*\x94\xcbm;\x96Rq~F[\x00\x00\x96W]=\xbf\x98\x9c\xb8M]\x84\xe6V'\xb2\x8d\x98\xc9S>\x05V[\x9a\x82\xdcʨ>u\xd8H\x95Og\xcfu~rqnCl\r\xbfv\xf1\xae\xd3\xfe\xff\xcbA%U\x8d\xca,\xb7\x84\x8f~W\x00.\\\xeeF\x96ƞJM\x00R\xea\"\a`\xe5P\x12\x00\x00\x00\x00\x80%\xe4C\xbd\xd8pVm\x8b^\x8e@\x16\xe0h\xb9R\xad/\xfb\x1d`9\xb5\xf5\xb9\xc5@(\xceu/G]\x9e\xd6\xfdI\x00\xc0R˯\xfb\xb3.d\xe7\xcb\xfcV\xfeg$\x12`\xe3\xf9\xd8\xf4[\xc0\xe6\x8a\a\x1f\x92w\xf6,x\xcb\x17c\xb0\xed\xbd\xfe\f\x80\xf5\xd4(\x84(\x1f\n+\x0fOm\xbf\xb2g\x7f[\x8d;T\x91\xbdeq\tp1\xa4ٛ\xdd\xc0j]i\x80\x94\xa1\xddo\x94\xbb\x80\x95\xe6-\xbf\b\xf6L\xb7\xb6\x9d\xdb\xf3\x1cv\xa4bkq\x0e\x00\x80%6\x9f>b\xf9W\xb0\xbc\xabP\xebf\x96\xa7Y>\xd6Z\xf9\xd4\xf7\xfd\x85\x00\xac\xa6\x10\x16\x93\x0e\xa4w}\xe9u\x9fϔ\x0foO\a6Fyۏ\xbaI\xe6\xac\xf2\xbdge\xf4\x89\xb2*\xa8ȃb3\xec\xaf\x00\x80ׯЮ\x95\x95\x0el\xc3Ғi\xa5\xf9c_M\xa7\n`\xc5\x10\xc8\x0e\x00\x00\x00\x00Kȇfј\xed\x8a. 1c&6\xe0h\xb9\x81=\x8e\x9b5B2VOU\x9f\x92\xeb\"\xd7-\xefP\xab,#\f\x12\x00VI\xf4\xe7\xcb\xfci \xab\xd4\xfb\x1a\xc0\xe6JA|U\xbf\rl\xa6\xf6\x91\xf7\xc5\xd1~\xae\xa2\x88jr{\x1e\xda]iޟ\x04\xb0\x9e\xb2Vm\xac-\x0f\x1c\xab\x9bG75j\xc43\n\x85\x95\x8d\xc7_\x97\x8e\x00oX\xfb\xc87Eߦ\xce\xf4\xc34٥\xddc\xb9\xddo\xb4\xa0\x01+-\v\xf6,\x17\x8bA]}\xa3&\x05\xb5\xe7W/\xce\x01\x00\xb0\xc4\xda\xf9c\xca\xd3\f\xec\xdaZ\x94H\xadN\xa46\xef\xc6,\a\xb0\xda2\x97\x9e\xea4\xc8Rj\xde(5\xb8\xe5\x1fnޓ\xbd\xf5媊\x99\xa5k\xad\xf2Pv\x01\xfe\xc1\xd2;\x00\xb8P\xce\xcaM1E\xc1\xa6F\xbd\xf6@\xe1\xe0g\x16'\x00\xac\f\x02\xd9\x01\x00\x00\x00`\xc9\xc4{\xbe)\xe6\xfd\xcck\xa1벗fe_\xec\x038\x1aM\x1a!ypk\xbf\a,\xa7\x10\xd2\xec\x84}\xa7\xbd\xc4\xd96\x1d\x1d\x00`e\xc4nְ\x94\x86[\xf9?\x10H\x01l4\x1f\xed\xf7\\\xf3g\xfe\f\x89\x016V\xb3\xf71\x15\xf5\x95\x96'\x8e\x14\xb3J\xca\xc6\xdd\f\xba\x00\xd6W\xcc\xc2\"`\xa3)mg75t\xa8\xf13\x85\xad\xeb\xa5۾\x9b\x16\x0e\\\x14m\xfd)\x95\xe9n\x8a\x99BZ;\xab\x871\x8e\x18\xb0\xdaR\xd0_(\xe4\xda̞\xedV\x95=\xd7.\xbb\xbb?\t\x00\xc0\xf2\xca\xc3\xe9.\x90åA\xf5S\xd94\xcd\xc8\xee\xa3\\ \xbc\x03Xi\xde\xd93\x1d\xad\x8c\xdav\x83T\xcc\xfd`q|\xc3l\xbd\xf5߹zd\x1bsK\xd3\xd2D>VlwY\xbe8\t\x00\x17\xc0\xb5u\x97\x9c\xc8\xd6\xca\xf6TL\x0f\x14\x1e\xfb]\xbcK\x05V\b5\x1d\x00\x00\x00\x00X2U\xf5\xb9\x97f`\x0fݔ Vu\x8b\xb4\xb7\x00G\xa9\xb2Gq8zk\xbf\a,'\xe7\xf6\x17#{\xa7\xbcå\xb9ٻ)h\x16'\x01\x00K\xafM\x9d\xd4\xd2@V.\xd5\x01\x16\xf5\x01\x00\x9b\xcau\x93\n\xd6\xf3\a\xfb}`\xb3\xcc\x1f\xfd\xe51\x1c\x0e\xac:s`;\xbb\x8bZMa\xdb\xf1dw\x1e\xc0zr\xd1-\xc6㋭-\xdb逪Bʏ\xfd\xea\xee<p1\xb4\xed\x83*R(A\xe8\xeb\\nJ\xf5\vXqѧ6\xf14\x18XnϳW,\xa6*\xdf\xf4\xc3\f\x80\x02\x00Xn\xcf|{\x1c\xa8\xb2\x8d\x14\xd9\x19\xe4R\xe3G\xaa\v\xa5\xf2i[v\x97\x00Xa݄5\x8d=ۙ\x9a|\xb88\xb6\x89\xb6\xdek\xff\n\xfd\xff\x7fcE\xf4|\xb2\xd8\x06\x80\vbiHVZ\xe9\xc9\xd2\xd8l\xae\xbc\x1e(\x1e~\xa8?\a`\x15Г\x15\x00\x00\x00\x00\x96L\xdb<n\xb5\xb5Eϩ\xa8\xbc\xeb|\xa1>\xb0\x1d\xc0\xd1h\xd2\xcb\xe3\xeb\xffw:>a\xa9\xf9\x97Z\xfal\xc39\xb5i\x04Zψ\xd6\x00\xb0*\xba\x8e\xd7]\xf9\xdf\xd2q\x06\xb2\x026\x9c\xd5?쳮\x1e_\xec\x02\x9b\xe4\xd1?\x13\xe3\xe1\xcf*\xcb\n)K\x9d\xb7[e)[,N+\xe6\xc7\x16\xd7\x00XK\xb1͕\xbb]\xdb8\x94ܡ\x15\x8b\xed\xf9\x1f\xbeW\xf95\x7f\x9369\\4\xa1>\xdd\x05\n\x85\xd4n\xa6R\xf2\xfbv0M\xe5\x04`UE\xcb%BH\x81B\xf6L\xb7\x99\xdcОk\x00\x00\x96\\8\xbc7\rkk\x19\x99\x95K\xe3L>\x05z\xa6~A\x85\xed\x87͜\xbd\x19X\x17\xc1y+\x9f\xda\xf3\x9c\x06\xa7\xc8\v\xb9\xc1\xe6\x06\xb2o\xdd\xf6Q\xa7\xb1\xa5ime\xcbI+\xae\x9f\xeb\xcf\x00\xc0\x05\xc8\xe6j\xad\x9cT\x15\xe9\xa5Q\xea$g\xcb\xe1\vj\x1f\xff\x83t\xae\x00V\xc4K\xdd[\x01\x00\x00\x00\x00\xcb!\xb6\xa7\xd2\xe7b;\xadR\x0f\fG ;p\x94Z\x9eA\xac\x80\xfc|K_\xb0|\xc3/^\x8e:G_o\x00X\x15ѥ\xc2\x7fJ\xb7yu\x03\xc0R\x03K\x12b\xbb\xd7\xef\x01\x1b\xe4\xec\xf7+\xee\x95*\xb7\x0e,K\x1cIپ\xadK\xb5\xf6L4\x19us`\x9d\xc5&\xb3\x8f\x13\xb65\xb7\x8c\xf0\x9cB\x16\x95\x17\xefY\x9c\x04.\x96v\xf1ц\xc6j_\x03\xcbcR=\xac\v!\x02\xb0\xa2R\t1v/T\xedY\x0e\x99\x1ccS\x00\x00V\xc0|\xf6\xf4\xa2\x1bP\x9b\n\xa8)\x98=\xcd\xc2n\xf9\x99\xb7O\x06Z\x02VZ꧱\bd\xb7g:\xcf\xe5\xb3\xcd\x1e\x9c\"\x1b\xa7\x01\xa7*\xfb\xf78\xae\x96\xee+\x00\xde\bߪ\xb6\xa2S,\x9dB*;\xb9F\xd9,\xd7\xe1\xe1\x8f\xf7\x17\x00Xv\xf4\x86\x02\x00\x00\x00\x80%\x12\x9f\xfcS1\xcf\xc6j|zY5V\x99:\xef\xb9}Ui\x16*\x00\x97Lt3\xa9\b\xb6\xa4\x81#\xfa\x002\x97\x9e?\xa7\x98z7\xa6٠\x80%V=\xfb\xdfƼ9i\x1b\x03\xa9<'e\x03\x8dg\x95ݿiv)\x00+\xab>\xa6\xcc\x1dXFU\xc9ř\xadȏ\xd6Yh\xef\xb2\"\xc8~\xf7}7y\x7f\x10\xc0f\xca\x1a\x15\xb5װ\xf9D\x7f\x00\xd8\x10\x8f\xfeQ\xab¼\xa0Q\xdeHӡe\x8e\xb6ά\x8e\xdeze\xd5Ъ\xed\xcf\xf5\x17\x02XG\xd3\x1d\xa7\xb6~̞\xf9k\x14''\xb4\x7f\xfcJeo\xfa\xdbtq\xc6E\x13\x1e\xff\xa6\xb8\x95&m.\xe6\xd2บ*,z\xcey\xea\xda\xc0J\xb3\xbaS\xb65W\xf0Q\a\xf3\xab\xec\x11\xff\xad\xfd\t\x00\x00\x96\xd7h\xfaq)ZatPZAՊ\xa4M\n\xf2\xcc,_\x1b*\xcf\xd2\xe4\x17\x00VU\xa6c*S]3\x14\x9a\x84]\x8d\xde\xfc3\x1bݶ1\xbd\xf2\xbf\xd6tx\xcc\xfea\x1e\xd5\xf0\xe0x\x7f\x14\x00.@\xb8Z\x83\xb6\xd5p\x1a勹\xdaA\xa3i\x99i\xbb\x1aK\x8f\xfc\xba4\xc2\x1d\x80%\xe7\x16\xa3Q\x02\x00\x00\x00\x00\x96A\xf5\xd87\xc6\xf8\xc2\x0f)\xcf\x0e\xe4\xc3H.5\xb2\xe4\xa7T\xbb\x91\n\xeao\xc0%\x13\xd5\xc8ei\xe6\x1d\xa7\xd8,\x9e\xb5n?\r\x83\x1e\x83\xf6\xc7\xef\xd2\xce]?K\xc7Y,\xadٓ\xbf>\x0e\x9f\xf9I٭\xac0ؗo\xb7\xa5v*\xa5\xc1\xfb[\xa2!\x81\x95\xd5\xee(\xe6/tst\xa7\xa2`\xb3\xfd[U\xdc\xf5\x03\xe4Gk\xea\xdc=_\x12\x8f\x1d\xfe\x8c}ѻ\x9a\x0f\xf75\xa87{\x86\x06`\xb3\xa5:Iд\f\x1a\xbd\xab!\xdd\xc7Ƙ}\xee\xedqx\xf8P\xbf\a`\xd3\x1c\x16\xad\xb6\x9aZ\xaa\xc7j\xecW{\xddo\xd5\xe0ֿC>\x88\x8bf\xf6\xd0\xd7\xc7\xc1\xa9\x1f\x95˽\xe6\xe9\xfdK\x1bT\x16\x13\xc5\xf6\xa4ջ'\xfdU\x00VM\xeb\x06\xca\xfcܶf\x8bImӄ\x8f\xa3\xa1\x0e\xb4\xab\x83*\xd7͓\xe7\xbb\xeb\x00`\xd5L\xc6AU\xb0\xf2\xca\xc0\xdbΎ\U000b0b50\x1f\xd3\xf0]\x9f\xa5\x8c\xbc\x0e><\xa2\x03\x10\xb0\xa6\xa2\xb6\xad\x8e\xf9\xa2\x14\xb6t\x90\x8f\xb5\xfd\xbe\xe77>\xdd\x0e\xf7_\x1d\xfd\v/XY=\x05\xb2\xcf\x16\a\x01\xe0\xf5rc\xc5f\xd2\r\b\xeeR\xdd?d\xaaꁊ\xfc\xa4\U000adae4;\xe8\xdb\t,;\x02\xd9\x01\x00\x00\x00`\x89\x1c>\xf8K\xe3\xe0܇\x95\xc5m\x858\xb5u\x1a\xa2u\xaa&\x1b+\x0faq\x11\x80K\xc0\x9e\xb54\x13{l\x15\xecYs>\xcae\xe9\xf931jz\xe5o\xd4\xe8\x96\x1f\xa4\xb1\x13K\xeb\xf0\xa1_\x1e\xb7\x9e\xff\x98\xddʥ\xda\xfc\xac\xb20\xb6{\xb7\x91e\x1e\x04\xb2\x03\xab\xec| \xbb\xf3]\xfe\x14\x8f\xfd.eo%\x90c]M\x1e\xfc\xba8>\xfb\xa3R\xb3\xa3\xf9p\xa2A]\xf4g\x00l\x1co帶T\x95{\x95\xd7\xfd~\xe9\xba\xef\"\xed\xc7ڛ?\xf8{b\xdc\xff~\r\x9ba\x7f\x04\xc0\xa6\x99\xbb\x99\x06~G\xaa\xf6U\x8dN\xaa|\xd7)\xf2?\\T{\x9fy\x7f\xdc\xda\xff\xa4\xb2|`\xf7ۡ\xf28R\x16k\xabw\x8f\xe5ڪ\xbf\n\xc0\xaa\x89Vori \x14\xd9ҽֱ\x8f\x90\xda\xd2J51W\xe9y\xbf\n`EYZ\xa6xL\x1a>-\xcd\xd2\x00\xd6\x13U\xfeJ\x95\xbf\xf4i\xca\xc9\xeb\x80@v`m\x05\x15\xf2aߊ\xa5'u./t\xec=ϒn?\xf3\x9b\xe2\xfc\xc9\x7f\xacA\xbcRr\a\xfdA\x00x\xbd\n+\x1fW\x96\xbeF\xa5n\xd5\xc1JSm\xc8m\x7f\xa0<\x1bʿ\x9br2\xb0\xec\bd\a\x00\x00\x00\x80%\xb2\xf7٫\xe2\xce\xecE\xb9\xf6fUzFe\x16\xa4\xa6U\xc8w\xe4C\xea\x84\x01\xe0\x92\x88i\xc6S{\xde\\\xab\xa8\xba\x8bi_|\x98\xd4v\xf2\xfe\x8a\x86N,\xb5\xfd{\xde\x11wN\xdd#\rN\xa8\U000563c4\x912\xb5\x8b\x8e{\xa9\xa3\v\x80\xd5\xd4n\xa9-N\xd9\xf3\x9c+\xb4Q\xfe\xcao\x96n\xff\x1b\xe4Ik\xaa~\xfc\x0f\xc4\xe2\xf9\xbfa\xdf\xfbPUY\xa9l\xb2\xfe\f\x80M\x13\xf3\x99\xdc|ۊq\x03UW\xbcW\xc37\xfdk\xd2~\xac\xbd\xe9=\xe38:\x98\xda\x16\x81\xec\xc0\xe6J3r\x1dS\xed\xcf);\xfe\xb5\xf2o\xfaQ\xf2?\\Tg?qC<6;+\x97\x02\xd9uF\x03\x8d\x14\x1b/\x8d\x82\\E\xff9`ey+?֍U\xa4\xd2N\xb6h\x13O\x83\xbc\xa6\xc1\x8a\x8b\\MHeL\x00X=\xa1\xf5VF\xb9N\xc5\xe0!۳\xb4\xae\x98\xe9L.\x9d\xb8;RN^q\x93\x87\xbe-\x8eO\x7fo\xbf\a`\xdd\x04\x1f\xe4\xeb \xe5\xc7unpR\xc7\xdeq\x1f鶙\xdf\xe7\xe2\xe0\xf4q\xfbwaFv\x00\x17*\rT\xb7\xe8\x03\x17\xfa\x945\xba`K\xb7\xa5\xd9\x15_\xa7\xed[\xfe1i.\xb0\xc4\bd\a\x00\x00\x00\x80%r\xf0)\x17\xb7\xd3\xc4\x1f\xed-\xaaܳ*s۩\xa3b\xb9-\xd74\x8b\x8b\x00\\\x02c[\xec\x19siƎ\x14\xd0nK+\xc5.\x00\xd8\xcb}\xf1\x84FN,\xb5\xc3O_\x1f\xb7\xce>#\r\xafQ\x9d\xbd`\xf7o\x9eơ\xb5\xdb\xd7n\xdd\xf3\xad\xf7\x00VO\xdcV\x9b\xbd\xa8\xcc\r\x95\x8a\x82\xf9u\xbfO\xba\xe9\xaf\xf0P\xaf\xb3\x8f\x94V\xf8/TeA\xa5\x95E\x00l\xa6P\xcc\xe4\xe7;\x96\x0f\fu\xb0\xb3\xa3\xed\xbb\x1f\"\xed\xc7Z\xab\x1e\xfe\x95ѝ\xfdI\x15\xcdq\xab\x8fӑ\x11\xd8XV\x06\xaef\x03\xb5\xc7r\x8d\xee>Mއ\x8b\xee\xf0c;q\xab\xb6[\xab\xb0:\x97N\xab\fc\xa9\xceԌ+\xe5\x8cc\n\xac\xacP\xe7\xf2\xde[92\xb7:Tm\xebJ\xc1\xcf\xd5ڡ6J\xc3t\x1c\x00VQ\xd9X\x01\xe6*K\xe8\xd2{?[\x8f_P5\xbe]\xe5]\xb4\x13\xad\xba\xe9=_\x13G\a\xff\xa6\xdf\x03\xb0n\x82\xaf嫁\x15D\a\x9a\x8c\xbfT\xe3;\xfe9\xe9\xb6i\x9e\xf8\xfa\x18\x9e\xfaq\x95i\xc0)\x00\xb8\x10\xae\xb5\xc5Ґ\xe0\x14\xa3->\xcagV\xf1w\x8d\xed\xb7\xda\xf37\xe8ػ\x9e$\xcd\x05\x96\x18\xa5\x00\x00\x00\x00\x00X\"E\nV\xe9\x06\x0e\x9c-:\\\xb4\xe5\xa2\xe6\x16\x18\x84\f\xb8\xb4R\xf0z\x9a\xbd\xda\xd6~\x115\xd6\x05\xb1ǁ\x9c\xdb\xee\xf6\x81e\x96\xc5\xc3\xc5F\x8c\x8a\xce\xd9}lK7\x80%\xb3\xf9\x02\xab\xae+\x05F\xbfȗ\xd2\fSXk\xadK\xe9v\xaa\x10\xd0\xc9\x1a\xd8d]\x13@\xd7\xcd\"\xca\xd7{i\x03Xka\xef'\x95\xcf\xed\xa6o\xbb\xe1\xb8\x00l*\xe7T\xfb\x91\xf2\xad\xaf\xee\x0f\x00\x17ѓ\x7f$\x96.ulM\x85\xacJYZ\xa5z\xb6\xab\xad\xecE\xf79`\x959\x7f \x15\x13[j\xc5<S\x9boI\xe5U\xf2\xc5\t\xc5l\xac\xe02\x16\x16\x16\x96\x95\\\x92\x90\xef[\xfav\xcc\x12\xbb\xa1\xda0P\xa3\xb7vǱ\xda\xf2\xfa\xfe~\v\xc0\xdar\xb9\xa2\x9fi<xo\x7f\x00\xf9M?\xe24\xda\xed\xf7\x00\xe0\xf5\x8b\xde\xf5\xb3\xaf'A>\xbdOM\ab!\xa7B\xe5\xf4)\xe9\xc1\xff\xae\xebb\x03`91#;\x00\x00\x00\x00,\x89\xf8\xe0\xb7D\x1d\xfc\x1d\xb9y-奚8R\x9e\xe2i\xf3s\x8an \u05fe\xd4\n\x03\xe0b\xeb:\x03س\x97\xf5\xa3I\x04\xaf\xd0\f\xe4\xfd\xaeT\xeeHﺟ\a\x10K\xad\xf9\xf4 \xe6\xe7*\xa9\xb8F\xd5\xf0\x94|\xeb\xe5\xeaVY>\xb2\xfb\xb9\xe9\xaf\x02\xb0z\xc6j\xfci\xe5~G\xf5<\xaa\xb8\xf5\x8fJ\xd7~'y\xd2\x1a\xab?2\x8eE\fj\xb3\x81\x15K,]\a\xb0\x91\xaab\xae\xb2Y\xcc\x10Z\rJ\x95\xef}\x81\xb4\x1fk\xaby\xf8\xbdQ\xcf}\xdc\xca;^M<\xa6<\x9b\xf6g\x00l\x9c|\xa6\xa9\x7f\x97F\xef\xfa\x04\xf9\x1e.\xbax\xcfWF7\xfb\x884oՖ\x87\xf2>\xca\xcdv$\xbf\xafi\xb1\xadQC\xfb\x19\xb0\xb2\xb2\xb9=˙b\xb0\xb2e\xb0gۥ\x19\xd9B7nE\x1a$,\x9bn\xf5\x17\x02\xc0j\x99\xe4A\x8d\xa6\xda\x19^\xa7\xa6\xaaՎN\xc9\x1f\xff]*\xaf\xff~\xca\xcb+\xae\xfd\xd8v\xcc\xda\xc5\xe0\xfa\x00\xd6O\xb4\xb2\xa8\xea\xa1\xc2x_\xd9;\x02i\xf6\xe7{\xf8\xeb\xa2N\xfd\xcb~\a\x00^\x9f\x98E\x9d\x8f\x81\xf5iD\xf048eH\x93\x04d\x8b\xbe\x9f;f\xdbwk\xf8\x8eϐ\xf6\x02K\x8a@v\x00\x00\x00\x00X\x12\xd5\xe7>\x18\xcb\xf9\x7f\x92\xe6{\xd2@j\xc3IeU&\r_X\xcc\xccά \xc0\xa5\xe3\x82-m\xbf\xa4\xb8_\xfbh\xc7\xf2ŕ\xf6\f^!\xdd\xfdQ\x1a8\xb1\xdc>\x93E\x9d\xb3\xfb8\xbbZ\xf5\xf8\x8cb\xeb\xe4\xe7\x8d\xf2bL ;\xb0\xcaܖ\x1awJ\xb9?\xa6j\x1eU~\xc99\xf2\xa35W\x7fd;\x16\xaa\xd4\xdaw\x9f\x85Y\x7f\x14\xc0\xa6\x99卆\x96\x1ah\xee\x15\xca\x1d\x85[>\xa8\xfcʿE\x1e\x80\xb53\xdd\xfb#qv\xcf\xdfԉxhu\xef\\u\x1c\xa9h\xea\xfe,\x80MS\xf9\x99\xfc\xceW*\x7f\xeb\xbf!\xcf\xc3E7\xff\xd8\xdb\xe2@\xcfZAk\xaez8Q\x91:\xb7\xce\xc6R\xb1\xaf\xa9?\xa1Q\xcb@*\xc0\xca\xca\x1a\xb5)>(\xe6\xf2.\xcd\xc2f\xcfw\xdbE\xb5۱V\xed\x16\xfdc\x01\xac\xa6\xac\xb9V!<\"_ޠv\xfa\x82\xdcv%\x7f\xe2\xcfH7}\a\xe5\xe5\x15W\x7f\xc4\xc5\"\x0e\xfb=\x00\xeb&\x05Z\xba\xa6T=\xdeW\xf1\xf6\x9f\x9b;\x18\xbd\x0f\x8f(\xa0\x03\xb8 ]\xd5_m7p\x9d\xf7\xa9\xee\x1f\x15j/\x17l\xf1\x03;xZ\xfbE\xa6\x9d\xf7\xa6\x8e\x9f\x00\x96\x11\x81\xec\x00\x00\x00\x00\xb0,h\xa8\x05\x8eN\xea\xd44\b\x9aϏɍΨL\x1d\x9c\x1a\xa9\xcawT\xbeo\x8f\xc6M,\xb7O^\x17\xbb\x11f\xdbZ1T\x8a\xb1\x95\xf3\x8b\x86{y\xcbZ\xda|q\x1d\x80\x95S\xabT\x91O-O\xaa\xbb|I\x1f\xa0\xb3ú;\xf8\xe8\xd5q۽`_\xfe\x96\xa5\xe1\xcc\xc8\x02l,?\xd7<\\\xa1A\x9e\x02\xac\x9e\x97N^-\xdd\xf1,y\x00\xd6N\xfc\xc4\t\xab\u009cU\xe6\xafP\xccO\xc9Y\xd9G5\x039\x02k+\xb5\xbf\x15R\x959\xb9\xf9\xed*\xfc#\xdd,\xecj\xaeQU<\xa7:\xbbV[\xefx\x86\xfc\x0e\x97\x06\xef_\x00\x00\xc0\xaa\xa9\nŢR\xf0\xb52\x174)K\x8d\xdf1\xa7\xbc\xbc\xea\x9e\xfc\x86\xa8\x17\xfe\x89Ճ\xb2\xfe\x00\x80\xb53\xaf\xa4\xddB\xcfǷ\xea\xeaw}\x8at\xfb\v\x9cy\xf8\x8b\xe3\xf1\xa7\uf5cb\xb5\xe2\xb6\xd7~)m\xb7\xb9\xfc\xe1D*\xd2`T\xf4o\x01paڡ%(\xd5L\x87\xae\xd2\xee{\xe9[\x03,#\xde\x02\x03\x00\x00\x00\x00\x00\xf8 \x85Rޥ(A\xdbN3v\xa4\xad\xc8\vd\xac\x80\x14\xc4\x1e\xdb\xc5b\x9c\xfb\xbc~\xb9\x91\xe6?`m8^\xdao\x84l\xb7\xdf\x00\xb0\xd1b.\xe7犱J;\x8a53Tc\r=\xfe[bl\x1a\xab}[\x19'?\xa3\xd0\x1c\xb3:x\x7f\x0e\xc0z\xb2:MtN\xde7\x96\xcf=\x91\x0e\xa4ޅ\xb2\x04\xa0\x1b\x8c\xaf\xd8\xfa5\x8b\xeb\x00\x00\x00\x00H\xde\xc9e\xb6\xf2\x8bw\x03.;ޭ\xb1\xda\xe6\xd5)\xab\a\x11W\x05\xac\xb5,\xf53\xca4\x18^\xb3\xd8\xc7\xcfs\xe2\xf6\x9fuq{n\x19\xdbD.\f囹\xdaz*\x95\x966\xba\xb2\xbf\n\x00.@S+\v^\x037\xee\x0f\x00X6\xf4d\x05\x00\x00\x00\x00\x00\xf0\x8dԌ\xe4\xb3v\xd1q>\x8e\xec#W\xe3\xd2\x1aXrm\xddu\xfa\x96\x8b/\x05\xb1\xbbn:v\x13\x89\x04\x01V\x99Oϴ=\xc7\xdd\xc0*n\xd0\x1f\xc5:+\x8a\x1b\xfa-\x00\x9b\xad\xb0<`\xa6\x18\xac\x9cgY@C ;\xd6\xd0\xfc\xe0G\xac*~`\x05\x9e\xa1\x95s\xec@\x18(\xb4\f&\a\xac\xb58\xb0\xeaM\xe8f\x93\xf4ޞ\xff\xe0\xa5\xfa*՚(\xcfoVy\xfb\xffI4\a\x00\x00\x00p\x9e\xb3\xf2r\nfwNA\x85\xb2\xfc\xd6\xfe\x04V\xd9|\xf6\xa4Ճ\b\xdf\x00\xd6Z\x9e\xa9\x0e\xb9\xc6\xdbw\xf6\a\xf0\x85\xe2\xee\r\x8b\x89ףӠ\x91B[u\xffni\xea\x11\x00\xb8`\xf34\x11L\xd0 \x8cu\ue7b7\xd3a\x0eXBԄ\x00\x00\x00\x00`\t\xcc\x1e\xff\xd34\x9c\x00G)\xf5\x95\x0f\xb9|^w\x93[\xa7\x0e\xf4r\x85Bvuw\x1aXf1T\xb64\xb6q~F\xf6\xf3\xfd\xbeӚ>\xe0\xc0*\xf3.eJ\x96-\xa5\x8c\x8a\xc1U6B6\xb8\xbd\x8b\xe7I\x83\x93\x00\xd8`\xd1\xea&.\xd5M\xaa\xbe\xaeR)<\xf6\xbbH\x18\xb06ڇ\xbf(\xba\x834\xeb\x8e\xed\xb8\xb9bu\xa5\x94\x1fڽO\xf7\x05`\xade\xb9\x82\xe5oN~\xd1Y\xa9\xb5\xcf0U(\x1a\xb9\xf1\a\xbbK\x80K\xe1\xe0\xe1o\xa6\x1c\x05\x00\x00V\x90U\x9a\xd3`\xec\xb6nc\xae\xbc\xb8kq\x18+\xad\xad\x9e\xb5\xcf\xc5,\xfb\x00\xd6UT\xd5\xe6*n\xf8kt\xd6x\x05~\xfc\xf5\xaa\x86ikO\x85\x86\xca3\xab\xb6Ǒ\xaa0\xed\xce\x03\xc0\x85p\xa9\xbfgV\xa7\x02\x97\xdaɽ\xfdQ\x00˄7\xc1\x00\x00\x00\x00\xb0\x04\xc2\xe4\xd3\xfd\x16\x80\xa3\xe1\x14c\xb4\xcfJ\xbe\x8b\x19L}\x1bse\x837\xa5\x1d`\xa9\xc5.\x80\xbd\xe9\u05cb\xa0W\x02@\x80\xf5\xe0\xa2=\xd3\xc1\xd9F)e\xe3\xfe(\xd6Y\xfe\xe6\xbf\xeb\x82+R\xd1\x04\xc0&\xb3\xb4\xdf[\x95$\xeb:,\xa7\xcfV\xae\xfaX\x7f\x12Xq\x0f|c\x8c\a\xf7\xa8\f[v\x7f\xdb\x12j\xb5鞷;\xdeg\x87\xfdE\x00\xd6R\xd6.\x9a\xdc\x1a\xab\xdf(\xf5V\x8e\xaa\xb3\x17\x15\xb6n\x90n\xfb>J\xc0\xb8d|\xf5\xb9~\v\x00\x00`\x85\xc4\xd8\rd\x1d\xac\x18\x9dfd\xf7\xb7\xfd\x9f\x94\x99\xd7@\x16\xf6\xad\x80\xca{\\`\xad\xb5\xad\xea\xae\xdd\x03\xaf\xc4]\xfb\x97\\ܾU!\xce\xed߫P\xe6\v5\xed@\x9e\x81\xbe\x01\xbc\x11\xa9_Mz\xc1\xda\xeci\xc7m\xe9\xf0\xe1/#Q\x01\x96\f5!\x00\x00\x00\x00X\x02\xae\xba\xaf\xdf\x02p\x14bL\x01cS[\xa2|\x1a\x01\xddն8\r\xc7\xef\xe8\xaf\x00\x96S\xfb\xe0\xd7E\xe7[\xbb_\x83\\j\x8c\xefgo\xee\xa4`v\x02ځ\xd5\xd6\rPa\x8f\xb6/\xa5\x9c@\xf6MѺn\xfa\xe5\xc5\x0e\x80\x8d\x14S\x94\x9f%\x03N\x99\xa2˕\xa7\xb2\xde\xfc\xe1\xfe,\xb0\xdaf\x93\x7f`\xd5\uf454\xa5\xba\xcaX!\x94\n\xd9\x19\xab\xca\x1c\xa3\x1f7\xb0\xeeb\xb3\x18\xaf\xa9\xc9-\x9f;f\x19]\xa5zP(?\xf6\x95\xddi\xe0R)\xeaG\xfb-\x00\x00\x80\xd5\x11\x9dW\x9b\x9a\x88Bz\xddG@\xe4:\b\xcf\xfc\x89\x98\xda\xf9R?\x04\x00k,Z\xe2]l\xf5;x%\xd9\xf8kT\xa5\x10\xd3fn\x1f\xa5B\xac5ȻS\x00pAB\xee_\x8a\x92-\xfc\xb6\x9a\xfd\xff\xb8\xd8\x01\xb04x\x15\f\x00\x00\x00\x00K \x0fO\xf5[\x00\x8eD\x1cH~ҽO\xcab\xeaH;Q\xf0^\xd9-\xdf\xc9[d,\xb5zvO\xd7\xd7a\xd1\xdf!\xbd\xe53\x9f\x1f\xcc\x0e`\xb5\xa5\x19\xd9Ӫ\x9b\xa1\xdb\xf2*l\x84\xe0\xf2n\"~\x00\x9b+\xa4\xf2\\\x9bf\r(Զ\x96\a\xa4\x01\x8bf\a\xfdY`u\xb5\xf7~0\xc6P)\v\x99\x95m\xd2\x00r{j\xad\x9c\x13\xed\x1e\x8f\xf1\xd0*8\xfd\x85\x00\xd6Rl\xe7\x8bv\xb7\x14\x8d\x13k\xc5\xcc҃\xf1\x9d*\xae\xfd[\x94~qI\xb9\xfa\xf9~\v\x00\x00`uD\x9f\x9d\x7f\xf3\xa7\xbc \x90}\x1dLf\x1fW\x1e3)T\xfd\x11\x00k\xc9;\x15Ý~\a\xaf\xa4\xb8\xfe{]1x\x8bU\xda\x0f\x15c\xa9\xe0N˵\xe4w\x00.\\\x9dO\xbb\xc1\x93\xd3\x1cFj\xcfihE\xae\xf9\x83\xbf\xe5|\x91\x1a\xc0\x12 \x90\x1d\x00\x00\x00\x00\x96@\xa6s\xfd\x16\x80\xa3\x10\xdb\\\xf2m\x9a\x90ݖ\xb1\xa2k_\xea\x18\x00,\xb3P?\xbb\blJ\xcbˢ\xf9\x0fXm\x8b@v\xef3\xfb(\xbbm\xac?\x82\xd8\x01t\x15\x93\xd4\xd1\"\x16j\xbaD\xc1\xf6\x1b[=\xf4\xfb\xa8\xa6`\xa5M\xf7~\\\x037\x96\xf2\x03\xa9\xb5z\xb7\x9fwU\x16\x17N\xc8姥\xfa\xaa\xfeJ\x00\xeb(\x86\x94\x99\x9d\xb4\r\xcb\xce܁Bn\xc9\xc1\xe0m\x8b\x93\xc0%\xe4\x03#\xa5\x00\x00\x80\xd5\xe3\xb2\xf3\xef\xf8\xbc|\xc6@\xb7렮\x9e\x92\x8f\xf6\xbd\xc6T7\x02\xb0\xb6\xbcW^\x8e\xfb\x1d\xbc\x9al\xebnɲ\xb8\x10\n\xdbk\xa5\x8a\xfc\x0e\xc0\x85\xab\xb3\xa9\xdafh\x89\xcbȒ\x94C\r\xe2\xb6\xf6\xf7~\xaa?\v`\x19Г\x15\x00\x00\x00\x00\x8e\xda3\xbf/\xfa\x96\xea\x19p\x94\xbc\x93\xaa<\xbd<>&\xdf<'WI\xb3\xad+\xfa\xb3\xc0\xf2\x1a\xb7\xe7\xa44\x10CZ\xd2\b\xfe]\xe7\x87\xcf\xcfS\x98\x9d\x1dXe\xb5\x1f[&\x95\xabn\x9fVu\xec+\xfa\xa3XwM~\x9d\xfcd\xd6\xef\x01\xd8DYq\xa8:\x9f\xd9r\xa8,\xb6V\xbe\xdbVeY\u0099)\x9d-\xb0\xba\xe6\x9f<\x16\xb7\xadz\xe2\xebTG\xb1\xfb\xdag\xdd\f;\x85\xdd\xe3eܳ\fp(\x15\xfb\x8b\x8b\x01\xac%_\x9e\xd0\xd4?.\xed\x1e\xa6F8i\xf8ne\xb7\xfe#\x86q\xc2%5\x7f\xf0\xeb\xa3\xe7\xf5\v\x00\x00XE\xfeP\x85\x15\x9bs\xab/7\xbb\xef\xef\x0fb\x95mןS\x9eOԦ\xe0*\x00\xeb+\xaf4\xb8\xf5?\xd1\xde\xf1Z\xbc\xf9\x9f\xba\xbd\xf2:e\xb3}\r\xdb\xd4D|П\x00\x80\xd7ow\x16\x14\xeb\xa8y1P\x95\xa7\x80\xf6\x89\xae(\x9f\xd0\xc1C_\xcb@\xe1\xc0\x92\xa0\xa9\x1e\x00\x00\x00\x00\x8eXS?\xab\xd8\xd0~\r\x1c\xa9\xd8\xca\xd9c\x98&\xbc\xed\xa2\xdam#/\x99\t\x0e\xcbm\xfe\xcc\xef\x8b\"\xff\x00֚K\x99S\x9a\x94\u05f6˛\xbe\x8b\a~Cd\xc5\x15R\xde\xef\x00\xd8L\x96\xf0\xc7\x18\x95\xb9`Փ4\x1b\xbb\x97o-_\xa8\xcf\xf5\x17\x00\xabe\xf2\xe0\x97Ǭ\xba\x86\xde\t\xc0\x86\x8b\xad\xd7(s\x8a\x13\xa9\x1df\xca\xde\xf6q\xea8\xb8\xe4\x9a\xfaL7~\n\x00\x00\xc0\xaaIMBJ\xaf\b\xb2\x03\x15\xc5-\x8b\x83Xm\xa9\\\x9a^\xf88\n\xa8\xc0:k\x1d\x8d\xa0\xaf\xc7x\xf8k\xd4\ff\x966\x96\xca\x03\xe9#\x807\"\x93\xf3\xad\x15\xa1[\xf9n\"\x98\\jl5\x7f\xa1?\x0f\xe0\xa8QJ\x02\x00\x00\x00\x80#6\x9f?\"ז\xfd\x1e\x80\xa3\x11\xe5}\xa6,\x05\xb1\xa7^\x01\xd9HY\xf9\xa6\xfe\x1c\xb0\x9cf\x93O\xa71\x18\x00\xac1\x9fz\xa9\x85\xa0\x18\xd3H+\xd8\x14\x83\xf2\xcdR\xc1w\x0el\xb2\x10\xb6m\tVG\xa9\x95\xa5\x10\xbf \xe5V\xee\xcb\xea\xb3ҽ\xbf\x83Y\x03\xb0Z\x9e\xff\xc6\x18\xce|H\x99N)d\xe4o\xc0&sM\x1a\xa9%S]K\xf3\xad\x0f\xf4G\x81K\xab\xae\x9f\"\x90\x1d\x00\x00\xac(o\xbf\xb7T\xf9J\xee\x1a\x06\xba]\a\xae\x1dZ\xd94\xb5\x8d4\x8b\x03\x00\xd6R\xa3\x1b\xfa-\xbc\x16\xf9\x9b\xff\xae\x8b\xe3\xe3\x8b\x1d\xea\xef\x00\xde\x10/\xe7\x1byWK\xc1\x8a\xcfmэ\x1f\xe4\xa7OH\x8f};\xefW\x81%@ ;\x00\x00\x00\x00\x1c\xb1\xb6~Br\x83~\x0f\xc0\xd1h\x95\xc5b\xd1R\x12ZE\xb7\xa5\xfc\xc6\x1f\xa0C\x00\x96Z\xa8\ue4d3ݷ\x00\xd6X\xec\x82\x17凋]l\x84⦿oe\x10\xa6d\a6Y\x88\xdb\xfdF\n\xf8Kk[b\xa6A<T<\xfc\x8f\xdd)`U\xcc^\xf8\xbbڶ:\xb6\xd3i\x85\xbc\xbf\xb7\x01l\xa8\xca~7\x8a\xa3\xa1\xc6w\xfc'\xda\xddpy\xb4\xcf[9\x8a\xf7/\x00\x00`\xf5\xa4A\x0e\xe5ƪi*^\v\x93{\xbf>f!\x05\xb2\xe7\x8a\x04\xb2\x03k\xad)\xde\xdco\xe1\xb5\xcaƿR\xd1WRM\xfd\x1d\xc0\x1bQZ\x82b\xe5\xac\xd0ʇؽ[M\xefX\x8b\xfaY\xd5\xfb\xff\xbc\xbf\x06\xc0Q\"\x90\x1d\x00\x00\x00\x00\x8e\x98\x0f\a\x123R\x01G˥\xd9n\xbd\x14j\xc5Ъ\xf2;\xfd\t`y\x15\xf5\x8b\xe4\x1f\xc0\x9a[Dvx\xb9l\xdcmas4\xbe\xec\xb7\x00l\"\x17Ke>\xd5O\xfa\x03\xbe\xb6e\xa0\xd2Y\x9e\xd0>\xd6\x1f\x04\x96\xdf\xc1\x83\xb7\xc7\xec\\\xda*\xec~ޱ[z\xda\x1d\a\xb0\xa1\xbcel\xa1Uq\xec\x8b\xfa\x03\xc0\xa5W\xb8IW\x8e\x02\x00\x00X5\xce\xca\xcei\x80\xc3X\x9e\xec\x8f`\x95\x85\xf8)\xb9n\x00[\x06)\a\xd6\xde\xe8\xed\xfd\x06^+\x7f\xdb?u\xb3<\x93Z\xfaj\x01x\x03\xbcW\xe6S3\xb4\xb3B\xb4-i:v+{\x95j\x94՟[\\\x03\xe0H\x11\xc8\x0e\x00\x00\x00\x00G\xe9\xb9?\x1d\xb3\xaecz\x9ab\r\xc0\x91\xf1Q\xb1I\xcd$M\xd7Z\xd2f\xbb\x8b\xe3\xc0\x92\x8aO\xfe\xee8\xac\xedf͘\xc0\fXg1\xa62\xa2\x97\xf3\x04\xb2o\x9a\xca3\xcd\x0e\xb0\xd1\\\xab\xdc\xea(j\xb2\x94\x19(䕂\xb3\xfd\x14\x84eE\xc0\xfa\xb1o\xa6\x11\x01K\xaf~\xe0\xf7\xc7\xe1\xecQ\x15\x85ݷ\xd3\xd6\xee\xeb\x93ru՟\x05\xb0\x91,o\x9b\x8f\xae\x96\xbf\xfd'i\xcc\xc0eQ?\xf5\xad\xb1L\x03(8n9\x00\x00\xb0\x8a\x82\xfd\xaa4\x1c\xbd\xb7\xdf\xc7*s\xf1Y\xfbJ봡nvP\x00kk\xeb\xf6\xff\x95J\xe8\x05ȶ\x7f\xb9%\x8f\f\xf4\r\xe0\r\x88\xbe+j\xa9\xcd\xe5R{`>\xb3\xb2\x97\xed\xfb\xa1|h4\xbb\xf7\xebx\xbf\n\x1c1\x02\xd9\x01\x00\x00\x00\xe0\bͪ\x9f\x91oJ)\xce\xfb#\x00\x8eB\x1a\x84\xb3\x9b\x91\xddE\xb9\xc2\xc9\x0f\bd\xc7r\x9bO\xff\xb3\xf2j\xcb\xeeٺ?\x02`\x1dŘ\x82\xbe,_\xe2\xa5\xfdƉ\x03\x06/\x006ۤ\xebW\xa1\x98fh\xf2j\x8bV3\x17Դ\x966؉f\xffߦ\xb3\xc0R\v\xfb\xff\x9b\xf2\xd91)\xaf5I\xb3^dgU\xd4Ե\x81\x8d\xe6\x1b\xf9\xed\x0f\xf6;\xc0\xa57\x99|T\x85˭\xf0\x94f_\x02\x00\x00X-.\r\xbe\xee*\x15\xa3/\xea\x8f`\x95\xb90բ_P#瘕\x1d\x00\xbeP\xf9\xa6\xff\xe0\xa6\x03\x06B\x05\xf0F\x04+oy\xf90\x90ˬ\xbcU\xb4\x92\xb7%\x1e\xb3S\xa5\xb2\xbd\x9f\xea\xaf\x03pT\bd\a\x00\x00\x00\x80#4o\x1e\x92oK)\xcc\xfa#\x00\x8eF\\\xccz\xeb\xbdT\xe4*J\x82ǰ\xdcf\xf3\x87\xa5vd[\x04\xb2\x03k\xad\xedg\x8e#\x90}\xe3\xf8\x82\xef\x1c\xd8l\xd5\xe2-n\x9bۇW\xb0U\xa5FU\nlw#\x85\xd9#\xddU\xc0\xb2:u\xef\rq\x90\xca1\xcdY5Ֆ\xfc\x89\x89\xd5\\\xceI\xe1x\x7f\x05\x80\x8d\x94U*\xde\xf4\xfd\xddX-\xc0\xe50\xaf\x1e\xed:\xaf\xaa\xa5\xfd\f\x00\x00\xac \xef\xbb@v]\xf7\x17(C\xaf\x81\x98\xdaIҌ\xec\x8ar\x84o\x00\xc0˪\x06\xf4\x9f\x04\xf0\x06\xa4\xbe\x9f\xc1\x8aΡ\x902\xaf\x98\xa7^uA!\x0e\xed؎\xb2\xeaE\xe9\xd1?\xc0\xac\xec\xc0\x11\xa2&\x04\x00\x00\x00\x00G(\xdb\x7fPE\x1a\xf5/K\xc1\x88\x00\x8e\x8ak\xafV\x91\xedK\x93\x99\x0e\xd3\b\x9c\xb7\xfd8\x1d\x02\xb0\xbc\x9e\xfc\xf6x<\xf5s\x18z\xb5qkq\f\xc0Z\xca|\xd0\xe1`\xa2\xf2\x8eϐ/m\x98\xe9\xce\x7fk\x9fi\x84\xf0\x81\xadK\xc5,\xd8\xd2ؑt+\x14\xbc\xdd\x01\xd6\\\x96\x9e\xff4\xe8]\xd1Xe\xa5\xd1\xe0p\xa8\xe3M\xa1qvڎG\r\xa3T\xdf\xf3\x15t\xb4\xc0r\xfa\xf8N\xbcbr\xae\x1btA\xd9P\x99\xabT\x1e\x94\xcaہb\xf1\\\x7f\x11\x80u\xd4\r\x12YԶ\x91:\x1d_\xa1\xe0\x87\x9a\xa7\xc1Y\xdcնرw\xa7^\x84\xc0\xe5sR\x96\xefĩ\x9a\x92\x81\xc2\x00\x00\xc0\n\x9aL\xb5\x9f\x87~\a\xab\xacy\xf8\xbf\x8a[i\x80\xa5\x91W\x15\xadl\xea'\xfd\x19\x00\xabh\x927V\xd7Lm\xf8i\xb6ߙ\x1a\x97\x1a\xec\a\xb6]i\xe6\xde\xdc_\x85\vq\xfc\x1d\xfb.\xf8z\xf1o\x9b&\x05\n\xe9\x1d\xe9HU1\xb7\x7f\xf3m۷5\x00\xbc\x924\b\x942\xfb\xbdg\x05\xb0C\xb9á\x8av(\x9f=g\xe5\xafC\xf9\xd11է\xff\xda\xe2Z\x00G\x82\xaeN\x00\x00\x00\x00p\x94\xba\xd9\xd5R#+}\xf8\x80\xa3\x14\xa2=\x87\xc1\xab\x9b\x91\xdd\xf3<b\xc95\xf7I\xf5ز\x8e\xb3\x8a\xf4_\x01֛\xf3\n\x81\a}\x13\x9d\xbc\xf6/\xba\x18SG\x984+\xf3\xe2\x1e\x88\xd1+\xeb\xea\r\xb6\x9f\x82\x84\x00l\xac̒\x82\xb6~\xba\xdf\x03\x96\xc7\xec\x89o\x8b]\x1e\xf5\x05\xf9\x94s\x8bz\xf6\xf95\x805\x95:q\a{\xfe\xb3Ҷ\x9f\x97w\xb5\x06\xbeP\xa5g\x15\aw\xf7\x17\x01\x97O\xaaR\xa5\xea\x93\xeb\xebT\x00\x00\x00+%\x93\xf2\xe2\x9a~\a\xab\xac\xa9'R\xbdh\xd7w)\xe0\x95\xf0\r`\xa59g\xcf\xf0\xf9\xf6O\x97\xba\x19勾F*5\xd8\"\xdd~\xa3|\xf9.\xb5~\xdf\xf2A\xcb\b}\xab\x10\x0f\x95\xc7\x1d5\xedY;\x96\x06\x00\a\x80\v\xe4ϩhNHO\xfd\xe1\x9f\xff\x12\v\xc0eCM\b\x00\x00\x00\x00\x8eP֦\x99\xd8+u\xb3\xd5\x0082!\xcd\x14\x152\xa5\x19\xe3\xa2/\xfa\xa3\xc0r\x9aU\x1fWl\x06v\xbf\xa6\x19\xceh\xde\x03\xd6Z\xf4j\x94\x06>\xc2&j\xe4ԦA\xaf\xfc\\\xae\xf5\xb6X\x19ťN\x1bVw`$\x13`\xc3\r\xd5\xce\x1eU|\xea\x0fИ\x80\xa5\xd2>\xff\xbdV\xb7\xee\a\\\xe9ۺR\xf0\xfa\xf9\xc5>\xbac\x00֓\v^upj\xf2m\xb5\xdd\xecY\x87]\xb9u\x9a[Y\xf6\x1d\x9f!\x01\xc0\xe5\x97\xda\xcfZ\xabCY\xed\n\x00\x00`\xe5x\xa9\x1c0\xb3\xef:h\xe7g\x15R\x914f\xf2Y\x94\x8fT\x8f\x80U\xb6\x18t\xba\xb5t\xda)t+K\xb0}T\x1dJ\xb9ݷ..\u0085\xdb\xfa*\xcd\n\xfb7\xf6\xa5\xed\xa4ĳ\xb2\x7f\xf1\xa1Bڦ}\x19\xc0\x1bФ$$\xee\xaa\xdd\xfb\xbe\xc5\x01\x00\x97\x1d=]\x01\x00\x00\x00\xe0\x884\x0f~k,ڡm\x05\x05\xdaY\x81\xa3\xe5\xe6R\xccm=T\xf4W\xf5\a\x81\xe5TM\x9f\\ܳ\x89'\x03\x01\xd6Z\x9a\x91]\xbb\xfd\x0e6M\xf0c\xb5.XZ\x9f:ix\xa9Me\x95t\xa6\x11\x91\xab\xc0\x86sNY\x98\xa9>\xf8\xf7\xfd\x01\xe0\xe8\xcd\x1e\xbc&n\xb5\xb3E\x00\xfb\x17\x0e\xd8H\x10;\xb0\x19\\ieX\xaf&\xcd~\x9dz#\xb5\xb6\x84Z\xc5֗v\xa7\x81\xcb\xe9\U0001ebcbY\x18v\xf5(fd\a\x00\x00\xab\xa9Ԡ|W\xbf\x8dU\x965{\xa9[\x90qVWj\x15\xe9 \x04\xac4\xaf4`Z\xec\x1e\xe9\xf6\xfc\xf3\x1c\x1bUa$\xdd\xf07x\xc0ߨ\xdb\xff\xa2s\xa3\xb7\xa9\xee\x06\xf5v\xcaҿh\x9c)\xef\x02۫t\x05\x00\\\x90\xd0Z\x82\xe2\xcf\xc9\x1d\xd4\xd2\xd3\xdfF\x97\v\xe0\b\x10\xc8\x0e\x00\x00\x00\x00G\xa4m>\xa1<\x8d\xcaj\x82O\xbd\xfa\x00\x1c\x95\xae3c\x9a\xe1Ԟ\xc9,\x7f[\x7f\x14XBO\xff\xb6\xe8gv\xab\xe6\xe7\x94fjv)\xc0\x11\xc0\xfa\xb2g\xbc\xccn\xecw\xb0i\xf2\xf2f5\xb1PL\x9daR\x9a\xdfu\x86I\xe9~k\x9f\xcc\xd4\x0fl\xb48W\x1a\x16/;|t\xb1\x0f\x1c\xb5\a\xdf\x19u\xf6y\xa9<\xae\x18-\xe7\xfa\xfc@v\x02\u0601\xcdἼ\xcb\xd5\xc6}e\xeeX7iV\xe3v5~˿%!\xc0e\x17\xe2\xa7\xe4c\n.\xb0:\xd5\x17\x0e\xb0\x02\x00\x00\xb0\x02Z7T~\xd3_\xa7,\xbd\x06\x06q\xb2\x18\x9b\xdc\x15]\xbf\x04\x17\t\xdf\x00V\x99\xb7g8\xf6\xa9s\f\xf6<\x87\xd4\x1eڨr;\x8b\x83x\xc3\x06;\xbfN\x93Pڿ\xeb\xa2^\x1f\xe2\x9e|q\xa5\xfd{ӿ\x12\xc0\x85s\xcdI);\xb7\x98G\xe0ܿX\x1c\x04pYQ\x13\x02\x00\x00\x00\x80\xa3\x12\x1e\xb1%5\xb0\x0e\x14\xba\xd6\x11\x00G\xca\xc7\xeeY\x1c\xdf\xf1O\xe9\x10\x80\xa5UO>\xa2\"\xcd&\xd5\r\xf2\xbd\xd3\x05\xb9\x02X_!\xabU\x96\xb7\xf7{\xd84\xd9\xf8\xeenF\xfe.\xe6\xe2\xfc\xe2Z۷:\x84K\xb3\x0e\x00\xd8T\x8b\x99nw\x94U\xb5\xf4\xc0\x17\x13\x99\x85\xa3u\xdf\xef\x8a:\xf5i\r\xed\xb6\f\xaa~~\xb0 A\xec\xc0f\xf1R\xaeL\xbe\xb52\xab\n\xd5厴\xf5e\xfdI\xe0\xf2\xf2z\xce>\xd3\xfb\x17ˠ\xba\x8e\xef\x00\x00\x00\xab\xa5\xca\xd20\x86Xu\xcdS\xbf5\xba\xb8o\x05\xd4T&\xf5VBM\xed\xfb\x94O\x81U֍K\xe1\xad\xc6\x19\x83b\x1ax:\xa6߭|yrq\x01ް\xec\x86\xefv.\x7f\x8b\xba1\xbe\xadN\xdfu\x8b\xc9\xf24f\x00\x00\\\xb0\xbc\x1bL\xa8\x94\x8a\xb1ڃ'\x16\a\x01\\V\x04\xb2\x03\x00\x00\x00\xc0Qi_\x94\xe2\xa1m\x94V;#\x10\x118ZΞ\xc3F\xc1\xd7\xfd>\xb0\x9c\xaa\x83\xa7T\x9e\xef\xdc\x103\x85\xf3C}\x03XK\xad\xe5M\x83\xc1\x9b\xfa=l\x9c\xd1]VB9\xde\xcd\xc1\xdeI\xc1\x80\xb1V\xb0\x03\xdeщ\x11\xd8dUz\xc3\x1bƶ\f4?\xf8\xf0\xe2 p\x14\x1e\xff\xb6\x18\x0f~\xc8nJ\xbb\x1f]\xae\xa6\x99[v\xf5\nu\x94\x14\xe0Ό\xb8\xc0zs\xb5\xfdv*\xad\xbc\xda\xc4\x17\x15\xb7ޭ\xfcm\xff\x8c\x86\v\x1c\t\x17\xa6\xf6Y\xd9F\nfϻc\x00\x00\x00\xab$\x96V\xd7\xc6\xcakf\x0fH\xad\x95M\xa3W\xdb\x06\xc5h\x15&\x9aG\x80\x95\x96ƛN]\x8cR \xbb|\xd1\x1ds.\xaa\x1c_\xd1m\xe3\xe2\xd8\xdd\xf9r\xb9\xbcV\x8a^\xf7\xf1\x98\xfdÿ`\xffУ\xfe,\x00\xbc~ΝUe\xe9H\x93YZ\x12\xf6\xa4\a?@\xa9\f\xb8\xcc\bd\a\x00\x00\x00\x80#\xe2B-\xb53)\x16\x8a\x9e6\x11\xe0(\x85\x14\x11\xa6h\xcf\"\x81\xecXb\xcf\xfd\xd1XOk9\u07fc\xd4\xc1\x818\x10`\xbd\xa5\xd1\xfcu\xeb\xffL\xe0Ǧ\xba\xe1ϸ\xa8\xb1R?\x18\xd9\xd6b#\xcdȞ\xf6\t\xc4\x006Y\x9b\xa5\x81-\xb2.-\xa8'\xd2\xc1\x13\xbf\x9cR!\x8eDu\xfa\x9fȵv\xfb\r+\xb5VM)\xb2ԋ\xd3n\xcd\x14\xcc\xfe2\x01\xed?o\xb6v\x00k\xa7\xf5U*\xae*k\x86)|X\xf9\xe8-\x8b\x13\xc0QHӴ\xa5w0\xdd\xd4xT\xab\x01\x00\xc0\xea\xf1%\x83\x99\xae\x83\xb6>\x9d\x1aD\xba\xf2i\xdb\xc6\xc5l\xc2\xdd\xcb\x1f\x00\xabʥ\a95\xd1۳\xed}n\t\xb6\xb7\xc7\xdai0\xdc\xe9\xaf\xc0E\xf1\xe6\xefq>\xb3z}L\xc1\xec'Մ\xa9\xbc\xb6\xfa\x93\x00p\x01|\xad\xc6\xe5\x9aǁ\xa5ۭT}\xaa?\x01\xe0r\xa1&\x04\x00\x00\x00\x00G\xa0~\xe4\xf7\xc42Şlmi^\x9f\xd3pFC+p\x94\x8aڞ˘iop{\x7f\x04XB\xcf\xff\x88\x8e\x173)\xcf5mw\x15m]\xb8\xc3\xfe$\x80U\xf4R0\xd7\xf9`\xaf\xcf\v\xfaJ\xe7B(\xbbml\xae\xc9\xd6\x13*2ۘ\x0f\xa4\xc19\xb5nG\xc1\x0f嚽\xc5\x05\x006R1=\xa9\xaaxN\x1aN\xb4UH\xe3\xe7\x1f\xe8\xcf\x00\x97\xcf\xec\x9e;\xa3o\x1e\x91\x82eT\xd5\xf5\xca\xf2]5i|\x85\xf3\x01쩜\xf3\xf9\x8by\xc5\xd9\xda\x01\xac\x84_\xac\xfer\xa8\x93\xd2ve;3\x15\xa3k\xe5o\xff~\x1ez\x1c\x89\xc9\xfd_\x12\xc7\xf5V\xd7+\xae\xf65\xf9\x0f\x00\x00XNi\x16\xdf8T\xabL\xc1\xb7\xb6\xa4\x81L\x87V\xc6N3\xb1G\xed\x8f\x7f]w\x19V\xdb\xf0\xf0T\x9a\xdbB\xa1\xb8Ney\xa0Ac\xdb\xf6\x9d\x03X]\xceR\xee4\xb6\xe7`k,ל\xb5$\xfb@s{\xac\xfd\r\xff\x98\xca\xe7E\xd6^\xf1\xeb5\x0f#\xb5\xa3G\xe4\xc3\xd5\xf6\xef\xcd\xe4$\x00\xde\x00K\xbbǮ\x95\v/\xc8\xfb]i:\x91\x1e\xfa\x92\xbe\xd1\x1b\xc0\xe5@ ;\x00\x00\x00\x00\x1c\x81\xba>\xad\xd8\xd8F\x9c)\xcb\nɥ\x97\x92\x00\x8e\x8c=\x87\xad\x0f\x1a\x947\xf6\a\x80\xe5\x13\xaaiz+jy\xc7\\\x99\xa2\\\xd6\xf5t\x00\xb0\xc2^\xb63}\x8c?\x17 \x92\x9d\\\xac\xb1\xb1\xf2\xfcJ\xa9M\xf7I\xaa<\xb8\xee\xdeȼ\xb7\xf4\x9f\xf7\xa9\xc0&\x1b\x14\x87\xf2*-}\x18[^R(\x86=\xe9\xa1\xdfA\u0080˦z\xf0w\xc6j\xfeL\xea\xf3c\xe5\x95ʖ\xb9m\x04\xe5\xccx\v\xac\xb5_\xac\xfe\xb2\x1dZ\x85I\xa6\xb0#\x95w=C\x82\x80#\x13\x83\xe5M\xdd\fy\xce~g?W\xc7\x06\x00\x00X*\xad\xe4SH\xb3\xb3\xf2\x8b\xb7B\x8c-Ύ)\r\x0e\xd5ꪛ\xbe\x872\xf5\x1aH_\xa2\x8bV&u\x13[l'\xd87\x9e\xde\xf1\x02Xa\xc1~\x0f\xecs*\xdf=\u05f9%\xe1\xc3\xc5)\\T\xd9\r\xff\xcci\xa7V\x9c\x0f\xe5\xfd\xbe\x1d!\xfd\x04\xf0Fd\nm\xa5<\x95\xd0\xfc\xa2\xcf]\x98\x1c\xf4\xe7\x00\\\x0e\x04\xb2\x03\x00\x00\x00\xc0\x11\xa8\xe7Oɵc\xd5m\xa3<\x1b\xf4G\x01\x1c\x19{\x0eS \xfbh\xf8\x9e\xfe\x00\xb0\\\x0e\x1e\xfc\xed\xb1\xeaf\xdfuR\x98ɧ\x81\xfa\xdd\xdc\xf6\xc8C\x80\x95\x96\x02A^af\xb8\xae\xa3}yk\xbf\x87M5\x1cܩ\xb6\x1dZY\xa5\xb5{%S\x13\x83\xb2\xcc2\x01[\x03\xd8\\\xce\xcf,Y\xb0r`\x93۞\x97\x8fSM\x0f?\xb48\t\\b\xcd=_\x1e\xab\xfd\x7f/_\xefٽh\aR\xe7\xeb|jyS#ה\x8b\x8b\x00\xac\xa7_\xa4\xfe\xe2\x9bF\xb5fj\xc6_\xd5\x1f\x05\x8e\x86k*\xa9\x9d[1\xc9\xcaIiY\f\xbd\x02\x00\x00\xb0\\\x82էӄ\ai\xf0\x9d\x90ɧ\"KV)\xb8\xa9\x95\xabi\xff]\a\xedc\xdf\x19}\xb0\xef2\x8eպs\x8b\xeaT\x18\xdbw\x9e\x06\x04\x04\xb0\xb2\\\x1a<m\xa4\x18&\xf2\xae\xb4\xedLʏ\xf7'q\xb1\xb9\xddw*\xa4<3L-\x9f$\xfd\x04\xf0Fl)6\x87\xdd\xe41r\x96\xae\xb4\xb9懧5{꿧\xf1\x10\xb8L\bd\a\x00\x00\x00\x80\xa3P=-\xb5#\xb5\xe9\xfdcfU\xb3n\x03\xc0\x91\x89i\xdc\xdeL\xd9u\xdf\xfd\xf2\xbdq\x81#VU\x1f\x92\xd3\\id\xef\x18[e\xa9\xa7CH/\xe9\b\x14\x01\xd6\xca\xe7\xcd\x12\x97f;tû\xfb=l\xaa\xf1\xe8}j\xe2\xae\xe4-\xcdw\xf9b\x80\x83\x14\xc8NGF`\xb3U\x96$\xb4Ѫ1\x13ۨ\xbb\x99\x9dT?\xae\xf9#\x1f\xa4\xa3\x05.\xad\xfb\xbf!\xe6\xd5Oi\x14\x9e\xd0\xd0n\xbc<\x8d\xb0\x95{˧ҽ\x98:\xfdP?\x016\xca\x17\xd4_TO\x95\x1f\x1b\xab\xbc\xed_Ӿ\x86#\x95ՇVeJ\x81a\xb9-t\x8d\x03\x00\x00\xcb)\xb5\xect\x8d<i\xdd.\x06+\x94\xafըU̶\xd2%Xq\xb3ɧ\x17\xf5\xa6X(\xa4Y\x84Ӕ\xecqd\xf5\xa7\xba\xbf\x02\xc0JJɵK\x81\xeci{d)y&Wܰ8\x87\x8b\xae\xbc\xe9\xc3Ε\x96\x86V\x96\x86\xa66h\x00\xb8PqХݖjK\xc1\xcac\xa9\\\xd6\x1c\xa8\xda\xff\xb7\xfd\x05\x00.5Z\xeb\x01\x00\x00\x00\xe0\bd\xe1\xc0>S\x03kZ\xb5ġ\x00G-\x04\x05\xbf\xd3\xef\x00K\xe6\xe1o\x8fE|Be\x8a\t\t\xb9\x9c\xf3]\x1fܶI\x1drSF\x02`-|a\x10\xbb-\xc5\xcd\x7f\x9b\x87|\xd3]\xf5\x9d.\xba\xe3V\x81H\x1d3\xfaW:]\xcf\x18:j\x00\x1b\xad\xc9RÂT\x1cZ\xa6ak7Ti\xabx\xf0\x99\xfe\x02\xe0⋏}Sl\xf6\xfeA\x17\x18\x98ٯ<\x9e\xb0\xedcv?\x16\x965\xa5\x1b\xb0\xed\xaf\x04\xb0\x11^\xa6\xfe\x92\x06W\xc9v\x7fC\x7f\x148:E3\xb5{\xd46\xba v/\xd7\xed\x00\x00\x00,\x97е\xf7\xd6Vf\x89\xf2Ѷ\xbb\xf2KP\x13\xad\xd6]\xdc\xd4]\x83\xd5\xd6\xce\x1f\xb2\xcfԖ\xef\x14\xba\xaf\xb8\\ԝ(\x9f\x02+-%\xd9\xce{\xf9\xf4\xba\xce\xd2\xec:\xb5\x95\x0e\xeeZ\x9c\xc4%\x11\xb7\uec143K#\b\xf4G\x00\xe0\x02D\x97R\x92\xae\xccm\x85n[\x17\x1a\xe4\xb5\xdc\xec>\xe9\x91?I\x01\r\xb8\fR-\x18\x00\x00\x00\x00p9=\xffG\xe2\xa0\xeb\xdc[\xcb;\xaf\xe8fR\xe8\x9aH\x00\x1c\x15\xd7\xc8\xe7\xd7\xf4;\xc0ri\xce\xfe\a\rS\xccz\x1e\x14c\xea\xec0T\n\x12I\xb1\"\xf2\x042\x02+\xaf\x9b\x8d\xe3\vމuA \xbc\x88ǂ˯X\xbc\xcdqi0\x13\xbb/Z\xabKp{\x00\x9b\xcd[y\xb0L\xb3s\xd9vJ\x0f\\\xa6\xac\x1d\xc8OOk\xfe\xa9w\xd1\xd1\x02\x17\xdd\xec\xa9o\x8c\xd5\xe1\xdfW\x9e\xee\xae\xd6n\xbcvlK.ՙb\x13\xad(\x93Nؒ:\xff\x00Xo\xafR\x7f98v\xb7t\xfdߧ\xa4\x8a#5\x7f\xfc7E\x1f\xe7\x8b2\x92U\xa4\x9a\x945\x91?\x01\x00\x80e\xe4\xcaE}\xba\x9b\x9d{Q\xa6N%\xed\xa0]e\xa3;\xbbK\xb0\xda|\xfb\xbc՟\xec\xfb\xf5sy\x9f\xc6\x06,l\xbb\x91\x18\v\x10Xi\xc1\xe5ݳ\x9c\xe6\xadIm$\xd1v\xb3[\xff\x0e\xed!\x97Pyǽn68a\xffޖ\x8e\x02\xc0\x85r\xf3Ż\xd54\xebXHiykE\xf2Vee\xa5\xf0\xbd\x7f\xd7]\x02\xe0\xd2\"\x90\x1d\x00\x00\x00\x00.\xb3\xe9\xe4\x13*R\xe0zܗw;\n\xb1\xb2\xdaY\x9af\x17\xc0\x91\xf1\x8d\x06\xc3\x1b\xfb\x1d`\xb9\xe4\xe1\xd3\xcag\xbb݀\xfd.o\xa50\xb2<\xa4U\uef02\x9b\xf6W\x01XI\xa9s\xc3\xe7-\x9d>\b\xa4\xeb\xd5\x04\x18_\xecv\xe3^E\xf53]6)\x90\xfd\v\x82\x87\x00l\x96|f\x1f\xb5\x9a\xb0\xad6\x8em\xbb\xb2\xa5T\xdeX\x1a\xd1~*]\x01\\<\x8f\xfe\xf1\x98\x9d\xfea\x15ӹeJv\xbf\xc5\xc1\xe2xW\x17\x99X\xde\xd4\xca+\xf5\xd6L\xbd\x7f\xe8\x89\r\xac\xb5_\xa4\xfe\xb2\xfd\x8e\xcf\xd8\x06p\xb4\x9aY?\xeb\xa5U\xa9\xd3m\xba\xb8W\xb95\x01\x00\xc0\xf2q\x1a(t\xc5\xea\xf4\x02pQ\xbenC:~\\\x1a1\xb3\xef:\xc8cj;I\xdfﴛD8\xa4\xc1\x01s;\x96\x02\xa7\x00\xac\xac6Mɮ}\xb9v\xa0\x18\xaa.\x90\x1d\x97^\xbb\xfdv\xab\xe4[\x9a\n\x00\x17(\x14\x96fw}\xed\xa2\xadǒ\xaf,]\xa9U4\xdbVd\xfbX\x7f\x15\x80K\x89\x9ep\x00\x00\x00\x00p\x99U\xf5\x13\xeaF\b\x8dAލ\xbaQ\xb5\xd3\xec\x8a\x00\x8eP\x1eU\x96'\xfb\x1d`y\x9c\xbe\xffODe\xfb\xd2|\xab\x9b\x80Wyc\xf9\xc7@i:v\xe7K\xcbC\x16\x1d[\x00\xac\xa6\xf3\xc1\x1f/\x05\x81\x9cw>\x18\x040Y\xb6\x95z/v\xf7\x89\xf7\u07b2\x80nJ\xc1\xc5I\x00\x9bɵ\xaa\xdbZusLmص\xfd\xda\x12\x89\x14C8\xd4 \x97\x9e\xba\xe7\x03\x14\x12qQL\x9e\xf9Cqz\xf6o\xaa8\x98\xc87\xbb\njTg\xb9\xda\xcc\xee\xb9\xd4\xf1:K\x8b]\x98\x82أWK\xfd\x04Xk\xd4_\xb0\nBs\xaa++\x9d\xaf2u\xd5'\x00\x00\x80e\xe42+[\xa7\x8dT`IK_\xde\xd6X\xba\xf1\xcfS\xc0^\x03\xbe\x9b\xae9Ik\xaf\x90\xa2]S\xc0T |\x03Xe\xad=\xc3\xd1\xcd\xecY\x1eX\x9d\xb3I\xc99.\x03?x\xa7e\x92T\xf2\x01\\\xb8ص\x19涎r~\xac\xe0\xaan`)\x1f\x8f\xd9\xf1F\xcf?\xf4\x1b\xbf\xa0\xe1\x1b\xc0\xc5\xe6~\xc1\v&\x00\x00\x00\x00\xc0%U}\xe4\xca\xe8\xdbJ~\xb4\xafv:R\x91\x0f\x14t(\x9f\xa6Y\x04p$\x1a?S\xfe\xbeH\x87\x00,\x9f\x8f\xa4a`\x87\xfd\x0e\x80\xf5\x93:9ܢ&?\xad&\x16\x1a\xc6Ӷ_h\xe6K\xb5W\x7f\x93\xb6n\xf9_ț\xd09\xfd\xc9Q<\xd9\xd8\xfd2\xb3<!\xf7j\x86\xad\xf2\x8a\xdb\x03\xc0+p35\xc7\xfe\x94\xf2\xb7\xd0\xe9\x19o\xd0'OFퟱ\xbcgG*v\xb4\xef\x9f\xd6NC\xfd\x04\xd8TM\x1c*\x8fg-\x9f)UgAE\xd9H\a;:\xb7\x9b\xeb\xd8ݧ\xc9s\xb0\x14\xc2Ǯ\x8c.\x9eR\f\xd7\xc9g\xcf(t\xe3\xfd\x1cW\xe6\xad>\x05\x00\x00\xb0L\xf2 U۪\xfdi\xf9|\xa0,\xccm\xbfй-\xafc\xef\x9cQ\xbe^u\x9f}_\xd4씕G_\x94\xb2\x89}\xc7q1VAL\x83\x01.\x06\x04\x04\xb0\x9a\xda\xd2\xd2\xec\xea\x9cԤ\xd9\xd9o\xd5\xd9룮\xb8\xf5Q\xd2\xed\xcb\xe0\xe9\a\xee\x88\xd7\xef?\xa8\xa6\xd9U\x9d\x9f\x93\x8b7)\xeb\x06\x02\x7fBYS.\x06\v\xa1\x7f\r\x80\v\x95\xda\x0f\xe9?\n\\RԂ\x00\x00\x00\x00\xe02s1*\xebG\xd3vi\xa6\x1a\xdbg\x901\xe0h\xc58귀\xe5\xd1<\xf9\xcdQ\x81\x97l\xc0Z\xebZ\xe8+[9\xfb\xd5X\x86\x94\xf63\xb9\xbc%\x88\x1d?O^\x16\xdd\xcc\x0e\xcaS\xf0E\xca\x1b\x98q\x00\xc0+K\xd9\xc9\xfc\xe0\x9f,v\x80\vt\xf8\xc0;c[[\xf9$\xdbQ\x9a\xf5?\xe8\x05\x95~\xbb?\v`\x13e\xee\xd0\xd2\x03K\x13b\xae\"x5\a\xa5\xe6\xa3}\x82ر\\b%\xd7抱\xedb\x83\x9cձ\xbd\xa7{\x1c\x00\x00XB\xc1\x8a\xd1\xee\v\xday}\xa6\xb2\xbc\xba\xdf\xc1Jk\xf6\xed;\x9e\xdbw\x9c\x02,Sy4}\xdf}\x10\xbb\x8a\xee\x12\x00\xab)\xd57\x95\xe2\x1c\xedю\xd9T\x83\xf2-\xfd\x19\\j[;_\xa6&\x04y7P\xe9Je\xf1\x05\xfb\x1a涔\n\xb1\xb2tv\xd0_\t\x00\x17\xa0\xdaV\xfb\xd4\xef\xa0#7p\t\xd1R\x0f\x00\x00\x00\x00\x97[h\xbb\x00\xf6\x18\xbc\xba\xf7U!\xa4p\xa5\xc59\x00G\xa2\xcdn跀\xe5Q\x9f\xfd\xfb\x96G\x10\xc8\x0e\xac7+\f\xba\x03[G[\xcfm5\xb2\xe7\xde+\x96\x8b\xb3\xc0y\xc5\xe0\x06\xab6\x9c\xe8\xdf\xea\x14\xca\x1c\xefO\x01\xbc\xb2\x99?\xa1\xa2z@\xfa\xdc\xfbI,pAR\x10{\xb5\xf7i\xb90\x95\xf2m\xcb\x7fj\x85X+g6\x1b`\xa39K\a\xe4\x8eے\x82/J\x85֩\xd9zW\x7f\x168z\xcd\xe3\x7f,zgy\x97՝Zͻ[չ\\\x8e@v\x00\x00\xb0\x8c\xbaQwB7̭K\x05\x97.\xb0\xbdP9  r-Tg\x15\xc3ľ\xdbV]Ǡ\xae\x1e\x95ۉ\xc2\xd6\x04\xb2\x03\xab,\xaa\xb24\xbb\x94\xb2\x91\x9al_\xdb\xc3/\xed\xcf\xe0R;v\xed\xff\u173b\xd2r\xceZ\x99\xa5\xa9>N\x95\xda\x01\x9c\xdbVӽ\r\xa1\xfd\x1a\xc0\x1b\xd0\xec*\xec\xffp\xbf\x03\xe0R\xa0\xa5\x1e\x00\x00\x00\x00.\xa3\xd9\xfd\xbf?f\xb1\xe9jcmL3\x81\xd8\xc14\x1b\xbb\xa3z\x06\x1c\xa5\xb6\xbc\xa3\xdf\x02\x96\xc43_\x1f\x8b\xbd\x99\xe5\x17\xc4\x1e\x01\xebmhE\xc1\xa9\x15\x05[[Ҍ컶8\x85\xc2\xd6\xc0\xe7)\xca\xf7\xa9\xf6\xf9\xa2\xde\xe0\xd3\f\x83\f\x84\x05\xe0\x95\xa5\x8e[e\xea\x0f;\xfd\xa8f\x8f\xfcv\n\x94x\xcd&\xcf\xfc\xa1\xa8O\x9e\x8c\xc3s\x8f\xeaX\x9b˧\xf2I\xf6l\xd7\xd9\xdak[\xb1\xb6:\n\x80\xcde9J\xc8fj\xf2\x14\xd0^\xab\xdc\xdd\xd2\xd6[>A\xc1\x14Kc>\xfb\xa8ݧ\xa9n\xbde\xb7\xab\xe5YvwƐ\x02\x86(\x0e\x01\x00\x80%\x94\x82\x99]\xb5\bbOA\xedir\xf6\x98++߾8\x8f\x95\x16\xe3\x81}\xbfs9\xdfϺ\x9fʥ\xc1\xbe\xe7nFv\xfa\a\x01\xab,\xaa^\x04\xb2\xabP\x95\x82\xdaO~'m#\x97Q\xb6\xf5ej\xb2\xd3R[)K].\x95\x06\x8d\xb7\xb46%\xaf-\x81\xec\x00ހl&\xb7w(=\xfa\xdfӘ\b\\\"Ԅ\x00\x00\x00\x00\xe02j\xeb\xcf\xc9wA\x89M7#{\xf4\xed\xe2D\xeaQ\x05\xe0\xc8\xf8\xd1;\xfa-`9ԧ\xfe\x95\xf2v \xcb,\xfa#\x00\xd6RH\x81\xc9V\x1e\x8c\xad\xb2\xb4V\xaehϽ\xcf߶8\x0f\xf4\xf2\x1b\x7f\xc05ec\x15\x8ac\xb6w`k\xea\x0f\x00^\xd9 ̤\xe2\xb8\xda4\xe1\xd3\xe9\xff\xa7?\n\xfc\"\x1e\xfd\xe3\xd1=\xf7w\xa5\xfd3ʪ(\xef\xaeJ\xa3\"\xa4\x9e\xd7Vfٕ\x0fc\xf9\xaeS \x80\x8d\xe5\v͚3\xaa\xedW\xc8\xe7\xd2\xeeW\xf6'\x80\xe5\x10\xea\x87\x17\xafZ\x9c\xb3*S\xa5p\xfe\xf5K\xb0\xba\x14\x00\x00\xc0\xd2I\xef\aj\xf9h\x05\x98\xe0\x14\x14m)\xa4\x1b\xbe\x97\xc6\xdf5\x90\x06/v]\x84\xa5}\x9d\xddk\xa0`\x1f};K<_P\x05\xb0\x9a\xd2sl\xe9uj6\xcdv\xfac\xb8l\xee\xfc!W\r\n5Mm\xe9\xeb\xd5v\xc0\xf2O\xbf\xaf,K\x03\xc3,.\x01\x80\vR\xec)\x9fY\xda~\xf8/\xfb\x03\x00.6\x02\xd9\x01\x00\x00\x00\xe02\xf2\xf1auA\x891ͺY*\xa6\x17T]#*\xd53\xe0(\x8dn\xff.:\x04`iTO\xfc\xe6\x18\xf6&R\xc1KO`ݵV.tʬL\x98:է\xf2`\xad\x90\x05\ro\xff\x0f\xe4K\xf8\x05b9T\xab\x91mնC\xfd\x01\xc0\xabh-\xbd\xa8\xd3l\xb9#\x95\xd5\x19\xe9\xb3\x1f\xa0\xfb\x16^\xd5\xec\xa9o\x8c\xf5\xfe\xdf\xd4hj\xf5\x90\xdc\xea!Y*\x9b</\x85\xc2\xee\xa7+m\x9df\xb2\x99\xa6\x18V\x00\x9b,u\b\x0e\x8dB\x1cj6\xbeQ\xba\xf5\x1fSo\xc1Rɚ\xb3v\x8fچ?\x90Ϣݮ^Υ\x00\x03\x02\xd9\x01\x00\xc0\xf2\x89)\bR\xadbpVf\xf1]\x9bo\xf4\x83\xc5I\xac\xb4\xe9c\xbf%v\x01\xec\xfd\xd2Ů\xdb\xf7\xdc\x15V\xd3\f\xed\xae^\\\b`E\xa5\xc1GҪVQ\xdc\xd2\x1d\xc1\xe5\xe5\xc7\x1fP\xeb\x86\xf6\x1d\xa4Aa\xbc\xea\xd0*\xf3c;3[\\\x00\x00\x17\xa2h\xa4l\xac0}B\xedS\xbf\x97w\xab\xc0%@O'\x00\x00\x00\x00\xb8\x9c\xe2\x13\xea\xa6\x01\x89V!\xf3\x99B\x1az9I\xa3/\x03\x00`\xfc\x99\x9fL\xf3-H\xe5\xa1e\x17Yw\f\xc0z\x8aJ/\xc2J\x854\xc8Q\n\x14s\x13\xb9\xb2?\t|\x01W\\\xabX\xa4\xbaDn{\xbc7\x05\xf0*\xa2\xe5-\xf3\x89\x95$\xad,Y\x94\xaaf?\xa3\xfa\xe1\xff\x96\x84\x03/+>\xf6Mѝ\xfbAe\xd5^\xea\x01\xd8\r\xa8\x15\xf2V\x8dk\x15\x94\n&\xde~\x9f\xb3\x8ch&\xb5t\xa8\a6Y\xf0^C\xab\xb0\f\xb3\xdb4\xbe\xe3\t\x82رt|\x13\xfa@\xf6\xb3\xb2\xdbU\xb1I\x83\xb3\xa4\xda\x13\x81B\x00\x00`\xf9\xc44;w\xfa\x1d\xbd\xad\xecW\x96\xc9\x15Ի\xd7A3y\xb0߲/8\xa6\x02i\x1a`ɶ\xd3k_\xdfX\xf9\xb4Z\x9c\x06\xb0\x9a\xd2\xc0#\xe9\xf1\xf6\xb5\x86\x837\xf7\aq9\x8dn\xfb\x8fN\xe55\x96\xae>mI\xed\x96BS,\x06\f\x89\x87\xfd\x15\x00\xf0\xfau=\xb8Sy\xbc\x9d+\x9b\xfc\x9b\xee\x18\x80\x8b\x8b@v\x00\x00\x00\x00\xb8\x8cB\x9a\x8d\xbdm\xd3\xdbHeY\nS\xec\xfb\x91\xa7!\x98\x01\x00\x1b\xafy\xf6\xd7\xc5\xec\xe0E\xf9Ҷ5U\x9bf`\x00\xb0Ƭl\xe8J9[\xa5Nk\xc1͘\xe9\x14\xaf(ˮ\x96\xf2\x89\xdd6\x83\xae\xff\x1b\x00\xbc\x92\xc6\a\x15i\x06mw\xa0X6\x96dD\xe9\xec\x0f\xa9~\xfa\x0f\xd3\xf8\x80\x9f\xef\xfeo\x88\xed\v\x7f[\x83\x83\xb9\xbc\xc6\n\xf9L\xfb\xfei\xd5\xf9@.\xbfR\xc1\xee\x98\xe0\x9e\x97\xca}\xbb\x9f,\xf3\x99\xa7Ym\x00l\xaaY,\xac\xbe\x92)\xcbo\xed\x8f\x00\xcb%\x05\x81-\x02\xd9\xeb\xaeG\\\x8c#\xabH\xa5\xb00\x8a@\x00\x00`\xf9\x84\x14\x05ib\xea+\xe229\xef\xe5sF\xba]\aM\xf5\x82\xe2\xa2Qž\xe04\xe3\xfe\xe2\xfb\x95\xb7}\x9f\xbe樂\x12\xc0*J\xeft\x9d\xb3g9k\x95\r\xae\xef\x8f\xe2r+\x8ak\xa44\xf6\xb7F\n\xedN\xfab\xec;!}\x05p\xe1\x9aX\xa8Ί\xaeȦ\xc9\xf3\x8b\x83\x00.*z\xc2\x02\x00\x00\x00\xc0\xe5\xf2ொ[\xed\xdbU\x15'ըT\xac\xcfɵ\xb9\x1a_-F\xf2\x03p\xc94\xed\x15\xf6\x9c\xcdd\x0f\x9dB\xea\x00\x90ZD\xe6\xf6칠\xe9\xe8=\x8b\x8b\x80%\x10\x9f\xfe\t\xb9\xa1ݛ\xf1:e\xf3\x1d\xe5\xf1\\\x7f\x06\xc0:\xf2\xed\x96\xda\xe2\xac|\x93+\x9b\xe6\xf2qKg\xb6\x7fY\x7f\x16\xf8\xf9\x86\xb7\xfd37\t\xfbR\x18Y>э\a\x0e\x00/\xcbǁ\x9a\xc2)\xf8\x14\xb8\x15\xba\xb1/\xdajG\xc5s\xffZz\xf8W\xc7\xc5U\xd8t\xf5\xe7\xbe,\xc6\xc3\x7f\xa8\xbc\xeb1\xe0\x14ۨF\x03\xed4C\r\xaaFY}\xa0\u070ex\r\xedb[Rp`1\xed\xfe,\x805\x15+5~\xa6C7\xd2\xcc_a\x05Й\x1dl\xec\xf7\xae%\x13N\xe3\xc3s\x9a\x8f\xef\x92\xde\xfe\xcf\x17\x117\xc02\xf9̍q\x90\r\x15ݮB=V9\xb7\xa5<k\xf5\xa7F1k\xfb\x8b\x00\x00\x00\x96G\xee*\xb5)\xae9ϭ\xccr\xca\xca\xdd\xc7t\xb8\xfde\xfdY\xac\xb4\xb8'\x97\xa6_OA\x951\x95E\xbbь\xa5:-^.\x0e\x17\xd7\x01XI\x830\x90/\xceh\x9e\x9e뛿\x976\x92#\xe2\xdf\xf13.6oU\x9c?/7\x9e\xaaJc\xc14ۋ\x93\x00p\x01\x8av\xa4b\xbe/\xefs5\xf3C\xd5\x0f\xbd\x83w\xaa\xc0EF ;\x00\x00\x00\x00\\.\xd5Y)\x1eH\xae\x96\xf3m7:\xabs\x8bї\xd3(\xcc\x00.\x1d\x97f\xe1\xe9\xe2\xbd\xecYs\x95\xad\x8b4\xadi\xd7\x0f\x7f0d\x84d,\x87p\xdf\a\xa3K=VR\x80\xa2ݧ\xd1ϻ\xfb\x14\xc0\xfar>.&\xdf\xf0y7j\x7f\xebg\x1a\x96o\xe9\xcf\x02\xbfP^\x9e\x90|\x1a\xe4\x84\xfa\x03\x80W\xe1\x9aE\x9bC\xea0\xeb2+R\x16\xf2YP\xdbX\xfaq\xee\xa9\xfe\"l\xb2\xf9\x03\xbf#\xcef\x0f\xa9m\xad\xa2\x1c\xacb\x1c\xb7\x14m\xedc\xaa/\x03\xd8\\\xb9\\,\x94\xe5\x8d\xe5\x1b/\xaa=\xb4C\xa9\x17\xb0\xd5Ub>\x95\x86'4\xd8\xfd\xeať\xc0\xb2i[\xcb\xd3\x16e\xa0\x85>X\xa8[\xe8\x1e\a\x00\x00\x96\x90\xaf\xad\xec\x926\xac\xccm\xeb\xd6\xf6\xb7n\xfd>\x1a~\xd7@F\xf9\x13X\x7f\xa9\xea\xe9ǋm\x1c\x9d݁R\x13\xf7H\xb5\xb2&\xb5a\xcd\xfb\x13\x00\xf0\xfau͊e\x9a|&S\xe6\xbd\xc2\xc1\xc1\xe2\x04\x80\x8b\x86\x9a\x12\x00\x00\x00\x00\\.\xf5\vj\xc2997\x97\xcfR\x87\xf2\xcf{\ay\xbeo\x15\x80K#\x05\x04ׅ伂\xd2\xcc\xecC[\n\xa5\xf1\xcf\xfd֝\x8bk\x80#\xd4>\xf5\xadqv\xf8\uf575\x03\xcb\x13\x86v\x9f\x1e*\xf8`\xf7)\xfdU\x80u\xe6\\\xeahoϽ\xb7<*oT\xe7\xadF7\xfco<\xf8xE\xe5\xf0K\xd4\xe65\xf5\a\x00\xaf\xca[M'\r\x9c\x17S\xa7\xd9&\xb7\xedLE\x1e\xed\xe8Y\xcd\xe6O\xa8\xfe읤\"\x1blvϝq~\xf8\xa3vo<\xb98\x10v-_\x19u\xadT\xb9k\x16\xc7\x00l\xa8B1\xec(\xf33\x15\xd9\\\xbe\x1bl/\xb7\x8ce?\xcdˮp\xfc+\xa5\xeb\xff<\xf5\x15,\xa7zn7\xa9ݩ.ʧ1Z\xce\a\xb1\x9bT.\x02\x00\x00X:\xbe\xb2\xba\xf8@j\x17\x81\xecMV\xf7'\xb0\xca\xe2\xd3\xdf\x12s\x02ف\xf5\xe6\x9aE\x1c{~\xcbb\x1fGƝ\xfcb\xa5\xac\xd4U\x99\xf2\xd6\xf2QG^\n\xe0\r\bA*[5\xbe\xb4\xe2\xf9\x8e\xfc\xfei\xe9\xa1/\xe7\x9d*p\x11QS\x02\x00\x00\x00\x80\xcb$\xb6{\xf69\x91\xf3\x8d\\\xea\xfa\x17\xa3\\\xb0\x8a\x19M\x1d\xc0\xa5\x97\xa5\xd12\xd3,י\xa2=w\x8bYx2\xcd\xd3\b\xf7\xd7\xd1\x01\x17G\xaf9\xfb\xaf\xe5\xe7S\xcb\x1f\xecv\xf4\x13\x05\x9f\x06\\(\xfa\xb3\x00\xd6Vz\x99\xdeX\xfe\x94\xf8ZUql\xb1\r\xbc\x82\xfc\xf6\x9fp\xd3\xdc6\xe8\b\a\xe0U5\xf2\x8ar\xadS]\x05\xb5\x95m\xfb\xa0\xa2\xa8\x94\x17\x87\xca\xceݧ\xf9\xbd\xef\xa15bÄ\xc7\xffx<\xfc\xc8\xf51?\xbcO\xc3zO#\xcbO\xf2<\xb3\x0f\xcbS\xac\x1a\xd2\xc5\xf8\xf5\x01\x7f\x006U\xd7\r۲\x91\xda\xf2\x90\xd4,\xb1#\x95{\xaa\xb3J\xad\x7f\xab\xfc\x9b\xff\tmhXZ\xa1M\xb3\xae\xa5A!\xed>\xee\x03\u05fb`\xf6.\x93\xa3\xfe\x04\x00\x00\x96\x90\x9bI\xadU\xce\xd3bŖ:+\xfb\x13Xe\xb3\xe9\xfd)\xfe\t\xc0\x9ak,\xddΊ\xbb\xfb=\x1c\x99\xab\xfe\xb6˷\xaf\x91\xa6\xa9!\xeb\xc0\xbe\x17\xf2R\x00o\x80\x9f)\xf5\xec\xae]JKr\x15aO\xda\xff\xe4\xe2\x1c\x80\x8b\x82\x96z\x00\x00\x00\x00\xb8\\\xfc\xbc\vbO\x9d\xc9cp\xdd\b~\x8b\x8eTA\x8b\xc8Z\x00\x97JL3[w\xcf]\xde\xf5ˏq\x9e\x9e<5\xfe\xe4\xe2\x02\xe0(\xdd\xf7U\xd1M\x1f\xd70ݔ\x99ݠ\xf9\\1\xf5\xb1\x8dCņ\x9e\x0e\xc0z\xab\x14\xdb\xd2J\x87\x8dZ\xe7\x94\r\xdf\xd9\x1f\a^YS\x8cd7L\xbf\a\x00/#Z\x19\xd2{\xb9\xac\x90\x8f^!\xcd \x10\xa2\xed\a\xe5E\x90\xcf\xc7r\xfb\x9f\x90\x9e|gj\x94\xc0\x06h\xef\xfd\x95\xb1=\xf5=\xdars\xe5͎J\r\x94+\xb3JG\xcaOfV\xff8\xb0{&\xcdXc\xc7\x00l\xac\xa8VY\xdeʅ\x81UU\xb6\xa5<\xebbj\xe6\xc51\r\xdfy\x1f\x05P,\xb5h\xe5\x9f\x18\xd3\xcck\xa9\x81--v,\xb8\xfe\x1d\f\x00\x00\xc0\xf2i\xd3;\xeb\xe0\x17\xed8\xb6튛\xfa3Xe\xcd\xfcQ\xe5\x81\xf7\xbb\xc0Z\xb3\xbag\xd0@\xd9\xe0\x1d\xfd\x01\x1c\xa9\x9d\xafQpiB\xa1ܲS&\x8b\x00\xf0\x06\xe4\x8d\xdaz\xbcxU\x96\x06\xcdL\x13\f̂\xe6\x8f~=\r\x8c\xc0EB ;\x00\x00\x00\x00\\\x06\xf5S\xffCL\x13\x81\xf8\xd4I\xd8>]\xf0\xddK\xc9nr\x90ԩ*5\xa8\x02\xb8d\xba\xfe\x8a\x99=gm\x96\xe6౧\xeeP\x8dk\x95\rn\xe9\xce\x03G\xa9\x9d\xfc\xa4\xf24d\xb7\xdf\xe9\xeeӴ\xd9*\x97s\xce\xf2\x8bE\xc7[\x00\xeb*t\x01\x86\xf2S\xd5*\xb5\xf5\xe6\x7fOp\b~Q\xd9\xe0\x9d\x96Q\xf0z\a\xc0+\x8bݨH\xb6d\xb9\xb2\xa2P\xeeR0{T\xac\x9dBcǋVeJF\x9e}Lz\xe87\xa4\xda\x12\xd6Xs\xef\x9d1;\xfb\x93*\xa6\xb9}\xf7\xa7-\x0f\xb9\u0096㶌S\xc5C1\xa4@\xf6\x99]\x99\x1a\xa9\bd\a6Zl\xbad \x8b[\x96>\x94\x96\x80\xeckj\xf9\x85\xdb\xfa\xaa\xfe\x02`9ՏXy&\xbdcq\xe9]K\xbf\x98\xaem-\xbd\x93I\xf5n\x00\x00\x80%\xe4B\xaa\x8b\xcfm\xcb+/\u07b58\x88\x95\x16\xeb\xd3\xf6\x91\xbeS\x00k\xcb\xea\x9c\xc1\r\xa5\x9b\xbf\x83\xf7\xba\xcb\xe0\xfa\xbf\xe9\xe2nj\xf3ޑK\r\xde\x00p\xa1,U\xf7\xedHyH\x83cTR\x9a\x98=\x0eTM\xfe\xed\xe2<\x807\x8c\x96z\x00\x00\x00\x00\xb8\f\x9a\xd9\xe7\xba\xc0\xf5\x18r)\x16\xb6\xe4]\a*\xe7SO\xc0tE\xd5]\a\xe0҈\xc1\x9e\xbbl\xa64#{\x16\x87J3\u0379\xcck0\xba\xad\xbf\x028\"?\xfb\xde\x18C%\x9fZ\xbf\xf34kT\xa3\xa6ݶ{\xf6\x84\x9c\x9bڽJ ;\xb0\xd6R\v\xbdO3\xe4Z\x16\x95:<\x00\xaf\xc1`\xf8~\xfb\xe4\xf5\x0e\x80W\xe6\\\x9e2\x16\xab\b\xa5\x80.\xcbgR\xdbC\xcc\x15\xdaB\xa1\x19\xd92\xef\x02\x99\xe3\xbcVs\xf6\x9fI\x8f}\x1d\xc1\xeck(>\xfb\xc7\xe2\xe1\xbd.\xb6\xe7\xee\xb3\xfb\xe0zi8\xd0,\xf5\xa3.\xceY6\x92\xea\xc7V\xd7h\xac\x10\xd2.\x06\\\xec\x06?\xa0\x9f\x1f\xb0ќ\xd5MT\xb5\x96uX\xdd$\xdbW\xed\x1b\x15\xc3/\xd6\xd6m?D\xc7l,\xb5\xfa\xf0~e\x99\xe5gݝj\xf7q\x1aմ\x0ff\xef0+;\x00\x00XBiНN\xaa\xa3\xbbB\x83\xb7\xfc_\x94\xbb\xd7@\x16*+\x7f\xd2\xff\aXk\xa9ڙ\r\xfa\x1d,\x83\xec\xf8oT\fA\xb9\xa7\x81\x1b\xc0\x85k-\t)|\xa6\xbcN\xe5\xf3ږ\x13\nŁ\xf2\xb9\x9dx\xf07\xd3\xc0\b\\\x04\xf4t\x02\x00\x00\x00\x80ˠm\x9eU8?\v{\x9a=1f\x92+\xa5\xac肗\x14hH\x05.\xa5\x90f\xddq\xf6\x9c\x85L\xde\r\xecٳ\xc7\xd0\x0e\x15\xe5\xd5\xfd\x15\xc0\xe5W\xdf\xfbk\xa3f\x1f\xeff\xc8Tf7\xa5\x9fuq#\xa1\xb9\xce>vm\x7f*\xe5\x8bk\x01\xac\xa9\xf3-\xf4\xa9{Zʟ\x80נ\xbc\xf1\xaf\xdb\x1dc\xf9\x06\x00\xbc\x12_t\xbd-bUIM\xea<\x1b\xe5\xad\x02\xe4TZ\xd63\x92o\xaeV\x15\xcfJ[S\xf9\xdak\xfe运\x1e\xfa\x1a:`\xac\x91\xc3\a\x7fk\x9c?\xfa\xbfj\xeb\x8c4\x18d\xaa\x06Ok\x92\xee\x80\xfcV\xdb>\xa36\x05\xb3g\xb5\xdd\x1b\x85\x1d\x1d\xcaY9$\x06\xa7\x9a\xf6)`\xb39\xaf4\xd6I\xd78\x91\x06۳\xecd\xb0\xf5K\xbbS\xc02k\xea\x17\x16\xefY\xd2\xf2yA\xeb/\x05\x87\xd1=\x0e\x00\x00,#gu\xf2TLIe\xefԖ\x83\xb5\xe0\x14\xacLJ ;\xb0\xee\xd2\xe45X\"7\xff\x1f\xaerg\xbb\xb6-\x00\xb8PM\x93\xfas\xa7A\xa0\x17\x03\x85\xab\xddU\x95M4\n\xd7(\x9c\xfd\xb1\xfe*\x00o\x84\x8bK<\xeal|\xe67F\xf7\xe4O\xf4{\x00\x00\x00\x9b\xa1ʼ\x8ab\"7߱\x02QP\b\xb5\x15\xdav\xe5\x06g\xa5\xdaK_<g\x04^`\x05\x9d\xfb\xccv<6\xa530pd\xc2L\xca\a\xaa\xdb\x1d\x15>\xcd>\xd7\xe8 Dm\xbf?\x92\xaf\xe2h<\xfc\xe5Qg>d\x1bW\xd8\xfdy\xb88\x06`\xf3\xd4A*\xaf\xd4a|Z\xc5u\xbfY\xe5\x8d?H\xbe\x84\xd7d\xff\xa3o\x8f;\xf9#\x8a\xf5\xae\xe6q\xaa\xac\x98\xaaH\x9dfj\xbb\x85\xba\xd7>^rMw-\x00\xfc\x021\xcd\xc6=\xb2e`\xf5\xa4֒\x8c\x99\x0eۡF;\uf4ff\xee\x97I'\xfe\x1c\xf9\xd1*\xfb\xf0hy;\x00\x008R\xa9\x7fP\x17\xd8\xfbRpoώ\xa7ss?\xd20\r\x04\xa9}\xcd5R\xb8\xe6\xcb4\xba\xf1'\xc8\x13\xb0\xf4\xce|\xf2X<\x91\x06\xf0\x01\x00\x00X!\x871\xd3V̥\xec\x9c^\x18\xe5\xba\xea\xeeԸ\x8bUvp\xdf\xfb\xe3\xf6\xe4\x13R\x9d\x11L\t\xac\xb3\xb6\xd6\xc1\x95_\xa9\xed7\xffK\xd2\xede\xf2\xd8\xff\x14\xe33\x7f^\x1a\xa6A[\xc7j\xdbVN\x13\xe5\xdd\x04CC\xc9\xf2\xdc\xd65ʺ\x11\x1c\x01\xe0\x02\x9c\xfc\x16\xe9M\xff\vi?\xf0\x06,u-\xa9\x9a\x1e\xf4[\x00\x00\x00\x9b#S\x9e\xe2\xd7\x15\x95\xea:Q\xde9\xdbjl\xd3\x16\xbf\xb5\xb8\b\xc0\xca\xf1-\xed\x17\xc0\xd1Z<\x83.\xbd\xa0H\xd2\xca\x0f\x17\xdb\xc0eV=\xfa\x87\xe3\xfc\xcccRsB\xaaO\xf7G\x01l\xa44\x8a\xb3\xdbW,\x1cA\xecx]\xf2\xed;\xba\x91\xc0]<P\xe9je]CBTHwQw'\xd9>\x00\xbc\x92ܖ\xcc\xf7kK/\xba\x99\xbf\xf6upp\x9f\xc23?\xd5]\x82\xd5\xd3>\xf8\xff\x8bg>\x9c\n\x17\x00\xf0\xf2~nv\xea\xcf\xd3\a\xb1'\x83\x98\xba\x10U\xaa\x9b\x81\xe2\xd65\x04\xb1ced\xe4~\x00\x00`\x05e]\xa0s۵\xe7\x16\xf9Ջ\x83Xi\xae\xad\xac\x8ee_\xe8\xcbս\x00\xac\x0fK\xbf\a\xe5\r\xfd\x0e\x96\xc6-\x7f\xceՖ\x9f\xc6X[\xd6:Q\xa1Fy\n\x97\vE\xf7\x9d\x05\x17,y&\x88\x1d\xc0\x1bP\x7f\xbc\xdf\x00p\xa1\x96:\x90\xbd9<\xd5o\x01\x00\x00l\x0e\x17\xf2.\xb8.\x9e\x9f \xd6پ\x9b\xab\x8b\x81-\xaeY\x1c\x03\xb0r\xe8H\x05\x1c\xb1\xd4\x11\xc09\xf9\xc5\x14\xa5\x96\xd1ze\xc5͋m\xe02\x9b\x9e\xf9\x01+\u07bd(\xf9cV\xbe\xeb\x0f\x02\xd8LYzi\x9e\x02ٯ\xe8\x0f\x00\xaf\xcd\xe8\x8e\x1fv!M\xb8\x1e\x0f\xe4\xddD>E\xb0\x874\xc3f\xa6\x90ff\xf7\xcc\xc6\x0e\xe0\x95\xa5\x14\xa2\xb5\xd4\"\x84\xd6\xd2\x11۳dcd\xe5\xd2\xcc=\xa3\xe9\xfeG\xd5>\xb4M+\xc6\n\x99>\xf5\xa7\xe3\xfe\xe7n\x8d\xda\xff':A\x05\x03\xc0\xabI\xc1\x14\xaf\x10P\xd1\xcd֮ZM]k>8\xaeᝏ\xbc\xfc\x85\xc0\x92\x89O\x7fS\xccϿS\x05\x00\x00X!yz\x7f\xdd5\xf2JeyW\xb7\xc6j\xf3\xed\xbck\xa7\x97\xa7|\n\xac5W\xa8\xb8\xf9\xfbyЗP\xbb\xfd\xab\xec\xfb\t\xf6\xdb\xd2c7\xb1#\xa9/\xf6Вf\xaf\xe8[[\xeaŅ\x00p\x01\xea٧\xfa-\x00\x17j\xa9\x03\xd9]u\xae\xdf\x02\x00\x00\xd8\x1c>\xa4\x19ً4\x17{?\vFf\x05\xa3Z\x95m\xc5\xc1\x9b\xbak\x00\xac\x96\xd9}\xdf\x10\a\xe9e\x15\x80#d\xf9\xa9q\xdd\xec\xa4N\x8d\xe5\xb3e\xf9\xb6\xee\x18p9M\xee\xb91\xee\xeaE\x95Ez\xaf\xf9\x82\x94o-N\x00\xd8L^\xaa,9\xf0\x837\xf7\a\x80\xd7\xce\xf9~\x96\x9e\x94\xa5\x04\xbb\x99R\x1bB\x17\x98\x94:@2#;\x80W\x96\x92\x8c\xe0\x83\x1a\x17\xd5t\x03a8y\xab2m\x15\xb6d3\x853\x87\x9a\x7fn\x18\xf5蟢1c\xd9=\xf1\xee8:\xf7g5>\xf3\x98\xdctW*\xf8\xca\x00\xbc\x0e\xfdL\xecI7[\xbb\xdbW\xa5\xa0ቯ\xec\x8f\x02\xcbo6\xff\x94\xcav\xa9\xbb\xbf\x01\x00\x00\xbc,\xefRy\xbcVp#\r\x86_\xbc8\x88\x95曃\xae\x9dm\xb9\xa33\x00\xbcQs7\uedf0lFw\xfeC\x17\xdcI\xb5)\x8b\xed^\x95\xdaF,\xd5\xdavt\x8d\\\x97\xf7\x02\xc0\x85\xf1\xf3=\x1d>\xf4\xa5$$\xc0\x1b\xb0\xd4U\xa5Ak\x15:\x00\x00\x80\x8d\x93ZM2E\x97ZRlq)\x90\xbdQ\xe3\x9c\xdc\xf8\x1d\x8bK\x00\xac\x94\xb6~@Y\x9a\xe1\f\xc0\xd1q\xb9}\xa4\x99\xa5\xdan\xb7q\xa5\xb2Ỻm\xe0r\x99\xde\xf7\xa5\xb1\x98=e\xf7\xe1\xa2I\xae\t\x87j\xd3\xc8\xfc\x006X\xdb\x05\xb2\xe7\xc3\xf7\xf7\xfb\xc0k\xe7\xc6\xefT\x9b\xc6\xea\xe9:c\xa4\xc1\xf0\x82\xe4S\x87\f+\xf7\xb8E\x99\a\x00^N\x99e\xca\xf3\\Y^\xda\xdeP\xcd| \xa5\xa5ݲ\xf4d؍\x03\x96M\v\xd5{\x7fY\xe1~:d,\xa3\xf93\xbf#\xeeݓ\xc7\xf6\xa9\xcfIg\xaeUV\x94rŞfsfd\a\xf0\x1a}A\x10{Z\x9aT79\xf9n\xe5\xb7\xfc\x80m\x01\xab\xa1\x99?\xae<\xf5H\a\x00\x00X1>\xa6\x80\xe7V\xb5\x1f*\xbb\xee/P\x06_\x03EH\x81\xec\xa9ɞHv`\x9d\xd5\xfed\xbf\x85e\x14\xcbw\xab\xd5Vz\ro;]\xa2\xac\xd6\a\xb9\xb4D\xd2g\x00\x17.S\xae8\xff\xe9~\x0f\xc0\x85Xڜ8<\xfb\x87b\x16\x0f\xfb=\x00\x00\x80\r\x12\xabn&\xf6\x90\x05E5V0ʻƔƗ\xd2\xcd\xdfŋ\v`\x15\x85\xe7\xec\x83@E\xe0(\xb5i`\x98\xd4A7\xa47\x15\x99\xed\x17\xd2-\xdfI\xbe\x8a\xcbfr\xefWFw\xf03*Rs\\\x13\x14\x9a\xb9\xbc?&\x1f\xeb\xfe\n\x00\x1b\xc9Y\xbd\xcf_\xa3\xc1\x8d\x7f\x8d<\t\xaf\xdf\xdd?\xee\x9a\xe2\xca\xc5v,\x14\xba\xfb)Z\x91'\xddNĝ\x02x\x15s\xafP5r\xb2%\xb3\xed\x98[\xf9\xb4\xb4\x8a\x93Փ\x9aL>\x1f+s\xb5\x8a\x03/\x7f\xf6\xa7\xa5{\xc7QO\xfcq\x12\x96%\xd1|⺨\xa7\xff\x85\xcai\xa90jՎ&\x8a\xcd\xd5r\xed5\x1a\x0e\xa8_\x00\xf8E\xa4\xf6\xb1\xcf\vb\xeft\xb3\xb1;M\x877\xaa|\xebǩ\x9b`\xa5\xc4j\xaf\v\x16\x02\x00\x00X9\xa9X\xee\xad(\x93\x8d\x16\xfbXi\x87\x0f\xfe\xb6\xe8\xe3̶\xbc\x1a\xda\xe7\x81\xf56\xbc\xb6\xdf\xc02*\xee\xfaw\xae)\xdeԧ\xc4\xf5b\xf0\xef,\xcd\xc6\xee\xe4\x02\x03\xc1\x02x\x032\xa7rb\xc5\xf8\xa7\xbe\x9e\xc2\x1ep\x81\x966\x90}>yȞ\xeeT\xa1\x03\x00\x00\xd84\xa9\f\x94F\x00l\xbb\xa0\x06\xa5Q\x00\xad\xca\x13\xddpq\x1a\xc0\xca\xf1a\x9f\x8eT\xc0\x11\xfb\xb9Q\xcf\x17\x0fc\xcc\xd2\f\xed\xc0\xe5\x11?\xf7e1\xdb\xff\x90\x86\x95\x95\xef\xeacj5R[x\xf9,\x93\xa3i\x1b\xd8l\xder\xa8\xec\x96~\ax\xfd\xaa\xfc\x06\xfbt\x96\xd9\x14\xdd\xfd\x14}\xdb5%\xa8\vf\a\x80W\xd0\xe4\xf2\xb5\xa5\x19a.\x9f\xcf\xe5\xf2\xda\xeaH\x8d%'\xb5\xa5%seM!g\xbb\xcaS\x82rR\xe1\xdcT\xe7N\x7f\x8f\xaaG\xbf\x9c\xd2\xeb\x11\xaa?\xf3\xa5\xf1\xf0\xa3.\xe6\xcd\v\x1a\xc4S\x1a\xf8\xa9\x95#\xd2 &\x8dB^\xd9\x15s\xbb(\r\xde\x06\x00\xaf \xa6A\x8f~n\xe9\xf4A\xec\xf2^;w?A!\x12+'k\xed\xb6\r\xbc\x80\x01\x00\x00+(\xcd\x12\xdb\x05\xb2\xd3\x1fl\x1dT\xb3\x87\xed;\xad\xba\xfaUK\v\x1a\xb0\xd6\xca\xd1\xf5\xfd\x16\x96\x95\x1fߕ^\x83HY\xe8\xda\xceS\x1bz\xa7&\x90\x1d\xc0\x1b1Pi\x89Ku\xf0\x93\xfd>\x80\xd7ky\x03٫礖\x11\xe3\x01\x00\xc0\x06J#\x00\xba\xa8`\xeb\xd0E6-\x8al\xd1\r\xba5\x80\x15\x94^V\xf1\xa2\n8R\xd1\xf73\xb2ǾS\x7f\x9a\xa1\x1d\xb8\f\x9e\xf9\xdc\xef\x89q\xfe\x1f5H\xb7\\\x1cJ\xf5\xdcn\xbfk\x15ˁ\xea\xf6\xb4T\xed..\x04\xb0\x99\xacΗ\xfbk\xfa\x1d\xe0\xf5\v\xd9\t5\xb1뉡E \xbb\x95wB\xaa|\x10\x83\x04\xe0U\x94\xbbrV'\xca\\\x1aLs\xa6\x98\xcdm\x99ZRrhi\x89\xad\xe7\aVvM\xb3\x81\x8d\xbbc>\xdb\xd2nu\x8b\xfc\xe9{T}\x86\xa1\x98.\xb7s\x0f\xfd\xea\xb8\xff\x89\"\x16\x87?\xad\xad:\xd7\xcc\xea\x15\xc1\xbe?\xd7x\xfbzr\xe5\xf3\x892\xff\xbc\xda\xc1YUE\xd9\xff)\x00\xf8\x85\xce\a\xaf\xbf\x14\xc4~\xde\xf9`v`՜\xfd\x93х\xbe\xdd\x17\x00\x00`դv\xdc4&\x8fg\x00\xf6u\x10\x9as\x8b\x8d.\x90\x9d\xf2)\xb0\xce\xca\xe1\x95\xfd\x16\x96\x95\x1b\xdc \x97\x15]>\xab\xac\xed\x16\x9fvZ\x02\xd9\x01\xbc\x01\xcd\xc8ғF\xedYi\xff\x85\xef\xa0\xc0\a\\\x00\xf7\v^P-\x89\xfd\x8feq\xa7)\x17\x85\a\x00\x00\x80\r\xd2\xf8J\xa1\xcdT\xe6'\xa4\xc9\xf3ҎT\x85L\xd5\xd6o\xd3\xf6[\xff.\xa5#`\xc5\x1c<\xf4+\xe3\xf0\xdc\x7fQ\x1e\xec\xf1eVD\xe0\xc84\xfe\xa4r=\xddMP\xa7\xc11\x9d\xdd\xfd\xa5:\xfe\x96\x9f\xe0\xa1\xc4%\x15\xee\xfd\xeaXM\xff\xa5\x86\r3)\x00\x1b\xab\xae\xa4a\xb0\xf5\xb6\x1a9\xb9ѾB5R\xac\xafT>x^\xf3z\xae\xd1/\xa1\x90\x887f\xf6\xb3E\x1c\xa6\x99\x04\xc2X\xf3\xa2Q\x9c\xb7\x1a\xc6\xe3R~\xd8_\x01\x00\x17Y\x9cI;_!\xdd\xf5o\xc9\xc3.\xa5\xcf|Ul\xa7\xffIY\xb4\x8al\x1a\xebԕj\xadf\x9b1\xeb,\x80\v\x95\xcdu\xa8Lq\x90\xab\x9c\xceT\xa6\xe4\xa4\x1a\xea\xdc\xf6\xad:\xf6\xee{Hӱr\xf6\xef\xbd#\xeeL\xee\xb7\xfbx\xbb\xebD\n\x00\x00\xb0R\xe6\xb9&#\xaf\xf1\x9b\xbeU:\xfe\x17(\x8f\xaf\xbaO\r\xa3fc\x85\xfc\x8c\xda0RA0;\xb0\xb2\xe6Y\xab¥\xc0g{\x8e\xdb]\xc5`\xf5M?\x95sC۟H_̻\xddUp\xfa3o\x89'\x9b\x17\xa5Yk\xdf\xdfP\x95\xb7\xf4y\x98k4\xed/\x00\x80ש\xcdf\xcaf\xd7[F\xf1\x82\xc2\r\xb9\xfc['\xe4\a\xc0봴3\xb2{\xe5\x92\xe3%<\x00\x00\xd8<\xceE9\xa5\x99sfV(*$+\x12\x05E\x95\x05\xb3\xf4\x01+)\xa4\xd9ة\xdb\x00G-\xc4\xda>\xbc\xd2d\xa5\xc1U\x1a\fn\xeb\xcf\x00\x97\xc6\xe1}\xbf$V\x87\xffJ\xc3\xf9\x8d\xfd\x11\x00\x1bɧ\xd9l\x8d\xab\xe5\xadL\xe8[)w^EV\xc9k.?\xbcvq\x1ex\x03\x8a\xad]\xa9ͻ\xc0\r\x97B\x1c\xb34\x12\xb8\xd5C\x00\xe0R\x19\uea9a\xff;\xed}j+\xce\x1e\xfazz\xe5^d\xa7?\xf5\xbex\xf6\x137\xc4\xc9\xfc\xdfZ\x19b\x9e\x1a\x8c\xad\"{\x85bs\x8dB}u\x7f\x15\x00\\\x80\xa6\xd4V\x91I\xfb\x8d\xca\xf4\xfe)^\xa5f4#\x88\x1d+˧`\x82T\x12\xf1\xdc\xc2\x00\x00`\x05Y}?\xa6\x97\x06\x04\xb1\xaf\x874\xc3~\xb4\xef3\f\xed\xab\xad\xfb\x83\x00VQ\x96:\x16\x99ԋW1}گT\xf7L\x03T\x9c\x7f\xf7\x8b\xa5\xb7\xbb\xfb\xab5\v\x87\xf6\x85n\xd9w\xf7\xa2\xca2(\xabS\xbfl\x00\xb80]/pg\xe9ʠP\x98\x14\xaa\x9fgVv\xe0\xf5Z\xda@\xf6<\x05o\x11\xec\x01\x00\x006\x90S\x94\x8f#\xdbؗ\xb2\x81\xd4\xd8\xefh\xa5\xa3\xdb\xfe\"/.\x80\x15\xe4\x9bI\x17\xb0DG*\xe0h\x858\xb1\x8f\xd2\xf2W\xafZs\x8dn\xfe>\x1eJ\\2\x93\xfbn\x8a\xd9\xe1\xcfj8-\xac \xb7\xd7\x1f\x05\xb0\x91ܖ\xe5?\x96\xe5x\xab\xe7\xd9\xcaե\x9c\xcb,;:\xec\xder\xf9\x9d\x0f\xf4\x17\x02\x17.۽KU\xf4v\x83\x05e)\x90\xdd\x0f\xed\xe6\x9a\xf7g\x01\xe0⛵{\xcaZiw6\xd7\xf0\xf4\x8fJ\x9f\xbc*\xea\xe1\xdf@g\x8d7\xe2\xd1?\x11\xc3'\xde\x12\xa7?\xe9c\xe9>\xa6q\xf6\xb4\xc6V\x9d\xc8|n'w\x16\x9d$\xb3sʆ\xcf/\xae\a\x80\vи\xf4\xc2iK\xdb)M\x99\xef\xaa*g\x8aǿ\xb1?\v\xac\x1e\xdf\xce\x16=H\xdd\xd2v\x7f\x03\x00\x00xeޫ\xcdhNY\a\xf3\x87\xbe1vm7i\x94\xa5\xb0e\xe5S\xab{\x01XY)h=\xa6g:\xa6\x899ӳ\xedl۫I\xd3Q\xe5\xb7..\xc2\xd2\xcbo\xfe>7/\xae\xb2\x8d\xa8\x90\x06\x1b\xb1<7k\x8a\xfe,\x00\xbc~\xad҄\x02)\x90\xddr\x86I\xae\xd9ޏ\xf6g\x00\xbcVKْ\x7f\xf0\xb9o\x89yW\xf0#\x90\x1d\x00\x00l\x1e\x1fS\xd3Wꠘ6\x06\x8a!Sp\xbb\x8b\x93\x00VN^\xef˷\xa9Q\x1b\xc0Qr\xbe\x91B\xca_K\xd5i\xf0<\xe0Rx\xfa\x8f\xc7\xf9gO\xc4\xc1\xa9\xe75\xac\xac\xfc6\xaa\xa4\x8c@v`\xa3\xf9R1f\xa9w\xbd\xed8\xa9=?\x80뤛\x98\xa3\xb8\xfdG\x18X\x05o\xdcM?\xe5fEc\xf7\xda`\xd1?\xce\xdb=ƌ/\x00.\xa1\xb2=\xa9\xcc\x1dOQ֩G\x9f4}Qՙ\x1f\xd3\xfc\u07bbb\xfd\xf8\xaf\xa5\x11\xe4u8\xf7\xe8{\xe3\xd9O\xbb8}\xf6/\xc9O\x9e\xd4h\xe45\xae\xb7TΏٿ\xebIivB\xaa\xac,\x91\x06g\xb3\xba\x85\xcbm\r\x00\x17\xc8\x15\x96D\xcfgV\x11\xd9U\x13Ok\xb6}\x83\x8a7}?u\x12\xac,\xdfN\xfb@\xf6\xc5>\x00\x00\xc0JIc\x93\x96\xf4\a[\a\xf5\xfc3V.\xb5\x82\xa9-\xa9\x9d\x9ey.\x80Ֆ\xa5w\xbb.*\xa4A\xa4cm\xdbi\x86v\xa7:\x05\xb5\x0f\xeeZ\\\x84\x9508\xf6\xebu\xe8N)\xa6t\xb9u\xcaRZ\r\x00\x17(\xc4B\xcaS:2[\xa4'\x87\x9f^\x9c\x00\xf0\x9a-e {\xa8>)\x1f\xad2GE\x0e\x00\x00l\xa2\x98Y!-\x059,\xb4i\xb6\xbe\xec\xda~\x0f\xc0\xaaq\xf5~\xfaTL\x83S\x0082.\x05t\xf9\x14H\xe8\x15\x1d\xf9*.\xbe\xfa\x91_\x1f\x0f\x9e\xfe>\r\x0e\x1ae#g7\xdd\xdc\x0e\xeeJ\xdb\xfd\x05\x006R\xb0\xfc\xa7\xedf\xca\xee\x9b\xe2\xd3\x00\xae\x9a+\xa4\xf6\xef\xec\xea\xc51\xe0\"\b['Դ\xa3~̄\xf9\xa23\r\x00\\\">4\x96\xa7\x9d\x95\xb2Z\xa9\xcfF\xc8Oڱk\xe4\xf6\x1fUx\xf1_i\xef\x93\xe3\xd8\xdc\xf7+I\x88^\xc9\x03\xdf\x10\xab\xfb\xde\x1b\x0f?}2\x16\xa7\x1e\xd2v]h4\xb6㣙\xd4\x1c\x93\x9f\xa7@\xd3ZjS'I\xabWd\xf6\xef\x9dff\xaf\xb7\xd4\x1c\xa4\v\x01\xe0\xc2dq\xd7\x12퉥5\x8d܉R\xbbo\xb9\x87^9XY\xed3\x7f2\x16!\xcdȞ\x06\x04\xa7\xd8\x01\x00\x00VO\x93y僛\xfb=\xac\xb2X?.\xb9VQ^\xaek\xa4\a\xb0\xd2һݴRaKj\vO\xfb^Mz\xe5{\xd7\x0fӖ\xb2B\x867\xff\xefn\x96\xb5\x966gRU.\xda\xdb\x01\xe0\x02\xe5\xa9\x17xJO\xea \x95M\xf7Zo\xf6\xc0\ah\x98\x04^\x87\xbe\xf7\xdcr\xf1zL.VrJ\xa3\x17\x01\x00\x00l\x98X(\xba\x83\xd4\x12fKc5\x1f\xaf,\xbf}q\x0e\xc0J\x99<\xfaͱh\xf7\xf5R\xe0\x12\x80#㜓\xbce\xae\xaeR\x91\xdd\xd9\x1f\x05.\x92{\xdf\x16\xf5\xec}\x1aLm;O\xe5\xb8L\xca檋=5\xd5[\x17\xd7\x00\xd8H]ݮk\x86\xcf\xd5\xcd\x12\xe7\x9d%\x11\xb5fn ?\xfc\xd2t\tpQ\f\xb7?\xa0\xd4MN1\xcd\xfa_\xa7I\x05\x00\xe0\xd2\t{R;V\x1b\xaf\xd3\xdc]\xa3iv\xa8\xc6?\xa7\xd2\xea\\\x83pB\xbb\xf3\xa9\xf2s?\xa9\xd9G\x8fŽO\xdf\x1d\xcf=\xfc\xdb\xe8\xc4a\xaa\xfb?\x10\xcf~\xb2\x88\x9a\xfe\x03\x95{\x9f\xd1\xd6~и\xa9\x957C\xb5\xb3kU\x85c\x9al\x9d\xb6D\xdd\xea\x13i)\x1a[\xac,1ܗ\xcaC\xab\xd7\xd6\xcaB\x1a\x14\a\x00.Ll\xbcB6\xd6~\xb6\xaf\xec\x8a?\xd8\x1f\x05V\xd3|\xf6\xb3\xf215\xc6\x15\n̔\x02\x00\x00VP\x95\x05\r\a\xef\xec\xf7\xb0ʲ\xf6\xb0[;\x97)f\a\xf2\xa1\xec\xf6\x01\xac\xa8\xb0\xa8c\x06\x97\x02\xd9\xfb\xc1)\xecX\x93\xd3̽\x8a\xca\xf1\x97\xc8\xe5\xd7Juf\tu\xdd\x1f\x05\x80ׯ\xe8>\xbdfieyB\x1e\xb6\x14&\xff\xa5;\n\xe0\xb5Y\xcah\x8aRg\xad\xb0g\x8fvF ;\x00\x00\xd8@a \xe7\xa7R\x1c(\xa8\xb62\x91U~\xf2[\xfb\x93\x00VI5{؞\xe9\xb9\xe42\x85Hc6p\x94\xbaG0\x8d\x82\xee\x1b\r\xcbw-\x0e\x02o\xd4\xe3\x7f$\x1e|\xf6\xed\xb1=}\x8f\x8a\xec)\x15\xe55\x96\xee\x0f\xed>\x9b,b\xd9ۛ\x14\xeag\xfb\x8b\x01l$\xbf\xb7\x18L%\x05\x9d\xa5@\xf6\xacUe\xe9\xc3<ߕ\xde\xfe#\xf4\xb4\xc7E3\xbc\xf1\x9f;\x97\xa5\xc1T\x06v\xaf9ˋ\xb8\xbd\x00\\B\x99\x95{5V\xac\x0e\xe4\xeb}\rmo\x98Y\xfa\xe3,\xb3\x8b\xfb\xb6wҮ\x19h\xe8\xf64\b\x9fSq\xf8O4\xb9\xe7\x8eX\xdd\xf7\xc1\xd8>\xf4\xcd\x1b\xd3@2{\xe4\xdb\xe2\x99O\xbf?\x9e\xfd\xf0(\xce~\xc6\xc5\xf2\xcc\xcf\xe8\xf8\xbcPs\xd8wf\x1e\xa42\xc2\x15R=\xb6\x7f\xb7\xe7\xe5\xb3sV\xaf\xb0\x82Bۨm\xa3\xea\xd6u\x93:\xc4T\x86H\x83\x95d\x95\xfc\xa0\xea\xfe(\x00\\\x90xFmq\xab\x8a\xab\xbeZ\xba\xfa\xbb)0b\xa5U\xd5=\x8b\r\x9f\xabYd\x96\x00\x00\x00+\xa5qQ\xee\xfa\xbfE\xb9|\r\x94\xa1\x8fwHA\xaf\xfe\xd0\xea^[\x8b}\x00+.WL\x9d\x8d\x82\xb7\xe7:\xcd\xc2K\x92\xbd\x8av\xde\xf4\xd3.\xf8\xe3\xdd\xf7\x173\xbeC\x00\x17΅\xa8\xa6\xa9,{H\x93\vX\xde\xe0\xbcJ\u06dd<\xfa5t\x0e\a^\xa3\xa5\fd\xcf4]t\xeas\x8c(\x0f\x00\x006P\xcc\xd5M\x1c\x10K\x85\xd0\xc8eNyv\xe5\xe2\x1c\x80\x95\xd2ԧ\xecY\xb6\x8d\x14\xbc\x04\xe0H\xc5n\xc4\xe4е!\xeaο\xc8C\x897\xac\xba\xe7\xabc\xf3\xcc\xdf\xd4\xf6\xe1g\x95\x15WH~\"\x95\x8f*\x0e\x82\xddiWZ9nl\xe9\xff\x93*}\xd3\xff\t\x00\x9b\xc8[\xbe\x93ɲ\x9d\xe0\x15SE/\xb32\xa2-\xb1\xd8\xe9\xaf\x00.\xa2<\r\xa2\x95\x06M\xc8\xd3XZ\x00p\t=g\x99\xdci\xe5\xf9T\xa5\v\xca*\xcb\xf0f)\x93Ks\x11\xe4\xaa\xfc\x81\x1a\xb5V.v*ki<\xa95>\xfb\xa8\xcaS\x1fR\xf6\xec\x0fi\xfa\x89cqvϻc\xfd\xc4\xef]\xbb\x8e\x1d\xcf\xdf\xff\xadQ\x1f\x19G\xfdl\x19\xcb\xe7\xfeg\x9d\x98|B\xc7}\u0530\xb0\xfa\x81߲B@\xa6ftRu\xb6ce\x83=\xabC<)\x8d\x9eS\x96e\x96\x8c\x1f\x93\xdb?\xae\xd6\xfe]\x9d\xfd\xc3僡\xb2\xfc\x98\xd5g\x87V\x01\xb1\x7f\xdb\xca\xfe\xfc\x9c2\x04\x80\v\xe7\x9c%\xbb\xc3\\\xc3[\x7f\x82\xb61\xac\xbc\xbay~\xf1\xfe\xc5*\xda-\x81\xec\x00\x00`\x05\xb5\x94\xca\xd7\xc2\xf4\xd1?\x19s\xf5\x83\x16\xa6\xbeA\xa9?B\x1ap\x16\xc0\xea\xea:\x16\xd9\xca\xf9\xf3\x93\xb3w}\x8e\bd_]\xd1\xe7\ny\xb0\xef\x93\x17\xa8\x00ހ\x18\x15ڨr\xb0\xad\xd8u\xfc\x99+o3\xed\xcd\xffy\x7f\x01\x80_\x8c\xebF\tZ\"\xf5\xfd\xffM,\xaa\xff[\xf1ܵ\xaaGϪl\xd3\x18\xfe\x00\x00\x00\x1b\xc4\a5uc%\xb5\x91\xf2<\xea \xaf\xb5\xfd\xae\x8aV0`\x05\xb5\x9fu1;w\x93\xb4\xf5\xc4\"b)\xa4\x0e\xdd\x00\x8eB\b)\x98\xd0k6\x98h\xf8\xcen\xc8\x18\xe0\x82\x84\xc7\x7fK\x9c\x9f\xfeW\x8a\x93\xd3\x1a\xdb/\x957H\xd1\xd2y\x00x9M)\r\xe6\x9a)\xa8\xacO\xc8\xe7ϫj3\x1d^\xf3\xc5:q\xcbO\x93\x1f\xe1\xa2j\x9e\xf9C1\x7f\xe4\a-\xb3zA\xedn\xa9l\xbe\xe8h\x03\x00K'\xe4VA\xabm\x89j\x9cW\xa3c*\x06\xb7*\x1b\xdf!\x8d\xac|}\xed_X\x8d<\xf2\xd1?\x10\xeb\xd9'\xd4\xce\x1f\x94\v\xa7T\xd8\xff\x89OA\xa25\x9d\x95\x01\x1c\x91pB*\x9eQ\x8c;\x9a獂\x9f*\x9f_\xa1\xb2\xb54\xb7\xdd\xd3s[\xbfT\u05fc\xeb?S\x0f\xc1z\xf8Ա\xa8zf\xf7}\xa9\xdaMUD\u07bf\x00\x00\x80e\x93\xdag\x17\x03\xeeD\xb5r\xdd\x04\b\xb1k\x0fI\xf6\xdcq\xed\xbe\xe7\x05\xca\xe7\xab\xee3\uf21a?l\xdf\xebHav ?\x98\xab\xa9\xafQ\xee\xcf\xf5\x17\x00X5M>W\xa8\xb7U\x0e,\r߳\xb4\xfcľ\xaazW\xd5\xee\a\xb5\xfd\xa6\x7f@\xba\xbd\xa2\xf6>V\xc6ݪP\x18Nlo\xac*LT\f\xa5vj\xbb\xb3+U\x8e\xed\xbbnӠyį\x01x%\xb9\x945V\xee\xb3\xfc!4\xf6\xdb[\x89\x7fG*\xae\x94\xca\xe3\xd2\xdd?K\x1e\x01\xfc\"\x96\xae\x17Sl\xf7\xac\xf4'\xb9,t\xb3\xd5\x00\x00\x00l\x9c\xe8\xba@\xbb\xee\x05F\x8a\xb3\xf3V\xb9\x01\xb0\x92\\\x9b>gݻH\x00Gk\x91\xaf\xa6\x8e\x01thą\x9b\xdc\xf7eqv\xea'T\x86}\x8d\x87#i\x18\x14\xfc\x83\xfdY\x00x9)Hoh\xe5\xc2`YО\xe5E\x96\x0f\x95A\xdbï\xe8\xcf\x03\x17O~\xdd_u\x1agR\x96)K\xf7\x1e\x00,\xab4\xd2Xj\xf7ti])\xb6g5\x9b<\xa2٩\x8f\xa8y\xf6?\xe8\xcc']\xdc\xfb̕qz\xff\x17\xc7\xf6\xd1o<\xfaV\x95\xa7\xffh\xac\x1f\xfb`<x\xe8\x1dq\uf05b\xe2᧯\xb7\xe5d\x9c\x9c\xfb?\xd4L\xff\x93|\xfb\x8c\xca\xc6\xf2\xfajG\x9a_\xd5\xff!\x008\x02\xf1\x19\xab\x82\xbcY\xad\xa6\x8a\xb3\x99\x06U\xa92?\xa5\x18\xb6\x14\xca1A\xecX/m%5V\xc7N\xefU\x17/c\x00\x00\x00\x96L\x1f\xc4\xfe\xf9\x13Υw\xd6iz_[\xf2\x826\x84u\x10۹չ괱h\xeb2..\xd6\x00VS\x1a\xabԥY\xd9ӳ\x9c\xd9b\x8fw\xeb*\x15\xf9\x8d\xfd\x15XE\xe3ѯ\x92\x8a\x89|[\xca7#e\xd5X\x9a\xdbW\xec\n\xe5\xf9\"p\x8diQ\x00\xbc\x9a\xae\\\x9f\xca\xf2\xbdEwT+\a6\x13\xa9:\xdb\x1f\x05\xf0j\x96nF\xf6\xe9g\xde\x1dG\xd3\xcf\xd9O\xb6\xa56?PV\xe7\xfd\x19\x00\x00\x80M\x91Z\u0082B\x1a\xd9\xcfEͷߢѝ\x1f\xa3\x89\x04X1\xedc\xdf\x11ݩ\uf45f\xdb\xe3;\xac\x15\xdb\xda\x1eiF\xec\x04\x8e\x8c˔F¬wnSq\xd7=\xe4\xabx}>\xfb\xfe\x18槥\xeayy\x1dJi<\x84\xf4\xde\xd2>\xe6\xedPC:#\x00x\x05\xa1m\xe5G۪\xa6\xfb*\a\x8d\xa5#\x03\xd5\xc7N\xaa\xb8\xf3i\xf2\"\\\x1a\x0f\xbd?\xea\xf4g\xa5lf\x95\x12\xea\x1f\x00\x96Sh\\7\xa8\xb9\xcbR;h\xa3\xd6\xca\xd3]l{W\xc8\xceT\xc6Ҏ[V\xe9m\xe9r̨\xd4o0\xcdX\x16\xed\xf8\xbc|\x8b2?T\x96\xef\xda\xfa\xb8\x94\x9f\xb0\x93\xb6\xbe\xf1\xbb^_\xfe\xfa\xf4\xb7G\x85I\xd7\xc1$6\aj\xeb\xa9\xdaP\xc9\xcd\xff\xbdbt]\x1fE\x97\xd6\xe9uzꘒֶ\xb4\xa3}Y\rs\xf1\xb3u\xe7\xec\x87k\xedgNK\xb0\xca\xc2\xc0\xea\f\x00p\x04b=\x93+oU\x13\x0e\xe4\u008b\x96FZ\x9a\x14j\x1d\x0e\xb6T\x1e\xfbz\x15\xb7\xff\xbdחN\x02Kjv\xffo\x8fý\x1f\x90\xe6\x96\xff\x17V\x96\xc8,o\x0e\xd4\x7f\x00\x00\xc0\x92\xe9\aZ?\xdfO?\xbd\xaeNbH\xed \xb6\\\xfdA\x15\xb7\xfd(e\xf4\x15\x17>rKT\xf3\x94\x95K\xb7\xa4v\"\x9f\xa7\xd99\xaf\x90w\xb4\x0f\x01\xab*\xe4\x95B\xbd\xab\xbc\x9cH\xb5%Ӯ\xd5A!m\xbf+\xed`\x95\xcd?\xeb\xe2`\x92\xde?\\!Ui\xa0\x82\x03)O\xf9\xf4\xb8;߄\xb3\xcaӌ\xcb\x00\xf02b\xc8\x17\xef6\xb3\xcav\xdaEYߎ)\f\xac\xe8_h\xff\xb6\xffN;W\xfdy\xf2\n\xe0U,] \xfb\xe4S7\xc6\xf1\xe4\xacTZ\xbd\xce~\xe5\xedbt\x1b\x00\x00\x80\x8d\x91:m\x96V\xc9i\xb7\xad4\x14\xe4N\xfe:\xe5\xb7\xff#*6\xc0\x8a9\xbc\xf77\xc4\xf1\xfe\x7fR\f\xa7\xe5ˡ\xd4\xd8s\xadrq\x12\xc0\xe5罚6(\xbb\xe2k\xe4\xde\xf4\x8f\xc9W\xf1\xda<\xf8EQ\xd5'՞\xa9\x95\xf94\xc3\xed\x8e\xe4j+\xaf\x1d.&K\xf0#E\x7fBE{\xba\xff\x03\x00\xf0\xf3\xcd\\\xd0\xc0\x15r\x8d%\x1a\xf9A\xf7>\xbc\xb8\xfa\x1b\xe4n\xf9\xfb\xe4E\xb84\x9e\xfbSq\xf6\xf4_ҰNї\xd4?\x00,\xa7\xe0\xd2\xfb\xdf \xdf5\x84F\xab\xaf\xfd܌U)\x96]U\nDK\xe9Xz\x8f\xdd\xd8\xf2y\xef\xb3\xd3\xf9\xcf\x7f\xbd\x9dr\xd4P\xd8_\xe3mɺIr\xeaѫw4˧\x95\x9c\xfd\xddi\xf1\xde\xfe\xb2T\xc6?\xcfvC\xeaǖ\x0e\xa7\xffNZR\xa0z7\x03\x8f-\xdd\xcci)p\xdeN\x9c\x9fi\xcb\xd9Ϙ\xfe\x8en6\xd8\xf4\xffDG7\x00G\xa4\x1e)\x8e\x0f\xa4vג\xc73\xb6\x1f\xd46^\xb3k\x7f\x89\xb6\xde\xfc\xd3\xd4A\xb06\x0e>\xfb\xae\xb8=\xfb\x944\xbdV!\r \x93\xed[q\x82@v\x00\x00\xb0dҀ|!\xd8\xe2\xe4\x9c->5j\xa8;\x96\x06\xf4˾dF\x19}\r\x84\x9f\xb9ξ\xe8\xe7\x7f.\x90=KS7\x1f\x93ܼ\xbf\x02\xc0\xaa\x89Y\xa3\xd0\xee\xd8\xf3\xbc/'\xafX\x8f\xb5?\xaa\xb4\xfb\xceC\xd2\xed\x15w\xf8\xf0\xbb\xe3\u058b\x9f\xb4LxWj\n\xfb\xb2+)Om\xfb^m\xcc\xd4\x06\xaf\x92\xf4\x1b\xc0+\x89\x96n\xa4w\x81>\xbd\x17L\xef/\xd3;Ag\xe5\xfb\xac{ݹw\xd5\xdbt\xfc\xb6O\x92W\x00\xafb\xe9\x02٫O\x9d\x88\xe5\xa1e\xfe\xa5TۏV,\xd9\xcf\a\x00\x00pɥ\xa8\xa8\xc1L\xa1\xdeV\xed\x9c\x06\xef?G\xa5\x06XA\xfb\x9fzGܙ=k\x8f\xf4\x8b\xf2\xd9x\x11\xc8\xee\xe8\xc8\f\x1c\x19\xefTY\x1e[~\x11/\x96\xf0\x8bH\xb32\xee\xfd\x84\xda\xfdG\x15\xc3\x19K\xc3\xed\xf6\xf1'\xed\xc4D\x8a\x8d\xa5\xe7\x03+\xafm\xabU.W\xee\xcb\x15\ars\x02\x05\x01\xbc\xbcY\x19\x95M*\x15\xc5\t\xa9>\xadíL[\xefLQ\xed\xc0\xa5\xb3\xf7\xb9+\xe2\xee\x9e\xe5Yi$p\x00XF\xb9\x15\xadS\x1bh\x17\xf7\xed亠\xf5\xb4\xb3\b\x04\x9f\xe5\xbb\xe9\xaa.\x90<K]\x05Sg\xef\x14L\x9e\x8e\xd9\xd2z\xbbބ\x97\x82\xdf\x17Yk\xea\x14\x9e\xfe\xdarz\xb6\xdb\x7f%\xb3\xc1N\xeaK\xdeI\xaf\xa2\xd3\x7f'\xbd3\xf7ݴ\xef\xc6/\x02\xe9]l\xedx\xbb\xf8\xf9\xac.\xb0\xb8&(+R\xe0\xfb\xa2cJ\xb0\xfffL\x7f\x81\xfd\x85\xce\xfe\x1fR\x8c\xbe\x9f\x13H\a\xe0\x88\xb8B\x96\x1a\xd9j\xcf\xea\x1f\x96 \xb5A\xed\xe8m\xca\xde\xf9Y\xea X+\x87\x9f\xba2n\xb5\xa7\xa4\xc9\r\x8aóv\xd7\x1fZ\xd6M\xfe\v\x00\x00\x96Lj|\x88\xfd\x8c\xec\xd1\xcb\xf9\xbe\xbfHt\u074c\xec\xfe\x97\xf0\xdez\xe5=\xfd\x87\xa3\x9e\xfc{\xb6qV\xa1\x18+4\x87ʭ^\xb6\x18\xe4\xb0o\xb7\x02\xb0z|\x1apd\xa04[\xb7\xd7@M\xb3\xad\xe9\xd6q\xed\xdc\xfd\x00\xe9\xf6\x1ah\x7f\xd6\xc5,\xbd\np\xf6u\xa6\xf4\xba\x7fI\xd0ġ}\xe7;\xca\xdbW\x7f\xbf\x00`\x83\xa5r^W\xc6\xeb\a\xb7\xeeF\xc4N\xfb\x8b\xf7\x8b\a\xa5\xd7\xf6\xdbk\xf2\n\xe0U\xf4o\xe3\x97\xc3\xf4\xc9o\x8dEH\x0frc\xcfrZ/Տ\a\x00\x00pYDe]\xe7\xc7\xf4\"#\xf8T\xe9\x01\xb0\x8a|\xf3\xbc}Vr\xca\x15\xd3\xccb1[\x9c\x00pD\x82嫴\x13\xe2U<\xf9\xfbc\xb8g7\xc6'\xfe\xffҩ\xcfY\x89,H\x83\xb1\xa6\xc5XM֪\xf1\xa5j?R\x93{\xb5\xc5D\xbe<+\xe7&vk\xd1\t\x01\xc0+˭\x1c\xe8\xdb\xf4\x12\xdcJ\x86.Wؾ\xab?\x03\\:\xf9\xe8WX\x1dd\xd4\xef\x01\xc0\xf2iۦ\xeb\xb0\xdd\xcdF\x96\xe5Z\x04\x8e\x8f-\xbfܶ\x93[*\xf3\xa9\n?S\xeef\xf2\xaa\xe5\xa2]kW\xb86\x93\x15Ε\xf9\xb3ʜ-ړ\x0f\av\xfc\xd0\xf2۹\\S)kZ\xc9\xca\xf0\xaf\xb6\x14q\xae,Δ\x05[\x87F\xb9\x15鋘\xa9Pn\xeb\xd2\xfe\x0e\xdf->\xf8n\xd0\xf5ܵʳ\xa0\xa2l\x94\r\xecgϝ-\xde\xea\v\xb6\x94\x99\\\x91+\xda\xffK\ny\xafS,>\x00\x1c\x91\xd6\xd2NK\x89҈\x1d\x96\x1e\x05M\xb7\xaf \x88\x1dk\xa9h\xd3\xc0\xc1\xb6\x915V\xf7\xb1{>\x0e\x16'\x00\x00\x00\x96IL\xef\x10\xd3\xc0w}\x91\xbc\vhO\xdb^>\xa3\xfc\xb2\x0e\xda\xd9g\xec;m\x16\xe1\x0ei\xc0\xc5n\xb4\xc4~\xa6N\x00\xab+\xd8s\xec\xe7\x8b\xf4;Ͳ\x9bWʳ;\xfa\x93Xu\xb1|\xb3\x0eS\x16\x9d\x06\x9c\xc9R\x9bB\x8a[s\xca\xf3Vy\x91\xb6\x01\xe0\x15\x9c\x0f\\O\x03c\xa7\xfe\xe0i0\x8c\x14\xdc\xeeӻ\xce\\\xe3\xc3F\xf5\x03_JB\x02\xbc\x8a\xa5\x8a\x14\x9f֟\xb4z\x9c=\xcc\xce\n\x04\xb6\xf6i\xca+\x00\x00\x80\r\x13ҔD\xce\xea7\xa9\xb2\xe3(\x0f\x01\xab*\xd7Ԟᙢ\x1b\xda\xf3<\xb7\x87\x9a\x81)\x80#\x95f\xd2\xcb\xc8W\xf1\v\xc5\a\xbe6V?;\x8cz\xf2\x87\xe5\x0fv\xe4\x06v\x9f\xecX\xba]\x9cS\bc\xb9\xf6j\xb5\xd59[W*4V\x9e\xe5\xf2ž\xa5\xf1\x87v,XR\xbf\x98-\x12\x00^^\xa3,\xb3t\xa2\x9e*\xe6C\x95\xe3_\xd3\x1f\a.\x9d\xf1m\xffԅA\xea\x81\x01\x00\xcb)\xf5\xeb\xf5\n\x8a]G\xb1&E\xa3u\xb3\xb4\xab\xb0\xb2\xf8`$_\x8f\x95\xb5\x03e\xc1/\x02\xd9݁\x9d?m\xe7^\xb0kl9\xb8J\x9a\x9c\x94\x9fn+\xab\n\xe5\xb5\xe5\xb7\xcd\xcc\xfe\xccĖ}\xcb}_\xfdW6\x8f\xf6\xe7\xec?]9\xe5M\x94o\xean\x91\xfd\x1dj&\xf2\xfeY[\x9e\x97w\xa7쇲\xb2\x7f\xb0\x9f\xaf\xb5\x1f\xb0\u07b2\xe5\x98\xe6\x87\xc7UMv\xd5Lm\xbf\x1a\xd8\xcfk?C[\xa8l\x86\x1a\xa4\x99z\x00\xe0\x88\xd4mi\x89۴\x1b\xf4C\xe55\xf2'\x7fw\x7f\x06X/)\xdfM\xafQ\xbbw0\xb2L]\xcc\xc6\x0e\x00\x00\x96O7\x13{\x92\x06\xf2\xb3\xa5\x1b\x1b;\x05\xb2\xa7@\x97\x82\xf6\x83uP\xcd\x1f\xb0\xef\xb4\xe9\x1a\xbbbꏐ\xbe\xf2\x14\xd4D ;\xb0Ⲯ]:i\x9a\\\xae\x98\xa8\xc8\xee\xec\xf6\xb1\xfa\xf2\x13\xbf^\x8d\xbbE\xb3\xb4\xd3\rV0\xb4\xb4;\xf5\xeb\xb4t<쥣\x00\xf0\n,o\xe8\x06/J\x83S\xa5\xf6I+ק6\xf9\xd4\x1e\x9f\xdei\xd6[j&\x1f_\\\n\xe0e-U {U?e\x0fq\xaa\xc0\xd9Nz\x88\x99)\r\x00\x00l\"+\x03u\x83\xf0\xa6\x0f\xcaC\xc0\xca\xcaS/*_\xc9\xc5rQ\xc7Y\xae\xea\x17\xb0y,[}i\xb4{l\xbc\xb3\x8f\x7fK<\xf7\xc9w\xc6\xf6g\xae\x88\xee\xf4\x87T\xba\xb14xVM\xf1\xb4\xaa6\xa8\x99\x9dT\x9c]\xa9\xb2>\xabq\xf3\xa8\x06\uee32XI\xcds\n\xcd\x19\xb9zK\xae\xbdJ\n'\xbb{\v\x00^QJ#ܮT\xcd\x14K\xaf\xc1\xb5\xdfCf\x84\xcb\"\x16\x8b\x0e6\x00\xb0\x8c\xbc/伷l\xb2Q\xd3NU5\xfb\x9a\x87\x03\xd5q\xa6\xda\xcfոs\xb6\xbd\xaf\xca\xf6\x9b\xd8,\x06\xfe\x8cc\x85\xb0\xa3V\xdbj\x86g\xd4\x0e\xf7\x15\x87\xad⨰\xb2\xfcqK\xf7N\xa8\xf1\xb6\xb8\x13ʛ\xfcU\x97\xba\xf0jJg\x7f\xd6\xfe\xda\xf4w\x8c綞\xa8\x1dL\xd4\xd8\"\xb7c\xcb\xd6b\xf1vQfٷ\xaf\x15\xb2C\x85\xfc\x9cF㩆\xc3\x03\x15ž2\xa5\x19\xe1gr\x8d\x97\xfdPVg8\xd6\xff_\x02\xc0\xe5\x17S0o\x8a\x97\x18MT\f\xbfL\x83뾛\xfa\a\xd6\xce\xe4\xb1\xdf\x1f}\x1a`&\xdd\xebi\xa2\x94\x0e\x81`\x00\x00`\t\x9d\x0fr\xe9\xdeQ/\xfa\x8b\xc4\xf3\x81\xec\f\xc0\xbe\x16\xea\xfa\x05\xfbR\x7f\xae-~1v\x81}\xd7\xddl\x9d\x00VV\xc8\x15\xedW\xeac\x14\xd3v\xde(\x7f\v\xefx\xd7\xc6-\x7fŹ\xfc6\xb5\xa9C\xa7+\x17A\xa8i2\xd6P[\xba>\xed/\x02\x80_(\xa6\x81\xaf\x93n\x92B+\xf3\xc5L\xb1MyEzG\x98\x96m\xab\x02L\xb5\xff\xdc\x7fMa\x10x\x05\xee\xa5\x11ߖ\xc0\xf4\xb3'\xe2\xe8`n?\xd5X\xc1\x9dR\x93\x8dU\xb6i\b:\x00\x00\x80\xcd\x11\xf2F~je\xa0\"W}\xfd\x7f\xa3\xe2\x86\x1f\xa0\x11\fX1\xd5\xfd\xef\x8a\xc5\xc1}\xb65Ԭ\x9dh\x94\x82H\xf6\xaf\xb2\xdd\xfd\xc5\x05\x00.\xbf\xba\xd5\xe4\xda/\xd6\xf8\xf6\x9f\"_\xddD\xcf\xfd\xc1\xa8\xe9\xd3j\x0e\x1fPS=\xa1<\xee+OwB\x9a\xbd)u|\xf5i\xe6&\x00\xb8\xf8Z\xd7*\xab\xad,\xe8o\xd0l\xeb\xcd\x1a\xbe\xfdC\xe4C\xb8l\x9a\x8f\xb9\x98Ͻ\x94\xedJa\xa2i^ɗc\xb9\xa9T\xa6\xa0P\xf2?\x00\x00\x80Ւժ\x9b(\x97\xe7\xb6x\x85\xba\xb5R])\x97:\xcdUs)\x1fi\xaf:\xa7p\xcdW\xea\xf8\x9b\xff\ru\x0f\xac\xa7\xcf\\\x11\xb5\xbf\xaf\xd9\xf8Z\xe5\xd53\xca\x1b\xaf\xe9p\xa0Q\xc3`^\x00\x00`ɤ@\x97\xb2\xb0\xa2\xfa\xae4~Vec\xc7*i\x9e\xefj\xf0\xfes\x94\xd7\xd7\xc0\xc1G\xde\x1e\x87\xf9g\x95\xd7c\xa9Υ\xe1\x9e\x1a\xfb\x9e}v\x8d|8\xd7_\x05`\xe5\xb8Z!\x850\xf9\x91|\xac\xb4\x977\xda}OK\xba\xbdN\x9e\xf9MQ\x0f\xfc\xb4\xb4\xfd\xbc\xa6\xf6=\xe7\xcdY\x15Ŗ\xaa\xd9P\xa5?\xec/\x02\x80\xd7'\xe4\xa5\xda4\x81\xce\xe8@\xa3\xbb\xd3\bV\x00\xbe\x90\xef\xd7K!6i\xf4\xb94#\xfbL.\x0e\x94\xb9~\xb4\n\x00\x00\x80M\x92F\xe6\xeafe\xaf\x94\xe77\xf5\a\x01\xac\x94\xd8\xda3\xbc\x184\xcc\xf9~\xf0\xb0\xf3k\x00G\xa3\x18j4|_\xbf\x83M1\x7f\xf0+\xe2\x99O\x9d\x8c\xf53\xffY\xb3S\x9f\xd4\xec\xf0\t\xc5f\xbfK\xa7\xbb!\xf1\xd3\xdb\xc7\xf3\xa3\xa5\x02\xc0%еqg\x99Zw\x8e v\\v\xce\xdf\xd8\r\xfc-?\xb5\xfb\xb0P\x919\xbb'\x83ݒ\x85Tr;\x02\x00\x00\xac\x9a4\xe3K\xee\x8e+\x8fWHujְ\x8f\xb6\xb1%\xbdW\xf2j\xe39\x8dv>@\x10;\xd6[\x1b\xbbv=\xa7\x14\t\xb6\xc0\xeb\x17\x00\x00\xb0\x8cR\x89%\x95]\\\x1aY4\x05D\x86\xc2\n.v<\xcdԎ\xd5\xf7\xe4\xb7G\xa7I\xbf\x93\xaa`]c\xfcb\x02\xfe\xf4.\x1a\xc0\n\xfb\xfcf\x15/\x9f\x9f跱6\xae\xfbGNǽ\xc2<MS4U\x9e\xef\xd8\xc1C\x15K4I,\x80\xd5\x13\x9b\xb9\x8a\xac\x91\xe6\xc7\x14\x9e\xf8\x9d$(\xc0\xcbH\xb5\xa6\xa5\x11*\xab\x9c\xfb̶\x0e\xe5\xe2\xb6\x15\x01\xa9\xc8\x01\x00\x80\xcd\x13Ӌ\x8b\xcckn\xdb\xee\x9a\uf8b3\x11\xb0\x8a\x9a\xb9\\\x1a\xa8+\x06\xb9\xf4\x96\xaa{\x92\x7f\xaeS\x15\x80#\xe0+\xb9뿗|u͝}\xf0w\xc7S\x9f|W\xdc\xfb\xe8Nl>\x9c\xc7\xc1\xe9\x9f҉i\xa58\xff\x9c\xa5ˏh\xa0\xb3*}\xbd\x98\x8d=\xfa.}\x8e\f\xa4\b\xe0\x92\xb22a\x96i6\xd8\xed\xf7\x81\xcb'\x1b\xff\x1a\xb5\x83\x91\x95\x83\xe6] {\xe6\xbcUQ\xacL\xd4\xf5\xa4\xa3~\x02\x00\x00\xb0j\xaaf(\x97\x95\xb6U˅\xb9\n9\xb98\xb4jG\xab4a\xc4d\xfb\x1a\x15\xef\xfciڿ\xb0\xd6\x161AA>4\x8b\xbaM\x1a\xb0\x8bn\xa1\x00\x00`\t9+\xb1\xa7n\xf0.\xdf_\xcc\xec\x1b\x8ew\xbd\xf6C\xbeT]\xf7q\xa1\xa6\xf7(\xeal\xea\xe2\xb7\xd0M\xba\x99\xa7\xb9k\xecx\xea\xf5\a`e\xd9\xf3\x9c&\xaeI\x93\xd8\x04畗\xb7\xf4'\xb0VN\xbcC1\xcc\xe5*/Wf\xaaR\x9e\xed\xeb\xfe$\x00\xbc~Yme\xc0\xe0\x94\xd7c\x1d\xcc\xff\xef\xfe(\x80Ϸ4\xb5\xe1\xea\x81o\x88>D\xfb\x89|7\t\xa9T\xda\x0fGGb\x00\x00\xb0\x81b\xd6\x05U\xd5V.\x02\xb0\x9a\\]\xc9Y\xfd\xc6Y\x15g1ڲ}x\x02E\x80\xa3T\xa5q\xf3\xb0V\xea\xa7\x7fo<\xfb\xc0{\xe3ӟ:\x16돏\xbbeg\xff\x1f\xeadxP\xbb\xbeU\xees\xbb\xaa\x92¡ʼ\xd2 \x8f*\xac|\x95ŁԦsΊ]\x96P\x97\xf4t\x05p)\xa51\xdc-\r:\xfeU\xfd>p\x19\xbd\xf5o;mݒrC\xcb\x0f\xd3@[\xa9\x8e\x92\xd6V7i\xba\xa3\x00\x00\x00X!A'\x14\xdciɿ(\uf8bc?ֽO\x9a\xabQ]\xechp\xd57\xf4W\x02\xeb+Ug\xd2,\xa6\x99\xd5t\xb2\xf4\x00x\xfb\xbd\xe8h\x06\x00\x00\xb0\\\\\xae\x18\x9d|\x1e\x14Z+\xb7\x84\xed\xae\xec\xd2\xe6\xc7\xfb\v\xb0\xca\xda\xe9\xbd\xf6u\xee\xa5\x12i\xd7\xf6n\x1f\xf6\xbbX\f\xb6\x14i\x7f\aV\x9bW\xf4\xe9\x99v\n.S9\xb8\xa3?\x8e\xb5r\xd3?w\x1aY\x9a=+\xec\xbb>T\xdb\xdaZ\x87\x8bs\x00p\xa1\x9a\\\x85?\xa3\xf6\xf0P\xcd\xd3\x7f\x90N\x89\xc0\x17X\x9a\x96\xfc\xd8ާ4nt\xaaѥ\t\xb1\xbah\x8f\x14\xd8\x0e\x00\x00\xb0a|\xeaT\xee2ipC\x7f\x04\xc0*i\x9f\xfb\xb6\x98f\xc3\xe9^TuU\x9a\xf4L\x0fla\xa0.\xe0(\xcduu\xbf\x85U\xd4<\xfa\xbb\xe3\xe1\xfd_\x1b\xcf|\xf2=\xf1\xecG\xaf\x8bӏl\xc5\xfc\xd9\xffK\xc7\xf7\xef\xd3\xf5MT\x11\x1a\x15\xedLY;\x95\x8b\x13Ks\xa7R^+\x0e\x86\x8a\xe3-\xbb\x01\n[,-\xael\xa9KŶ\xb0e\xd1,\x96F\xd2\x06\x80K&\x8c4\xf3\x03\xe5\xb7\xfc\x9f]_&\xe0\xb2\x1b\xbd[\xf3X\xa8mҬ\x9d\x85|\xaa\x9e\xf8Zm7\x05\x10\x00\x00\x00V\x89ϧRV-ڝ\xad\xae\x91\x06\xf0\x9bd\xe7T\x8dv\x95m\xff:\x95W}\x0f\xf5\x0e\xac\xb7ǿ5\xfa6S\xc8\xd2\xfb\xd44K\x9a=\f\xde~S\xbd\x01\x00\x00˨\x1bt\xdbVVv\xc9b\xf9R\x1f\x12_\xdc\xde\x1d\xc7jk\xeb'\x94\xa7~@)\xe8!\x85<8+\x94v\xb3\xb2\x17VD\xa5\x80\n\xac\xb4\x90\x06\xa5X\xf4#\t\xca\xe5o\xfdA\xda[\xd6T\xb6\xfb\xe5\x96~O\xd4\xce2K\xd3-\xafNi9\x00\\\xa8l`\x19Ǿ\xad\xe7*\xe6N\xf3\xe9?\xeaO\x008oi\x02\xd9}\xfbL_\xa1\x9b-\x06&\xf3s{\x80\x97\xe6\xc7\x03\x00\x00\xb8l\x9c\x0f\n\xd1k0\xba\xb3?\x02`\x95\xcc\xe7\x9fV\xd6\xd6\xe9a\xb6\xbd\xbeq3\x16\xb6ό\xec\xc0Q\x1a\x14w\xf7[XF\xf1\xa9?\x16\xe3\x13\xdf\x14\xdbG\xbe:6\x0f\xbc36\x9f\xbd:\xb6\x1f\x1f\xc6\xf0a\x1f\xdb\xff\xe2bv\xf0w4\x9e\xfe\x98Nԟ\xd0\xf1fO\xa3\xa6\x90\x9b\xe5\xd2\xe1T\xda\xdfW\x13\xae\xb0\xe5\x84갥\xba\xc9Ԧ>\xdd))\xae\x83%\xbf\xad%\xc7C\xfb\xaf\f$o\xeb\xcc\xfelZ\x9cS\x8cQ\xa1]\xbc\x80\x04\x80K!\xfa\\\xf9\xf8W\xf4{\xc0\xe5W\xde\xf8\x0f\x9c\xf3\xb7+\x844\v{!\xefR>X\xdb:\xe5\x8d\x00\x00\x00X%\xbe<\xabn<\xbev\xdb\xcav۪\xdaI7v_v\xfc\x03\xf2o\xf9\x87t\xaa\xc6\xfa\x9b}\xa2\xebK\x163\xdbvM\u05f6\x17S\x14;\xcd{\x00\x00`\x199'\xe7S\xbfx\xdb\xd4\xd0>\x0em\xc95\x18\xbe\xaf\xbf\x00\xab̵\x87\xcae\x05Ӑf\xf0\xb52i\x96\xfa\x04\xa5jYi\x9fTπU\x16\xbb\x11\xd3\x16\xdbm\xd7\xff\x0fk\xeb\xcd\xffε\xbb\xa5\xe2l\xac\xc2\xcf\xed\xbbOi:\x00\\ \xb7\xd5\xe7\x1fc\x8dR\x7f\x8c\xc3\xe7\xbb\xc3\x00~\xce\U00094b1a3\xf6Ӥ\x8e\xc5S\xdbI\xa3\xd9\xd8:.F\xa3\x03\x00\x00\xd8(Y\xad&:\x95c\x02\xee\x80UT\xd5\x0f\xca)\xbd\xa0Z\xf4\x9cJ#-\xc7`u\x9b~\xa4V\x00G\xa3ܺ\xbe\xdf\xc2e\xf7\u009f\x8az\xf0k\xa2\x1e\xf8\xb5Q\xf7\xfe\x8a\xa8Ͼ;\xb6\x9f\xba-6\x1f\xbf2\xd6\x1f\x1d\xc6\xf9\x87\xb3\x18^\xf8^\xb5/\xfc=\x85\x17~R\xeeŇ\x94\x9f;P6o\xe5cT\x96\x92\xd0\xc9\x15r\xb3]\xa9M\x9d<jK\\ӌ\xeb^*Nؗ{\x8d\x9a\xcc\xd2\xda2\xca\rZ\xe5\xa5\x15\xa7\xec\x9cK\xedJ\xf5@\x9amK\xe3 \r[\xbbvn\x7fnf\x17T\x8a>ʧA\x14\x1b:\x12\x00\xb8t\x1aKwv\xee\xf8\xd7$48R\xe3\xf1\xdb-o\xb4\xfaH\xb0|\xb1IS\x17\x06\xb94\xb8\v\x00\x00\x00VJ\x8a\xddU}\xcc\xcauے;\xa7\xe8\n\r\xc7_\xa9\xf1-?J\x9d\x03\x1b!\xcc\xef\xb5\xcfLn\xf10\xd8s\x10Ԥw1\xbc~\x01\x00\x00K(\x86\xa0\x18\xad\xb4Ҧ\x12L\x1ap\xfb\x8cB\x1c)\xbf\xf9{(\xbf\xaf\x814ﺷ\xefSm\xa9`\xdfh\xec\x06-HeSo\xdf5\xf1\x0f\xc0*K\xb3\xb0'\xdd\xe0i\x8b\xd6\x18\xac1\x7f\xec\x03ʳ=Kϥ\xb9\xdb\xe9\x8f\x02\xc0\x85\xb0\xb2\x7f\xca6\x82\xb7\xdcc\xacb\x92\xaby\xe4\xed\xb4\\\x02\x9fǥ\x02\xd62h>\x9eǼme\xf5v\xc5|G\x99\x9bHu\x9a\xb5\xb0\xbf\x00\x00\x00`S\xe4\x95&\xd5H\xe3_r@I\bXA\xa7\xee\xbb:^qn\xa6n\x90\xael\xa8:;P\xa6+\xe4\xdb3v\xb6\\\\\x04ಋ'?(\xf7\xa6\x1f\"o}\x1d\xea\xa7\xff\xc7X7s\xb5m\xab\x9d\xfa~\xc5\xd8\xca\xc5Fj'\x8a\xedT\xa1=\xb4\xa5\xb2\xfdFM1\xe9FKt\xb2kBk\xdbMw\xad\x0f\xb1k\xdbi\xf2\xa2{\xc9\xe7l?-Yj\x8eJ#Ww\x8b]Ц\xce\x1b\xe9\xda4\x8bRLC\x80د4\x14H\x9a5\xddi\x10\xec\xbfi\xd7\x05;f\xbbjm\x89ήJ\xffQ\xfb\xcbFU\x1a@\xc4vR\xf0\xba\x1d\f\xf6\xf7\xa6\xf3\xce\xdb5\xb6n\xbb\x9f\xcb\xfe\xde\xc5\x7f\xa2\xfbYӌ\xb4\xbe\x1bD1\xfd\x99\xda\xd6\x00p\xf1ջ\xad\x8a;*\xf2\x1f\x1c\xad'\xffD\xd4\v\x7fM\x9a\x1e\xb3<\xf2\x9c\xdc\xd6Tn~\xad\x9d8\xbb8\x0f\x00\x00\x80\x95\x10\xb2-\xf94`\x9f?%e\a\n\xc5m\xf2\xef~\x98\xfa\x066F\xf8\x84\x8b\xfe\xf0F\xb5\xbbO+k\x82B\xe3T\r2\r\x0f\xb6\xa4b\xde_\x05\x00\x00\xb0\x1c\xda0\x94\xd3Y\xf9\xc2\xca1\xedur\xfe\x19Ew\x85\xfc{_\xa4\f\xbf\xe2N?\xfbm\xf1\xe4\x13\x7fUݬ\x9b\xd1ʥ~bG\xa3m\x96ʢ}\xe1Y\x9a؏\xf7\xcf\xc0\xaa\xaa\xc3H\xc5茚\xe9\x8e\xe6\xc5H[\xef}\x8et{\xdd}\xc2EMvt8\xb4\xef\xbb\xdd\xeb\x0f\x02\xc0\xeb\x13b#\x97YY0N\xe5\x9a7ہ\xa7ux|\xa2\xad\xb7\xa5ގ\x00\x92\xa5\bd\x9f?\xf2\xc18x\xf1'l\xabT\x9b\r\xec\x87\xf2rᬭw\xec'\xa4\"\a\x00\x006\xcc4\xe8\xf9\x9dVW\xbf\x87\xe9A\x81Ut\xf6\xe3\xb7Ɓ{L\xa3&͌\x93f\fn5\x9b\x17\xcaƹ\x8a.\xd0\x12\x00\x00\x00k#\x94\xaa]\xab4\xe9F\xa8&\x1a6\xa5\x94mI\xed\x9eڲQ\xf6\x1e^Ha9\x9cy\xf8\xedq\xfb\xd4gUhWq\x1e5=\xb1\xaf\xf1\x84Y\xd9\x01\x00\x00\x96I\x1c4j+'\xdf\x16\xf2\xa9Û\xb7ꄛ\xa9Q\xdd\r\xfcWǠquL\x9aX}\xe3\xf8M\xca\xde\xfd\x18\xf5\rl\x94\xc9\xc7\xdf\x1e\xf3\xf8Y\x95\xa9\x12^\xe5R\xb1\xaf`ǣ\xbbRY8X\\\x04\x00\x00\xb04\x9c\xa6\xf69\xccJ\xb9\xfa\x9c\x95\xefG\xdaߺV;oc0\xaaU7\xbd\xf7\xce8\xda\x7f\xac\xdf\x03\xb0nB\x96\xc9\xcf\x0f\xad\xb2yR\xd5ͿF\xe5\x8d?H\xba\xbd\xe6&\x0f\xbc7\xea\xd4\xc3\x1a\x173˾\xdb\xc5\xc1\x90\xbe\xf6\xfe\xab\xeff\xd9H\xd2:\xb5D\x00\xc0\xcbh\xa2桐v\x82\xb2\xa6Qn\xc9E\xc8+\x1d\x8e\xbf\\;o\xfd\xf7\xe4%\x809\x9f\xa3\x1e\xa9\xd0ZU=\xe5\xe7.Ͳ\x15m;\x9e\xcf\xf2\x01\x00\x006\x8fw\xca˫\xfa\x1d\x00\xabƫ\xeef\xfb\xb5\xcaM\xea=\xd5\x1dI\x93\r\xbbH#&\x00\x00\xc0\xda\xc9R\x19\xcf+ͬ\x91\xa5B\xa0ϭ\xe07\xe9\xdee\xfb\xec\xe6\xc55\xc0\x12\xd8.\xbf^\xae\xb0\x8d6\xc8\xf9\x812\xc6\xd8\x02\x00\x00X:\xb1\x1e\xdbg\xae\x98U\xb6\xb6zE3\xb5c\x99\xb2z\xa8\xa2\xde\xd2X[j\xe29\x85c\x85\xb2+\xbf\xae\xfb3\xc0\xc6x\xe2OFg\xcfE\xa7\x7f\xf7\x92\xa4\xf7/\n}'s\x00\x00\x80%\xe3\xcf\xf7\xd2\xef\xfa\x90d\xf2\xf9\x15\x8b}\xac\xb4X\xcf\xfb-\x00\xeb\xa8\xeb\xf7gu\xcd\xe0\xf7U\x14\xb7,\x0eb\xad\r\xb6\xbeF\xa1ؗ\x9aܾ\xf8\xf4\xe5\xa7Ɔ\x97C\xffO\x00\xaf\xc6\xc9\xf9V\xce\xf5iE\xb0\xf2\x7fڬ^\\\xec\x03X\x8e@\xf6X\xed\xa9\x8b\xe9ȼ=\xb6\xad|\xday\xb5\xfc\x1f\x00\x00`\x9deN\xe5\xe0-\xfd\x0e\x80\x95\xf2\xf0\xb7D\xaf\x83\xfee\xa4\xd5kBf\xeb̪:a9*_\x00\x00\x00\xb8\xc8\x1a\xb94@k\xa8\xd2\xf0E} \xfbLu1\x90\x1b\xfd\xea\xfe\x1a\xe0\xe8\x157\xfeY\xe7\x87\xef\xb4:ʼ\x1b\x80!\xaf\xed^\x05\x00\x00\xc0Rq\xd5\xc8\xea\x15\x99\x9coԺZQ\xad\\\xb0\xfd\x98Y9.Js\xa7j\xcbj!W~\xbdt\xe3_\xa5G\r6\xcb\xf4s\xf6L\x9cM]\xcb\xd4\r$\x9c\xea\xe0\xa9&n\xab\xa84\xf8\x03\x00\x00\xc0\xb2\x89\xdd\xfb\x83\xc5\xc0;i?W>|S\xda\xc0\x8asU?\xc0\x12\x805e\x15\xcflK\x95\xaf\xe5\xae\xf9.\xda_6@v\xfdw:\xbfu\x85Դ\x8b\xb9\x8bR\xb3C\xd7\xee`^\x9a\x8d\x1d\x00~\x11.\xb7ߵ\xbc\xab䃥\x1da\x90\xba\x14\xc9M\x9eRx\xf4\x9b\xbb\x94\x05\xd8tK\x91\xab\xc6\xfa\xec\"\x90=u\xf8\x8b\xf5\xe2\x05\x9c\xfdh\xb1\x1b\xce\b\x00\x00`\xc3X\t\xad,\xde\xde\xef\x00X)\xf3O\xc9řR\xf8zj\x81\x88\xdd\x1bI'\x9fYݦ\xab\xe7\x00\x00\x00`\xbd\x04e>\xa6\xf1\xc8\xe4S\xd9/\x04\xcd\xd2xF\xa3\x9b\xa4\xb7\xfc-:6`\xa9\xf8\xd1ר*\xd3@\xc2g\x95\xa5\x17\xa7\x00\x00\x00X.\xb1\xe9&~蚕\xad\xb8\xe6\xf2Lʭ\xfc\x96\x1d\xda\u0381\xeap\xa8\xe1\xb1_\xaf\xf2\xa6\x7fH]\x03\x1b\xa7\x99\xddk\x8f\xc5\xfe\xe2\xf9HK׳\xbc\xb0m{NR_3\x00\x00\x80e\x13\xbd\xf2\xae\x19\xd6\xca\xf4\xa9ˈ\x1bhp\xf3?\xa2,\xbf\x06\x8af\xdao\x01XG!\xa4\xc0\xa6\x91\x18\x13z\xb3\x8cw\xbfVi:\xfe\x98&.\xb2<<\xa6YY??\x88=Ͱ|~\x96e\x00x9.\x93\xcfZ9+\xff\xbb.\r)l)5\b\xa7\x15\xf6\xffU\x7f\x11\xb0ٖ\xa2\xa7\x92o\xcfȥ\x17\v\xdd\xe8s\xe9\xe5B\x9f\xe9{\x02=\x00\x00\xc0\xe6i\\Py\xcb\xdf\xe0\xc5\x05\xb0\x82\xda\xeaA\x15\xa9\xcd2\xf5\x9fJ\x81\xec\xdd\xd1(\xefZ;\xb6\x14\xd5/\x00\x00\x00\\D1F\xb9\xacQ.'\x17\n\xb5m\xa3*\xed\x8d\xdf\xd3_\x01,\x91[\xfe\x9c\x8b\xbb7\xa8\xf6t\xb2\x00\x00\x00XF\xaeܷ\x8fZ\xae-\xe5\xe3@i\xf6F\xf9\x89\xa2k\xd5\xda\xf9\xec\xd8\xfb\xe5o\xfa1\xde\x1fa#\xb5\xd5SV\xdbn^\xea3\x1e\xd3FL}\xcd\n\xf9\xee\t\x01\x00\x00X.\xb1\x1b}'\x95YR'\x12\xa9\xceG\x8b\x13Xi\xe1\xa9?\x10s\xcd\xfb=\x00\xeb\xc8\x05\xabc\xa6N\x7f\x83\x93\x8b\x03\xd8\f\xd7\xff-W\x8f\xaf\xe9\xe3\xda\xf2~\xdd\xf7\xf7$\x80\x1d\xc0k\x91y\xb9\x14\a\xdb5U\xa6\x98\xd8\xc6֥\x8a\xb4\xd7>\xaeç\xfe\a\x82d\xb1\xf1\x8e>\x92\xe2\xd1\xdf\x19\xf3x`\x0fk\x1aib1sMWc\x8fNi\x00\n\x00\x00\x80MS\xa5\x196\x00\xac$\u05fe\xa8,\x94R[Z\x1d\xa7\xb5%H]\xe3v\xb0s\f\xd3\n\x00\x00\xb0v\xa2\x95\xfd\xdc\xdc\xd6Vֳ2`\x8c\xad\xf2\xe2-\x1a\xdc\xf4\x83\xb4nc)\rv\xbfB!\xb3\xfb6#\xd0\x03\x00\x00`\xe9\x94\xd5b\xdd\f\xe5\x9aL\xb1\x9di\xa6\xa0\xa9\xbbBUy\x87\xfc\xdb~\x96z\x066\x96\x0f\aʬ\xee\xdd͌\x96:\x90gA\xb1\x8b,\xc8\xe5\xbb 1\x00\x00\x80%\x94\x82\xd8cc\x85\x99\\m\xb1\xd5\x1f\xc4*\xab\xeaO\xa5\xcf\xc5\x0e\x80\xb5\xe4\\Tk\xcf\xf9`\xc8\xc0囦=\xfe\x15r>\xf5\xfb\xcc\xedFX\xf4\xe3N\x03\xdb/\xa451\xa8\x00^\xc5\xf9&\xca6Ks\xb2K\xd9\xdc\xea\x01ގ\x0f\xbby\x9e\xe3\xb9\x1f\xeb/\x006ב\a\xb2\xb7\a\xf7+K\x1d\xfd\xb2\xc22\xf9Ũs\xddG\x1b\x17\xa3\xe7\x02\x00\x00l\x988 \xd8\x15XU>\r\xce\x15\xb6\xe5\x9aR!M˞\xa7\x8eT\xfd(\xad)\xc0\x1d\x00\x00\x00k%\xc4\u008azA\xaa}\x17Ȟ\xe5Q\xe3\xf1\xdb\xfb\xb3\xc0\x12\xba\xf6\xef\xb8l\xf86\xdb \x90\x1d\x00\x00`٤\x1e21\xb5#ǁm\xb5i\xfe\a\xb5nK\xd9\xf8\xcb5z\u05fd\xe7\xbb\xc1\x01\x1b)\x97\x93sC\xb94\x88\x9c=\r1\xab\x15\xbb\x8d\\\xf2\f\x12\x0e\x00\x00\x96O\n^\t\xc1J\xf9i\xc9\x06Vl\xa1\xcf\xc8:\x98\xcf\x1eXT\xde\x00\xac\xad.\x90\xddU*G_\xd4\x1f\xc1\xa6\x18\xde\xfaw\\\x8akK\x03ФƇ\xae\xdd\xc1\xbc\x14\xcc\xeeh\x9e\x03\xf0\xca\xd2\xc4\x17]91M\x84\x96y)\x9f(tq\xb1'\xba\xe3\xe3\xd9\xfd\xd2\xd3\xdfΈ\x18\xd8hG\x1e\xc8\xdeT\xa7\xba\x01&҈5]\x06\x9f\xf2\xf6\x94\xc1[\xa6\xef\xc8\xe8\x01\x00\xc0\x06r%\x81\xec\xc0J\v\x03\xc5P\xc8Y=\xa7\xab\xda\xc4`K:~\xe4\xd5/\x00\x00\x00\\dN\xa5\x82\x95\xf5\xda\xc6\n~!\x97˲\xf4\x86\xbb?\v,\xa7<\x7f\x8b\x02\xaf_\x00\x00\x00\x96N\x1b}\u05fe\x9cBv\x95Ջ\xc9Z\xb2]\r\xee\xfc!Jo\xd8h/<\xf1Ǣs\xe9\xfdi\x1a\xe8!\x05\xadG9\x17Ԟ\x1fH\xb8\x9f%\r\x00\x00`\x99\xa4>\xf0]0K\xea0\xe2]7\xc3+V_\xdb<o\x1f\xfd\x0e\x80\xf5\xe4}\x17Ȯ뿋\xf6\x98M\xb4h\x90{\xa9\xad\xe1|0{\x87\x89Z\x01\xbc\n\x17b\x97L\xc4\xd4w\xc8\xea\x02\x8d%#M\f\na,\x85\x1d\xf96\xaa\x9e\xfex\x7f5\xb0\x99\xdcK\xa3\xc3\x1c\x95\x0f\x8f\x8e\xf8\a\x00\x00\x00\xb8\xbcR\xf9\xcb\xf9\xa1\x9a0WV\xa6ѷ\x9cT\x15r\x83\xa1\xd5X\x0e\xf4\xfc5ߪ\xabo\xfe\xcb4\x82\x01+\xe6\xf0\xbewǭ\xbd\xfb\xfa=\x00\x00\x00l\x84X\xa8\x19\xec+\xb7\x1a\\<\xf0\nW\xff2eo\xfe\x8f\xd4\xe7\xb0\xf4\x9e\xba\xff\xad\xf1\xaa\xf9\x03*\xeb]\xbby\x0f%ߪnoP\xebk\r\xdc\xf3r\xd5Vw\f\x00\x00\x00\x17QvL\x9a\xefIE#\r\x82\xaa\xb6\x95\x0f]غd\x87\x14G\x8bN\xb2\xc5P\xb3\xfa\x94\xf6\xddI]\xf5E/R\xbf\xc0\xc6k>{u\xcc'\xfb\xfd\x1e\x00\x00\xc0jhc\xaeL\x95Ҍ\xec\x93q\xab\xf1;\x19^t\x1dL?\x92ő+\xed{\xed\x0f\x00X?S\xa7\x17v[]\xf5\xee9\xe9\xf6\x86z\xfe\xb3.^}vK\xb2\xf4\xbe\xc9\x1aŝV\xcdt\xa2Q{\x85\x1d;\xec\xaf\x02\x80\xd7\xc9ғ\x10\xa3\xfc\xfb\xd2L\x19\xc0fbJ@\x00\x00\x80\xcb,\xa6aYӠ}\xa93R7C\xb3\xd5GRO\xa58\xb7\xed\x86 v`E\xb9\xb6\xee\xb7\x00\x00\x00\xb01\\\xde\x05\x9d\xc4\xd9@\xcdx\x87 v\xac\x8c\x9dݯV\x9d\xfaPVv\xcb6\xa3n\x06\x99\xa8\x17\xe44W\x8c[v\x85\x9d\x04\x00\x00\xc0\xc5U\xedu32\xa6\x99\xd7c\x18ʵ\x03[RY\xcc\xca_\xc1֣\xa9\xad\v\xc5ɋ\xcaF;\xba\xf2\xe6o\xe9\xff \xb0\xd9bH#=\x00\x00\x00\xac\x18\x97\xe6yK}\xc2\n[xu\xb0\x16\x9e\xfdS1\xd7\xc0\n\xa8\xcc\xe1\a\xac\xb5\xcc)/\xae\xeaw\xb0\x89\xb6\x87\xbfJm\x91\x06\x02\x8fʳ\xa80\xabU8\xcb\xcf\xd3\vU\x00\xb8@!:KV\xb6\xa4\a\x7f-\x85Il\xac#\rd\xaf\x9e\xfc\x0e\x1e>\x00\x00\xb0q\xda4$\xabk\x94)ʵE\xf7\xde\xc2e\x95}\xcc\xd5F\x1a:\x80U\x95\xd5i0\n\x00\x00\x00l\x14g\xf5\xbbz\xa8:Vjw~E\x7f\x10X~\xbb\xd7~\xaf+\x8b\x9b\x14\xd2k\xa2֖\xe8\xe5}\xd5\x05\xb2\xa7 \xaa \x06\xea\x02\x00\x00\xb8\xe8\xf2F\xa1pr~\xa0\xd0\f\x14\x9bB\xaeͭ,VX\x19̎\xb5\xbbj\xdd\x19\xa5\xb8\x88b\xf7w\xca]\xf3\xe7\x89v\x01\x92\x9a\xf7\xa7\x00\x00`\x155V\xd6\xcfl]*\xfa\xd1\xe2\x10VZ3\xfd\xb0\x16\x81\xecTՀ\xb5\x96e*\ao\xe9w\xb0\x89\xc6o\xfawn\x9e\xb2\xee\xec\xa0\xeb\x0f\xe0f\xb5\xf2./\xa7}\x02\xc0\x85\v\xa1\xb4\xcfV\xcd\xde\x7fX\x1c\x006Б\x06\xb2ϧ\xf7\xf4[\x00\x00\x00\x1bƵ\xb6\xb8\x97:\x8b˷V5\xa9\x15\xfcv\x7f\x01\x80U\x93ד~\v\x00\x00\x00\x1b#N\xa5\xca+\x0e\xa3\x86o\xfa1z.a\xa5\x14'\xfeke\xe3\x1d)ۓ\\\xa9\u070e\xa5\x97F\xa9\x0f^H;\x00\x00\x00\xb8\xb8\x06A\xbe\xcc\x15\xad\xe4\x15[\xdbv\x8d|^\xa5QR\xa5\xbcU\x98\xe4\xaa\v+\x9a]\xf5k\xa5[\xbe\x97\xfa\x05\xd0\xcb\x1b:\x8a\x03\x00\x80\x15\x94\xfa\x86u-\xae\x99\x82\xae\xe9\x0ea\xb5ͦ\x1f\x93\x8b\x85\"\x81\xec\xc0zsAeyw\xbf\x83M\x15\xb7ߪ&k\xa4&W\x19\aR\xc8Ԋy\\\x01\\\xb8h\xf5\x02\xf9C\xe5\a\x95\xf4\xd8W\x93\xa0`#\x1di \xbb\x9b?\xdco\x01\x00\x00l\x10\x97z\x83\xa7\x99\xcd\xd2ȻV\x1cs\xae\xeb$\xde\xc4\xdcN]\xdf]\x02`\xb5\x84g\xbf-fM\xd5\xef\x01\x00\x00`c\x04\xab\xdb\xf9F\xd9\xce{\xfa\x03\xc0\n\xb9鯸\xb8}\xb3\xaa4)P\xeaS\xd9\xeeʵN1L\xd3d\x13\x00\x00\x00\xb8Ȫ\xb6U\xd34j\xbb\xb6\xe4\x99\xf2\xc1\\JKvh\xf5\x8a\x99|Vk\xb0\xfb\x9b\xa5[\xfe\x05Q\x11@\xaf~쿋Ξ\x1d\x00\x00\x80U\xe3\xd2\x04'iR\x13\xd7ʻw.\x0eb\xb5\xcdO\xd9G\xb4\xef\xf4H\xc3/\x00\\bin\xaa\xe2\xe6\xbfN\xdb̆ۺ\xed>7\x1f\xd8\xc6<\xb3\x1b\"\x05\xb2W\n\xf6\v\x00.T\xe6c*JJ\xfe\xb8\xc2\xc1\xbf\\\x1c\x046\xcc\xd1\x06\xb2\xb7\xcf\xf6[\x00\x00\x00\x9b\xc3\xf9R1\xa6\x0e\x17)\x98ݤz\x89-m\xdcV>\xbccq\f\xc0J\x99\xd7\x1f\x91¼\xdf\x03\x00\x00\xc0\xa6\b.S\xb5}\xa5\xf2\xdb?Fg\x06\xac\xa4v\xf4K\xd5\xe4ۊmc7t.\xd7zE\xcd\xe5\xbaA\xf8\x00\x00\x00p1\xf9\x90\x16\xaf<:\xe5\xa9Ӛ-\xa1\x95\x0ek[ڡ\xfc\x89_.\xf7\xe6\x1f\xa4n\x01|\x9e0\xf9\xe8\xe2E*\x00\x00\xc0\x8a\x89V\xf6W֨\xf53m\xdd\xf5O)篁<u\xf5\x8b\x93~\x90\x02\x00\xeb*\xc5-\x03I\x1c߭&\xb34\xdf[\x9e\x1e\xa3\xa2#\x90\x1d\xc0\x85\xf3V/P\xb3%\r-I9\xb4\x03O~\x03\x8d\x9e\xd88G\x1aȞ\xb7{\xfd\x16\x00\x00\xc0\xe6\xf0n\xa0\xd0U=R\xa3F\xdapݖ\xd3q\xab\x9cܕN\x00X1Ms\xaf=\xd2M\xbf\a\x00\x00\x80M\xe1\x8a\\a\xeb+\xfa=`\xf5\x14\xd7}\xb7\x1b\x8e\xbfJ1\x8fvC\x9f\x93O\xfd\xefB&\xb5\xe3\xc5\x05\x00\x00\x00\xb8h\xd2PA\x99\xbc\xbc\xb7\xf2V\x1a8\xa8\x95\xa6u\x1a\xe8\xf8:\x8dv\xde'\xdd\xf1\xe3DC\x00_ V\x0ft\x9d\xc5\x01\x00\x00V\x8f\x95\xf93+\xf4\xe7L\x8a\xb0\x16\x9e\xff\x93q\x10K+\x9b\xa6@vʧ\xc0:\x8b\x03\x06{\xc6\xc2\xf6m\x9fqa\\K\xcd\xc4n\x8cB\xc1\x93\xa7\x03x\x03\x824ӖT̕\xd5\x03\xe9\xe0C\xfd\t`s\x1ci \xbb\x8f\xb3~\v\x00\x00`sD\x97\xfd\\\x7f\x8b4B_\x9au\xa3\xdb\x1fK\xb7~7\x9d\x94\x80\x15\x14Ë\x8b\xe7\x19\x00\x00\x00\x1bŕN\xc3\xdb\xfe\x1e\xf58\xac4\xff\xd6\x1fq~`\xb7q\xdeJY\x90\x0f\x85bU\xf6g\x01\x00\x00pѤ\xb1P\xdbЯ\xbd\xad\v)\xech{\xfb\x0e\xf9\xeb~Yw\t\x80\x9f/\x863V\xf9\xa6\xda\r\x00\x00VO7#\xbb\xfdNcXa\xf55\xcd\xe3rqw\xb1Ӎ\b\v`]\xf9\xb2\xe8\xb7\x00\xbb\x1fƭTU\x96\xa1\x0f\x15S_o\x00\xb8P\xed@m\xe64\x8d\x96\xae\xf8R\x9a\x1cH/|\a\t\v6ʑ\x05\xb2\x9f\xbb\xef=\xb1H\xb3z\x00\x00\x00l\x98\xac\xddW^\xefv\x13\x9c\x85a7Ն\xf2\xf9\x8ef\xf9\xe7\xfa+\x00\xac\x9a\xf2p.\r\xa8\xdf\x00\x00\x00\xac\x9d\xf6\n\xa9\x9bYcֽ\x9c\xae[\xafYk\xf58\x7f\xad\x94\x06j\xbd{Bo%\xac\x85\xfa\xf8WKS\xdb\b\xf6Q\xcc\xe4\xb6\xf7\x17'\x00\x00\x00\xf0\xda\xe5\x99\x1a\xfbU\xc7Թ5uFK\a\v\xab;\fm\xbd\xab\xb9m\xce\x06\xb5\xc2\xf6Y+w\xd9zVh|\xfcm\xf2o\xfb\x0fN'\xfe\x1cu\v\xe0e\fR\x90P9\xee\xf7\x00\x00\x00VG\xde\x05\xbd\x8dtZ\xd7\xf4G\xb0ʚ\xfd\x0fi6\x9c\xaa.\xbc\xdc\xec\xc8\xc2/\x00\\\f>\r42T\x1d\xa2b\xd6\xd8\x12\x14Cn\tw\xaa{\xb6\xda\x1f\xfc\xf6\xc5u\x80\x99\\\xf1{u88&\x85\xe75j\x8e\xf7G\x01\xe0\x02\xc4C\x8d5W\x9c\x8d\xa4Qʋ\xce)<\xf7\xff\xf4'\x81\xcdpd5)\x17\x1b{\b\x198\x02\x00\x00l\xa0\xae\f\x14\xba\xc9\x03\x9cK\x81\xafAʼ\x8a\xe2\xaa\xee4\x80\xd5\xc3`\x9b\x00\x00\x00몲:\x9c\xd5\xd9RHI<\xab\"s\x1a\xfa\x81\xea\xf0\xb4\xe2\xf0\xd6\xc5%\xc0\x1a(n\xf91\xa7c\xd7u\xb3\x83f!W\x9b\x82\xda\x01\x00\x00\xf0\xba4\xcd\\\xce9\xe5y!\xf9\xcc\xea\x10Ϊ\x13s\x05+\\\xc5\xf6\xc0\xd6;\xcaC#?\x1d\xa9-g\x8aW\xbcC\xee\x8e\xffB\x00;\xf0\xaa\b\x12\x02\x00\x00+*\xb3z\x81\v\x1a\x94\xbcKX\a\xa1\xa9Ԇz\xb1\x93:\xfd\x01X]i&\\{\x8c3\xfb\x15c\xaas\xa6\x8e\xbcvL\x95\xed7\xba\xfa\xe6\xbf\xccC\x8e\x97\xec^\xf9W\\q\xc2\xee\x956u\x10M\xf7\t\x00\\\xa0XZ12\xca\xe5\a\xb6]u}3\xda\xea\x85\xfe$\xb0\x19\x8e.\x90\xbd\xb5\x87.P\xc6\x03\x00\x00\x1b(5~\xb9V\xae\x8b\x84HRPD\xa6\xb2|k\xbf\x0f`\x95\xd4\x0f|c\xccC\x9e\x86\xa7\xe8\x8f\x00\x00\x00`m\x84\x89Z+\xe65\xd915Vu\x93\xab\xbaQ\x8c&Y\xa3p\xec\x1b\x16\xd7\x00\xeb\xe2\xf8oPH\xb3P(\x97\vi\xe0=\x00\x00\x00\xbc\x1e1\x06y+O9W\xd8^\nd\x8f\x8a.\rf\xdcX\x11k\xa6\x81\x1b+\xafK۟ʍ߮\xec.\x82\u0601W\xf5䟵\x87(\xb7g\x89\x8e\xe2\x00\x00`\x05\xe5\x99\xea\xd8h<~_\x7f\x00+\xad\x9dɷq\xd1v\xee\x98\xed\x02Xi!\x05\xb27\x8b`¶\xe8\x1e阥 \xf6\x99\x1a\xf1|\xe3\x17*w\xbfR\xb3\xd4\xdc\xd7\r|\x00\x00\x17\xaa\x94|c\xd5\x04ˇ\xd2\x00I\xf5\xf5\x8a\xd5\xf3\x9a>\xfa\xcb\xc8|\xb01\x8e,'\xcd\xc2\xcc2\xf2~\a\x00\x00`\x938+\x82YE䥢XH\xdbN\xe5\xe0]\x8b}\x00+\xa5\x9a\x7fX>n)ґ\n\x00\x00`\r\xb5\x8aީv\xd9b\x82\x8d\xf42)̔\x8d\xbfX\xd9-\x7f\x8e\xa0\x13\xac\x97\x1b\xff\x86\xf3\xdbi\x90\xbd\x99|\xbe8\x04\x00\x00\x80\u05ee\xf0VoH\x03\x9e\xc6h\xf5\x06\xfbm\x9b\xbe\xc8\xe4\av\xb4\x88\xf2\xcd9\xaba8\xb5[\xd7\xc9\xdf\xf9i\xea\x13\xc0/&\xbc`Kz\x9fJ\a3\x00\x00\xb0\x82\xac^PYY\xa6\xb8\xe1\xfb(\xfb\xaf\x81\xac\x9d+\x8bA.\x051\x1eY\xf4\x05\x80\x8b!tu\xccfц\xd3\xda\x03mϵ\xf3\xadZ\xd7X\x15t{q\x11\xf0\xf9\xae\xfd\xc7N\xc7lM\xf3\x04\x807\xc2\x15\nq\xa2<\r\x84۽Cp\xcaC%M\xfes\x7f\x01\xb0\xfe\x8e\xa4*U\xbd\xf0mѷSۢ&\a\x00\x006PL#\xb3\xa6\xe0\x87\xbc\xab\x84\xb4iF\x8e\xd4(v\x13/.\x80U\xe4\xeaǺ\x06\xed\xc8\x13\f\x00\x00\xb0~\\T\x96\xa5\x97I\x87\xf2\xee\xca\x14\u05ee֍\xb5}\xe7\xffK\xe9\x0f\xeb\xe9\xf8oV\x9b7\x92\x0f\xfd\x01\x00\x00\x00\xbcf.\xeb\xde\xfb\xa8m\x15\xac\xf2\x90fgO\x83\x1b\x87\x98\xabis\xc5z\xa6\xf6\xd8\x15\xca\xeez\x9a\xfa\x04\xf0\x1a4\xf191#;\x00\x00XYa\xae:\x8e\xfb\x1d\xac\xb4'\xbe5\x96\xaeUiu<\xd7\x05\x1eQ>\x05VYPf\x9f\xb5d\xcfu78\x85I\xabF\xb9\xb2\xf2\x86n\x1f\xf8B\xe5\xe8k\xd5\xc6I\xbf\a\x00\x17 kմQ.\xecX\xc6cyQ\xf6\xbc|^(\x9f۹\xfb>\xc8P\x19\xd8\bG\x13\xc8>\xfb\xac|\x9c\xd9V*\x04\x02\x00\x00l\x96\x98:2%\xc1+vA\xec\xa9!\x8cr\x11\xb0\xaa\x8a\xb6Y\xbc\xa4r\xbc\xa8\x02\x00\x00X;Yz\x89\x94\xdbj.\x97媳\xb1\xe2\xd6\a\xfa\x93\xc0\x1a\xba\xf1;\xdcl|\x9b\xea\x86\x0e\x96\x00\x00\x00\xaf[(\x14S\x10{l\xe4\xad.!\x1fմ\x99\xe6U\xa9\xaa\xdaV{\xe2&\x95w=E\x10;\xf0\x1a5\xeds\xdd\xfb\xd4\x14f\x00\x00\x00\xb0r\xdc\\ʮ\xeew\xb0\xca\xea\xeasr]\xbf\xa0Tͳ\xf2i\x9aA\x13\xc0\xea:? E\x9a\x8c\xcaكm\x8ft71\xaev\x94\x0f\xef\xec/\x02~\xbe\xec\xa6\x1fuM\xc9\xfbS\x00o\x80\v\x8bn\xe6\xdd *C\x85\xcc\xf2\xa1<W\xd1\xee(\x1c\xfcdw\t\xb0\xee\x8e$\x90\xbd\xad\x1e\xb3\x8a\\\x1a2\x82\xf7s\x00\x00`\xf3\xa4\xe0\xf5\xae\x18\x14s+\x8c\xb9n\x82\x0eW\x14\x8b\x93\x00VN\x11\xb6\x94F\xd2>?F\x05\x00\x00\x00\xd6HjA\xaf\xa4a(ո\xe7\x14FoQ\xfe\xb6\x7fC\xc36\xd6\xda֝\x0f;\xe7\xaf\xec\xf7\x00\x00\x00\xf0\x9a\xc5L1\xcdȞz@\xfb4+{T\xddze\xfe\xb8\xc6۷(\xbf\xfbq\xea\x12\xc0\xeb\xd0ĳ\xdd\xfbT\x02\xd9\x01\x00\xc0Jʤ\xa2`f\xdfu0\x9b=,uAGi/u\x0e\xa2|\n\xac2\xa7\xb2\x1f\x8f\"=˩/\xafSk\x9bNǥ\xd1\xdb\xd2\t\xe0e\r\xc6_\xdao\x01\xc0\x05\x88\xb5\xbah\x11wV\xc1\xe5j}n\xeb\xda\xf2\xa4\x81eI\xfb:\xf7\xec\xef\xedr'`\x9d\x1dM {s\xda\x1e@*q\x00\x00`3E\xe7\x17\xed\xda]@{&o%2W\xa4N\x18\x00VM\xf3\xe47G\x85\xf1\"\x90\xddц\x00\x00\x00\xb0n\xa2\x0f\xe9]\x92\x95\xf7Fj\xad\xbc\xe7G7/N\x00k.\x1f\x9d\xec\xb7\x00\x00\x00\xf0\x9a\xb9R\xceer>\xb5\x15\xb7\n\xa1\xb5JE\xaerhe\xab+n_\\\x03\xe05\vqj\x9f\xa9k\x1b}\xcc\x00\x00\xc0\nʤ\xbc8\xd1\xef`\x95U\xf5\v} {\xaa\xe3\xb9E\x9f?\x00\xab\xcbg\x9f\xf7\x18\xa7\b\xf6\xc5N\xd4X\xba\xe9/0\b!^ٝ?\xce\xfd\x01\xe0\xc2\x05\xcbo\xdc\xd8\U0009b98f%\xb9B\x95m\xb7\xb1\x91\xf7\xb9\x0e\xf7?\xd4_\b\xac\xaf#\tdߞ<k\x15\xba\\\xcdp\xd2\x1f\x01\x00\x00\xd8\x1ci\\\xd6n\xf2\x80,\xd8\xc69\xb9\xf6\x1a\x1d\x8e\xfe\xab\xee\x1c\x80\xd5\x12\x0f\x7fF\xd5\xd6)5\xf91\xf9yzk\x05\x00\x00\x80\xd5\xd27\x91;g\x9bq\xb1\xb8V\xd1U\xddr\xae\xbdZn\xbb\x96\x1a\xab\xbb\rnT\xf1\xe6\x1f\xe3\xe546\xc3\xdb\xfe_\x17\xc63\xdb(\xbbg\"\xadg\xd9LS;\x16\xbd=\x06U\x1a\xe1\x01\x00\x00`ÔW\xcbj\vj\xad\\\xa4\"\x95\x95*u\x81\f\xde\xcaFvl\xe6\x9fW\x9dWV\xbdؑf\xb9r+3\x95;\xdb\xd2\xdb?\xeet\xdd\x0fS\x97\x00^\xa7\xdd\x17\x9f\xb2\xe7mϞ7\x1e\x1f\x00\x00\xb0zB\x90\x06o\xfe\x11\n2k`\xb7h4\xaf\xae\xb5/t\xa8֝\xb6\xfa\x1f\x13\xd6\x00\xab,\x8b\x87\xf2\xe1\xb8\x1aK\xa1èV\x1a\xa0\xa2\x9cn\xa9\xce\xef\xe9\xaf\x00^\xc5\xce;4\xf5\xb3\xae-0\xf55H\xb3*\xab\x9c\xdb\xda\xf6\xc9\xf5\x01\xbc\x9a\xae_R\x90k\x87\xca\u0081\xcapN\xc3f\xa8<\xa5\x1f!\xd7\xf5\xb3\xfb\xd5<\xf2?\xbe4\xd4\n\xb0\x8e\x8e$\x90=S)\x1f\x191\x17\x00\x00l(\x97\x1a\xbflm\x95\x8e\xa4qSm\xdd\xfa}4a\x00+(4\x13\xb5mk\xcfu\x1a\x9d\xb5?\b\x00\x00\x80\x15\xb2h\xa3\x8e!tK7\xfc\xbes\xf6;\xb7\xe2]\xa6]?Q\x98\a5;Ry\xd7\x13\x94\xf8\xb0Q\xfc\xf6\x1fP5pj\xebT\xe7\xa94\f\x85\xca鶜ol\x7f\xdc_\x05\x00\x00\xb09\xea\xc9Y5Mcu\x05\xab\x1aġ\xd5!\xacL\x14\xb6lۖ\xb6\x90\x8f\xa5\xca\xd2\xceY\xf1\xa9\x9a\x17\x9amߠ\xecm\x8fQ\x8f\x00\x00\x00\x006P\xf0E\xbf\x85\x95\xf6\xc8\xff\x14\xd5d\xf2\xf9b 3*x\xc0\x1a\xe8\xa6c\x0f]<\xa1siZ\xaa y\xf7\xff\xb1\xf7\x1f\x80\x96e\xf7]\xe7\xfb[k\x87\x93n\xaaܱ\xaaCuR\xe7Vp\xf6\x8cq\xc2\t\x0f\x06\x83\x13``\xc0&\x980\xc0\x10\x06,\x1b\xb0\x01\x83\r\x8f1 \xc0\x18\fol\xc6~0\xb6\a\x8c\x13\xb6q\xc0\x01\xc9A\xb2Z\xa1%\xb5\xba\xd5\xdd\xeaT]\xe1\xde{\xc2\x0ek\xbd\xff\xda\xe7T\xab%\xb7䮪[\xb7\xee\xb9\xf7\xfb\xe9\xdeg\xc7snK\xe7\xec\xbd\xd6\x7f\xef\xf5_KEq\xac\xdb\r|B\xa3/\x96\x8a^\u05ff\xa54\xb0\x1f\xd1LU;\xb2ߒ-\xa7\xbe\xc1\x01\xe0rU\x99\xaa\xe6\x97\x17+\xc0\xfe\xb4\xfb\x89\xecO\xfc\xc9\xe8\xbb\az\xde\xea\x80t\x14\x01\x00\x00\x0e\xa2\xa9\xba!\xd9\xdb^\xb7V\xfb\xee\x8e\x06\x80%\x14\x9bm\xb9&\xc8u\xb1\r=.\x03\x00\x00,\x1d\xe7\xba\xfbԡKd\xbf\xd8\xfc(\xcd\xe7\x93o\xa7\x16\xb3Mծ~F\xb7\a8PN\xfd\x7f\xdcl\xf8\xb0&y\xea\xf0a&5}e\xf5\x9a\x05B=\xb5Yj\xd8\x03\x00\x00p\xc0\xf8\x99\xf2\xc2˻~W'\x8a\xa1PH\xcf{BfqE\xa925X\x9d\x0e\xa5\xb6R\\9\xa9\xfe}O\x91\xe3\x00\x00\x00\x00\x1cP\xb18\xbeX\xc22\xab\x9bw*6\x85\xf2l;\xad)*\xb5\xf7\xbb&\xe3\b\x02\xd8)i@\xce4\"\xeeb5uR!\x97\xa9,\xef\\l\x00>\x81\x9b\xbf\xd1eû\xa5f\xbe\xea\\Ԭ-\xe5\xfc!\x12\xd9\x01\\\x99:S\x98\xfd\xeab\x05؟v=\x92\x9aL\xdf!\x17\n\xb9nDv\x00\x00\x80\x03(\xab\xac>ԓ\xdaLid\xf6&\x9f'\xb4\x03X>Y\x1c۔R\x9c҈\xec$r\x00\x00\x00,\x1d\x97\xeaq\xa9\xc7\xfdES\x85t\xdf:M\xc1jx\xad\xcdg3\xe5kC\xf5n\xf9Y\x12Pp \xad\x9e\xfei\x17W\x0e\xd9y\x91n`\xd8\xe4\xcf*\xb6}5\x05-1\x00\x00\xc0\xc1S\xe4R\xe6ӳ\x1d\x8b\x15\xdaV\xc17R>\xeb&W\xd8r3V5\xad5\xeb\x9dR\xef\x91w\x11C\x00\x00\x00\x00\aX]ܲX\xc22\xab\x9aw\xca\a'\x97\xcd\x14C\x90O\x1d\x98\x11\xed\x01\xcb\xcdy\x9b\xea\xb40_\x0fM\xb7-\xef=8_\a~\x1b\xe5\xf0\x8b\xa4\xc2\x16\x9a\xb3]n\x9c\xf2\xb1b\xd7v\x94A\xcd\x00\\\x89J\xf9lK\x9bO\xbc1\x8d\xac\x06\xecKV\v\xdb]\xf5\xecIY$g\x81\\\x94#\xd1\x03\x00\x00\x1c@\xb1\xbb\xffe\xd50\xab\x13\xa5{a\xbe8\xd9m\a\xb0d\x9e\xfc\xf3\xb1p3\x15v\x1e;\xdfJa\xd7\xc3+\x00\x00\x00쀔ĞrQ\xbcO\x8d\x16\xd2=k\xaf\x18ӈ\x8a)`\xeb+[\xfb\xea\xf9\x81\xc0\x01\xd5[\xffݚ\xa6\xc6\x18n\"eSE\x7fVm\xea\x99\x0f\x00\x00\xe0\xa0\t\xa1\x9bB]\xab\xd1TY1\x91\xefm+\xe4c\xb5~&\xc5Z\xe1\xf0!\x92\xd8\x01\x00\x00\x00(\x94\xaf[,a\x99\x85\xe6iei\xb4\xe6\x8b\\\xa1\xe8\x16\xc3\xf0\x02XN1\xb3s\xb9\x92\x0f\xb9\x9d\xe4Nm\x8cjSC\xde\x1b\xffO\xee\xe7൹\xe1[\x9c֎\xccۀǞ\x8abj\xf3m)\xb7u\x00\xb8\\^\xea\x05\xaf\xb8\xf5\xd6\xc5\x06`\xff\xd9\xf5L\vW[\x01\x1d+[h\xe4b\x7f\xb1\x15\x00\x00\xe0\xe0\xe8\xf2\xd7\xd3\xfd\xed\xaeQ\x93T\xf4\x1e\x9e\xef\x00\xb0T\x9a\xea\xddR\x98ڒ\xb7\xd8&%q\xe4\xddv\x00\x00\x00,\x91E\acJ#\xb2\xbf<*\xbb\xb3M^\x99/U\x1d~\xbdt\xfd\xbf\xa0\xd1\x02\x0e\xb4\xf2\x86\x7f\xe5\xe2\xca#j\xfav*đ\\\x1a\x8d=\x96\x8b\xbd\x00\x00\x00\aH\x8a\x19\xd2h\xec\xc6g\xad\\ިn\x83&S\xa9\xaa\x06j\xd7N\xaa\x7f\xdfS\xc4\x0f\x00\x00\x00\x00\xb4r\x1b\xcf\x16\xf6\x03\x17*\xb9\xd4Ư)\xe4\xe2\xbc]Pp\x8c\xb8\v,\xb3\x90R\xa8\\T\xbc8hMw\xbb\x87\x01:q\x89\xd6?O\xb3\xf4\xb84\xb6\xea\xa7|\xf6\xb0y\xf1\xb6!\x00\\\x9eT<\xb9L\xe5\xb6T\x7f\xf0\v\x18Y\x00\xfbҮ\x17\x95\xbeM\xc3vLm\xc1\x02;\xd1\xd0\t\x00\x00\x1c<\xce\xcdo\x84ɧ:Q\xa1\xf2\xf6\xef\xe1\xc1\x05\xb0\x84\xa6\xb3\xf7\xa5;\xdbs\xe9\x9cfDv\x00\x00\x80\xa5\x13\xbb\x0e\x89\x16l9嵧Dve\x85T\xf6U\xde\xf3s\xc4k\x80\x19\x9c~\x9bk\xfb\xb7(\xb8`\xe1\x8fWNC=\x00\x00p\x10y\xdfM\xce9y\x97+*W]\xe7r\xe1\x06\r\xfa\x0f({\xdd\x13\xc4\x0f\x00\x00\x00\x00\xb0O\xb4O\xfd\x8d\xe8\xdbR\xca\x1a\xa9N\x83\xf7\xe5J\x03\xf9\xcdG\xb0\x01\xb0\xf4B\xd65\xf9\xeb\x12\a\x8b\x94\xe3\x04\\\x82\x1b\xbe\xd7\xc5\xd1\x1d\xf6\xe3ٔ\xa6\xeb\xf2Mc?\xa9\xdeb'\x00\\\xbaFv\r\x89^\xfd\xb6\xaff\U0008b2ed\xc0\xfe\xb2\xab\x99\x16\xe1쟋YJ^\x8f\xb5\xad\xa5 \x8e\x11\v\x01\x00\xc0\xc1\x13RRD\xca~\xf5\xa9\xd17=9\x02\xcbj6{q\x1eքh1N\x1a\x91\xf0\x15IP\x00\x00\x00X\x0e.u\x8f\x9e\xeasQ\xc1\x16CzI\xb7ͻDv:b\x05^\xa9-\xee\xd0̍\xa5\xfaN\xe5~k\xb1\x15\x00\x00\xe0\x00I\xf1B\xdbv\xb3\x18\xbdb(\x95鈆û\xa4#\x9f\xba8\b\x00\x00\x00\x00\xb0\x1f4\xe1ie\xb1\xdf5\xef\x8bMa/\xb9\xa2k\xbb\xfe\x90\x01,\xaf\x98\x06\xa2\xba\xc8e\xca\xec\x1cw9\xedxq\xe9\xb2\xe1\x9d\xf3\x8c\xbc\xf11\xa9u\n.uz\x02\x00\x97\xa7\x89\xa5\x14lʝ\xdaY\xa5\xb3/\xfea\x1a\xa5c\xdf\xd9\xd5D\xf6\xc9\v?\xa7~\xf1\x82U\xfe\xa2\xaa\xf4\x97\xc3\xf6|\a\x00\x00\xc0AҌ\xa4*\x97ʨ\xe7{k\x8b\x8d\x00\x96MYX<\xe3W윶\xf3\xb9]S;\xb8\xb0\xd8\x03\x00\x00\x80e\xe1\xdaU\xb5\xbe\xaf:\xaf\xe4\xfbSe\xb9\xd3x\x12unt\x83\xf4\xba'i\x8a\x04\xbc\xc2\xf0\xce\x1fq\xd3\xeb>I[ͻ\xe5'\x87ԨV\xeb*5\xce)\xa4\xde\xc1}-\x15S\xc5|j\xdb\xd7\x17\xef\x02\x00\x00X\"\x83J\xb1KV\x1fY\xdd\xc6&g\xf5\x9dl\xaa\xba\xac4ɽ\xad;5\xd9\xcc\xe2\x86\x15\xb9\xe9\x86\xfcv\xab\xde\xfa\x9a\xf4\xc0O9\xdd\xf0\x1d\xc4\x0f\xc0\x0e{q\xa5\xa7\"5ެ\x19\xf1\x12\x00\x00\xec=\x93l`1\xc2TJ\t\xcey\xad\xdab\a\xd5\x167\xd8\xf6Yv\xeb\xe2(,\xb3\xe9\xf9\x9fP\xaf>#\xcdNh\xb2\xf2\x92Z7\x93\xaff\xf6u\xdbw\x0f`i\xa5\x94\xf5\x90n\xf3\xa4A\xd8\xe3y\xbb\x8e\xa7s\xfcK\xba}\xc0\xa5(N\xfd'\xb7\x95\x1f\x96\x06\xdb\xd2(S\x1b\xac.\x00\x00\x97\xa9\x9f\x9eG\xb4c\xbb\xa6\x04\x95q\xa2ѳ?\xb2\xd8\x03\xec\x1f\xbb\x9a\xc8\x1eC#+\x9d\xe5\x9c\x17O\xf0\x00\x00\xc0\xc1\x95F\xf83V!*\xca#\xf3e\x00K\xc7u#\xb1\xdbԍ\xe2\xd9\xc8\xd3\xf7\x1d\x00\x00\xc0҉\xf6O\xe6\xb6TTk\xd2\xd4[\xb8\x96\xa9\xb8\xee\x906\xee\xf8Una\x03\xaf\xe2Ѝ\xbf\xe4\x8aՓ\x16\a\x9dS\x1e{\xb23F\xb9J9\xf5\xecl\x1a\xda4\xe7c\xbdX\x02\x00\x00X\x1eq;WhS\xe2Ie\x15\x9aYw\xff\xd75\x99\xf2zE\xbdf5u㣼=a\xdbϨɟSs\xf4f\xe9\xae\xf7\x12;\x00W\x89w\xbd\xc5\x12\x0f`\x00\x00\xc0\x1e\xe4\xeay\x130\x8b\b\xd2(\xdd]\xcbx\x9f2#\x83\xca\xc1\r\xf3c\xb0\xdc\xd2}\xeeT\x15\xb5\xaf6%\xbe\xban(\xf6E\xbb?\x00\xcb+]\xbfӹ\x1d\xf2n\xb5q\x13\x8dn\xf9'\xdc\xdf\xc1e\x19\xf5?\xdf~N\xcfw\x83!\xf5\xf9\x15\x01\xb8\x12\xd1j\x9cy\xa58\xf3*\xd3\xf5\xa4\xa6s\f\xec?\xbb\x9a\xc8\xee\x1a;\x89Bk\x7f5\xb7X=#\xd1\x03\x00\x00\x1cH.\xd5\xc0REȂ\x8c\xb2\xb8m\xbe\x11\xc0R\x99<\xfe\xd51OϦ\xa2\xc57\x99M\xdd\x03Jz\\\x06\x00\x00X6\xa1\x98H\xed\xd0&\vЦG5\x19D\x15w}\x90G\xcc\xc0'\xd0[\xfb\x1am\xf6S\xb3\xbd\xae\xe9^\xb7ͩP\x8c=\x85\x90\xcd\xfb\xfbrM\xb7\x1d\x00\x00`\x99\xb8\xb8\xaa\xac(\xa4\"\xd5efj[\xab\xef\xb4=\xb9\xba\x90\xaf[\x15\x83`\xeb)\xc1}M\xf9u\xa7\x95\xdf\xf5\x18\xb1\x03p\x15y\xbfb\xaf$\n\x01\x00\x80\xbd)s\xadUU\xfa]\x1b\xb04\x0eB\xa6Q\xaa\xc0\xa8V#\xb7\xfa\xc0\xe2(,3\xd7\xd6\xe9f\xb7-\xd5\xdd\xccu/\xf6ew\t\xed\x00\x96\xd7\xd4\xce㼻\xe7\x93Ծ\xea\xe6\xc0\xe5pw}\xaf\x9b\xa5\x8c\xd3\xdaʌ\xc5sS\x00\xb8\x1c\xad\xd51\xa3O\x8f \xac\x8c\n+ʪm\x8d\xdf\xfd:2o\xb1\xaf\xecj\"{\x96z\x83H\xa3\xb2\xa7\xec-\x97\x1a8\x01\x00\x00\x1c<.\xdd\xd0vV-\n=\x95\xf9=\x8b\xad\x00\x96I=\xfbUe]\xdb)\x8bm\xf2\xa86\xd6rvN\x03\x00\x00`\xb9x\xab˩KNٖ\xfa3e\xab\xbfc\xb1\a\xc0\xc7u\xf3\xb7\xb8p賺\x0e\xc1\xbb\xc6>:o'\xd3v\u05c8\xaf\xb1\x18ɧF|\x91Dv\x00\x00\xb0\x84\n\x9bR\x8c`u\x99\xb6M\xed\xc3r\xf9Ծ%۲\xe9E\xc5*\xa8\x19\x9c\x93\x0e}\xaet\x92$v\xe0j+\xb2\r{mm\xda\xd5\xe6m\x00\x00\x00\xaf\x89w\xc1b\a\v\"|P\x17>\xa8\xb4\xf5\xa8*\xdd\x1f\xbd\xe9;\x88\x17\xf6\x81,\xa4dW\xab\x8b\xfaV\xcebC\x17R\xbdԾo\xea\xa7\xc0r˪y;\xbf\xf4\x8cخ\xdfMN\x9b?\\\x19\xb7\xf2\xc9V\xfe7RJ>\x05\x80\xcbԺF\xce\x17\xcaR\\Q\xdb<\xb6\n\xd3w-\xf6\x02\xfbîER\xf5\xd3o\x8eE3I\xf1\x9bI\x7f6\xc5\xe8\xf4\x9a\v\x00\x00\x0e\x1e\xe7\xd3\xe8\xcdQM\x1c\xa8\xb8\xf1\xef\xf3\xe0\x02XBY\xf8\x90\x8a\xeeaU\xde=\x94\f]x\xc3\xe9\f\x00\x00\xb0l\\\x18\xd8\xeb\x05\x9b\xacnwl\xa8\xf2\x96\x1f\xa2R\a\xbc\x06\xeb\xa7~\xdcM\xfa\xc7U\xe5vʤF\x9a\u171dF\x93.\x99\xbdM\x19\xeeiXv\x00\x00\x80e\x13\xadNӌ\xbbA\xd7\xd5\x14ʲ\xc5\xe8\xec\xb9m(\xa5ɶ-\x1e\xfe\"\xe9\x8e\xff\x1fq\x03\xb0\vr\xb7\x91\x02\xf7\xc5\x1a\x00\x00\xc0ޒ\xc9+vu\x95\xa8\x98f\xc1\xe6\xb1U\xed\u05fa\xfdXn\xcdc_\x15K]\x1c]\xb7\xb6\xd7t\xdf;\xb5\x13Jm\x84v-\xfd\x02\xc0U\x90\xfa\x1b\xe9\x9e\r\xa7\x8b\xb7-\xfb\xe2d\xb7\x1d\xb8\\\xbd;~\xce\xd5}\xfb15]/\xe0\x00pYb\x9e:Q\x1aɥ\x0ew\xd3@\xd2vI\xe9չ.<\xf6\x194\xbe\xc0\xbe\xb1k\x91T5{\xa7\xb2P-\xd6\xf2yC&ǳ=\x00\x00p\x10u-\xbc\x15\xe3\xca|\x15\xc0\xd2)\x1b\x8bmR\x97ڮ\xa7\xd6\xfeI\xbd\xe0ɥ֍\x00\x00\x00X&iD\xc5JCM\x0fmH\xb7=\xc3\rk\xe0\x12\x84C\x9f\xabпg\xde\xe0'\xb5\xf5\tSe]\xa3>\x9b\xe8\xe8\v\x00\x00,\xa1ַ\x8a\xf2r1\x8dz\x92I\xbeRc\x11\xc38\x14\x9a\xb85\r\x8f\x7f\x85t\xf2?Q\xd1\x01vI\x96\x1f\xb6\xd0\"Z\xf0N[M\x00\x00\xb0\a\xb5\x85\x9c\x9f-Fc7\xb1R\xb0\xc9\xe7\xd7/6`\x99\x85\xe9o\xaaH\x89\xec.\xb3:i\xd3u\xe2\xda%\xb2\xa7}b\xc4]`\x99u\xf9\xeb\xad-\xd85<5\xe5-z\x0f\xcfw\x00W [{\x93ڌ\xfb\x17\x00._\xba\x84x\xf5\xa4\x94\xd0\xeel\xb2\xaag\x11\x0e+N\x7f~q\x04\xb0\xfcv-\x91\xbd\x9d}\xc0^\x9b\xee/F9\x85\xd4\xfb\\נ\t\x00\x00\xe0`I\xbd\xef\xca[=ȧ\x91\xff\x00,\x9d\xe7\xfeR,B)\xd5\xe9\x8ev\xa9\uefb6\xb3u7\xe9v\x03\x00\x00`y\xb88V[\x9e\x96;\xfe\xbb\x17[\x00\xbcV+7\xfc3\x97\xad|\xae\x9c;\xae.0\x8a\xc1\xce)\x9b\xbbԑ1#\x0e\x00\x00\x80\xe5\x132/\xe7G\xf2\x1aH>%,LU\xc5L\xa1xX\xfd\xf5?$\xdd\xf1}4r\x01vQ\xe6R\xa7\xe0\xf3\x11\xf2\x00\x00\x00\xf6\x9c6\x97\xf2Fmש\xa7\xd5[\xb2\xa9|֪\x1c0\xb2\xef\xbe\xd0<1\xaf\x87\xa6D\xf6\xee\x06\xb8\xad\\\xec`\xc9s\xff\x1bXf\xcey{Iق)I\xb0Py\xfb\xf7\x10u\xe2\x8a\xf5o\xff%7\x1b\xdao\n\x00.S\xde\xdd\x06\x9d\xaa\x89\xdbRi\xe5T{L\n3\xf5k\xaf\xf8\xde\xcfZTD\x81\xe5\xb6k\x89\xec\xa1y\xc9^\xed\xbc\xe9\xe2\xb8\xd8M\x00\x00\x00\aQW\x0frN.\xeb/\xb6\x00X&\xd3\xfa\xddRۓ\x9at\xd7 =\xb02.\xb7\xe8\x8a\x18\a\x00\x00`\xe9dQ\xf9hC\xbdco\xa1\x81\x02p\x19\x8a\x9b\xfe\x91\x93\xbby\xdeh3\xf8\xf9\xa84\tg\x14\x00\x00XBM\xe8\xabM\xf7~S\"J\x9cuu\x1a\x97\x0f4Z\xfb$\xb9[\xff15\x1c`\x979W,\x96\x00\x00\x00\xf6\x1e\x17r)K\xed\xc0\x9c\xbc\x1bI\xbe\xb5\xfaKTY\x1cY\x1c\x81e\xe6\u0085.&\x9cwں\xe8\xc4վ\xeb\x94yѵ\x13\x02\xb0\xb4B:\x97ә\xec+\x9b\xd31\x05vN\x18\xa5\xdf\x14\x00\\\xa6\x90Yus\xa2\xaa\xb1\xe5\xd2ʪ&\xc5\x15\xe7Ջ7k\xb2\xfd3\xdd!\xc0\xb2۵D\xf6\x95\xe6)i8Vp7\xcaǉ\x06j\xed\x04\xa3\xe2\a\x00\x00\x0e\x1e?+5K\x9d:^\xf7ً-\x00\x96I\xd8|\xb7\x94:\xea\x1a\x1d\xd5LϨ\x17\xd6켾\xb0\xd8\v\x00\x00\x80=%o\x14\xc2\xd4\x02\xb0B*\x87\x9a\xfaZUl\xa5XZ\x9d\xaeճ\xa3/Tq\xe7\x7f#!\x05\xb8\x12\x8f\xbc\xd5MWNKY)\x17\xed|\xab픲S\xec\xe5\x0e\x8dSr\xfb+'Cg\xc7\x00\x00\xe0Z\b\xdeb\x01?\xb4\xa5BQS\xb5~\xaaX\xf4U\xf7\a\xda\xeeO՟\x8d\x94\xe5\xb5\xd5e^\xd4؎\x9a\x14\xa74x\xe8\xbc#\x89\x1d\xb8FN\xbd\xc5\xcdR\xbf\xe0\x8eT!\x00\x00\xb0\a\xa5Dg\xab\xa6䥗f示\xa7\xed4\x06\xc2\xc9\x7fI\xfc\xb0\xec\x9e\xf9\x86X\x04\xab\x8866\xb9Zyӓo\xedk\xcd,^l\xfb\x1a\x84\xcdŁ\x00\x96R3\x92*\xbb`\x97Q\xcf\xf7\xd6\x16\x1b\x81+\xb7r:\xba\xdaM\xed7fu\x83\xd8W\xac\xa7\xaad뽣VgX\xb5\x89\x11\xdb\x01|\x02\xae\x90k\xbd\x86\xce\xea\xa0UO*>h\xc1\x86-\x87\xe74L\xf5\xd2\xf7|\x1a\x8d,\xb0\xf4v-\x91ݩ\xb5\xe0\xcd)\xaaVL\x81\xdc\xee\xfdi\x00\x00\x80=\xc6+\xf8Fű\x7fȃ\v`\x19\xc5Ʀ4\x8f\xdd \xec݉|q\xd4A\x00\x00\x00\xec-Ͱ\x1b,\xa3m\xa6\x8a\xb3m\xf5c\xab\xa2YSS\x1fQ;\xcat\xdd=\xff\x99\x8a\x1c\xb0\x03\xfaG\xbfBu\xb0Xi\xd2S\xd6w\x9aT\xf3g\xa8]\xc2\xfa\xab$\xad\xbf<r;\x00\x00\xc0.\xf2q\xc5^\xcf\xda\xc2XN\x87\x95\xb5\x87\x14\xebs\xf2\xd5Y\x8d\xaau\xb9\xf2\x82\xda\xfa\xacfVU\x19\xae}\xba\x06\xf7}\x90J\vp\x8d\x85\x96AR\x00\x00\xc0^\xd5Ze%\xb5\x8b\x9f)\xf3\xb9\xad[\xbd\x85\xaa˾\x90\xe2B\x00\xfb٢\xb34'\x15e\x1a\xed\x16\xd89yq\x9bbf?.7\x95\xb3\xeaAz$\x1a\x9a\xd9\xfc\x99)w\x1a\x01\\\x818~~\xb1\x04,\xaf]\xc9&\x9f}\xf0O\xc7,\xb5Sj\x0eY\xd8^˥\xbfj\xc1\xbb#\x99\x1d\x00\x00\x1cDޫ\xf1\x8c\x1c\x00,+\xd7V\xf3Dv\x97:\xe8r\xb6lS\x17\xda\x10\xdf\x00\x00\x00\xec9~\xac\x10\xae\xb3\x18\xac\x91\xf33\xa9ِ\vcUC\xab\xd3\x1d\xfaË\x83\x00\\\xb1\x9b\xdf\xec|\xff\xf7H\x99\x9dgm#\xef\xfd\xc7\x1fu\xfd\x15#\xb3\x03\x00\x00\xec*\xd7ڔI\xa1\x90\xa2Mʺ\xbb\xba\x99mVc/q\xa6\xb6g\xcb+\x9f*\xdd\xf3sTX\x80=\xa0j\xca\xf93\x19\x00\x00\x80\xbd\xc6\xd7\x16[\xac\xdaB%\x9f\xa5\x18\xc3\xcb\x17\x87\xe7\xfb\xb0\xd4\xea\xe9\x87\x17K\x00\xf6\xa3.\x97i1zMY\xdc6\xdf\b\xec\x10\xb7\xf2y\x9a\x15\x03\xc54\x12{\xd6Sf\xbf\xb7\xa6ݞ?7\xa5\xc3\x1b\x00W\xa0\xae\x9e\xd5\xec\xf9\xaf\xe4N)\x96ڮdZT\xb3_\x9f/\xb4\x1b]\xcfs/\xffUN\x1f\x00\x00p\x10\xa5\xde\xf6\xf2\xf5\xc5\n\x80e\xe3\x9a\xf1b\xa9\xb1ɂ\x9bh\x93k\x15S\x17\x9a\x00\x00\x00\xd8[\xb2\xc6\xeaiA\x99/\xad\xeaf\xb1X\x98H\xe5D\xc5\xe1G\x94\xdd\xfcOIL\x01vPv\xff\xf7\xba\xfa\xf0ݚ\xa9R\xcfŏ\x1eu\xfd\xe3%\xb5\x03\x00\x00\xec&wAJ\xa3\xb0\xc7u\x8b\x13^\x92\x8a\x17m\x1aX\xacгXaK\xe7\xed\x90b\xe3\xf3ջ\xfb\x17\x88\x15\x80=\"hm\xb1\x04\x00\x00\xb0Ǥ\xb6\xf0\xd1\xe2\x89n٧aޔe\xa7\xe7\xebXja\xf2\xd4b\t\xc0~\xe4\xdc<\x89=\x84\x9e\xca\xfc\x9e\xc5V`\x87\xdc\xfe\xcf\\\xe8ߤI\x1a\xeb,ur\x93\xee2\xc6\xd9˿;\x00\xb8\\E6V}\xe1\xa7\x17k\xc0rڕD\xf6ؼ?=Y\xb0)\xb3\xb28%{,\xa4\xc2\x18\x00\x00\xe0\x80\t\xde++N.\xd6\x00,\x95\xf7\xff\x89\x98\x87\xaa[\f\x17c\x9b\xe0\xba\xde2\x03#\n\x02\x00\x00\xec=횔?/\xef\x06R\xb3\"\xf5f\xd2Ƨ\xab8\xf5\xc3Tހ\xab\xa0\xb8\xf3]\xae\x1enX\x80\xb4\xd9%\xb2\x93\xcc\x0e\x00\x00\xf6\x94T\x1d\xb1:I\xaa\xa2\xb8\xa2\xee\x1a\x8f\xc6jbqCi\xb1\xc2a\xb9#_)wˏ\x12+\x00{H\xe6\x0f+0d\x19\x00\x00؋R\x15%\xe6ݠ\xbei\xf0\x83\xca\xe6Y\xf1@\xb7\v\xcb\xcdWg\x16K\x00\xf6#\xe7\xdb.\x8f\xa9\x89\x03\x157\xfe}\xee\x03aǹ\xd1\x1bT\xb95\xc5\x10\xac\xae\xe0\x94Ye\xc1\xe5\xb5-\xefJ\xfa\x1e\x80}\xca\x15v-9\xbf\xb9X\x03\x96Ӯ\x94\x84YsN]\"{\x9cX\xc5/(\xb6\x16\xbd\xa7\n`\xb0\t\x00\x00\xe0\x80\xa9\xac\x06\xd6\xebݿX\x03\xb0L\xc2\xf4Qeq*\xb9L\xd1Y<\x93n.v\x0f%\xa56Ґ\n\x00\x00`\xafi۠\xac\xf5\xf2a\xaa\xb1\xdb\xd4\xf6\xf0N\xe9Ο\xa3A\x02p\x15\xad\xdcs\xd6M\v[\xe82\xc4>\xe6tK\xc9\xec$\xb4\x03\x00\x80k%lXU\xe4\xac\xe4_\xb0i\xa4\xba\x91^\xb2i\xab8%m\xfc!\xad\xdd\xf2\xbd\xc4\n\xc0\x1eS\xf6O\xa8u<\x7f\x01\x00\x00{O\x9b\x1a\x8a\xb8&\xe5\xb0Kq\xaa\x90Ee\xb7\xfeKb\x8a}\xa0l\xb7\x16K\x00\xf6\xa7\xf4\x9c*(ƕ\xf9*\xb0\xc3\x06'\xff\xa5\xcb{w[]\xc1~k\xa1P\x96z\xbfɦ\xb6\xdc_\x1c\x01\x00\x97.(Wo\xe2\xa5\xc7\xfe'\x1a\\`i\xedJ\"{\x91\x1a%\x85\xd2\x02\xf6s\xf2)ϣ\x1dYAl\xdb\xe2|$C\x00\x00\x80\x83\xa4uQ\xd9\xc9\x7f̓\v`\t\xc5\xfa\x03r\xa9\x97.g\x81M\u05edvژª\xa8\x109\xad\x01\x00\x00\xf6\x9a\x987rպ-U*W\xef\xd1\xe8\x9e\xf7Pi\x03v\x81;\xf6u\x163Y\xac\xf4\x8ad\xf6\x18\xe3˓\xbdt\xdb\x00\x00\x00vUtr]\xa3\x95`!B\xa3\xe0\xd7Un\xbc^+\x8f\xbc\xc3\xe9\xb6\xef V\x00\xf6\xa0rpX\xad\xcb\x17k\x00\x00\x00{GT\xaa\xa3L\xe54T\x8c3)u\ue265\x17>\xf4g\xa2\xefz'\x00\xb0_\xc5h\xe7\xb8w6\r\x16[\x80\x9d\xb72z\xbd|\x9eY\xc1\x92\xea\v\xe9\xf7V\xabM9u\x00p\x99fq\xa0\xdcW\xd2\xf9\xb7.\xb6\x00\xcb'e\\\\U\xe1\xb9o\x8c>E\xe7itB7I\x03\x17*\x84\x9e-\xa7FJ4T\x02\x00\x00\aOKS(`y5\xe7\x16\vi\xf4\x8f`a\xcd\"\xa4\xb2Y׃&\x00\x00\x00\xf6\x94ܯ[\xd5\xed\xacU\xdd\xfa\xca\xef~\x94h\f\xd8%\xbd\x1b\xdf\xe2^mD\xf6.\x89\xfd\x15s\x00\x00\x80\xdd\x14\xfdy\xc9\x1f\x92\xdaU\xd5ә|\x9ek\xf5\xba/X\xec\x05\xb0\x17\xe5\xf9\xd0N^\xc2y\x00\x00\xb0\xf7\xb4\xd1+\xaa\xb2\xbaJ\xbfk-\xe2s\xea,\xfbAU\x7f\xb8\xcb7\x04\xb0\x7fuϨ\x9c\x93\xcb\x18\x1d\x1bW\xd1\xe9\x7f\xea|f\x05J\xf06\xb9\xae\xae\xe0D\";\x80\xcb\xd7:+\xb7z\x16\x7fl\x8f5~\xe2\xf3hp\x81\xa5t\xd5\x13\xd9\xfd\xb3\xffRy\x1cJ\x85\x05\xea\xbe/?Ε\xe9\xdc<\x83\x8b\x0es\x01\x00\xc0\xbe\xf4\x91*VT+\xb94z\xb3ͳ\xa6\x9b\xf2\x86\x9e\x1c\x81e\x95E;\x8fc\xbf;ͳ\xbao!M:\xb7\xb7\xa4\xaa\xafQ\xb0s\x1d\x00\x00\x00\xbb\xaaΦ\n٪\xdal\xa6PL\xadnvDMu\xa3f\xb5\xed\x1cXݭ}N\x9be_ͭ\x7fx\xfe\x06\x00\xbb\xe7\xe1M7]\xbb\xcbNԉ\x94O\x15\xe3\xba4\xed\xc9\xc5Zn4[\x1c\x04\x00\x00\xb0s\x1a\x8b\x0fRlеEII$\x99\x05\x06n\xa6\x18r\x85\xfa\xb0\x9c\vjg\xcf\xebE\xbf\xa9\xad\x9b?K\xc5\x03/:\x1d\xfe[\xa4(\x00{ٍ\xff\xc6e~{\xb1\x02\x00\x00\xb0wdy\x1a\xfc\xa0\x95ڳ6]\xa7\xc9\n\t\x91\xfbA8\xfbK\xf6\xe56\x8b5\x00\xfb\x91\x9f\x95\x9ay\xc9]\xf7ً-\xc0\xd519\xfa\x906\xe3D*\ar\xb3S\x1a\xf7\x9f_\xec\x01\x80K\xb72\x1b\xabqR3\xeaix\xe1'\x16[\x81\xe5r\xd5\x13ٕ\x929R\xa0\x9e&㺑\xd8\x17.\x8e^\b\x00\x00\xb0\xaf̓Y?jt\xb14\x02Y\x98OY\xef\xd8b#\x00\x00\x00\x00\xe0Jd\xf1\x84E`/\xc8[\xcc\xe5\xeb5\xb5\xd3\x17\x94\xe7\x9b\xea\rV4\x9b6\x9a\xf8\xa1V\x0f}\x83\xf2\xe3\xdfIr\np\r\xf4O\xbf\xcd͎\x9cR\xb5\x95\xd9yj\xe7갯\x18\a\xd2v\xb68\x02\x00\x00`\xe7\xe4\xcdq\xf9\xaa\xa7Y=S\xa5V\xc1\x17\x8a>\x93\xd3T\xdeo\xa9\x99HqtXG\x8f\x7f\xa5\x0e\x9d\xfa)b\x04`I8W,\x96\x00\x00\x00\xf6\x904\xb0Ij\x86\xefK\x8b=\xc6\xea\xf7\xee\x9eoǒK\x03\xd6p\xff\x1a\xd8\u07fc]\xb7\x1b\x15\xc7\xfe!\xf7\x86pU\rn\xfc\x05\xe7G\xad4\xebI\xf99\xc6c\ape\xdcDN\xab\xcaS^\xeel }\xf0\x0f2*;\x96\xce\xd5\xcf$ok\x8b\xe9\x1a;a\xe2\xcbI\xec.%r%\xafL\xee\x02\x00\x00\xd8/.\xd6u\x92\xd4qO\xb7\ueeaaO\xdb\x06\x15\x83\xd3\xf3}\x00\x96\xca\xe4\xbd_F\x00\x03\x00\x00\xb0\xc7x_+6\x99\\=\xb2\xb0\xabQ\x96W\xb6q\xdbb\xb1y#\xa3\xb8\xfa\xfb\xa4[\xbe\x8dF\b\xc05Ի\xf3\x83\xaeYɥ\xcaB\xaa\xe2\x82\\\xb6b˽\xc5^\x00\x00\x80\x1dԜ\x97\xd3a\x15:\xaa\xa6\xa9\xac\xfaQɹ\xc3R6\x90\xc2\x05m\xadޭl\xed\x8fI\xa7\xbe\x97\x18\x01X\"!\xbfy\xb1\x04\x00\x00\xb0w\xc44\xc0[ȻD\xf6\xdamk\xa5\xff\x99\x8b=Xf\xde\xe2H\x12ف}\xce{5~>X\x15p\xb5\x15\xc3{5\x8d[\xb6\xb4\xa5\x92\x9f\x1d\x80+\xd0d\xad\xd5U7\xac\xaej\xf5ժ\xd0l\xf2\x9f\x17{\x80\xe5q\xd5\x13\xd9c\xa8ljl\xe1\xe2\x88\xec\x17\x9f\a\xce\x13\xba\x00\x00\x00\xf6\x1d\x17\x14\xd5~dD\xf6\x94\xcc\xfe\xf2\x94\xcb\x0f\x1f\x98o\a\xb0T\xda\xd9o.\x96\x00\x00\x00\xb0W\xb4Ͷ\n\xadHa\xdb\xd6\xc6R\xd1S\xe5\xb65\x8d\x13\xf5F_\xab\xe1\xe9\x7f\xcdMh`\x0f\x18><u\x8d\x9d\xaa\x1a\a)?o\xe7j>\xdf\x01\x00\x00\xb0\x83\xa2\x9fI\xd9\x19\xf9,h\xe8\xd6\xd4k2\xb5\xe1\x82ڼP;\xba^\x1b\x0f\xbe˹[\xff.1\x02\xb0d\x9a\x82\xd1M\x01\x00\xc0\x1e\x15\xe6\xed\xc1\xea4\xd0\xdb\xc6\xdf&\xd6\xd8\a\\\x18\xdb\xebUO\xaf\x00p-ev\xb9\xce\xd7\x17+\xc0\xd5U\x9e\xfeM\u05ce\xb6\x15\xda\xd4\x01\xceb#\x00\\\x86\xc6\xe5v\x1dY\xe4\xa6\xf8\xa9\xda\v\x13M\x9e\xfa*\x06h\xc3R\xb9\xba\x91\xd6\xd3\x7f-v=Ω\x99\xf7<w\xb1\xe4MI\\\x00\x00\x00\xfbX\xea\xbc\xc7\xf9\x8b\xb1A\xaa\xfbx\xdbVؔI\xd7\x7f\x13\x0f.\x80%\xe4\x9b'\x17K\x00\x00\x00\xd83bO\xca/HE\x90\x9a\x15\x85\xb6P\u0557\xb2\xf57H\xf7\x90\xc4\x0e\xec)\x87\xff\xb8T\xd6\xd2t\xa6Ы\x16\x1b\x01\x00\x00vN5XQ\x95ͤp\xc6\xd6Z\xb9\x18\xb4\xad\xa9&+\xf7*{\xf0\x19\xe2\x03`I\xc5\xe2\xbe\xc5\x12\x00\x00\xc0^\xe2\x15\xa3\x85\x19\xbe\xb1\xe8cc\xb1\rˬ}\xec\xf7E\x17\xa7\x16S\x12>\x02\xfbY\xf0^Yqr\xb1\x06\\}n\xedvU)\x95\xae\xa6|\x01p%R\xcc\xf1lJѕ\xf2L\xbe\xedI\xb3\x1f\xec\xf6\x00\xcb\xe2\xaaf\x94\xd7\xdb\xef\x90\xf3m7*i\x97\xc8e\xf3\x97]\x1c\x95\x14\x00\x00`\xbf\x89\xa9\xce\x13\xbbd\xf6\xf9\xbaՃ҃\v\xabz\xf9̂\x06\x00K\xa9lg\x8b%\x00\x00\x00\xec\x15>\x9f\xa6W\x9b\x8e\xa8\xf1A\xe7▲\xc1\x17\xa98\xfd_y\n\f\xec1\xf9\xc9\x7f\xeet\xe8\xb34sQS\xd7_l\x05\x00\x00\xd89\x8d\xabTf\x1b\x16\"X8\xd0l\xab\xceZ\rֿD+\xa7\x7f\x96\xf8\x00Xb\xa3\xd3\x7f\x8fs\x18\x00\x00\xec91\xe4\n\xae\x91\xdcTeq\xfbb+\x96Y3{\x97\xec[\x9d7\xfd\x03\xb0oU^\xea\xf5\xee_\xac\x01W_\x7f\xf4\x15\n\xc5\xc0*\x0f\xa3\xc5\x16\x00\xb8tY\x18\xcay\x8b?\x9a\xdcb\x90\x15\xe5\xc5y\xf9\xcdJ\xfa\xf0_eTv,\x8d\xab\x9aI\xdeLߧ\x94\xbf5\xcf\xe1Z\x9c\x17\xafLf\a\x00\x00؇bJ\\OҨ\xec6\x85.\xaf\xdd*D\xde\x02\x87\x82Dv`\x19\x85\x0f\xff\xe5\x98\x13\xca\x00\x00\x00\xec=\xa9\x91X\xb3b\x15\xb6B\xb1\xcc5X\xfbd\rn\xffO4p\a\xf6\xaa\xdb\x7f\xcae\u05fdIy3Xl\x00\x00\x00\xd89\x83Ye\xb1\x81\xc5\a\xf9\x8d\x9a\x0e\x8f*\x1e\xfeJ\x15\xa7\x7f\x98\xf8\x00\x00\x00\x00\xc0\x8e\x8b\xa1H\xd9$R\xd1jпg\xb1\x15ˬ\xa9\x1f\x97\xcfl!\xa6\x17\x00\xfbU뢲\x93\xff\x9a\xfbE\xd85\xfe\xe8\xdfvY\xffu\x92c X\x00\x97\xcf\xc7\xf3V\x88ق\xeb\xab\tN\xbe\x17\x95Oz\xaa6\x7ft~\x00\xb0\x04\xaejI\x18\xea3\xe9L\x99O\xaf\x8a\x82\x18\x00\x00\xecC\xa9㞗{\xf3\x99\xd7w\xe2\xc5D\xf6\x8c\x1b\xdd\xc02\x9a\xd5\xef\x9d\xdf\x00\x00\x00\x00\xc0\x9e\xd2N\xaeS\xebǚ\xf9g-\xe4\xbaQ\x83\xbb~\x91F\a\xc0\x1e\x97\xdf\xf2ˮ̟[\xac\x01\x00\x00\xec\x1c\xdfn(L\x9f\xd2v<\xaf\xfe\x03/\xb8\xf2\xd6\xef%>\x00\x00\x00\x00pU\xc4\xe0\xe5}\x90r\x8bEz7-\xb6b\x99\xc5v[\xea\x9a\xfc\x11J\x02\xfbY\xcb)\x8ek\xa0\x97\xdfku\x86j\xb1\x06\x00\x97\xce\xe9\xbc\x14\xd6m!\xd7\xd4mٖ5e\x1akv\xee\xec\xfc\x00`\t\\\xd5L\xf2<N\xac\xa6g\x11z\x9aR\xefd\xd1\xfe\\\x9a^Ɛ\x86\x00\x00`\xffq\xed\xc8^*U\xf5HMYX\x85\xab\xb6\xe0\xe1\x82\x1a\x7fA\xba\xef)n\x83\x01K(;\xfb\xa8T,V\x00\x00\x00\xb0k\x1a_)\xe6>\xb5\x02S\xdb\xe465\xb65=\xe0\xcd\x15\xea5\xe5\xf1E\xb9P\xab^\xfdTe\xf7=J\xbc\x05,\x8b\aS3\xa1\xa8F\x1b\xda*\xa2&\xc5\xd46F\xfbwd\xe7\xfbT\xdbyZ\a\x00\x00\xf8\x18\xb5\xc5\x03\xad\xd5\x19\xb2\xa9\x1a\xab/\xd4.SH\xf5\a\xa5\xf6(\xb9\xb6\xb3sr\x87\xbfT\xa3\x87.\x10\x1b\x00\xfb͑?\xa2sm=\x7fV\xd3X\xbc\x10r\x057T\xdd\xc5\x0e\xc3\xee\x10\x00\x00\x80\xdd\xd4\vQ.\x9bi;UGN}\v1Ȳ{\xfc\x9b\xe3ЭZl9PU\xcc\x16\x1b\x01,\xa7\x8f\xe4+\xc54rM70\x95ͳ\xa6\x9b\xf2f\xb0\xd8\v\xec\xa2;\xbfǝ\x1d\x1e\xb2\x9fg\xa3&\xf65ͧ\xaa\xfc\xbaZ\xbf\xa2\x98\xa5\xfb\x1c)\xd7\xee\xe3\r \v\x00v\xf9\xb0k\x87,\xfeH\xcfGVb\x90\x9fUR\xdeת{N\xfa\x1f\x03. X\n\xaf\xcc*\xdfq\xf4H\x06\x00\x00\x0e\xa4\xd4\xdbn[ȻV1\xa4\x04\x8b^\xb7\xb9M7\x1a\x00,\xa5\xee\\&\xcc\a\x00\x00\xd8u\xb9;ҵ-h\xddXq\x10\x94\x8dJ\xa9L\xb1\xd5T>\xdbV(*\xf9\xd1gk\xe5\xce_\xe0f4\xb0l\xde8uqtT\xaafꇞԗB\xdcV;]\u05c8[(\x00\x00\xe0Ք\x03\xa97\x93\x9aU\xf9\xe9!\xe5ږ\xf3\xb5\xdafC\xb3\xb0\xa5\xe2\x86?&w\xd7\x0f\x12\x1b\x00\xfb\xd1m\xff\xc4\xf9\xb2\xb4\x85\xa9\xe4\x8b\xd4(\xadKH\x98\x9f\xf0\f\xa4\x02\x00\x00v_\xd7F>\xb5#\xf1\xa9s-,\xbd\xe6\xc3r1\xd5.s\x9bh \x04,\xb7y\x8c\x18_\x99\x14\x9c\xae\xd9a>e\xbdc\x8b\x8d\xc0\xee\xea\x8f>S!4\xca\\\xae\xd2\xca\x1b\xa73V\xf6\x04\x9b\xa7\x81c\xc7V\xa7\x98\xdf\xe5\x00\x00`\xbf\xbajM\x81\x9a'\xfe`̈\xe3\x00\x00\xc0A\xe4\x1b)\xf4\xe4\xf3Z!\xa6\x1eZ\x87J\xad(\x9aEB;\x80\xe5\x13\xda\xd4)\x05\x00\x00\x00v]\xfd\x82\xd4Z|\x15\x06j\xc2X\xb3\xf4\x007\xacK\xd1\xe2\xabf[\xe3\x8dO\x95\xee\xfdI\x9e\xe8\x02K\xaax\xdd;\xdc`pR.\xce\x14\xaaR\x95/\xe4\xf3\x894\xdbX\x1c\x01\x00\x00\xf0\x11U\xb1\xa9zr\xccb\x84u\xf9\"W\f\x99.\xd8?\xf5F\xa3\xde'GW\xde\xf8/\x88\r\x80}\xcc\xf57Ԧ\xb3<+\xbbg\xaf16\x16Kd\xea:\x19\a\x00\x00\xd8m\xdd\x00'R^ܺ\u0600eV\xd5ﶺea\xf1\xa6Ś\xaa\x17[\x01,\xa5W\x0e\xc6\x19\xfdb\xddu\x83]\xb7mP18=\xdf\a\xec\xb2\xc1\xa9\x7f\xef\xe4W\xed'9\x93\xb72'\v\x8d\xcd\x1b\xfb\x9dn\xccK\x1eg\xe5\x10\x00\\\xa6\xad\xf7\xfd>\xb2x\xb1\xe7]\xb5D\xf6\xba\xfaMe]\xcfd\x00\x00\x00\aLj,\x11\xfar\xbeRHO,\xd2Mn\x97\xab\xcd\xe8\xc9\x11XV>N\xed<\xce\x16k\x00\x00\x00\xd85]\x15\xec\xac\\h\xd4ozʧ\x99\xaaj\xa2&[\x97V\xefa$v`\x1f\xc8\xee\x7f\xc2U\xe51M\xaa-\xf5\x9c\x93\xeb\xe5Rc1\x18\x00\x00\xc0\xc7\xf0mJ\x129'\xb9\xd4\xf1\xe8\xb6\xea^+\xb7rR\xfd{\x9e%.\x00\x0e\x82\xf2\xa4R\x17\xe2\xf2\xe9f\x81\x9d\xf6\xb1V\xa6\x91-\xd2F\x13\x00\x00\\\x03V\ai\xac6\x92\xf7\xeeYl\xc02\xab\x9a\xf7\xd8kj\xe7w\xd5R+\x00\xec\x16\x17\x14\xd5~dD\xf6t^\xbf<\xe5\xf2\xc3\a\xe6ہk\xc0\xaf|\xa6\xealSj\x1a\xf96%\xae\xa7v\xa9\xf6\x9bM\xb7:(\x83\x00\\\x81\xe2\xfcO.\x96\x80\xbd몕tM\xf5\x84\x15\xa4<(\x00\x00\x00\a\x94\vr\xbe\x96K\xc3\x02t=:f\xf2%=\xf0\x02\xcb(\xbe\xef\x7f\x8dYj\x1a\xe5\xf2\xc5\x16\x00\x00\x00얶\x7fL\xb3t\x17;\x8d\xc4\xee\xfa\xca\\i덚\xf5O\x95\x1ex\x94d\x15`\x9f(\xef\x7f\xde\xf9ކ\\\xb8 Uv\x9e\x97$\xb2\x03\x00\x80\xdf*W!\x97G\xc5\xe2y͊\xb1\xf2\xf5\xcf\xd3\xda\xddO\x10\x17\x00\aD\xd6\x7fHuj\x8a\x16+\x9bR\xa3\xb7\xd6Vh\xe4\r\x00\x00\xae\x11\x17\x14\xd4Sv\xc7\xf7\x13\x93\xec\x03\xb1y\xc1^ꮞ\t`\xf9\xb9\xd4q\xb2\xbfxB\xa7\xb8\xd1۶¦L\xba\xfe\x9b\xb8n\xe3ڹ\xeb?\xb9\xa6'\x85\xc6ʜ\xf6\xb8\xfdX[E\xbf\xa9<\xb3\x9fe\xcb@K\x00._\xaf:+=\xf3\xd5\xd4f\xb1\xa7]\xb5\xbb\xf9Y\xb3e\xc1\\X\xac\x01\x00\x00\x1c\x1c1\xe4V˚7\xb8vqd\xcb)\x01֫7\xb8\xb7\xdb\x06`\xb9\xd4գ\xca}zXEc(\x00\x00\x80\xdd6\x9e\x05e\xf1\x98\xc5T\x85\x14Ϋͧ\x1a\x1e\xfa\xbd\xea\x9f\xfe\x01\x1a\x18\x00\xfb\xcc\xe0\xe8\x9fP\xe5\x8fi\x1c\xcf)f\x1b\x8b\xad\x00\x00\x00\xaf\x10\x1b\xcd\xeaB\x93\xde\xf5\xea\x1d\xf9\x06e\xb7\xfc\x18q\x01p\x80\foz\x8b\x8b\xfe\x90\xe4R\"\xfbŤ\x04\x9b\x02\xed\xd3\x00\x00\xc0\xb5\x10\x14\xdc`\xb1\x8ce\x97\x87T\xb7ܲ\xbaf\x90S9\xdf\b`9u9L\xb1Kf\x9f\xaf\xdb\xf9m1dJ\x9d\xf2Yo\xbe\r\xb8\x96\x86\x9f\xa6\xc6[Y\x93\xeek\xd8ﴉ\xb5\xbc\x1b\xda\xef\xb4^\x1c\x00\x00\x97\xa3\xd0d\xeb\xbf,\x96\x81\xbd\xe9\xea%\xb2\xb7\xa9\u0097z\xbe\x05\x00\x008Xb(\xa5|\xdc\xdd\xff\xf2aͶ\x8c\x15|Pq\xea\xdbiP\x05,\xa1\xb6z\xdcN\xecF\xe9Q\x15\x00\x00\x00vW1>\xab<\x0e\x14\xf3#\xda\xee\x0fUm\xfc.e\xa7\xff/*f\xc0~t\xf2[\\v\xec\xebT\xac\\\xaf^K\"\n\x00\x00\xf8\xad&\xee6\xb5\x1b\xa75\xbc\xef\x19\xa7\x9b\xfe1q\x01p\x00\x95\xf9\xed\x8a\xde\xe2\x854\xba\x9e\xf3]R;\xe3\xac\x00\x00\x80k#X\xbd\x84\x84\xc8\xfd`\xfc\xf4\uf3e5R\x02\xa1\xad\xb8\xc6\xe6\xf9|\a\x80\xa5\x14S\xc3ݤ\x8b\x1bݼﳔ\xc8\xee\xed\xdc.\xb8n\xe3\xda\x1b\xdc\xf2\xf3Ε\xc7\xed\xf7\xf8\x8c\xfd.\v5i$\xf64\x80\x9a\xaa\xf9\x01\x00p9\xac\x9c\x8b\x9bg\x15\x9e\xfe\xc6EA\b\xec=W'\x91\xfd\x99?\x13\xb3X\xd8\x02O\n\x00\x00\xc0\xc1\x13C\xa6\x94\xef\xea,\fp\xea[\x8d\xab\x9ew\xe8\b`)\xb5\xed\x19{\r\f\xe8\x01\x00\x00p\r\xf4\xcb\x13\x16X=\xa9q\xfe\x82\xfa\x87\xff\xa4\x06\xb7\xfd \xd1\x15\xb0\x8fe\xa7\xfe\x96+F_!\xd7^Xl\x01\x00\x00\xf8\x880:\xaa\xe1\x9do'&\x00\x0e\xb0<?\xde=\x87\xb5\xe8A\xf2\xa9\xd9[\xf3\x91$\x05\x00\x00\x80]\x15\xe4\x9c\xd5I\xb0\xf4\xea\xe6qei$\xdcķV\xdd$\x91\x1dXjn\xde\xf9Y7-ҥ\xe2\xc5D\xf6\x8c\xeb6\xf6\x86\xac<\xa6\xae\xb8q\xa5b\xb32\xff\x8d:\x12\xd9\x01\\\x89(7\xdb\xd0l\xfbG\x16\xeb\xc0\xdesU\x12٧/\xfe\x98\xf2\x10\xd5\xe6\xcdb\v\x00\x00\xc0\xc1\x91\xe53MۡB\xbb\xa6\xdc}@j\xbdƽ\x1b\x16{\x01,\x9b~V[䴪\xe0\x89o\x00\x00\x00v\\\x7f*\xb9UM\xd5\xd34\xaf\x14z\xb9B\xe6d\x01\x95\x94\x97\xaa\xf4a\x8d\x8b;5z\xa0q\xd9\xcd\x7f?\xb56\x00\xb0\xdf\xdd\xf2\x1dN\x9f\x14\x9d\x82]\x1ftDM\xb1\xa1*\xb3\xe5Ʀz`\xd7\x06\xa7Y\x99\xf6\x01\x00\x80e\x13\\>O8M\xc3'wC(g6\x1f*\xc4U5ZS,\xfajC%\xb5vLaS6\xd3\x05;\xe6\xfc\xea\xfd\n7\x7f\xbdFw\xfd\x121\x01p\xc0\x95w\xfegwa2\xb2\xeb\xc4\x19\x8b\x0f\xd6\xed\xdaQ˻4\xd8\n\x00\x00\xc0.\xabK\xb5kw.V\xb0̊ͧ\x14\x9as\x16\x83\xf6%W[\xdcJ\xa2+\xb0\xcc\\k1\xa3\xabT\xd5#5e!oq\xa3\xd3\x055\xfe\x82t\xdfS\xdc[\u009e\x10\x8e\x7f\xb1\xe2\xf4\xb4T\x9d\x97\x06\x13U\x83t\xbf\xd4\xca!\x00\xb8L\xe3ފ\x06y\xad\xc1\xb9\xb7-\xb6\x00{\xcf\xd5\x19\x91==X\x8cQ\xae\xeb\xc5\b\x00\x00\xe0\x80\xe9\x86m\x0e\xf2\xdd(\x00\xc6\x15\xf2\xf9\x91\xf92\x80\xe5\xf2\xfe\xbf\x15\x95\x1eP\xa5\x1e/\xed\xbc\x06\x00\x00\xc0Κ]\xb8Eq\xf0\x82\x8a삲\xcab\xa7\xa6\x96o\x8f)\xc6R\xed살\xfe\xc9\x1a>\xf4\x1en4\x03\aё\xbf\xa1z0SS?\xab\xb2=$\rV\xa4\xfc\xac4q\xea5\x1b\x8b\x83\x00\x00\xc02\xf1\xb1Q\x1a\x15+\xa8U\xe3j5~\xa2\xa68\xab\xba\xf7\x82\xda\xf2y\xb9\xc9LY\x1cY\x99?S\xdd\xcc4.\xa3\xca\x13wk\xfd\xee_q\xfe\xba\x7fH\\\x00\xa03\\\xbdI\x8a\xb9]+^\x94\xd3 e),\xf6\x00\x00\x00\xec\"רW\u07b2X\xc12\vm=\xcfy\xe8F\xc3u\x16\xbb\xd2>\bXj\xde\xceᶐ\xb7X1\xa6\xbc&\xf5\xba\xcdm\xb8:\xa9S\xc0\xe5ȏ\xffM\xe7\xd6\n+\x84\x9c\x86V\xa7\xf0uf\x1b\x19\x91\x1d\xc0\xe5s\xed9\xbb\x8e4v]\xc9վ\xff\xf7\xc6\xc5f`O\xb9*\xb5\xb1\xd8n\xdb\x0f?%\xb2\xd3#\x19\x00\x008\x80\xe2\"\x89=5\xa9Ja\x80+\x94\xf7o\xefv\x01X.\xd3\xea7\xe4\xdbBj}zV\x05\x00\x00\x80\x1d\x16\xfac\xd5[ǕY\xf0T\xa4\x01Ԛ\x91\xaa\xfaIM\xe3!e\x87\xeeTy\xf7/R\v\x03\x0e\xaa\xd3\x7f\xd3ek\x7fDy\xb1f+\xe7,.\xeb\xab\xf2\xb9b6\x93\xaa\xb4\r\x00\x00,\x9dl\x9aZS\xa9u\x99\x1aW\xa8\xcd2\x05ﺆ+]\xe3\x15?\xb1\xfd[\xaa\xdb#\x9a\xaex\xf9\xa3_\xac\xfeɷ\x12\x13\x00\xf8(\xc5\xc6\x17\xaa\xc9ӳ\xd8h\xff\xce;\x18\a\x00\x00\xd8u\xbeUq\xf2\xbb\x88W\xf6\x83\xa6\x96Km\xfd\x16\xd5Jo\xf5L\x00Ķ$\xbe\x9e|^+ęm\x18Z\xfch\xa7\xfa\"\xa1\x1d\xd83\x0e\xbdQʬ\xcci\n\x15\xf5L1\xa3\xa3>\x00\x97\xaf\f\xa9#a\xa7\x98[\xa5v\xf2Ë\xad\xc0\xde\xd2=\v\xdci\xdeo\xa7W\xfb\xfd_\x95\x8f\a\x00\x00\xd8ۢW\x96nhǶ\xbb\xc1\x1dU\xaaw\xf2\xdf\xf3\xe0\x02XB\xd3\xea7\xe5c\xea\xf9\xd2NfGC(\x00\x00\x80\x9d\xd6w\xb5\xca\xecy\xa9^\xb7:W)\xb53\x8d\xf3\\\xee\x86\xc3\xd2]\x8c\xc4\x0e\x1ct\xfe\xe4\xdfs\xf9C\xe7ݬ7иzQyX\x95+\xfa\x16\x9f=\xb98\x02\x00\x00,\x9b蜜J\xe5\x1a\xa9h\xfb*\x9b\xbe\x8a\x99-\x8fW\xba\x16,ma\xe5\xfd\x91SZ\xbd\xbbu\xfd\xeb\xfe_b\x02\x00\xbfՍ\xdf\xe1\xa6\x03\xa7\xa6Y\xb1\xcb\xc6\xcc\xe2\x03\x06Z\x01\x00\x00\xbbo\x96\xa5\xdey\xb1\xec\xc2\xfb\xfe\x8f\xe8ӈ\xcdV\xa5\f)ӵ\x1b\x91\x9dDB`\xa9\xa5\x11\xd9C_\xceW\n\xa9\xf3\xb3\xd4\xf6\xcf\xe5j\xb3c\x8b\x03\x80=\xe2\xfa\xefqZ\xb1\xdf\xe5,\xb5K\x1d\xab\x8d\xe5|;\x00\\\x86\xae_\x8c\x90\xa7\x8cve۵\xf4ؗ\xd3;\x13\xf6\x9c\x1d\xcf4\xaf\x9e\xf9\x86\x98\xbb\xb1-YD\x17\xed\x04\x00\x00\x008h|&\x97\x12\xd9C\xdb\r\xc8>\xf3\xf4\xe4\b,\xab\xd8>m/\xe9Fa+'\xe2\x1b\x00\x00\x80\x9d\xe6\xea\xb3\x16;\x1d\xb68j\xacf6\xd5\xf6\xd0it\xe3W\xaa\x7f\xea7HX\x01\xf02\xbf\xf1\x17\x94\x8f\xd6\xe5\xdd\x05\v\xcf2M\x87\x8b\x1d\x00\x00`\xa9\x84X\xcaő\xf2ط\xa9\x91o7\xa5z,\xcd&6E\x9d)nT{䳔\x9f~\x1b\xf1\x00\x80O(\f\x1eVp\x03[\xb0\x15\xc7%\x03\x00\x00\xec\xbe&;\xb2X\xc22k\xaaw\xa8P\xdd\xd5)\xa3K킬n\x19l\x1d\xc0rsA\xce\xd7r\xed\xbc\x83\x8a\x94\xdb\xe4\xcb[\xe7\xfb\x80\xbdd勥h\xe5N\xf4jS\xa7\v\x00p\xb9\xe2QUa\xcbj\xb3\xc3\xd4\xe4]\xda\xfa\xa9\xf9v`\x0f\xd9\xf9D\xf6\xfa\xdd\xcaҧ\xa6AH#\x0f\n\x00\x00\xc0\x01u1\xf1\xd5gjrZW\x03\xcb*\vS\xc9U\xf3\x89\x1e/\x01\x00\x00v^ܐ곚5}M\x8f\x15*\x8f\xff!\x157\xfe[n,\x03\xf8(\xc5ɿ\xe9\xca\a\xce9\rNh\x96]\xb0-k\xf3\x1d\x00\x00`\xa9\x84\xb8\x9a\xb2Om)5(\xd9\xec\xf2\x03\xd2\x18\t\x1a\xacH+7\xea\xc8CO\xb9\xf2\xd4\x0f\x12\x0f\x00\xf8m\xad\x9d\xfeE\xa7\xb2\x96\x1a\xbb\xa6d$\x1a\x01\x00\x80k\xa0\xbce\xb1\x80eֶ\xefQ\x91\xf2\x1eBk1j\nP\x13Fd\a\x96YL\xa3\xd1\xfai\xb7\x9c:T\x94\x9fقWopo\xb7\r\xd8Sn\xfen\x17F\x87la]^\xcd|\x1b\x00\\\x96\xf5y\xdf-\xcdQ\x8bU\xac<\x9c\\\x90\x9e\xf8\x8b\x8cʎ=e\xc7\x13\xd9g\xd5\a\xbag\x8e\x8an\x9e\xbf\x05\x00\x00p\xc0\x049\x85`\x15\xa14e=\xb9\xb2\xbf\xd8\x03`\x99l?\xf3\xe7c\xe9z\x92K\r\xa0R\xaf\x97\xf4x\t\x00\x00\xb0\xe3,f\x9a\xb8u\xd5G״r\xe7\xb6+\xae\xffN\x92V\x00||\xf7=\xed\x8a\xd1\x1d*\xa7\xab\x8b\r\x00\x00`\xa9\xb8ټ\xf1\xb0\xaan\xb5ͥz\xf5\x0e\xc5\x13_)\xbd\xe1=\xc4\x02\x00.I^\x1c\x91\xe2\xc5d#\x00\x00\x80\xddU\xf6n_,a\x99\xc5\xf6)\x8bU\x83\xcd[\x8bW\xadn\x19S\xc7k$@\x00\xcb,\x86\xd2\x02\xc6qw:\xfb\x90:F\x1e+\xf8\xa0\xe2Էs\xef\t{\x92_\xfb_\xec\xc5\xcb[y\x04\x00\x97o\xac\xd2K\xc1\x9d\xb7rp\xd5ʾF\xda\xfa\xbe\xc5>`o\xd8\xf1D\xf6\xa6>c\xb5?[H5\xbf\x9d\xffx\x00\x00\x80=/%\xb1\x87`\x95\xffT\x1f*r\xf9\xac\xb7\xd8\x03`\x99\xd4\xf5\x13\xca}_ݨ@)\xb4\x89y\xb7\x1d\x00\x00\x00;g\xea\x9fS\xbeq\x93V\xeex\x8a\x86\x03\x00^\x13\x7f\xd7{\x9do\x9f^\xac\x01\x00\x80\xa5\xe2\xb6\xe4ܦ-\xcc\x145P\x9d\x9dV;\xfa\x9dr7\xbf\x85x\x00\xc0%\xf3\xbaɮ+S)\x90\xcc\x0e\x00\x00v_^\x9eX,a\xa9\x85\xb1\xbdD\xb5m+\xa7\x8b\xf5ʔ\x03\x01`Y\xc5\x14#:\xfb\xd7Ne\xa7\xbe\x05\x8fu\x1a\xa3\x13ػn\xfdn\x17\xb2My\xca\x1f\x00W`\xe6\x9fW\x167\xbaD\xf6JǕ\xa5q\x18\xc7\xcfHO\xff\r..\xd83v<\xd3\xfc\xc4l&\xb5\x16\xd7\xe5C\x85,\x05w\x00\x00\x00\aKn5\xffԣ\x95\xc2P\xe3\xd8\xd3\xe0\x8e_\xe46\x18\xb0\x84\xb2\xcd_R\xa63v.\x17\x8a~\xa06\xf5N\a\x00\x00\x80\x8ff\xf5$\xc5V\xb38մ\x98j6\x98\xaa\xb2y\xed\xa7\n\x9aj\xb3?\xb3z\x94\x05HuߦL\x8d\xafT\xe7\x16\"\xc5¦\xa9\xfa\xaf\x8f\xaex\xdd;\x88\x99\x00\\\x9aO\x89NyT\xe5\x8ek\\\xac\xd9%Ů)a$\xcdJ\x9b\xb7\x8a\xfd\xe9\xe2@\x00\x00\xb0\xbb2\xb5\xad\xd3,\xe4\xaa\xf2\xbe\xea\xd2[\x18`\xb1A\xac\xbb\xfb\xac\xf9tCv\x80ƃ\xa0\xed\xeb>O\xfd\a\x1es\xfd\x9b\xff1\xf1\x00\x80\xcbs\xcfϸj\xb4.mN5˧j\xf3\xa0\x98W\xb2\x8b\x8d]\x8elrvMr\x85]~\x18\x88\x05\x00\x00\\\xba\xed\xac\x9cgA\xfaZ!/U\xfbֶ\xa6:\x86\x855V\xd7p71\xb2\xef\xd2{\xe6/\xc5\\+\xf6}F\xe5Ś\xfc4\xd5\x1bg\x9a\xe6i\x04g\x00\xcb*\xcb\xed<n\x87\n\xed\x9ar\xf7\x01\xbbh{\x8d{7,\xf6\x02{\x93_\xffcr\xd3FM<\xacZ}\xb5\xae\x92\x8at\x7f\xa3\x91B\xcf~ϙ\xd5Gl\x1b\x00|\x1cy\x16\xec\xe5\xbc\xf2fC\xe5v\xeaP\xd8\xe9BO:w\xe6\xe7\xe6\a\x00{\xc0\xceߩO#\x8f\xdao\xbf\xfbh\xd7-\x00\x00\x00\x1c,.\xdd8H\xf3\xc2f\xf5|\x1b\x80\xa5\xe3\xc2\xc5xf\xfe\xec\xd1\xcd\x03\x1d\x00\x00\x00\xbcR\xbb\xdd\xcd2_(w}\xe5a\xa0\"\x0em\x1aɻ\x15\xadVQm\xaaW\xf5Ki\xb5V\xde\x04\xb9\xc9Dӵ#қ\xe8\xfb\x1e\xc0\x15xx\xea\xdc\xe1\x1b\x95M\xce\xd8ue,\xf5\xa6v\xad\x19َ\x9eܘD\x15\x00\x00\xae\x85\x90\xd7\xca\x06QE>\x93\xaa\xf3*\xeaZE\xb1!_\xae*\x84J\xdb\xd99ՃG4\xbc\xbfv+'\x7f\x90x\x00\xc0\x15+{\x9f%\xe5\x8dz\xb1\xa7,\xf4\xe5\x9a\x15\xa9\xe9K\xb1\x94|\x94\xf3A\xa1IIg\x00\x00\x00\x97&\xe5\xb0wm\xe2m\x8a\xb1\x95OmG\x16c\x19\x067\x98/`\xa9\xd5\xd5\xf3\xf3T\x874\x80_\xb486uV\x103y\xf2\x1f\x80\xe5ֵ\xf9\v\xf2\xa9\xb3\xf5\xc4\x15\xf2\xf9\x91\xf92\xb0W\xdd\xf6\x9d\xae)\x8e)צ\nU\xca\xd2\xef7\xfd\x94\xbb\xbaG#\x9fE\xab\x8bdi\x05\x00>\x8e\x15\xc5֮\x13\xa9\x1c\xec\xae\x1d\x85J{\xf5ᙴ\x02\xec\t;ڒg\xfa\xd8W[\xb4\x9eFd\xef\xd9Z\n\xd9y\x10\x00\x00\x00\x0e\x9e\xa8ʂ\x80\xbc\xbb\x01\xd6\xd2n\x1aXZ\xae\xad\xbag\x92i\xc4\x0e\xe7\x9c\x05\xf3\xc47\x00\x00\x00\x1f\xab\x8b\x7f\xbcS\xe6\xfaRU*\x8c3ŉ\x05B\x95\xc5Did\xe4X\xaa\xcc2;\xe6yi;H\xb69;\xfaz\xf5\xef~\x9c\xa4\x15\x00W\xac\xb8\xed\xbf\xbbޱ\xcfP,,^\x9bLm\xc3\x19\xbb\xce\xf4\xa4f\xb88\x02\x00\x00\xec\xa6:\xafTM\x1a\xf9\xc9!\x95nd\xf1@-mm\xab\xaak\xb5+=\xb5\xd7\xff>\x15\xf7\xbf\x8dX\x00\xc0ι\xf3\a\xdcxh1@z\xa0\xd3\xda\xe5\xa5\xed+\xb6\xab\n]\"\xbb]\x8fR\a\xe4\xf5\"\xe3\f\x00\x00\xe0\x12d.\x85.V\x8f\x88\x99\xbd\xb6r.%\x8f\xa5d\xf6 \x97\xdf\xda\x1d\x83\xe56\x9b<nu\xc7 \x85ª\x92Ӯ\xfe\x98\xea\x93\xce3p\r\xb0\xd4\xec:\xdd%\xb1/.\xe3\xa9\x1do\u07bf\xbd\xdb\x05\xece\xedڧ\xdbk\x1aEybe\x93\xfd\x80COmtj\xb3*5f\xb5\x03Hd\a\xf0\xf1\xb98RH\u05c9\xf4\\&u\xccԔ\xeaY9X\x86'\xa5\xdf\xfc\fn\x90bO\xd8\xd1Ԫ\xa6z\xfb\xbc§\x9e}2\xbfq\x00\x00p0\xc5tc;\xa4\xc6\x11V\xd5\xca7\x16[\x01,\x1b\xafŃ)_X\x9c\x93\xd1Q\x17\x00\x00\xc0\xabp\xa9\xfb\xde\xdc\xeaJ\xc1\xa6&ȇ\xcaB\xa1\xd4\xd8\xc7&W\xd9ΔP\xba\xdd=omzQ\xf5\xb1?)w\xe7[\xbb\xbb\xc8\x00\xb0#N\xff\xb4k\x8e\xff\x11\xd5}\x8bٶ\xec\xba\x13\xcfH}\x8b\xe3\x00\x00\xc0\xae+\xaaB\x85\x1a\x8b\a,\x00\b\xab]R鹬\xd6dt\xa3\x8a\xc3\x7fVk'\xffob\x01\x00;\xaeZ\xfd\f\xbbޤX\xc0\xae=~\xa2\xe8\x9cB\xd7S\U0007c877OI\xed\x00\x00\x00\x97\xa8\x1b\x91\xbdk'\x92)\xc6\xd4ANn\xf5\x8d\\\x8d\xd55bqoډ%\xd7ΞP\x9eF`ר\xcbuU\x96\x92\x04su}\x18\x00X^\xd1+K\x17\xf1h\xe7t7(m\xa9\xde\xc9\x7fϙ\x8d=\xafw\xd7\x7f\xb4\xdfii\xbf\xdb\xf4\xfbM%S\xcf~\xc2V\xf7\xc8j+\xa3Rg+$\xb2\x03\xf8\xf8b\xb4B\xcf\xd9\xf5\xc2W6\xd95\xa4.\x94\xfa\xf8\xecř\xea\xe9/.\x8e\x02\xae\xad\x1dMd\x0f\xf5\x93\xa9\xa6gR\x0fF\xad\x05\xf1\xd4\xf7\x00\x00\xc0\xc1\x13\xd3\r\x848\xefا\xe8ݱ\xd8\n`\x99\x84\xf7\xfc\xaf\xb1\x88Mw?0\xce{\xebRf\xeb\x00\x00\x00\xf8h\xdd-\xe0\xee!\xaa\u0557\n\x9b\x06VgJS\xaf\x92\x8aZj6\xd5\xd6\xeb\x8aGO)\x7f\xb8q\xc5\xc9\x7f\xc2Mc\x00;\xae8\xf9\xaf\\v\xfc\x9b\xb4=\xc8TMmC~v\xbe\x03\x00\x00\xec*\xdf\x0e\xe4\xb2\r+\x8bg\n\xf9\x875[\xe9k\xf5\xfa\xaf\xd2\xfa\xeb\xde\xe3t\xe3\xdf$\x16\x00pUl\x9c\xfe\t'\xd7W\xeb\x83]\x7f6\xe5\xf2mu#\x0f\xd9j\xca@\xf39\x89\xec\x00\x00\xe0\xd2\xe5]\x83\xf8T\xa1HK\x8d-\xfan\xb5\xf1^\xc5\xdd\xdfO|\xb3\x0f\xe4\xe1\xa5.\x91¹\xde<y=\x8d\xc8\xee\xa3\\\xfa\xae\x01,/\x9f\xd99m\xd7\xf0\xd0vW\xf2\x99\xefͷ\x03ˠ\xbc\xc7~\xb7+\xf6\xfb\xb52)\xfd\x8c\x95\x06aJ\x85\x94UB\xd2(\xed\x00\xf0\xf1\xc4Me\xe9\xfeh\xea\x9c)\xab\xec\x9aau\xdaf\xd8]B\xf2\xb6\xd5\xe6\xe3_1o\xdc\x05\\C;\x1ai\xe5\xcd\xcc\nI[\xb0@.\xa6\xd1v\"#^\x00\x00\x80\x83)Z5+\xfa\xb1\x86\xe5\x1b\x16[\x00,\x93\xb6~W\xea˲[\x0e1\xb3\x93\xda\xe2\xf7\xd4K+\x00\x00\x00>Z\xb0jR\x9d\x9e\xa0\xda\xd4=\x10\tjm6\xad\xa5q;\xb4\x03\x8e);\xf9F\xb9{>\xc8SU\x00W\x95\xbf\xf1\xcdn\xf4H\xe3ڣ7\xd8\x05h\xb1\x11\x00\x00\xec\xae\xfc\x82b\xd8\xd2\xd4oh\xb6\xf6F\xf5\xee\x1b\xbb\xec\xe6\xff\x8bX\x00\xc0շ\xf2\x99\x9a\x15}\xcdr\xc9\xe5ce\xa9Yf\x98\xefRf\x1b\x01\x00\x00.Uz\xeea\xd1L\xb0\x97yޘ\xbd\xc4Lm\xc6h\xa8\xfb\u0087\xffz쩲\x85־Z\xfb\x96SFEj\x17\xe4&\xb6\x89\x8e\x90\x80\xa5\x97F\xa5\xb5\xf3\xdb\xf9LM\x9e\x9eY\x03Kb\xfd\x8bT\x977w\xd5\x0e\xf9Z\xb9+\x17\xc9쩠\xbax\xa3\x03\x00~+\x1f\xa7\xf6\xb2x\x1c\xe3gR\x96:\xfaL\xf5\xdaaw\tq\xe7\x18\x95\x1d\xd7ގ%\xb2\xd7O\x7fS,\xba\x1f\xb8\xc9\xed\xc7\xeej\xf9П\xaf\x03\x00\x00\x1c \xa9\xe7\xaa4*a\xeb\xc6ҩo]D\x04\x00\x96I[?i's\xb0)\xdd\xfe[\x84M\xdd\rn\x00\x00\x00\xbcR\xea\xef'\xa4jR74\xbb\xb3z\x944\xa9\xa4\xdaݤl\xf4\x90\xe2-_&\xdd\xfc\x13\xc4E\x00v\xcd\xe0\xee\xa7\xdd\xf8\xc8C\x8b5\x00\x00\xb0\x9b\xdaxT\xb3\xb5#rǿD\x83;\x7f\x858\x00\xc0\xee\xb9\xe7\xc7\\\xe8?Х\"\xa5\x9b\x15.\xf6\xec\xa2T\xd8r\xa6v\xbe\x15\x00\x00\xe0\x92\xf8\xd41N\xe2\x8anD\xdf\xee%8\x85\x8b\xc9!Xja\xfb\xdd\xea\xba$\x88\xb5MSy\xf5m\x1e\xa4\"%\xfc0z3\xb0̺\x0eH\xd2\x03\xec4e=\xb9\x92\x9c&,\x91[\xbfŅ\xe1\xed\n\xa9\x90\xf23+\x9f2+\x9e\xcanp\xb5\x8fTN\x00\xe0\xb7r\xe9^hX\xb5\xeam\xea\xe9\xb3U,gj\xb3Ԡ+\x95\x83}\xadL\x9e\x93\x1e\xff\xc3\\HpM\xedX\"\xfb\xac~\xbf\U0008b05bO\x89\xec\x8d\x05t\xf4H\x06\x00\x00\x0e&碂\xb3:\x11\x80\xa5Ԇ\x97\x16K\x16֤\xec\xac\x14:\xf1,\x12\x00\x00\xe0\xb7H7\x98-\xfc\xb1JSZJ\xf7\x83K\xb9\xfc\xa8V\x0fݧ\xde\xfd?\xe5\xdcuo\xa1\x16\x05`\xd7\r\xef\xfc5\xae=\x00\x00\\\x03UvR\xfd{\x9eu\xbd\x93\xff\x86\xb2\x18\xc0\xaes\xc5\xddj\xba\xce\xf6\xd2Z\x1aqo\xa0\x94l\xd6\xc4&m\x00\x00\x00\xb82\xdds\x10\x9bu#\xa2b\xd9ͦ\xcft\xe3[\xa8m\xed%%\xb3\xa7g\\V\x91\xb4\xaf7\x86\"\x1d\x02`I\xa5$\xf6\x10R.\x93\x9d\xd3E.\x9f\xd19\x05\x96K\xecߠ\xd0U7B\xd7\x16Çܖm\x03\x9d\xe9\x00\xf8Db\xdf.\x1b+j\xebR\x8d\x95\x85\xaeh\x14\xb3F\xad]G\x9aԞ+8M\xb6\xff\xdb\xe2`\xe0\xdaرhze\xfb\xe7\xe4\xca\x17-xې\xaaUe\x9a\xa9N\xc9\xec\x00\x00\x00\a\x8c\x9fH\xae\x1ftΝ^l\x01\xb0T>\xfc\x17bn\x91R낲xTecq\x8d\xbb\xa0\xda[\xac\x03\x00\x00pФ\xc6\xdfi\xb4\xf5\x98F1\xeb\xa7V\u1a8bF[\xbd\xa9Ε\xf5\xfcyi6\xe8\x1a\xfa4\xd3sj\x067\xaa8\xf5'\xa5\xd3?\xc2ST\x00\xd7\xd6\x1b'\xee\xfcu_\xab\x89Z\xd9\x05ʮ_^\xad\x0f\x9a\xfaLmϮ[Ѷ\xb5\x16\xefՙ\xedO\xa35\xa6\x9e\xc8KE\xdb\x1f2.a\x00\x80\x03*X\xb9\xd95\xf4m\x14\xd2\x00\x06\xb9\xad\xa7\xf6\xfb>5\x96,U[\x1cP\xb9F\x8drŬ\xa7J\x99\xb6\xda\\M~\xaf\xb4\xfaG5x\xe8m\x14\xa2\x00\xae\x99\xd1\xed\xdf\xe3\x8al\xd0\r\xa4\xa9\x8d\xe74\x8d隶\xa1\x9e\xb6\xe6\a\x00\x00\x00\\\x82\xa6\xdcT\x15V-\x1c\x8a\x1al\x1e\x97z/\xa9\x1a\x8e\xe4\a_\xb48\x02\xcbl0\xf95\x8b}\xbd}\xaf)\xa9\xc7\xc2ަ\xb2\xf5L\xaa\xfbʳ3\x8b\xa3\x00,\xa3<\xeb\xabLYRa\xa8q\xecip\xc7/r\xbf\nKepꟻ\xad\xf2V+\x9c\x86\xd2\xe4E\xf5c\xae\xbai5)S\xe3\r\x00\xf88\x8aT\x9f}Iy/(WO\x9a\x16\xcaCP\x16ƶn\xfb\x06R6}|q0pm\xecX\"{l\x9a\xf4,\xd3b\xb9T8ZDGu\x0f\x00\x00\x1cT]c\xe7L\xbdމ\xf9:\x80\xa5R7/v=Y\xba\xe8-\xba\x99\xa5\x85y\x88\xe3S/\xcc\x00\x00\x00\aLJ\U0010ce42\xf3\xaa\xf3s\x9a\xb9M\xf9\xba\xd0JuX\x1baU\x9a暅s\xda\x1e5ʯ\xfbz\xf5^\xf7\x01W\x1e\xfdf\xee\x0e\x03\xd8\x13\xd6o\xfegn\xf0\xc6\xcaM6\n5\xedXY3RJW\xaf'g\xd5\xf8#\xaa\xb3\x155\x85S\x9bW\x8aٶ\x05~S\v\x01\xe7\xa3\x1b\x00\x00p\x10\xc5^\xadX\x96R~\xd4\xd6N\xa8\xa9G\xaagQ\xa1\xb1\xb22\xd6\xca\xe3H\xa5+\x95\xcb\xcb\xe9\x82\xca\xe1\xb6ʣ\x9f\xa2\xfc\xe1\xb7:\xdd\xfd\x9d\xc4\x01\x00\xae\xb9\xd1\xe8O\xa8\xea\xd9\xf5\xec\x82\xd5\xfd\xbd\x97\x82\xd5\xf3\xeb4:;\x00\x00\xc0\xa5\xb9\xd8nD\x16\v\xa9\x98u\xedFZ7U\x91ߴ8\x02\x00\xb0'\xa5\xc18S\ag\xae\xb0\x99]Á%\xb4\xbe\xf6y\x1a7c\xab\x83\xac\xd8\xda\x19\x15Y\xa3\xac)\xe7;\x01\xe0rD\xa72\xc54\xef\xfddZC\xe0\x9a\xd9\xd1D\xf6`?\xe8\x98z\xb3M\x9f\x9a\x02\xf8\xae\x06\b\x00\x00p\xc0\xe4\x99\xea\x98k\xb8z\xe7b\x03\x80eRϞTf\xf1\x8c\x8f+\x16\xd6L\xe7\xf1M\xeb\xe5|5?\x00\x00\x00\xe0 \xa9o\xb2\xfaЦBＢ\xef)\xf7Ǖy'5/I\x9b\x17ԔG\xa5\x8d7h\xf4\xf0\x96\xd3m\xff\x94\xc4\x15\x00{\xd2\xe0\x9e\xcae\xeb\xb7I\xf1\xac4\xad\xd4/\xd3\xe8\xec\x03\xb5e\xa6\xbalU\x95SUy\xadƥ\xe7Z-\x89\xec\x00\x80\x03k\x16\x0f\xabV\xae\xdam\xaa\x8d\x16\x03\x84JY\xcc\xe5].\x97G\xb9\xa6V\x98\xb4\x9a\x86\v\xd2\xe8\xb4tot\xe5\xe9\x1f'\x0e\x00\xb0g\xb8۾\xdd\xd5\xc5\xc3\x1a\xd7۶f\x15\xfb\xd8(\xb8b\xbe\x13\x00\x00\xe0\x128\x95r\xa9\x1e\xe1*\xa9\x98JM\xae\xd6O\xd5;\xf5\xf7\x88\x81\x00`\x0f\x8bi\xd4ِw\x89\xec\xed\x8eeK\x01\xbb\xcb\xdd\xfc\x167\xcb\x0eKe\xaavD9\xfb-\xe7-\x89\xec\x00._\x9b:\xe9\xf2}\x85\xed_^l\x01v\xdf\xceU\xcd\xdaf\xb1`\xc5d\xea\xc5(\xa6\x02\x93Dv\x00\x00p\x00Ũ\xaa\xcdU\\\xff\xdd<\xb8\x00\x96P;\xfd\xa0\xb2\x98zf\x1dX\xe0^\xa7\x8cv[\xee\xc9w\t\r\x00\x00\x00\aL\xff\x05{9\xa6\xbcYW\xe9grz^m3Tp7(\x8e\x06҉\xafQ\xef\xee\xffA\xec\x03`\xcfsw\xbf\xdfŵ\xcfUݷ8/\xf6T\xb8\xe7U\xea%\x15~\xa2\xdc\xe2\xbe\xcc\xf7\xe5e\u05f5n\x94\xa5\x8f<\xf3\x02\x00\xe0 \xf1M)\x1f\xa7\xcaܶ\x95\x956\xf9L\xde\xf5l\xc7\xd4\nө\xc2V+\x7f$W\xff\x86\xff]\xba\xeb\xbd\xc4\x01\x00\xf6\xa4\x95\xbb~չ5[\x88[]\xfdޗ\x8c\xc0\a\x00\x00.\x9d\v\x85\x9cs\x8a\xb1\x92\xf2J1\xf4\x14|\xbe؋e6~\xff_\xa1+S`\x1f\x8bn*\x85R\xf2)\xf3wc\xb1\x15X>\xc5\xea\x17j\x92\x9d\x9b\xaft\xcdX\xb9\x1d\v\xe0\xf2E\xd95\xc4OU\x8c\xa5\xe6=\x9fO}\x18\xd7Ď$\xb2\xb7\x8f\xff\xd1\xe8cT\x96z\x9e\xf3N\xce\a\xab\xfcY\x00\xafvq\x04\x00\x00\xc0\x01Ҷ\xaac\x7f\xb1\x02`\xd9\xf8\xf6E\x8bgR\xd2B\n\x97,pOI\fi\xc9q#\x10\x00\x00\x1c<mf/\xe5\vV'\xba mzM'\xd2d8Qs\xc3gʽ~\xcb\xe57\xfe}*I\x00\x96\x86{ݏ\xbb\xfc؟Ҷj\xf9\xba\x92\x9fE\xe53\xa9\xb0)\xaf{\xf2qd\a\x15\x8a\x8eD\x17\x00\xc0\xc1T\xf8g\x95G+#\x83U\xf3\x83\x05\x03q\xda\xc5\x02\xa9ES\xda\xd4\xdc\xfe\x88tϖ\xd3͌@\b`o\x1b\xac\x7f\xae\xea\x94g\xe6Z\x9b\xac\xc2\x0f\x00\x00p\x89\\\xf4r\xaa\xd2x&\xb6\"\xb5\xce\xc9e\xd7\xcfwb\xa9\xb9\xea\xd1\xc5\x12\x80\xfd(\xa6\vwL\x1d3F\x15\xbd;\x16[\x81\xe5\xb3r\xfb\xbfs\x93\xeeކUD\xaa\xd4pc\xdam\a\x80\xcb\xe1\xd2=\xd2\xd4!\x86\xcfԎ\x7f|\xb1\x15\xd8];\x92\xc8\xdeT\xef\xb0W\xab\xf0\xf9\xbc+#C\xea\xee%\x16V\xf7c\xc4B\x00\x00p@\x15\xa3\xc5\x02\x80eS\x84Ԡ)\xc52\xa9c\xaea\x17\xea(\x9b\xda*=k\x03\x00\x80\x83\xa7\t^լP\xddF5+A\xe5ч\xb5r\xff\x96+o\xfe>\x12W\x00,%w\xf2;\xdd\xe8\xc6\xffC\xca\xefQtG\x15R\xe8\x97\xf2\xd6S\x7ff)\xfe\x93W\xf0\\\xe2\x00\x00\a\x93K\xd9\xea\xed\xaaT\x8dl\xdej\xeakM\x87\xa5\xb4\xfe\xbf\xc8?\x14]yӯPH\x02X\n\xee䏻jtB3o\x15\xfd\x96\xf6k\x00\x00\xe02\xa4{\x85~ҵ\x8bW[*\xe6Q\xb9\xbf\xabۅ\xe5\x96\xd7\xef],\x01د\xa2\xbc\xa2\x1fkX\xbea\xb1\x05XNE\xef\xf5V\x1f9\xa1\xd8\x16RV-\xb6\x02\xc0\xa5\xcbR\xa3\x88fMꍔM\xa4\xfa\x89\xcf\xe9ZG\x00\xbbiG\x12\xd9g\xb3w[m/\xb5\xf4ɺ^\xb8c\x97\xf4\x91z|\x01\x00\x008\x80\xac\x86U\xf4W\x17+\x00\x96\xcac\x7f.\x16\x17\x9bb\xba\xa9\\\x1a\x8d/\xf5@\x97G\xc5@\";\x00\x008x\xb2ٍ\nŪ¡\xd3ʎ\xfdY\xe5w\xfe*\x89+\x00\x96߉orz\xe4Q\xa7\xc3_\xa3\xb6\x7f\x9f\x9a\xac\xb4\x18p\xdbv\x8c-\x04l\x14E\xfc\a\x008\xa0\xfcFzQ㢪ކ\xfc\xa1\aU\\\xf7'\xe4\xee\xf8\x0f\xc4\x01\x00\x96N\xb9\xf1'\xd4\xe6Gm\x896\x99\x00\x00\xe0r\x04y\x97\x12\xc6,F\xaa\x87ry\xad\"\xbbw\xbe\vK\xcd7O-\x96\x00\xecG\xa9\x03\x92\xd4ܯuc\xe9ԷrO\vKm\xf5\xf4[]pk\n\xb9'E\x0f\xc0\x15\xf2\xaa]߮%Y\xd7\x1a\"\xdb\xfc\x95\xf9f`\x17\xedH\"{UoZ\xbc\xdeu=\xa7\x18\x177\xff\x83\x93\xf3<\b\x00\x00\x00\a\x90\xf7\xca\xcb\xc1b\x05\xc02\xa9\xab\xe7\xe5\\\xb1X\xab,±s9\xdd\xd9N\xff\x86r\xb1\x1d\x00\x00\xe0\xe0\xc8z\xefS\xbf\xff\x19\xea\xdd\xf1\x98s7\xfc#\x1e\xf4\x03\xd8W\xdc\xed\xff\xd0\xc5\xf5{\x15\xfa=)o$\x7f\xc166j\xe3\x8e<>\x03\x00`\xe94\xfd\xb3\x9a\xf5\xcf+\f\x87*\u05feT\xe5\xe9_w\xd9\xf1\x7f@\x1c\x00`)\x15\u05fd\xd9)\xbf\xde\xea\xfa\x8b\r\x00\x00\x00\x97$t\xdd\xe18e\x8a\xa1T\xcc\x1a\xe5w\x10\x1f\xed\a!\xa4NM\x01\xecg\xceE\x05W/ր\xe5ֺ\\\xa9I+\x1dq\x03\xb8\"mO\x95kT5\xa5d\xd7\x14?n5}\xee\xabH\xfcŮ\xba\xf2\x968O\xff\xe5\xb8\xe6ޤ*\x9f\xda\x0f\xf9\x82\xcafK\xd9tE\xca\v\xd5Yoq\x10\x00\x00\xc0\xc11\xf3^\xbd\xdb~\x82\a\x17\xc0\x12*\xc6\xff\x8fT\x05)\xf5:g3\xaf\xe7\xece\"ն\xee\xb6\x16G\x01\x00\x00,\x91\xc2B\x13_Iq*\xb5\x8d\xd4\xe46\xb7\xba\x8d\xca\xee\xeep\x13V\x15\x9c-\xa4\xfb\xbb\x99M\xa1\xa7\xd0\\\xaf\xe8n\xb0\xc5\r\xb9\xd7G\xa7{~\x90\xf8\x06\xc0\xbeU\xde\xf2\xef]\xf9\xc0\x05\xf7\xfc\xfa\xa7\xebL\x18\xc8U\x95zY\xd55NmcލH\xdbd\xadB\xe1\x15\xfdHm\xbb\xaa\xaa\xa6\x03C\x00\xc0\x1e\x15\vE\xab\xc2[\xe9euz\xab\xdf\xfb\xd4`ת\xf3)\x06\xa8W->\xc8l5\xa8\xb5#\x82/\xe6ɝ\xe9\xb8֦\xa6Ѥ=,\xb7\xfa\xd5*\xef\x7f\xde\xe9\xf6\x7fC\x1c\x00`\xe9\r\xef\xff5\xf7\x9c\xfb\xd4\xf9u\xce\xf5\xb5\x9d\xe56\xa5\a@\x87m=ﮕU\xba6\x02\x00\x00|\xac\xb0\xa2ئ\xa6#i$vi\x1a\xad\x0e\x81\xe5\xf7\xd4\xd7\xc4\"\xbb8\xc0\x05\x80\xfd(5\xf5s\xfd\xa0s\xee\xf4b\v\xb0܊\x87\xde\xe9\xceE/\xa7-\x85b\xda=\xb3\x9cfS\xb5\xa3\xa9*oS\x95\xf2\xf7\xd6\xe6\xf7y\x01\xe0㉹z\xd9\x19\xb5ql\x85\xe5u\xb6>V\xf1\xfc\xdb\x16;\x81\xddq\xe5\x89\xec\xf5s\xf62\x96\xf3\x16\xa0w\xfd0X\xb4\xee\xe7\x0f?]\xb0\b\x1e\x00\x00\xe0\x80\x89~\xb8X\x02\x00\x00\x00\x80k\xacj\xa5vU\xad\x8e\xab\xc9W\xd5\xf4*\xb5\xc59)\x8d61ur\xbd\xb3\xf2~fǥVXvh~^\xf1\xf8a\xb97\xbc\xdf\xf9\a>L\xe2\n\x80\x03\xe3\xf8\xe9\x9fsG\xae\xfb\x12U\xc3uM^\x1a\xa6\x94?e\xbeR\xee\xed\x92\x19WT\xb7#5~\xaa\xac\xff\x92\xca!\x1d\x9d\x01\x00\xf6\xa8\xa2\x90\xcb\nE\x97w\x89\xea\xc1;\x85|\xa6P\x9eW(^P\xeb\xb6\xd5\xc6LYSʷ\x16\a\xa4\xbe\xae\xe2u\x9a\xf6\xee\xd4t\xe3\x0e\xad>pƕ\xb7\xfe\x7f\x89\x03\x00\xec+'\x1e\xfc\x85\xee\xba\xd6j[\x03[\xeaG\xafP\x9d\xe9:1v\xf1\xb0M\x8c\xc8\t\x00\x00^E\x8cr\xa9\x16\xd1\r\xcbn\xcbW\xde\xe2\x1e{\xc0,\xd5\x03[\xc2^`_\xcb\xd29\x9e\xa9\xd7;1_\a\xf6\x81\xd1\xf0MR-\xf9\xb6\x94o\x06ʪ\xa1\x15j\xe9\xe7^(O\x0f3M\xa4x\x03\xf0\tyy\x97[\x8c3\xb6\xfapc\x17\x8dU\xb5\xd5s\xaa\x9e\xfbˌʎ]s\xe5a\xf5\xec}\n:c?\xe6v\x1e\xacG\xfb\xc8\x14\xb9[\xd0\x1eSI\t\x00\x00p\xc0\xc4\xfc\xc8b\t\x00\x00\x00\x00\xae\xad\xd8VRvA\xae?U\x93\xe7\x1a7+\x9a\xccV\x15c!\xf5Zem\xa3f\x1a4\r\xb6\xbe\xf1\xb0\xb2\xd7G\x97\xdd\xfeV\x1eq\x028\x98n\xfe\xbf]\xf9\xc0YW\xddt\x8bT_\xb0)H\xed!\x95.S\xe6_\x9aw\x02\xd2ڶ\x86\x11{\x00\x00{S\xdd\\Pp\xb5|֓\x8b\x035U\xa9\xaa\xf6j\xa2\xc5\x06V|\xd56\xf7\xe5T*3+\xd7\xd6lݫZ\x1d\xa8\xff\xf0{\\\xff\x9eG\x89\x03\x00\xec[\xcd\xda\x17\xab\xca3\xf9vKY\xc8%פ\xbe<\xccH\x05M5\x01\x00\xc0\xab\x89A\xce{9\xe7\xbbQ\xd9\xf3\xfc\xce\xc5\x0e,\xb3\xd9\xf4)\v\x8e\xe9\x95\x00\xd8\xd7,\xf6\xabc\xae\xe1*\xd7m\xec\x1f\xbd{\xff\xab\x9b\xba\x91\xacRbJ\x15aUY5R\x16\v\xf9\xdc6\x86\xa06\xdd\xef\x00\x80\x8f'\rX\xad\xdcb\x9c֮\x19)\x91}C\xae>\xaf\xe9K?\xb68\x00\xb8\xfa\xae8\x12\xab\xa7\x8f\xcb\xc5s\xf3^\xe7\xd2h\xec\xf6\xa3\x9eK?\xea\xf9-\x7f\x00\x00\x80\x83\xa4\xcdn\\,\x01X&\x93'\xfe:M\x95\x00\x00\xc0\xbe\xe3\xd2PcѪ9\xd3m\xe5նV\\\xabQ/\xa8-\xa7\xaa|%\xcdnT\xbe\xfe\x06\xf5o\xfd+\xd2\xeb~\x95\xc4\x15\x000\xebw\xfe\xa6k6N\xab\xe9\x9dT\xd3N\xa4\xf6E\xe5\xa1U\xa1\x81T\x1fV[\x95\x8b#\x01\x00\xd8[\x9a\xacQ\x88Vv\xc5V>\x14*\xe3@\xfd\xb0\xaa\xb2]Q\x16F\xea\xf9\\u5\xd4\xd4ʵfc\xa4\xe2\xe6?\xa0\xe1\xdd\x1f \x0e\x00\xb0\xef\xe5\xaf\xfb\x7f\x9d\x1f\xbdIUj\xca\x16&\xf2\xbd\x81\xfc`\xac\xe8\xcf\xd9\xc5se~\x10\x00\x00\xc0+\xb9\xd4\x13Xzƒ\xab\xf5\xb5\x8a\xfc\x91\xc5\x0e,\xb3\xb6z\xd6^I\xf4\x03\xf6\xb5\x18U\xb5\xb9\x8a뿛{^\xd8W\xc2\xe8u\xf6\xfb\xae\xac\x8e\xb2-e)_\xcf۔2\xdb\xc7j\xe3L!\x8e\xe6\a\x02\xc0\xab\xf1\xc1\x8aȔ\xca^ڼ\xb5\xebȶr\vy\xe2\x85ǥ\xe7\xff\x06\xed\xe7\xb1+\xae8\x91=\xd6/\xcaǩ}\x90}TH\x89\xec6\xf7\xe9\xf7\x1b\xba\x18\x1e\x00\x00\xe0\xc0)\xeeX,\x00X*\xd3w-\x16\x00\x00\x00\xf6\x8ff|\xbb\x14\x0e\xcbg\xadr\x8d\xe5\x9b\xf3\nq\xa6\xa6\x7fX\xed\xa1\x1b\xa4\xdb\xfe\x98t\xef\xaf8]\xf7f\x1e\xe4\x03\xc0+\xe4\xf7=\xe6t\xf2KU\xaf\x15\nU&M\x06\xb6\xb5\x96z[\x8aYo~\x10\x00\x00{L\xaf\x9f\xcb{\xaf\xd8l[\x1cpA\xca\xc6R1\x91\x9c\xcdg۪\xb7\x8e\xd9A\u05eb8\xf6{\x95\xdf\xfdA\xa7\xeb\xdfB\x1c\x00\xe0\xc0\xe8\xdd\xf1ߜ\xeb=\xacF\xad\xe4\xed\xba\x98E\x85\x98\xae\x97$2\x01\x00\x80W\x91:\tNÞ\xc6B\xd1\ae\xb7~\x1f\xf1\xd3>\x90\x85M\xab\v^q\xfa\x04\x80\xbd\xacmU\xc7\xfeb\x05\xd8?\xcaC\x9f\x93\xeeh\xa4\xacS)\x7fɦ\xa9-\xa7\x84\xf6\x99U[\x82|\x9e\x9ee\x02\xc0\xc7\xe1k\xb5\xa1\x92\x0f\xebr)\xef7{I.\x93zuOz\xe9\a\x16\a\x01W\xd7\x15Gbi\x04\x8a\\\x16\x9b\xa7$\xf64,{\x97\xc4\xde(\x06+\b=7\xfa\x01\x00\xc0\xc1\xb3r\x9a\x9e\x1c\x81e\xe4\xeb\xc7\x16K\x00\x00\x00\xfbG\x9d\xbfO1\xf5\xca]\x1d\xe9\x1af\xb7\xa5\xcd\xd6\xeeT\xffug\xdc\xe0֧I`\a\x80O ?\xfa\x1dn\xf0\xba\x17\x9c?\xfe\x99\nÉ\xc2$W\x98\x0e\x95\xf5\x9f[\x1c\x01\x00\xc0\xde\x12&}\xb9X\xc8eQr\xb5\x05\x04V~US\xb5\xbeP\x1c\x1dVyݧ\xab|\xe01\x97\xdd\xc2s\f\x00\aS\xf1\xf0\xaf\xba\xe9`M\x936\xc85i\xc0\x96L\xd1m\xcdw\x02\x00\x00\xbcR欞`qV\x9b)d]\xda\x18\x96\\\xf8\xf0_\x8e\xb9\v\xf3|\a\x00\xfb[\xc1\xc8\xd4\xd8\x7f\xf2\xeb\xbf\xd5\xc5\xf2\xb4\xb6\xd3 \xec]\x12j\xbd\xe8x\xc7)\xcf[\xe5EZ\x06\x80\x8f#\xdau\xc2\x02\x1c\x17R|c\x17\x92\xee\xd6\xe8H\xfd\xac\xaff\xfb=\xf3c\x80\xab\xec\x8a\x12\xd9_\xfa\xf0\x9b\xa3w\xb9\xfd\x88K\xfbAg\x8a\xf6q\xd1\nĠV\xce~\xe0\xdd(\xed\x00\x00\x00\x00\xb0\x04|\xf3\xd4b\t\x00\x00`\xff\x18\xe4'Ֆۺ\xb0rF[\x87ߤ\xec\xf5\xd1\x15w\xbe\x93\x16:\x00p)\xee\xf8)\xd7\x1c\xfdfM\x8f\x1dV=<+7>\xb5\xd8\x01\x00\xc0\xde\x12&\xb5\\\x9b\x1a1fR\x9ek\x92\x1f\xd5\xd6\xe0SU\x1d\xf9\xa3r\x0f\x9cq\xba\xed\xfb\x89\x05\x00\x1cx\xfd\xf5\xaf\x93\xf77J\xed\x962\x17\xd4\xe6\xe5b\x0f\x00\x00\xc0GD\xe7\x14cTh%\x97\x11J\xed\a\xe3\xe9\xaf)\x8f\x16/\x87j\xb1\x05\xc0\xbe䥢\xbf\xbaX\x01\xf6\x97\xfcЗ\xa8q\xa74M+\xa9c\x96зJKa+\xadb\xb8\x90\xb6\x02\xc0\xab\xeb⚞\x82\xdbR\xf4\xb5B\xf4]\x1e\xb0\xcam\xe5\x8dԼ\xe7\v\xe8\r\x03W\xdd\x15e\x9aW\xcd3\xf6+\x1eؒ\x15|iD\xf6\xf4q\xa9g\x17g\x85`׳K\xda\x06\x00\x00\x00\x00{\x9f\xe7F\x1e\x00\x00؇\xe2\xecI\xe5ŧj\xed\xfe\xe8V\xee\xfc\x05ZZ\x01\xc0e*O~\xa3\x1b\xde\xf5\xb4\xeb\xf5\xbf@*\x9eXl\x05\x00`o)\x87\xbe\x1bUC\x95S\xeb\xafS\xff\xc8\xff\xac\xb5\x87~\xc1\rn\xf9Nb\x01\x00X\xc8O}\x9b\xd5\xeb\xdf(\u05cd:\xd4\xda\xfc\xe8|\a\x00\x00\xc0+\xa4\xd1\xd8SS\xf8\x98\x12\x9fS\xdbx,\xbd\xbazھ\xca\x1477\x8b-\x00\xf6%\uf557)\xc7\t؇N}\x87s\xf9\xadi\xe8YɕRkS\xca\xe5\v\xb5\xeaz\xb28\b\x00^E\xb0\xc9\xf7-\xceٲ\xa9Q\x1b\xaf\xd34X\xbd\xb8xI\xcaN\xa8\xbe\xf0\xcb\xf3〫\xe8\x8a\x12\xd9\u05f6\xbeׂ\xb9\xa9\x15\x80\xb5}\x92\x15\x85\xc1&\xfb\r{+\b]Jb\x0f\xe9W\x0e\x00\x00\xb0\xbfLK'5V\xff\xd1@ʧ\xaaR\xa3\xb0طJ\xfcT\xb3\xec\xd6\xf9A\x00\x96\xcb\xd3_\x1f\xb3ze\xb1\x02\x00\x00\xb0\x8b\xba\x96P\xadjWk\x9aW\x9a\x94\xb5\xea\"*\xa4\x11\x14\x95K\x95\xc5\x1bMT\f\xb9*7\xb2cVT\x15\x99\xaar\xaaڦq~Du\xdfb\x93\x9e\x1d\x97Uj-T\xa9둽\xf5\x84\xb4z\x93ܧ\xd8\x1f\xb8\xef\xe7IZ\x01\x80\x9dr\u05cf8\xbd!\xbaЍ\xc2d\xd7\xe9Ԉ5\xb3)\xac\xda5{M1ueޛv\xf7\x8c\xa4Yw\x0f\xa9M#\xe3\xa6,\x19߳\xe3l\xaa\x18\xf1\x11\x00\xf0q\x04+?b\x987B\xb4\xb2#\xbaj\xde&#%Nh}\xde\xd0(m+j\x85«\xc9\a\xaa\xb3R!\xcf\x15mR;\x91\x06\x1b҉?\xa8\xec\xa1\x0f9w\xfb\x0f\x10\v\x00\xc0\xab\xb9\xfb\xffq\xb3C\xbf[\xb3&\xddNyҮ\xab\xa9\xfe\xee\x15\xeb\xa3\xf3d\xb5\xae>\xbfa\xf5\xfb\xe3jS\xfd\x1e\x00\x00\x1c8>\xaf\x95ͤ2%\x8a\xad\xfe\xee\xc5V,\xb3\x95\xfaQ\xe5\xf9XmF\x82+\xb0\x9fͼWﶟ\xe0\x9e\x18\xf6\xad\xe1ښFӓR=\xd3v\x7f\"\xf5\xb6\xa4&S\xe9oX\x1c\x01\x00\xaf\"/캱\xad,\xf4\x957=\x15\xedK\x1a(Z\xc1ٗ\xe2y\r\xf2\xb3\xaa\xdf\xf9\xc5\xf4\xe0\x85\xab\xea\x8a\x12\xd9cK\xa2:\x00\x008xb\xean\u05f9\xb4\xd0\xe5\x9cd]\x82\x89\xad*W9\xb8\xae[\x06\xb0\\\x9a\xe6%{\xbd\xa2\xf0\b\x00\x00\xe0\U000b8402\n\x15\xc5@\x85\xb7)\x0e\x94\xb5=\xf9\xb6\x90B)\r\a\xd2\xc0\xea)\xe5\x962\x7fFexQe\xb5\xadr\xfb\xb0\x8a\v\xb7k8}Z\xc5\xf9-\xd5\x17\xbc\xb6\x9bR\xd3#ǤۿHz\xf8\x83Nw?\xc6\x03z\x00\xb8J\xfc#c\x17N\xfc~\x8d݊\xc2tf\x1b^\x90F\xcf\xcb幪fEu+\xb5\xbeP\xec\x0f\xe4{\x85\x82o\x14\xe3\xa6\x1dgS1^|\n\x00\x00\x1f#\xbf\xc1\xa6\xd4\xf9\xc9\x05\xb5ٶ\xa2\x1b)\xe6\xebj\xf3\xb1\xea\xfc9M\xcaB\xb3\xb8\xa1X\xf5嫱\xf2漊:*TQ\x93vK\xb3\x95\xaf\x90\xee\x7f\xd1\xe9Կ\"\x16\x00\x80\xdfF\xef\x96\xff\xe8\xca\xe1\x17\xd85\xd6V\xda\xf4\xec\xd7˕V\xa7\xcf\x1a\xa9^\xb5\xd5g\x15\x8b'\xe5g'\xe7o\x00\x00\x00\aKH\xedì\x8a\x90m\xa9\xd7c`\x93}\xa1\xb5\xa9\xeb .-\x00د\xa2\x1f.\x96\x80\xfd)\xbf\xfd\x87\x9c\x86\x8d\xd4x\r\xf3\x99B\x18(\xe6V\xb6U\xb6\r\x00.W\xe3\x14\xe3\xfb\x17+\xc0\xd5qE\x99\x1a\xbe!\x91\x1d\x00\x00\x1c<i\xa0\xad\xae\x16\x15C70J\xe63[n\xd5\xfa\xa1\xdc\xea\x9d\xdd1\x00\x96K]=\xd55P\x02\x00\x00\xd8mmSY8\x11\x15\x9b(\xd7dr\x95\x97\xafmG\xd7k\x96\xb4\xd9\\P\x95FU\x8c\xa5\xb2\xb8j\xfb\xd6\xecM+v\xc0\xb6\x94?\xd1\r\xf6\xab~OŉG4z\xd3č\xeez\xd2\x15'\xbe\x87\xa4\x15\x00\xd8\x05\xfe\xe4w\xb9\xe1#/\xba\xf6\x86\xfbT\xf5\xba\x81\x0f\xece\xa22\x8c\xe4\xb2\xeb\x15|\xa1J[j\xc2Ԯ\xf5C\xbb\x96\x1fV\xcc\n\x85\x92\x86$\x00\x80Wׄ\xe7\x15\x82S\fk\xf2\xd1\xea\xfeY\xabڍU\x85hq\x81\xb3mQ\xbdb,\xe7[+s2\xc5*\xa8\xe9W\xcaO|\xbe\x86o\x88\xaew\xcf\xf7\x11\v\x00\xc0%p\xaf\xfb\x11\u05ee\xff\xcf\n.*\xc6\xdc\xea\xec\xa93\x91\xd0%7\xa5\xfc\xa6n\xb2Z=\x00\x008xBj\"\xefG\xaa\xbc\xd5\x05\x8e\x7f+\xb1\xd6>\xe0ھԦ\x01k\xb8?\v\xecg1?\xb2X\x02\xf6\xafzt\xb7\xfd\u0603\x95m\xa5\xa2\x95k閆\x1a:\xd2\x06p\x05\xc2P\xa1z\xbff\x1f\xf8S\x8cʎ\xab\xe6\x8a25\xf2\x96\xdf&\x00\x008x\\Jv]$\xb2\x87\xb4\x9cFgo\x1b5\x1aJ7\xbd\x85\a\x17\xc0\x12j\xaa\xa7\xed\x95\xd3\x17\x00\x00\xec\xbelЗ+r\x8b3R\x03\x9a\xd4Hzf\xb1ƶM\xe7mzI\xab\xc5\xf5*\xc3Pq\\I\x93\xad\xf4\x0e\xa9\xdcP;8\xa4q\x7f\xa0涯\x90ް\xe9t\xc7\x7f\xa72\x03\x00\xd7Hq\xcb;\xdc\xe4\xd8_\xd2\xe6\xf0~5\xedq)<\xa7\xac\xddV\xd1J\xbdz\xa8\"\xf4\xec\xea\x9d2`Ҩ\xec\x03\x85vu\xf1N\x00\x00>Z\xd6o\x94z\xd3m\x9bLqjEǬR\xea\xffd\xa0u\x8d\xdc\xcd\xea\x05\x8b\t\xa6\xb6\xa3\x9d*\xac\xf5\xe4\xae\xffr\xe5\x0fN\x9dn\xf9\x0f\xc4\x03\x00p\x99\xca\xdb\x7f\xda\xf9ރjʶ\xbb\xfej\xb6\xde]\x8b\xe5\x86r\xa9\xee\x9e?\xbb8\x12\x00\x00\x1c$1\xa66\xf2\x03\xd5)1\fKo\xfc\xee/\x8bYH\x89\xecy\x97\xf0\a`\xffj\xb3\x1b\x17K\xc0\xfeU\x9c\xfeI\x97\x9a\xack\x92\xcb\xf9Z1\f$\x97ړ\x00\xc0erVG\x9e\xd5j\xab\xff\xb0\xd8\x00\xec\xbc\xcbNd\x0f\x1f\xf8\xba\x98\x1a\xe0\x00\x00\x00\x1c4YJ\x1cqA1\xfd\x13\xac:\x15S?\xad\x95\xfdC#d`Y\xc5\xfa\x8c\xbd\xa6\xa1O\x01\x00\x00v[nqE\x1a֣\xee\x1e0\xfa^+\xf5m*m[\xba{\xbb}N\n=\xf9\xe1!\xb5\xa3\xa8\xf3\xbd\xb3zi\xb4\xa1\xec\xe1\x0f\xbb\xe1\xc3\x17\\~\x92\x11\x17\x01`/X\xbf\xfe\xdb\xdc\xe1\xfb\xdf\xee\xfc\r\x9f\xa9i\xefF\x85\xea\x82TUv\r\x1f\xcd\x0f\xc8.\xd8u\xfe\x9cB\x9c\xa9m\xae\x9fo\x03\x00\xe0c\x84pLr\xb5\xf2\xe2\xac\xc5\x06Vv\xe4=\x8b\v\xd6l\x87\x95)Փ\xd2,ujuL\xedu\xbfK\xfe\xfem\xa7۾\x9fx\x00\x00v½\xbf\uec8d{5\xe9O4\xad|\x1a\xdaL\xcak\xab\xc7W\x8ai\x19\x00\x00\x1c8Yj\x10&\xa7X\x1e\x9eo\xc0R\v\xf1\xed\xf6m\xa6^\t\x8a\xf9\x06\x00\xfbWq\xc7b\x01\xd8\xe7\x06\x9f\xa6\x18\xb6\xe5B\x99zpH\x95\x17\x00\xb8|Y\xe8:\xea\x1f\xd4\xcf-6\x00;\xef\xb2\x13٫\xd9;\xa5\xc83Q\x00\x00p\xf0\xa4\x91\x12\xd3h\xec\xad\xf3\x8a\xe9\x06w\x97t\x12\xe4\x8aC\xdd~\x00\xcbǧ\xe1\x8dHd\a\x00\x00\xd7@5\xad\x15\xeaF\xb1M\xbd\x866]\xa7Y\xf2\xb9\x9aXh\x12\n\x85\xe1L\xd3\xf2\xac\xb6˙\xc2Ɨj\xfd\xe1\xe8\x0e\xdf\xfd\x0en\xcc\x02\xc0\x1e\xe5O\xfd\x80\xeb?\xf8\x94\xcb\x0e\xfd\x0eixR\x8d{Am\xbd\xad4 \xbb\x9cS\x9eG\xf9\xde\x13\xf3\x83\x01\x00\xf8\x18\xd3qmeF)+0\xe6\r\x0fö\xda未,W5:\xa1ɩ/S\xf6\xe0\xf3.;\xf5C\xc4\x04\x00\xb0\xc3\xfc\xad\xbf\xe8\xdcڧ\xa8Y=\xa36=7j\xecZ\x9cFbmx\x06\f\x00\xc0\x81䢂*\xf5\xfa\x0f/6`\x99\xb9\xf8\xac\xc5\xd8\x16s\xa7\x86\x7f\x91L?`?[9\xfd\xdd\xdc7\xc3\xc1p\xe7ϻي\x97k֔ű\xe4\xd3\x10\xed\x00pyR\x159\xcbK\xb9ن\xaawݜ\xb2e\x80\x1dwى\xec\xb3\xfa}\xf6z\xd9o\a\x00\x00XZ.u擪\xe7\xde)\xba\xd4SkjS\x96)\xebmt\xcb\x00\x96\xcb\xec\x83\x7f(\x16.\xc56\xd5|\x03\x00\x00\xc0.*\x8aBYYʕ\xa9ьSS\x97\x9aL\x0e\xa9\n\xa7\xd5\xe6\x0f\xe9\xccᛔ\xdd\xfc\xa75\xbao\xdb\x15\xb7\xfe \x0f\xdd\x01`Y\xdc\xf3_\x9d\x1ez\x9f\x9bm\xbcI\x93\xdeH\x8dV\xa4\xf6\x88\xed\xf0*\xfcl~\f\x00\x00\x1fcPn\xa7\x92B\x9a\xaek6Y\xd7\xf9pH[+\x8f(\xde\xf8\xc7U>\xf0\xac\x1b\xdc\xf4\xaf\x88\t\x00\xe0*\x1a\xdd\xfesn\xb8\xf1F\x85\xbeS\f=)\x94r\xc5K\x8b\xbd\x00\x00\xe0@qQ\xad\xabT\x0eߴ\u0600e\xe6\xc2D\x8a\xe9\xbel#\xe7\x18\x95\x1d\x00\xb0?\xf8\xf5O\xb1׳Rp\x9a9\x12\xd9\x01\\\xbe\x99s\xe9\xa2\"U}\x85\xe9S\x8b\xad\xc0κ\xecL\xf4\x10\xd2Mz\x9e\x91\x02\x00\x80\x83ǅ\xd8\r\xa2\xd5-\xbbL\x8aV\xa5\xcas\x15\xe5h\xb1\x15\xc02\xa9\x9b\x0f+O\xe7q\x1a\x01\x15\x00\x00`\xb7\xb5\xc1b\n\x8b0R}$\x16rZSop\x8b\x86\xc7?I+\xb7~\x81\x8e\x9dz\xc2\x15G\xffOn\xc4\x02\xc0\x92\x1a\xdd\xf5\xcbn\xe5\xd8\xd7(\xf6W4\v/J\x13\xbb\xe6\x8fO/\xf6\x02\x00\xf0ѼF\x16#li\x16\xcf(\x1b\x1d\xd7\xfa\x8d\x7f@\xeb\xf7\xfe\x8a\xeb]\xf7\x0f\x88\t\x00`\x97\xa4\x91ً\xf26\xc5✔\x8f\xa5\xc2&\x00\x00p \xa5Dv]\xff\xad\xc4c\xfb@L\xcf\xe3҈\xec\x8ar\f\xe4\a\x00\xd8'\xf2\xde\xe7\xd8K+5CUi8e\x00\xb8L\xb5\xb7k\x89;b\xd5\xe5M\xe5M\xae\xb3\x8f?̨\xec\xd8q.\xc6\xcb\xf8]=\xfd\xd7b\xf5\xfc\xb7\xa9\fy\xd7s\v\x00\x00\xc0\x81Ү*\xf6_P\xf0}\xb9i#\xdf\x16\xaa\x86\x13\x95\x0f\xa6\xa1\xda\x01,\x9b\xc9o\x1c\x8f\x83\xe9Ģ\xa3\xa9M\x16\xe3\x00\x00\x00|\x14\xab\xe6gA\xd17r>%\x9d\xc7y\xff7ij\x9d\xaaա\\\x9bwq\x81k\xbc|\xda\xeff6Uݼ\xf1\xa9\xf3+'\x17\xbd2\xf7\x8a{\xb1!=D\xf4R\xb9\xad\xba\x19\xa8\xcaNw\xbde\x0fn\xfb\xe7\xc4\x15\x00\xb0O\xb5\x8f\x7f]\xcc\xce\xff\x90\x05\xa2\xcfYٲn\xe5\x84šV\xbe\x04\x8bE\xd3]%g˩s\x93T\x10\xb8\xf1\x11{\xa9m\xff\xfc\x18+8\xbaϨ]\xa6\xa8Le\xd7!\x1b\x00`\xaf\x89\xbd\xa9\xa2]\xb6Cӗ\xb7:\xbfwv\xfd\xf6\x16\a\xa4\xb6\x15v\xbd\xdf\xecW*\xecZߋA.\xc5\x04\x8dmO1D\xba\u07bb6\x8d\x9d\xa3\xfe\xea'kp\xd7/\x12\x17\x00\xc056~\xecw\xc5l\xf3\xbf\xd85\xbbQc\xd5o\xdf\x1e\xb6k{\x1a\xdd\xec\xbc\xd5\xe77\xed\xbam\x8b\xb1\xec:@\xcf\x02\xf5s\x00\x00\xf6\x9d:j2\x98i\xf00\xed\xc1\x96]\xf3\x81/\x8c\xf9\x85\x1f\xb3\xa5Lլ\xa7<\x9fX\xbd\x8eQفe5-\x9d\xfa\xe3J\xca\xd7l:\xab\xaa.T\xa6\xce!\xf3s\x9a\xe9\x1e\xf5\x1ey\x94\xeb6\x0e\x94\xc9{\xee\x89z\xf1i\r\xf2\xf4\xbcq,\x97FUN\xd3+\xc5h\xff\xc6\xf9>\x00x\x15Qkj\xfc\xf3*\xdaSVm~BV\xb8J\xf7\x13\vag]\xd6]\xf4f\xf6\xbc\x85r\x8b\a\xaa\x00\x00\x00\aMJJ\x89\xde*\xec3y߳u\xabRe\xa9\xb6\x0e`\x19u\xbd.\xa7P;\x9d\xcb\x00\x00\x00\x1f+=\xd0k\xa4\xaa\xca5\x99\xf5mZQ\x1d\x0e[\xdd\xe1\x84\xc5\x01\u05eb\x1co+\xaf\xce+\x8bg\xe4\x8b\xf3\x8a\xbdm\x85^\xd3\xe5\xa3\xcc|\xa9<\xac(w\xb9\xb2\xbc\xed\x06]\xaf\xdc@c\xadi+\xef\xe9B/\xa8\x1e\xfe5\x15o\x18\xbb\xd1\xc3ow$\xb1\x03\xc0\xfe\x96\xddj\xd7\xf9\x87\x9eu:\xf1\xbf\xe9\\~^\x93\xaeW\xf34\xfe\xcfL\x99\x955~\xeb\xb0\xfc\xf6u\n\x93\xa1\xc2\xe8\x82\xe2`\xa2P6\n\x99\x95E\x16\xb3F\x15V\xae\xe4*#\x9d\xb0\x01\xc0^\x15ځ\x82]\xdb]1U̝]\xbf7,\xa6X\xb7B`\"\x15\xe7\xb4:s\xeaOK\xb9I\xa98\r\xaa\xc2TS;n\\ޠ\xf1\xe0~\x1dzCt$\xb1\x03\xc0\xde0\xbc\xe3\x87]o\xed\xab4՚\xf2XX\xbd\xfd%\x85\xf8\x94\xda`\xf5\xf5p\xbd\x9a\xe8\xed\x9a_)s\xfd\xc5;\x00\x00\xc0\xbe\x92\x92\xbd\xfc\xa1\xc5\n\x96YS\x8f\xa5z\xdeY\xb5\xeb:\x9d\xa6}\x10\xb0̺\x81<S2nz\x8eo\xb3,\x9b\x8fB\x1d\x95\xab\x1c\\\xd7-\x03\aIo\xf4Ŋ\xe5\xa6-\xcdo+w\xe7H\x9a>\x06I\xec\x00>\x91\xd4\x11F\xe1\xfb\n\xf1\x05[K\x83\xb6X\x9d\xf9\xb1?G\xe20v\xd4eEb\xb3\xc9\a\x95Ş\xda\xc5\xe8\x0f\x00\x00\x00\aK%\xb5+\xf3@\xdf\x156\xb3*Uvx\xb1\x0f\xc0\xd2i\xd2\bw6\xe7>\x1d\x00\x00x\x151\xa8\x1b)7\xcb\ney!\xdf\xf3\x8a\xfd\x99\x9a\xfe\x05\xb5\x83\x97\xac\x0eqDέ\xd9<= \x9f\xa9\x8dc\xb5m\xad\x10\ar\xe1\x90ToY\b1Ӵn\xb4\x99U\x9a\xac\xd8\xe7\x9c\xf8\x1c\xad<r֭=8vŝ\xdfB-\x04\x00\x0e\x9a۾\xddm<\x12]n\xe5\xc1\xb4פ\xe2C\xb60/K\xf2V\x99\x86\xaa\xad\x00\x9aŨY(U\xb5\xb6\x1eFV\xb6\xf4\xac\xccII\xeci\xccG\x00\xc0\x9e\xe4&\xca\xfd\xaa2\x7f}\u05c86\xba\x0f\xab\x89\xcfI\xcd\xc8\xe2\x82\xdb,\xb8ضc6\xa5b[u\xd9j2\xb8^\xe1Зh\xf8\xe0\x93nx\xefۉ\r\x00`\xaf9\xfd=NǾZ\xcaj\xbb\x8e\xaf+6'\x94\xf5\xb7\xe4\xfb/*\x84\x81\x9az\xa5\xbb\xf6\x03\x00\x80}(uRVޱX\xc12kg\xe7\x14\x1a[\x88\x99|\x16\xe5\x19X\x12Xj>\xa5ԥ\xb6~1t\xcf\xf23\x9f\x92\xedZ\xb5~(\xb7zgw\fp\x90\xf8\x9b\xfe\xbe\xf3kkv\x1eL\xe7I\xec\xaf&%\xb1\x93\xc8\x0e\xe0\x13\xf0\xe9\x1e\xa7;\xa6Vc\x9bۆ\xb6\xd4\xd6\xe4g\xe7;\x81\x1drY\x89\xec\xed\xe4q+\xe4R\xd2\x16\x1d+\x00\x00\x80\x03\xc8WR\x18\xa5{_VQ\xcfT\xa7\a\x17\xf9\xa9\xf9>\x00\xcb\xe5\xf1\xbf\x12\xb3PKYP˃*\x00\x00\xf0*\\\x11\xe5}\x90wQY\x1b\x94յ\xdct\xa60\x9b\xa8\x9dN\x15\x8b\xb3Rn1\x82\x1bJ\xed\x86\xed_S\xd68\x15\xedY\x15\xeeY\x9d+3M\x0fݢ\xfeM_\xa9\xb5\a\xa3[\xbf\xeb\x05\u05fb\xf9\xfb\xa8x\x00\x00T\xdc\xf4_\\\xff\xde\xe8\xe2\xd1ߩ\xd9J\xa5\xda?+\xf93\xd2ʋ\xea\xd5}\xf5\xeb\x81\xca0R\x11G\xca\xfd@\x99K\x8f\xf5,\x86\x8d)\xf3\x1d\x00\xb0\x17e\xd5-\xd2$\x93\xc6\xcfK\xd5\x05\x8b%\x06\xca\xcbC\x1638\xc5\xf8\xacb)Ms\xdbݻNّ?\xaa\xf5\x94\xc0~\xdb\xf7\x12\x1f\x00\xc0\x1e\xd6?\xf9O\x9d\xd6\xfe\xac\x9a\xd5\xfb5+7\x15\x9b\x89U˝ʬR\x96Mմ\xa9\xb3)\x00\x00\xb0\xef8'?|\xfdb\x05\xcb,k.h>v\x9f\x85߾U\f\x84\xe1\xc02si\xe0\xa9E\"{H\xcb)9\xb7m\xd4h(\xdd\xf4\x16Np\x1cH\xfd\xd1\x17\xd9\xeb\xd8N\x87W\x9c\x02\xe4\xfb\x01\xb8\x14)1&X\xa5\xb9\xcb4\xb6\xe5\xc6V\xeb\xf7\xa8}\xfa\xafr1\xc1\x8eq\x97\x93\x8c\xbe\xf5ˣ\xb8\xe2K5n[yL#\r\x01\x00\x00\x1c\x1c!\x9b\xca\xd57+\xf8\x0f)s\xeb\x1a[Ž<\xf2\xe5\xcao\xfbnn\x82\x01K&\xbc\xf7wE\x9d\xfd\x89ndպ\x89*\xb8y\a\x00\x00>F\xccB\xf7|Ϸ\xe9\x01x\xba\x17\x9a\x1e\x86\xe76\xbb\xf8P\xdc&\x97\x12\xd9'\xaa}\xa3\xd6\xe7ʊ#\xca\xcb#rźt\xdb/\x10'\x00\x00^\x93\xc9\a?'\xc6\xf1\x7f\x93\x1f7\xea\xa7\r\xdd3\xb8T\xceX\xb9s\xb1\xe1I\x1a:\xa8{\x80\\\xcc\xd7\x01\x00{K\x1a\x997\xb7k\xb5\x9f\xcd\x1bӆ\xa8V\x16P\x14\xad\xb2<\xe8l\xef\xb3u䮟$F\x00\x80%\xb5\xf5\x9e\xd7\xc5\xfc\xfc\xbbԯ\xbc]\xdb\xd7\xed\x9a?S\xed\xc7*\x9a\xae\x06\x0f\x00\x00\xf6\x91\x183\xb97m\x11\xbf\xed\x03\xf1m\xd7E7{N\x1a\xac*\xf8\xa9|\xeaa\xae\x1b\xd2\x19\xc02j\xb3BY\xdcV\xac\aj\xb2\x99\x8a\xbc\xaff2\xd1xx\x9b\xd6\x1ez/\xd7m\x1cXͯ\x1d\x8ey\xea|\xefc\x91\xdc\x0eൈ\xad\x82\xc5@\xbeWHa\xd3.*\xb9\xd2-\xd0ft\x8f\x86\xf7\xbc\x9d\xf2\x15;\xa2\xeb'\xe1R\r4\xb5\x1fhe\xe5\x19I\xec\x00\x00\xe0\xe0\xe9:euA>䊡R\xc8\x02I\xec\xc0\x92j\x9bwσ\xa2X۹}Y\xe1\x11\x00\x00\xd8\xe7ں\xe8\xea\xfei\x84\x06\x95c\xa9\xb7e\xf3s\xb6\xfe\x92\xa2;#\xd5/Z]\"m?\xa1b\xfd\xb3\xd5?\xf6\x97T\xdc\xffa\xe7\xee\xfaMG\x12;\x00\xe0R\fn\xf9I7|]\xedܡOS\xf0\x03\xb5.\xaaM\x89\xeb\xedT\n\x13+oR\xe3\x93L\x8d\x1f\xcd\xdf\x00\x00\xd8{r\x8b\x0f2\x8b\x17\x8a\xa9f\xf9L[e\xa3\xd9\xe8V\xe5\x87\xfe\xb0\xfc\x03ё\xc4\x0e\x00\xcbm\xe5\xaeG]\xffė\xa8\x19\xad\xaa\xb1zz\xac\xfb*D\xfd\x1c\x00\x80\xfd\xa8\xc9i#\xbf\x1f4O\x7futqS\xf2\xf3Ϊ\xdb4\xba$\xf9\x0f\xc0Rs)\x0f7\x06\xb5\xce+*\x9fw\xfe\xab W\x1c\xea\xf6\x03\aU\xbb\xf6\xd9\xf3\xa4\xf5W&\xae')y\x9d\x04v\x00\xbf\x9dX\xaa\rS\xab2\x0fS\x8d\xd9\x04\x95\xca\x14'\xef\x92\x1e\xff&.\"\xd8\x11\x97\x9c\xa9\xd1<\xfeWc\x96\xdb\xef/5\x98q\x8c\xf6\x00\x00\x00\x0e\x9e\xd0\x05\xfa3\xb9\xb0\xd6U\xd8]\x99n\x84\x01XFM\xf5Dwc[\xa1\xb6\xf3\x9aDv\x00\x00\xf0[\xe5\xa9aKz\x1a\x9e&\xab.D_h\xe2\xd7t\xb6\xb8A/\x16\xb7\xaa\xbd\xe1\xafJ\x9f\\;=\xf8!\xa7;~\xc2\xe9\xe6o!1\x05\x00pEz\xb7\xff\xbc\xab\x8f\xffy\xd5\x1b_\xad\xba\xffz\xd5V\xee\xa4vX\xe9\xe9p\x93\x05\xb5\x19E\r\x00\xecUM\xb6\xa2\xb1\x05\x0e\xe7\x94i\xb6\xf2\x88Vo\xfc\xebZ\xb9\xef=\xce\xdd\xfa]\\\xbc\x01`\xbf8\xf5\xc3.^\xff\xe75;rTm\xbe)\x8d\x8f-v\x00\x00\x80}\xa5 \xd9y?h\xa6\x8fIm\xea$ԫm\x83bj#D\x1a\x0e\xb0\xd4\\t\xf3\xf3\xd8;E\x97w\xdb\xf2<S\xd6\xdb薁\x83\xaaw\xfb\x0f8yo'Ij\xe3>\xbf\x1d\x1dc|y\"\x99\x1d\xc0oǻ\x81b\x98\xda\xe5\"\x95\xafij4hlvῤ\xdd\xc0\x15\xbb\xe4L\x8d\xbazV\xca\xec\xc7h\x85\x98#\xd1\x03\x00\x00\x1c@1U\xa1\\eK#[\x8e\xcaJڟ\x01\xcb*4v.w\x89\xec6\xf3\x9c\xcb\x00\x00\xe0Ud\x17\xac\xa20Ql2\xb5\xf5I\xab3|\xae\x06\x0f\x9dw\x87\x1e|\xda\x1d{\xf0\x03.\xbb\xfd[\xa9D\x00\x00v\\\xef\xa6oq\xfd\xd3\xff\xd6\xf5\x1f|\xab+F\x9f$_\x1cU\xb0\xb8\xb5u\x95\x1a\xbf\xbd8\n\x00\xb0\xd7lg\xa5\x06k_\xac\x8d\ak\xb7v\xc7ۜ;\xfe\xcd\xc4\v\x00\xb0\x0f\x15\u05fdٍ\xeez\xd2\xe5ŝ\x92\xfb\xe0b+\x00\x00\xd8O\x9c\x9f'Gb\xb9\xb5\xf5K]\u0383BT\xdb\xc64K_\xee|'\x80\xa5\xe4\xd2\xf9|q\xd9e\xa9\xd1_\xcadWQ\x8e\x16[\x81\x03\xecUFd\xef\x92\xd8_1\a\x80W\xd7(\xf3\x87T\xc5\xf3Vw\xee\xdbz\xcf.\x1c\xad|<\xa4z\xfak\xf3C\x80+\xe4.\xb50\x9a\xfej\x19\xfbm\xa6\x90\xca7\xf5\xed\x03\xa6\x8b=\x00\x00\x00\aC\xdb\v\xca&\xb6\xd0Tj\xb3\x13:wCԑ\x93\xcf\xd1\x18\rX2\xed\xfb\xfe\x8f\x98;U\x9a\xadk֜W/\xde$\xf9\x17\x17{\x01\x00\xc0\x9eѦ\x87\xcf]\xab\x12)\xf36\xcdGH\x8f\xb1QH\xc3\xd3f\xa9\xebW\x13S\x8f\xeb\xe9P\xa7\xb0\xe8\xbf3\xad\xf75\xb3\x85\xd0uʞ\xd6]\x1a\xc56\x96\xf6\u07bc\x1bݶȷm\xddv\xb6\xa5d\xdb:\xbe\xb5\x03\xed}v\xe8\xf3\xeb_\xa6\xa2w\xb3\x0e\xdd\xf4\x8fl\r\x00\x80kg\xf2\uebcc\xd9\xd6O\xa9h\x9e\x97\xebo(\xf8s]\xc3\xcb\xd8\xe4\xf2\xf5!e1\x95\x91\xe7l\x9aZ\x01\u05f7\x1d\xb63\x15vI\xd7h\xe5\xe2dR\xd9\a\x00\aRa\xd7Ʃ\xc5\fu\xd7\xed\xbf\xcfӵӦ\xdab\x8c\xbaU۳\xeb\xa7k\xe4|\x1a\xa5\xad\x997\x8a\x8d\x85\x1d:\xb0Ki\xa1\xcc.\xab\xce\xd9{\xdb\xc9b\x7f\xcf>\xf2\x98\xb2\xfeMr\xe5Q\xe9\xf6\x1f&n\x00\x80\x83\xe8\xad.\xaaZ\xb7\x85\x15\xa9\x97ʘ\x99\x9a\xd6\xea屵zza\xe5Jc\xcbVD\xa4\xa4\xa9t\xdb*\x95\x16\xdd\xfd([h\xd3\xfd\xae\x8bi\x18\x00\x00`פ\x11KU\xaan&ʋT\x16\xdbz]ZlWJͦ^\xb8\xee\xcf\xe8\xd8\xcd\xff\x80\x18oɵo;\x14\xbd\xceY\xb5\xecf\xf9\xe2C\xb2P\xde\xe2\xf9C\xf2.5\xfe\x03\xb0\x94\xdaU\xc5\xfe\v\n\xbe/7m\xe4\xdbB\xd5p\xa2\xf2\xc1\x14t\x01\x98\xfd\xa6\x8b\xbd\x97F\xd2ƶ&\xedPEL-h\xa6j\xd4W\xc9\xed\a\x00\x97\xa9Y˕ߵIY\x8b+r\xe9\x89\xeco+b?\x16\xf3F\xa1!\xb7\x02\xad^\xec\x01\x00\x008\x18\x9a^\xad|\x92\x1a\xbb\x055y_ӛ\x1f\xd4\xcau?K\xc5\x1cX2\xe3G\xffX\x1c4\xdf%W\x95\xaac\xa5\xa2\xbd^\xca\xce.\xf6\x02\x00\x80=\xa3\x9cZ\xdd\xdb\xe6m\xba\x1f\x99\x92Fl\xf9\x15SS\x8fl\x9b[\xb4\x03\x8e\xe9\x8e\xe7|J\xd2\x1b\xac\xea\xae`;\x1b\x9bҼ\xcb\xe7KO\xe7R\x02|\x9a_\xa7\xe8\xb65\xf5\x13\xd5\xe5P\xbew\x97\xca\xe1\xff\xa4\xf2\xe4ߥ\x8e\x0f\x00؛\x9e\xf8\x1bq\xf2\xd2\xdfVY\x1fW\x16W汬?g;r\x858\xb0\"ϫ\xa8\x82\\J\x86Iɖ\xaeRkEcL\xbdT\xa7$\x9a\x94\x889O\xcd\x04\x80\x83\xa7\xb6\xeb_\xea \xcbە0z\xbb\x1a\xa6\x8e\xb3r\xbb6\xe6\xf26e\xfeiۗ:\xb8\xb2@\"\x8d攒\vC쮡)\xeeP\xa8\x153\xbb\xae\x96\xad\xea\xfea\xc5\xde\xeb5\xbc\xedǉ\x1d\x00\x00VO\xff\xac8\x1d\xffL\xd7!z\xba\x1d\xa5\xb0jeɚ-4VG\x7fA\xce\xea\xeb\xa9.\x1e\x82\x95#V\x9eX\t\xa4<\x95 >=wNk\x00\x00`W\xa5gI~`a^e\x85r3\x8f\xf9\x1a/Wd\x8a\xf5\xb6ܛ\xd2\xcd4,\xbb\x90\x12\xd9\xe3\xa6ڸaծ3\xd2\xcc\xea]\xf9P\xce\xeac\x00\x96T(\x15\xcb-\xb5YT^\r\xbb\xc7\xfe\xd5J\xad\xf2\xbe\x19\xd7m\xc0\x84\xf7\xfe\xce\xe8\xcf\xfc\x98\xd5uF\xaa\xcbBY\xb4z\x8d\x95{!\xbfNY\x93\x9e'\x02\xc0\xa5\xabz}\x95\x0f\x9c\xa5\xac\xc5\x15\xf1\x8b\xf9k溸<[\xf4@\xbeh\x10\n\x00\x00p\x80\xc4\xd8Z\xa4\x9fKy_\x8d\x1fk\xa5\xf7\x19\x8b=\x00\x96I5{\xbf\xd4\xf4\xba$\xf6<+m\xcbt\xbe\x03\x00\x00\xec)us\xc2\xca\xeb\r5idپ\xb7)50\x19\xa8\x8d몫\xa3\xcac\x1a\x15\xb1\x90O#\xb7\xa7d\xf54\xcd\xec\x8d3\xab\xb7\xcf\xe2|\xaal_\xe8\xd9Ɓ\xe4J\x85,K\x83\xb2\xdbg\x05\xc5#\xf7\xca]\xf7\x15\x1a\xbcq\xea\xd6\x1e|ɭ\xdc\xfd\x8b\x8e$v\x00\xc0\x9ev\xeao\xb9\xc1\xc3\xd1e\xd7\x7f\xad\xea\xd5gնg-\xa4\xb5\xf2.%[z\xa7:ݷ\n\x9b\xb6\x9e\x86\x17\xb2\xb2/\x1c\x91\x8b\x87\x15\xddH\xb1ȬHL\x9d\xb9\x00\xc0\x01\xd5+\xa4\xd2b\x83^\xa9\xac\xe7\xe5\xf3\x99]\x1b\xcf\xdb\xfa\v\xd2\xf0Y\x8b'V,\b\xb1\xd8cj1D\xba\xb6\xa6\x84\x06M\xe4\x8a-\x8b\x1f65^\r\n\x87?I\xb9]\x87\a\xf7\x9cq$\xb1\x03\x00^v\xea\xa7]\xff\xf0\u05ebX\xef\xa7~O\x14Ʃ>~\xc6ʘ\xa9\x9ato\xcbE5~[.s*\xfcay\x1dQ\xa3\\\x15IT\x00\x00\\\x1b!u\x02\x99\x12أ\\[ty\xed\xa9\xe3\xb2\x18ӈ\xa5\xb6\x82\xa5\xd7>\xf1Mч`_\xecP\xad;oߵm\fC\xfb\xdeӃD\x00˫\xb2\x13|%5\xe4\xb5\U000f9c19\x97\xb2Ë}\x00\xfc\x9d?ꪁ\xd4ԥ\n\x95\xf2!=C\x1cv\x03\xd9\x02\xc0\xe5r\x93L\xb3\x0f~\x1e\x81\x12\xaeȥ\x8d\xc8\xfe\xa17\xc7\xfa\x99\xbf\xa3\"5\x1a\xcd&\nS+\xd4|7\x84\x11\x00\x00\xc0\x81Q\x15S\x95\xe3u\xa9\xf0\xba\xe0\xcfk\xed\xf5-\rՀ%t\xf6Wn\x8d\x87\xfc\x05U\xf1%\x95=;\xa7S\x83\xa24Z\x1d\x00\x00\xd8SfŨ\xebL*\xc4\xd4\x02\xb8\x9a7(JϢ\xed%\x8d\x8e\x91W\x8b\xc6&\xe96\xe7\xa2f\xdeھ؍k%\xe5\xf5\xba\xa2oT[\xfd\xbd\xe9o\xc8\xf5oW9|\xa3\xb2뾅z<\x00`\x7fx\xea\xafDm\xfe\x98ڭ\x0fI\xcdyeY\x8ao\xaf\xb7\xb2qj\xd3\xd8\x0e\x98)X!\x19UZ\xd99\xb2\xf225Za\xc4\x05\x00\aS(,p\b\x92\x7f\xf9\xb6~\xba\x1fh\xd7M\x8b3\xba\x90\xa2\xb6`\xc3\xd9\x01\xb6\xbbI\xa3\xb6g+\x8a\xc5\x11\xf9\xe2\xb8\xf2|M\xfe\xf4\x8f\x11G\x00\x00~{\xef\xf9\xda8\xdd\xfeQ+L\x9eWVƮ\xe1\xb8\xd2\xe01m*\x87\xd2x\xed\xa5\xa2\x953\xa9#\xaah\xe5\x8eo\xb7\xe7\xef\x03\x00\x00\xbb&=w\xf2\x85\x95\xcb\x16\a\xc66\x93+\x1a\xc5l\xd2E\x89m\xbb\xa2\xde\x1b6\x89\xff\x96\xdc\xf6\xbb~O\x1cm\xfe\x84-\x15\x9a\xfa\x97\xd4ϭ\xee5>&7x\xc1\xeai\xa9\x03l\x00\xcb)u:rBM\xfea;\xbb\x8f\xa8\xaaj\xb9#\xf7\xa8\xb8㗸n\x03\vۏ\x9d\x8eŹ\xc7U\x16\xabv\xcaL\xac\xdc[\x9d\xb7}\xefj:\x00p\x19\xaaR[\x1b\x8dV\xeeݦ\xbc\xc5e\xbb\xa4D\xf6\xf8ؗ\xc6\xf8ҏ\xcb\xe7\xb9TN\x14ƅ\xbc_\xec\x04\x00\x008 \xaab\xa6r{]\xea5:\xe7{\xdax\xe4E*\xe4\xc0\x12\xda\xfc\x95\x95\xb8\x9a\x8dT\xe9y\x95\xc5ai|A\xca\xe8u\x12\x00\x80=ǧ$\xbcRj\xd7leh˭\x94[\xb9\xed7\xad\xec\xb6Mi\x80\xc4\xd4\x0e\xb8\x9b\xfa\xaa\xf2\xbeB>T\x9b\xf5\xe5|\xa6\x8d\xe1\xa7J\xc5)\xe9\xf87So\a\x00\xec\x7f\x8f}NT\xf5\xa8\xe2\xf6\x05+2\xd3\xd8Q\xf5<Խ\x18\ueda1\xcbה\x95\x93\x00p\x10͊\x99\xb2蕧\x84\xf5\x94H؍B\x93\x1a=\xa4)\xda\xea\x055Y\xab\xba\xc8Ԕ\xa9q\xfbCZ;\xf5_\x88%\x00\x00\x97\xa5\xfa\xc0\x1b\xe3\xf4컵\xdanu\xfd\xa4H\xc7ԍ\x00\xeaӽ-\xab\x9e\xc7u\x85x\xa3\n}\xa0;\x1e\x00\x00\xec\x9e&\x06\xe5Ek\v\x16\x17\xd6}\xa9\xac,L\x9c\xa8\xb281\xcfnW\xfe\xc0\xbb\x89\x05\x97܅_\x7f(\xaeUﵥ\xa1\xc6\xf9\x19\r|On\xb2!\xf5\x9f\xb3\xef\x9d\xfb\xa3\xc0\xb2\n\xd9T\xae\xbeY\xc1\x7fH\x99[\xd78\xb6*\x8f|\xb9\xf2۾\x9b\xeb6\xf0\n\x93G]\x1c\xa4~\xf3Z+\xf3ܚ\x9a\xf2y\xe5\x94\x7f\x00.\x97\x95\xb7ӬQ~\xe4k\x95\xdfB\x99\x8b\xcbsI\x89\xec\xd5;O\xc7r\xfb9\xc5\xcc\xdeSnˍ\a\x92\x7f\xed\xef\a\x00\x00\xd8\x0f\xaa<(\x9f\x94\U000bda76\x8b\x875z\xe0W\xa8\x8c\x03K\xa8~\xab\x8f\x85\xd6ոs\xca\xcbUi{K\xca\xe8q\x19\x00\x80=g2\x9d\xe7\x94\\\xcc/餕\x81\xa2\xeb\xe9|\xb6\xad\xcc\x1f\x96+nQٿO\xe5\xedo\xa1~\x0e\x008\xf0\xea\xf7~il\xaa3j\xaa'\xe5\xe2\xb3\xea\xbbz\x9e˞\x1e\xeb\xa5\xc1\x16\x1c\rU\x00\x1cLU\x91\xa51q\xa5P\xdb\xf5\xb0\x91\x9a.\xabP*JŢЋY_\x83\xc1\xfdZ\xb9\xfd\xa7\x89+\x00\x00;&\xbe\xe3\r1\xd4\xcf+VO[\xbd\xdcʞ\xd49\xa3\xef\xd9R\xd0\xd4ʤ\xa1\xa8\x9f\x03\x00\xb0\xdb\x1a+\x90\xf3l\xdb\xe2C/\xd5kR>S[L4\x8b\x874\x1c}\xa6t\xe7\x0f\x12\x17.\xb9\xad\xb7\xdd\x10W\x9a\x17\xbaN=\xa7\xf9\x96\x8afEY۳\xf53VA\xa3\xfe\x05,\xab&\x9f*\xabo\xb4\xf3\xf89\xc9\x15\xdaΝV\x1eatX\xe0cM\x1e\xbf9\x0e\x9e}ʖJ\xa9?R\xcc\xcf\xcaM)\xff\x00\\\xa6l\xaa\xd8\fT\x8fnPy\xef\xfb(wqY^n\xfa\xf9Z\xb4\xcd\a\xed\xd5)D\u05f5s!\x89\x1d\x00\x00\x1cH\xb1T\xf4\xadb֨\u07fb{\xb1\x11\xc02\x99\xbe\xff+b\x91\xa2\x9a\xb0ekC\x8buf\x16\xea\x10W\x03\x00\xb0'\xad\x1e\xb7iM͠\xd0x\xd0\xd3\xe6\xe8\x06m\x1f\xfa4\xd5'\xbeB\xee\x86?\xad\x8dG\xc6n\xf5\xa1\xa7\xdcʽ?\xefHb\a\x00`\xae\xb8\xf3\x87\xdcྟw\xab\x8f<\xe9¡O\xd1vyL\x930\x94\xeacR{\xdb\xe2(\x008x|\xca_\xaf\x1b\xc56*\x84RU\xef\xb8\xc6k\xf7k\xeb\xc8gir\xfcw\xe9\xd8}/:\x92\xd8\x01\x00;\xcd\xdd\xffV\x97Y\xdd|\xb6\xf1i\xda\xf4\xc74\xab\x8fJ͆|\xe64\x1c,\x0e\x02\x00\x00\xbbʩ\xd4|,\xb8\xd4\xc1\x99-8\xa7\xd6\x16\x9d6\xa4\xc1\xeb\xd2\x0e,\xb9<N쵱/u\"o\x91~h3\xdbh\xdbB\xd7\xe5'\x80%\x15R\x1b?7\x93\vkvݞʕ\x8b\x8e*\x01|\x94~\xf15\n~\xa8\xe8\x83Mv\xae\xa4r\x10\x00\xae\x80\v^\xf9\xf69\xe9\x83\x7f\x91\x84b\\\x96KJd\xb7\b\xce\xde\xe1-p\x8f\x8b\xe0\x9d\xe7\xb7\x00\x00\xe0\xe0\x89V\tw\xce\xeaE\x16\xd3g\xbd\x9b\x16[\x01,\x93\xaaz\xa6{\x0e\x99F]\x8a\xa1T\x1b*\vo\x88\xab\x01\x00؋f\xab\x9f\xabp\xe8\xeb\x95?X\xb9\xe1\xfdS\xb7z\xef\xd3nt\xc7ϸ\xe2Կp\xba\xf1\xcdܠ\x04\x00\u0df1v\xdb\x7fs\xeb\xf7=\xef\x06k\x7fP\xa1\xd7S\x93\x7f`\xb1\a\x00\x0e\x9e\xbc\xbf\xad,\xab\x15\xe3\x8a|\xef~\x95\x1b_\xa5\xe1\xbdow+\xb7\xfe\xa8\x1b^\xff\xbd\xc4\x17\x00\x80\xabjt\xf7Ϻ՛\xff\xb8\xca\xf5ê\xdcsҴ\x92\xc6\xc7\x16{\x01\x00\xc0\xae\xf2٢-|\x922\xd8\xe7+QC\xe9\xe6o%>\xdc\a|j\xdf\xd7Is\xaf\x10s\x9bY\xfd+\x8d\xc2\x0f`iŔ\x02\xe5\xec\\\xd6Ȗ\xa3\xb2\x92K6\xf0j\xdcM\x7f\xc7\xf9\xdeqŢU\x1d*5ӕ\xc5\x1e\x00\xb8tm\n\x97\xc2L\xbe\x1eh2\xf9\xf1\xf9F\xe0\x12\xb9\x94\x94\xfeZ\xc4'\xffd\f\x17\xbeKq3*/\xd6m\xc3\x19\x9bV\xed\x13\xea\xc5\x11\x00\x00\x00\aD\xdeʢz\x8d-\xb8\x1f>\x1c\xb9\v\x06,\xa1\xf6\xd7N\xc40\x1b\xcb\x0f\xb6\xa4j\xa4,z\x85l*\x1f\xe8u\x12\x00\xb0\xf3\xea|:_\x88\xf360\xceʝ\xd4+\x92_4\x92\xa8\x16=\x84\xcf\xdbǄEϓ\xe1b{\x19+\xa7\xca\xf9Br\xb13\xf1\xb4/,\x0eHC\b\xa4^\xc7\xd3\xe7v\xd3b\xf9b\x1f\x96yz\x88k\xe2+z\"\x7f\xc5r\xc8\xe7\x9fﺿY۾\xee\xc3m\xbehܑ\xa7\xf2\xd1>+\xa4\xaa\xef+>\xbb\x9b[\xb9\x9am\xdaV\xfb\xdf\xe3\xd36;6\xfd\xfd\xf4\xd6t\a;\r]\x91\xea\xcf\xdd\xff\xf8\xf4j\x7f%s\xf6Vo\x93mp\x99B8n\xc7\x1cRֻ\xc5\xca\xe6\xbb\xe5i\x1c\x04\x00\xc0U7{\xfb}Q\xcdy\xf9\xf6y\x15J\x9d\xbb\xa5\xad\xf6\x12V\xac\xfc\xee\xa9.&Vn\xd7r\xbe\xb1jF\xec\xcayoGƐw\x93O\xf5\x90\xaeΰ\x98\xdbq]\x05 \xb7\xb2?5\x10\xad\xfb\xe9\x03\x01\xe0\x925Y\x8aE\xec:\xa3ܮ?)\xa9\xc0\xae)]\x9b\x84t]2\xf16\v\x85f\x16\xb1lZ\xe8\xb1\xfdr\xa3\xf4\x14\x12u\x91J}\xbd\xc5\x1a\x9bv\xdcVwi\x9b\xc7U\xc5\xfc\xdad\xefzq\xf8&\xf5W\xef\xd7\xca\xc9\xef\"\xee\x00\x00\\s\xcd{\xbe \xd6\x17~Y\x83\x98\xee\x1fZ\xbdܧ:\xb5-\xa6\xaa\xb9\x95_m;\xb0\xfawOy\xf9\x82\x82U\xb9c3TlGV\xfe\x95\xf2\xa9\xcc\xf4\x93\xae\x9c\xf4\xa9.n\xab\x8a\x85ͼ\x82\xb3-\xd1-n\x13F\r\xda4\x1a)\x00\x00\xf8(uOӕm\x95\xb3V>\x8fjs\xaf\xec\xc2\r\n\xa3\xa7\xe4\x1f\xa2=\xd8\xd2{\xe7룦g\x14\xea\x17\xa5l\xdc}\xc7ݍ\x85\xeeYf\x9a\xe6\xcf9\x01,\x9f\xb6\x17\x94\xa5\x10\xa7\xa9\xd4f't\ue1a8#'\x9f\xe3\xba\r\xbc\x8a\xf3g\xbf>\xfa\x0f|\xb7VU\xabv^E\xfb\x8a\xf6G\x00p)\xa6R\xd5\x1b\xa8.Ω߮){\xc39\xca^\\\xb2ל\xc8^?\xfe\xfb\xa3\xbb\xf0C\xf2\xd3L>Kw\xcbS\xa3\x96t\xe7\xfc\xb5\xbd\x1f\x00\x00`\xdfX$\xb2O\a\x85\xfa\xf7O\xa8\x84\x03K\xa8y\xeb\xd1蛩\xe2`\xfb\xe5D\xf6\xd6Ol\x9eb\x1c\x00\x00vV\xebS+\xd2t#\xce\xcb9\xa7\x94\xf4=߰\x98\x87ECҮ\xc1Dږ&\xabf\xa6\xfdQ\x9a\xb8i\xf7\xbe\xc4/\xb2۳n\xff\xfcs\x95\xb5]\xc2F\xba\xcf\xd7\xdd\xeb\v\x1f\x99'\x8d\x1f\xbe|\xacO\x1fh\xcb\xfe\xe2=A[v\xa1\x98/\xdb\xde\xee\xeft\xff\x1d\xf6߹\xf8\x9bMmk>v\xff9>%\x9f+\xa8\xb5\x7fBl\x14B\xab~\x97Ȗ\xdeg\xff[\xed\xbdMz\x9f\xcb\x15|\xfa\xaf\xcc4\xeem\xdb\xfbV\x94e'\x94\xe7'U\xe6\xb7+\xbb\xe5\x9f\xce?\x1c\x00\x00\\S\xb3\x0f}S\xac6\x7f\\Y\xf5\x0e\x95\xed\x96\U000943de:\xdb)\xd6R\xe5\u0096\xbbl\x18\xab\x04\xa4\xbaCڙ\xb2g\xac\x1e\x90;Ő\xea\v\xb9\xd5O,\x96\xee\xea-QM\xd3tS\xbf \xbe\x06p\xb9Fv=I\x1dlY\x9c\x14R0b\x9b\xdc\xc0\xaeC)\xae\xe9Y\xe8\xf1\x8c]{Ҷ\xbe\x1dcS\xb0\xebMk\xd3ˍ\xd0?4\xdf\xe7\xd75\xf5\xad\xa6E\x94\xefߢ\x95\xd1\uf43f\xe9ۈC\x00\x00{R\xf5\xdeO\x8amznU\x8d\x955V/\x0f[\xca\xfc\xd4ʴT\a7\xf5![\x9eI\x99m\xeb\x12\xd8m[\xba\x17\xd7\xe4jZ\xaf^\x98w\xec\xd2mO\xf7\x0f\xd3<ݓ\xec\xd6m\xaa\xe8h\n\x00\x80ߢ\xe9\xa9\x1el+o\x82\\\x16\xd5Z\x19\x9am_\xaf\xb06\x93\xbf\xff\f\xf1\xe3\xb2\xfb\x8d;\xa3\xaaM\x85pA>\xb7zT\xf7\xbc\xd6*Gmz\x0e[X]jQ\x7f\x02\xb0t\x9a^\xad|b\xe7q\bj\xf2\xbe\xa67?\xa8\x95\xeb~\x96\xeb6\xf0qL\x1e[\x8b峕\xb2Qm\xe5 \x89\xec\x00.S\xcc5+\xb7\xa4\xfa\xa8\xf2\xf8\xa2\xb2\xb5ϗ\xee\xfeQ\xca_\\\x92ל\xc8>~\xef\xa7\xc4r\xf3\xed\x16\xb0[\xa5/?o\x05\xd8!)ۜ?\x18\x06\x00\x008H\xf2V\xb1\nj\xd7\xefV~\xf7oR\x01\a\x96P\xf5K\xeb\xb1\xf4Qm\xb9\xdd\xf5\xb2\x9d\xa9P\x88i\x04\xa7\x8b\x89|\x00\x00\xec\xa4ECы\r\"\x16\xf7\xe3\xe6\xf7\xe5\xa2\\\x971\x96\xaa\x95\x17\x1bO\xd8ԍ~\u07b58M\xf7\x81M\x98\x1f\xdf%\x9e\xdb\xe7\xb8E\xb2\xbam\xaf\xfcj\xb7\x9e\x12˺QS}k\x9f\x96FPM\x7f/*\x84A\xfa\x00\x13\xba\xe3\\\xb4\xcf\xea\x86L\x9f+\xd3\xc8\xe9\x17u\x89h\xb9\xedO\xd3<\x11\xc4\a+/\xbbDu{\x7f\xb0\xcfs\xf3Q\x9d\x9c\xb7c}\xfaK\x9b\xf3\xf7\xf8C\x8a\xc5\t\xb9\xf2\x06\xe5\xfd\x9b\x95\x95\xc7\xe4\x8f\xff\x1d\xea\xcb\x00\x00,\x8b\xc7\xffBl'?\xa3f\xf6n\xf5ꪫ:\xcc+\"V'Hu\x8d4Ŕ\xc8^\xabM\xf9\xa4\x17Ky\xab't\x8bVo\xe8:\xc2II\xed3\xaa\x00\x00.SH#Қt\x19\xe9\xae)\xe9~\x9d\xcdSL\x92\xa4\xf8\xe5b\a\x1a\x16\xb5t\x1d\xf0\xa7N6\xb2\x14'IU\xb3f!\x98\xc5D\xa3\xebT\xac|\xaet\xfd?[\xbc\x11\x00\x80\xe5\xb0\xf9\x9eό\xd9\xf8\xed\xea\xcd\xcew9\xebj\xad,,V҂\x95{\xa9\xbc\xb3\x8d\xa9\x18L\x9dMu\xf7\x04ms*2\xd3B\xaa\x93\xa7M\xa9s\x974\xa5\xf7'Y\xbdX\x00\x00\x00/k{\x8a\xfd\xb1T\xa7gu^\x8dŗ\xf9\xf4\xb8t\xec\xb4t\xe7/\x10K.\xbb\xffq<\xc60\xb3J\xd2L\xae\xb0JQ\xaa$\x05\xabD\xa5\xbc\x87\x98\xee5,\xee?\x00X:u9U1\xb6\x18ɮ\xddS?V\xff\xb6\xff]:\xf4-\\\xb7\x81\x8f#>\xfd{\xe3\xf4\x83?\xa8A\xaf\xb4\x95\xf9}\x04\x00\xb8dV\x9f\xaeʙ\xf2\xea\xb0|xI\xb3\x9e\xd4{\xf0\xe2\xc3;\xe0\xb5y͉\xec\x9b\xef\xbc9\x0e\xb6\xce*O\xbd\x97\x17g\xa4\xd9\rR\xf9\x8c\x05\xf2\xf4\xd8\n\x00\x00\x0e\x98\xacU\xdd\x04e\x87\xbfL\xfe\xf4\xf7S\x01\a\x96L\xfd\xfe?\x1b\xdds\xffRy\xafP\x9dM\xe5*uqN\xd4D/\x8f\x90\v\x00\xc0Nzy\xc4\xf3\x8b\xf7\xe1\xe6\rN_\xbe/׳\xc2h\xb1\x986]\xbc\xc5\xdb\xed\xb6)O=\"\xa7\x9c\xf6\xa4\x1b\ru\xd1آ\x9bҪ\xcd\xd3\xe6$f\xb6\xd8\xef\xe6N=ې\x1a\xacn\xcd\xf7]\x94\x1a\xbb\xbe\xf2\x9e`X\x8c\xd8\xdeM\x8d\x1aW+\xd8<\x8d<\x91\x1a\xc1\xe6\xc1>'%\x8f\xb8\x91|\xb6!\xdf;\xa2\xcc&\xf56\xa4\xc2\xde{\xf4-ԉ\x01\x00\xd8gƏ\xde\x1bc\x98ʷ\x9bV\x17\x98\xaaH\t3\xa9\x91gmSj\xf0\xe9S0m\xf5\t\x9f\xea43Um\xdd\xd5a\xf2\xa2\xb0\xc9\xea.S;\x1e\x00.C\xe3R\xfc\x91b\xa6\x99\x9c\xc5&\x89\xb7\x7f\\7Z\x9a]\x7f\xa6\x8b\xebO֪\xb5p\xa7V\xae\x90Fk/\x0e+\xcfWT\xde\xf9\x0e\xe2\x13\x00\xc0\xfe\xf0\xa1o\x8cq\xeb\xc7TM\xdf.\xdf4V\x0fw\xf21W\x96*\xde>\xdd,\xb4\xf22\xa6\xfb\x8a\xad\xa6\xa5S\xd6\xf54\x15\xe6\x9dK\xa5\xce1\xbb\x8e2\xb3n\xcexk\x00\x00\xbc\x8a\xf4\xfc\xab\x98\xda<X\xd1Y\xa8m\xc6\x16j\x1e\x95n\xfc}\xd2\xc9\x7fBl\xb9\xe4\xe2\xaf\fcW7J#\xb1\xa7\xa6@]'?6uI\xec\xa9\xf3\xceq:\f\xc0\x12\xaa\xec\xda]\x8e\xd7\xed\x1a\xeeu\xc1\x9f\xd7\xda\xeb[\xae\xd9\xc0o\xa3z\xa7\x8f\xe5氻\xaf\x0e\x00\x97\xa3\x8a\xb5|6T\x96o\xcaU+\xaa\xfd\x96ܡ\xcfW~\x1b\xa3\xb2\xe3\xb5{͉\xec\x17~\xa3\x1fWǥ\\VJ\xe5\x19\x85\xe9M\U000bd9e4\x86Dv\x00\x00p\xc0\xb8F\xb3\xe8\xd4;\xf1ץ\x93\xdfH\xe5\x1bX2\xb3\xf7|^\xec\xbd\xf4\xb3\x16\xd7d\xaa\\\x1aնV\x11G\xb6'\xf5\xb6\x9c\x1a\xfe\x00\x00\xb0\xc3R\xe2W\x92\x8a\x99\x8bC\x97z\x9b\xa7)ٲr\xa8\x1bi0%\x83\xa5)%mDE[vi\xbe\xc8l\x0f1*\x8d\xa5\x9e\xb4\x8byz\x1d\xb4\xa1[N\x8d1\xba\xc4\xf6\xf49i\xb9KzO\x8b\x1fi\xaaڦ\x84\x10{W\xfaȘ\x12\xd7\xcd8\xcf\xe4\xfc\x8a2\x7fXY~\x83\xb2\xecz\xe56w\xa7\xfe\xe1\xe2?\x10\x00\x00\x1cd\xe7\xde\xf7\xb9Q\xb3_SќQ\xbfk\xf7\x99Y\xfd\xe5м\xae\x91\xa7\xfaG\x9a\xa2ڐ[}\xc5[M#\xb7\x90\xfb\xdc\xe2\xdd\x00pi\xea®-\xa1'\x1f\x06\xcaRRA\xac\xed\x1a\xb3iӼ\x83\xae\xc6\x15\nY\xb00'\xb3c\x8fJ\xc5\xfdZ\xbd\x95F2\x00\x80\xfdm\xfa\xc4\xe7Ŧ~I\xa1\xfa\xb0|\xfd\xbc\x8a\xbaQ\x19\x9c\\3\xb4r3\rJ\x93:\xaal\xac\x8e>\x9bO\xb6\xdc\xdd\xff\x93\xeb\xee-f\xcd\xe2>!\x00\x00xY\x90Ş.\xb5\x13\x89\x16g\x0e\xe4\xa6\xe7\x15\xb3C\xf2oz\x89\x18s?xk\x99\x1e\xb4Ηcz\xdejU\xa4\x8b\x9d䥇\xb6\xce\xeaL\x00\x96RU\xccTn\xafK\xbdF\xe7|O\x1b\x8f\xbc\xc8u\x1b\xf8\xed|\xe83\xa2\x9e\xf8\x05;oҀ\x18\x00p\xe9f\xa1T\xcf\x1dR̟\x90k\x0fY@U\xab\x19\x95\xca\xef;C9\x8c\xd7\xec5'\xb2o\xfd\xba\x8b+\xd3\xd5\xf9J\x7fS\xed\xf4\x84|\xff9\xb9\x9aDv\x00\x00p\xc0\xb8F\x13\x97k\xf0\xfa\t\x15o`\t\xcd\x1e\xbd-\xf6.<\xd3u\xb0\\e\xa5|\xd8Vތ\xa4\xac\xe9\x1e^\x01\x00\xb0\xe3⢳\x14\xab=F\x9b\xcfS\xc9S\x92\x97\xefF<\xef\xa7\x11ד\x97GE\x9f'\x83u\xe5R\x1a% \x8d\xb8\xf4r_+i\x84\x80y\x03\x8b\xe8\xd2F\xfb\x8cn\xe4u\xd9g\x05[n\x17\xf3\xd0%\xaa\xa7e\xad]oG\xad*\xf7\xd7\xd9tR\xf9\xcd\xdfE=\x16\x00\x00\\\xba\xe7\xde\x1c\xc7\xe3\x1fդ\xfa\r\x1d\xa9K\xc5j\xa2\xda\xea)\xa9JR\xa4\x81\x8c\xfc\xc0\xea-\xb9\xda:*KI4\x00p9|\x1a\r\xcf\xc2!\v\x9c\xda\x140\xc5R>\xcf\xe4\v\xbb\xd8x\xafs\xfd{4\x1cܫ\xf2Կ\"\xae\x01\x00\x1cX\xb3\xf7\x7fy\x8c\xf5\xaf+T\xef\xb723j4\x1bZ\x99Y[Y\x99\xee<ZA\xda\xe9۶t\xdfЦ\xec\xc2|\x13\x00\x00xY\xe3\v\xe5m\xa5\xd6\a\x85lC\xc5\xe4\x8cb\x7f]\xee\x11\x12ٗ\xdd䉯\x8a\x83\x17\xff\x83.&\xb2\xc76(\x06'\xef\xd3\xf3\xda\xcc6\xa4\xb6A\x17\xebL\x00\x96M\x95\a\xe5\x93R\xbe7\xd5v\xf1\xb0F\x0f\xfc\n\xd7m\xe05\x98\xfd\xf2j\xecy\x9e\xdf\x01\xb8<1\x1e\xb1\xaa\xf4\xb6\xda\xfa\x9c\x94\xf5\x94\xb5\x87\x15\xa2-\xdf\xf8;\xe5O\xfeG\xcab\xbc&\xaf)\x91\xbd\xfa\xd0\x1f\x8d\xe1\xccw\xab_\xaf\xdaJ%\x8d\xa4\xba\xb2\xca_\xb1\xa9\x8c\x11\xd9\x01\x00\xc0\x81Sk\\\xf44|h\x9bJ7\xb0\x84\xc6o\xf7q8.Rޟ\x9a\xdeЂ\xa2\v\xca\xc6\x16\xd7t\xa3\xc8-\x0e\x02\x00`G\xa5\x86\x10)i=%\xaf\x17j\x95w\xf3\x90\n#\x9b\x9c\x7fJi\x88\xa4\xa8̶\xe5v\xdc|\x9e\x12\xd5\xd31\xbdYf\x87\xe5\xf2Y_Y6\xb0š\x94\rm\xbd\x94\xb7\xedu\xef\x93T\xdc\xfcfJ1\x00\x00\xb0k\xaag\xbf>V\xb3\x17\xd5L\x9e\xb4\x95gT\x86\xb3\xeak\xdc\xf5\xc1\xd3U}\"\xcf\x0f\x01\\&wĦ\vj\xfd\xa6f\x99S(oT\xd1\x7f\xa3z\xb7\xd0\b\x06\x00\x80\x8fg\xf2\xe8\x831\xc6m\xab\x8b\x9f\xb7:\xf9\xa6\xb2v6\x7f\xec\x95F\x1c\x8d\x8b\x84-\x00\x00\xf0QfY\xa1\xdel\xa6\xc6\nͶ<\xa6\xde\xf6\v\xd2hUz\x90D\xf6e\xb7\xf9\xae7\xc5\xd5\xf1oX\x1d\xc8\xeaAV\x17\x8am\xea\xec\xc7V\xf3\xf4\xd5\xc6n\xddu\x9d\x87\x03XFU\x96+\xabZ\xf9\xfeDa\xf0\a\x94\xdd\xf5o\xb9n\x03\xafA|\xf7\x97D\xb7\xf9\x93\x8b5\x00\xb8D.u\xa4yA1\xf45+\xb7ԯ\x8eX\xa1|^\xf5\xf1\r\x15w\xbd@Y\x8c\xd7\xe45%\xb2o}\xe0\x8bcy\xe1GT6\x03i2\x91V\xd6T73\xf9|J\";\x00\x008xB\xa5I\x7f\xa4\xc1\x83\x17\xa8t\x03Kh\xf3\xd7]\\\x9d\x96\x16\r\xb5j\a\x1b\x16\x14\xbd$\xb7\u0557K-z<\xa75\x00`\xe7\xc5\xd4`\xd4\x17r\xdeʟ|`\xd3Ȧ\xa1m\xeb\xa5\x16\x13R\xf9i\xb6l\xfbn$\x19\x1d\x00\x00,\xa7\xf0\xde/\x8b\xda|T\xbey\xce\xea7S\x9b\x16;\x00\xe0\x12\xd5\xc1\xa9\xe8\xdd)\xad|\xbat\xfa;\xb9\x9a\x00\x00p\x19\xc2\a\xbe<\x86\xc9cV\xb0>'\xd7l+K\xa3\xb5\x03\x00\x80\x8f2\xc9\v\r\xa6\xf3D\xf6\xa6\xbc^\xfd\xf1\xb3\xd2\xea\xaat\xdf\x19b\xd1%w\xf67n\x8d\x1b\xd5\xd3r\xe99l\xeaX<\xe5J8\x9b\xba\xdc\xf5V\xb1\xa9l\x95\xfc\a`Y\xcd\\\xa9\xa2\x9d\xca\r\xed\\^\xfd\xab\xd2-\xdf\xcau\x1bx\xad\xfe\xc7\xe0\xb7O \x04\x80W\xe3\xa7Ҵ\x90\x86\x99\xcdj\xf5'=\xa9\x1ek6\xe8k|ßӡ\x13\x7f\x87\xf2\x18\xbf\xadה\xc8~\xee\u05fe0\xfa\xf83Z\xcb,h\xdbN\x8dl+\x85rK*\x9cB\x9d\x86U\x00\x00\x0088\xce\x15#\xf5V\xbf@\xab\xb7\xfc;*\xdc\xc0\xb2y\xf7\xa7DM\xceX\xf0\xfc\xa2\xc55\xdbR\xd6\xce\x1fX\x85\xdc\xe66\xb9fq \x00`_I\x1d\x95\xd8\xe5>v\x97\xfdL.\x8d\x82\x9e\xf7\xa5\xbcgeA\xa6Xܳ8\xf0\xd5U\xc37\xca9{\x97\xb7\xf7\xd9<\xbb\x9e\x84s\x00\x00\x80O\xe8\x1dy\x94ս\xda\xc6\xeaO*\xac:\xe6\xadNf1wJrO\xdb\xddȖ\x83\xc5\xe3)&\x8fV;\vv\\\xaab\xd9d\xf5\xad\xee\xb8\xf4\b3Z}M\xc3n{\xf0\xb5\xa2m\x0f\xaeV\x91ٶ`\x9f\x97b\xfa6}\x8e}~(\xec\xb8\xd2&\x9bg\x9b6\apY\xd2\xf9\xd7I\xe7_ngg\xb4\x7fj\xb9t\x0e{;_\x9b\x8d\xf9\xf9\xa7\xca\xe6vB\xdb6\v\x96lJ\xf7ּ\xb6\xfc\xd4\xce\xe9\xcc&{\r\xb9|kK\xad\x9d\xe1\xe9\xde[:\xc7\x1b;g\xb3\xb1M3\xcd2i\x9a\x95\n\xbd;U\x0e\xefW\xafwR\xf9u\x7f\x97x\v\x00\x80\xab\xe1\xc9o\xb6\"\xfd\xbd\xaa\xeb\xc7\x14\xea\xa7\xe4\x9a-e\xa9\x18\xb7\x7f\\\x98,\x0e\x02\x00\xe0\xe0\xa8\xdc!\vQ\x9f\x97/\xac@,,\\u7\xaa\\\xff\xbd*o\xfcGĥK\xee\xa9G\x7fw\xbci\xfc#\x8b5\x00\xfbͤ\xac4\xad\xfb\xca{\x8fh\xf5\xbe_\xe0\x9a\r\x00\x00\xb0$^S\";\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\\\x8a\xea\xa97\xc7\xc9\xf6O\xca\xcf\x1eUQo\xaa\xd7\xe6r\xa1/\xf9\xba\xcbg\xed\x92ٽ\xcdcTL\x103\x15D\x00\x00\n\xd8IDAT\xf9\xad\xad\xcdm\xa1菺\x04\xd9\x18*\x9b\xea\xee0\x17\xec\xbd)Q=\x16\x9a*v\xf9\ue67d\xd7\xf9V>\x1d\xa0Ǝ\x89\xf3ό\xe9C\x01\\\x96vh\xe7QeS\x1a\xb9\xb5\x9doS\x1a\xa5,M\xa5\xc6\xf93*\xec\x14\xcb\\&\x17S\xa7\x12\xb9ڶ\xb5S\xb6R\b\x8d\xfayfǥ\xf7\xd9d\xe7v\xeag\xc2\x0e\x95K\xfdL\xd8\xfc\xc2d\xa0\xb2\xbcI\xfd\xe1C\xd2m\xdfOCS\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd8\xe7Hd\a\x00\x00\x00\x00\x00\x00\x00\x00\x00p\xd55\xef\xfb=q<~T+\xedS\nq\xa6\x18k\xf9\x94\x90~1\x955\xe4R\x9b\x92bS\xc6k\xe8\x06yv>e\xb1\xa7\xd1\xd7mSL\t붧\xd7ʥ\xccX\xa5\xb1ܽ}NJ\x80o\xed3\xd3(\xd1R\xafI\t\xb7\x00.G\x9b\xce;\xe7\xec\xfc\xb3\x854\xcaz\xea \"\x04Ŧ\xed\x12ֳ\xf2\x84mO\xe7\xef\xb6\xed\xda\xee\xce\xcd,\xe6r\xd9\xc8\x16\x06\xd2\xf4\xd9\xf9\a\xb9R\xad[W\x95m\xa8-\x0eKŚ|1\xd0\xf0\xf6\x1f\"y\x1d\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x10\x12\xd9\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00캭\xf7\xfd\xc1\xd8\xcc\xfe\xbb\x8a\xe6\xfd\x1aD\xc9w\x838\x17\xf3\xc4\xf5\x94\x1d\x9b\x1ec\xa6\x94W\x9bb\x1a~\xdd\xf7l*\xe5\xea\xf3\xb6\xc1)\x86L\xc1\xd9ܥ7\xa7Q\xda[\xb9t،Dv\xe0rʹb\xa7\xdeL.\xceGe\xf7Y\xeal\"\xb7\xd30Mv~\xce\xec\xfc\xcb\xd2y\xbab\xe7aiSk\xc7O\x14\xb2m\xd9a:\x1bVT\x94\xc7T\x0e\xeeQ\xd1\x7fX\xd9\r\x7f\x9b\xc4u\x00\x00\x00\x00\x00\x00\x00\x00\x00\x008\xc0Hd\a\x00\x00\x00\x00\x00\x00\x00\x00\x00p\xcdUo\xff\xe28\x19\xff\xa6V\x8a\xe7\xbau\x97\xc6[\xbf\xf8(3=\xd3즔\xed~\"m\x98/\xbbň\xed\xae\xb6)\xed\xb3\xf5\x98\xa7w\x00\xb8\x1c\xbe\xb2\xd3(%\xa8\x8f\xa4\xd6\xe6\xdd96\x96\xb2\xcd\xf9\xfe\xb0f\xc7\x04E;\xdfj\x97\xa9\xf2\xabr\xc5\r*\xfa7\xab\xec\x1d\x93n\xfa\x17$\xae\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00^F\";\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80=\xe7\xc2\xfb\xbf6\xc6\xe9[\x95M?\xa8^;Q\x91F\x7f\x0e\x035\xe5Ye\xb6\xdf]L\x97\rަ\xde<\xe96\x14R\xb9\xb5\xd8\x01\xe0\x92\xb5\xd3\xf9<\x9d_\xe9|Jg[7,\xbb\x9dg6{!\xcf\xd4\xeb\x9d\xd2p\xf0\x88\U0009bfdb\xa4u\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0'D\";\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80=\xafz\xdf\uf333\xfaQ\xb9\xba\xb6\xb5\xa9\\ؖ\x8f\xb5\xb2(\x15m.\x17Wl{Or\xe7\xbb\xe3\x01\\\xbazv\xbbb9V(Ϋ)Z\xc5\xfc\x84\x8a\xf2\x01\xf5o\xfb\x01\x92\xd6\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x97\x8cDv\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcb\xe7\xf1o\x88\xf5\xec7\xd4\xce\xde'\x1f_R\xa1F\xceE\xa9*\x17\a\x00\xb8T['>_\xc3\xc1\xed\xf2Ǿ\x9d\xc4u\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0\x15#\x91\x1d\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0\xbe\xf0\u0087\xfeb\x1cn\xfdk\xf9\x10\xa5\xa6\x92o\x1a\x95\xa1\x95\v\xc1\xf6\xa6\xe7\xa26\x95N\nަB1\xe4\n\xce+:;Ʒ\xb27*\xb6Q\xde\xe76\xa5\xedv\x98\xbd\xb7Io7\xce9\xf9\xd6ޛ\x96\xed\xb3\xb2t\x80lg\xb7\x7fqP\x91F\x8c71\x1dg\xfb\xbb\xb9\xef\xfeN\xd2\xe4\x17\x9f\xcf6\xddg\xb8Xw\xf3\x8e\x1d\xee\xab^\xf7w\xece\xbe\xed\xa2\x18\xed_;\xfec\xb7cwe\xf6;I\xba\xef5\xb3\xefɾ\xdb\xeew\x90&o\xafi\xff\xe2\xb7`s\x97\x96\xed\xf7uq]\xcdh~\xa8M\xdd\xefk1\xa5_@\x9a\xf7\xa7\xf6\xd2Y|Fڑ:h\xb8\xf8\xb3\xe9\x15\xb6\xcbV\xbam\x8b\xbf\xd5\xcd\x17\ad\xf6\xfb\xeb\x16\xed\xbf\xcf~\xdf\xdd\xdc\xd91\xf6\xdbN\xc7μ\xfdڲu)\xbfU\xaew\x8fz\xb7\xfd\xbb\x8b\x7f\x10\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\x1dG\";\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80}+|\xe0\xebb\x18\xff\x9ab\xf5~\x85\xe6%\xa9]\x97\xf7Rf\x93O\xc9\xc0]Fq\xb0\xed\xb6\x98\x9e\x9d\xfaY\xf7\xbey\xa2rn\xf3\xb4\xdf\xe6.\x9bo\x1b\xbe0ߝ6/\x1e\xb5.R\x8e;y[,\x96\x12;\xfe\xe2A\x1f;\xef\x12\xdb\xed\xd8\xf4\xb9\xdd߱u\xe7\xec?\xe5\xc2|\xf7\xc7&\xb3_|\x1f\xae-\x7fq\xc4\xff\x940\xde\xcc\xe7\xc9ů'\xf4\xe6\xf3\x8b\xdf\xdd\xc7v<\x10\xec\x87\xf6\xf2Wi\xbf\x9c\x94\x94\xde%\xa3/6\xa6D\xf5\x94xnۢ\xb7m\x8b\xb7\xa7\xdf[\xf7\x93\xd8\xea\xcf7\xa4c\xba߯M\xddq\xf3_\xe1v6Jﴟs\xb4\xf7\x0f\x95\x95'\xd4\xeb\x9dR\xaf\x7f\x8b\xfd\xcc\x0eK'\xfe\xd6\xc7\xfc\a\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00p\xf5\x90\xc8\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x00\xe0`y\xf6O\xc5z\xf2N\xcd\xc6\xef\x96fϩ\x88R\xafK\xef\xcd\xd5e\xb9\xb7)\xc18%\x1c/\x9e\xa5\xa6}\x17\xd3\x7f\xab\x95\x8f,\xa7<u\x9b\x85\xcc\xcdG\u05f6嬙'\xb2\xa7\x11\xde}J\x90O#a\xbbf\xf1\x19\xb6\\\xa7\x04\xf6\xc5t1;9d\xf69i[ڴe\x9b\\7\xfd\x96$\xe8\x84\xe7\xbb\xd7TS\r\xe7\t\xe5\xf6m\xbb4\x92\x7f\xfa\xd6_N*\xb7yQ\xcd\x7f\x14\xa9\xa3\x82\xf4\xbd\x86\xf9h\xfci\u07bd\xbf_w\xdft:܇4\"\x7f\xe8\xf2л\x84\xf64\xef~/\xe9\xc8\xf4G\xbcZe\xf3\xb1\xfb\xbb\x8e\x0f\xd2\xeftb\x87\xa5\x8e\x15J{K\xcf>{h\xf3\x81\xed/\xed\xfd\xb9\xf2\xa3_\xa8\xe2\xe6\xbf\xf3*?\x1c\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00v\x1f\x89\xec\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00`\x9ag\xfeL\x9c\x9e\xfbI\x85\xa6V\f\x8d\xbcMEJ%\x8e\xad\\[u\xc9\xc6y\xbc8\x02w\xab\x8b\xa3`wYɋ\xe5\xaa̻\xb9\x0fi\xeemWf\xbbl\xeelލ\xf2~֦4Zv\x1aqۦ\x94r\x9cr\x9e\x17\xf3|<\x1fѻKd\x9f/\xcc\xe7\x17\xf1|\xf7\x9a\xaa\x06\xf6\xbbH_\xb7}\x97\xde&\xd7\r\xcdo_\\\xf7ݚv8\x9fw\xc9퍂\x1a\xfb\x8e\xd3r\xb0\xefئ0\x1f\xd1\xfd\xe2\xf7k\x9f\xd0\xfd6\x12\xfb\x85\xd8\x1f8\xd3mM\x89\xeb\xadmo\\\xde̓/\xba\xdf\xcatx\x9b\xb2l]ev\x93VO\xfd\xeb\x8f\xf9q\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb0\xb7\x90\xc8\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x9f\xc0\xec\xc3\x7f*N\xab\x0f\xa9\xae\xcfi8{kzʪ,\xa8\x1bQ;O9\xcai\xe4\xf5ň\xdam֤\xdd\xf3Q\xb6\x13\xdbչ\xb8\xde\x1c풚;6OI\xce)\xa99%9'^\x8bD\xf9Wze2;\xcfw\xaf\xa9Ƶ\x8b%\xfb\xae\xec{\xe9\xbe\xe7\x8bI\xec\xe9{j^\xf1]u\xa3\xa8ۺO\xdbRr\xba\xad\xb5[\xf3\xef0m\xb2\x1fJ\xea̠\xb1\xc5\xf4\xb6\xf4Q\xb3∲lMy~\xa3z\xbd;\x94\xdd\xf2\xdd\xe9H\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x96\x12\x89\xec\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00p\x99\xc6\x1f\xfa\x86\xd8\xcc>\xa0\xa6~F\xb1=\xaf\x8d\xf6\xf9n{֍\xd2m\v)?\xfdb\xa2sZw\x9b\xf6\x92\xd6\x17S\xcc\xe7\xfbS\xd2s\x9a\xfb\x89m7\xaf\xf6\x1c7%J\xf3|\xf7\xda\nEz\xb1\xef\"M)\xa9\xbd\xb6\xf9\xe2;I)\xe7\xcd|D\xfdyo\x06i>\x1fu\xff\xe2T\x85V.%\xaa\x177*\xef\xdd&\x95\xb7K7\xff\xa3\xf4N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xf6\x1d\x12\xd9\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\xe0*i\x9e\xf83\xb1\xae\x1fW\xd5<\xa3\x18/\xa8\x19oK\xaeQ\x16[宖\xd7̖ke>v\xb9ϹF\xf3d\xf5\xc5\xf4\xca\xe7\xb9i\xe4v\\c\x99}\x1f\xf6=\x04\xfb\xa6\xdah\x93J\x9b\xd2<Sp^\xb1|Q>[W\x91\x9dPYަ\xbc8-\xdd\xf0w\xf9\xe2\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\a\x12\x89\xec\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00p-=\xf9\xbfŶ}A1l)\xbf\xf0\x93\xf3$\xf6\x10^NdO\xd3\xc5$v\x92ٯ\xadv\xed3\xe5\xfd\x8a\\vD*o\x94nz3_\b\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\a\x89\xec\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb0,\x9e\xf9\xf3Q\xa1\x96\x9aM\xc5\xea%\xc5\xfa\x9cMg\x15[[ogR\xcfۼV\f\x8d\xbc\x1a\x15>\xbd\xa9\x91l\x9b\xdaV\xc1\x1f\xea>&\x8d\n\xef\xd5vs\xdbaS\xb0)=;>b3[領\xcd\xf6\xa7\xa1\xe2\xd3H\xe4rvXn\xf3\xab\xc8U\xf6w\xd3߱\xe5\x98\xfe\xe3\xd3߳ɕ\x92\xb7\xed\xf1\xa5t\x90M\xb6\xcf\x15\xb6^t\xa3\xa3\xbb\xb4nSt\x1f\x96O\x8b\x99\xed\xb2\x97*8\x9b\xdb\xf1\xbe\x94\xcf\xed\xf8\xedRY1\xb0i\xdd>v\xc3\xfe\xffX\xed澴\xf5\xa2/]\xf7\xed\xe9\xc3\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0\x0e \x91\x1d\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x8ag\xff\\Tl\xa4\xd0ؿ35\xed\xd4\xe6\xb5\xdaP)\xc6V+\xfe\xbd]»l\x9b\x9a\xa9m\xaf\x15[;6%\xb6+\xa8\xec\x12گ\x9e-߷ה\xa4\x9e)\xf3\xa5\xf2\xbc/\x9f\xf7\x94e)\x91=\xd7v}R>\xed\xcb\ne\xb6/\xcbz\x92\xcde\xebr\xf6\xbec\xdfF\":\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00{\x04\x89\xec\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\x1d\xe5\x17s\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00v\x04\x89\xec\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\x1dE\";\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00`G\x91\xc8\x0e\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd8Q$\xb2\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00v\x14\x89\xec\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\x1dE\";\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00`G\x91\xc8\x0e\x00\x00\x00\xfc\xffۃc\x02\x00\x00\x00\x84A\xeb\x9f\xda\x12\x9e\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00pT\x03\xa9֜\x18\x18\x92\xe1_\x00\x00\x00\x00IEND\xaeB`\x82",
+}
@@ -0,0 +1,354 @@
+package analysis
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"tx2db/database"
+	"tx2db/locale"
+
+	"github.com/pkg/errors"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+//colors of the reports, as in style.css
+var (
+	reportBlue   = color.RGBA{0x00, 0x35, 0x80, 0xff}
+	reportGreen  = color.RGBA{0x00, 0x4a, 0x2c, 0xff}
+	reportYellow = color.RGBA{0xfe, 0xcc, 0x00, 0xff}
+	reportGrey   = color.RGBA{0xee, 0xee, 0xee, 0xff}
+	reportMuted  = color.RGBA{0x6c, 0x75, 0x7d, 0xff}
+	reportOK     = color.RGBA{0x28, 0xa7, 0x45, 0xff}
+)
+
+//chartFont is the font of the charts
+const chartFont = "Helvetica"
+
+//routePoint is a position of a driver during a tour
+type routePoint struct {
+	TourID    uint
+	Latitude  float64
+	Longitude float64
+}
+
+//ecoRow is an eco monitor report of a driver
+type ecoRow struct {
+	StartTime       time.Time
+	Distance        float64
+	DurationIdling  float64
+	DurationDriving float64
+	FuelConsumption float64
+	SpeedAverage    float64
+}
+
+//activityShare is the share of the time of a driver spent on an activity
+type activityShare struct {
+	Activity string
+	Share    float64
+}
+
+//barSeries contains the labels and the values of a bar chart
+type barSeries struct {
+	labels []string
+	values []float64
+}
+
+//getRoute gets the positions of the activities of a driver, by tour and oldest first
+func getRoute(transicsID string, start, end time.Time) ([]routePoint, error) {
+	var result []routePoint
+	if err := database.DB.Raw(`
+	SELECT t.id as tour_id, tar.latitude, tar.longitude
+	FROM tours t
+	INNER JOIN truck_activity_reports tar
+	ON t.id = tar.tour_id
+	WHERE t.driver_transics_id = ?
+	AND t.start_time >= ?
+	AND (t.end_time <= ? OR t.end_time IS NULL)
+	AND tar.latitude <> 0
+	AND tar.longitude <> 0
+	ORDER BY t.id asc, tar.start_time asc`,
+		transicsID, start.Format("2006-01-02"), end.Format("2006-01-02")).Scan(&result).Error; err != nil {
+		return nil, errors.Wrap(err, database.ErrorDB)
+	}
+
+	return result, nil
+}
+
+//getEcoRows gets the eco monitor reports of a driver, oldest first
+func getEcoRows(transicsID string, start, end time.Time) ([]ecoRow, error) {
+	var result []ecoRow
+	if err := database.DB.Raw(`
+	SELECT demr.start_time, demr.distance, demr.duration_idling, demr.duration_driving, demr.fuel_consumption, demr.speed_average
+	FROM driver_eco_monitor_reports demr
+	INNER JOIN tours t
+	ON demr.tour_id = t.id
+	WHERE t.driver_transics_id = ?
+	AND t.start_time >= ?
+	AND (t.end_time <= ? OR t.end_time IS NULL)
+	ORDER BY demr.start_time asc`,
+		transicsID, start.Format("2006-01-02"), end.Format("2006-01-02")).Scan(&result).Error; err != nil {
+		return nil, errors.Wrap(err, database.ErrorDB)
+	}
+
+	return result, nil
+}
+
+//getActivityShares gets the share of the time spent on every activity by a driver, the longest first
+//activities under 0.1% of the time are left out
+func getActivityShares(transicsID string, start, end time.Time) ([]activityShare, error) {
+	var result []struct {
+		Activity  string
+		StartTime time.Time
+		EndTime   time.Time
+	}
+	if err := database.DB.Raw(`
+	SELECT tar.activity, tar.start_time, tar.end_time
+	FROM tours t
+	INNER JOIN truck_activity_reports tar
+	ON t.id = tar.tour_id
+	WHERE t.driver_transics_id = ?
+	AND t.start_time >= ?
+	AND (t.end_time <= ? OR t.end_time IS NULL)
+	AND tar.start_time >= ?
+	AND (tar.end_time <= ? OR tar.end_time IS NULL)`,
+		transicsID, start.Format("2006-01-02"), end.Format("2006-01-02"), start.Format("2006-01-02"), end.Format("2006-01-02")).Scan(&result).Error; err != nil {
+		return nil, errors.Wrap(err, database.ErrorDB)
+	}
+
+	durations := make(map[string]float64)
+	var total float64
+	for _, activity := range result {
+		duration := activity.EndTime.Sub(activity.StartTime).Seconds()
+		if duration <= 0 {
+			continue
+		}
+		durations[activity.Activity] += duration
+		total += duration
+	}
+
+	var shares []activityShare
+	for activity, duration := range durations {
+		if share := duration / total; share >= 0.001 {
+			shares = append(shares, activityShare{Activity: activity, Share: share})
+		}
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].Share != shares[j].Share {
+			return shares[i].Share > shares[j].Share
+		}
+		return shares[i].Activity < shares[j].Activity
+	})
+
+	return shares, nil
+}
+
+//groupRows sums the eco monitor reports kept by label, in order of appearance, and divides the sums
+func groupRows(rows []ecoRow, label func(ecoRow) string, keep func(ecoRow) bool, value func(ecoRow) (float64, float64)) barSeries {
+	var series barSeries
+	var numerators, denominators []float64
+	index := make(map[string]int)
+	for _, row := range rows {
+		if !keep(row) {
+			continue
+		}
+		l := label(row)
+		i, ok := index[l]
+		if !ok {
+			i = len(series.labels)
+			index[l] = i
+			series.labels = append(series.labels, l)
+			numerators = append(numerators, 0)
+			denominators = append(denominators, 0)
+		}
+		numerator, denominator := value(row)
+		numerators[i] += numerator
+		denominators[i] += denominator
+	}
+
+	for i := range series.labels {
+		var v float64
+		if denominators[i] != 0 {
+			v = numerators[i] / denominators[i]
+		}
+		series.values = append(series.values, v)
+	}
+
+	return series
+}
+
+//weekLabel labels a report by its ISO week, Week 07
+func weekLabel(week string) func(ecoRow) string {
+	return func(row ecoRow) string {
+		_, w := row.StartTime.ISOWeek()
+		return fmt.Sprintf("%s %02d", week, w)
+	}
+}
+
+//idlingByWeek is the idling time over the total driving time per week, in percent
+func idlingByWeek(rows []ecoRow, week string) barSeries {
+	return groupRows(rows, weekLabel(week), func(ecoRow) bool { return true }, func(row ecoRow) (float64, float64) {
+		return row.DurationIdling * 100, row.DurationIdling + row.DurationDriving
+	})
+}
+
+//consumptionByDay is the fuel consumption in L/km per day, the reports under 2 km are left out
+func consumptionByDay(rows []ecoRow, loc *locale.Locale) barSeries {
+	return groupRows(rows, func(row ecoRow) string {
+		return loc.Day(row.StartTime)
+	}, func(row ecoRow) bool {
+		return row.Distance > 2
+	}, func(row ecoRow) (float64, float64) {
+		return row.FuelConsumption, row.Distance
+	})
+}
+
+//speedByWeek is the mean of the average speeds per week, the reports under 2 km are left out
+func speedByWeek(rows []ecoRow, week string) barSeries {
+	return groupRows(rows, weekLabel(week), func(row ecoRow) bool {
+		return row.Distance > 2 && row.SpeedAverage > 0
+	}, func(row ecoRow) (float64, float64) {
+		return row.SpeedAverage, 1
+	})
+}
+
+//newChart creates a plot in the style of the reports
+func newChart() (*plot.Plot, error) {
+	p, err := plot.New()
+	if err != nil {
+		return nil, err
+	}
+
+	labelFont, err := vg.MakeFont(chartFont, vg.Points(9))
+	if err != nil {
+		return nil, err
+	}
+	tickFont, err := vg.MakeFont(chartFont, vg.Points(8))
+	if err != nil {
+		return nil, err
+	}
+	p.X.Label.Font, p.Y.Label.Font = labelFont, labelFont
+	p.X.Tick.Label.Font, p.Y.Tick.Label.Font = tickFont, tickFont
+	p.BackgroundColor = color.Transparent
+
+	return p, nil
+}
+
+//localeTicks writes the ticks of an axis with the number format of a language
+type localeTicks struct {
+	loc *locale.Locale
+}
+
+//Ticks returns the default ticks, their labels keeping the same number of decimals
+func (t localeTicks) Ticks(min, max float64) []plot.Tick {
+	ticks := plot.DefaultTicks{}.Ticks(min, max)
+	for i, tick := range ticks {
+		if tick.Label == "" {
+			continue
+		}
+		var decimals int
+		if dot := strings.IndexByte(tick.Label, '.'); dot >= 0 {
+			decimals = len(tick.Label) - dot - 1
+		}
+		ticks[i].Label = t.loc.Number(tick.Value, decimals)
+	}
+	return ticks
+}
+
+//barChart builds a bar chart, the bars filling 60% of the width up to 40 points each
+func barChart(series barSeries, yLabel string, width vg.Length, loc *locale.Locale) (*plot.Plot, error) {
+	p, err := newChart()
+	if err != nil {
+		return nil, err
+	}
+	p.Y.Label.Text = yLabel
+	p.Y.Min = 0
+	p.Y.Tick.Marker = localeTicks{loc: loc}
+	if len(series.values) == 0 {
+		p.HideX()
+		return p, nil
+	}
+
+	barWidth := width * 0.6 / vg.Length(len(series.values))
+	if barWidth > 40 {
+		barWidth = 40
+	}
+	bars, err := plotter.NewBarChart(plotter.Values(series.values), barWidth)
+	if err != nil {
+		return nil, err
+	}
+	bars.Color = reportBlue
+	bars.LineStyle.Width = 0
+	p.Add(bars)
+	p.NominalX(series.labels...)
+	//keep half a bar of space on the sides
+	p.X.Min, p.X.Max = -0.5, float64(len(series.values))-0.5
+	//many labels would overlap
+	if len(series.labels) > 4 {
+		p.X.Tick.Label.Rotation = math.Pi / 4
+		p.X.Tick.Label.XAlign = draw.XRight
+		p.X.Tick.Label.YAlign = draw.YCenter
+	}
+
+	return p, nil
+}
+
+//routeChart plots the positions of a driver, a line per tour
+//the longitudes are scaled by the cosine of the mean latitude, as on a map
+func routeChart(points []routePoint) (*plot.Plot, error) {
+	p, err := newChart()
+	if err != nil {
+		return nil, err
+	}
+	p.HideAxes()
+	if len(points) == 0 {
+		return p, nil
+	}
+
+	var latitude float64
+	for _, point := range points {
+		latitude += point.Latitude
+	}
+	scale := math.Cos(latitude / float64(len(points)) * math.Pi / 180)
+
+	var all plotter.XYs
+	for start := 0; start < len(points); {
+		end := start
+		var tour plotter.XYs
+		for end < len(points) && points[end].TourID == points[start].TourID {
+			tour = append(tour, plotter.XY{X: points[end].Longitude * scale, Y: points[end].Latitude})
+			end++
+		}
+		line, err := plotter.NewLine(tour)
+		if err != nil {
+			return nil, err
+		}
+		line.Color = color.NRGBA{0x00, 0x35, 0x80, 0x60}
+		line.Width = vg.Points(2)
+		p.Add(line)
+		all = append(all, tour...)
+		start = end
+	}
+
+	stops, err := plotter.NewScatter(all)
+	if err != nil {
+		return nil, err
+	}
+	stops.Color = reportBlue
+	stops.Shape = draw.CircleGlyph{}
+	stops.Radius = vg.Points(2)
+	p.Add(stops)
+
+	//keep a margin around the route, a single position would have no extent
+	xmin, xmax, ymin, ymax := plotter.XYRange(all)
+	margin := math.Max(math.Max(xmax-xmin, ymax-ymin)*0.1, 0.05)
+	p.X.Min, p.X.Max = xmin-margin, xmax+margin
+	p.Y.Min, p.Y.Max = ymin-margin, ymax+margin
+
+	return p, nil
+}
@@ -1,15 +1,19 @@
 package analysis
 
 import (
+	"os"
+	"strconv"
+	"sync"
 	"time"
 	"tx2db/database"
+	"tx2db/score"
 
 	"github.com/pkg/errors"
 )
 
 //driverData defines a information about a driver
 type driverData struct {
-	Name, PersonID, Email string
+	TransicsID, Name, PersonID, Email, Language string
 }
 
 //driverMetric defines a driver metric
@@ -18,11 +22,11 @@ type driverMetric struct {
 	Metric     string
 }
 
-//getDrivenKm gets the number of kilometers driven
-func getDrivenKm(start, end time.Time) ([]driverMetric, error) {
+//getReportDrivers gets the drivers who have driven during the period, the ones a report is built for
+func getReportDrivers(start, end time.Time) ([]string, error) {
 	var result []driverMetric
 	if err := database.DB.Raw(`
-	SELECT demr.driver_transics_id as transics_id, SUM(distance) as metric 
+	SELECT demr.driver_transics_id as transics_id
 	FROM driver_eco_monitor_reports demr
 	INNER JOIN tours t
 	ON demr.tour_id = t.id
@@ -32,76 +36,53 @@ func getDrivenKm(start, end time.Time) ([]driverMetric, error) {
 	GROUP BY demr.driver_transics_id
 	ORDER BY demr.driver_transics_id asc`,
 		start.Format("2006-01-02"), end.Format("2006-01-02")).Scan(&result).Error; err != nil {
-		return result, errors.Wrap(err, database.ErrorDB)
+		return nil, errors.Wrap(err, database.ErrorDB)
 	}
 
-	return result, nil
+	var drivers []string
+	for _, driver := range result {
+		drivers = append(drivers, driver.TransicsID)
+	}
+
+	return drivers, nil
 }
 
-//getDriverName gets a driver name
-func getDriverData(driversList []string) ([]driverData, error) {
+//getDriverData gets the name, contact and language of the drivers by TransicsID
+func getDriverData(driversList []string) (map[string]driverData, error) {
 	var result []driverData
 	if err := database.DB.Raw(`
-	SELECT transics_id, name, person_id, email
+	SELECT transics_id, name, person_id, email, language
 	FROM drivers
 	WHERE transics_id IN (?)
 	ORDER BY transics_id asc`,
 		driversList).Scan(&result).Error; err != nil {
-		return result, errors.Wrap(err, database.ErrorDB)
+		return nil, errors.Wrap(err, database.ErrorDB)
 	}
 
-	return result, nil
-}
-
-//getDriverLanguage gets a driver language
-func getDriverLanguage(driversList []string) ([]driverMetric, error) {
-	var result []driverMetric
-	if err := database.DB.Raw(`
-	SELECT transics_id, language as metric
-	FROM drivers
-	WHERE transics_id IN (?)
-	ORDER BY transics_id asc`,
-		driversList).Scan(&result).Error; err != nil {
-		return result, errors.Wrap(err, database.ErrorDB)
+	drivers := make(map[string]driverData, len(result))
+	for _, driver := range result {
+		drivers[driver.TransicsID] = driver
 	}
 
-	return result, nil
+	return drivers, nil
 }
 
 //getTruckDriven gets the trucks that a driver has been driving
+//based on the driver assignments so a swap of trucks during a tour is taken into account
 func getTruckDriven(driversList []string, start, end time.Time) ([]driverMetric, error) {
 	var result []driverMetric
 	if err := database.DB.Raw(`
-	SELECT t.driver_transics_id as transics_id, trucks.license_plate as metric
-	FROM tours t
+	SELECT da.driver_transics_id as transics_id, trucks.license_plate as metric
+	FROM driver_assignments da
 	INNER JOIN trucks
-	ON t.truck_transics_id = trucks.transics_id
-	WHERE t.start_time >= ?
-	AND (t.end_time <= ? OR t.end_time IS NULL)
-	AND t.driver_transics_id IN (?)
-	GROUP BY t.driver_transics_id, trucks.license_plate
-	ORDER BY t.driver_transics_id asc`,
-		start.Format("2006-01-02"), end.Format("2006-01-02"), driversList).Scan(&result).Error; err != nil {
-		return result, errors.Wrap(err, database.ErrorDB)
-	}
-
-	return result, nil
-}
-
-//getTotalPanicBrakes gets the number of panic brakes performed
-func getTotalPanicBrakes(driversList []string, start, end time.Time) ([]driverMetric, error) {
-	var result []driverMetric
-	if err := database.DB.Raw(`
-	SELECT demr.driver_transics_id as transics_id, SUM(number_of_panic_brakes) as metric
-	FROM driver_eco_monitor_reports demr
-	INNER JOIN tours t
-	ON demr.tour_id = t.id
-	WHERE t.start_time >= ?
-	AND (t.end_time <= ? OR t.end_time IS NULL)
-	AND t.driver_transics_id IN (?)
-	GROUP BY demr.driver_transics_id
-	ORDER BY demr.driver_transics_id asc`,
-		start.Format("2006-01-02"), end.Format("2006-01-02"), driversList).Scan(&result).Error; err != nil {
+	ON da.truck_transics_id = trucks.transics_id
+	WHERE da.deleted_at IS NULL
+	AND da.start_time < ?
+	AND (da.end_time >= ? OR da.end_time IS NULL)
+	AND da.driver_transics_id IN (?)
+	GROUP BY da.driver_transics_id, trucks.license_plate
+	ORDER BY da.driver_transics_id asc`,
+		end.AddDate(0, 0, 1).Format("2006-01-02"), start.Format("2006-01-02"), driversList).Scan(&result).Error; err != nil {
 		return result, errors.Wrap(err, database.ErrorDB)
 	}
 
@@ -117,7 +98,7 @@ func getVisitedCountries(driversList []string, start, end time.Time) ([]driverMe
 	INNER JOIN truck_activity_reports tar
 	ON t.id = tar.tour_id
 	INNER JOIN drivers d
-	ON d.transics_id = t.driver_transics_id 
+	ON d.transics_id = t.driver_transics_id
 	WHERE t.start_time >= ?
 	AND (t.end_time <= ? OR t.end_time IS NULL)
 	AND t.driver_transics_id IN (?)
@@ -130,64 +111,243 @@ func getVisitedCountries(driversList []string, start, end time.Time) ([]driverMe
 	return result, nil
 }
 
-//getRollOutRatio gets ratio of rolling out
-func getRollOutRatio(driversList []string, start, end time.Time) ([]driverMetric, error) {
+//ecoScoreConfig is the eco score configuration of the run, read once by loadEcoScoreConfig
+var ecoScoreConfig struct {
+	once   sync.Once
+	config score.Config
+	err    error
+}
+
+//loadEcoScoreConfig reads the eco score configuration of ECO_SCORE_CONFIG the first time it is needed
+//every period of a run is then scored with the same configuration
+func loadEcoScoreConfig() (score.Config, error) {
+	ecoScoreConfig.once.Do(func() {
+		ecoScoreConfig.config, ecoScoreConfig.err = score.LoadConfig(os.Getenv(score.ConfigEnv))
+	})
+	return ecoScoreConfig.config, ecoScoreConfig.err
+}
+
+//computeEcoScore scores the drivers over every period, the eco monitor reports of the periods are read at once
+func computeEcoScore(drivers []string, periods []Period) ([]map[string]float64, error) {
+	config, err := loadEcoScoreConfig()
+	if err != nil {
+		return nil, err
+	}
+	all := span(periods)
+	tours, err := database.TourEcoTotalsBetween(all.Start, all.End, drivers)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]map[string]float64, len(periods))
+	for i, p := range periods {
+		totals := make(map[uint]*database.EcoTotals)
+		for _, tour := range tours {
+			if !p.contains(tour.StartTime, tour.EndTime) {
+				continue
+			}
+			if _, ok := totals[tour.DriverTransicsID]; !ok {
+				totals[tour.DriverTransicsID] = &database.EcoTotals{DriverTransicsID: tour.DriverTransicsID}
+			}
+			totals[tour.DriverTransicsID].Add(tour.EcoTotals)
+		}
+
+		values[i] = make(map[string]float64, len(totals))
+		for driverID, driverTotals := range totals {
+			values[i][strconv.FormatUint(uint64(driverID), 10)] = score.Score(*driverTotals, config).Total
+		}
+	}
+
+	return values, nil
+}
+
+//storeEcoScores stores the scores of the drivers for the trend tracking
+func storeEcoScores(start, end time.Time) error {
+	config, err := loadEcoScoreConfig()
+	if err != nil {
+		return err
+	}
+	_, err = score.Compute(start, end, config)
+	return err
+}
+
+//getDriverGroups gets the truck group of the drivers, the one of the trucks they drove the most during the period
+func getDriverGroups(driversList []string, start, end time.Time) (map[string]string, error) {
 	var result []driverMetric
 	if err := database.DB.Raw(`
-	SELECT demr.driver_transics_id as transics_id, SUM(demr.distance_coasting) / SUM(demr.distance) as metric 
+	SELECT t.driver_transics_id as transics_id, tg.name as metric
 	FROM driver_eco_monitor_reports demr
-	LEFT JOIN tours t
+	INNER JOIN tours t
 	ON demr.tour_id = t.id
+	INNER JOIN trucks
+	ON trucks.transics_id = t.truck_transics_id
+	INNER JOIN truck_groups tg
+	ON tg.id = trucks.truck_group_id
 	WHERE t.start_time >= ?
 	AND (t.end_time <= ? OR t.end_time IS NULL)
 	AND t.driver_transics_id IN (?)
-	AND distance > 2
-	GROUP BY demr.driver_transics_id
-	ORDER BY demr.driver_transics_id asc`,
+	GROUP BY t.driver_transics_id, tg.name
+	ORDER BY t.driver_transics_id asc, SUM(demr.distance) desc`,
 		start.Format("2006-01-02"), end.Format("2006-01-02"), driversList).Scan(&result).Error; err != nil {
-		return result, errors.Wrap(err, database.ErrorDB)
+		return nil, errors.Wrap(err, database.ErrorDB)
 	}
 
-	return result, nil
+	groups := make(map[string]string, len(result))
+	for _, group := range result {
+		//the most driven group comes first
+		if _, ok := groups[group.TransicsID]; !ok {
+			groups[group.TransicsID] = group.Metric
+		}
+	}
+
+	return groups, nil
 }
 
-//getCruiseControlRatio gets ratio of cruise control usage
-func getCruiseControlRatio(driversList []string, start, end time.Time) ([]driverMetric, error) {
-	var result []driverMetric
-	if err := database.DB.Raw(`
-	SELECT demr.driver_transics_id as transics_id, SUM(demr.distance_on_cruise_control) / SUM(demr.distance) as metric 
+//the metrics of the driver reports, shown in this order, labelled by the catalogues
+func init() {
+	//overall eco-driving score
+	RegisterMetric(&metric{
+		name:           "eco_score",
+		unit:           "/100",
+		higherIsBetter: true,
+		format:         formatInteger,
+		compute:        computeEcoScore,
+	})
+
+	//kilometers driven, a driver is not better for driving more
+	RegisterMetric(&metric{
+		name:    "driven_km",
+		unit:    "km",
+		neutral: true,
+		format:  formatDecimal,
+		query: `
+	SELECT demr.driver_transics_id as transics_id, t.start_time, t.end_time, SUM(distance) as metric
+	FROM driver_eco_monitor_reports demr
+	INNER JOIN tours t
+	ON demr.tour_id = t.id
+	WHERE distance > 2
+	AND t.start_time >= ?
+	AND (t.end_time < ? OR t.end_time IS NULL)
+	AND t.driver_transics_id IN (?)
+	GROUP BY demr.driver_transics_id, t.id, t.start_time, t.end_time`,
+	})
+
+	//number of panic brakes performed
+	RegisterMetric(&metric{
+		name:   "panic_brakes",
+		unit:   "x",
+		format: formatInteger,
+		query: `
+	SELECT demr.driver_transics_id as transics_id, t.start_time, t.end_time, SUM(number_of_panic_brakes) as metric
+	FROM driver_eco_monitor_reports demr
+	INNER JOIN tours t
+	ON demr.tour_id = t.id
+	WHERE t.start_time >= ?
+	AND (t.end_time < ? OR t.end_time IS NULL)
+	AND t.driver_transics_id IN (?)
+	GROUP BY demr.driver_transics_id, t.id, t.start_time, t.end_time`,
+	})
+
+	//ratio of cruise control usage
+	RegisterMetric(&metric{
+		name:           "cruise_control",
+		unit:           "%",
+		higherIsBetter: true,
+		format:         formatPercent,
+		query: `
+	SELECT demr.driver_transics_id as transics_id, t.start_time, t.end_time, SUM(demr.distance_on_cruise_control) as metric, SUM(demr.distance) as total
 	FROM driver_eco_monitor_reports demr
 	LEFT JOIN tours t
 	ON demr.tour_id = t.id
 	WHERE t.start_time >= ?
-	AND (t.end_time <= ? OR t.end_time IS NULL)
+	AND (t.end_time < ? OR t.end_time IS NULL)
 	AND distance > 2
 	AND t.driver_transics_id IN (?)
-	GROUP BY demr.driver_transics_id
-	ORDER BY demr.driver_transics_id asc`,
-		start.Format("2006-01-02"), end.Format("2006-01-02"), driversList).Scan(&result).Error; err != nil {
-		return result, errors.Wrap(err, database.ErrorDB)
-	}
+	GROUP BY demr.driver_transics_id, t.id, t.start_time, t.end_time`,
+	})
 
-	return result, nil
-}
+	//fuel consumed
+	RegisterMetric(&metric{
+		name:   "fuel_consumption",
+		unit:   "L",
+		format: formatDecimal,
+		query: `
+	SELECT demr.driver_transics_id as transics_id, t.start_time, t.end_time, SUM(fuel_consumption) as metric
+	FROM driver_eco_monitor_reports demr
+	LEFT JOIN tours t
+	ON demr.tour_id = t.id
+	WHERE t.start_time >= ?
+	AND (t.end_time < ? OR t.end_time IS NULL)
+	AND t.driver_transics_id IN (?)
+	GROUP BY demr.driver_transics_id, t.id, t.start_time, t.end_time`,
+	})
 
-//getFuelConsumption gets the fuel consumption of a driver
-func getFuelConsumption(driversList []string, start, end time.Time) ([]driverMetric, error) {
-	var result []driverMetric
-	if err := database.DB.Raw(`
-	SELECT demr.driver_transics_id as transics_id, SUM(fuel_consumption) as metric 
+	//ratio of rolling out
+	RegisterMetric(&metric{
+		name:           "roll_out",
+		unit:           "%",
+		higherIsBetter: true,
+		format:         formatPercent,
+		query: `
+	SELECT demr.driver_transics_id as transics_id, t.start_time, t.end_time, SUM(demr.distance_coasting) as metric, SUM(demr.distance) as total
 	FROM driver_eco_monitor_reports demr
 	LEFT JOIN tours t
 	ON demr.tour_id = t.id
 	WHERE t.start_time >= ?
-	AND (t.end_time <= ? OR t.end_time IS NULL)
+	AND (t.end_time < ? OR t.end_time IS NULL)
 	AND t.driver_transics_id IN (?)
-	GROUP BY demr.driver_transics_id
-	ORDER BY demr.driver_transics_id asc`,
-		start.Format("2006-01-02"), end.Format("2006-01-02"), driversList).Scan(&result).Error; err != nil {
-		return result, errors.Wrap(err, database.ErrorDB)
-	}
+	AND distance > 2
+	GROUP BY demr.driver_transics_id, t.id, t.start_time, t.end_time`,
+	})
 
-	return result, nil
+	//ratio of the time spent idling
+	RegisterMetric(&metric{
+		name:   "idling_ratio",
+		unit:   "%",
+		format: formatPercent,
+		query: `
+	SELECT demr.driver_transics_id as transics_id, t.start_time, t.end_time, SUM(demr.duration_idling) as metric, SUM(demr.duration_driving) as total
+	FROM driver_eco_monitor_reports demr
+	LEFT JOIN tours t
+	ON demr.tour_id = t.id
+	WHERE t.start_time >= ?
+	AND (t.end_time < ? OR t.end_time IS NULL)
+	AND t.driver_transics_id IN (?)
+	AND demr.duration_driving > 0
+	GROUP BY demr.driver_transics_id, t.id, t.start_time, t.end_time`,
+	})
+
+	//harsh accelerations per 100 km
+	RegisterMetric(&metric{
+		name:   "harsh_accelerations",
+		unit:   "/100km",
+		format: formatDecimal,
+		query: `
+	SELECT demr.driver_transics_id as transics_id, t.start_time, t.end_time, SUM(demr.number_of_harsh_accelerations) * 100.0 as metric, SUM(demr.distance) as total
+	FROM driver_eco_monitor_reports demr
+	LEFT JOIN tours t
+	ON demr.tour_id = t.id
+	WHERE t.start_time >= ?
+	AND (t.end_time < ? OR t.end_time IS NULL)
+	AND t.driver_transics_id IN (?)
+	AND distance > 2
+	GROUP BY demr.driver_transics_id, t.id, t.start_time, t.end_time`,
+	})
+
+	//ratio of the time driven at high RPM
+	RegisterMetric(&metric{
+		name:   "high_rpm",
+		unit:   "%",
+		format: formatPercent,
+		query: `
+	SELECT demr.driver_transics_id as transics_id, t.start_time, t.end_time, SUM(demr.duration_high_rpm) as metric, SUM(demr.duration_driving) as total
+	FROM driver_eco_monitor_reports demr
+	LEFT JOIN tours t
+	ON demr.tour_id = t.id
+	WHERE t.start_time >= ?
+	AND (t.end_time < ? OR t.end_time IS NULL)
+	AND t.driver_transics_id IN (?)
+	AND demr.duration_driving > 0
+	GROUP BY demr.driver_transics_id, t.id, t.start_time, t.end_time`,
+	})
 }
@@ -0,0 +1,62 @@
+package analysis
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//ReportFilter selects the drivers to build a report for, every driver of the period when empty
+type ReportFilter struct {
+	//Drivers are the TransicsID or PersonID of the drivers
+	Drivers []string
+	//Group is the name of a truck group, a driver belongs to the group of the trucks driven the most
+	Group string
+}
+
+//IsEmpty returns whether the filter selects every driver
+func (f ReportFilter) IsEmpty() bool {
+	return len(f.Drivers) == 0 && f.Group == ""
+}
+
+//apply keeps the drivers matching the filter, in the order of the drivers
+//a requested driver without report in the period is an error, as is a group without driver
+func (f ReportFilter) apply(drivers []string, data map[string]driverData, groups map[string]string) ([]string, error) {
+	if f.IsEmpty() {
+		return drivers, nil
+	}
+
+	//a driver is requested by TransicsID or PersonID
+	requested := make(map[string]bool, len(f.Drivers))
+	for _, id := range f.Drivers {
+		var found bool
+		for _, transicsID := range drivers {
+			if id == transicsID || (data[transicsID].PersonID != "" && id == data[transicsID].PersonID) {
+				requested[transicsID] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("Driver %s has no report in the period", id)
+		}
+	}
+
+	var selected []string
+	for _, transicsID := range drivers {
+		if len(f.Drivers) > 0 && !requested[transicsID] {
+			continue
+		}
+		if f.Group != "" && !strings.EqualFold(groups[transicsID], f.Group) {
+			continue
+		}
+		selected = append(selected, transicsID)
+	}
+	if len(selected) == 0 && len(f.Drivers) > 0 {
+		return nil, errors.Errorf("None of the drivers %s is in the truck group %s in the period", strings.Join(f.Drivers, ", "), f.Group)
+	}
+	if len(selected) == 0 {
+		return nil, errors.Errorf("No driver of the truck group %s has a report in the period", f.Group)
+	}
+
+	return selected, nil
+}
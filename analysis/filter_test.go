@@ -0,0 +1,77 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReportFilterApply(t *testing.T) {
+	drivers := []string{"1", "2", "3"}
+	data := map[string]driverData{
+		"1": {TransicsID: "1", PersonID: "P1"},
+		"2": {TransicsID: "2", PersonID: "P2"},
+		"3": {TransicsID: "3"},
+	}
+	groups := map[string]string{"1": "North", "2": "South", "3": "North"}
+
+	tests := []struct {
+		name    string
+		filter  ReportFilter
+		want    []string
+		wantErr string
+	}{
+		{
+			name:   "every driver",
+			filter: ReportFilter{},
+			want:   drivers,
+		},
+		{
+			name:   "drivers by TransicsID and PersonID",
+			filter: ReportFilter{Drivers: []string{"P2", "1"}},
+			want:   []string{"1", "2"},
+		},
+		{
+			name:   "truck group",
+			filter: ReportFilter{Group: "north"},
+			want:   []string{"1", "3"},
+		},
+		{
+			name:   "drivers of a truck group",
+			filter: ReportFilter{Drivers: []string{"1", "2"}, Group: "North"},
+			want:   []string{"1"},
+		},
+		{
+			name:    "driver without report",
+			filter:  ReportFilter{Drivers: []string{"4"}},
+			wantErr: "Driver 4 has no report in the period",
+		},
+		{
+			name:    "truck group without driver",
+			filter:  ReportFilter{Group: "East"},
+			wantErr: "No driver of the truck group East has a report in the period",
+		},
+		{
+			name:    "drivers out of the truck group",
+			filter:  ReportFilter{Drivers: []string{"2", "P1"}, Group: "East"},
+			wantErr: "None of the drivers 2, P1 is in the truck group East in the period",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filter.apply(drivers, data, groups)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("got error %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got drivers %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package analysis
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+	"tx2db/database"
+	"tx2db/locale"
+
+	"github.com/pkg/errors"
+)
+
+//Metric is a value computed for every driver of a report
+type Metric interface {
+	//Name identifies the metric in the templates and the exports
+	Name() string
+	Unit() string
+	//Label is the title of the metric in the language of a driver, English when missing
+	Label(language string) string
+	//HigherIsBetter tells if a driver should aim for a higher value
+	HigherIsBetter() bool
+	//Neutral tells if no value is better than another, the drivers are then neither ranked nor told they improved
+	Neutral() bool
+	//Compute returns the values of the metric for the given drivers by TransicsID over every period, in the order of the periods
+	//drivers without value in a period are omitted
+	Compute(drivers []string, periods []Period) ([]map[string]float64, error)
+	//Format writes a value with the number format of a language, followed by the unit of the metric
+	Format(value float64, loc *locale.Locale) string
+}
+
+//MetricValue is the value of a metric for a driver, as shown in a report
+type MetricValue struct {
+	Name           string
+	Unit           string
+	Label          string
+	Value          string
+	Raw            float64
+	Missing        bool
+	HigherIsBetter bool
+	Neutral        bool
+	//trend of the driver, empty without value before the report
+	Previous     string //value over the previous period
+	Delta        string //change since the previous period, +5.0%
+	Improved     bool   //better than the previous period
+	Average      string //average over the 8 weeks before the report
+	AverageDelta string //change compared to the average
+	//ranking of the driver in percent, top 20%, 0 when missing
+	GroupTop int //within the drivers of the same truck group
+	FleetTop int //within all the drivers
+}
+
+//Period is a range of days of a report
+type Period struct {
+	Start time.Time
+	End   time.Time //last day of the period
+}
+
+//contains tells if a tour is selected in the period: started from its first day and ended during its last day, or not ended
+func (p Period) contains(start time.Time, end *time.Time) bool {
+	if start.Before(midnight(p.Start, start.Location())) {
+		return false
+	}
+	return end == nil || end.Before(midnight(p.End.AddDate(0, 0, 1), end.Location()))
+}
+
+//span returns the period covering every period
+func span(periods []Period) Period {
+	covering := periods[0]
+	for _, p := range periods[1:] {
+		if p.Start.Before(covering.Start) {
+			covering.Start = p.Start
+		}
+		if p.End.After(covering.End) {
+			covering.End = p.End
+		}
+	}
+	return covering
+}
+
+//midnight returns the midnight of a day in a location
+func midnight(day time.Time, loc *time.Location) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+}
+
+//registry contains the registered metrics, in registration order
+var registry []Metric
+
+//RegisterMetric adds a metric to the driver reports and exports
+func RegisterMetric(m Metric) {
+	for _, registered := range registry {
+		if registered.Name() == m.Name() {
+			panic(fmt.Sprintf("analysis: metric %s registered twice", m.Name()))
+		}
+	}
+	registry = append(registry, m)
+}
+
+//Metrics returns the registered metrics
+func Metrics() []Metric {
+	return append([]Metric(nil), registry...)
+}
+
+//metric is a metric computed by a SQL query or a Go function
+//the query gets the start, the day after the end and the drivers and returns a row by driver and tour: transics_id, start_time and end_time of the tour and metric
+//the periods are read in a single query, the metric of a period is the sum of the metric of its tours
+//a ratio also returns a total column, the metric of a period is then the sum of the metric over the sum of the total
+//the label is the message metric.<name> of the catalogues, or one of the labels by language
+type metric struct {
+	name           string
+	unit           string
+	labels         map[string]string
+	higherIsBetter bool
+	neutral        bool
+	query          string
+	compute        func(drivers []string, periods []Period) ([]map[string]float64, error)
+	//format writes the value only, the unit is appended by Format
+	format func(float64, *locale.Locale) string
+}
+
+func (m *metric) Name() string {
+	return m.name
+}
+
+func (m *metric) Unit() string {
+	return m.unit
+}
+
+func (m *metric) Label(language string) string {
+	if label, ok := locale.Get(language).Lookup("metric." + m.name); ok {
+		return label
+	}
+	if label, ok := m.labels[language]; ok {
+		return label
+	}
+	if label, ok := m.labels["EN"]; ok {
+		return label
+	}
+	return m.name
+}
+
+func (m *metric) HigherIsBetter() bool {
+	return m.higherIsBetter
+}
+
+func (m *metric) Neutral() bool {
+	return m.neutral
+}
+
+func (m *metric) Compute(drivers []string, periods []Period) ([]map[string]float64, error) {
+	if len(periods) == 0 {
+		return nil, nil
+	}
+	if m.compute != nil {
+		return m.compute(drivers, periods)
+	}
+
+	all := span(periods)
+	var result []struct {
+		TransicsID string
+		StartTime  time.Time
+		EndTime    *time.Time
+		Metric     *float64
+		Total      *float64
+	}
+	if err := database.DB.Raw(m.query, all.Start.Format("2006-01-02"), all.End.AddDate(0, 0, 1).Format("2006-01-02"), drivers).Scan(&result).Error; err != nil {
+		return nil, errors.Wrapf(err, "%s: metric %s", database.ErrorDB, m.name)
+	}
+
+	values := make([]map[string]float64, len(periods))
+	for i, p := range periods {
+		sums := make(map[string]float64)
+		totals := make(map[string]float64)
+		for _, row := range result {
+			if row.Metric == nil || !p.contains(row.StartTime, row.EndTime) {
+				continue
+			}
+			sums[row.TransicsID] += *row.Metric
+			if row.Total != nil {
+				totals[row.TransicsID] += *row.Total
+			}
+		}
+
+		values[i] = make(map[string]float64, len(sums))
+		for driver, sum := range sums {
+			total, ratio := totals[driver]
+			switch {
+			case !ratio:
+				values[i][driver] = sum
+			//a ratio over nothing has no value
+			case total != 0:
+				values[i][driver] = sum / total
+			}
+		}
+	}
+
+	return values, nil
+}
+
+func (m *metric) Format(value float64, loc *locale.Locale) string {
+	if m.format == nil {
+		return strconv.FormatFloat(value, 'f', -1, 64) + m.unit
+	}
+	return m.format(value, loc) + m.unit
+}
+
+//formatDecimal formats a value with one decimal
+func formatDecimal(value float64, loc *locale.Locale) string {
+	return loc.Number(value, 1)
+}
+
+//formatInteger formats a count
+func formatInteger(value float64, loc *locale.Locale) string {
+	return loc.Number(value, 0)
+}
+
+//formatPercent formats a ratio as a number of percents, the unit of the metric is %
+func formatPercent(value float64, loc *locale.Locale) string {
+	return loc.Number(value*100, 1)
+}
+
+//driverMetrics contains the values of every registered metric by driver TransicsID
+type driverMetrics map[string]map[string]float64
+
+//computeMetrics computes every registered metric for the drivers over every period, in the order of the periods
+func computeMetrics(drivers []string, periods []Period) ([]driverMetrics, error) {
+	values := make([]driverMetrics, len(periods))
+	for i := range periods {
+		values[i] = make(driverMetrics, len(drivers))
+		for _, driver := range drivers {
+			values[i][driver] = make(map[string]float64, len(registry))
+		}
+	}
+
+	for _, m := range registry {
+		computed, err := m.Compute(drivers, periods)
+		if err != nil {
+			return nil, err
+		}
+		for i, period := range computed {
+			for driver, value := range period {
+				if _, ok := values[i][driver]; ok {
+					values[i][driver][m.Name()] = value
+				}
+			}
+		}
+	}
+
+	return values, nil
+}
+
+//reportMetrics returns the values of every registered metric of a driver, formatted in its language
+//the values are compared to the previous ones of the driver and to the other drivers when comparison is set
+func (d driverMetrics) reportMetrics(transicsID, language string, comparison *metricComparison) []MetricValue {
+	loc := locale.Get(language)
+	var metrics []MetricValue
+	for _, m := range registry {
+		value, ok := d[transicsID][m.Name()]
+		formatted := "-"
+		if ok {
+			formatted = m.Format(value, loc)
+		}
+		metrics = append(metrics, MetricValue{
+			Name:           m.Name(),
+			Unit:           m.Unit(),
+			Label:          m.Label(language),
+			Value:          formatted,
+			Raw:            value,
+			Missing:        !ok,
+			HigherIsBetter: m.HigherIsBetter(),
+			Neutral:        m.Neutral(),
+		})
+		if ok && comparison != nil {
+			comparison.compare(&metrics[len(metrics)-1], m, loc, d, transicsID)
+		}
+	}
+
+	return metrics
+}
+
+//exportMetricsCSV writes the raw values of every registered metric, a line per driver
+func exportMetricsCSV(filePath string, drivers []string, data map[string]driverData, values driverMetrics) error {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return errors.Wrap(err, "Could not create metrics export")
+	}
+	defer file.Close()
+
+	w := csv.NewWriter(file)
+	header := []string{"transics_id", "person_id", "name"}
+	for _, m := range registry {
+		header = append(header, fmt.Sprintf("%s (%s)", m.Name(), m.Unit()))
+	}
+	w.Write(header)
+
+	for _, driver := range drivers {
+		row := []string{driver, data[driver].PersonID, data[driver].Name}
+		for _, m := range registry {
+			value, ok := values[driver][m.Name()]
+			if !ok {
+				row = append(row, "")
+				continue
+			}
+			row = append(row, strconv.FormatFloat(value, 'f', -1, 64))
+		}
+		w.Write(row)
+	}
+	w.Flush()
+
+	return errors.Wrap(w.Error(), "Could not write metrics export")
+}
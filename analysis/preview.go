@@ -0,0 +1,223 @@
+package analysis
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"tx2db/locale"
+
+	"github.com/kardianos/osext"
+	"github.com/pkg/errors"
+)
+
+//previewReloadScript reloads a page of the preview when the version of the templates changes
+const previewReloadScript = `<script>
+(function() {
+    var version = null;
+    setInterval(function() {
+        fetch("/version").then(function(response) { return response.text(); }).then(function(current) {
+            if (version !== null && current !== version) {
+                location.reload();
+            }
+            version = current;
+        });
+    }, 1000);
+})();
+</script>
+`
+
+//styleSheetPath is the path of the style of the html templates, watched by the preview
+var styleSheetPath = path.Join("analysis", "assets", "css", "style.css")
+
+//reportGraphs are the graphs of the html templates, drawn by the R analysis or by renderGraph
+var reportGraphs = []string{"maps", "activity", "fuel_consumption", "idling", "high_speed"}
+
+//previewServer serves the html reports of a period as a legacy run writes them, the graphs are drawn in Go
+type previewServer struct {
+	wd         string
+	dir        string //folder the reports and their graphs are written in
+	reports    *reportSet
+	start, end time.Time
+
+	mu      sync.Mutex
+	version string
+	err     error //error of the last writing of the reports
+}
+
+//ServePreview serves the html reports of the drivers selected by the options on addr
+//the reports are written by a dry run in a temporary folder, again when the template or the style change, and the open pages reload
+//nothing is stored, mailed or uploaded and no network call is made
+func ServePreview(addr string, opts ReportOptions, start, end time.Time) error {
+	//get program path
+	wd, err := osext.ExecutableFolder()
+	if err != nil {
+		return err
+	}
+	//load the catalogues of the languages
+	if err := locale.Load(); err != nil {
+		return err
+	}
+
+	opts.DryRun = true
+	assetsPath := path.Join(wd, assetsFolderPath)
+	reports, err := collectReports(opts, assetsPath, start, end)
+	if err != nil {
+		return err
+	}
+
+	dir, err := ioutil.TempDir("", "tx2db-preview-")
+	if err != nil {
+		return errors.Wrap(err, "Cannot create the preview folder")
+	}
+	defer os.RemoveAll(dir)
+	for _, report := range reports.reports {
+		if err := drawGraphs(dir, report, start, end); err != nil {
+			return err
+		}
+	}
+
+	s := &previewServer{wd: wd, dir: dir, reports: reports, start: start, end: end}
+	if _, err := s.load(); err != nil {
+		return err
+	}
+
+	//the pages link to the assets by their path on disk, only the assets of the templates are served, not the generated reports
+	assetFiles := http.StripPrefix(assetsPath+"/", http.FileServer(http.Dir(assetsPath)))
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.serve)
+	mux.HandleFunc("/version", s.currentVersion)
+	mux.Handle(assetsPath+"/css/", assetFiles)
+	mux.Handle(assetsPath+"/flags/", assetFiles)
+	mux.Handle(assetsPath+"/logo.png", assetFiles)
+
+	log.Printf("Previewing %d drivers reports on http://%s\n", len(reports.reports), addr)
+	return errors.Wrap(http.ListenAndServe(addr, mux), "Preview server stopped")
+}
+
+//drawGraphs draws the graphs of the html report of a driver in a folder, named as by the R analysis
+func drawGraphs(dir string, report driverReport, start, end time.Time) error {
+	for _, graph := range reportGraphs {
+		buf := &bytes.Buffer{}
+		if err := renderGraph(buf, graph, report.data.TransicsID, report.language, start, end); err != nil {
+			return errors.Wrapf(err, "Graph %s of driver %s not drawn", graph, report.data.TransicsID)
+		}
+		name := fmt.Sprintf("%s_%s_graph_%s.png", report.data.TransicsID, graph, report.data.EndTime)
+		if err := ioutil.WriteFile(path.Join(dir, name), buf.Bytes(), 0644); err != nil {
+			return errors.Wrap(err, "Could not write graph")
+		}
+	}
+
+	return nil
+}
+
+//load writes the reports again when the template or the style changed and returns the version of the files
+//the version is the time of the latest change, the reports are kept as they were when the template cannot be parsed
+func (s *previewServer) load() (string, error) {
+	files := []string{styleSheetPath, reportTemplatePath}
+
+	var latest time.Time
+	for _, filePath := range files {
+		info, err := os.Stat(path.Join(s.wd, filePath))
+		if err != nil {
+			return "", errors.Wrap(err, "Cannot read report template")
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	version := strconv.FormatInt(latest.UnixNano(), 10)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if version == s.version {
+		return version, s.err
+	}
+	s.version = version
+	tmpl, err := parseTemplate(s.wd)
+	if err == nil {
+		for _, report := range s.reports.reports {
+			if err = writeReportHTML(s.dir, tmpl, report); err != nil {
+				break
+			}
+		}
+	}
+	if s.err = err; err != nil {
+		return version, err
+	}
+	log.Println("Report template loaded")
+
+	return version, nil
+}
+
+//currentVersion answers the version of the template, polled by the pages to reload
+func (s *previewServer) currentVersion(w http.ResponseWriter, r *http.Request) {
+	version, err := s.load()
+	if err != nil {
+		log.Printf("ERROR: Report template not loaded: %v\n", err)
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	fmt.Fprint(w, version)
+}
+
+//serve answers the list of the reports on /, and the files of the reports, /driver_P1_report_2020-02-16.html
+func (s *previewServer) serve(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/" {
+		s.index(w)
+		return
+	}
+
+	//only the files of the folder of the reports are served
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	if name != path.Base(path.Clean(r.URL.Path)) {
+		http.NotFound(w, r)
+		return
+	}
+	if !strings.HasSuffix(name, ".html") {
+		http.ServeFile(w, r, path.Join(s.dir, name))
+		return
+	}
+
+	//the page shows the error of the template, or the report with the script reloading it
+	buf := &bytes.Buffer{}
+	if _, err := s.load(); err != nil {
+		fmt.Fprintf(buf, "<!DOCTYPE html>\n<html>\n<body>\n<pre>%s</pre>\n</body>\n</html>\n", html.EscapeString(err.Error()))
+	} else {
+		s.mu.Lock()
+		content, err := ioutil.ReadFile(path.Join(s.dir, name))
+		s.mu.Unlock()
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		buf.Write(content)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(bytes.Replace(buf.Bytes(), []byte("</body>"), []byte(previewReloadScript+"</body>"), 1))
+}
+
+//index lists the drivers with a report
+func (s *previewServer) index(w http.ResponseWriter) {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "<!DOCTYPE html>\n<html>\n<head><meta charset=\"utf-8\"><title>Driver reports %s — %s</title></head>\n<body>\n",
+		s.start.Format("2006-01-02"), s.end.Format("2006-01-02"))
+	fmt.Fprintf(buf, "<h1>Driver reports %s — %s</h1>\n<ul>\n", s.start.Format("2006-01-02"), s.end.Format("2006-01-02"))
+	for _, report := range s.reports.reports {
+		fmt.Fprintf(buf, "<li><a href=\"/%s.html\">%s</a> %s (%s)</li>\n",
+			html.EscapeString(reportName(report.data)), html.EscapeString(report.data.FullName), html.EscapeString(report.data.PersonID), html.EscapeString(report.language))
+	}
+	fmt.Fprintf(buf, "</ul>\n</body>\n</html>\n")
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,383 @@
+package analysis
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/png" //decode the logo
+	"io"
+	"os"
+	"strings"
+	"time"
+	"tx2db/locale"
+
+	"github.com/pkg/errors"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+//size of a rendered report, 1500x900 px as the html reports so the pdf layout is the same
+const (
+	pageWidth    = vg.Length(1125)
+	pageHeight   = vg.Length(675)
+	pageDPI      = 96
+	sidebarWidth = vg.Length(165)
+	gap          = vg.Length(8)
+)
+
+//size of a graph of the html templates
+const (
+	graphWidth  = vg.Length(360)
+	graphHeight = vg.Length(270)
+)
+
+//the logo shown in the reports is compiled in the binary, run go generate after changing it
+//go:generate go run ../tools/embed -package analysis -var assets -o assets_gen.go assets/logo.png
+
+//activityColors are the backgrounds of the rows of the activities, as the R tables
+var activityColors = []color.Color{
+	color.RGBA{0xc6, 0xdb, 0xef, 0xff},
+	color.RGBA{0xde, 0xeb, 0xf7, 0xff},
+	color.RGBA{0xf7, 0xfb, 0xff, 0xff},
+}
+
+//renderer draws a report page, the positions are measured from the top left corner
+type renderer struct {
+	draw.Canvas
+	height        vg.Length
+	regular, bold vg.Font
+	loc           *locale.Locale
+}
+
+//renderReport draws the report of a driver and saves it as png
+//the graphs are computed from the database, the idling, consumption and speed ones include the week before the report
+func renderReport(pngPath string, data DriverReportData, language string, start, end time.Time) error {
+	loc := locale.Get(language)
+	regular, bold, err := reportFonts()
+	if err != nil {
+		return err
+	}
+
+	route, err := getRoute(data.TransicsID, start, end)
+	if err != nil {
+		return err
+	}
+	rows, err := getEcoRows(data.TransicsID, start.AddDate(0, 0, -7), end)
+	if err != nil {
+		return err
+	}
+	activities, err := getActivityShares(data.TransicsID, start, end)
+	if err != nil {
+		return err
+	}
+
+	img := vgimg.NewWith(vgimg.UseWH(pageWidth, pageHeight), vgimg.UseDPI(pageDPI), vgimg.UseBackgroundColor(reportGrey))
+	r := &renderer{Canvas: draw.New(img), height: pageHeight, regular: regular, bold: bold, loc: loc}
+
+	r.sidebar(data, loadLogo(), start, end)
+
+	//main part, two rows of three cards
+	left := sidebarWidth + gap
+	column := (pageWidth - left - 3*gap) / 3
+	top := vg.Length(20)
+	row := (pageHeight - top - 2*gap) / 2
+	x := func(i int) vg.Length { return left + vg.Length(i)*(column+gap) }
+
+	routePlot, err := routeChart(route)
+	if err != nil {
+		return err
+	}
+	routePlot.Draw(r.card(x(0), top, column, row, loc.T("report.route")))
+	r.metrics(x(1), top, column, row, data)
+	r.activities(r.card(x(2), top, column, row, loc.T("report.activities")), activities)
+
+	bottom := top + row + gap
+	charts := []struct {
+		title  string
+		axis   string
+		series barSeries
+	}{
+		{loc.T("report.consumption"), loc.T("chart.consumption_axis"), consumptionByDay(rows, loc)},
+		{loc.T("report.idling"), loc.T("chart.idling_axis"), idlingByWeek(rows, loc.T("chart.week"))},
+		{loc.T("report.speed"), loc.T("chart.speed_axis"), speedByWeek(rows, loc.T("chart.week"))},
+	}
+	for i, chart := range charts {
+		p, err := barChart(chart.series, chart.axis, column, loc)
+		if err != nil {
+			return err
+		}
+		p.Draw(r.card(x(i), bottom, column, row, chart.title))
+	}
+
+	file, err := os.Create(pngPath)
+	if err != nil {
+		return errors.Wrap(err, "Could not create report")
+	}
+	defer file.Close()
+	if _, err := (vgimg.PngCanvas{Canvas: img}).WriteTo(file); err != nil {
+		return errors.Wrap(err, "Could not write report")
+	}
+
+	return nil
+}
+
+//renderGraph draws a graph of the html templates as png, in place of the one of the R analysis
+//the graphs are maps, activity, fuel_consumption, idling and high_speed
+func renderGraph(w io.Writer, graph, transicsID, language string, start, end time.Time) error {
+	loc := locale.Get(language)
+	img := vgimg.NewWith(vgimg.UseWH(graphWidth, graphHeight), vgimg.UseDPI(pageDPI))
+	c := draw.New(img)
+
+	switch graph {
+	case "maps":
+		route, err := getRoute(transicsID, start, end)
+		if err != nil {
+			return err
+		}
+		p, err := routeChart(route)
+		if err != nil {
+			return err
+		}
+		p.Draw(c)
+	case "activity":
+		activities, err := getActivityShares(transicsID, start, end)
+		if err != nil {
+			return err
+		}
+		regular, bold, err := reportFonts()
+		if err != nil {
+			return err
+		}
+		r := &renderer{Canvas: c, height: graphHeight, regular: regular, bold: bold, loc: loc}
+		r.activities(c, activities)
+	case "fuel_consumption", "idling", "high_speed":
+		rows, err := getEcoRows(transicsID, start.AddDate(0, 0, -7), end)
+		if err != nil {
+			return err
+		}
+		series, axis := consumptionByDay(rows, loc), loc.T("chart.consumption_axis")
+		if graph == "idling" {
+			series, axis = idlingByWeek(rows, loc.T("chart.week")), loc.T("chart.idling_axis")
+		} else if graph == "high_speed" {
+			series, axis = speedByWeek(rows, loc.T("chart.week")), loc.T("chart.speed_axis")
+		}
+		p, err := barChart(series, axis, graphWidth, loc)
+		if err != nil {
+			return err
+		}
+		p.Draw(c)
+	default:
+		return errors.Errorf("Unknown graph %s", graph)
+	}
+
+	if _, err := (vgimg.PngCanvas{Canvas: img}).WriteTo(w); err != nil {
+		return errors.Wrap(err, "Could not write graph")
+	}
+
+	return nil
+}
+
+//reportFonts returns the regular and bold fonts of the reports
+func reportFonts() (vg.Font, vg.Font, error) {
+	regular, err := vg.MakeFont(chartFont, 10)
+	if err != nil {
+		return regular, regular, err
+	}
+	bold, err := vg.MakeFont(chartFont+"-Bold", 10)
+	return regular, bold, err
+}
+
+//loadLogo decodes the embedded logo, the report is drawn without it when invalid
+func loadLogo() image.Image {
+	logo, _, err := image.Decode(strings.NewReader(assets["logo.png"]))
+	if err != nil {
+		return nil
+	}
+	return logo
+}
+
+//sidebar draws the driver, the period, the trucks, the countries, the violations and the joke
+func (r *renderer) sidebar(data DriverReportData, logo image.Image, start, end time.Time) {
+	r.rectangle(0, 0, sidebarWidth, r.height, reportGreen)
+
+	width := sidebarWidth - 2*gap
+	y := vg.Length(15)
+	if logo != nil {
+		size := logo.Bounds().Size()
+		w := sidebarWidth * 0.7
+		h := w * vg.Length(size.Y) / vg.Length(size.X)
+		r.DrawImage(r.rect((sidebarWidth-w)/2, y, w, h), logo)
+		y += h + gap
+	}
+
+	y = r.paragraph(r.style(true, 14, reportYellow), gap, y, width, data.FullName)
+	y = r.paragraph(r.style(false, 9, color.White), gap, y, width, data.PersonID)
+	r.rectangle(gap, y+4, width, 3, reportYellow)
+	y += 12
+
+	heading := r.style(false, 12, reportYellow)
+	text := r.style(false, 9, color.White)
+	y = r.paragraph(heading, gap, y+4, width, r.loc.T("report.period"))
+	y = r.paragraph(text, gap, y, width, fmt.Sprintf("%s — %s", r.loc.Date(start), r.loc.Date(end)))
+
+	y = r.paragraph(heading, gap, y+4, width, r.loc.T("report.trucks"))
+	for _, truck := range data.TruckDriven {
+		y = r.paragraph(text, gap, y, width, truck)
+	}
+
+	y = r.paragraph(heading, gap, y+4, width, r.loc.T("report.countries"))
+	y = r.paragraph(text, gap, y, width, strings.Join(data.VisitedCountries, "  "))
+
+	if data.ComplianceChecked {
+		y = r.paragraph(heading, gap, y+4, width, r.loc.T("report.compliance"))
+		for _, violation := range data.Violations {
+			y = r.paragraph(text, gap, y, width, fmt.Sprintf("%s — %s: %s / %s", violation.Date, r.loc.T("rule."+violation.Rule), violation.Value, violation.Limit))
+		}
+		if len(data.Violations) == 0 {
+			y = r.paragraph(text, gap, y, width, r.loc.T("report.no_infringement"))
+		}
+	}
+
+	y = r.paragraph(heading, gap, y+4, width, r.loc.T("report.joke"))
+	r.paragraph(text, gap, y, width, data.PersonalJoke)
+}
+
+//metrics draws a card per metric, on two columns
+func (r *renderer) metrics(x, y, width, height vg.Length, data DriverReportData) {
+	if len(data.Metrics) == 0 {
+		return
+	}
+
+	rows := (len(data.Metrics) + 1) / 2
+	w := (width - gap/2) / 2
+	h := (height - vg.Length(rows-1)*gap/2) / vg.Length(rows)
+	for i, metric := range data.Metrics {
+		cx, cy := x+vg.Length(i%2)*(w+gap/2), y+vg.Length(i/2)*(h+gap/2)
+		r.rectangle(cx, cy, w, h, color.White)
+
+		center := cx + w/2
+		ty := r.centered(r.style(true, 18, reportBlue), center, cy+4, metric.Value)
+		ty = r.centered(r.style(false, 8, reportBlue), center, ty, metric.Label)
+		trend := r.style(false, 7, reportMuted)
+		if metric.Delta != "" {
+			improved := trend
+			if metric.Improved {
+				improved.Color = reportOK
+			}
+			ty = r.centered(improved, center, ty, fmt.Sprintf("%s %s", metric.Delta, r.loc.T("report.vs_previous")))
+		}
+		if metric.Average != "" {
+			ty = r.centered(trend, center, ty, fmt.Sprintf("%s %s", r.loc.T("report.average"), metric.Average))
+		}
+		if metric.FleetTop > 0 {
+			ranking := r.loc.Tf("report.fleet_top", metric.FleetTop)
+			if metric.GroupTop > 0 {
+				ranking += ", " + r.loc.Tf("report.group_top", metric.GroupTop, data.TruckGroup)
+			}
+			r.centered(trend, center, ty, ranking)
+		}
+	}
+}
+
+//activities draws the share of every activity as a table, as many as fit
+func (r *renderer) activities(area draw.Canvas, activities []activityShare) {
+	sty := r.style(false, 9, reportBlue)
+	rowHeight := sty.Font.Size * 1.6
+	//the area is in canvas coordinates
+	x, y := area.Min.X, r.height-area.Max.Y
+	width := area.Max.X - area.Min.X
+	for i, activity := range activities {
+		if r.height-y-rowHeight < area.Min.Y {
+			break
+		}
+		r.rectangle(x, y, width, rowHeight, activityColors[i%len(activityColors)])
+		sty.XAlign = draw.XLeft
+		r.line(sty, x+4, y+rowHeight*0.2, activity.Activity)
+		sty.XAlign = draw.XRight
+		r.line(sty, x+width-4, y+rowHeight*0.2, r.loc.Number(activity.Share*100, 2)+"%")
+		y += rowHeight
+	}
+}
+
+//card draws a white card with a title and returns the area under the title
+func (r *renderer) card(x, y, width, height vg.Length, title string) draw.Canvas {
+	r.rectangle(x, y, width, height, color.White)
+	below := r.centered(r.style(true, 14, reportBlue), x+width/2, y+4, title)
+
+	area := r.Canvas
+	area.Rectangle = r.rect(x+gap, below+gap/2, width-2*gap, height-(below-y)-gap)
+	return area
+}
+
+//style returns a text style
+func (r *renderer) style(bold bool, size vg.Length, c color.Color) draw.TextStyle {
+	font := r.regular
+	if bold {
+		font = r.bold
+	}
+	font.Size = size
+
+	return draw.TextStyle{Color: c, Font: font}
+}
+
+//rect converts a rectangle from the top left corner to the canvas coordinates
+func (r *renderer) rect(x, y, width, height vg.Length) vg.Rectangle {
+	return vg.Rectangle{
+		Min: vg.Point{X: x, Y: r.height - y - height},
+		Max: vg.Point{X: x + width, Y: r.height - y},
+	}
+}
+
+//rectangle fills a rectangle
+func (r *renderer) rectangle(x, y, width, height vg.Length, c color.Color) {
+	rect := r.rect(x, y, width, height)
+	r.FillPolygon(c, []vg.Point{rect.Min, {X: rect.Max.X, Y: rect.Min.Y}, rect.Max, {X: rect.Min.X, Y: rect.Max.Y}})
+}
+
+//line draws a line of text with its top at y, aligned on x according to the style, and returns the position under it
+func (r *renderer) line(sty draw.TextStyle, x, y vg.Length, text string) vg.Length {
+	x += vg.Length(sty.XAlign) * sty.Font.Width(text)
+	r.SetColor(sty.Color)
+	r.FillString(sty.Font, vg.Point{X: x, Y: r.height - y - sty.Font.Size*0.8}, text)
+	return y + sty.Font.Size*1.25
+}
+
+//centered draws a line of text centered on x and returns the position under it
+func (r *renderer) centered(sty draw.TextStyle, x, y vg.Length, text string) vg.Length {
+	sty.XAlign = draw.XCenter
+	return r.line(sty, x, y, text)
+}
+
+//paragraph draws a text wrapped to a width and returns the position under it
+//the lines below the page are left out
+func (r *renderer) paragraph(sty draw.TextStyle, x, y, width vg.Length, text string) vg.Length {
+	for _, line := range wrap(sty.Font, text, width) {
+		if y+sty.Font.Size*1.25 > r.height-gap {
+			break
+		}
+		y = r.line(sty, x, y, line)
+	}
+	return y
+}
+
+//wrap splits a text in lines of at most width, a word longer than the width gets its own line
+func wrap(font vg.Font, text string, width vg.Length) []string {
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if line != "" && font.Width(candidate) > width {
+			lines = append(lines, line)
+			candidate = word
+		}
+		line = candidate
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -12,6 +12,9 @@ import (
 	"strings"
 	"text/template"
 	"time"
+	"tx2db/compliance"
+	"tx2db/database"
+	"tx2db/locale"
 	"tx2db/util"
 
 	"github.com/kardianos/osext"
@@ -25,33 +28,48 @@ type DriverReportData struct {
 	PersonID         string
 	TransicsID       string
 	TruckDriven      []string
-	DrivenKm         string
-	CruiseControl    string
-	PanicBrakes      string
-	FuelConsumption  string
+	TruckGroup       string
+	Metrics          []MetricValue //every registered metric, in registration order
 	VisitedCountries []string
 	PersonalJoke     string
 	StartTime        string
 	EndTime          string
+	AssetsPath       string //folder of the css, flags and logo of the html templates
+	//ComplianceChecked shows the driving and rest times section
+	ComplianceChecked bool
+	Violations        []ComplianceItem
+}
+
+//ComplianceItem is a driving or rest time violation shown in a report
+//Value and Limit are formatted durations, 4h30
+type ComplianceItem struct {
+	Rule  string
+	Date  string
+	Value string
+	Limit string
 }
 
 var (
 	//path of the analysis
-	reportFolderPath     = path.Join("analysis", "assets", "report")
-	reportTemplatePathDE = path.Join("analysis", "driver_report_de.html")
-	reportTemplatePathEN = path.Join("analysis", "driver_report_en.html")
-	reportTemplatePathFR = path.Join("analysis", "driver_report_fr.html")
-	reportTemplatePathNL = path.Join("analysis", "driver_report_nl.html")
-	analysisPath         = path.Join("analysis", "analysis.R")
-	//path of the html2png.js
+	assetsFolderPath   = path.Join("analysis", "assets")
+	reportFolderPath   = path.Join("analysis", "assets", "report")
+	reportTemplatePath = path.Join("analysis", "driver_report.html")
+	analysisPath       = path.Join("analysis", "analysis.R")
+	//path of the html2png.js, the filled in one is written in the folder of the run
 	phantomPath    = path.Join("analysis", "html2png.js")
-	phantomGenPath = path.Join("analysis", "html2png_gen.js")
+	phantomGenName = "html2png_gen.js"
 )
 
-//startAnalysis launch the R analysis
-func startAnalysis(wd, startTime, endTime string) error {
+//startAnalysis launch the R analysis, writing the graphs in the folder of the run
+//the graphs are only built for the given drivers, or every driver when empty
+func startAnalysis(wd, runDir, startTime, endTime string, drivers []string) error {
+	args := []string{path.Join(wd, analysisPath), runDir, startTime, endTime}
+	if len(drivers) > 0 {
+		args = append(args, strings.Join(drivers, ","))
+	}
+
 	//Run the analysis
-	r := exec.Command("Rscript", path.Join(wd, analysisPath), path.Join(wd, reportFolderPath), startTime, endTime)
+	r := exec.Command("Rscript", args...)
 	//display error and output
 	r.Stdout = os.Stdout
 	r.Stderr = os.Stderr
@@ -64,21 +82,23 @@ func startAnalysis(wd, startTime, endTime string) error {
 }
 
 //saveReport runs phantomjs to take a convert a html template to png
-func saveReport(wd, genReportPath string) error {
+func saveReport(wd, runDir, genReportPath string) error {
 	//fill in template
 	tmpl, err := template.ParseFiles(path.Join(wd, phantomPath))
 	if err != nil {
-		return err
+		return errors.Wrap(err, "Could not read phantomjs script")
 	}
 	buf := &bytes.Buffer{}
-	err = tmpl.Execute(buf, genReportPath)
+	if err := tmpl.Execute(buf, genReportPath); err != nil {
+		return errors.Wrap(err, "Could not fill in phantomjs script")
+	}
 
-	if err := ioutil.WriteFile(path.Join(wd, phantomGenPath), buf.Bytes(), 0644); err != nil {
-		log.Fatal(err)
+	if err := ioutil.WriteFile(path.Join(runDir, phantomGenName), buf.Bytes(), 0644); err != nil {
+		return errors.Wrap(err, "Could not write phantomjs script")
 	}
 
 	//run phantomjs
-	phantom := exec.Command("phantomjs", path.Join(wd, phantomGenPath))
+	phantom := exec.Command("phantomjs", path.Join(runDir, phantomGenName))
 	//display error and output
 	phantom.Stdout = os.Stdout
 	phantom.Stderr = os.Stderr
@@ -92,10 +112,10 @@ func saveReport(wd, genReportPath string) error {
 	return nil
 }
 
-//cleanAnalysis remove the uncessary analysis report files required only for its generation
-func cleanAnalysis(wd string) error {
+//cleanAnalysis remove the uncessary analysis report files of a run required only for its generation
+func cleanAnalysis(runDir string) error {
 	//clean report files
-	report, err := os.Open(path.Join(wd, reportFolderPath))
+	report, err := os.Open(runDir)
 	if err != nil {
 		return err
 	}
@@ -103,8 +123,8 @@ func cleanAnalysis(wd string) error {
 
 	names, err := report.Readdirnames(0)
 	for _, f := range names {
-		if strings.Contains(f, ".html") || strings.Contains(f, "_graph_") {
-			if err := os.Remove(path.Join(wd, reportFolderPath, f)); err != nil {
+		if strings.Contains(f, ".html") || strings.Contains(f, "_graph_") || f == phantomGenName {
+			if err := os.Remove(path.Join(runDir, f)); err != nil {
 				return errors.Wrap(err, "Could not remove report files")
 			}
 		}
@@ -113,113 +133,123 @@ func cleanAnalysis(wd string) error {
 	return nil
 }
 
-//BuildDriverReport builds a report aimed at drivers
-func BuildDriverReport(skipSendMail, skipSendDriverMail, skipUploadToFtp bool, startTime, endTime time.Time) error {
+//ReportOptions are the options of a run of the driver reports
+type ReportOptions struct {
+	//SkipSendMail sends no mail, SkipSendDriverMail only no mail to the drivers
+	SkipSendMail       bool
+	SkipSendDriverMail bool
+	SkipUploadToFtp    bool
+	//WithCompliance checks the driving and rest times of the period and shows their violations in the reports
+	WithCompliance bool
+	//LegacyRendering renders the reports with R and phantomjs from the html templates instead of drawing them in Go
+	LegacyRendering bool
+	//DryRun renders the reports in a temporary folder without storing the eco scores and violations or any network call
+	DryRun bool
+	//Filter selects the drivers getting a report, every driver when empty
+	Filter ReportFilter
+}
+
+//driverReport is the data of the report of a driver and the language it is written in
+type driverReport struct {
+	data     DriverReportData
+	language string
+}
+
+//reportSet contains the reports of the drivers of a period
+type reportSet struct {
+	//drivers are the drivers with a report
+	drivers    []string
+	driverData map[string]driverData
+	//metrics contains the metrics of the whole fleet
+	metrics driverMetrics
+	reports []driverReport
+	//compliance is the check of the driving and rest times of the period, nil when not checked
+	compliance *compliance.Result
+}
+
+//collectReports computes the data of the reports of the drivers selected by the filter of the options, nothing is stored
+//the joke of the day is fetched online, a dry run leaves it empty
+func collectReports(opts ReportOptions, assetsPath string, startTime, endTime time.Time) (*reportSet, error) {
 	//format start and end time
 	formatedStartTime := startTime.Format("2006-01-02")
 	formatedEndTime := endTime.Format("2006-01-02")
 
-	//get metrics
-	drivenKm, err := getDrivenKm(startTime, endTime)
-	if err != nil {
-		return err
-	}
-	//get list of which driver report to build
-	var driverList []string
-	for _, driver := range drivenKm {
-		driverList = append(driverList, driver.TransicsID)
-	}
-
-	log.Printf("Generating %d drivers reports for the period %s to %s\n", len(driverList), formatedStartTime, formatedEndTime)
-
-	//get metrics
-	driverData, err := getDriverData(driverList)
-	if err != nil {
-		return err
-	}
-	//get metrics
-	driverLanguage, err := getDriverLanguage(driverList)
-	if err != nil {
-		return err
-	}
-	//get metrics
-	truckDriven, err := getTruckDriven(driverList, startTime, endTime)
-	if err != nil {
-		return err
-	}
-	//get metrics
-	panicBrakes, err := getTotalPanicBrakes(driverList, startTime, endTime)
-	if err != nil {
-		return err
-	}
-	//get metrics
-	vistedCountries, err := getVisitedCountries(driverList, startTime, endTime)
+	//get the drivers of the period, the fleet the drivers are ranked in
+	fleet, err := getReportDrivers(startTime, endTime)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	//get metrics
-	cruiseControl, err := getCruiseControlRatio(driverList, startTime, endTime)
+	//get driver information
+	driverData, err := getDriverData(fleet)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	//get metrics
-	fuelConsumption, err := getFuelConsumption(driverList, startTime, endTime)
+	//get truck groups
+	groups, err := getDriverGroups(fleet, startTime, endTime)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	//get program path
-	wd, err := osext.ExecutableFolder()
+	//get list of which driver report to build
+	driverList, err := opts.Filter.apply(fleet, driverData, groups)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	//parse all templates
-	tmplDE, err := template.ParseFiles(path.Join(wd, reportTemplatePathDE))
+	log.Printf("Generating %d drivers reports for the period %s to %s\n", len(driverList), formatedStartTime, formatedEndTime)
+
+	//get metrics of the fleet, to rank the drivers
+	computed, err := computeMetrics(fleet, []Period{{Start: startTime, End: endTime}})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tmplEN, err := template.ParseFiles(path.Join(wd, reportTemplatePathEN))
+	metrics := computed[0]
+	//get metrics of the previous periods
+	comparison, err := computeComparison(driverList, groups, startTime, endTime)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tmplFR, err := template.ParseFiles(path.Join(wd, reportTemplatePathFR))
+	//get trucks
+	truckDriven, err := getTruckDriven(driverList, startTime, endTime)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tmplNL, err := template.ParseFiles(path.Join(wd, reportTemplatePathNL))
+	//get countries
+	vistedCountries, err := getVisitedCountries(driverList, startTime, endTime)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	//start (and clean) analysis
-	if err := startAnalysis(wd, formatedStartTime, formatedEndTime); err != nil {
-		return err
+	set := &reportSet{drivers: driverList, driverData: driverData, metrics: metrics}
+	//check driving and rest times, the end time is the last day of the report
+	violations := make(map[string][]database.ComplianceViolation)
+	if opts.WithCompliance {
+		if set.compliance, err = compliance.Check(startTime, endTime.AddDate(0, 0, 1), compliance.EULimits); err != nil {
+			return nil, err
+		}
+		for _, violation := range set.compliance.Violations {
+			transicsID := strconv.FormatUint(uint64(violation.DriverTransicsID), 10)
+			violations[transicsID] = append(violations[transicsID], violation)
+		}
 	}
-	defer cleanAnalysis(wd)
 
-	//genReportPathList contains the list of path of the generated reports
-	var genReportPathList []string
-	//fill in templates -- ASSUME THAT RESULTS ARE SORTED
-	for i, driverDrivenKm := range drivenKm {
+	//fill in report data
+	for _, transicsID := range driverList {
 		var data DriverReportData
+		driver := driverData[transicsID]
 
-		//assign metrics to report data
-		kms, _ := strconv.ParseFloat(driverDrivenKm.Metric, 32)
-		data.DrivenKm = fmt.Sprintf("%.1f", kms)
-		data.TransicsID = driverDrivenKm.TransicsID
-
+		data.TransicsID = transicsID
 		data.StartTime = formatedStartTime
 		data.EndTime = formatedEndTime
+		data.AssetsPath = assetsPath
 
-		data.FullName = strings.ToUpper(driverData[i].Name)
-		data.PersonID = driverData[i].PersonID
-		data.Email = driverData[i].Email
+		data.FullName = strings.ToUpper(driver.Name)
+		data.PersonID = driver.PersonID
+		data.Email = driver.Email
 
-		data.PanicBrakes = fmt.Sprintf("%sx", panicBrakes[i].Metric)
-		data.FuelConsumption = fmt.Sprintf("%sL", fuelConsumption[i].Metric)
-		cc, _ := strconv.ParseFloat(cruiseControl[i].Metric, 32)
-		data.CruiseControl = fmt.Sprintf("%.1f%%", cc*100)
+		//assign metrics to report data
+		data.Metrics = metrics.reportMetrics(transicsID, driver.Language, comparison)
+		data.TruckGroup = comparison.groups[transicsID]
 
 		for _, truck := range truckDriven {
 			if truck.TransicsID == data.TransicsID {
@@ -227,6 +257,18 @@ func BuildDriverReport(skipSendMail, skipSendDriverMail, skipUploadToFtp bool, s
 			}
 		}
 
+		//the violations are dated in the language of the driver
+		data.ComplianceChecked = opts.WithCompliance
+		loc := locale.Get(driver.Language)
+		for _, violation := range violations[data.TransicsID] {
+			data.Violations = append(data.Violations, ComplianceItem{
+				Rule:  violation.Rule,
+				Date:  loc.DateTime(violation.StartTime),
+				Value: formatMinutes(violation.Value),
+				Limit: formatMinutes(violation.Limit),
+			})
+		}
+
 		for _, country := range vistedCountries {
 			if country.TransicsID == data.TransicsID {
 				if country.Metric != "" {
@@ -236,48 +278,195 @@ func BuildDriverReport(skipSendMail, skipSendDriverMail, skipUploadToFtp bool, s
 		}
 
 		//get personal joke (short only)
-		for len(data.PersonalJoke) == 0 || len(data.PersonalJoke) > 500 {
-			data.PersonalJoke = util.GetJoke(driverLanguage[i].Metric)
+		for !opts.DryRun && (len(data.PersonalJoke) == 0 || len(data.PersonalJoke) > 500) {
+			data.PersonalJoke = util.GetJoke(driver.Language)
 		}
 
-		//fill in template (with right translation)
-		var report *template.Template
-		switch driverLanguage[i].Metric {
-		case "DU":
-			report = tmplDE
-		case "FR":
-			report = tmplFR
-		case "NL":
-			report = tmplNL
-		default:
-			report = tmplEN
+		set.reports = append(set.reports, driverReport{data: data, language: driver.Language})
+	}
+
+	return set, nil
+}
+
+//templateFuncs returns the functions translating the html template in a language
+//t and tf write the messages of the catalogue, date a day of the report and lang the language tag
+func templateFuncs(loc *locale.Locale) template.FuncMap {
+	return template.FuncMap{
+		"t":    loc.T,
+		"tf":   loc.Tf,
+		"lang": loc.Tag,
+		"date": func(day string) string {
+			t, err := time.Parse("2006-01-02", day)
+			if err != nil {
+				return day
+			}
+			return loc.Date(t)
+		},
+	}
+}
+
+//parseTemplate parses the html template, the same for every language
+func parseTemplate(wd string) (*template.Template, error) {
+	templatePath := path.Join(wd, reportTemplatePath)
+	return template.New(path.Base(templatePath)).Funcs(templateFuncs(locale.Get(locale.DefaultLanguage))).ParseFiles(templatePath)
+}
+
+//localize returns a copy of the html template writing in a language
+func localize(tmpl *template.Template, language string) (*template.Template, error) {
+	localized, err := tmpl.Clone()
+	if err != nil {
+		return nil, err
+	}
+	return localized.Funcs(templateFuncs(locale.Get(language))), nil
+}
+
+//reportName returns the name of the files of the report of a driver, without extension
+func reportName(data DriverReportData) string {
+	return fmt.Sprintf("driver_%s_report_%s", data.PersonID, data.EndTime)
+}
+
+//writeReportHTML fills in the template in the language of a report and writes it in a folder, the graphs are read beside it
+//the legacy rendering converts this file to png and the preview serves it
+func writeReportHTML(dir string, tmpl *template.Template, report driverReport) error {
+	localized, err := localize(tmpl, report.language)
+	if err != nil {
+		return err
+	}
+	buf := &bytes.Buffer{}
+	if err := localized.Execute(buf, report.data); err != nil {
+		return errors.Wrapf(err, "Could not fill in report %s", reportName(report.data))
+	}
+	if err := ioutil.WriteFile(path.Join(dir, reportName(report.data)+".html"), buf.Bytes(), 0644); err != nil {
+		return errors.Wrap(err, "Could not write report")
+	}
+
+	return nil
+}
+
+//BuildDriverReport builds a report aimed at drivers
+//every run writes in its own folder, listed in its manifest.json, and the old runs are removed according to the retention
+//only the drivers selected by the filter get a report, the fleet pdf is then neither uploaded nor announced to the instructor
+func BuildDriverReport(opts ReportOptions, startTime, endTime time.Time) (err error) {
+	//format start and end time
+	formatedStartTime := startTime.Format("2006-01-02")
+	formatedEndTime := endTime.Format("2006-01-02")
+
+	//get program path
+	wd, err := osext.ExecutableFolder()
+	if err != nil {
+		return err
+	}
+	//load the catalogues of the languages
+	if err := locale.Load(); err != nil {
+		return err
+	}
+
+	//start the run in its own folder, the manifest gets the outcome of the run
+	root := outputRoot(wd)
+	if opts.DryRun {
+		if root, err = ioutil.TempDir("", "tx2db-report-"); err != nil {
+			return errors.Wrap(err, "Cannot create the dry run folder")
 		}
-		buf := &bytes.Buffer{}
-		err = report.Execute(buf, data)
+	}
+	run, err := startRun(root, startTime, endTime)
+	if err != nil {
+		return err
+	}
+	log.Printf("Report run %s started in %s\n", run.manifest.RunID, run.dir)
+	defer func() {
+		if finishErr := run.finish(err); finishErr != nil && err == nil {
+			err = finishErr
+		}
+		//a dry run is left in the temporary folder
+		if opts.DryRun {
+			log.Printf("Dry run, nothing sent, the reports are in %s\n", run.dir)
+			return
+		}
+		if cleanErr := cleanRuns(root, run.manifest.RunID, retentionFromEnv()); cleanErr != nil {
+			log.Printf("ERROR: Old report runs not removed: %v\n", cleanErr)
+		}
+	}()
 
-		//save template to disk
-		genReportPath := path.Join(wd, reportFolderPath, fmt.Sprintf("driver_%s_report_%s", data.PersonID, endTime.Format("2006-01-02")))
-		if err := ioutil.WriteFile(genReportPath+".html", buf.Bytes(), 0644); err != nil {
-			log.Fatal(err)
+	reports, err := collectReports(opts, path.Join(wd, assetsFolderPath), startTime, endTime)
+	if err != nil {
+		return err
+	}
+	//store eco scores and violations, a dry run leaves the database as it is
+	if !opts.DryRun {
+		if err := storeEcoScores(startTime, endTime); err != nil {
+			return err
 		}
+		if reports.compliance != nil {
+			if err := compliance.Store(startTime, endTime.AddDate(0, 0, 1), reports.compliance); err != nil {
+				return err
+			}
+		}
+	}
 
-		//save template to png
-		if err := saveReport(wd, genReportPath); err != nil {
-			log.Fatal(err)
+	//parse the template, only the legacy rendering uses it
+	var tmpl *template.Template
+	if opts.LegacyRendering {
+		if tmpl, err = parseTemplate(wd); err != nil {
+			return err
+		}
+
+		//start (and clean) analysis
+		var analysisDrivers []string
+		if !opts.Filter.IsEmpty() {
+			analysisDrivers = reports.drivers
+		}
+		if err := startAnalysis(wd, run.dir, formatedStartTime, formatedEndTime, analysisDrivers); err != nil {
+			return err
+		}
+		defer cleanAnalysis(run.dir)
+	}
+
+	//export the metrics of all drivers
+	csvName := fmt.Sprintf("driver_metrics_%s.csv", formatedEndTime)
+	if err := exportMetricsCSV(run.path(csvName), reports.drivers, reports.driverData, reports.metrics); err != nil {
+		return err
+	}
+	run.add(artifactMetrics, csvName, "")
+
+	//genReportPathList contains the list of path of the generated reports
+	var genReportPathList []string
+	//render reports
+	for _, report := range reports.reports {
+		data := report.data
+
+		genReportName := reportName(data)
+		genReportPath := run.path(genReportName)
+		if opts.LegacyRendering {
+			//fill in template (with right translation)
+			if err := writeReportHTML(run.dir, tmpl, report); err != nil {
+				return err
+			}
+
+			//save template to png
+			if err := saveReport(wd, run.dir, genReportPath); err != nil {
+				return errors.Wrapf(err, "Could not convert report %s", genReportName)
+			}
+		} else {
+			//draw the report
+			if err := renderReport(genReportPath+".png", data, report.language, startTime, endTime); err != nil {
+				return err
+			}
+			log.Printf("Report successfully generated in %s.png\n", genReportPath)
 		}
 
 		//add all report path a list
 		genReportPathList = append(genReportPathList, genReportPath+".png")
+		run.add(artifactReport, genReportName+".png", data.TransicsID)
 
 		//send analysis mail to drivers
-		if !skipSendMail && !skipSendDriverMail {
+		if !opts.DryRun && !opts.SkipSendMail && !opts.SkipSendDriverMail {
 			//inform SYSTEM_ADMINISTATOR_EMAIL if no driver mail provided
 			if data.Email == "" {
 				if err := util.InformSystemAdministratorDriverEmailMissing(data.PersonID); err != nil {
 					log.Printf("ERROR: System Administrator not informed of unexisting mail: %v\n", err)
 				}
 			} else {
-				if err := util.InformDriver(data.Email, genReportPath+".png", formatedStartTime, formatedEndTime); err != nil {
+				if err := util.InformDriver(data.Email, report.language, genReportPath+".png", startTime, endTime); err != nil {
 					log.Printf("ERROR: Driver mail not informed of available report: %v\n", err)
 				}
 			}
@@ -288,13 +477,14 @@ func BuildDriverReport(skipSendMail, skipSendDriverMail, skipUploadToFtp bool, s
 	if len(genReportPathList) > 0 {
 		//build all reports to pdf
 		pdfName := fmt.Sprintf("weekly_report_%s.pdf", formatedEndTime)
-		pdfPath := path.Join(wd, reportFolderPath, pdfName)
+		pdfPath := run.path(pdfName)
 		if err := util.BuildPDFFromImages(pdfPath, genReportPathList); err != nil {
 			return err
 		}
+		run.add(artifactPDF, pdfName, "")
 
-		//upload pdf to ftp
-		if !skipUploadToFtp {
+		//upload pdf to ftp, a filtered run does not replace the pdf of the fleet
+		if !opts.DryRun && !opts.SkipUploadToFtp && opts.Filter.IsEmpty() {
 			if err := util.UploadToFTP(pdfName, pdfPath); err != nil {
 				//inform system administator
 				util.InformSystemAdministratorFTPError(pdfPath)
@@ -304,12 +494,18 @@ func BuildDriverReport(skipSendMail, skipSendDriverMail, skipUploadToFtp bool, s
 		}
 
 		//inform INSTRUCTOR_EMAIL that weekly analysis are available
-		if !skipSendMail {
-			if err := util.InformInstructor(formatedStartTime, formatedEndTime); err != nil {
-				log.Fatalf("ERROR: Instructor not informed of new weekly driver analysis available: %v\n", err)
+		if !opts.DryRun && !opts.SkipSendMail && opts.Filter.IsEmpty() {
+			if err := util.InformInstructor(startTime, endTime); err != nil {
+				return errors.Wrap(err, "Instructor not informed of new weekly driver analysis available")
 			}
 		}
 	}
 
 	return nil
 }
+
+//formatMinutes formats minutes in hours and minutes, 4h30
+func formatMinutes(minutes float32) string {
+	total := int(minutes + 0.5)
+	return fmt.Sprintf("%dh%02d", total/60, total%60)
+}
@@ -0,0 +1,239 @@
+package analysis
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	//outputDirEnv is the environment variable containing the folder of the runs, the report folder of the program when empty
+	outputDirEnv = "REPORT_OUTPUT_DIR"
+	//keepRunsEnv is the environment variable containing the number of runs to keep, all when empty
+	keepRunsEnv = "REPORT_KEEP_RUNS"
+	//keepDaysEnv is the environment variable containing the number of days the runs are kept, forever when empty
+	keepDaysEnv = "REPORT_KEEP_DAYS"
+	//manifestName is the file listing what a run generated
+	manifestName = "manifest.json"
+)
+
+//statuses of a run
+const (
+	runRunning   = "running"
+	runCompleted = "completed"
+	runFailed    = "failed"
+)
+
+//kinds of the files generated by a run
+const (
+	artifactReport  = "report"
+	artifactMetrics = "metrics"
+	artifactPDF     = "pdf"
+)
+
+//reportRun is a generation of reports, writing its files in its own folder
+type reportRun struct {
+	dir      string
+	manifest runManifest
+}
+
+//runManifest lists what a run generated, saved in the manifest.json of its folder
+type runManifest struct {
+	RunID      string        `json:"run_id"`
+	Status     string        `json:"status"`
+	Error      string        `json:"error,omitempty"`
+	StartTime  string        `json:"start_time"` //first day of the reports
+	EndTime    string        `json:"end_time"`   //last day of the reports
+	CreatedAt  time.Time     `json:"created_at"`
+	FinishedAt *time.Time    `json:"finished_at,omitempty"`
+	Artifacts  []runArtifact `json:"artifacts"`
+}
+
+//runArtifact is a file generated by a run, its path is relative to the folder of the run
+type runArtifact struct {
+	Kind             string `json:"kind"`
+	Path             string `json:"path"`
+	DriverTransicsID string `json:"driver_transics_id,omitempty"`
+}
+
+//retention defines which finished runs are kept, a limit of 0 is disabled
+type retention struct {
+	//runs is the number of newest runs kept, the current one included
+	runs int
+	//days is the number of days a run is kept
+	days int
+}
+
+//outputRoot returns the folder of the runs, REPORT_OUTPUT_DIR or the report folder of the program
+func outputRoot(wd string) string {
+	if root := os.Getenv(outputDirEnv); root != "" {
+		return root
+	}
+	return path.Join(wd, reportFolderPath)
+}
+
+//retentionFromEnv reads the retention of the runs using .env
+func retentionFromEnv() retention {
+	var keep retention
+	if runs, err := strconv.Atoi(os.Getenv(keepRunsEnv)); err == nil && runs > 0 {
+		keep.runs = runs
+	}
+	if days, err := strconv.Atoi(os.Getenv(keepDaysEnv)); err == nil && days > 0 {
+		keep.days = days
+	}
+
+	return keep
+}
+
+//newRunID returns a unique id sorting in order of creation, 20200217-101500-a1b2c3
+func newRunID(now time.Time) (string, error) {
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", errors.Wrap(err, "Cannot generate a run id")
+	}
+
+	return now.Format("20060102-150405") + "-" + hex.EncodeToString(suffix), nil
+}
+
+//startRun creates the folder and the manifest of a new run of the reports of a period
+func startRun(root string, start, end time.Time) (*reportRun, error) {
+	now := time.Now()
+	id, err := newRunID(now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, errors.Wrap(err, "Cannot create the report folder")
+	}
+	//Mkdir fails when another run got the same id
+	dir := path.Join(root, id)
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return nil, errors.Wrap(err, "Cannot create the run folder")
+	}
+
+	run := &reportRun{
+		dir: dir,
+		manifest: runManifest{
+			RunID:     id,
+			Status:    runRunning,
+			StartTime: start.Format("2006-01-02"),
+			EndTime:   end.Format("2006-01-02"),
+			CreatedAt: now,
+			Artifacts: []runArtifact{},
+		},
+	}
+
+	return run, run.save()
+}
+
+//path returns the path of a file of the run
+func (r *reportRun) path(name string) string {
+	return path.Join(r.dir, name)
+}
+
+//add lists a file generated by the run in its manifest
+func (r *reportRun) add(kind, name, transicsID string) {
+	r.manifest.Artifacts = append(r.manifest.Artifacts, runArtifact{Kind: kind, Path: name, DriverTransicsID: transicsID})
+}
+
+//finish saves the manifest of the run with its final status
+func (r *reportRun) finish(runErr error) error {
+	now := time.Now()
+	r.manifest.FinishedAt = &now
+	r.manifest.Status = runCompleted
+	if runErr != nil {
+		r.manifest.Status = runFailed
+		r.manifest.Error = runErr.Error()
+	}
+
+	return r.save()
+}
+
+//save writes the manifest of the run, through a temporary file so that it is never read half written
+func (r *reportRun) save() error {
+	content, err := json.MarshalIndent(r.manifest, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "Cannot encode the run manifest")
+	}
+
+	temporary := r.path(manifestName + ".tmp")
+	if err := ioutil.WriteFile(temporary, content, 0644); err != nil {
+		return errors.Wrap(err, "Cannot write the run manifest")
+	}
+	if err := os.Rename(temporary, r.path(manifestName)); err != nil {
+		return errors.Wrap(err, "Cannot write the run manifest")
+	}
+
+	return nil
+}
+
+//readManifest reads the manifest of the run of a folder
+func readManifest(dir string) (runManifest, error) {
+	var manifest runManifest
+	content, err := ioutil.ReadFile(path.Join(dir, manifestName))
+	if err != nil {
+		return manifest, err
+	}
+
+	return manifest, json.Unmarshal(content, &manifest)
+}
+
+//cleanRuns removes the runs beyond the newest ones or older than the days to keep
+//the folders without manifest are not runs and are left as they are, the running runs are only removed once too old
+func cleanRuns(root, current string, keep retention) error {
+	if keep.runs == 0 && keep.days == 0 {
+		return nil
+	}
+
+	entries, err := ioutil.ReadDir(root)
+	if err != nil {
+		return errors.Wrap(err, "Cannot read the report folder")
+	}
+
+	var runs []runManifest
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == current {
+			continue
+		}
+		manifest, err := readManifest(path.Join(root, entry.Name()))
+		if err != nil || manifest.RunID != entry.Name() {
+			continue
+		}
+		runs = append(runs, manifest)
+	}
+	//newest first
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].CreatedAt.After(runs[j].CreatedAt)
+	})
+
+	oldest := time.Now().AddDate(0, 0, -keep.days)
+	var kept int
+	for _, run := range runs {
+		tooOld := keep.days > 0 && run.CreatedAt.Before(oldest)
+		if run.Status == runRunning && !tooOld {
+			continue
+		}
+		//the current run is the newest
+		kept++
+		if !tooOld && (keep.runs == 0 || kept < keep.runs) {
+			continue
+		}
+
+		if err := os.RemoveAll(path.Join(root, run.RunID)); err != nil {
+			return errors.Wrap(err, "Cannot remove an old run")
+		}
+		log.Printf("Report run %s removed\n", run.RunID)
+	}
+
+	return nil
+}
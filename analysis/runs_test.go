@@ -0,0 +1,91 @@
+package analysis
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestCleanRuns(t *testing.T) {
+	now := time.Now()
+	//the runs of the report folder, the current one is not listed, its folder exists as the others
+	runs := []struct {
+		id      string
+		status  string
+		created time.Time
+	}{
+		{"run-1", runCompleted, now.Add(-1 * time.Hour)},
+		{"run-2", runFailed, now.AddDate(0, 0, -2)},
+		{"run-3", runRunning, now.AddDate(0, 0, -3)},
+		{"run-4", runCompleted, now.AddDate(0, 0, -10)},
+		{"run-5", runRunning, now.AddDate(0, 0, -20)},
+	}
+
+	tests := []struct {
+		name string
+		keep retention
+		want []string
+	}{
+		{"no retention", retention{}, []string{"current", "not-a-run", "run-1", "run-2", "run-3", "run-4", "run-5"}},
+		{"newest runs", retention{runs: 3}, []string{"current", "not-a-run", "run-1", "run-2", "run-3", "run-5"}},
+		{"current run only", retention{runs: 1}, []string{"current", "not-a-run", "run-3", "run-5"}},
+		{"days", retention{days: 7}, []string{"current", "not-a-run", "run-1", "run-2", "run-3"}},
+		{"runs and days", retention{runs: 2, days: 7}, []string{"current", "not-a-run", "run-1", "run-3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := ioutil.TempDir("", "tx2db-runs-")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(root)
+
+			for _, dir := range []string{"current", "not-a-run"} {
+				if err := os.Mkdir(path.Join(root, dir), 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, run := range runs {
+				writeTestManifest(t, root, runManifest{RunID: run.id, Status: run.status, CreatedAt: run.created})
+			}
+
+			if err := cleanRuns(root, "current", tt.keep); err != nil {
+				t.Fatal(err)
+			}
+
+			entries, err := ioutil.ReadDir(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, entry := range entries {
+				got = append(got, entry.Name())
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+//writeTestManifest creates the folder and the manifest of a run
+func writeTestManifest(t *testing.T, root string, manifest runManifest) {
+	t.Helper()
+
+	if err := os.Mkdir(path.Join(root, manifest.RunID), 0755); err != nil {
+		t.Fatal(err)
+	}
+	content, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(root, manifest.RunID, manifestName), content, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,118 @@
+package analysis
+
+import (
+	"math"
+	"time"
+	"tx2db/locale"
+)
+
+//trendWeeks is the number of weeks before a report averaged to show the trend of a driver
+const trendWeeks = 8
+
+//metricComparison contains what the metrics of a report are compared to
+type metricComparison struct {
+	//previous contains the metrics of the period before the report
+	previous driverMetrics
+	//averages contains the metrics averaged over the periods of the 8 weeks before the report
+	averages driverMetrics
+	//groups contains the truck group of the drivers
+	groups map[string]string
+}
+
+//computeComparison computes the metrics of the drivers before a report, the truck groups are the ones of the fleet
+//the 8 weeks before the report are split in periods of the length of the report, at least one
+func computeComparison(drivers []string, groups map[string]string, start, end time.Time) (*metricComparison, error) {
+	days := int(end.Sub(start).Hours()/24+0.5) + 1
+	periods := trendWeeks * 7 / days
+	if periods < 1 {
+		periods = 1
+	}
+
+	//the periods are computed at once, the previous one first
+	var previous []Period
+	for i := 1; i <= periods; i++ {
+		previous = append(previous, Period{Start: start.AddDate(0, 0, -i*days), End: end.AddDate(0, 0, -i*days)})
+	}
+	computed, err := computeMetrics(drivers, previous)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := &metricComparison{previous: computed[0], averages: make(driverMetrics, len(drivers)), groups: groups}
+	counts := make(map[string]map[string]int, len(drivers))
+	for _, values := range computed {
+		for driver, metrics := range values {
+			if _, ok := comparison.averages[driver]; !ok {
+				comparison.averages[driver] = make(map[string]float64, len(metrics))
+				counts[driver] = make(map[string]int, len(metrics))
+			}
+			for name, value := range metrics {
+				comparison.averages[driver][name] += value
+				counts[driver][name]++
+			}
+		}
+	}
+	//only the periods with a value count in the average
+	for driver, metrics := range comparison.averages {
+		for name := range metrics {
+			metrics[name] /= float64(counts[driver][name])
+		}
+	}
+
+	return comparison, nil
+}
+
+//compare fills the trend and the ranking of the value of a metric of a driver, formatted in its language
+//a neutral metric only gets its trend
+func (c *metricComparison) compare(value *MetricValue, m Metric, loc *locale.Locale, current driverMetrics, transicsID string) {
+	if previous, ok := c.previous[transicsID][value.Name]; ok {
+		value.Previous = m.Format(previous, loc)
+		value.Delta = formatDelta(m, loc, value.Raw-previous)
+		value.Improved = !value.Neutral && value.Raw != previous && (value.Raw > previous) == value.HigherIsBetter
+	}
+	if average, ok := c.averages[transicsID][value.Name]; ok {
+		value.Average = m.Format(average, loc)
+		value.AverageDelta = formatDelta(m, loc, value.Raw-average)
+	}
+	if value.Neutral {
+		return
+	}
+
+	//rank among the drivers with a value, within the truck group and the fleet
+	var fleet, group []float64
+	for driver, metrics := range current {
+		other, ok := metrics[value.Name]
+		if !ok {
+			continue
+		}
+		fleet = append(fleet, other)
+		if c.groups[transicsID] != "" && c.groups[driver] == c.groups[transicsID] {
+			group = append(group, other)
+		}
+	}
+	value.FleetTop = topPercent(value.Raw, fleet, value.HigherIsBetter)
+	if len(group) > 0 {
+		value.GroupTop = topPercent(value.Raw, group, value.HigherIsBetter)
+	}
+}
+
+//topPercent returns the share of the drivers, in percent, doing better or as well as a value
+//the best driver of ten is in the top 10%
+func topPercent(value float64, values []float64, higherIsBetter bool) int {
+	var better int
+	for _, other := range values {
+		if (higherIsBetter && other > value) || (!higherIsBetter && other < value) {
+			better++
+		}
+	}
+
+	return int(math.Round(float64(better+1) * 100 / float64(len(values))))
+}
+
+//formatDelta formats the change of a metric with its sign, +5.0%
+func formatDelta(m Metric, loc *locale.Locale, delta float64) string {
+	if delta < 0 {
+		return m.Format(delta, loc)
+	}
+	return "+" + m.Format(delta, loc)
+}
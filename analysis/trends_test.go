@@ -0,0 +1,94 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+)
+
+//createTestTour creates a tour of a day of driver 1 with an eco monitor report
+func createTestTour(t *testing.T, day time.Time, distance, cruiseControl float32) {
+	t.Helper()
+
+	tour := database.Tour{DriverTransicsID: 1, TruckTransicsID: 100, StartTime: day.Add(6 * time.Hour), EndTime: day.Add(18 * time.Hour)}
+	if err := database.DB.Create(&tour).Error; err != nil {
+		t.Fatal(err)
+	}
+	report := database.DriverEcoMonitorReport{TourID: tour.ID, DriverTransicsID: 1, Distance: distance, DistanceOnCruiseControl: cruiseControl}
+	if err := database.DB.Create(&report).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestComputeComparison(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	//a report of a week, compared to the 8 weeks before
+	start := time.Date(2020, 2, 10, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 6)
+	//the week of the report is not compared
+	createTestTour(t, start, 500, 500)
+	//the previous week
+	createTestTour(t, start.AddDate(0, 0, -7), 100, 50)
+	createTestTour(t, start.AddDate(0, 0, -3), 100, 30)
+	//three weeks before
+	createTestTour(t, start.AddDate(0, 0, -21), 100, 10)
+	//nine weeks before, out of the average
+	createTestTour(t, start.AddDate(0, 0, -63), 100, 100)
+
+	comparison, err := computeComparison([]string{"1"}, nil, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		metrics driverMetrics
+		metric  string
+		want    float64
+	}{
+		{"previous driven km", comparison.previous, "driven_km", 200},
+		{"previous cruise control", comparison.previous, "cruise_control", 0.4},
+		{"average driven km", comparison.averages, "driven_km", 150},
+		{"average cruise control", comparison.averages, "cruise_control", 0.25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := tt.metrics["1"][tt.metric]; !ok || got != tt.want {
+				t.Errorf("got %v (%v), want %v", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodContains(t *testing.T) {
+	p := Period{Start: time.Date(2020, 2, 10, 0, 0, 0, 0, time.UTC), End: time.Date(2020, 2, 16, 0, 0, 0, 0, time.UTC)}
+	at := func(day, hour int) time.Time {
+		return time.Date(2020, 2, day, hour, 0, 0, 0, time.UTC)
+	}
+	ended := func(day, hour int) *time.Time {
+		end := at(day, hour)
+		return &end
+	}
+
+	tests := []struct {
+		name  string
+		start time.Time
+		end   *time.Time
+		want  bool
+	}{
+		{"first day", at(10, 0), ended(10, 8), true},
+		{"started before", at(9, 22), ended(10, 6), false},
+		{"ending on the last day", at(16, 6), ended(16, 14), true},
+		{"ending after", at(16, 20), ended(17, 0), false},
+		{"not ended", at(16, 20), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.contains(tt.start, tt.end); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+	"tx2db/compliance"
+	"tx2db/database"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	//complianceFrom is the first day to check
+	complianceFrom string
+	//complianceTo is the last day to check
+	complianceTo string
+)
+
+var complianceCmd = &cobra.Command{
+	Use:   "compliance",
+	Short: "Check the driving and rest times of the drivers (EU 561/2006)",
+}
+
+var complianceCheckCmd = &cobra.Command{
+	Use: "check",
+	Example: `
+	tx2db compliance check --from 2020-02-01
+	tx2db compliance check --from 2020-02-01 --to 2020-02-29`,
+	Short: "Check the imported tachograph activities and store the violations",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		//parse range, the last day is included
+		from, err := time.Parse("2006-01-02", complianceFrom)
+		if err != nil {
+			return errors.Wrap(err, "Wrong date format, should be in the format 2020-02-10")
+		}
+		to := time.Now()
+		if complianceTo != "" {
+			to, err = time.Parse("2006-01-02", complianceTo)
+			if err != nil {
+				return errors.Wrap(err, "Wrong date format, should be in the format 2020-02-10")
+			}
+			to = to.AddDate(0, 0, 1)
+		}
+
+		log.Print("Connecting to database...")
+		//connect to database
+		err = database.InitDB()
+		if err != nil {
+			return err
+		}
+		defer database.DB.Close()
+
+		result, err := compliance.Check(from, to, compliance.EULimits)
+		if err != nil {
+			return err
+		}
+		if err := compliance.Store(from, to, result); err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "DRIVER\tRULE\tSTART\tEND\tDETAILS")
+		for _, violation := range result.Violations {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", violation.DriverTransicsID, violation.Rule,
+				violation.StartTime.Format("2006-01-02 15:04"), violation.EndTime.Format("2006-01-02 15:04"), violation.Details)
+		}
+		w.Flush()
+		fmt.Println(result)
+
+		return nil
+	},
+}
+
+func init() {
+	//--from flag, required
+	complianceCheckCmd.Flags().StringVar(&complianceFrom, "from", "", "First day to check")
+	complianceCheckCmd.MarkFlagRequired("from")
+	//--to flag, default today
+	complianceCheckCmd.Flags().StringVar(&complianceTo, "to", "", "Last day to check (default today)")
+	complianceCmd.AddCommand(complianceCheckCmd)
+	rootCmd.AddCommand(complianceCmd)
+}
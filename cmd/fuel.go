@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+	"tx2db/database"
+	"tx2db/fuel"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	//fuelFrom is the first day to check
+	fuelFrom string
+	//fuelTo is the last day to check
+	fuelTo string
+	//fuelSkipSendMail permits to do not send the digest of the anomalies
+	fuelSkipSendMail bool
+	//fuelOptions defines what is considered as an anomaly
+	fuelOptions = fuel.DefaultOptions
+)
+
+var fuelCmd = &cobra.Command{
+	Use:   "fuel",
+	Short: "Detect fuel anomalies of the trucks",
+}
+
+var fuelCheckCmd = &cobra.Command{
+	Use: "check",
+	Example: `
+	tx2db fuel check
+	tx2db fuel check --from 2020-02-01 --to 2020-02-29 --skipSendMail`,
+	Short: "Compare the fuel level of the vehicle snapshots with the activities and mail the anomalies found",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		//parse range, the last day is included, default yesterday
+		var err error
+		from := time.Now().AddDate(0, 0, -1)
+		from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+		if fuelFrom != "" {
+			from, err = time.Parse("2006-01-02", fuelFrom)
+			if err != nil {
+				return errors.Wrap(err, "Wrong date format, should be in the format 2020-02-10")
+			}
+		}
+		to := from
+		if fuelTo != "" {
+			to, err = time.Parse("2006-01-02", fuelTo)
+			if err != nil {
+				return errors.Wrap(err, "Wrong date format, should be in the format 2020-02-10")
+			}
+		}
+		to = to.AddDate(0, 0, 1)
+
+		log.Print("Connecting to database...")
+		//connect to database
+		err = database.InitDB()
+		if err != nil {
+			return err
+		}
+		defer database.DB.Close()
+
+		result, err := fuel.Detect(from, to, fuelOptions)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "TRUCK\tDRIVER\tKIND\tSTART\tEND\tDETAILS")
+		for _, finding := range result.Findings {
+			fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\t%s\n", finding.TruckTransicsID, finding.DriverTransicsID, finding.Kind,
+				finding.StartTime.Format("2006-01-02 15:04"), finding.EndTime.Format("2006-01-02 15:04"), finding.Details)
+		}
+		w.Flush()
+		fmt.Println(result)
+
+		//send the digest to SYSTEM_ADMINISTATOR_EMAIL
+		if !fuelSkipSendMail {
+			if err := fuel.SendDigest(result.Findings, from, to); err != nil {
+				return errors.Wrap(err, "System Administrator not informed of the fuel anomalies")
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	//--from flag, default yesterday
+	fuelCheckCmd.Flags().StringVar(&fuelFrom, "from", "", "First day to check (default yesterday)")
+	//--to flag, default the first day
+	fuelCheckCmd.Flags().StringVar(&fuelTo, "to", "", "Last day to check (default the first day)")
+	//--skipSendMail flag
+	fuelCheckCmd.Flags().BoolVar(&fuelSkipSendMail, "skipSendMail", false, "Don't send the digest of the anomalies")
+	//--tankCapacity flag, converts the fuel level to liters
+	fuelCheckCmd.Flags().Float64Var(&fuelOptions.TankCapacity, "tankCapacity", fuelOptions.TankCapacity, "Tank capacity in liters, 0 when the fuel level is in liters")
+	//--minLoss flag
+	fuelCheckCmd.Flags().Float64Var(&fuelOptions.MinLoss, "minLoss", fuelOptions.MinLoss, "Minimum unexplained fuel loss in liters")
+	//--minRefuelling flag
+	fuelCheckCmd.Flags().Float64Var(&fuelOptions.MinRefuelling, "minRefuelling", fuelOptions.MinRefuelling, "Minimum fuel increase in liters considered as a refuelling")
+	//--refuelActivities flag
+	fuelCheckCmd.Flags().StringSliceVar(&fuelOptions.RefuelActivities, "refuelActivities", fuelOptions.RefuelActivities, "Activity names considered as refuelling")
+	fuelCmd.AddCommand(fuelCheckCmd)
+	rootCmd.AddCommand(fuelCmd)
+}
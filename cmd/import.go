@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"fmt"
 	"log"
 	"tx2db/database"
+	"tx2db/txtango"
 
 	"github.com/spf13/cobra"
 )
@@ -14,6 +16,8 @@ var (
 	importFromQueueOnly bool
 	//cleanTourQueue will delete the entiere queue
 	cleanTourQueue bool
+	//importWorkers is the number of tours imported at the same time
+	importWorkers int
 )
 
 var importCmd = &cobra.Command{
@@ -21,6 +25,15 @@ var importCmd = &cobra.Command{
 	Short: "fetch data from Transics and import it into a database",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var err error
+		//stop the import properly on SIGINT/SIGTERM
+		ctx, cancel := signalContext()
+		defer cancel()
+
+		//create TX-TANGO client
+		txClient, err := txtango.NewClient(txtango.ConfigFromEnv())
+		if err != nil {
+			return err
+		}
 
 		log.Print("Connecting to database...")
 		//connect to database
@@ -32,40 +45,26 @@ var importCmd = &cobra.Command{
 
 		//cleanTourQueue if requested
 		if cleanTourQueue {
-			database.DB.Unscoped().Delete(&[]database.TourQueue{})
+			if _, err := database.PurgeQueueItems(nil, ""); err != nil {
+				return err
+			}
 			log.Print("Sucessfully cleaned tour queue")
 		}
 
-		wg.Add(1)
-		go func() {
-			//import drivers concurrently
-			err = database.ImportDrivers(&wg)
-		}()
-
-		wg.Add(1)
-		go func() {
-			//import trucks concurrently and create tours
-			err = database.ImportTrucks(&wg)
-		}()
+		//run import
+		importer := database.NewImporter(txClient, importWorkers)
+		importer.IgnoreLastImport = ignoreLastImport
+		importer.QueueOnly = importFromQueueOnly
+		report := importer.Run(ctx)
 
-		//handle only one error
-		if err != nil {
-			return err
-		}
-		wg.Wait()
+		//print how long we have been waiting for Transics
+		stats := txClient.Stats()
+		log.Printf("%d calls made to Transics (%d throttled), waited %s in total (max %s)\n", stats.Calls, stats.Throttled, stats.Waited, stats.MaxWait)
 
-		if importFromQueueOnly {
-			//import tours data from queue
-			err = database.ImportQueuedToursData(true)
-		} else {
-			//import tours data
-			err = database.ImportToursData(ignoreLastImport)
-		}
-		if err != nil {
-			return err
-		}
+		//print import report, a failed phase makes the command fail
+		fmt.Printf("Import report:\n%s\n", report)
 
-		return nil
+		return report.Err()
 	},
 }
 
@@ -76,5 +75,7 @@ func init() {
 	importCmd.PersistentFlags().BoolVar(&importFromQueueOnly, "importFromQueueOnly", false, "Import only missing data from the queue")
 	//--cleanTourQueue
 	importCmd.PersistentFlags().BoolVar(&cleanTourQueue, "cleanTourQueue", false, "Empty the tour queue")
+	//--workers flag, number of tours or drivers imported concurrently
+	importCmd.PersistentFlags().IntVar(&importWorkers, "workers", 4, "Number of tours or drivers imported concurrently (sharing the Transics rate limit)")
 	rootCmd.AddCommand(importCmd)
 }
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"tx2db/database"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	//migrateTo is the version to migrate up to
+	migrateTo int
+	//migrateSteps is the number of migrations to revert
+	migrateSteps int
+)
+
+var migrateCmd = &cobra.Command{
+	Use: "migrate",
+	Example: `
+	tx2db migrate up
+	tx2db migrate down --steps 2
+	tx2db migrate status`,
+	Short: "Manage the database schema",
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply the pending migrations",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMigrate(func() ([]database.Migration, error) {
+			return database.MigrateUp(migrateTo)
+		})
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Revert the latest migrations",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMigrate(func() ([]database.Migration, error) {
+			return database.MigrateDown(migrateSteps)
+		})
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Print the applied and pending migrations",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		err := database.ConnectDB()
+		if err != nil {
+			return err
+		}
+		defer database.DB.Close()
+
+		states, err := database.MigrationStatus()
+		if err != nil {
+			return err
+		}
+
+		for _, state := range states {
+			status := "pending"
+			if state.AppliedAt != nil {
+				status = "applied " + state.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%4d  %-30s %s\n", state.Version, state.Name, status)
+		}
+
+		return nil
+	},
+}
+
+//runMigrate connects to the database and runs a migration command
+func runMigrate(migrate func() ([]database.Migration, error)) error {
+	log.Print("Connecting to database...")
+	err := database.ConnectDB()
+	if err != nil {
+		return err
+	}
+	defer database.DB.Close()
+
+	done, err := migrate()
+	for _, migration := range done {
+		fmt.Printf("%4d  %s\n", migration.Version, migration.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(done) == 0 {
+		fmt.Println("Nothing to migrate")
+	}
+
+	return nil
+}
+
+func init() {
+	//--to flag, default to the latest version
+	migrateUpCmd.Flags().IntVar(&migrateTo, "to", 0, "Migrate up to a specific version (default latest)")
+	//--steps flag, default to the latest migration only
+	migrateDownCmd.Flags().IntVar(&migrateSteps, "steps", 1, "Number of migrations to revert")
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd)
+	rootCmd.AddCommand(migrateCmd)
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"tx2db/database"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	//queueStatus selects the elements of the queue by status
+	queueStatus string
+	//queueAll selects every element of the queue
+	queueAll bool
+)
+
+var queueCmd = &cobra.Command{
+	Use: "queue",
+	Example: `
+	tx2db queue list --status dead
+	tx2db queue retry --status dead
+	tx2db queue purge 12 13`,
+	Short: "Inspect and manage the tour queue",
+}
+
+var queueListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the elements of the queue",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := database.InitDB(); err != nil {
+			return err
+		}
+		defer database.DB.Close()
+
+		queue, err := database.QueueItems(queueStatus)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tTOUR\tDRIVER\tREPORT\tDAY\tSTATUS\tTRIALS\tNEXT ATTEMPT\tREASON")
+		for _, data := range queue {
+			fmt.Fprintf(w, "%d\t%d\t%d\t%s\t%s\t%s\t%d\t%s\t%s %s\n", data.ID, data.TourID, data.DriverTransicsID, data.ReportType, data.ImportOn.Format("2006-01-02"),
+				data.Status, data.Trial, data.NextAttemptAt.Format("2006-01-02 15:04"), data.ReasonCode, data.Reason)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+var queueRetryCmd = &cobra.Command{
+	Use:   "retry [id...]",
+	Short: "Retry elements of the queue at the next import",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runQueueCommand(args, "Rescheduled", database.RetryQueueItems)
+	},
+}
+
+var queuePurgeCmd = &cobra.Command{
+	Use:   "purge [id...]",
+	Short: "Permanently remove elements of the queue",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runQueueCommand(args, "Purged", database.PurgeQueueItems)
+	},
+}
+
+//runQueueCommand applies a queue command on the elements selected by id, --status or --all
+func runQueueCommand(args []string, done string, run func(ids []uint, status string) (int64, error)) error {
+	var ids []uint
+	for _, arg := range args {
+		id, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return errors.Errorf("Invalid queue element id %q", arg)
+		}
+		ids = append(ids, uint(id))
+	}
+
+	//never select the whole queue by mistake
+	if len(ids) == 0 && queueStatus == "" && !queueAll {
+		return errors.New("Select the elements by id, --status or --all")
+	}
+
+	if err := database.InitDB(); err != nil {
+		return err
+	}
+	defer database.DB.Close()
+
+	count, err := run(ids, queueStatus)
+	if err != nil {
+		return err
+	}
+	log.Printf("%s %d elements of the queue\n", done, count)
+
+	return nil
+}
+
+func init() {
+	//--status flag
+	queueCmd.PersistentFlags().StringVar(&queueStatus, "status", "", "Select the elements by status (pending or dead)")
+	//--all flag
+	queueRetryCmd.Flags().BoolVar(&queueAll, "all", false, "Select every element of the queue")
+	queuePurgeCmd.Flags().BoolVar(&queueAll, "all", false, "Select every element of the queue")
+	queueCmd.AddCommand(queueListCmd, queueRetryCmd, queuePurgeCmd)
+	rootCmd.AddCommand(queueCmd)
+}
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"log"
+	"net"
+	"strconv"
 	"time"
 	"tx2db/analysis"
 	"tx2db/database"
@@ -21,33 +23,60 @@ var (
 	startTime string
 	//reportRange defines the number of days a report contains
 	reportRange int
+	//withCompliance adds the driving and rest times to the reports
+	withCompliance bool
+	//legacyRendering renders the reports with R and phantomjs
+	legacyRendering bool
+	//reportDrivers are the TransicsID or PersonID of the drivers to build a report for
+	reportDrivers []string
+	//reportGroup is the truck group of the drivers to build a report for
+	reportGroup string
+	//dryRun renders the reports in a temporary folder without sending them
+	dryRun bool
+	//previewHost is the address the preview listens on
+	previewHost string
+	//previewPort is the port the preview listens on
+	previewPort int
 )
 
+//reportPeriod returns the first and last day of the report, by default from monday a report range back
+func reportPeriod() (time.Time, time.Time, error) {
+	var err error
+	var reportTime time.Time
+
+	//get report date
+	if startTime == "" {
+		//get report from the report range back (default a week)
+		reportTime = time.Now().AddDate(0, 0, -reportRange)
+
+		// iterate back to Monday
+		for reportTime.Weekday() != time.Monday {
+			reportTime = reportTime.AddDate(0, 0, -1)
+		}
+	} else {
+		//parse begin and end date into time.Time
+		reportTime, err = time.Parse("2006-01-02", startTime)
+		if err != nil {
+			return reportTime, reportTime, errors.Wrap(err, "Wrong date format, should be in the format 2020-02-10")
+		}
+	}
+
+	return reportTime, reportTime.AddDate(0, 0, reportRange-1), nil
+}
+
 var genReportCmd = &cobra.Command{
 	Use: "gen-report",
 	Example: `
 	tx2db gen-report
-	tx2db gen-report --startTime 2020-02-20`,
+	tx2db gen-report --startTime 2020-02-20
+	tx2db gen-report --driver 1234 --driver 5678 --skipSendMail
+	tx2db gen-report --group North
+	tx2db gen-report --dry-run`,
 	Short: "Generate driver reports aimed at drivers only",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var err error
-		var reportTime time.Time
-
-		//get report date
-		if startTime == "" {
-			//get report from the report range back (default a week)
-			reportTime = time.Now().AddDate(0, 0, -reportRange)
-
-			// iterate back to Monday
-			for reportTime.Weekday() != time.Monday {
-				reportTime = reportTime.AddDate(0, 0, -1)
-			}
-		} else {
-			//parse begin and end date into time.Time
-			reportTime, err = time.Parse("2006-01-02", startTime)
-			if err != nil {
-				return errors.Wrap(err, "Wrong date format, should be in the format 2020-02-10")
-			}
+		start, end, err := reportPeriod()
+		if err != nil {
+			return err
 		}
 
 		log.Print("Connecting to database...")
@@ -58,7 +87,16 @@ var genReportCmd = &cobra.Command{
 		}
 		defer database.DB.Close()
 
-		err = analysis.BuildDriverReport(skipSendMail, skipSendDriverMail, skipUploadToFtp, reportTime, reportTime.AddDate(0, 0, reportRange-1))
+		opts := analysis.ReportOptions{
+			SkipSendMail:       skipSendMail,
+			SkipSendDriverMail: skipSendDriverMail,
+			SkipUploadToFtp:    skipUploadToFtp,
+			WithCompliance:     withCompliance,
+			LegacyRendering:    legacyRendering,
+			DryRun:             dryRun,
+			Filter:             analysis.ReportFilter{Drivers: reportDrivers, Group: reportGroup},
+		}
+		err = analysis.BuildDriverReport(opts, start, end)
 		if err != nil {
 			return err
 		}
@@ -67,6 +105,39 @@ var genReportCmd = &cobra.Command{
 	},
 }
 
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Review the driver reports",
+}
+
+var reportPreviewCmd = &cobra.Command{
+	Use: "preview",
+	Example: `
+	tx2db report preview
+	tx2db report preview --port 8080 --startTime 2020-02-10 --driver 1234`,
+	Short: "Serve the html driver reports in a browser, reloaded when the templates change",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		start, end, err := reportPeriod()
+		if err != nil {
+			return err
+		}
+
+		log.Print("Connecting to database...")
+		//connect to database
+		err = database.InitDB()
+		if err != nil {
+			return err
+		}
+		defer database.DB.Close()
+
+		opts := analysis.ReportOptions{
+			WithCompliance: withCompliance,
+			Filter:         analysis.ReportFilter{Drivers: reportDrivers, Group: reportGroup},
+		}
+		return analysis.ServePreview(net.JoinHostPort(previewHost, strconv.Itoa(previewPort)), opts, start, end)
+	},
+}
+
 func init() {
 	//--skipSendMail flag
 	genReportCmd.PersistentFlags().BoolVar(&skipSendMail, "skipSendMail", false, "Don't send mail alert for reports")
@@ -78,5 +149,27 @@ func init() {
 	genReportCmd.PersistentFlags().StringVar(&startTime, "startTime", "", "Define the start time of a report (default monday, a week ago)")
 	//--reportRange flag, default to 7 days
 	genReportCmd.PersistentFlags().IntVar(&reportRange, "reportRange", 7, "Define a report range")
+	//--compliance flag, check the driving and rest times of the period
+	genReportCmd.PersistentFlags().BoolVar(&withCompliance, "compliance", false, "Add the driving and rest times violations (EU 561/2006) to the reports")
+	//--legacy flag, render the reports from the html templates with R and phantomjs
+	genReportCmd.PersistentFlags().BoolVar(&legacyRendering, "legacy", false, "Render the reports with R and phantomjs instead of natively")
+	//--driver flag, repeatable, build the reports of some drivers only
+	genReportCmd.PersistentFlags().StringArrayVar(&reportDrivers, "driver", nil, "Build the report of a driver only, by TransicsID or PersonID (repeatable)")
+	//--group flag, build the reports of the drivers of a truck group only
+	genReportCmd.PersistentFlags().StringVar(&reportGroup, "group", "", "Build the reports of the drivers of a truck group only")
+	//--dry-run flag, render the reports without storing, mailing or uploading anything
+	genReportCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Render the reports in a temporary folder without storing the eco scores and violations, mailing or uploading to FTP")
 	rootCmd.AddCommand(genReportCmd)
+
+	//the period and the drivers of the preview are selected as for gen-report
+	reportPreviewCmd.Flags().StringVar(&startTime, "startTime", "", "Define the start time of a report (default monday, a week ago)")
+	reportPreviewCmd.Flags().IntVar(&reportRange, "reportRange", 7, "Define a report range")
+	reportPreviewCmd.Flags().BoolVar(&withCompliance, "compliance", false, "Add the driving and rest times violations (EU 561/2006) to the reports")
+	reportPreviewCmd.Flags().StringArrayVar(&reportDrivers, "driver", nil, "Preview the report of a driver only, by TransicsID or PersonID (repeatable)")
+	reportPreviewCmd.Flags().StringVar(&reportGroup, "group", "", "Preview the reports of the drivers of a truck group only")
+	//--host and --port flags, where the preview listens
+	reportPreviewCmd.Flags().StringVar(&previewHost, "host", "localhost", "Address the preview listens on")
+	reportPreviewCmd.Flags().IntVar(&previewPort, "port", 8080, "Port the preview listens on")
+	reportCmd.AddCommand(reportPreviewCmd)
+	rootCmd.AddCommand(reportCmd)
 }
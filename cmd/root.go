@@ -1,24 +1,40 @@
 package cmd
 
 import (
+	"context"
 	"log"
 	"os"
-	"sync"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 )
 
-var (
-	//WaitGroup used to wait for all the goroutines launched here to finish
-	wg sync.WaitGroup
-)
-
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "tx2db",
 	Short: "Import Transics data in a database",
 }
 
+//signalContext returns a context cancelled on SIGINT or SIGTERM
+func signalContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case sig := <-signals:
+			log.Printf("Received %s, stopping...\n", sig)
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(signals)
+	}()
+
+	return ctx, cancel
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+	"tx2db/database"
+	"tx2db/score"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	//scoreFrom is the first day to score
+	scoreFrom string
+	//scoreTo is the last day to score
+	scoreTo string
+	//scoreConfig is the path of the JSON configuration of the eco score
+	scoreConfig string
+	//scoreDriver is the TransicsID of the driver of which the scores are shown
+	scoreDriver uint
+	//scoreLimit is the number of periods shown
+	scoreLimit int
+)
+
+var scoreCmd = &cobra.Command{
+	Use:   "score",
+	Short: "Rate the eco-driving of the drivers",
+}
+
+var scoreComputeCmd = &cobra.Command{
+	Use: "compute",
+	Example: `
+	tx2db score compute --from 2020-02-01 --to 2020-02-29
+	tx2db score compute --from 2020-02-01 --to 2020-02-29 --config eco_score.json`,
+	Short: "Score the drivers over a period and store their scores",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		//parse range, the last day is included
+		from, err := time.Parse("2006-01-02", scoreFrom)
+		if err != nil {
+			return errors.Wrap(err, "Wrong date format, should be in the format 2020-02-10")
+		}
+		to, err := time.Parse("2006-01-02", scoreTo)
+		if err != nil {
+			return errors.Wrap(err, "Wrong date format, should be in the format 2020-02-10")
+		}
+
+		//the flag overrides the environment
+		configPath := scoreConfig
+		if configPath == "" {
+			configPath = os.Getenv(score.ConfigEnv)
+		}
+		config, err := score.LoadConfig(configPath)
+		if err != nil {
+			return err
+		}
+
+		log.Print("Connecting to database...")
+		//connect to database
+		err = database.InitDB()
+		if err != nil {
+			return err
+		}
+		defer database.DB.Close()
+
+		scores, err := score.Compute(from, to, config)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "DRIVER\tKM\tCOASTING\tCRUISE\tIDLING\tOVERSPEED\tHARSH ACC\tPANIC\tHIGH RPM\tGREEN\tTOTAL")
+		for _, s := range scores {
+			fmt.Fprintf(w, "%d\t%.0f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\n", s.DriverTransicsID, s.Distance,
+				s.Coasting, s.CruiseControl, s.Idling, s.OverSpeeding, s.HarshAccelerations, s.PanicBrakes, s.HighRPM, s.GreenSpot, s.Total)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+var scoreHistoryCmd = &cobra.Command{
+	Use: "history",
+	Example: `
+	tx2db score history --driver 1234
+	tx2db score history --driver 1234 --limit 20`,
+	Short: "Show the last scores of a driver",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log.Print("Connecting to database...")
+		//connect to database
+		err := database.InitDB()
+		if err != nil {
+			return err
+		}
+		defer database.DB.Close()
+
+		scores, err := database.DriverScores(scoreDriver, scoreLimit)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "FROM\tTO\tKM\tTOTAL")
+		for _, s := range scores {
+			fmt.Fprintf(w, "%s\t%s\t%.0f\t%.1f\n", s.PeriodStart.Format("2006-01-02"), s.PeriodEnd.Format("2006-01-02"), s.Distance, s.Total)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+func init() {
+	//--from and --to flags, required
+	scoreComputeCmd.Flags().StringVar(&scoreFrom, "from", "", "First day to score")
+	scoreComputeCmd.MarkFlagRequired("from")
+	scoreComputeCmd.Flags().StringVar(&scoreTo, "to", "", "Last day to score")
+	scoreComputeCmd.MarkFlagRequired("to")
+	//--config flag, default ECO_SCORE_CONFIG or the built-in configuration
+	scoreComputeCmd.Flags().StringVar(&scoreConfig, "config", "", "Path of the JSON configuration of the eco score (default $ECO_SCORE_CONFIG)")
+	scoreCmd.AddCommand(scoreComputeCmd)
+
+	//--driver flag, required
+	scoreHistoryCmd.Flags().UintVar(&scoreDriver, "driver", 0, "TransicsID of the driver")
+	scoreHistoryCmd.MarkFlagRequired("driver")
+	//--limit flag, default 12 periods
+	scoreHistoryCmd.Flags().IntVar(&scoreLimit, "limit", 12, "Number of periods shown")
+	scoreCmd.AddCommand(scoreHistoryCmd)
+
+	rootCmd.AddCommand(scoreCmd)
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"time"
+	"tx2db/database"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	//rebuildFrom is the first day of the tours to rebuild
+	rebuildFrom string
+	//rebuildTo is the last day of the tours to rebuild
+	rebuildTo string
+	//segmentOptions defines what ends a tour
+	segmentOptions = database.DefaultSegmentOptions
+)
+
+var toursCmd = &cobra.Command{
+	Use:   "tours",
+	Short: "Manage the tours of the trucks",
+}
+
+var toursRebuildCmd = &cobra.Command{
+	Use: "rebuild",
+	Example: `
+	tx2db tours rebuild --from 2020-02-01
+	tx2db tours rebuild --from 2020-02-01 --to 2020-02-29 --longRest 11h`,
+	Short: "Rebuild the tours from the imported activity and eco monitor reports",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		//parse range, the last day is included
+		from, err := time.Parse("2006-01-02", rebuildFrom)
+		if err != nil {
+			return errors.Wrap(err, "Wrong date format, should be in the format 2020-02-10")
+		}
+		to := time.Now()
+		if rebuildTo != "" {
+			to, err = time.Parse("2006-01-02", rebuildTo)
+			if err != nil {
+				return errors.Wrap(err, "Wrong date format, should be in the format 2020-02-10")
+			}
+			to = to.AddDate(0, 0, 1)
+		}
+
+		log.Print("Connecting to database...")
+		//connect to database
+		err = database.InitDB()
+		if err != nil {
+			return err
+		}
+		defer database.DB.Close()
+
+		result, err := database.RebuildTours(from, to, segmentOptions)
+		if err != nil {
+			return err
+		}
+		fmt.Println(result)
+
+		return nil
+	},
+}
+
+func init() {
+	//--from flag, required
+	toursRebuildCmd.Flags().StringVar(&rebuildFrom, "from", "", "First day of the tours to rebuild")
+	toursRebuildCmd.MarkFlagRequired("from")
+	//--to flag, default today
+	toursRebuildCmd.Flags().StringVar(&rebuildTo, "to", "", "Last day of the tours to rebuild (default today)")
+	//--longRest flag, rests and periods without data ending a tour
+	toursRebuildCmd.Flags().DurationVar(&segmentOptions.LongRest, "longRest", segmentOptions.LongRest, "Minimum rest (or period without data) ending a tour")
+	//--maxKmGap flag, missing km ending a tour
+	toursRebuildCmd.Flags().IntVar(&segmentOptions.MaxKmGap, "maxKmGap", segmentOptions.MaxKmGap, "Maximum km difference between two activities of a tour")
+	//--restActivities flag
+	toursRebuildCmd.Flags().StringSliceVar(&segmentOptions.RestActivities, "restActivities", segmentOptions.RestActivities, "Activity names considered as rest")
+	toursCmd.AddCommand(toursRebuildCmd)
+	rootCmd.AddCommand(toursCmd)
+}
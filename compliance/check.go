@@ -0,0 +1,59 @@
+package compliance
+
+import (
+	"fmt"
+	"log"
+	"time"
+	"tx2db/database"
+)
+
+//lookBack is the activity loaded before the checked period, the weekly rules need the previous weeks
+const lookBack = 14 * 24 * time.Hour
+
+//Result summarises a compliance check
+type Result struct {
+	Drivers    int
+	Periods    int
+	Violations []database.ComplianceViolation
+}
+
+func (r *Result) String() string {
+	return fmt.Sprintf("%d drivers, %d activity periods checked: %d violations", r.Drivers, r.Periods, len(r.Violations))
+}
+
+//Check evaluates the rules for every driver between from and to, the violations found are stored by Store
+func Check(from, to time.Time, limits Limits) (*Result, error) {
+	periods, err := database.ActivityPeriods(from.Add(-lookBack), to)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Periods: len(periods)}
+	//periods are ordered by driver
+	for start := 0; start < len(periods); {
+		end := start
+		for end < len(periods) && periods[end].DriverTransicsID == periods[start].DriverTransicsID {
+			end++
+		}
+
+		driverID := periods[start].DriverTransicsID
+		for _, violation := range Evaluate(driverID, periods[start:end], limits) {
+			//violations of the previous weeks have been stored by a previous check
+			if violation.StartTime.Before(from) || !violation.StartTime.Before(to) {
+				continue
+			}
+			result.Violations = append(result.Violations, violation)
+		}
+		result.Drivers++
+		start = end
+	}
+	log.Printf("Compliance from %s to %s: %s\n", from.Format("2006-01-02"), to.Format("2006-01-02"), result)
+
+	return result, nil
+}
+
+//Store stores the violations of a check between from and to
+//the violations started during the period are replaced, so a period can be checked again after a new import
+func Store(from, to time.Time, result *Result) error {
+	return database.ReplaceViolations(from, to, result.Violations)
+}
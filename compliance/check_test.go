@@ -0,0 +1,44 @@
+package compliance
+
+import (
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+)
+
+func TestCheckStoresOnlyWhenAsked(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	for _, period := range periods([]part{drive(hm(5, 0)), rest(hm(11, 0))}) {
+		if err := database.DB.Create(&period).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	from, to := monday, monday.Add(24*time.Hour)
+	result, err := Check(from, to, EULimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Violations) != 1 || result.Drivers != 1 {
+		t.Fatalf("got %s, want 1 violation of 1 driver", result)
+	}
+
+	var count int
+	database.DB.Model(&database.ComplianceViolation{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("got %d violations stored by the check, want 0", count)
+	}
+
+	//storing twice replaces the violations of the period
+	for i := 0; i < 2; i++ {
+		if err := Store(from, to, result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	database.DB.Model(&database.ComplianceViolation{}).Count(&count)
+	if count != 1 {
+		t.Errorf("got %d violations stored, want 1", count)
+	}
+}
@@ -0,0 +1,389 @@
+//Package compliance checks the driving and rest times of the drivers against the EU regulation 561/2006
+//the rules are evaluated on the tachograph activity periods imported from TX-TANGO
+package compliance
+
+import (
+	"fmt"
+	"time"
+	"tx2db/database"
+)
+
+//Rules of the regulation 561/2006
+const (
+	RuleContinuousDriving  = "continuous_driving"  //art. 7, a break of 45 min after 4.5 h of driving
+	RuleDailyDriving       = "daily_driving"       //art. 6.1, 9 h a day, extended to 10 h twice a week
+	RuleWeeklyDriving      = "weekly_driving"      //art. 6.2, 56 h a week
+	RuleFortnightlyDriving = "fortnightly_driving" //art. 6.3, 90 h in two consecutive weeks
+	RuleDailyRest          = "daily_rest"          //art. 8.2, 11 h (reduced to 9 h three times) within 24 h
+	RuleWeeklyRest         = "weekly_rest"         //art. 8.6, 45 h (reduced to 24 h) after six 24 h periods
+	RuleMissingData        = "missing_data"        //not a rule, time without tachograph data long enough to be a break
+)
+
+//activityUnknown is the activity of the time without any period, it is never counted as rest
+const activityUnknown = "unknown"
+
+//Limits are the durations defined by the regulation
+type Limits struct {
+	//ContinuousDriving is the driving time after which a break must be taken
+	ContinuousDriving time.Duration
+	//Break is the break after the continuous driving, it can be split in SplitBreak and Break - SplitBreak
+	Break      time.Duration
+	SplitBreak time.Duration
+	//DailyDriving can be extended to ExtendedDailyDriving ExtendedDays times a week
+	DailyDriving         time.Duration
+	ExtendedDailyDriving time.Duration
+	ExtendedDays         int
+	WeeklyDriving        time.Duration
+	FortnightlyDriving   time.Duration
+	//DailyRest can be reduced to ReducedDailyRest ReducedDailyRests times between two weekly rests
+	//or split in SplitDailyRest followed by ReducedDailyRest
+	DailyRest         time.Duration
+	ReducedDailyRest  time.Duration
+	ReducedDailyRests int
+	SplitDailyRest    time.Duration
+	//WeeklyRest can be reduced to ReducedWeeklyRest, two consecutive weekly rests cannot both be reduced
+	WeeklyRest        time.Duration
+	ReducedWeeklyRest time.Duration
+	//WeeklyRestInterval is the maximum time between the end of a weekly rest and the start of the next one
+	WeeklyRestInterval time.Duration
+}
+
+//EULimits are the limits of the regulation 561/2006
+var EULimits = Limits{
+	ContinuousDriving:    4*time.Hour + 30*time.Minute,
+	Break:                45 * time.Minute,
+	SplitBreak:           15 * time.Minute,
+	DailyDriving:         9 * time.Hour,
+	ExtendedDailyDriving: 10 * time.Hour,
+	ExtendedDays:         2,
+	WeeklyDriving:        56 * time.Hour,
+	FortnightlyDriving:   90 * time.Hour,
+	DailyRest:            11 * time.Hour,
+	ReducedDailyRest:     9 * time.Hour,
+	ReducedDailyRests:    3,
+	SplitDailyRest:       3 * time.Hour,
+	WeeklyRest:           45 * time.Hour,
+	ReducedWeeklyRest:    24 * time.Hour,
+	WeeklyRestInterval:   6 * 24 * time.Hour,
+}
+
+//span is a continuous period of a single activity of a driver
+type span struct {
+	activity string
+	start    time.Time
+	end      time.Time
+}
+
+func (s span) duration() time.Duration {
+	return s.end.Sub(s.start)
+}
+
+//workday is the time between two daily rests of a driver
+type workday struct {
+	start     time.Time
+	end       time.Time
+	driving   time.Duration
+	splitRest bool
+	//rest is the daily rest ending the workday, nil when the data ends before it
+	rest *span
+}
+
+//Evaluate checks the activity periods of a driver, ordered by start time, against the limits
+//the time without any period (card withdrawn) is unknown, it is reported as missing data when long enough
+//to be a break and the rules are not checked across it
+func Evaluate(driverID uint, periods []database.DriverActivityPeriod, limits Limits) []database.ComplianceViolation {
+	spans := timeline(periods)
+	if len(spans) == 0 {
+		return nil
+	}
+
+	var violations []database.ComplianceViolation
+	add := func(rule string, start, end time.Time, value, limit time.Duration, details string) {
+		violations = append(violations, database.ComplianceViolation{
+			DriverTransicsID: driverID,
+			Rule:             rule,
+			StartTime:        start,
+			EndTime:          end,
+			Value:            float32(value.Minutes()),
+			Limit:            float32(limit.Minutes()),
+			Details:          details,
+		})
+	}
+
+	checkMissingData(spans, limits, add)
+	checkContinuousDriving(spans, limits, add)
+	checkWorkdays(spans, limits, add)
+	checkWeeklyDriving(spans, limits, add)
+	checkWeeklyRests(spans, limits, add)
+
+	return violations
+}
+
+//timeline builds the spans of a driver, the gaps between the periods are filled with unknown spans
+//overlapping and duplicated periods are trimmed and consecutive periods of the same activity are merged
+func timeline(periods []database.DriverActivityPeriod) []span {
+	var spans []span
+	appendSpan := func(s span) {
+		if n := len(spans); n > 0 && spans[n-1].activity == s.activity && spans[n-1].end.Equal(s.start) {
+			spans[n-1].end = s.end
+			return
+		}
+		spans = append(spans, s)
+	}
+
+	for _, period := range periods {
+		start := period.StartTime
+		if n := len(spans); n > 0 {
+			last := spans[n-1]
+			if start.Before(last.end) {
+				start = last.end
+			}
+			if start.After(last.end) {
+				appendSpan(span{activity: activityUnknown, start: last.end, end: start})
+			}
+		}
+		//the running period has no end yet
+		if !period.EndTime.After(start) {
+			continue
+		}
+		appendSpan(span{activity: period.Activity, start: start, end: period.EndTime})
+	}
+
+	return spans
+}
+
+//checkMissingData reports the time without tachograph data long enough to be a break
+func checkMissingData(spans []span, limits Limits, add func(string, time.Time, time.Time, time.Duration, time.Duration, string)) {
+	for _, s := range spans {
+		if s.activity == activityUnknown && s.duration() >= limits.Break {
+			add(RuleMissingData, s.start, s.end, s.duration(), limits.Break,
+				fmt.Sprintf("%s without tachograph data, not counted as rest", formatDuration(s.duration())))
+		}
+	}
+}
+
+//checkContinuousDriving checks the breaks taken after the continuous driving time
+//the driving on both sides of missing data is not checked as a single block
+func checkContinuousDriving(spans []span, limits Limits, add func(string, time.Time, time.Time, time.Duration, time.Duration, string)) {
+	var driven time.Duration
+	var blockStart time.Time
+	var firstPart bool
+
+	endBlock := func(end time.Time) {
+		if driven > limits.ContinuousDriving {
+			add(RuleContinuousDriving, blockStart, end, driven, limits.ContinuousDriving,
+				fmt.Sprintf("%s driven without a break of %s", formatDuration(driven), formatDuration(limits.Break)))
+		}
+		driven = 0
+		firstPart = false
+	}
+
+	for _, s := range spans {
+		switch s.activity {
+		case database.ActivityDrive:
+			if driven == 0 {
+				blockStart = s.start
+			}
+			driven += s.duration()
+		case database.ActivityRest:
+			switch {
+			case s.duration() >= limits.Break:
+				endBlock(s.start)
+			case firstPart && s.duration() >= limits.Break-limits.SplitBreak:
+				endBlock(s.start)
+			case s.duration() >= limits.SplitBreak:
+				firstPart = true
+			}
+		case activityUnknown:
+			if s.duration() >= limits.Break {
+				endBlock(s.start)
+			}
+		}
+	}
+	endBlock(spans[len(spans)-1].end)
+}
+
+//checkWorkdays checks the daily driving time and the daily rest of every workday
+//a workday ended by missing data has no daily rest to check, the missing data is reported instead
+func checkWorkdays(spans []span, limits Limits, add func(string, time.Time, time.Time, time.Duration, time.Duration, string)) {
+	extended := make(map[time.Time]int)
+	var reduced int
+	end := spans[len(spans)-1].end
+
+	for _, day := range workdays(spans, limits) {
+		//daily driving, extended twice a week at most
+		week := weekStart(day.start)
+		switch {
+		case day.driving > limits.ExtendedDailyDriving:
+			add(RuleDailyDriving, day.start, day.end, day.driving, limits.ExtendedDailyDriving,
+				fmt.Sprintf("%s driven in a day", formatDuration(day.driving)))
+		case day.driving > limits.DailyDriving:
+			extended[week]++
+			if extended[week] > limits.ExtendedDays {
+				add(RuleDailyDriving, day.start, day.end, day.driving, limits.DailyDriving,
+					fmt.Sprintf("%s driven in a day, already extended %d times this week", formatDuration(day.driving), limits.ExtendedDays))
+			}
+		}
+
+		//daily rest, taken within 24 h from the start of the workday
+		deadline := day.start.Add(24 * time.Hour)
+		if (day.rest == nil && !end.After(deadline)) || (day.rest != nil && day.rest.activity == activityUnknown) {
+			continue
+		}
+		var rest time.Duration
+		if day.rest != nil && day.rest.start.Before(deadline) {
+			rest = minTime(day.rest.end, deadline).Sub(day.rest.start)
+		}
+
+		switch {
+		case rest >= limits.DailyRest || (day.splitRest && rest >= limits.ReducedDailyRest):
+		case rest >= limits.ReducedDailyRest:
+			reduced++
+			if reduced > limits.ReducedDailyRests {
+				add(RuleDailyRest, day.start, deadline, rest, limits.DailyRest,
+					fmt.Sprintf("daily rest of %s, already reduced %d times since the weekly rest", formatDuration(rest), limits.ReducedDailyRests))
+			}
+		default:
+			add(RuleDailyRest, day.start, deadline, rest, limits.ReducedDailyRest,
+				fmt.Sprintf("daily rest of %s within 24h", formatDuration(rest)))
+		}
+
+		//the reduced daily rests are counted between two weekly rests
+		if day.rest != nil && day.rest.duration() >= limits.ReducedWeeklyRest {
+			reduced = 0
+		}
+	}
+}
+
+//workdays splits the spans of a driver on the daily rests and on the missing data as long as a daily rest
+func workdays(spans []span, limits Limits) []workday {
+	var days []workday
+	var current *workday
+	for i, s := range spans {
+		if (s.activity == database.ActivityRest || s.activity == activityUnknown) && s.duration() >= limits.ReducedDailyRest {
+			if current != nil {
+				current.rest = &spans[i]
+				days = append(days, *current)
+				current = nil
+			}
+			continue
+		}
+
+		if current == nil {
+			current = &workday{start: s.start}
+		}
+		current.end = s.end
+		switch {
+		case s.activity == database.ActivityDrive:
+			current.driving += s.duration()
+		case s.activity == database.ActivityRest && s.duration() >= limits.SplitDailyRest:
+			current.splitRest = true
+		}
+	}
+	if current != nil {
+		days = append(days, *current)
+	}
+
+	return days
+}
+
+//checkWeeklyDriving checks the driving time of every fixed week (monday to sunday) and of every two consecutive weeks
+func checkWeeklyDriving(spans []span, limits Limits, add func(string, time.Time, time.Time, time.Duration, time.Duration, string)) {
+	first := weekStart(spans[0].start)
+	last := spans[len(spans)-1].end
+	for week := first; week.Before(last); week = week.AddDate(0, 0, 7) {
+		end := week.AddDate(0, 0, 7)
+		if at, driven, ok := exceeded(spans, week, end, limits.WeeklyDriving); ok {
+			add(RuleWeeklyDriving, at, end, driven, limits.WeeklyDriving,
+				fmt.Sprintf("%s driven in the week of %s", formatDuration(driven), week.Format("2006-01-02")))
+		}
+		//the first week of the timeline has no previous week
+		if week.Equal(first) {
+			continue
+		}
+		if at, driven, ok := exceeded(spans, week.AddDate(0, 0, -7), end, limits.FortnightlyDriving); ok && !at.Before(week) {
+			add(RuleFortnightlyDriving, at, end, driven, limits.FortnightlyDriving,
+				fmt.Sprintf("%s driven in the weeks of %s and %s", formatDuration(driven), week.AddDate(0, 0, -7).Format("2006-01-02"), week.Format("2006-01-02")))
+		}
+	}
+}
+
+//exceeded returns when the driving time between from and to exceeded the limit and the total driving time
+func exceeded(spans []span, from, to time.Time, limit time.Duration) (time.Time, time.Duration, bool) {
+	var driven time.Duration
+	var at time.Time
+	for _, s := range spans {
+		if s.activity != database.ActivityDrive || !s.end.After(from) || !s.start.Before(to) {
+			continue
+		}
+		start, end := maxTime(s.start, from), minTime(s.end, to)
+		if at.IsZero() && driven+end.Sub(start) > limit {
+			at = start.Add(limit - driven)
+		}
+		driven += end.Sub(start)
+	}
+
+	return at, driven, !at.IsZero()
+}
+
+//checkWeeklyRests checks the time between two weekly rests and that two consecutive weekly rests are not both reduced
+//missing data as long as a weekly rest is not a weekly rest, the rests before it are not compared with the next ones
+func checkWeeklyRests(spans []span, limits Limits, add func(string, time.Time, time.Time, time.Duration, time.Duration, string)) {
+	var previous *span
+	for i, s := range spans {
+		if s.activity == activityUnknown && s.duration() >= limits.ReducedWeeklyRest {
+			previous = nil
+			continue
+		}
+		if s.activity != database.ActivityRest || s.duration() < limits.ReducedWeeklyRest {
+			continue
+		}
+
+		if previous != nil {
+			if gap := s.start.Sub(previous.end); gap > limits.WeeklyRestInterval {
+				add(RuleWeeklyRest, previous.end, s.start, gap, limits.WeeklyRestInterval,
+					fmt.Sprintf("%s without a weekly rest", formatDuration(gap)))
+			}
+			if previous.duration() < limits.WeeklyRest && s.duration() < limits.WeeklyRest {
+				add(RuleWeeklyRest, s.start, s.end, s.duration(), limits.WeeklyRest,
+					fmt.Sprintf("two consecutive reduced weekly rests of %s and %s", formatDuration(previous.duration()), formatDuration(s.duration())))
+			}
+		}
+		previous = &spans[i]
+	}
+
+	//the driver has not taken the next weekly rest yet
+	end := spans[len(spans)-1].end
+	if previous != nil {
+		if gap := end.Sub(previous.end); gap > limits.WeeklyRestInterval {
+			add(RuleWeeklyRest, previous.end, end, gap, limits.WeeklyRestInterval,
+				fmt.Sprintf("%s without a weekly rest", formatDuration(gap)))
+		}
+	}
+}
+
+//weekStart returns the monday at midnight of the week of t
+func weekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+//formatDuration formats a duration in hours and minutes, 4h30
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	return fmt.Sprintf("%dh%02d", int(d.Hours()), int(d.Minutes())%60)
+}
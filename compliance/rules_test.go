@@ -0,0 +1,202 @@
+package compliance
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"tx2db/database"
+)
+
+//monday is the start of the timelines of the tests
+var monday = time.Date(2020, 2, 10, 0, 0, 0, 0, time.UTC)
+
+//part is an activity of a timeline, an empty activity is a time without tachograph data
+type part struct {
+	activity string
+	duration time.Duration
+}
+
+func hm(hours, minutes int) time.Duration {
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+}
+
+func drive(d time.Duration) part { return part{database.ActivityDrive, d} }
+func work(d time.Duration) part  { return part{database.ActivityWork, d} }
+func rest(d time.Duration) part  { return part{database.ActivityRest, d} }
+func gap(d time.Duration) part   { return part{"", d} }
+
+//repeat repeats the parts of a timeline
+func repeat(n int, parts ...part) []part {
+	var repeated []part
+	for i := 0; i < n; i++ {
+		repeated = append(repeated, parts...)
+	}
+	return repeated
+}
+
+//join joins the parts of several timelines
+func join(parts ...[]part) []part {
+	var joined []part
+	for _, p := range parts {
+		joined = append(joined, p...)
+	}
+	return joined
+}
+
+//periods builds the consecutive activity periods of a timeline starting on monday
+func periods(parts []part) []database.DriverActivityPeriod {
+	var periods []database.DriverActivityPeriod
+	start := monday
+	for _, p := range parts {
+		if p.activity != "" {
+			periods = append(periods, database.DriverActivityPeriod{
+				DriverTransicsID: 1,
+				Activity:         p.activity,
+				StartTime:        start,
+				EndTime:          start.Add(p.duration),
+				Duration:         float32(p.duration.Minutes()),
+			})
+		}
+		start = start.Add(p.duration)
+	}
+	return periods
+}
+
+func TestEvaluate(t *testing.T) {
+	//a day of 9h driving and a daily rest
+	day := []part{drive(hm(4, 30)), rest(hm(0, 45)), drive(hm(4, 30)), rest(hm(14, 15))}
+	//a day of 8h driving and a daily rest
+	shortDay := []part{drive(hm(4, 0)), rest(hm(0, 45)), drive(hm(4, 0)), rest(hm(15, 15))}
+
+	tests := []struct {
+		name  string
+		parts []part
+		//duplicate imports the first period twice
+		duplicate bool
+		want      []string
+	}{
+		{
+			name:  "driving within every limit",
+			parts: day,
+		},
+		{
+			name:  "continuous driving over the limit",
+			parts: []part{drive(hm(4, 31)), rest(hm(11, 0))},
+			want:  []string{RuleContinuousDriving},
+		},
+		{
+			name:  "break split in 15 and 30 min",
+			parts: []part{drive(hm(2, 0)), rest(hm(0, 15)), drive(hm(2, 0)), rest(hm(0, 30)), drive(hm(2, 0)), rest(hm(11, 0))},
+		},
+		{
+			name:  "break split in 30 and 15 min",
+			parts: []part{drive(hm(2, 0)), rest(hm(0, 30)), drive(hm(2, 0)), rest(hm(0, 15)), drive(hm(1, 0)), rest(hm(11, 0))},
+			want:  []string{RuleContinuousDriving},
+		},
+		{
+			name:  "daily driving extended twice a week",
+			parts: repeat(2, drive(hm(4, 30)), rest(hm(0, 45)), drive(hm(4, 30)), rest(hm(0, 45)), drive(hm(1, 0)), rest(hm(12, 30))),
+		},
+		{
+			name:  "daily driving extended three times a week",
+			parts: repeat(3, drive(hm(4, 30)), rest(hm(0, 45)), drive(hm(4, 30)), rest(hm(0, 45)), drive(hm(1, 0)), rest(hm(12, 30))),
+			want:  []string{RuleDailyDriving},
+		},
+		{
+			name:  "daily driving over the extended limit",
+			parts: []part{drive(hm(4, 30)), rest(hm(0, 45)), drive(hm(4, 30)), rest(hm(0, 45)), drive(hm(1, 1)), rest(hm(11, 0))},
+			want:  []string{RuleDailyDriving},
+		},
+		{
+			name:  "daily rest reduced three times",
+			parts: repeat(3, drive(hm(4, 0)), rest(hm(0, 45)), drive(hm(4, 0)), work(hm(6, 15)), rest(hm(9, 0))),
+		},
+		{
+			name:  "daily rest reduced four times",
+			parts: repeat(4, drive(hm(4, 0)), rest(hm(0, 45)), drive(hm(4, 0)), work(hm(6, 15)), rest(hm(9, 0))),
+			want:  []string{RuleDailyRest},
+		},
+		{
+			name:  "daily rest shorter than the reduced daily rest",
+			parts: []part{drive(hm(4, 0)), rest(hm(0, 45)), drive(hm(4, 0)), work(hm(6, 16)), rest(hm(8, 59)), drive(hm(1, 0)), rest(hm(11, 0))},
+			want:  []string{RuleDailyRest},
+		},
+		{
+			name:  "weekly driving of 56h",
+			parts: repeat(7, shortDay...),
+		},
+		{
+			name:  "weekly driving over 56h",
+			parts: repeat(7, day...),
+			want:  []string{RuleWeeklyDriving},
+		},
+		{
+			name:  "fortnightly driving over 90h and two reduced weekly rests",
+			parts: repeat(2, append(repeat(6, day...), rest(hm(24, 0)))...),
+			want:  []string{RuleFortnightlyDriving, RuleWeeklyRest},
+		},
+		{
+			name:  "regular weekly rest followed by a reduced one",
+			parts: join([]part{rest(hm(45, 0))}, repeat(5, shortDay...), []part{rest(hm(24, 0))}, shortDay),
+		},
+		{
+			name:  "more than six days without a weekly rest",
+			parts: join([]part{rest(hm(45, 0))}, repeat(7, shortDay...)),
+			want:  []string{RuleWeeklyRest},
+		},
+		{
+			name:      "duplicated periods are counted once",
+			parts:     []part{drive(hm(3, 0)), drive(hm(1, 30)), rest(hm(11, 0))},
+			duplicate: true,
+		},
+		{
+			name:  "short gap is not a break",
+			parts: []part{drive(hm(4, 0)), gap(hm(0, 20)), rest(hm(0, 30)), drive(hm(1, 0)), rest(hm(11, 0))},
+			want:  []string{RuleContinuousDriving},
+		},
+		{
+			name:  "gap as long as a break is missing data",
+			parts: []part{drive(hm(3, 0)), gap(hm(1, 0)), drive(hm(2, 0)), rest(hm(11, 0))},
+			want:  []string{RuleMissingData},
+		},
+		{
+			name:  "gap instead of a daily rest",
+			parts: []part{drive(hm(4, 0)), rest(hm(0, 45)), drive(hm(4, 0)), gap(hm(12, 0)), drive(hm(4, 0)), rest(hm(0, 45)), drive(hm(4, 0)), rest(hm(11, 0))},
+			want:  []string{RuleMissingData},
+		},
+		{
+			name:  "gap instead of a weekly rest",
+			parts: join([]part{rest(hm(45, 0))}, repeat(5, shortDay...), []part{gap(hm(30, 0))}, repeat(3, shortDay...)),
+			want:  []string{RuleMissingData},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := periods(tt.parts)
+			if tt.duplicate {
+				input = append([]database.DriverActivityPeriod{input[0]}, input...)
+			}
+
+			var got []string
+			for _, violation := range Evaluate(1, input, EULimits) {
+				got = append(got, violation.Rule)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got violations %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateViolationDetails(t *testing.T) {
+	violations := Evaluate(7, periods([]part{drive(hm(5, 0)), rest(hm(11, 0))}), EULimits)
+	if len(violations) != 1 {
+		t.Fatalf("got violations %+v, want 1", violations)
+	}
+
+	v := violations[0]
+	if v.DriverTransicsID != 7 || !v.StartTime.Equal(monday) || !v.EndTime.Equal(monday.Add(5*time.Hour)) || v.Value != 300 || v.Limit != 270 {
+		t.Errorf("violation %+v, want 5h driven from monday with a limit of 4h30", v)
+	}
+}
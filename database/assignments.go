@@ -0,0 +1,149 @@
+package database
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+//Sources of a driver assignment
+const (
+	AssignmentSnapshot = "snapshot" //driver of the vehicle when importing the trucks
+	AssignmentEco      = "eco"      //driver of an eco monitor report trip
+)
+
+//assignmentMergeGap is the maximum gap between two eco monitor trips of a driver merged in one assignment
+const assignmentMergeGap = 30 * time.Minute
+
+//DriverAssignment is a period during which a driver drove a truck
+//assignments from eco monitor reports are exact, snapshot ones are bounded by the import times
+type DriverAssignment struct {
+	gorm.Model
+	TruckTransicsID  uint
+	DriverTransicsID uint
+	StartTime        time.Time
+	EndTime          time.Time `sql:"default: null"` //null while the driver is still in the truck
+	Source           string    //snapshot or eco
+}
+
+//AssignmentsForTruck returns the assignments of a truck overlapping a period, oldest first
+func AssignmentsForTruck(truckID uint, from, to time.Time) ([]DriverAssignment, error) {
+	var assignments []DriverAssignment
+	err := DB.Where("truck_transics_id = ? AND start_time < ? AND (end_time IS NULL OR end_time > ?)", truckID, to, from).
+		Order("start_time asc").Find(&assignments).Error
+	if err != nil {
+		return nil, errors.Wrap(err, ErrorDB)
+	}
+
+	return assignments, nil
+}
+
+//DriverAt returns the driver of a truck at a given time, 0 when unknown
+func DriverAt(truckID uint, t time.Time) (uint, error) {
+	return driverAt(DB, truckID, t)
+}
+
+//driverAt returns the driver of a truck at a given time, eco monitor assignments are preferred to snapshots
+func driverAt(db *gorm.DB, truckID uint, t time.Time) (uint, error) {
+	var assignment DriverAssignment
+	err := db.Where("truck_transics_id = ? AND start_time <= ? AND (end_time IS NULL OR end_time > ?)", truckID, t, t).
+		Order("CASE WHEN source = '" + AssignmentEco + "' THEN 0 ELSE 1 END, start_time desc").First(&assignment).Error
+	if err == gorm.ErrRecordNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, ErrorDB)
+	}
+
+	return assignment.DriverTransicsID, nil
+}
+
+//recordSnapshotAssignment records the driver seen in a truck when importing the trucks
+//the assignment of the previous driver of the truck, or of the driver in another truck, is ended
+func recordSnapshotAssignment(truckID, driverID uint, at time.Time) error {
+	var open DriverAssignment
+	err := DB.Where("truck_transics_id = ? AND source = ? AND end_time IS NULL", truckID, AssignmentSnapshot).Last(&open).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return errors.Wrap(err, ErrorDB)
+	}
+
+	//same driver, still assigned
+	if err == nil && open.DriverTransicsID == driverID {
+		return nil
+	}
+
+	//end the assignments of the previous driver of the truck and of the driver in other trucks
+	err = DB.Model(&DriverAssignment{}).
+		Where("source = ? AND end_time IS NULL AND (truck_transics_id = ? OR driver_transics_id = ?)", AssignmentSnapshot, truckID, driverID).
+		Update("end_time", at).Error
+	if err != nil {
+		return errors.Wrap(err, ErrorDB)
+	}
+
+	//no driver in the truck
+	if driverID == 0 {
+		return nil
+	}
+
+	return errors.Wrap(DB.Create(&DriverAssignment{
+		TruckTransicsID:  truckID,
+		DriverTransicsID: driverID,
+		StartTime:        at,
+		Source:           AssignmentSnapshot,
+	}).Error, ErrorDB)
+}
+
+//recordEcoAssignment records the driver of an eco monitor trip
+//assignments of the same driver and truck overlapping or close to the trip are merged
+func recordEcoAssignment(tx *gorm.DB, truckID, driverID uint, start, end time.Time) error {
+	if truckID == 0 || driverID == 0 || start.IsZero() {
+		return nil
+	}
+	if end.Before(start) {
+		end = start
+	}
+
+	var existing []DriverAssignment
+	err := tx.Where("truck_transics_id = ? AND driver_transics_id = ? AND source = ? AND start_time <= ? AND end_time >= ?",
+		truckID, driverID, AssignmentEco, end.Add(assignmentMergeGap), start.Add(-assignmentMergeGap)).
+		Order("start_time asc").Find(&existing).Error
+	if err != nil {
+		return errors.Wrap(err, ErrorDB)
+	}
+
+	if len(existing) == 0 {
+		return errors.Wrap(tx.Create(&DriverAssignment{
+			TruckTransicsID:  truckID,
+			DriverTransicsID: driverID,
+			StartTime:        start,
+			EndTime:          end,
+			Source:           AssignmentEco,
+		}).Error, ErrorDB)
+	}
+
+	//extend the first assignment and remove the ones merged into it
+	merged := existing[0]
+	var ids []uint
+	for _, assignment := range existing {
+		if assignment.StartTime.Before(start) {
+			start = assignment.StartTime
+		}
+		if assignment.EndTime.After(end) {
+			end = assignment.EndTime
+		}
+		if assignment.ID != merged.ID {
+			ids = append(ids, assignment.ID)
+		}
+	}
+	if merged.StartTime.Equal(start) && merged.EndTime.Equal(end) && len(ids) == 0 {
+		return nil
+	}
+
+	err = tx.Model(&DriverAssignment{}).Where("id = ?", merged.ID).Updates(map[string]interface{}{"start_time": start, "end_time": end}).Error
+	if err == nil && len(ids) > 0 {
+		err = tx.Unscoped().Where("id IN (?)", ids).Delete(&DriverAssignment{}).Error
+	}
+
+	return errors.Wrap(err, ErrorDB)
+}
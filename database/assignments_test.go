@@ -0,0 +1,136 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+)
+
+//trip is an eco monitor trip of a driver in a truck between two hours of the timeline
+type trip struct {
+	truckID, driverID uint
+	start, end        int
+}
+
+//wantAssignment is an eco monitor assignment expected after recording the trips
+type wantAssignment struct {
+	truckID, driverID uint
+	start, end        int
+}
+
+func TestRecordEcoAssignment(t *testing.T) {
+	minutes := func(m int) time.Duration { return time.Duration(m) * time.Minute }
+
+	tests := []struct {
+		name  string
+		trips []trip
+		want  []wantAssignment
+	}{
+		{"single trip", []trip{{100, 1, 60, 120}}, []wantAssignment{{100, 1, 60, 120}}},
+		{"same trip twice", []trip{{100, 1, 60, 120}, {100, 1, 60, 120}}, []wantAssignment{{100, 1, 60, 120}}},
+		{"overlapping trips", []trip{{100, 1, 60, 120}, {100, 1, 90, 180}}, []wantAssignment{{100, 1, 60, 180}}},
+		{"trips within the merge gap", []trip{{100, 1, 60, 120}, {100, 1, 150, 200}}, []wantAssignment{{100, 1, 60, 200}}},
+		{"trips further apart", []trip{{100, 1, 60, 120}, {100, 1, 151, 200}}, []wantAssignment{{100, 1, 60, 120}, {100, 1, 151, 200}}},
+		{"trip joining two assignments", []trip{{100, 1, 0, 60}, {100, 1, 200, 260}, {100, 1, 60, 200}}, []wantAssignment{{100, 1, 0, 260}}},
+		{"other driver", []trip{{100, 1, 60, 120}, {100, 2, 120, 180}}, []wantAssignment{{100, 1, 60, 120}, {100, 2, 120, 180}}},
+		{"other truck", []trip{{100, 1, 60, 120}, {200, 1, 130, 180}}, []wantAssignment{{100, 1, 60, 120}, {200, 1, 130, 180}}},
+		{"unknown driver", []trip{{100, 0, 60, 120}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer databasetest.Open(t)()
+
+			for _, trip := range tt.trips {
+				err := database.RecordEcoAssignment(database.DB, trip.truckID, trip.driverID, at(0).Add(minutes(trip.start)), at(0).Add(minutes(trip.end)))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var assignments []database.DriverAssignment
+			if err := database.DB.Order("truck_transics_id, start_time").Find(&assignments).Error; err != nil {
+				t.Fatal(err)
+			}
+			var got []wantAssignment
+			for _, a := range assignments {
+				if a.Source != database.AssignmentEco {
+					t.Errorf("assignment %+v not from the eco monitor reports", a)
+				}
+				got = append(got, wantAssignment{a.TruckTransicsID, a.DriverTransicsID, int(a.StartTime.Sub(at(0)) / time.Minute), int(a.EndTime.Sub(at(0)) / time.Minute)})
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got assignments %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("assignment %d is %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDriverAt(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	//driver 1 is seen in truck 100, then driver 2 takes it and driver 1 goes to truck 200
+	steps := []struct {
+		truckID, driverID uint
+		at                int
+	}{
+		{100, 1, 0},
+		{100, 2, 10},
+		{200, 1, 12},
+	}
+	for _, step := range steps {
+		if err := database.RecordSnapshotAssignment(step.truckID, step.driverID, at(step.at)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	//the eco monitor reports show driver 3 drove truck 100 for a while
+	if err := database.RecordEcoAssignment(database.DB, 100, 3, at(14), at(16)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		truckID uint
+		at      int
+		want    uint
+	}{
+		{100, -1, 0},
+		{100, 0, 1},
+		{100, 9, 1},
+		{100, 10, 2},
+		{100, 15, 3},
+		{100, 16, 2},
+		{200, 11, 0},
+		{200, 12, 1},
+		{300, 12, 0},
+	}
+	for _, tt := range tests {
+		got, err := database.DriverAt(tt.truckID, at(tt.at))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("got driver %d in truck %d at %dh, want %d", got, tt.truckID, tt.at, tt.want)
+		}
+	}
+
+	//a single snapshot assignment stays open per truck and per driver
+	var open int
+	database.DB.Model(&database.DriverAssignment{}).Where("source = ? AND end_time IS NULL", database.AssignmentSnapshot).Count(&open)
+	if open != 2 {
+		t.Errorf("got %d open assignments, want 2", open)
+	}
+
+	assignments, err := database.AssignmentsForTruck(100, at(11), at(15))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 2 || assignments[0].DriverTransicsID != 2 || assignments[1].DriverTransicsID != 3 {
+		t.Errorf("got assignments %+v, want those of drivers 2 and 3", assignments)
+	}
+}
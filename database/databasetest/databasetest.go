@@ -0,0 +1,34 @@
+//Package databasetest opens temporary databases for the tests of the packages using the database
+package databasetest
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"tx2db/database"
+)
+
+//Open opens a migrated SQLite database in a temporary folder as database.DB, closed and removed by the returned function
+func Open(t *testing.T) func() {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "tx2db-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := database.OpenDB(database.DriverSQLite, path.Join(dir, "tx2db.db")); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	if _, err := database.MigrateUp(0); err != nil {
+		database.DB.Close()
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	return func() {
+		database.DB.Close()
+		os.RemoveAll(dir)
+	}
+}
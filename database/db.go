@@ -2,12 +2,12 @@
 package database
 
 import (
-	"fmt"
-	"net/url"
+	"log"
 	"os"
+	"tx2db/txtango"
 
 	"github.com/jinzhu/gorm"
-	_ "github.com/jinzhu/gorm/dialects/mssql" // driver mssql
+	"github.com/pkg/errors"
 )
 
 //DB is the database object
@@ -16,29 +16,74 @@ var DB *gorm.DB
 //ErrorDB specified an connection error to the database
 var ErrorDB = "Connection error to the database"
 
-//InitDB initialize the sql database
+//logTransicsWarnings prints every warning returned by TX-TANGO
+func logTransicsWarnings(warnings txtango.TXWarning) {
+	for _, warning := range warnings.Warnings {
+		log.Printf("WARNING: %s (%s) - %s %s\n", warning.Code, warning.Category(), warning.CodeExplenation, warning.Value)
+	}
+}
+
+//InitDB initialize the sql database using .env
 //We are using an GO ORM named GORM
+//the database schema must be up to date, see MigrateUp
 func InitDB() error {
-	dbUser := os.Getenv("DB_USERNAME")
-	dbPassword := os.Getenv("DB_PASSWORD")
-	dbHost := os.Getenv("DB_HOST")
-	dbName := os.Getenv("DB_NAME")
+	err := ConnectDB()
+	if err != nil {
+		return err
+	}
 
-	//encode the password so no failure in database connection
-	dbPassword = url.QueryEscape(dbPassword)
+	pending, err := PendingMigrations()
+	if err != nil {
+		DB.Close()
+		return err
+	}
+	if pending > 0 {
+		DB.Close()
+		return errors.Errorf("Database schema is not up to date (%d pending migrations), run `tx2db migrate up`", pending)
+	}
+
+	return nil
+}
+
+//ConnectDB connects to the database using .env without checking its schema
+func ConnectDB() error {
+	driver := os.Getenv("DB_DRIVER")
+	if driver == "" {
+		driver = DriverMSSQL
+	}
 
 	//build connection string
-	dbURI := fmt.Sprintf("sqlserver://%s:%s@%s:1433?database=%s", dbUser, dbPassword, dbHost, dbName)
+	dbURI, err := buildURI(driver, dbSettings{
+		User:     os.Getenv("DB_USERNAME"),
+		Password: os.Getenv("DB_PASSWORD"),
+		Host:     os.Getenv("DB_HOST"),
+		Port:     os.Getenv("DB_PORT"),
+		Name:     os.Getenv("DB_NAME"),
+		SSLMode:  os.Getenv("DB_SSLMODE"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return OpenDB(driver, dbURI)
+}
+
+//OpenDB connects to a database of the given driver (mssql, postgres or sqlite3)
+func OpenDB(driver, dbURI string) error {
+	driver = normalizeDriver(driver)
 
 	//database connection
-	conn, err := gorm.Open("mssql", dbURI)
+	conn, err := gorm.Open(driver, dbURI)
 	if err != nil {
 		return err
 	}
 
+	//sqlite does not support concurrent writers
+	if driver == DriverSQLite {
+		conn.DB().SetMaxOpenConns(1)
+	}
+
 	DB = conn
-	//Database migration
-	DB.Debug().AutoMigrate(&Driver{}, &DriverEcoMonitorReport{}, &Truck{}, &TruckGroup{}, &TruckActivityReport{}, &Trailer{}, &Tour{}, &TourQueue{})
 
 	return nil
 }
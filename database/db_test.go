@@ -0,0 +1,28 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+	"tx2db/txtango"
+	"tx2db/txtango/txtangotest"
+)
+
+//startTestServer starts a fake TX-TANGO server and a client connected to it
+func startTestServer(t *testing.T) (*txtangotest.Server, *txtango.Client) {
+	t.Helper()
+
+	server := txtangotest.NewServer()
+	client, err := server.Client()
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+
+	return server, client
+}
+
+//day returns the midnight of a day relative to today, in UTC as the dates sent by TX-TANGO
+func day(offset int) time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, offset)
+}
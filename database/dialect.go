@@ -0,0 +1,81 @@
+package database
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	_ "github.com/jinzhu/gorm/dialects/mssql"    // driver mssql
+	_ "github.com/jinzhu/gorm/dialects/postgres" // driver postgres
+	_ "github.com/jinzhu/gorm/dialects/sqlite"   // driver sqlite
+)
+
+//Supported database drivers (DB_DRIVER)
+const (
+	DriverMSSQL    = "mssql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite3"
+)
+
+//dbSettings contains the settings used to build a connection string
+type dbSettings struct {
+	User, Password, Host, Port, Name, SSLMode string
+}
+
+//normalizeDriver maps the accepted DB_DRIVER aliases to a gorm dialect
+func normalizeDriver(driver string) string {
+	switch strings.ToLower(driver) {
+	case "sqlserver", "mssql":
+		return DriverMSSQL
+	case "postgresql", "postgres":
+		return DriverPostgres
+	case "sqlite", "sqlite3":
+		return DriverSQLite
+	}
+	return driver
+}
+
+//buildURI builds the connection string of a database driver
+func buildURI(driver string, s dbSettings) (string, error) {
+	//encode the credentials so no failure in database connection
+	userInfo := url.UserPassword(s.User, s.Password)
+
+	switch normalizeDriver(driver) {
+	case DriverMSSQL:
+		if s.Port == "" {
+			s.Port = "1433"
+		}
+		return fmt.Sprintf("sqlserver://%s@%s:%s?database=%s", userInfo, s.Host, s.Port, url.QueryEscape(s.Name)), nil
+	case DriverPostgres:
+		if s.Port == "" {
+			s.Port = "5432"
+		}
+		if s.SSLMode == "" {
+			s.SSLMode = "disable"
+		}
+		return fmt.Sprintf("postgres://%s@%s:%s/%s?sslmode=%s", userInfo, s.Host, s.Port, url.PathEscape(s.Name), url.QueryEscape(s.SSLMode)), nil
+	case DriverSQLite:
+		//DB_NAME is the path of the database file
+		return s.Name, nil
+	}
+
+	return "", errors.Errorf("Unsupported database driver %q (mssql, postgres or sqlite)", driver)
+}
+
+//dialect returns the name of the dialect of the opened database
+func dialect() string {
+	return DB.Dialect().GetName()
+}
+
+//sqlAddDays returns the SQL expression adding a number of days to a date column
+func sqlAddDays(column string, days int) string {
+	switch dialect() {
+	case DriverPostgres:
+		return fmt.Sprintf("(%s + INTERVAL '%d day')", column, days)
+	case DriverSQLite:
+		return fmt.Sprintf("datetime(%s, '%+d day')", column, days)
+	}
+	return fmt.Sprintf("DATEADD(DAY, %d, %s)", days, column)
+}
@@ -1,8 +1,8 @@
 package database
 
 import (
+	"context"
 	"log"
-	"sync"
 	"time"
 	"tx2db/txtango"
 	"tx2db/util"
@@ -33,28 +33,23 @@ type Driver struct {
 }
 
 //ImportDrivers imports all the driver from Transics and fill the database
-func ImportDrivers(wg *sync.WaitGroup) error {
-	//notify WaitGroup that we're done
-	defer wg.Done()
-
+func ImportDrivers(ctx context.Context, txClient *txtango.Client) error {
 	//import data from transics
 	log.Println(loadingDataFromTransics)
-	txDrivers, err := txtango.GetDrivers()
+	txDrivers, err := txClient.GetDrivers(ctx)
 	if err != nil {
 		return err
 	}
 
-	//check and return error
-	if txDrivers.Body.GetDriversV9Response.GetDriversV9Result.Errors.Error != (txtango.TXError{}).Error {
-		log.Printf("ERROR: %s - %s\n", txDrivers.Body.GetDriversV9Response.GetDriversV9Result.Errors.Error.Code, txDrivers.Body.GetDriversV9Response.GetDriversV9Result.Errors.Error.Value)
-	}
-
-	//check and print warning
-	if txDrivers.Body.GetDriversV9Response.GetDriversV9Result.Warnings.Warning != (txtango.TXWarning{}).Warning {
-		log.Printf("WARNING: %s - %s\n", txDrivers.Body.GetDriversV9Response.GetDriversV9Result.Errors.Error.Code, txDrivers.Body.GetDriversV9Response.GetDriversV9Result.Warnings.Warning.Value)
-	}
+	//check and print warnings
+	logTransicsWarnings(txDrivers.Body.GetDriversV9Response.GetDriversV9Result.Warnings)
 
 	for i, data := range txDrivers.Body.GetDriversV9Response.GetDriversV9Result.Persons.InterfacePersonResultV9 {
+		//stop when the import is cancelled
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		//parse modified date into time.Time if existing
 		modifiedDate, err := time.Parse("2006-01-02T15:04:05", data.UpdateDatesList.UpdateDatesItem.DateLastUpdate)
 		if err != nil {
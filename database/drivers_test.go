@@ -0,0 +1,96 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+	"tx2db/txtango/txtangotest"
+)
+
+func TestImportDrivers(t *testing.T) {
+	defer databasetest.Open(t)()
+	server, client := startTestServer(t)
+	defer server.Close()
+
+	updated := time.Date(2020, 2, 10, 8, 0, 0, 0, time.UTC)
+	server.AddDriver(
+		txtangotest.Driver{TransicsID: 1, PersonID: "P1", Name: "Jan Peeters", Language: "NL", LastUpdate: updated},
+		txtangotest.Driver{TransicsID: 2, PersonID: "P2", Name: "Marie Dubois", Language: "FR", Inactive: true, LastUpdate: updated},
+	)
+
+	if err := database.ImportDrivers(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+
+	var drivers []database.Driver
+	if err := database.DB.Order("transics_id").Find(&drivers).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(drivers) != 2 {
+		t.Fatalf("got %d drivers, want 2", len(drivers))
+	}
+	if d := drivers[0]; d.TransicsID != 1 || d.PersonID != "P1" || d.Name != "Jan Peeters" || d.Language != "NL" || d.Inactive || !d.LastModified.Equal(updated) {
+		t.Errorf("driver 1 stored as %+v", d)
+	}
+	if d := drivers[1]; d.TransicsID != 2 || d.Language != "FR" || !d.Inactive {
+		t.Errorf("driver 2 stored as %+v", d)
+	}
+}
+
+func TestImportDriversUpdatesChangedDrivers(t *testing.T) {
+	defer databasetest.Open(t)()
+	server, client := startTestServer(t)
+	defer server.Close()
+
+	updated := time.Date(2020, 2, 10, 8, 0, 0, 0, time.UTC)
+	server.AddDriver(txtangotest.Driver{TransicsID: 1, PersonID: "P1", Name: "Jan Peeters", Language: "NL", LastUpdate: updated})
+	if err := database.ImportDrivers(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	//the email is filled in manually and must be kept
+	if err := database.DB.Model(&database.Driver{}).Where("transics_id = ?", 1).Update("email", "jan@example.com").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	//a newer version of the driver replaces the stored one
+	second := txtangotest.NewServer()
+	defer second.Close()
+	second.AddDriver(txtangotest.Driver{TransicsID: 1, PersonID: "P1", Name: "Jan Peeters", Language: "FR", LastUpdate: updated.Add(time.Hour)})
+	secondClient, err := second.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := database.ImportDrivers(context.Background(), secondClient); err != nil {
+		t.Fatal(err)
+	}
+
+	var drivers []database.Driver
+	if err := database.DB.Find(&drivers).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(drivers) != 1 {
+		t.Fatalf("got %d drivers, want 1", len(drivers))
+	}
+	if drivers[0].Language != "FR" || drivers[0].Email != "jan@example.com" {
+		t.Errorf("driver stored as %+v", drivers[0])
+	}
+}
+
+func TestImportDriversError(t *testing.T) {
+	defer databasetest.Open(t)()
+	server, client := startTestServer(t)
+	defer server.Close()
+
+	server.SetError(txtangotest.GetDrivers, txtangotest.Entry{Code: "INVALID_LOGIN", Explanation: "Invalid login"})
+	if err := database.ImportDrivers(context.Background(), client); err == nil {
+		t.Fatal("got no error, want the TX-TANGO error")
+	}
+
+	var count int
+	database.DB.Model(&database.Driver{}).Count(&count)
+	if count != 0 {
+		t.Errorf("got %d drivers stored, want 0", count)
+	}
+}
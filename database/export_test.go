@@ -0,0 +1,25 @@
+package database
+
+import "time"
+
+//internals used by the tests of package database_test
+
+//DBSettings are the settings of a connection string
+type DBSettings = dbSettings
+
+var (
+	BuildURI           = buildURI
+	SQLAddDays         = sqlAddDays
+	ImportConcurrently = importConcurrently
+	UpsertStatement    = upsertStatement
+	UpsertTourReports  = upsertTourReports
+
+	RecordSnapshotAssignment = recordSnapshotAssignment
+	RecordEcoAssignment      = recordEcoAssignment
+	ParseOptionalFloat       = parseOptionalFloat
+)
+
+//NextAttempt returns when to retry an element which failed the given number of times
+func (p QueuePolicy) NextAttempt(trial int, now time.Time) time.Time {
+	return p.nextAttempt(trial, now)
+}
@@ -0,0 +1,91 @@
+package database
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+//Kinds of fuel findings
+const (
+	FuelLoss              = "fuel_loss"              //fuel level dropped more than the consumption, suspected theft
+	FuelSensorFault       = "sensor_fault"           //fuel level dropped and came back, suspected sensor fault
+	UnexplainedRefuelling = "unexplained_refuelling" //fuel level increased without a refuelling activity
+)
+
+//FuelFinding is a fuel level change between two vehicle snapshots which the activities do not explain
+//fuel quantities are in liters
+type FuelFinding struct {
+	gorm.Model
+	TruckTransicsID  uint
+	DriverTransicsID uint
+	Kind             string
+	StartTime        time.Time
+	EndTime          time.Time
+	LevelBefore      float64
+	LevelAfter       float64
+	Expected         float64 //consumption according to the activities or the distance
+	Distance         float64 //km
+	Details          string
+}
+
+//SnapshotsBetween returns the snapshots with a fuel level taken during a period, by truck and oldest first
+func SnapshotsBetween(from, to time.Time) ([]VehicleSnapshot, error) {
+	var snapshots []VehicleSnapshot
+	err := DB.Where("taken_at >= ? AND taken_at < ? AND fuel_level IS NOT NULL", from, to).Order("truck_transics_id asc, taken_at asc").Find(&snapshots).Error
+	if err != nil {
+		return nil, errors.Wrap(err, ErrorDB)
+	}
+
+	return snapshots, nil
+}
+
+//ActivitiesBetween returns the truck activities overlapping a period, by truck and oldest first
+//an activity imported in several tours is returned once
+func ActivitiesBetween(from, to time.Time) ([]TruckActivityReport, error) {
+	var activities []TruckActivityReport
+	err := DB.Where("start_time < ? AND end_time > ?", to, from).Order("truck_transics_id asc, start_time asc, updated_at desc").Find(&activities).Error
+	if err != nil {
+		return nil, errors.Wrap(err, ErrorDB)
+	}
+
+	var unique []TruckActivityReport
+	for _, activity := range activities {
+		if n := len(unique); n > 0 && unique[n-1].TruckTransicsID == activity.TruckTransicsID && unique[n-1].StartTime.Equal(activity.StartTime) {
+			continue
+		}
+		unique = append(unique, activity)
+	}
+
+	return unique, nil
+}
+
+//FuelFindings returns the findings started during a period, by truck and oldest first
+func FuelFindings(from, to time.Time) ([]FuelFinding, error) {
+	var findings []FuelFinding
+	err := DB.Where("start_time >= ? AND start_time < ?", from, to).Order("truck_transics_id asc, start_time asc").Find(&findings).Error
+	if err != nil {
+		return nil, errors.Wrap(err, ErrorDB)
+	}
+
+	return findings, nil
+}
+
+//ReplaceFuelFindings replaces the findings started during a period by the given ones in a single transaction
+func ReplaceFuelFindings(from, to time.Time, findings []FuelFinding) error {
+	return inTransaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Where("start_time >= ? AND start_time < ?", from, to).Delete(&FuelFinding{}).Error
+		if err != nil {
+			return errors.Wrap(err, ErrorDB)
+		}
+
+		for i := range findings {
+			if err := tx.Create(&findings[i]).Error; err != nil {
+				return errors.Wrap(err, ErrorDB)
+			}
+		}
+
+		return nil
+	})
+}
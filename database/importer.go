@@ -0,0 +1,158 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+	"tx2db/txtango"
+)
+
+//Import phases
+const (
+	PhaseDrivers = "drivers"
+	PhaseTrucks  = "trucks"
+	PhaseTours   = "tours"
+	PhaseTacho   = "tacho"
+	PhaseQueue   = "queue"
+)
+
+//Importer runs the different phases of an import from Transics
+type Importer struct {
+	TX *txtango.Client
+	//Workers is the number of tours, or drivers, imported at the same time
+	Workers int
+	//IgnoreLastImport reimports every tour from its start, and the tachograph activities since the first tour of the drivers
+	IgnoreLastImport bool
+	//QueueOnly skips the tours import and only imports the queue
+	QueueOnly bool
+}
+
+//PhaseResult is the outcome of an import phase
+type PhaseResult struct {
+	Name     string
+	Duration time.Duration
+	Skipped  bool
+	Err      error
+}
+
+//ImportReport contains the result of every phase of an import
+type ImportReport struct {
+	Phases []PhaseResult
+}
+
+//NewImporter creates an importer using the given TX-TANGO client
+func NewImporter(txClient *txtango.Client, workers int) *Importer {
+	return &Importer{TX: txClient, Workers: workers}
+}
+
+//Run imports drivers and trucks concurrently, then the tours data and the tachograph activities of the drivers
+//the first failing phase cancels the phases running next to it, the tours are only imported when both succeeded
+func (i *Importer) Run(ctx context.Context) *ImportReport {
+	report := &ImportReport{}
+
+	//drivers and trucks are independent, import them concurrently
+	groupCtx, cancel := context.WithCancel(ctx)
+	var (
+		wg      sync.WaitGroup
+		results = make([]PhaseResult, 2)
+	)
+	for n, phase := range []struct {
+		name string
+		run  func(context.Context, *txtango.Client) error
+	}{
+		{PhaseDrivers, ImportDrivers},
+		{PhaseTrucks, ImportTrucks},
+	} {
+		wg.Add(1)
+		go func(n int, name string, run func(context.Context, *txtango.Client) error) {
+			defer wg.Done()
+			results[n] = runPhase(name, func() error {
+				return run(groupCtx, i.TX)
+			})
+			if results[n].Err != nil {
+				cancel()
+			}
+		}(n, phase.name, phase.run)
+	}
+	wg.Wait()
+	cancel()
+	report.Phases = append(report.Phases, results...)
+
+	//import tours data only when drivers and trucks are up to date
+	name := PhaseTours
+	if i.QueueOnly {
+		name = PhaseQueue
+	}
+	if report.Err() != nil || ctx.Err() != nil {
+		report.Phases = append(report.Phases, PhaseResult{Name: name, Skipped: true, Err: ctx.Err()})
+		if !i.QueueOnly {
+			report.Phases = append(report.Phases, PhaseResult{Name: PhaseTacho, Skipped: true, Err: ctx.Err()})
+		}
+		return report
+	}
+
+	report.Phases = append(report.Phases, runPhase(name, func() error {
+		if i.QueueOnly {
+			return ImportQueuedToursData(ctx, i.TX, true)
+		}
+		return ImportToursData(ctx, i.TX, i.IgnoreLastImport, i.Workers)
+	}))
+	if i.QueueOnly {
+		return report
+	}
+
+	//the tachograph activities do not depend on the tours data, a failed tour does not skip them
+	if ctx.Err() != nil {
+		report.Phases = append(report.Phases, PhaseResult{Name: PhaseTacho, Skipped: true, Err: ctx.Err()})
+		return report
+	}
+	report.Phases = append(report.Phases, runPhase(PhaseTacho, func() error {
+		return ImportTachoData(ctx, i.TX, i.IgnoreLastImport, i.Workers)
+	}))
+
+	return report
+}
+
+//runPhase runs and times an import phase
+func runPhase(name string, run func() error) PhaseResult {
+	log.Printf("Starting %s import\n", name)
+	start := time.Now()
+	err := run()
+
+	return PhaseResult{Name: name, Duration: time.Since(start), Err: err}
+}
+
+//Err returns the errors of every failed phase, nil if the import succeeded
+func (r *ImportReport) Err() error {
+	var failed []string
+	for _, phase := range r.Phases {
+		if phase.Err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", phase.Name, phase.Err))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("Import failed (%s)", strings.Join(failed, "; "))
+}
+
+//String summarises the import report, one line per phase
+func (r *ImportReport) String() string {
+	var lines []string
+	for _, phase := range r.Phases {
+		status := "OK"
+		switch {
+		case phase.Skipped:
+			status = "SKIPPED"
+		case phase.Err != nil:
+			status = fmt.Sprintf("FAILED - %v", phase.Err)
+		}
+		lines = append(lines, fmt.Sprintf("%-8s %-10s %s", phase.Name, phase.Duration.Round(time.Millisecond), status))
+	}
+
+	return strings.Join(lines, "\n")
+}
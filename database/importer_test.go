@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+	"tx2db/txtango/txtangotest"
+)
+
+func TestImporterRun(t *testing.T) {
+	tests := []struct {
+		name string
+		//setup makes Transics fail, the import stops before writing anything
+		setup       func(server *txtangotest.Server, cancel context.CancelFunc)
+		queueOnly   bool
+		wantPhases  []string
+		wantFailed  []string
+		wantSkipped []string
+	}{
+		{
+			name: "failed drivers skip the tours",
+			setup: func(server *txtangotest.Server, cancel context.CancelFunc) {
+				//the drivers fail after the trucks have been imported
+				server.SetDelay(txtangotest.GetDrivers, 100*time.Millisecond)
+				server.SetHTTPStatus(txtangotest.GetDrivers, http.StatusServiceUnavailable)
+			},
+			wantPhases:  []string{PhaseDrivers, PhaseTrucks, PhaseTours, PhaseTacho},
+			wantFailed:  []string{PhaseDrivers},
+			wantSkipped: []string{PhaseTours, PhaseTacho},
+		},
+		{
+			name: "failed trucks cancel the drivers",
+			setup: func(server *txtangotest.Server, cancel context.CancelFunc) {
+				server.SetDelay(txtangotest.GetDrivers, 10*time.Second)
+				server.SetFault(txtangotest.GetVehicles, &txtangotest.Fault{Code: "soap:Server", String: "Internal error"})
+			},
+			wantPhases:  []string{PhaseDrivers, PhaseTrucks, PhaseTours, PhaseTacho},
+			wantFailed:  []string{PhaseDrivers, PhaseTrucks},
+			wantSkipped: []string{PhaseTours, PhaseTacho},
+		},
+		{
+			name: "cancelled import",
+			setup: func(server *txtangotest.Server, cancel context.CancelFunc) {
+				cancel()
+			},
+			queueOnly:   true,
+			wantPhases:  []string{PhaseDrivers, PhaseTrucks, PhaseQueue},
+			wantFailed:  []string{PhaseDrivers, PhaseTrucks, PhaseQueue},
+			wantSkipped: []string{PhaseQueue},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := txtangotest.NewServer()
+			defer server.Close()
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			tt.setup(server, cancel)
+
+			importer := NewImporter(client, 2)
+			importer.QueueOnly = tt.queueOnly
+			start := time.Now()
+			report := importer.Run(ctx)
+			if time.Since(start) > 5*time.Second {
+				t.Errorf("the import took %v, want the failure to cancel the other phases", time.Since(start))
+			}
+
+			var phases, failed, skipped []string
+			for _, phase := range report.Phases {
+				phases = append(phases, phase.Name)
+				if phase.Err != nil {
+					failed = append(failed, phase.Name)
+				}
+				if phase.Skipped {
+					skipped = append(skipped, phase.Name)
+				}
+			}
+			if strings.Join(phases, ",") != strings.Join(tt.wantPhases, ",") {
+				t.Errorf("got phases %v, want %v", phases, tt.wantPhases)
+			}
+			if strings.Join(failed, ",") != strings.Join(tt.wantFailed, ",") {
+				t.Errorf("got failed phases %v, want %v", failed, tt.wantFailed)
+			}
+			if strings.Join(skipped, ",") != strings.Join(tt.wantSkipped, ",") {
+				t.Errorf("got skipped phases %v, want %v", skipped, tt.wantSkipped)
+			}
+			if report.Err() == nil {
+				t.Error("got no error, want the import to fail")
+			}
+		})
+	}
+}
+
+func TestImportReport(t *testing.T) {
+	report := &ImportReport{Phases: []PhaseResult{
+		{Name: PhaseDrivers, Duration: 1500 * time.Microsecond},
+		{Name: PhaseTrucks, Duration: time.Second, Err: errors.New("timeout")},
+		{Name: PhaseTours, Skipped: true},
+	}}
+
+	if got, want := report.Err().Error(), "Import failed (trucks: timeout)"; got != want {
+		t.Errorf("got error %q, want %q", got, want)
+	}
+
+	want := "drivers  2ms        OK\ntrucks   1s         FAILED - timeout\ntours    0s         SKIPPED"
+	if got := report.String(); got != want {
+		t.Errorf("got report\n%s\nwant\n%s", got, want)
+	}
+
+	if err := (&ImportReport{Phases: []PhaseResult{{Name: PhaseDrivers}}}).Err(); err != nil {
+		t.Errorf("got error %v, want none", err)
+	}
+}
@@ -0,0 +1,413 @@
+package database
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+//Migration is a numbered change of the database schema
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(tx *gorm.DB) error
+	Down    func(tx *gorm.DB) error
+}
+
+//SchemaMigration is a migration applied to the database
+type SchemaMigration struct {
+	Version   int `gorm:"primary_key;auto_increment:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+//TableName sets the table name of the applied migrations
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+//MigrationState is a migration and when it has been applied, AppliedAt is nil when pending
+type MigrationState struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+//uniqueIndex is an unique index added on an existing table
+type uniqueIndex struct {
+	model   interface{}
+	table   string
+	name    string
+	columns []string
+}
+
+//uniqueIndexes are the keys the importer relies on to find existing rows
+var uniqueIndexes = []uniqueIndex{
+	{&Driver{}, "drivers", "idx_drivers_transics_id", []string{"transics_id"}},
+	{&Truck{}, "trucks", "idx_trucks_transics_id", []string{"transics_id"}},
+	{&Trailer{}, "trailers", "idx_trailers_transics_id", []string{"transics_id"}},
+	{&TruckActivityReport{}, "truck_activity_reports", "idx_truck_activity_reports_tour_start", []string{"tour_id", "start_time"}},
+	{&DriverEcoMonitorReport{}, "driver_eco_monitor_reports", "idx_driver_eco_monitor_reports_tour_start", []string{"tour_id", "start_time"}},
+}
+
+//migrations contains every migration of the schema, ordered by version
+//a migration must never be changed once released, add a new one instead
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "create tables",
+		//tables were created by AutoMigrate before migrations existed, AutoMigrate keeps them as they are
+		//the tables are frozen copies of the models (see migrations_v1.go), the models get the columns of the later migrations
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&driverV1{}, &driverEcoMonitorReportV1{}, &truckV1{}, &truckGroupV1{}, &truckActivityReportV1{}, &trailerV1{}, &tourV1{}, &tourQueueV1{}).Error
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists(&tourQueueV1{}, &tourV1{}, &trailerV1{}, &truckActivityReportV1{}, &truckGroupV1{}, &truckV1{}, &driverEcoMonitorReportV1{}, &driverV1{}).Error
+		},
+	},
+	{
+		Version: 2,
+		Name:    "add unique indexes",
+		Up: func(tx *gorm.DB) error {
+			for _, index := range uniqueIndexes {
+				//remove duplicated rows first, the oldest row is kept
+				columns := strings.Join(index.columns, ", ")
+				result := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE id NOT IN (SELECT MIN(id) FROM %s GROUP BY %s)", index.table, index.table, columns))
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected > 0 {
+					log.Printf("Removed %d duplicated rows from %s\n", result.RowsAffected, index.table)
+				}
+
+				err := tx.Model(index.model).AddUniqueIndex(index.name, index.columns...).Error
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Down: func(tx *gorm.DB) error {
+			for _, index := range uniqueIndexes {
+				err := tx.Model(index.model).RemoveIndex(index.name).Error
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version: 3,
+		Name:    "add the truck of the eco monitor reports",
+		//the existing reports get the truck of their tour
+		Up: func(tx *gorm.DB) error {
+			err := addColumn(tx, &DriverEcoMonitorReport{}, "TruckTransicsID")
+			if err == nil {
+				err = tx.Exec("UPDATE driver_eco_monitor_reports SET truck_transics_id = (SELECT truck_transics_id FROM tours WHERE tours.id = driver_eco_monitor_reports.tour_id)").Error
+			}
+			return err
+		},
+		Down: func(tx *gorm.DB) error {
+			return dropColumn(tx, &DriverEcoMonitorReport{}, "TruckTransicsID")
+		},
+	},
+	{
+		Version: 4,
+		Name:    "add driver assignments",
+		Up: func(tx *gorm.DB) error {
+			err := tx.CreateTable(&DriverAssignment{}).Error
+			if err == nil {
+				err = tx.Model(&DriverAssignment{}).AddIndex("idx_driver_assignments_truck_start", "truck_transics_id", "start_time").Error
+			}
+			if err == nil {
+				err = tx.Model(&DriverAssignment{}).AddIndex("idx_driver_assignments_driver_start", "driver_transics_id", "start_time").Error
+			}
+			if err == nil {
+				err = addColumn(tx, &TruckActivityReport{}, "DriverTransicsID")
+			}
+			return err
+		},
+		Down: func(tx *gorm.DB) error {
+			err := dropColumn(tx, &TruckActivityReport{}, "DriverTransicsID")
+			if err != nil {
+				return err
+			}
+			return tx.DropTableIfExists(&DriverAssignment{}).Error
+		},
+	},
+	{
+		Version: 5,
+		Name:    "queue backoff and dead letters",
+		//an element is a day of a report of a tour, or of the tachograph activities of a driver
+		Up: func(tx *gorm.DB) error {
+			err := addColumn(tx, &TourQueue{}, "Status")
+			if err == nil {
+				err = addColumn(tx, &TourQueue{}, "NextAttemptAt")
+			}
+			if err == nil {
+				err = addColumn(tx, &TourQueue{}, "DriverTransicsID")
+			}
+			//existing elements keep being retried 3 days after their day, as before
+			if err == nil {
+				err = tx.Exec("UPDATE tour_queues SET status = ?, driver_transics_id = 0, next_attempt_at = "+sqlAddDays("import_on", 3), QueueStatusPending).Error
+			}
+			//the same day queued several times is kept once, the oldest row is kept
+			if err == nil {
+				err = tx.Exec("DELETE FROM tour_queues WHERE id NOT IN (SELECT MIN(id) FROM tour_queues GROUP BY tour_id, report_type, import_on)").Error
+			}
+			if err == nil {
+				err = tx.Model(&TourQueue{}).AddUniqueIndex("idx_tour_queues_owner_report_day", "tour_id", "driver_transics_id", "report_type", "import_on").Error
+			}
+			if err == nil {
+				err = tx.Model(&TourQueue{}).AddIndex("idx_tour_queues_status_next_attempt", "status", "next_attempt_at").Error
+			}
+			return err
+		},
+		Down: func(tx *gorm.DB) error {
+			err := tx.Model(&TourQueue{}).RemoveIndex("idx_tour_queues_status_next_attempt").Error
+			if err == nil {
+				err = tx.Model(&TourQueue{}).RemoveIndex("idx_tour_queues_owner_report_day").Error
+			}
+			if err == nil {
+				err = dropColumn(tx, &TourQueue{}, "DriverTransicsID")
+			}
+			if err == nil {
+				err = dropColumn(tx, &TourQueue{}, "NextAttemptAt")
+			}
+			if err == nil {
+				err = dropColumn(tx, &TourQueue{}, "Status")
+			}
+			return err
+		},
+	},
+	{
+		Version: 6,
+		Name:    "add vehicle snapshots",
+		Up: func(tx *gorm.DB) error {
+			err := tx.CreateTable(&VehicleSnapshot{}).Error
+			if err == nil {
+				err = tx.Model(&VehicleSnapshot{}).AddIndex("idx_vehicle_snapshots_truck_taken", "truck_transics_id", "taken_at").Error
+			}
+			return err
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists(&VehicleSnapshot{}).Error
+		},
+	},
+	{
+		Version: 7,
+		Name:    "add driver activity periods",
+		Up: func(tx *gorm.DB) error {
+			err := tx.CreateTable(&DriverActivityPeriod{}).Error
+			if err == nil {
+				err = tx.Model(&DriverActivityPeriod{}).AddUniqueIndex("idx_driver_activity_periods_driver_start", "driver_transics_id", "start_time").Error
+			}
+			return err
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists(&DriverActivityPeriod{}).Error
+		},
+	},
+	{
+		Version: 8,
+		Name:    "add compliance violations",
+		Up: func(tx *gorm.DB) error {
+			err := tx.CreateTable(&ComplianceViolation{}).Error
+			if err == nil {
+				err = tx.Model(&ComplianceViolation{}).AddIndex("idx_compliance_violations_driver_start", "driver_transics_id", "start_time").Error
+			}
+			return err
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists(&ComplianceViolation{}).Error
+		},
+	},
+	{
+		Version: 9,
+		Name:    "add fuel findings",
+		Up: func(tx *gorm.DB) error {
+			err := tx.CreateTable(&FuelFinding{}).Error
+			if err == nil {
+				err = tx.Model(&FuelFinding{}).AddIndex("idx_fuel_findings_truck_start", "truck_transics_id", "start_time").Error
+			}
+			return err
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists(&FuelFinding{}).Error
+		},
+	},
+	{
+		Version: 10,
+		Name:    "add driver scores",
+		Up: func(tx *gorm.DB) error {
+			err := tx.CreateTable(&DriverScore{}).Error
+			if err == nil {
+				err = tx.Model(&DriverScore{}).AddIndex("idx_driver_scores_driver_period", "driver_transics_id", "period_end").Error
+			}
+			return err
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.DropTableIfExists(&DriverScore{}).Error
+		},
+	},
+}
+
+//addColumn adds the column of a model field when missing
+func addColumn(tx *gorm.DB, model interface{}, fieldName string) error {
+	scope := tx.NewScope(model)
+	field, ok := scope.FieldByName(fieldName)
+	if !ok {
+		return errors.Errorf("Unknown field %s", fieldName)
+	}
+	if tx.Dialect().HasColumn(scope.TableName(), field.DBName) {
+		return nil
+	}
+
+	return tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD %s %s", scope.QuotedTableName(), scope.Quote(field.DBName), tx.Dialect().DataTypeOf(field.StructField))).Error
+}
+
+//dropColumn drops the column of a model field, sqlite cannot drop columns so it keeps them
+func dropColumn(tx *gorm.DB, model interface{}, fieldName string) error {
+	if tx.Dialect().GetName() == DriverSQLite {
+		log.Printf("Column of %s kept, sqlite cannot drop columns\n", fieldName)
+		return nil
+	}
+
+	scope := tx.NewScope(model)
+	field, ok := scope.FieldByName(fieldName)
+	if !ok {
+		return errors.Errorf("Unknown field %s", fieldName)
+	}
+
+	return tx.Model(model).DropColumn(field.DBName).Error
+}
+
+//appliedMigrations returns the applied migrations by version
+func appliedMigrations() (map[int]SchemaMigration, error) {
+	//the table of the applied migrations is the only one created outside of a migration
+	if !DB.HasTable(&SchemaMigration{}) {
+		err := DB.CreateTable(&SchemaMigration{}).Error
+		if err != nil {
+			return nil, errors.Wrap(err, "Cannot create schema_migrations table")
+		}
+	}
+
+	var rows []SchemaMigration
+	err := DB.Order("version asc").Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]SchemaMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+
+	return applied, nil
+}
+
+//MigrationStatus returns every migration and whether it has been applied
+func MigrationStatus() ([]MigrationState, error) {
+	applied, err := appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]MigrationState, len(migrations))
+	for i, migration := range migrations {
+		states[i].Migration = migration
+		if row, ok := applied[migration.Version]; ok {
+			appliedAt := row.AppliedAt
+			states[i].AppliedAt = &appliedAt
+		}
+	}
+
+	return states, nil
+}
+
+//PendingMigrations returns the number of migrations not applied yet
+func PendingMigrations() (int, error) {
+	states, err := MigrationStatus()
+	if err != nil {
+		return 0, err
+	}
+
+	var pending int
+	for _, state := range states {
+		if state.AppliedAt == nil {
+			pending++
+		}
+	}
+
+	return pending, nil
+}
+
+//MigrateUp applies the pending migrations up to the given version, every migration when version is 0
+func MigrateUp(version int) ([]Migration, error) {
+	applied, err := appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range migrations {
+		if version > 0 && migration.Version > version {
+			break
+		}
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+
+		log.Printf("Applying migration %d (%s)\n", migration.Version, migration.Name)
+		err = runMigration(migration.Up, func(tx *gorm.DB) error {
+			return tx.Create(&SchemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return done, errors.Wrapf(err, "Migration %d (%s) failed", migration.Version, migration.Name)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+//MigrateDown reverts the given number of applied migrations, latest first
+func MigrateDown(steps int) ([]Migration, error) {
+	applied, err := appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		migration := migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+
+		log.Printf("Reverting migration %d (%s)\n", migration.Version, migration.Name)
+		err = runMigration(migration.Down, func(tx *gorm.DB) error {
+			return tx.Where("version = ?", migration.Version).Delete(&SchemaMigration{}).Error
+		})
+		if err != nil {
+			return done, errors.Wrapf(err, "Reverting migration %d (%s) failed", migration.Version, migration.Name)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+//runMigration runs a migration step and records it in the same transaction
+func runMigration(step func(tx *gorm.DB) error, record func(tx *gorm.DB) error) error {
+	return inTransaction(func(tx *gorm.DB) error {
+		if err := step(tx); err != nil {
+			return err
+		}
+		return record(tx)
+	})
+}
@@ -0,0 +1,92 @@
+package database_test
+
+import (
+	"testing"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+)
+
+func TestMigrations(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	pending, err := database.PendingMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending != 0 {
+		t.Fatalf("got %d pending migrations, want 0", pending)
+	}
+	states, err := database.MigrationStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, state := range states {
+		if state.AppliedAt == nil {
+			t.Errorf("migration %d not applied", state.Version)
+		}
+	}
+
+	//every migration can be reverted and applied again
+	reverted, err := database.MigrateDown(len(states))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reverted) != len(states) {
+		t.Fatalf("reverted %d migrations, want %d", len(reverted), len(states))
+	}
+	if database.DB.HasTable(&database.Tour{}) {
+		t.Error("tours table kept after reverting every migration")
+	}
+	if pending, err := database.PendingMigrations(); err != nil || pending != len(states) {
+		t.Errorf("got %d pending migrations (%v), want %d", pending, err, len(states))
+	}
+
+	applied, err := database.MigrateUp(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != len(states) {
+		t.Fatalf("applied %d migrations, want %d", len(applied), len(states))
+	}
+}
+
+func TestUniqueIndexesRemoveDuplicates(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	states, err := database.MigrationStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := database.MigrateDown(len(states)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := database.MigrateUp(1); err != nil {
+		t.Fatal(err)
+	}
+
+	//the tables created by AutoMigrate may contain the same driver several times
+	for _, name := range []string{"first", "second"} {
+		if err := database.DB.Create(&database.Driver{TransicsID: 1, Name: name}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if database.DB.Dialect().HasIndex("drivers", "idx_drivers_transics_id") {
+		t.Error("unique index created by the first migration")
+	}
+
+	if _, err := database.MigrateUp(2); err != nil {
+		t.Fatal(err)
+	}
+
+	//the oldest row is kept
+	var drivers []database.Driver
+	if err := database.DB.Find(&drivers).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(drivers) != 1 || drivers[0].Name != "first" {
+		t.Errorf("got drivers %+v, want the first one", drivers)
+	}
+	if err := database.DB.Create(&database.Driver{TransicsID: 1, Name: "third"}).Error; err == nil {
+		t.Error("got no error, want the unique index to refuse the driver")
+	}
+}
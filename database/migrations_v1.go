@@ -0,0 +1,173 @@
+package database
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+//the models below are the tables created by migration 1, as they were before the migrations existed
+//they must never change, the columns added since belong to later migrations
+//the associations are left out as AutoMigrate creates no foreign key
+
+//driverV1 is the drivers table of migration 1
+type driverV1 struct {
+	gorm.Model
+	TransicsID   uint
+	PersonID     string //identifier used within bolk and not by transics
+	Name         string
+	Email        string //email is manually filled as not present in transics
+	Language     string
+	Inactive     bool
+	LastModified time.Time
+}
+
+func (driverV1) TableName() string {
+	return "drivers"
+}
+
+//driverEcoMonitorReportV1 is the driver_eco_monitor_reports table of migration 1
+type driverEcoMonitorReportV1 struct {
+	gorm.Model
+	TourID                                             uint
+	DriverTransicsID                                   uint
+	Distance                                           float32
+	DurationDriving                                    float32
+	FuelConsumption                                    float32
+	FuelConsumptionAverage                             float32
+	RpmAverage                                         float32
+	EmissionAverage                                    float32
+	SpeedAverage                                       float32
+	FuelConsumptionIdling                              float32
+	DurationIdling                                     float32
+	NumberIdling                                       int
+	DurationOverSpeeding                               float32
+	NumberOverSpeeding                                 int
+	DistanceCoasting                                   float32
+	DurationCoasting                                   float32
+	NumberOfStops                                      int
+	NumberOfBrakes                                     int
+	NumberOfPanicBrakes                                int
+	DistanceByBrakes                                   float32
+	DurationByBrakes                                   float32
+	DurationByRetarder                                 float32
+	DurationHighRPMnoFuel                              float32
+	DurationHighRPM                                    float32
+	NumberOfHarshAccelerations                         int
+	DurationHarshAcceleration                          float32
+	DistanceGreenSpot                                  float32
+	DurationGreenSpot                                  float32
+	FuelConsumptionGreenSpot                           float32
+	NumberOfGearChanges                                int
+	NumberOfGearChangesUp                              int
+	PositionOfThrottleAverage                          float32
+	PositionOfThrottleMaximum                          float32
+	NumberOfPto                                        int
+	FuelConsumptionPtoDriving                          float32
+	FuelConsumptionPtoStandStill                       float32
+	DurationPtoDriving                                 float32
+	DurationPtoStandStill                              float32
+	DistanceOnCruiseControl                            float32
+	DurationOnCruiseControl                            float32
+	AvgFuelConsumptionCruiseControlInLiterPerHundredKm float32
+	AvgFuelConsumptionCruiseControlInkmPerLiter        float32
+	StartTime                                          time.Time
+	EndTime                                            time.Time
+}
+
+func (driverEcoMonitorReportV1) TableName() string {
+	return "driver_eco_monitor_reports"
+}
+
+//truckV1 is the trucks table of migration 1
+type truckV1 struct {
+	gorm.Model
+	TruckGroupID uint
+	TransicsID   uint
+	LicensePlate string
+	Inactive     bool
+	LastModified time.Time
+}
+
+func (truckV1) TableName() string {
+	return "trucks"
+}
+
+//truckGroupV1 is the truck_groups table of migration 1
+type truckGroupV1 struct {
+	gorm.Model
+	Name string
+}
+
+func (truckGroupV1) TableName() string {
+	return "truck_groups"
+}
+
+//truckActivityReportV1 is the truck_activity_reports table of migration 1
+type truckActivityReportV1 struct {
+	gorm.Model
+	TruckTransicsID uint
+	TourID          uint
+	KmBegin         int
+	KmEnd           int
+	Consumption     float32
+	LoadedStatus    string
+	Activity        string
+	SpeedAvg        float32
+	Longitude       float32
+	Latitude        float32
+	AddressInfo     string
+	CountryCode     string
+	Reference       string
+	StartTime       time.Time
+	EndTime         time.Time
+}
+
+func (truckActivityReportV1) TableName() string {
+	return "truck_activity_reports"
+}
+
+//trailerV1 is the trailers table of migration 1
+type trailerV1 struct {
+	gorm.Model
+	TransicsID   uint
+	LicensePlate string
+}
+
+func (trailerV1) TableName() string {
+	return "trailers"
+}
+
+//tourV1 is the tours table of migration 1
+type tourV1 struct {
+	gorm.Model
+	DriverTransicsID     uint
+	TruckTransicsID      uint
+	TrailerTransicsID    uint
+	DestinationLongitude float32
+	DestinationLatitude  float32
+	Status               string
+	StartTime            time.Time
+	EndTime              time.Time `sql:"default: null"`
+	LastImport           time.Time `sql:"default: null"`
+}
+
+func (tourV1) TableName() string {
+	return "tours"
+}
+
+//tourQueueV1 is the tour_queues table of migration 1
+type tourQueueV1 struct {
+	gorm.Model
+	TourID         uint
+	ReportType     string // should only be tar or emr
+	ImportOn       time.Time
+	Reason         string
+	ReasonCode     string //TX-TANGO error code or kind of failure
+	ReasonCategory string //category of the failure, see txtango.ErrorCategory
+	Trial          int    //number of time the element of the queue has been tried to be imported
+}
+
+func (tourQueueV1) TableName() string {
+	return "tour_queues"
+}
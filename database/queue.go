@@ -1,101 +1,320 @@
 package database
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"time"
+	"tx2db/txtango"
 
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
 )
 
 const (
-	reasonQueueNoData = "No data found during import"
-	tar               = "tar" // Truck Activity Report
-	emr               = "emr" // Eco Monitor Report
+	tar = "tar" // Truck Activity Report
+	emr = "emr" // Eco Monitor Report
+	tdp = "tdp" // Tacho Driver activity Periods
 )
 
+//queueReason describes why a tour has been added to the queue
+type queueReason struct {
+	Code     string
+	Category string
+	Message  string
+}
+
+//reasonQueueNoData is used when Transics answered without any data
+var reasonQueueNoData = queueReason{
+	Code:     "NO_DATA",
+	Category: string(txtango.CategoryNoData),
+	Message:  "No data found during import",
+}
+
+//Status of an element of the queue
+const (
+	QueueStatusPending = "pending" //waiting for its next attempt
+	QueueStatusDead    = "dead"    //too many attempts, only retried manually
+)
+
+//QueuePolicy defines when the elements of the queue are retried
+type QueuePolicy struct {
+	//BaseDelay is the delay before the first attempt, doubled after every failed attempt
+	BaseDelay time.Duration
+	//MaxDelay caps the delay between two attempts
+	MaxDelay time.Duration
+	//MaxAttempts is the number of failed attempts before an element is dead
+	MaxAttempts int
+}
+
+//DefaultQueuePolicy retries an element for about two weeks, Transics data can arrive days late
+var DefaultQueuePolicy = QueuePolicy{
+	BaseDelay:   6 * time.Hour,
+	MaxDelay:    72 * time.Hour,
+	MaxAttempts: 8,
+}
+
 //TourQueue represents the database tour queue
+//an element is a day of a report of a tour, or of the tachograph activities of a driver, which could not be imported
 type TourQueue struct {
 	gorm.Model
-	TourID     uint
-	ReportType string // should only be tar or emr
-	ImportOn   time.Time
-	Reason     string
-	Trial      int //number of time the element of the queue has been tried to be imported
+	TourID           uint   //0 for the tachograph activities
+	DriverTransicsID uint   //driver of the tachograph activities, 0 for the reports of a tour
+	ReportType       string // should only be tar, emr or tdp
+	ImportOn         time.Time
+	Reason           string
+	ReasonCode       string //TX-TANGO error code or kind of failure
+	ReasonCategory   string //category of the failure, see txtango.ErrorCategory
+	Trial            int    //number of time the element of the queue has been tried to be imported
+	Status           string //pending or dead
+	NextAttemptAt    time.Time
+}
+
+//nextAttempt returns when to retry an element which failed the given number of times
+func (p QueuePolicy) nextAttempt(trial int, now time.Time) time.Time {
+	delay := p.BaseDelay
+	for i := 0; i < trial && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	return now.Add(delay)
+}
+
+//queueDay returns the day of the queue of a report import
+func queueDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 }
 
 //ImportQueuedToursData imports the data from the queue
-func ImportQueuedToursData(handleError bool) error {
+//only the pending elements due for a new attempt are imported, oldest day first
+func ImportQueuedToursData(ctx context.Context, txClient *txtango.Client, handleError bool) error {
 	var queue []TourQueue
 
-	//get only element from queue where the import_on date is older than 3 days and older date first
-	DB.Where("? > import_on", time.Now().AddDate(0, 0, -3)).Order("import_on asc").Find(&queue)
+	err := DB.Where("status = ? AND next_attempt_at <= ?", QueueStatusPending, time.Now()).Order("import_on asc").Find(&queue).Error
+	if err != nil {
+		return errors.Wrap(err, ErrorDB)
+	}
 
 	log.Println("Checking & importing tour from queue")
 	for i, data := range queue {
-		var tour Tour
-
-		log.Printf("(%d / %d) Checking & importing tour from queue\n", i+1, len(queue))
-		err := DB.Model(&tour).Where("id = ?", data.TourID).First(&tour).Error
-		if err != nil {
-			//if a tour of the queue cannot be gotten, skip it
-			continue
+		//stop when the import is cancelled
+		if err := ctx.Err(); err != nil {
+			return err
 		}
 
-		//caculate elapsed time between last import and day to import
-		diff := int(tour.LastImport.Sub(data.ImportOn).Hours() / 24)
+		log.Printf("(%d / %d) Checking & importing tour from queue\n", i+1, len(queue))
+		if data.ReportType == tdp {
+			//the tachograph activities are imported by driver
+			_, err = importTachoDay(ctx, txClient, data.DriverTransicsID, data.ImportOn)
+		} else {
+			var tour Tour
+			err = DB.Model(&tour).Where("id = ?", data.TourID).First(&tour).Error
+			if err != nil {
+				//the tour does not exist anymore (rebuilt tours), nothing to import
+				if err := failQueueItem(&data, queueReason{Code: "TOUR_NOT_FOUND", Category: string(txtango.CategoryInvalidRequest), Message: err.Error()}, true); err != nil {
+					return err
+				}
+				continue
+			}
 
-		switch data.ReportType {
-		case emr:
-			err = importEcoMoniorReport(&tour, diff)
-		case tar:
-			err = importActivityReport(&tour, diff)
+			switch data.ReportType {
+			case emr:
+				_, err = importEcoMoniorReport(ctx, txClient, &tour, data.ImportOn)
+			case tar:
+				_, err = importActivityReport(ctx, txClient, &tour, data.ImportOn)
+			}
 		}
 		if err != nil {
 			log.Printf("ERROR: %s\n", err)
+			//the error has not been queued again, count it as a failed attempt
+			if err := failQueueItem(&data, reasonFromError(err), false); err != nil {
+				return err
+			}
 			if handleError {
 				return err
 			}
+			continue
 		}
 
+		//an imported day has left the queue, a day still there has been queued again
 		var newQueue TourQueue
-		err = DB.Model(&TourQueue{}).Where(TourQueue{TourID: data.TourID}).First(&newQueue).Error
+		err = DB.Where("id = ?", data.ID).First(&newQueue).Error
+		if err == gorm.ErrRecordNotFound {
+			continue
+		}
 		if err != nil {
+			return errors.Wrap(err, ErrorDB)
+		}
+		if err := failQueueItem(&newQueue, queueReason{Code: newQueue.ReasonCode, Category: newQueue.ReasonCategory, Message: newQueue.Reason}, false); err != nil {
 			return err
 		}
+	}
 
-		//element of the queue has been fetched, remove it permanently
-		if data.Trial == newQueue.Trial {
-			DB.Unscoped().Where(data).Delete(&TourQueue{})
-		}
+	return nil
+}
 
+//queueOnTransicsError adds a tour to the queue when a Transics call failed temporarily
+//other errors (SOAP faults, bad requests) are returned to the caller
+func queueOnTransicsError(tour *Tour, importOn time.Time, reportType string, err error) error {
+	return queueItemOnTransicsError(tourQueueItem(tour, importOn, reportType), err)
+}
+
+//queueItemOnTransicsError adds an element to the queue when a Transics call failed temporarily
+func queueItemOnTransicsError(item TourQueue, err error) error {
+	if !txtango.IsTemporary(err) {
+		return err
 	}
+	log.Printf("ERROR: %s\n", err)
 
-	return nil
+	return addToQueue(item, reasonFromError(err))
 }
 
-//addTourToQueue add tours into the tour queue
-func addTourToQueue(tour *Tour, importOn time.Time, reportType, reason string) error {
+//reasonFromError builds a structured queue reason from a failed Transics call
+func reasonFromError(err error) queueReason {
+	var apiErr *txtango.APIError
+	var httpErr *txtango.HTTPError
+	var transportErr *txtango.TransportError
+
+	switch {
+	case errors.As(err, &apiErr):
+		//keep the TX-TANGO error code of the main error
+		first := apiErr.First()
+		return queueReason{
+			Code:     first.Code,
+			Category: string(first.Category()),
+			Message:  first.CodeExplenation,
+		}
+	case errors.As(err, &httpErr):
+		return queueReason{
+			Code:     fmt.Sprintf("HTTP_%d", httpErr.StatusCode),
+			Category: string(txtango.CategoryServer),
+			Message:  err.Error(),
+		}
+	case errors.As(err, &transportErr):
+		return queueReason{Code: "TRANSPORT", Category: "transport", Message: err.Error()}
+	}
+
+	return queueReason{Code: "UNKNOWN", Category: string(txtango.CategoryUnknown), Message: err.Error()}
+}
+
+//addTourToQueue add a day of a report of a tour into the tour queue
+func addTourToQueue(tour *Tour, importOn time.Time, reportType string, reason queueReason) error {
+	return addToQueue(tourQueueItem(tour, importOn, reportType), reason)
+}
+
+//tourQueueItem returns the element of the queue of a day of a report of a tour
+func tourQueueItem(tour *Tour, importOn time.Time, reportType string) TourQueue {
+	return TourQueue{TourID: tour.ID, ReportType: reportType, ImportOn: queueDay(importOn)}
+}
+
+//driverQueueItem returns the element of the queue of a day of the tachograph activities of a driver
+func driverQueueItem(driverID uint, importOn time.Time) TourQueue {
+	return TourQueue{DriverTransicsID: driverID, ReportType: tdp, ImportOn: queueDay(importOn)}
+}
+
+//addToQueue adds an element into the tour queue
+//a pending day already in the queue only gets the new reason, the attempts are counted by ImportQueuedToursData
+func addToQueue(item TourQueue, reason queueReason) error {
 	var tourQueue TourQueue
-	data := &TourQueue{
-		TourID:     tour.ID,
-		ReportType: reportType,
-		ImportOn:   importOn,
-		Reason:     reason,
+	err := DB.Where("tour_id = ? AND driver_transics_id = ? AND report_type = ? AND import_on = ?", item.TourID, item.DriverTransicsID, item.ReportType, item.ImportOn).First(&tourQueue).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return errors.Wrap(err, ErrorDB)
 	}
 
-	if err := DB.Model(&tourQueue).Where(TourQueue{TourID: tour.ID}).First(&tourQueue).Error; err != nil {
-		if err != gorm.ErrRecordNotFound {
-			return errors.Wrap(err, ErrorDB)
-		}
+	if err == gorm.ErrRecordNotFound {
+		item.Reason = reason.Message
+		item.ReasonCode = reason.Code
+		item.ReasonCategory = reason.Category
+		item.Status = QueueStatusPending
+		item.NextAttemptAt = DefaultQueuePolicy.nextAttempt(0, time.Now())
+		return errors.Wrap(DB.Create(&item).Error, ErrorDB)
+	}
 
-		//add tour to queue
-		DB.Create(&data)
-	} else {
-		//update the number of trial for that queue
-		tourQueue.Trial = tourQueue.Trial + 1
-		DB.Save(&tourQueue)
+	//a dead day waits to be retried manually
+	if tourQueue.Status != QueueStatusPending {
+		return nil
 	}
 
-	return nil
+	err = DB.Model(&tourQueue).Updates(map[string]interface{}{
+		"reason":          reason.Message,
+		"reason_code":     reason.Code,
+		"reason_category": reason.Category,
+	}).Error
+	return errors.Wrap(err, ErrorDB)
+}
+
+//removeFromQueue removes an imported day from the queue
+func removeFromQueue(tx *gorm.DB, item TourQueue) error {
+	err := tx.Unscoped().Where("tour_id = ? AND driver_transics_id = ? AND report_type = ? AND import_on = ?", item.TourID, item.DriverTransicsID, item.ReportType, item.ImportOn).Delete(&TourQueue{}).Error
+	return errors.Wrap(err, ErrorDB)
+}
+
+//failQueueItem records a failed attempt of an element of the queue
+//the element is dead when it failed too many times or when it cannot succeed anymore
+func failQueueItem(tourQueue *TourQueue, reason queueReason, dead bool) error {
+	//update the number of trial for that queue
+	tourQueue.Trial = tourQueue.Trial + 1
+	tourQueue.Reason = reason.Message
+	tourQueue.ReasonCode = reason.Code
+	tourQueue.ReasonCategory = reason.Category
+	tourQueue.NextAttemptAt = DefaultQueuePolicy.nextAttempt(tourQueue.Trial, time.Now())
+	if dead || tourQueue.Trial >= DefaultQueuePolicy.MaxAttempts {
+		tourQueue.Status = QueueStatusDead
+		log.Printf("Queue element %d (tour %d, driver %d, %s on %s) is dead after %d attempts: %s\n", tourQueue.ID, tourQueue.TourID, tourQueue.DriverTransicsID, tourQueue.ReportType, tourQueue.ImportOn.Format("2006-01-02"), tourQueue.Trial, reason.Message)
+	}
+
+	return errors.Wrap(DB.Save(tourQueue).Error, ErrorDB)
+}
+
+//QueueItems returns the elements of the queue with the given status, every element when status is empty
+func QueueItems(status string) ([]TourQueue, error) {
+	var queue []TourQueue
+	db := DB.Order("status asc, import_on asc")
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+	if err := db.Find(&queue).Error; err != nil {
+		return nil, errors.Wrap(err, ErrorDB)
+	}
+
+	return queue, nil
+}
+
+//queueSelection selects elements of the queue by id, or by status when no id is given
+func queueSelection(ids []uint, status string) *gorm.DB {
+	db := DB.Model(&TourQueue{})
+	if len(ids) > 0 {
+		db = db.Where("id IN (?)", ids)
+	}
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+	return db
+}
+
+//RetryQueueItems schedules the selected elements of the queue for the next import, with no failed attempt
+func RetryQueueItems(ids []uint, status string) (int64, error) {
+	db := queueSelection(ids, status).Updates(map[string]interface{}{
+		"status":          QueueStatusPending,
+		"trial":           0,
+		"next_attempt_at": time.Now(),
+	})
+	if db.Error != nil {
+		return 0, errors.Wrap(db.Error, ErrorDB)
+	}
+
+	return db.RowsAffected, nil
+}
+
+//PurgeQueueItems permanently removes the selected elements of the queue
+func PurgeQueueItems(ids []uint, status string) (int64, error) {
+	db := queueSelection(ids, status).Unscoped().Delete(&TourQueue{})
+	if db.Error != nil {
+		return 0, errors.Wrap(db.Error, ErrorDB)
+	}
+
+	return db.RowsAffected, nil
 }
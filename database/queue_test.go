@@ -0,0 +1,223 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+	"tx2db/txtango/txtangotest"
+)
+
+func TestQueuePolicyNextAttempt(t *testing.T) {
+	policy := database.QueuePolicy{BaseDelay: time.Hour, MaxDelay: 10 * time.Hour, MaxAttempts: 5}
+	now := time.Date(2020, 2, 10, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		trial int
+		want  time.Duration
+	}{
+		{0, time.Hour},
+		{1, 2 * time.Hour},
+		{2, 4 * time.Hour},
+		{3, 8 * time.Hour},
+		{4, 10 * time.Hour},
+		{20, 10 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := policy.NextAttempt(tt.trial, now); !got.Equal(now.Add(tt.want)) {
+			t.Errorf("trial %d: got next attempt %s, want %s", tt.trial, got, now.Add(tt.want))
+		}
+	}
+}
+
+//makeQueueDue makes every pending element of the queue due for a new attempt
+func makeQueueDue(t *testing.T) {
+	t.Helper()
+	err := database.DB.Model(&database.TourQueue{}).Where("status = ?", database.QueueStatusPending).
+		Update("next_attempt_at", time.Now().Add(-time.Minute)).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+//queueItem returns the only element of the queue of a report type
+func queueItem(t *testing.T, reportType string) database.TourQueue {
+	t.Helper()
+	var items []database.TourQueue
+	if err := database.DB.Where("report_type = ?", reportType).Find(&items).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("got %d elements of %s in the queue, want 1", len(items), reportType)
+	}
+	return items[0]
+}
+
+func TestImportEmptyDayTwice(t *testing.T) {
+	defer databasetest.Open(t)()
+	server, client := startTestServer(t)
+	defer server.Close()
+
+	today := day(0)
+	importTestTour(t, server, today)
+
+	//the day without data is queued once, the imports do not count as attempts
+	for i := 0; i < 2; i++ {
+		if err := database.ImportToursData(context.Background(), client, true, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, reportType := range []string{"tar", "emr"} {
+		if item := queueItem(t, reportType); item.Trial != 0 || item.Status != database.QueueStatusPending || item.ReasonCode != "NO_DATA" {
+			t.Errorf("%s queued as %+v, want a pending element without attempt", reportType, item)
+		}
+	}
+
+	//an attempt of the queue still without data counts
+	makeQueueDue(t)
+	if err := database.ImportQueuedToursData(context.Background(), client, false); err != nil {
+		t.Fatal(err)
+	}
+	item := queueItem(t, "tar")
+	if item.Trial != 1 || item.Status != database.QueueStatusPending || !item.NextAttemptAt.After(time.Now()) {
+		t.Errorf("got %+v after an attempt, want 1 attempt and a later next attempt", item)
+	}
+
+	//the day leaves the queue once imported
+	server.AddActivity(100, txtangotest.Activity{Begin: today.Add(time.Minute), End: today.Add(time.Hour), Name: "Driving"})
+	if err := database.ImportToursData(context.Background(), client, true, 1); err != nil {
+		t.Fatal(err)
+	}
+	var count int
+	database.DB.Model(&database.TourQueue{}).Where("report_type = ?", "tar").Count(&count)
+	if count != 0 {
+		t.Errorf("got %d elements of the activities in the queue, want the imported day removed", count)
+	}
+}
+
+func TestQueueDeadLetter(t *testing.T) {
+	defer databasetest.Open(t)()
+	server, client := startTestServer(t)
+	defer server.Close()
+
+	importTestTour(t, server, day(0))
+	server.SetHTTPStatus(txtangotest.GetActivityReport, 503)
+	if err := database.ImportToursData(context.Background(), client, true, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	//every failed attempt counts until the element is dead
+	for attempt := 1; attempt <= database.DefaultQueuePolicy.MaxAttempts; attempt++ {
+		makeQueueDue(t)
+		if err := database.ImportQueuedToursData(context.Background(), client, false); err != nil {
+			t.Fatal(err)
+		}
+		item := queueItem(t, "tar")
+		if item.Trial != attempt || item.ReasonCode != "HTTP_503" {
+			t.Fatalf("got %+v after attempt %d", item, attempt)
+		}
+		if dead := attempt == database.DefaultQueuePolicy.MaxAttempts; (item.Status == database.QueueStatusDead) != dead {
+			t.Fatalf("got status %s after attempt %d", item.Status, attempt)
+		}
+	}
+
+	//a dead element is not tried anymore, not even by the import of its tour
+	calls := server.Calls(txtangotest.GetActivityReport)
+	makeQueueDue(t)
+	if err := database.ImportQueuedToursData(context.Background(), client, false); err != nil {
+		t.Fatal(err)
+	}
+	if got := server.Calls(txtangotest.GetActivityReport); got != calls {
+		t.Errorf("got %d calls for a dead element, want none", got-calls)
+	}
+	if err := database.ImportToursData(context.Background(), client, true, 1); err != nil {
+		t.Fatal(err)
+	}
+	if item := queueItem(t, "tar"); item.Status != database.QueueStatusDead || item.Trial != database.DefaultQueuePolicy.MaxAttempts {
+		t.Errorf("got %+v, want the element still dead", item)
+	}
+}
+
+func TestRetryAndPurgeQueueItems(t *testing.T) {
+	//the queue holds two dead elements and a pending one
+	fill := func(t *testing.T) []database.TourQueue {
+		items := []database.TourQueue{
+			{TourID: 1, ReportType: "tar", ImportOn: day(-3), Trial: 8, Status: database.QueueStatusDead},
+			{TourID: 1, ReportType: "emr", ImportOn: day(-3), Trial: 8, Status: database.QueueStatusDead},
+			{TourID: 2, ReportType: "tar", ImportOn: day(-1), Trial: 2, Status: database.QueueStatusPending, NextAttemptAt: time.Now().Add(time.Hour)},
+		}
+		for i := range items {
+			if err := database.DB.Create(&items[i]).Error; err != nil {
+				t.Fatal(err)
+			}
+		}
+		return items
+	}
+
+	tests := []struct {
+		name   string
+		purge  bool
+		ids    func(items []database.TourQueue) []uint
+		status string
+		want   int64
+		//wantDead and wantAll are the number of dead elements and of elements left
+		wantDead int
+		wantAll  int
+	}{
+		{"retry the dead elements", false, nil, database.QueueStatusDead, 2, 0, 3},
+		{"retry by id", false, func(items []database.TourQueue) []uint { return []uint{items[0].ID} }, "", 1, 1, 3},
+		{"retry by id and status", false, func(items []database.TourQueue) []uint { return []uint{items[2].ID} }, database.QueueStatusDead, 0, 2, 3},
+		{"purge the dead elements", true, nil, database.QueueStatusDead, 2, 0, 1},
+		{"purge by id", true, func(items []database.TourQueue) []uint { return []uint{items[1].ID, items[2].ID} }, "", 2, 1, 1},
+		{"purge everything", true, nil, "", 3, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer databasetest.Open(t)()
+			items := fill(t)
+
+			var ids []uint
+			if tt.ids != nil {
+				ids = tt.ids(items)
+			}
+			action := database.RetryQueueItems
+			if tt.purge {
+				action = database.PurgeQueueItems
+			}
+			got, err := action(ids, tt.status)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %d elements, want %d", got, tt.want)
+			}
+
+			dead, err := database.QueueItems(database.QueueStatusDead)
+			if err != nil {
+				t.Fatal(err)
+			}
+			all, err := database.QueueItems("")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(dead) != tt.wantDead || len(all) != tt.wantAll {
+				t.Errorf("got %d dead of %d elements, want %d of %d", len(dead), len(all), tt.wantDead, tt.wantAll)
+			}
+
+			//a retried element starts again without attempt and is due now
+			if !tt.purge {
+				var retried int64
+				for _, item := range all {
+					if item.Status == database.QueueStatusPending && item.Trial == 0 && !item.NextAttemptAt.After(time.Now()) {
+						retried++
+					}
+				}
+				if retried != tt.want {
+					t.Errorf("got %d elements due without attempt, want %d", retried, tt.want)
+				}
+			}
+		})
+	}
+}
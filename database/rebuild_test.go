@@ -0,0 +1,149 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+)
+
+//rebuildBase is the start of the timelines of the rebuild tests
+var rebuildBase = time.Date(2020, 2, 10, 0, 0, 0, 0, time.UTC)
+
+//at returns the time of the timeline at a given number of hours
+func at(hours int) time.Time {
+	return rebuildBase.Add(time.Duration(hours) * time.Hour)
+}
+
+//createTestTour creates a tour of a truck with an activity
+func createTestTour(t *testing.T, truckID uint, tour database.Tour, activity database.TruckActivityReport) database.Tour {
+	t.Helper()
+
+	tour.TruckTransicsID = truckID
+	if err := database.DB.Create(&tour).Error; err != nil {
+		t.Fatal(err)
+	}
+	activity.TourID = tour.ID
+	activity.TruckTransicsID = truckID
+	if err := database.DB.Create(&activity).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	return tour
+}
+
+func TestRebuildToursExtendsRangeToOverlappingTours(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	//every tour only overlaps the next one
+	createTestTour(t, 100, database.Tour{DriverTransicsID: 1, StartTime: at(0), EndTime: at(10)},
+		database.TruckActivityReport{StartTime: at(1), EndTime: at(3), KmBegin: 0, KmEnd: 100, Activity: "Driving"})
+	createTestTour(t, 100, database.Tour{DriverTransicsID: 1, StartTime: at(8), EndTime: at(20)},
+		database.TruckActivityReport{StartTime: at(9), EndTime: at(11), KmBegin: 100, KmEnd: 200, Activity: "Driving"})
+	open := createTestTour(t, 100, database.Tour{DriverTransicsID: 1, StartTime: at(18)},
+		database.TruckActivityReport{StartTime: at(19), EndTime: at(21), KmBegin: 200, KmEnd: 300, Activity: "Driving"})
+
+	result, err := database.RebuildTours(at(25), at(26), database.DefaultSegmentOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.OldTours != 3 || result.NewTours != 1 || result.Deleted != 2 {
+		t.Errorf("got %s, want 3 tours rebuilt into 1", result)
+	}
+
+	var tours []database.Tour
+	if err := database.DB.Find(&tours).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(tours) != 1 || tours[0].ID != open.ID || !tours[0].StartTime.Equal(at(1)) || !tours[0].EndTime.IsZero() {
+		t.Errorf("got tours %+v, want the open tour starting with the first activity", tours)
+	}
+}
+
+func TestRebuildToursKeepsLastImportOfReusedTours(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	imported := time.Date(2020, 2, 11, 6, 0, 0, 0, time.UTC)
+	open := createTestTour(t, 100, database.Tour{DriverTransicsID: 1, StartTime: at(0), LastImport: imported},
+		database.TruckActivityReport{StartTime: at(1), EndTime: at(3), KmBegin: 0, KmEnd: 150, Activity: "Driving"})
+	//the second driver took the truck without a new tour
+	second := database.TruckActivityReport{TourID: open.ID, TruckTransicsID: 100, StartTime: at(4), EndTime: at(6), KmBegin: 150, KmEnd: 300, Activity: "Driving"}
+	trip := database.DriverEcoMonitorReport{TourID: open.ID, TruckTransicsID: 100, DriverTransicsID: 2, StartTime: at(4), EndTime: at(6)}
+	if err := database.DB.Create(&second).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := database.DB.Create(&trip).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := database.RebuildTours(at(0), at(24), database.DefaultSegmentOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Created != 1 || result.Moved != 1 {
+		t.Errorf("got %s, want 1 tour created and 1 report moved", result)
+	}
+
+	var tours []database.Tour
+	if err := database.DB.Order("start_time").Find(&tours).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(tours) != 2 {
+		t.Fatalf("got %d tours, want 2", len(tours))
+	}
+	created, reused := tours[0], tours[1]
+	if created.DriverTransicsID != 1 || !created.EndTime.Equal(at(3)) || created.LastImport.IsZero() {
+		t.Errorf("new tour stored as %+v", created)
+	}
+	if reused.ID != open.ID || reused.DriverTransicsID != 2 || !reused.StartTime.Equal(at(4)) || !reused.EndTime.IsZero() {
+		t.Errorf("open tour stored as %+v", reused)
+	}
+	if !reused.LastImport.Equal(imported) {
+		t.Errorf("last import of the reused tour changed to %s, want %s", reused.LastImport, imported)
+	}
+}
+
+func TestRebuildToursUsesTheTruckOfEcoReports(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	first := createTestTour(t, 100, database.Tour{DriverTransicsID: 1, StartTime: at(0), EndTime: at(5)},
+		database.TruckActivityReport{StartTime: at(0), EndTime: at(2), KmBegin: 0, KmEnd: 150, Activity: "Driving"})
+	other := database.TruckActivityReport{TourID: first.ID, TruckTransicsID: 100, StartTime: at(2), EndTime: at(4), KmBegin: 150, KmEnd: 300, Activity: "Driving"}
+	if err := database.DB.Create(&other).Error; err != nil {
+		t.Fatal(err)
+	}
+	//the trip of driver 2 in truck 100 is imported with the tour of driver 2 in truck 200
+	second := createTestTour(t, 200, database.Tour{DriverTransicsID: 2, StartTime: at(5), EndTime: at(8)},
+		database.TruckActivityReport{StartTime: at(5), EndTime: at(7), KmBegin: 1000, KmEnd: 1100, Activity: "Driving"})
+	trip := database.DriverEcoMonitorReport{TourID: second.ID, TruckTransicsID: 100, DriverTransicsID: 2, StartTime: at(2), EndTime: at(4)}
+	if err := database.DB.Create(&trip).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := database.RebuildTours(at(0), at(24), database.DefaultSegmentOptions); err != nil {
+		t.Fatal(err)
+	}
+
+	//driver 2 took truck 100 after driver 1
+	var tours []database.Tour
+	if err := database.DB.Where("truck_transics_id = ?", 100).Order("start_time").Find(&tours).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(tours) != 2 || tours[0].DriverTransicsID != 1 || tours[1].DriverTransicsID != 2 || !tours[1].StartTime.Equal(at(2)) {
+		t.Fatalf("got tours of truck 100 %+v, want a tour of driver 1 then of driver 2", tours)
+	}
+	if err := database.DB.First(&trip, trip.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if trip.TourID != tours[1].ID {
+		t.Errorf("eco monitor report in tour %d, want %d", trip.TourID, tours[1].ID)
+	}
+
+	//the tour of truck 200 is kept as it is
+	if err := database.DB.First(&second, second.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if second.DriverTransicsID != 2 || !second.StartTime.Equal(at(5)) {
+		t.Errorf("tour of truck 200 stored as %+v", second)
+	}
+}
@@ -0,0 +1,161 @@
+package database
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+//DriverScore is the eco-driving score of a driver over a period
+//the sub-scores and the total are between 0 and 100, see the score package
+type DriverScore struct {
+	gorm.Model
+	DriverTransicsID   uint
+	PeriodStart        time.Time
+	PeriodEnd          time.Time //last day of the period
+	Distance           float64   //km
+	Coasting           float64
+	CruiseControl      float64
+	Idling             float64
+	OverSpeeding       float64
+	HarshAccelerations float64
+	PanicBrakes        float64
+	HighRPM            float64
+	GreenSpot          float64
+	Total              float64
+}
+
+//EcoTotals sums the eco monitor reports of a driver over a period
+type EcoTotals struct {
+	DriverTransicsID           uint
+	Distance                   float64
+	DurationDriving            float64
+	DistanceCoasting           float64
+	DistanceOnCruiseControl    float64
+	DurationIdling             float64
+	DurationOverSpeeding       float64
+	NumberOfHarshAccelerations float64
+	NumberOfPanicBrakes        float64
+	DurationHighRPM            float64
+	DistanceGreenSpot          float64
+}
+
+//EcoTotalsBetween sums the eco monitor reports of the tours of a period by driver
+//the tours started from start and ended during end, or still running, are selected, the reports of less than 2 km are ignored
+func EcoTotalsBetween(start, end time.Time) ([]EcoTotals, error) {
+	var totals []EcoTotals
+	err := DB.Raw(`
+	SELECT demr.driver_transics_id,
+	SUM(demr.distance) as distance,
+	SUM(demr.duration_driving) as duration_driving,
+	SUM(demr.distance_coasting) as distance_coasting,
+	SUM(demr.distance_on_cruise_control) as distance_on_cruise_control,
+	SUM(demr.duration_idling) as duration_idling,
+	SUM(demr.duration_over_speeding) as duration_over_speeding,
+	SUM(demr.number_of_harsh_accelerations) as number_of_harsh_accelerations,
+	SUM(demr.number_of_panic_brakes) as number_of_panic_brakes,
+	SUM(demr.duration_high_rpm) as duration_high_rpm,
+	SUM(demr.distance_green_spot) as distance_green_spot
+	FROM driver_eco_monitor_reports demr
+	INNER JOIN tours t
+	ON demr.tour_id = t.id
+	WHERE demr.distance > 2
+	AND demr.deleted_at IS NULL
+	AND t.start_time >= ?
+	AND (t.end_time < ? OR t.end_time IS NULL)
+	GROUP BY demr.driver_transics_id
+	ORDER BY demr.driver_transics_id asc`,
+		start.Format("2006-01-02"), end.AddDate(0, 0, 1).Format("2006-01-02")).Scan(&totals).Error
+	if err != nil {
+		return nil, errors.Wrap(err, ErrorDB)
+	}
+
+	return totals, nil
+}
+
+//Add adds the totals of other reports of the driver
+func (t *EcoTotals) Add(other EcoTotals) {
+	t.Distance += other.Distance
+	t.DurationDriving += other.DurationDriving
+	t.DistanceCoasting += other.DistanceCoasting
+	t.DistanceOnCruiseControl += other.DistanceOnCruiseControl
+	t.DurationIdling += other.DurationIdling
+	t.DurationOverSpeeding += other.DurationOverSpeeding
+	t.NumberOfHarshAccelerations += other.NumberOfHarshAccelerations
+	t.NumberOfPanicBrakes += other.NumberOfPanicBrakes
+	t.DurationHighRPM += other.DurationHighRPM
+	t.DistanceGreenSpot += other.DistanceGreenSpot
+}
+
+//TourEcoTotals sums the eco monitor reports of a driver during a tour
+type TourEcoTotals struct {
+	EcoTotals
+	StartTime time.Time
+	EndTime   *time.Time
+}
+
+//TourEcoTotalsBetween sums the eco monitor reports of the given drivers by tour, the tours are selected as in EcoTotalsBetween
+//the totals of several periods are read at once and summed by period
+func TourEcoTotalsBetween(start, end time.Time, drivers []string) ([]TourEcoTotals, error) {
+	var totals []TourEcoTotals
+	err := DB.Raw(`
+	SELECT demr.driver_transics_id, t.start_time, t.end_time,
+	SUM(demr.distance) as distance,
+	SUM(demr.duration_driving) as duration_driving,
+	SUM(demr.distance_coasting) as distance_coasting,
+	SUM(demr.distance_on_cruise_control) as distance_on_cruise_control,
+	SUM(demr.duration_idling) as duration_idling,
+	SUM(demr.duration_over_speeding) as duration_over_speeding,
+	SUM(demr.number_of_harsh_accelerations) as number_of_harsh_accelerations,
+	SUM(demr.number_of_panic_brakes) as number_of_panic_brakes,
+	SUM(demr.duration_high_rpm) as duration_high_rpm,
+	SUM(demr.distance_green_spot) as distance_green_spot
+	FROM driver_eco_monitor_reports demr
+	INNER JOIN tours t
+	ON demr.tour_id = t.id
+	WHERE demr.distance > 2
+	AND demr.deleted_at IS NULL
+	AND t.start_time >= ?
+	AND (t.end_time < ? OR t.end_time IS NULL)
+	AND demr.driver_transics_id IN (?)
+	GROUP BY demr.driver_transics_id, t.id, t.start_time, t.end_time
+	ORDER BY demr.driver_transics_id asc, t.start_time asc`,
+		start.Format("2006-01-02"), end.AddDate(0, 0, 1).Format("2006-01-02"), drivers).Scan(&totals).Error
+	if err != nil {
+		return nil, errors.Wrap(err, ErrorDB)
+	}
+
+	return totals, nil
+}
+
+//ReplaceDriverScores replaces the scores of a period by the given ones in a single transaction
+func ReplaceDriverScores(start, end time.Time, scores []DriverScore) error {
+	return inTransaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Where("period_start = ? AND period_end = ?", start, end).Delete(&DriverScore{}).Error
+		if err != nil {
+			return errors.Wrap(err, ErrorDB)
+		}
+
+		for i := range scores {
+			scores[i].PeriodStart = start
+			scores[i].PeriodEnd = end
+			if err := tx.Create(&scores[i]).Error; err != nil {
+				return errors.Wrap(err, ErrorDB)
+			}
+		}
+
+		return nil
+	})
+}
+
+//DriverScores returns the last scores of a driver, latest period first
+func DriverScores(driverTransicsID uint, limit int) ([]DriverScore, error) {
+	var scores []DriverScore
+	err := DB.Where("driver_transics_id = ?", driverTransicsID).Order("period_end desc, period_start desc").Limit(limit).Find(&scores).Error
+	if err != nil {
+		return nil, errors.Wrap(err, ErrorDB)
+	}
+
+	return scores, nil
+}
@@ -0,0 +1,64 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+)
+
+func TestEcoTotalsBetween(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	start := time.Date(2020, 2, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, 2, 16, 0, 0, 0, 0, time.UTC)
+	tours := []struct {
+		name     string
+		driverID uint
+		start    time.Time
+		end      time.Time
+		distance float32
+	}{
+		{"first day", 1, start.Add(6 * time.Hour), start.Add(14 * time.Hour), 100},
+		{"ending on the last day", 1, end.Add(6 * time.Hour), end.Add(14 * time.Hour), 200},
+		{"still running", 2, end.Add(20 * time.Hour), time.Time{}, 50},
+		{"started before", 3, start.Add(-6 * time.Hour), start.Add(6 * time.Hour), 400},
+		{"ending after", 3, end.Add(20 * time.Hour), end.Add(30 * time.Hour), 800},
+		{"short report", 3, start.Add(30 * time.Hour), start.Add(32 * time.Hour), 1},
+	}
+	for _, tt := range tours {
+		tour := database.Tour{DriverTransicsID: tt.driverID, TruckTransicsID: 100, StartTime: tt.start, EndTime: tt.end}
+		if err := database.DB.Create(&tour).Error; err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		if tt.end.IsZero() {
+			//a running tour has no end
+			if err := database.DB.Model(&tour).UpdateColumn("end_time", nil).Error; err != nil {
+				t.Fatalf("%s: %s", tt.name, err)
+			}
+		}
+		report := database.DriverEcoMonitorReport{TourID: tour.ID, TruckTransicsID: 100, DriverTransicsID: tt.driverID, Distance: tt.distance}
+		if err := database.DB.Create(&report).Error; err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+	}
+
+	totals, err := database.EcoTotalsBetween(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[uint]float64{1: 300, 2: 50}
+	got := make(map[uint]float64)
+	for _, total := range totals {
+		got[total.DriverTransicsID] = total.Distance
+	}
+	if len(got) != len(want) {
+		t.Errorf("got distances %v, want %v", got, want)
+	}
+	for driverID, distance := range want {
+		if got[driverID] != distance {
+			t.Errorf("driver %d: got %v km, want %v km", driverID, got[driverID], distance)
+		}
+	}
+}
@@ -0,0 +1,478 @@
+package database
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+//SegmentOptions defines what ends a tour when rebuilding tours from the reports
+type SegmentOptions struct {
+	//LongRest is the minimum duration of a rest (or of a period without data) ending a tour
+	LongRest time.Duration
+	//RestActivities are the activity names considered as rest, compared case insensitively
+	RestActivities []string
+	//MaxKmGap is the maximum odometer difference between two consecutive activities of a tour
+	MaxKmGap int
+}
+
+//DefaultSegmentOptions ends a tour after a reduced daily rest (9h) or when 5 km are missing
+var DefaultSegmentOptions = SegmentOptions{
+	LongRest:       9 * time.Hour,
+	RestActivities: []string{"rest", "rust", "repos", "ruhe", "pause"},
+	MaxKmGap:       5,
+}
+
+//RebuildResult summarises a tours rebuild
+type RebuildResult struct {
+	Trucks   int
+	OldTours int
+	NewTours int
+	Created  int
+	Deleted  int
+	Moved    int
+}
+
+func (r *RebuildResult) String() string {
+	return fmt.Sprintf("%d trucks: %d tours rebuilt into %d (%d created, %d deleted), %d reports moved", r.Trucks, r.OldTours, r.NewTours, r.Created, r.Deleted, r.Moved)
+}
+
+//timelineEvent is a report on the timeline of a truck
+type timelineEvent struct {
+	tourID   uint
+	driverID uint
+	start    time.Time
+	end      time.Time
+	kmBegin  int
+	kmEnd    int
+	activity string
+	//reportID is the id of the activity or eco monitor report
+	reportID uint
+	eco      bool
+}
+
+//tourSegment is a tour derived from the timeline of a truck
+type tourSegment struct {
+	driverID uint
+	start    time.Time
+	end      time.Time
+	lastKm   int
+	events   []timelineEvent
+}
+
+//RebuildTours rebuilds the tours of every truck between from and to using the imported reports
+//tours are split on driver changes, long rests and km gaps, the range is extended to the tours crossing it
+//and to the tours crossing those, so that no rebuilt tour overlaps a tour left as it is
+func RebuildTours(from, to time.Time, opts SegmentOptions) (*RebuildResult, error) {
+	result := &RebuildResult{}
+	err := inTransaction(func(tx *gorm.DB) error {
+		//extend the range to the tours crossing it, until no tour crosses the extended range
+		for extended := true; extended; {
+			extended = false
+			var crossing []Tour
+			err := tx.Where("start_time < ? AND (end_time IS NULL OR end_time > ?)", to, from).Find(&crossing).Error
+			if err != nil {
+				return errors.Wrap(err, ErrorDB)
+			}
+			for _, tour := range crossing {
+				if tour.StartTime.Before(from) {
+					from = tour.StartTime
+					extended = true
+				}
+				if tour.EndTime.After(to) {
+					to = tour.EndTime
+					extended = true
+				}
+			}
+		}
+
+		//get the tours of the extended range and their reports
+		var tours []Tour
+		var activities []TruckActivityReport
+		var ecoReports []DriverEcoMonitorReport
+		inRange := "tour_id IN (SELECT id FROM tours WHERE deleted_at IS NULL AND start_time < ? AND (end_time IS NULL OR end_time > ?))"
+		if err := tx.Where("start_time < ? AND (end_time IS NULL OR end_time > ?)", to, from).Find(&tours).Error; err != nil {
+			return errors.Wrap(err, ErrorDB)
+		}
+		if err := tx.Where(inRange, to, from).Order("updated_at desc").Find(&activities).Error; err != nil {
+			return errors.Wrap(err, ErrorDB)
+		}
+		if err := tx.Where(inRange, to, from).Order("updated_at desc").Find(&ecoReports).Error; err != nil {
+			return errors.Wrap(err, ErrorDB)
+		}
+
+		return rebuildTours(tx, tours, activities, ecoReports, opts, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+//rebuildTours segments the timeline of every truck and moves the reports into the rebuilt tours
+func rebuildTours(tx *gorm.DB, tours []Tour, activities []TruckActivityReport, ecoReports []DriverEcoMonitorReport, opts SegmentOptions, result *RebuildResult) error {
+	toursByID := make(map[uint]Tour, len(tours))
+	for _, tour := range tours {
+		toursByID[tour.ID] = tour
+	}
+
+	//build the timeline of every truck, the same report imported in several tours is kept once
+	timelines := make(map[uint][]timelineEvent)
+	var duplicatedActivities, duplicatedEcoReports []uint
+	seen := make(map[string]bool)
+	for _, report := range activities {
+		//activities imported before the driver assignments belong to the driver of their tour
+		driverID := report.DriverTransicsID
+		if driverID == 0 {
+			driverID = toursByID[report.TourID].DriverTransicsID
+		}
+
+		key := fmt.Sprintf("%d-%d", report.TruckTransicsID, report.StartTime.UnixNano())
+		if seen[key] {
+			duplicatedActivities = append(duplicatedActivities, report.ID)
+			continue
+		}
+		seen[key] = true
+
+		timelines[report.TruckTransicsID] = append(timelines[report.TruckTransicsID], timelineEvent{
+			tourID:   report.TourID,
+			driverID: driverID,
+			start:    report.StartTime,
+			end:      report.EndTime,
+			kmBegin:  report.KmBegin,
+			kmEnd:    report.KmEnd,
+			activity: report.Activity,
+			reportID: report.ID,
+		})
+	}
+	for _, report := range ecoReports {
+		//the eco monitor reports are on the timeline of the truck they were driven in
+		key := fmt.Sprintf("%d-%d-%d", report.TruckTransicsID, report.DriverTransicsID, report.StartTime.UnixNano())
+		if seen[key] {
+			duplicatedEcoReports = append(duplicatedEcoReports, report.ID)
+			continue
+		}
+		seen[key] = true
+
+		timelines[report.TruckTransicsID] = append(timelines[report.TruckTransicsID], timelineEvent{
+			tourID:   report.TourID,
+			driverID: report.DriverTransicsID,
+			start:    report.StartTime,
+			end:      report.EndTime,
+			reportID: report.ID,
+			eco:      true,
+		})
+	}
+
+	//remove the duplicated reports
+	err := inChunks(duplicatedActivities, func(ids []uint) error {
+		return tx.Unscoped().Where("id IN (?)", ids).Delete(&TruckActivityReport{}).Error
+	})
+	if err == nil {
+		err = inChunks(duplicatedEcoReports, func(ids []uint) error {
+			return tx.Unscoped().Where("id IN (?)", ids).Delete(&DriverEcoMonitorReport{}).Error
+		})
+	}
+	if err != nil {
+		return errors.Wrap(err, ErrorDB)
+	}
+
+	//tours of trucks without reports are kept as they are
+	toursByTruck := make(map[uint][]Tour)
+	for _, tour := range tours {
+		if _, ok := timelines[tour.TruckTransicsID]; ok {
+			toursByTruck[tour.TruckTransicsID] = append(toursByTruck[tour.TruckTransicsID], tour)
+		}
+	}
+
+	for truckID, events := range timelines {
+		segments := segmentTimeline(events, opts)
+		err := saveSegments(tx, truckID, toursByTruck[truckID], segments, result)
+		if err != nil {
+			return err
+		}
+		result.Trucks++
+		result.OldTours += len(toursByTruck[truckID])
+		result.NewTours += len(segments)
+	}
+
+	return nil
+}
+
+//segmentTimeline splits the timeline of a truck into tours
+//a long rest ends the tour it belongs to, rests are never a tour on their own
+func segmentTimeline(events []timelineEvent, opts SegmentOptions) []tourSegment {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].start.Before(events[j].start)
+	})
+	attributeDrivers(events)
+
+	var segments []tourSegment
+	var current *tourSegment
+	for _, event := range events {
+		if current != nil && isTourBoundary(current, event, opts) {
+			segments = append(segments, *current)
+			current = nil
+		}
+
+		if current == nil {
+			current = &tourSegment{driverID: event.driverID, start: event.start, end: event.start}
+		}
+		current.events = append(current.events, event)
+		if current.driverID == 0 {
+			current.driverID = event.driverID
+		}
+		if event.kmEnd > 0 {
+			current.lastKm = event.kmEnd
+		}
+		if event.end.After(current.end) {
+			current.end = event.end
+		}
+
+		if isRest(event.activity, opts) && event.end.Sub(event.start) >= opts.LongRest {
+			segments = append(segments, *current)
+			current = nil
+		}
+	}
+	if current != nil {
+		segments = append(segments, *current)
+	}
+
+	return mergeRests(segments, opts)
+}
+
+//mergeRests merges the segments made of rests only into a neighbouring segment
+//a rest belongs to the tour it ends, unless it is the rest of the next driver before his tour
+func mergeRests(segments []tourSegment, opts SegmentOptions) []tourSegment {
+	var merged []tourSegment
+	for i := 0; i < len(segments); i++ {
+		segment := segments[i]
+		if !isRestSegment(segment, opts) || len(segments) == 1 {
+			merged = append(merged, segment)
+			continue
+		}
+
+		var previous *tourSegment
+		if len(merged) > 0 {
+			previous = &merged[len(merged)-1]
+		}
+		nextDriver := previous == nil ||
+			(i+1 < len(segments) && segment.driverID != 0 && segment.driverID != previous.driverID && segment.driverID == segments[i+1].driverID)
+		if nextDriver && i+1 < len(segments) {
+			next := &segments[i+1]
+			next.events = append(segment.events, next.events...)
+			next.start = segment.start
+			if next.driverID == 0 {
+				next.driverID = segment.driverID
+			}
+			continue
+		}
+		if previous == nil {
+			//only rests in the tours of the truck
+			merged = append(merged, segment)
+			continue
+		}
+
+		previous.events = append(previous.events, segment.events...)
+		if segment.end.After(previous.end) {
+			previous.end = segment.end
+		}
+	}
+
+	return merged
+}
+
+//isRestSegment checks if a segment only contains rests
+func isRestSegment(segment tourSegment, opts SegmentOptions) bool {
+	for _, event := range segment.events {
+		if event.eco || !isRest(event.activity, opts) {
+			return false
+		}
+	}
+	return true
+}
+
+//attributeDrivers sets the driver of the activities using the eco monitor reports driven at the same time
+//activities outside of any eco monitor report keep the driver of their tour
+func attributeDrivers(events []timelineEvent) {
+	var eco []timelineEvent
+	for _, event := range events {
+		if event.eco && event.driverID != 0 {
+			eco = append(eco, event)
+		}
+	}
+
+	for i := range events {
+		if events[i].eco {
+			continue
+		}
+		//last eco monitor report started before the activity
+		n := sort.Search(len(eco), func(j int) bool {
+			return eco[j].start.After(events[i].start)
+		})
+		if n > 0 && !eco[n-1].end.Before(events[i].start) {
+			events[i].driverID = eco[n-1].driverID
+		}
+	}
+}
+
+//isTourBoundary checks if an event starts a new tour
+func isTourBoundary(current *tourSegment, event timelineEvent, opts SegmentOptions) bool {
+	//driver change
+	if event.driverID != 0 && current.driverID != 0 && event.driverID != current.driverID {
+		return true
+	}
+
+	//no data for a long time, the truck was resting
+	if event.start.Sub(current.end) >= opts.LongRest {
+		return true
+	}
+
+	//km gap, data of the truck is missing
+	if event.kmBegin > 0 && current.lastKm > 0 {
+		gap := event.kmBegin - current.lastKm
+		if gap > opts.MaxKmGap || gap < -opts.MaxKmGap {
+			return true
+		}
+	}
+
+	return false
+}
+
+//isRest checks if an activity is a rest
+func isRest(activity string, opts SegmentOptions) bool {
+	activity = strings.ToLower(activity)
+	if activity == "" {
+		return false
+	}
+	for _, rest := range opts.RestActivities {
+		if strings.Contains(activity, strings.ToLower(rest)) {
+			return true
+		}
+	}
+	return false
+}
+
+//saveSegments saves the segments of a truck as tours
+//existing tours are reused when they contain most of the reports of a segment, the open tour stays the last tour
+func saveSegments(tx *gorm.DB, truckID uint, tours []Tour, segments []tourSegment, result *RebuildResult) error {
+	toursByID := make(map[uint]Tour, len(tours))
+	var openTour *Tour
+	for i, tour := range tours {
+		toursByID[tour.ID] = tour
+		if tour.EndTime == (time.Time{}) && (openTour == nil || tour.StartTime.After(openTour.StartTime)) {
+			openTour = &tours[i]
+		}
+	}
+
+	used := make(map[uint]bool)
+	for i, segment := range segments {
+		lastSegment := i == len(segments)-1
+
+		//reuse the tour containing most of the reports of the segment
+		var tour Tour
+		if lastSegment && openTour != nil {
+			tour = *openTour
+		} else {
+			count := make(map[uint]int)
+			for _, event := range segment.events {
+				if tour, ok := toursByID[event.tourID]; ok && !used[event.tourID] && (openTour == nil || tour.ID != openTour.ID) {
+					count[event.tourID]++
+				}
+			}
+			for id, n := range count {
+				if n > count[tour.ID] || (n == count[tour.ID] && id < tour.ID) {
+					tour = toursByID[id]
+				}
+			}
+		}
+
+		//a reused tour keeps its trailer, destination, status and last import
+		fields := map[string]interface{}{
+			"driver_transics_id": segment.driverID,
+			"start_time":         segment.start,
+		}
+		if lastSegment && openTour != nil {
+			fields["end_time"] = gorm.Expr("NULL")
+		} else {
+			fields["end_time"] = segment.end
+		}
+
+		if tour.ID == 0 {
+			//the reports of a new tour are already imported
+			tour = Tour{TruckTransicsID: truckID, Status: "Rebuilt", LastImport: time.Now()}
+			if err := tx.Create(&tour).Error; err != nil {
+				return errors.Wrap(err, ErrorDB)
+			}
+			result.Created++
+		}
+		used[tour.ID] = true
+		if err := tx.Model(&Tour{}).Where("id = ?", tour.ID).Updates(fields).Error; err != nil {
+			return errors.Wrap(err, ErrorDB)
+		}
+
+		//move the reports into the tour
+		var activities, ecoReports []uint
+		for _, event := range segment.events {
+			if event.tourID == tour.ID {
+				continue
+			}
+			if event.eco {
+				ecoReports = append(ecoReports, event.reportID)
+			} else {
+				activities = append(activities, event.reportID)
+			}
+		}
+		err := inChunks(activities, func(ids []uint) error {
+			return tx.Model(&TruckActivityReport{}).Where("id IN (?)", ids).UpdateColumn("tour_id", tour.ID).Error
+		})
+		if err == nil {
+			err = inChunks(ecoReports, func(ids []uint) error {
+				return tx.Model(&DriverEcoMonitorReport{}).Where("id IN (?)", ids).UpdateColumn("tour_id", tour.ID).Error
+			})
+		}
+		if err != nil {
+			return errors.Wrap(err, ErrorDB)
+		}
+		result.Moved += len(activities) + len(ecoReports)
+	}
+
+	//remove the tours which have been replaced
+	var unused []uint
+	for _, tour := range tours {
+		if !used[tour.ID] {
+			unused = append(unused, tour.ID)
+		}
+	}
+	err := inChunks(unused, func(ids []uint) error {
+		return tx.Where("id IN (?)", ids).Delete(&Tour{}).Error
+	})
+	if err != nil {
+		return errors.Wrap(err, ErrorDB)
+	}
+	result.Deleted += len(unused)
+
+	log.Printf("Truck %d: %d tours rebuilt into %d\n", truckID, len(tours), len(segments))
+
+	return nil
+}
+
+//inChunks calls fn with chunks of ids small enough for every dialect
+func inChunks(ids []uint, fn func(ids []uint) error) error {
+	for start := 0; start < len(ids); start += maxUpsertParams {
+		end := start + maxUpsertParams
+		if end > len(ids) {
+			end = len(ids)
+		}
+		if err := fn(ids[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
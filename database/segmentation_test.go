@@ -0,0 +1,207 @@
+package database
+
+import (
+	"testing"
+	"time"
+)
+
+//segmentBase is the start of the timelines of the segmentation tests
+var segmentBase = time.Date(2020, 2, 10, 0, 0, 0, 0, time.UTC)
+
+//at returns the time of the timeline at a given number of minutes
+func at(minutes int) time.Time {
+	return segmentBase.Add(time.Duration(minutes) * time.Minute)
+}
+
+//activity builds an activity of the timeline between two minutes
+func activity(driverID uint, start, end, kmBegin, kmEnd int, name string) timelineEvent {
+	return timelineEvent{driverID: driverID, start: at(start), end: at(end), kmBegin: kmBegin, kmEnd: kmEnd, activity: name}
+}
+
+//ecoTrip builds an eco monitor report of the timeline between two minutes
+func ecoTrip(driverID uint, start, end int) timelineEvent {
+	return timelineEvent{driverID: driverID, start: at(start), end: at(end), eco: true}
+}
+
+//wantSegment is a segment expected from the timeline
+type wantSegment struct {
+	driverID uint
+	start    int
+	end      int
+	events   int
+}
+
+func TestSegmentTimeline(t *testing.T) {
+	const h = 60
+	longRest := int(DefaultSegmentOptions.LongRest / time.Minute)
+
+	tests := []struct {
+		name   string
+		events []timelineEvent
+		want   []wantSegment
+	}{
+		{
+			name: "one driver without interruption",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(1, 2*h, 3*h, 150, 150, "Loading"),
+				activity(1, 3*h, 5*h, 150, 300, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 5 * h, 3}},
+		},
+		{
+			name: "driver change",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(2, 2*h, 4*h, 150, 300, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 2 * h, 1}, {2, 2 * h, 4 * h, 1}},
+		},
+		{
+			name: "driver change from the eco monitor reports",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(1, 2*h, 4*h, 150, 300, "Driving"),
+				ecoTrip(1, 0, 2*h),
+				ecoTrip(2, 2*h, 4*h),
+			},
+			want: []wantSegment{{1, 0, 2 * h, 2}, {2, 2 * h, 4 * h, 2}},
+		},
+		{
+			name: "unknown driver continues the tour",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(0, 2*h, 4*h, 150, 300, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 4 * h, 2}},
+		},
+		{
+			name: "long rest ends the tour and moves its end",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(1, 2*h, 2*h+longRest, 150, 150, "Rest"),
+				activity(1, 2*h+longRest, 13*h, 150, 300, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 2*h + longRest, 2}, {1, 2*h + longRest, 13 * h, 1}},
+		},
+		{
+			name: "rest just shorter than a long rest",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(1, 2*h, 2*h+longRest-1, 150, 150, "Rust"),
+				activity(1, 2*h+longRest-1, 13*h, 150, 300, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 13 * h, 3}},
+		},
+		{
+			name: "long rest after a driver change belongs to the tour it ends",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(1, 2*h, 4*h, 150, 300, "Driving"),
+				ecoTrip(1, 0, 2*h),
+				ecoTrip(2, 2*h, 4*h),
+				activity(1, 4*h, 4*h+longRest, 300, 300, "Repos"),
+				activity(1, 4*h+longRest, 15*h, 300, 400, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 2 * h, 2}, {2, 2 * h, 4*h + longRest, 3}, {1, 4*h + longRest, 15 * h, 1}},
+		},
+		{
+			name: "rest of the next driver before his tour",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(2, 2*h, 2*h+longRest, 150, 150, "Pause"),
+				activity(2, 2*h+longRest, 13*h, 150, 300, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 2 * h, 1}, {2, 2 * h, 13 * h, 2}},
+		},
+		{
+			name: "rest starting the timeline belongs to the first tour",
+			events: []timelineEvent{
+				activity(1, 0, longRest, 0, 0, "Rest"),
+				activity(1, longRest, longRest+2*h, 0, 150, "Driving"),
+			},
+			want: []wantSegment{{1, 0, longRest + 2*h, 2}},
+		},
+		{
+			name: "rest after a km gap is not a tour on its own",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(1, 2*h, 2*h+longRest, 200, 200, "Rest"),
+				activity(1, 2*h+longRest, 13*h, 200, 300, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 2*h + longRest, 2}, {1, 2*h + longRest, 13 * h, 1}},
+		},
+		{
+			name: "only rests",
+			events: []timelineEvent{
+				activity(1, 0, longRest, 0, 0, "Rest"),
+				activity(1, longRest, 2*longRest, 0, 0, "Rest"),
+			},
+			want: []wantSegment{{1, 0, 2 * longRest, 2}},
+		},
+		{
+			name: "gap without data as long as a long rest",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(1, 2*h+longRest, 13*h, 150, 300, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 2 * h, 1}, {1, 2*h + longRest, 13 * h, 1}},
+		},
+		{
+			name: "gap without data shorter than a long rest",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(1, 2*h+longRest-1, 13*h, 150, 300, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 13 * h, 2}},
+		},
+		{
+			name: "km gap",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(1, 2*h, 4*h, 150+DefaultSegmentOptions.MaxKmGap+1, 300, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 2 * h, 1}, {1, 2 * h, 4 * h, 1}},
+		},
+		{
+			name: "km difference within the maximum gap",
+			events: []timelineEvent{
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+				activity(1, 2*h, 4*h, 150+DefaultSegmentOptions.MaxKmGap, 300, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 4 * h, 2}},
+		},
+		{
+			name: "unordered events",
+			events: []timelineEvent{
+				activity(2, 2*h, 4*h, 150, 300, "Driving"),
+				activity(1, 0, 2*h, 0, 150, "Driving"),
+			},
+			want: []wantSegment{{1, 0, 2 * h, 1}, {2, 2 * h, 4 * h, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			segments := segmentTimeline(tt.events, DefaultSegmentOptions)
+
+			var got []wantSegment
+			for _, segment := range segments {
+				got = append(got, wantSegment{
+					driverID: segment.driverID,
+					start:    int(segment.start.Sub(segmentBase) / time.Minute),
+					end:      int(segment.end.Sub(segmentBase) / time.Minute),
+					events:   len(segment.events),
+				})
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got segments %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("segment %d is %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
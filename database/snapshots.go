@@ -0,0 +1,73 @@
+package database
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"tx2db/txtango"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+//VehicleSnapshot is the state of a truck each time the vehicles are imported
+//values Transics did not send (nil) are stored as NULL
+type VehicleSnapshot struct {
+	gorm.Model
+	TruckTransicsID      uint
+	TakenAt              time.Time
+	DriverTransicsID     uint
+	TrailerTransicsID    uint
+	CurrentKms           *float64
+	FuelLevel            *float64
+	Speed                *float64
+	ETA                  *time.Time
+	ETAStatus            string
+	DistanceETA          *float64
+	DestinationLongitude float32
+	DestinationLatitude  float32
+	DestinationInfo      string
+}
+
+//saveVehicleSnapshot stores the state of a vehicle returned by Get_Vehicles_V13
+func saveVehicleSnapshot(vehicle *txtango.TXVehicle, takenAt time.Time) error {
+	snapshot := VehicleSnapshot{
+		TruckTransicsID:      vehicle.VehicleTransicsID,
+		TakenAt:              takenAt,
+		DriverTransicsID:     vehicle.Driver.TransicsID,
+		TrailerTransicsID:    vehicle.Trailer.TransicsID,
+		CurrentKms:           parseOptionalFloat(vehicle.CurrentKms.Text, vehicle.CurrentKms.Nil),
+		FuelLevel:            parseOptionalFloat(vehicle.FuelLevel.Text, vehicle.FuelLevel.Nil),
+		Speed:                parseOptionalFloat(vehicle.Speed, ""),
+		ETAStatus:            vehicle.ETAInfo.ETAStatus.Text,
+		DistanceETA:          parseOptionalFloat(vehicle.ETAInfo.DistanceETA.Text, vehicle.ETAInfo.DistanceETA.Nil),
+		DestinationLongitude: vehicle.ETAInfo.PositionDestination.Longitude,
+		DestinationLatitude:  vehicle.ETAInfo.PositionDestination.Latitude,
+		DestinationInfo:      vehicle.ETAInfo.PositionInfoDestination,
+	}
+
+	//parse ETA into time.Time if existing
+	if vehicle.ETAInfo.ETA.Nil != "true" && vehicle.ETAInfo.ETA.Text != "" {
+		eta, err := time.Parse("2006-01-02T15:04:05", vehicle.ETAInfo.ETA.Text)
+		if err == nil {
+			snapshot.ETA = &eta
+		}
+	}
+
+	return errors.Wrap(DB.Create(&snapshot).Error, ErrorDB)
+}
+
+//parseOptionalFloat parses a number sent by Transics, nil when missing or invalid
+func parseOptionalFloat(text, isNil string) *float64 {
+	text = strings.TrimSpace(text)
+	if isNil == "true" || text == "" {
+		return nil
+	}
+
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,91 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+	"tx2db/txtango/txtangotest"
+)
+
+func TestParseOptionalFloat(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		isNil string
+		want  *float64
+	}{
+		{"number", "80.5", "", float(80.5)},
+		{"spaces", " 120000 ", "", float(120000)},
+		{"zero", "0", "", float(0)},
+		{"nil", "", "true", nil},
+		{"nil with a value", "12", "true", nil},
+		{"empty", "", "", nil},
+		{"invalid", "n/a", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := database.ParseOptionalFloat(tt.text, tt.isNil)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("got %v, want %v", format(got), format(tt.want))
+			}
+		})
+	}
+}
+
+//float returns a pointer to a value
+func float(value float64) *float64 {
+	return &value
+}
+
+//format prints an optional value
+func format(value *float64) interface{} {
+	if value == nil {
+		return "nil"
+	}
+	return *value
+}
+
+func TestImportTrucksSnapshots(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	//two imports keep two snapshots of the truck
+	eta := time.Date(2020, 2, 10, 17, 30, 0, 0, time.UTC)
+	for i, vehicle := range []txtangotest.Vehicle{
+		{TransicsID: 100, LicensePlate: "1-ABC-123", Group: "North", CurrentKms: 120000, FuelLevel: 80, Speed: 82, DistanceETA: 35.5, ETA: eta, ETAStatus: "ON_TIME"},
+		{TransicsID: 100, LicensePlate: "1-ABC-123", Group: "North", CurrentKms: 120150, FuelLevel: 65},
+	} {
+		server, client := startTestServer(t)
+		server.AddVehicle(vehicle)
+		err := database.ImportTrucks(context.Background(), client)
+		server.Close()
+		if err != nil {
+			t.Fatalf("import %d: %s", i+1, err)
+		}
+	}
+
+	var snapshots []database.VehicleSnapshot
+	if err := database.DB.Order("taken_at").Find(&snapshots).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 2 {
+		t.Fatalf("got %d snapshots, want 2", len(snapshots))
+	}
+
+	first, second := snapshots[0], snapshots[1]
+	if first.ETA == nil || !first.ETA.Equal(eta) || first.ETAStatus != "ON_TIME" || first.DistanceETA == nil || *first.DistanceETA != 35.5 {
+		t.Errorf("ETA of the first snapshot stored as %+v", first)
+	}
+	if first.Speed == nil || *first.Speed != 82 {
+		t.Errorf("got speed %v, want 82", format(first.Speed))
+	}
+	//the ETA Transics sent as nil is NULL
+	if second.ETA != nil || second.TakenAt.Before(first.TakenAt) {
+		t.Errorf("second snapshot stored as %+v", second)
+	}
+	if second.CurrentKms == nil || *second.CurrentKms != 120150 || second.FuelLevel == nil || *second.FuelLevel != 65 {
+		t.Errorf("got %v km and %v fuel, want 120150 and 65", format(second.CurrentKms), format(second.FuelLevel))
+	}
+}
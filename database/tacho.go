@@ -0,0 +1,264 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+	"tx2db/txtango"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+//Activities of a driver recorded by the tachograph
+const (
+	ActivityDrive     = "drive"
+	ActivityWork      = "work"
+	ActivityAvailable = "available"
+	ActivityRest      = "rest"
+)
+
+//DriverActivityPeriod is a period of a single tachograph activity of a driver
+//the periods are used to check the driving and rest times of EU 561/2006, they are imported by driver
+//and day whatever the tours of the driver
+type DriverActivityPeriod struct {
+	gorm.Model
+	DriverTransicsID uint
+	TruckTransicsID  uint    //0 when the period has no vehicle
+	Activity         string  //drive, work, available or rest
+	Slot             string  //tachograph slot of the driver card, DRIVER or CODRIVER
+	Duration         float32 //minutes
+	StartTime        time.Time
+	EndTime          time.Time
+}
+
+//tachoActivities maps the TX-TANGO tachograph activities to the activities of a period
+var tachoActivities = map[string]string{
+	txtango.TachoDriving:   ActivityDrive,
+	txtango.TachoWorking:   ActivityWork,
+	txtango.TachoAvailable: ActivityAvailable,
+	txtango.TachoResting:   ActivityRest,
+}
+
+//DriverImportError is the failure of the import of the tachograph activities of a single driver
+type DriverImportError struct {
+	DriverID uint
+	Err      error
+}
+
+//DriverImportErrors are the drivers which failed during an import
+type DriverImportErrors []DriverImportError
+
+func (e DriverImportErrors) Error() string {
+	var msg []string
+	for _, driverErr := range e {
+		msg = append(msg, fmt.Sprintf("driver %d: %v", driverErr.DriverID, driverErr.Err))
+	}
+	return fmt.Sprintf("%d drivers failed to import (%s)", len(e), strings.Join(msg, "; "))
+}
+
+//ImportTachoData imports the tachograph activities of every active driver, day by day
+//since the day of the last imported period, or since the start of the first tour of the driver
+//drivers are imported concurrently by the given number of workers sharing the Transics rate limit
+//a failing driver does not stop the import, failures are returned together as DriverImportErrors
+func ImportTachoData(ctx context.Context, txClient *txtango.Client, ignoreLastImport bool, workers int) error {
+	log.Println("Importing tachograph data")
+
+	var drivers []Driver
+	if err := DB.Where("inactive = ?", false).Find(&drivers).Error; err != nil {
+		return errors.Wrap(err, ErrorDB)
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		failed  DriverImportErrors
+		done    int
+		pending = make(chan uint)
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for driverID := range pending {
+				err := importDriverTachoData(ctx, txClient, driverID, ignoreLastImport)
+
+				mu.Lock()
+				done++
+				log.Printf("(%d / %d) Tachograph data of driver %d imported\n", done, len(drivers), driverID)
+				if err != nil {
+					log.Printf("ERROR: driver %d: %s\n", driverID, err)
+					failed = append(failed, DriverImportError{DriverID: driverID, Err: err})
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	log.Printf("%s (%d drivers, %d workers)\n", loadingDataFromTransics, len(drivers), workers)
+	for _, driver := range drivers {
+		//stop feeding the workers when the import is cancelled
+		if ctx.Err() != nil {
+			break
+		}
+		pending <- driver.TransicsID
+	}
+	close(pending)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(failed) > 0 {
+		return failed
+	}
+
+	return nil
+}
+
+//importDriverTachoData imports the tachograph activities of a driver for every day since its first day to import
+func importDriverTachoData(ctx context.Context, txClient *txtango.Client, driverID uint, ignoreLastImport bool) error {
+	start, err := tachoImportStart(driverID, ignoreLastImport)
+	if err != nil || start.IsZero() {
+		return err
+	}
+
+	now := time.Now()
+	for day := start; day.Before(now); day = day.AddDate(0, 0, 1) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, err := importTachoDay(ctx, txClient, driverID, day); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//tachoImportStart returns the first day to import for a driver, the day of its last imported period
+//is imported again as it may have been incomplete, zero when the driver never had a tour
+func tachoImportStart(driverID uint, ignoreLastImport bool) (time.Time, error) {
+	if !ignoreLastImport {
+		var period DriverActivityPeriod
+		err := DB.Where("driver_transics_id = ?", driverID).Order("start_time desc").First(&period).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return time.Time{}, errors.Wrap(err, ErrorDB)
+		}
+		if err == nil {
+			return queueDay(period.StartTime), nil
+		}
+	}
+
+	//the periods are only needed since the driver drives our trucks
+	var tour Tour
+	err := DB.Where("driver_transics_id = ?", driverID).Order("start_time asc").First(&tour).Error
+	if err == gorm.ErrRecordNotFound {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, ErrorDB)
+	}
+
+	return queueDay(tour.StartTime), nil
+}
+
+//importTachoDay import the tachograph activities of a driver started on a given day
+//the periods of the day are written in a single transaction
+func importTachoDay(ctx context.Context, txClient *txtango.Client, driverID uint, start time.Time) (UpsertResult, error) {
+	//build date range
+	end := start.AddDate(0, 0, 1)
+
+	//import data from transics
+	txTacho, err := txClient.GetTachoData(ctx, driverID, start, end)
+	if err != nil {
+		return UpsertResult{}, queueItemOnTransicsError(driverQueueItem(driverID, start), err)
+	}
+
+	//check and print warnings
+	logTransicsWarnings(txTacho.Body.GetTachoDataV2Response.GetTachoDataV2Result.Warnings)
+
+	//check if the data is actually present, a driver has no activity on their days off
+	if len(txTacho.Body.GetTachoDataV2Response.GetTachoDataV2Result.TachoActivities.TachoActivityItem) == 0 {
+		onTour, err := driverOnTour(driverID, start, end)
+		if err != nil {
+			return UpsertResult{}, err
+		}
+		if onTour {
+			return UpsertResult{}, addToQueue(driverQueueItem(driverID, start), reasonQueueNoData)
+		}
+	}
+
+	var rows []interface{}
+	for _, data := range txTacho.Body.GetTachoDataV2Response.GetTachoDataV2Result.TachoActivities.TachoActivityItem {
+		activity, ok := tachoActivities[data.Activity]
+		if !ok {
+			log.Printf("Unknown tachograph activity %q of driver %d skipped\n", data.Activity, driverID)
+			continue
+		}
+
+		//parse begin and end date into time.Time
+		startTime, err := time.Parse("2006-01-02T15:04:05", data.BeginDate)
+		if err != nil {
+			log.Println(errParsingDate)
+			startTime = time.Time{}
+		}
+
+		endTime, err := time.Parse("2006-01-02T15:04:05", data.EndDate)
+		if err != nil {
+			log.Println(errParsingDate)
+			endTime = time.Time{}
+		}
+
+		//the period still running has no duration yet
+		duration := data.Duration
+		if duration == 0 && endTime.After(startTime) {
+			duration = float32(endTime.Sub(startTime).Minutes())
+		}
+
+		rows = append(rows, &DriverActivityPeriod{
+			DriverTransicsID: driverID,
+			TruckTransicsID:  data.Vehicle.TransicsID,
+			Activity:         activity,
+			Slot:             data.Slot,
+			Duration:         duration,
+			StartTime:        startTime,
+			EndTime:          endTime,
+		})
+	}
+
+	//write the whole day at once
+	var result UpsertResult
+	err = inTransaction(func(tx *gorm.DB) error {
+		result, err = upsertDriverReports(tx, driverID, rows)
+		if err != nil {
+			return err
+		}
+		return removeFromQueue(tx, driverQueueItem(driverID, start))
+	})
+	if err != nil {
+		return UpsertResult{}, err
+	}
+	log.Printf("TachoData of driver %d on %s: %s\n", driverID, start.Format("2006-01-02"), result)
+
+	return result, nil
+}
+
+//driverOnTour checks if a driver had a tour during a period, his tachograph activities are then expected
+func driverOnTour(driverID uint, start, end time.Time) (bool, error) {
+	var count int
+	err := DB.Model(&Tour{}).Where("driver_transics_id = ? AND start_time < ? AND (end_time IS NULL OR end_time > ?)", driverID, end, start).Count(&count).Error
+	if err != nil {
+		return false, errors.Wrap(err, ErrorDB)
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,98 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+	"tx2db/txtango"
+	"tx2db/txtango/txtangotest"
+)
+
+func TestImportTachoData(t *testing.T) {
+	defer databasetest.Open(t)()
+	server, client := startTestServer(t)
+	defer server.Close()
+
+	//the tour starts after the first activities of the day
+	yesterday := day(-1)
+	importTestTour(t, server, yesterday.Add(12*time.Hour))
+	server.AddTachoActivity(1,
+		txtangotest.TachoActivity{VehicleTransicsID: 100, Begin: yesterday.Add(6 * time.Hour), End: yesterday.Add(10 * time.Hour), Activity: txtango.TachoDriving, Slot: "DRIVER", Duration: 240},
+		txtangotest.TachoActivity{VehicleTransicsID: 100, Begin: yesterday.Add(10 * time.Hour), End: yesterday.Add(10*time.Hour + 45*time.Minute), Activity: txtango.TachoResting, Slot: "DRIVER"},
+		txtangotest.TachoActivity{Begin: day(0).Add(time.Hour), End: day(0).Add(2 * time.Hour), Activity: txtango.TachoWorking, Slot: "DRIVER", Duration: 60},
+	)
+	//a driver who never had a tour is not imported
+	server.AddDriver(txtangotest.Driver{TransicsID: 2, PersonID: "P2", Name: "Marie Dubois", Language: "FR"})
+	server.AddTachoActivity(2, txtangotest.TachoActivity{Begin: yesterday.Add(6 * time.Hour), End: yesterday.Add(7 * time.Hour), Activity: txtango.TachoDriving})
+	if err := database.ImportDrivers(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+
+	//importing twice does not duplicate the periods
+	for i := 0; i < 2; i++ {
+		if err := database.ImportTachoData(context.Background(), client, false, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var periods []database.DriverActivityPeriod
+	if err := database.DB.Order("start_time").Find(&periods).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(periods) != 3 {
+		t.Fatalf("got %d periods, want 3", len(periods))
+	}
+	if p := periods[0]; p.DriverTransicsID != 1 || p.TruckTransicsID != 100 || p.Activity != database.ActivityDrive || p.Duration != 240 || !p.StartTime.Equal(yesterday.Add(6*time.Hour)) {
+		t.Errorf("period stored as %+v", p)
+	}
+	//the duration of a period without duration is computed
+	if p := periods[1]; p.Activity != database.ActivityRest || p.Duration != 45 {
+		t.Errorf("period stored as %+v", p)
+	}
+	if p := periods[2]; p.Activity != database.ActivityWork || p.TruckTransicsID != 0 {
+		t.Errorf("period stored as %+v", p)
+	}
+}
+
+func TestImportTachoDataQueuesDriverDays(t *testing.T) {
+	defer databasetest.Open(t)()
+	server, client := startTestServer(t)
+	defer server.Close()
+
+	importTestTour(t, server, day(0))
+	server.AddTachoActivity(1, txtangotest.TachoActivity{Begin: day(0), End: day(0).Add(time.Minute), Activity: txtango.TachoResting})
+	server.SetHTTPStatus(txtangotest.GetTachoData, 503)
+
+	//a temporary failure does not fail the import, the day of the driver is queued
+	if err := database.ImportTachoData(context.Background(), client, false, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	items, err := database.QueueItems(database.QueueStatusPending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].ReportType != "tdp" || items[0].DriverTransicsID != 1 || items[0].TourID != 0 || items[0].ReasonCode != "HTTP_503" {
+		t.Fatalf("got queue %+v, want the day of driver 1 queued after HTTP 503", items)
+	}
+
+	//the queued day is imported for the driver
+	server.SetHTTPStatus(txtangotest.GetTachoData, 0)
+	if _, err := database.RetryQueueItems(nil, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := database.ImportQueuedToursData(context.Background(), client, true); err != nil {
+		t.Fatal(err)
+	}
+
+	var count int
+	database.DB.Model(&database.DriverActivityPeriod{}).Where("driver_transics_id = ?", 1).Count(&count)
+	if count != 1 {
+		t.Errorf("got %d periods, want 1", count)
+	}
+	if items, _ := database.QueueItems(""); len(items) != 0 {
+		t.Errorf("got queue %+v, want it empty", items)
+	}
+}
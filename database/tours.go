@@ -1,7 +1,11 @@
 package database
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"strings"
+	"sync"
 	"time"
 	"tx2db/txtango"
 
@@ -9,9 +13,6 @@ import (
 	"github.com/pkg/errors"
 )
 
-//Transics waiting time, useful to do not get blocked
-const transicsWaitTime = 5 * time.Second
-
 //Tour represents information data about truck tours
 //A tour is a period of driving connected to one driver
 //Example Driver A and Driver B in the same trip will result in 2 Tours
@@ -33,21 +34,22 @@ type Tour struct {
 //TruckActivityReport represents the activity report of a specific truck
 type TruckActivityReport struct {
 	gorm.Model
-	TruckTransicsID uint
-	TourID          uint
-	KmBegin         int
-	KmEnd           int
-	Consumption     float32
-	LoadedStatus    string
-	Activity        string
-	SpeedAvg        float32
-	Longitude       float32
-	Latitude        float32
-	AddressInfo     string
-	CountryCode     string
-	Reference       string
-	StartTime       time.Time
-	EndTime         time.Time
+	TruckTransicsID  uint
+	DriverTransicsID uint //driver assigned to the truck at the start of the activity
+	TourID           uint
+	KmBegin          int
+	KmEnd            int
+	Consumption      float32
+	LoadedStatus     string
+	Activity         string
+	SpeedAvg         float32
+	Longitude        float32
+	Latitude         float32
+	AddressInfo      string
+	CountryCode      string
+	Reference        string
+	StartTime        time.Time
+	EndTime          time.Time
 }
 
 //DriverEcoMonitorReport represents the eco monitor report of a driver
@@ -55,6 +57,7 @@ type TruckActivityReport struct {
 type DriverEcoMonitorReport struct {
 	gorm.Model
 	TourID                                             uint
+	TruckTransicsID                                    uint
 	DriverTransicsID                                   uint
 	Distance                                           float32
 	DurationDriving                                    float32
@@ -100,60 +103,64 @@ type DriverEcoMonitorReport struct {
 	EndTime                                            time.Time
 }
 
-//buildTour handles tour import and creation flow
+//buildTour keeps the open tour of a truck up to date with the vehicle snapshot
+//a new tour is only started when the driver of the truck changes, the exact boundaries of the tours
+//are derived from the imported reports by RebuildTours
+//until its reports are imported a new tour starts after the last activity of the truck
+//or at the start of the day, the import then moves the start to the first report of the tour
 func buildTour(truck *Truck, driverTransicsID, trailerTransicsID uint, tourStatus string, long, lat float32) error {
 	// if transics id not set, then do not create tour
-	if driverTransicsID == 0 || long == 0 || lat == 0 {
+	if driverTransicsID == 0 {
 		return nil
 	}
 
-	//initialize tour
-	//keep in mind that if a driver keep doing the same tour with the same destination, his tour will never be finished.
+	//get the open tour of the truck
 	var tour Tour
-	newTour := Tour{
-		TruckTransicsID:      truck.TransicsID,
-		DriverTransicsID:     driverTransicsID,
-		TrailerTransicsID:    trailerTransicsID,
-		DestinationLongitude: long,
-		DestinationLatitude:  lat,
-	}
-
 	status := "Skipped"
-	err := DB.Where(newTour).Last(&tour).Error
-
-	//if do not exist or tour already ended
-	if err != nil || tour.EndTime != (time.Time{}) {
-		if err != gorm.ErrRecordNotFound {
-			return errors.Wrap(err, ErrorDB)
-		}
-
-		//check how many tour has a truck
-		var count int
-		if err = DB.Model(&tour).Where(Tour{TruckTransicsID: truck.TransicsID}).Count(&count).Error; err != nil {
-			return errors.Wrap(err, ErrorDB)
-		}
+	err := DB.Where("truck_transics_id = ? AND end_time IS NULL", truck.TransicsID).Last(&tour).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return errors.Wrap(err, ErrorDB)
+	}
 
-		now := time.Now()
-		if count > 0 {
-			// Get old tour
-			var oldTour Tour
-			if err := DB.Model(&tour).Where(Tour{TruckTransicsID: truck.TransicsID}).Last(&oldTour).Error; err != nil {
+	now := time.Now()
+	if err == gorm.ErrRecordNotFound || tour.DriverTransicsID != driverTransicsID {
+		//the driver changed, end the tour of the previous driver
+		if tour.ID != 0 {
+			if err := DB.Model(&tour).Update(Tour{EndTime: now}).Error; err != nil {
 				return errors.Wrap(err, ErrorDB)
 			}
+		}
 
-			// Start new tour and old tour using now date
-			DB.Model(&tour).Where(oldTour).Update(Tour{EndTime: now})
+		start, err := tourStartBefore(truck.TransicsID, now)
+		if err != nil {
+			return err
 		}
 
 		// create tour
 		status = "Creating"
-		//set startTime first ever tour imported set to today's date
-		newTour.StartTime = now
-		newTour.Status = tourStatus
-		DB.Create(&newTour)
+		newTour := Tour{
+			TruckTransicsID:      truck.TransicsID,
+			DriverTransicsID:     driverTransicsID,
+			TrailerTransicsID:    trailerTransicsID,
+			DestinationLongitude: long,
+			DestinationLatitude:  lat,
+			Status:               tourStatus,
+			StartTime:            start,
+		}
+		if err := DB.Create(&newTour).Error; err != nil {
+			return errors.Wrap(err, ErrorDB)
+		}
 	} else if truck.LastModified.After(tour.UpdatedAt) { // update tour
 		status = "Updating"
-		DB.Model(&tour).Where(newTour).Update(Tour{Status: tourStatus})
+		err := DB.Model(&tour).Updates(map[string]interface{}{
+			"trailer_transics_id":   trailerTransicsID,
+			"destination_longitude": long,
+			"destination_latitude":  lat,
+			"status":                tourStatus,
+		}).Error
+		if err != nil {
+			return errors.Wrap(err, ErrorDB)
+		}
 	}
 
 	//add tour
@@ -162,8 +169,44 @@ func buildTour(truck *Truck, driverTransicsID, trailerTransicsID uint, tourStatu
 	return nil
 }
 
+//tourStartBefore returns the earliest start of a tour of a truck started at a given time
+//the activities of the day driven after the last imported activity of the truck may belong to the tour
+func tourStartBefore(truckID uint, at time.Time) (time.Time, error) {
+	start := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location())
+
+	var last TruckActivityReport
+	err := DB.Where("truck_transics_id = ? AND end_time <= ?", truckID, at).Order("end_time desc").First(&last).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return start, errors.Wrap(err, ErrorDB)
+	}
+	if last.EndTime.After(start) {
+		start = last.EndTime
+	}
+
+	return start, nil
+}
+
+//TourImportError is the failure of the import of a single tour
+type TourImportError struct {
+	TourID uint
+	Err    error
+}
+
+//TourImportErrors collects the tours which failed during an import
+type TourImportErrors []TourImportError
+
+func (e TourImportErrors) Error() string {
+	var msg []string
+	for _, tourErr := range e {
+		msg = append(msg, fmt.Sprintf("tour %d: %v", tourErr.TourID, tourErr.Err))
+	}
+	return fmt.Sprintf("%d tours failed to import (%s)", len(e), strings.Join(msg, "; "))
+}
+
 //ImportToursData import TruckActivityReport and DriverEcoMonitorReport
-func ImportToursData(ignoreLastImport bool) error {
+//tours are imported concurrently by the given number of workers sharing the Transics rate limit
+//a failing tour does not stop the import, failures are returned together as TourImportErrors
+func ImportToursData(ctx context.Context, txClient *txtango.Client, ignoreLastImport bool, workers int) error {
 	log.Println("Importing tours data")
 
 	var tours []Tour
@@ -173,88 +216,181 @@ func ImportToursData(ignoreLastImport bool) error {
 	if ignoreLastImport {
 		err = DB.Find(&tours).Error
 	} else {
-		err = DB.Where("last_import IS NULL OR end_time IS NULL OR DATEADD(DAY, 3, end_time) > last_import").Find(&tours).Error
+		err = DB.Where("last_import IS NULL OR end_time IS NULL OR " + sqlAddDays("end_time", 3) + " > last_import").Find(&tours).Error
 	}
 	if err != nil {
 		return errors.Wrap(err, ErrorDB)
 	}
 
-	for i, tour := range tours {
-		log.Printf("(%d / %d) %s\n", i+1, len(tours), loadingDataFromTransics)
+	log.Printf("%s (%d tours, %d workers)\n", loadingDataFromTransics, len(tours), workers)
+	var (
+		mu    sync.Mutex
+		total UpsertResult
+	)
+	failed := importConcurrently(ctx, tours, workers, func(tour *Tour) error {
+		result, err := importTourData(ctx, txClient, tour, ignoreLastImport)
+		mu.Lock()
+		total.Add(result)
+		mu.Unlock()
+		return err
+	})
+	log.Printf("Tours reports: %s\n", total)
 
-		//if never has been imported, only import from the tour startTime
-		if ignoreLastImport || (tour.LastImport == time.Time{}) {
-			tour.LastImport = tour.StartTime
-		}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
 
-		//caculate elapsed time betfore last import and queries missing days
-		now := time.Now()
-		diff := int(now.Sub(tour.LastImport).Hours() / 24)
+	//import data from queue - we do not handle error here as not necessary
+	ImportQueuedToursData(ctx, txClient, false)
 
-		//for every days elapsed since last import
-		for day := diff; day >= 0; day-- {
-			//import eco monitor report
-			err = importEcoMoniorReport(&tour, day)
-			if err != nil {
-				log.Printf("ERROR: %s\n", err)
-				return err
-			}
+	if len(failed) > 0 {
+		return failed
+	}
 
-			//import activity report
-			err = importActivityReport(&tour, day)
-			if err != nil {
-				log.Printf("ERROR: %s\n", err)
-				return err
+	return nil
+}
+
+//importConcurrently runs the import of every tour using the given number of workers
+//feeding the workers stops when the context is cancelled
+func importConcurrently(ctx context.Context, tours []Tour, workers int, importTour func(tour *Tour) error) TourImportErrors {
+	if workers < 1 {
+		workers = 1
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		failed  TourImportErrors
+		done    int
+		pending = make(chan Tour)
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tour := range pending {
+				err := importTour(&tour)
+
+				mu.Lock()
+				done++
+				log.Printf("(%d / %d) Tour %d imported\n", done, len(tours), tour.ID)
+				if err != nil {
+					log.Printf("ERROR: tour %d: %s\n", tour.ID, err)
+					failed = append(failed, TourImportError{TourID: tour.ID, Err: err})
+				}
+				mu.Unlock()
 			}
+		}()
+	}
+
+	for _, tour := range tours {
+		//stop feeding the workers when the import is cancelled
+		if ctx.Err() != nil {
+			break
 		}
+		pending <- tour
+	}
+	close(pending)
+	wg.Wait()
+
+	return failed
+}
 
-		//update last import tour date
-		DB.Model(&tour).Where("id = ?", tour.ID).Update(Tour{LastImport: now})
+//importTourData imports the reports of every day elapsed since the last import of a tour
+func importTourData(ctx context.Context, txClient *txtango.Client, tour *Tour, ignoreLastImport bool) (UpsertResult, error) {
+	//if never has been imported, only import from the tour startTime
+	if ignoreLastImport || (tour.LastImport == time.Time{}) {
+		tour.LastImport = tour.StartTime
 	}
 
-	//import data from queue - we do not handle error here as not necessary
-	ImportQueuedToursData(false)
+	//caculate elapsed time betfore last import and queries missing days
+	now := time.Now()
+	diff := int(now.Sub(tour.LastImport).Hours() / 24)
+
+	//for every days elapsed since last import
+	var total UpsertResult
+	for day := diff; day >= 0; day-- {
+		start := tour.LastImport.AddDate(0, 0, -day)
+
+		//import eco monitor report
+		result, err := importEcoMoniorReport(ctx, txClient, tour, start)
+		if err != nil {
+			return total, err
+		}
+		total.Add(result)
+
+		//import activity report
+		result, err = importActivityReport(ctx, txClient, tour, start)
+		if err != nil {
+			return total, err
+		}
+		total.Add(result)
+	}
+
+	//the tour starts with its first report
+	if err := moveTourStart(tour); err != nil {
+		return total, err
+	}
+
+	//update last import tour date
+	if err := DB.Model(tour).Where("id = ?", tour.ID).Update(Tour{LastImport: now}).Error; err != nil {
+		return total, errors.Wrap(err, ErrorDB)
+	}
+
+	return total, nil
+}
+
+//moveTourStart moves the start of a tour to its first activity or eco monitor report
+func moveTourStart(tour *Tour) error {
+	var activity TruckActivityReport
+	err := DB.Where("tour_id = ?", tour.ID).Order("start_time").First(&activity).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return errors.Wrap(err, ErrorDB)
+	}
+	var ecoReport DriverEcoMonitorReport
+	err = DB.Where("tour_id = ?", tour.ID).Order("start_time").First(&ecoReport).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return errors.Wrap(err, ErrorDB)
+	}
+
+	first := activity.StartTime
+	if first.IsZero() || (!ecoReport.StartTime.IsZero() && ecoReport.StartTime.Before(first)) {
+		first = ecoReport.StartTime
+	}
+	if first.IsZero() || !first.After(tour.StartTime) {
+		return nil
+	}
+
+	if err := DB.Model(&Tour{}).Where("id = ?", tour.ID).UpdateColumn("start_time", first).Error; err != nil {
+		return errors.Wrap(err, ErrorDB)
+	}
+	tour.StartTime = first
 
 	return nil
 }
 
 //importActivityReport import the truck activity report of a given tour
-func importActivityReport(tour *Tour, elapsedDay int) error {
-	//wait to do not be blocked by Transics
-	time.Sleep(transicsWaitTime)
-
+//the reports of the day are written in a single transaction
+func importActivityReport(ctx context.Context, txClient *txtango.Client, tour *Tour, start time.Time) (UpsertResult, error) {
 	//build date range
-	start := tour.LastImport.AddDate(0, 0, -elapsedDay)
 	end := start.AddDate(0, 0, 1)
 
 	//import data from transics
-	txTruckActivity, err := txtango.GetActivityReport(tour.TruckTransicsID, start, end)
+	txTruckActivity, err := txClient.GetActivityReport(ctx, tour.TruckTransicsID, start, end)
 	if err != nil {
-		return err
-	}
-
-	//check and return error
-	if txTruckActivity.Body.GetActivityReportV11Response.GetActivityReportV11Result.Errors.Error != (txtango.TXError{}).Error {
-		log.Printf("ERROR: %s - %s\n", txTruckActivity.Body.GetActivityReportV11Response.GetActivityReportV11Result.Errors.Error.Code, txTruckActivity.Body.GetActivityReportV11Response.GetActivityReportV11Result.Errors.Error.Value)
-		err = addTourToQueue(tour, start, tar, txTruckActivity.Body.GetActivityReportV11Response.GetActivityReportV11Result.Errors.Error.Code)
-		if err != nil {
-			log.Fatal(err)
-		}
+		return UpsertResult{}, queueOnTransicsError(tour, start, tar, err)
 	}
 
-	//check and print warning
-	if txTruckActivity.Body.GetActivityReportV11Response.GetActivityReportV11Result.Warnings.Warning != (txtango.TXWarning{}).Warning {
-		log.Printf("WARNING: %s - %s\n", txTruckActivity.Body.GetActivityReportV11Response.GetActivityReportV11Result.Warnings.Warning.Code, txTruckActivity.Body.GetActivityReportV11Response.GetActivityReportV11Result.Warnings.Warning.Value)
-	}
+	//check and print warnings
+	logTransicsWarnings(txTruckActivity.Body.GetActivityReportV11Response.GetActivityReportV11Result.Warnings)
 
 	//check if the data is actually present
 	if len(txTruckActivity.Body.GetActivityReportV11Response.GetActivityReportV11Result.ActivityReportItems.ActivityReportItemV11) == 0 {
-		err = addTourToQueue(tour, start, tar, reasonQueueNoData)
-		if err != nil {
-			log.Fatal(err)
-		}
+		return UpsertResult{}, addTourToQueue(tour, start, tar, reasonQueueNoData)
 	}
 
+	var rows []interface{}
 	for _, data := range txTruckActivity.Body.GetActivityReportV11Response.GetActivityReportV11Result.ActivityReportItems.ActivityReportItemV11 {
 		//parse begin and end date into time.Time
 		startTime, err := time.Parse("2006-01-02T15:04:05", data.BeginDate)
@@ -269,10 +405,9 @@ func importActivityReport(tour *Tour, elapsedDay int) error {
 			endTime = time.Time{}
 		}
 
-		//check if date is contained in tour date boundaries
-		if tour.StartTime.Before(startTime) && (tour.EndTime.After(endTime) || tour.EndTime == time.Time{}) {
-			var truckActivity = TruckActivityReport{}
-			newTruckActivity := TruckActivityReport{
+		//check if date is contained in tour date boundaries, the first report starts the tour
+		if !startTime.Before(tour.StartTime) && (tour.EndTime.After(endTime) || tour.EndTime == time.Time{}) {
+			rows = append(rows, &TruckActivityReport{
 				TourID:          tour.ID,
 				TruckTransicsID: tour.TruckTransicsID,
 				KmBegin:         data.KmBegin,
@@ -288,65 +423,63 @@ func importActivityReport(tour *Tour, elapsedDay int) error {
 				Reference:       data.Reference,
 				StartTime:       startTime,
 				EndTime:         endTime,
-			}
-
-			if err := DB.Where(TruckActivityReport{TourID: newTruckActivity.TourID, StartTime: newTruckActivity.StartTime}).First(&truckActivity).Error; err != nil {
-				if err != gorm.ErrRecordNotFound {
-					return errors.Wrap(err, ErrorDB)
-				}
+			})
+		}
+	}
 
-				//add truck activty
-				DB.Create(&newTruckActivity)
-				log.Printf("TruckActivity added in tour %d\n", tour.ID)
-			} else if truckActivity != newTruckActivity {
-				//update activity report
-				DB.Model(&truckActivity).Where(truckActivity).Update(newTruckActivity)
-				log.Printf("TruckActivity updated in tour %d\n", tour.ID)
+	//write the whole day at once
+	var result UpsertResult
+	err = inTransaction(func(tx *gorm.DB) error {
+		//attribute every activity to the driver in the truck at that time
+		for _, row := range rows {
+			activity := row.(*TruckActivityReport)
+			driverID, err := driverAt(tx, activity.TruckTransicsID, activity.StartTime)
+			if err != nil {
+				return err
+			}
+			if driverID == 0 {
+				driverID = tour.DriverTransicsID
 			}
+			activity.DriverTransicsID = driverID
+		}
 
+		result, err = upsertTourReports(tx, tour.ID, rows)
+		if err != nil {
+			return err
 		}
+		//the day is imported, it leaves the queue
+		return removeFromQueue(tx, tourQueueItem(tour, start, tar))
+	})
+	if err != nil {
+		return UpsertResult{}, err
 	}
+	log.Printf("TruckActivity of tour %d on %s: %s\n", tour.ID, start.Format("2006-01-02"), result)
 
-	return nil
+	return result, nil
 }
 
-//importActivityReport import the driver eco monitor of given a tour
-func importEcoMoniorReport(tour *Tour, elapsedDay int) error {
-	//wait to do not be blocked by Transics
-	time.Sleep(transicsWaitTime)
-
+//importEcoMoniorReport import the driver eco monitor of given a tour
+//the reports of the period are written in a single transaction
+func importEcoMoniorReport(ctx context.Context, txClient *txtango.Client, tour *Tour, start time.Time) (UpsertResult, error) {
 	//build date range
-	start := tour.LastImport.AddDate(0, 0, -elapsedDay)
 	end := start.AddDate(0, 0, 3)
 
 	//import data from transics
-	txDriverEcoMonitor, err := txtango.GetEcoReport(tour.DriverTransicsID, start, end)
+	txDriverEcoMonitor, err := txClient.GetEcoReport(ctx, tour.DriverTransicsID, start, end)
 	if err != nil {
-		return err
+		return UpsertResult{}, queueOnTransicsError(tour, start, emr, err)
 	}
 
-	//check and return error
-	if txDriverEcoMonitor.Body.GetEcoMonitorReportV4Response.GetEcoMonitorReportV4Result.Errors.Error != (txtango.TXError{}).Error {
-		log.Printf("ERROR: %s - %s\n", txDriverEcoMonitor.Body.GetEcoMonitorReportV4Response.GetEcoMonitorReportV4Result.Errors.Error.Code, txDriverEcoMonitor.Body.GetEcoMonitorReportV4Response.GetEcoMonitorReportV4Result.Errors.Error.Value)
-		err = addTourToQueue(tour, start, emr, txDriverEcoMonitor.Body.GetEcoMonitorReportV4Response.GetEcoMonitorReportV4Result.Errors.Error.Code)
-		if err != nil {
-			log.Fatal(err)
-		}
-	}
-
-	//check and print warning
-	if txDriverEcoMonitor.Body.GetEcoMonitorReportV4Response.GetEcoMonitorReportV4Result.Warnings.Warning != (txtango.TXWarning{}).Warning {
-		log.Printf("WARNING: %s - %s\n", txDriverEcoMonitor.Body.GetEcoMonitorReportV4Response.GetEcoMonitorReportV4Result.Warnings.Warning.Code, txDriverEcoMonitor.Body.GetEcoMonitorReportV4Response.GetEcoMonitorReportV4Result.Warnings.Warning.Value)
-	}
+	//check and print warnings
+	logTransicsWarnings(txDriverEcoMonitor.Body.GetEcoMonitorReportV4Response.GetEcoMonitorReportV4Result.Warnings)
 
 	//check if the data is actually present
 	if len(txDriverEcoMonitor.Body.GetEcoMonitorReportV4Response.GetEcoMonitorReportV4Result.EcoMonitorReportItems.EcoMonitorReportItemV3) == 0 {
-		err = addTourToQueue(tour, start, emr, reasonQueueNoData)
-		if err != nil {
-			log.Fatal(err)
-		}
+		return UpsertResult{}, addTourToQueue(tour, start, emr, reasonQueueNoData)
 	}
 
+	var rows []interface{}
+	var assignments []DriverAssignment
 	for _, data := range txDriverEcoMonitor.Body.GetEcoMonitorReportV4Response.GetEcoMonitorReportV4Result.EcoMonitorReportItems.EcoMonitorReportItemV3 {
 		//parse begin and end date into time.Time
 		startTime, err := time.Parse("2006-01-02T15:04:05", data.BeginDate)
@@ -361,11 +494,26 @@ func importEcoMoniorReport(tour *Tour, elapsedDay int) error {
 			endTime = time.Time{}
 		}
 
-		//check if date is contained in tour date boundaries
-		if tour.StartTime.Before(startTime) && (tour.EndTime.After(endTime) || tour.EndTime == time.Time{}) {
-			var ecoMonitor = DriverEcoMonitorReport{}
-			newEcoMonitor := DriverEcoMonitorReport{
+		//every trip tells who drove the truck
+		assignment := DriverAssignment{
+			TruckTransicsID:  data.Vehicle.TransicsID,
+			DriverTransicsID: data.Driver.TransicsID,
+			StartTime:        startTime,
+			EndTime:          endTime,
+		}
+		if assignment.TruckTransicsID == 0 {
+			assignment.TruckTransicsID = tour.TruckTransicsID
+		}
+		if assignment.DriverTransicsID == 0 {
+			assignment.DriverTransicsID = tour.DriverTransicsID
+		}
+		assignments = append(assignments, assignment)
+
+		//check if date is contained in tour date boundaries, the first report starts the tour
+		if !startTime.Before(tour.StartTime) && (tour.EndTime.After(endTime) || tour.EndTime == time.Time{}) {
+			rows = append(rows, &DriverEcoMonitorReport{
 				TourID:                       tour.ID,
+				TruckTransicsID:              assignment.TruckTransicsID,
 				DriverTransicsID:             tour.DriverTransicsID,
 				Distance:                     data.DataResult.Distance,
 				DurationDriving:              data.DataResult.Duration,
@@ -409,24 +557,30 @@ func importEcoMoniorReport(tour *Tour, elapsedDay int) error {
 				AvgFuelConsumptionCruiseControlInkmPerLiter:        data.CruisingResult.AvgFuelConsumptionCruiseControlInkmPerLiter,
 				StartTime: startTime,
 				EndTime:   endTime,
-			}
-
-			//add eco monitor for driver
-			if err := DB.Where(&DriverEcoMonitorReport{TourID: newEcoMonitor.TourID, StartTime: newEcoMonitor.StartTime}).First(&ecoMonitor).Error; err != nil {
-				if err != gorm.ErrRecordNotFound {
-					return errors.Wrap(err, ErrorDB)
-				}
+			})
+		}
+	}
 
-				//add ecomonitor report
-				DB.Create(&newEcoMonitor)
-				log.Printf("EcoMonitorReport added in tour %d\n", tour.ID)
-			} else if ecoMonitor != newEcoMonitor {
-				//update ecomonitor report
-				DB.Model(&ecoMonitor).Where(ecoMonitor).Update(newEcoMonitor)
-				log.Printf("EcoMonitorReport updated in tour %d\n", tour.ID)
+	//write the whole period at once
+	var result UpsertResult
+	err = inTransaction(func(tx *gorm.DB) error {
+		for _, assignment := range assignments {
+			err := recordEcoAssignment(tx, assignment.TruckTransicsID, assignment.DriverTransicsID, assignment.StartTime, assignment.EndTime)
+			if err != nil {
+				return err
 			}
 		}
+
+		result, err = upsertTourReports(tx, tour.ID, rows)
+		if err != nil {
+			return err
+		}
+		return removeFromQueue(tx, tourQueueItem(tour, start, emr))
+	})
+	if err != nil {
+		return UpsertResult{}, err
 	}
+	log.Printf("EcoMonitorReport of tour %d on %s: %s\n", tour.ID, start.Format("2006-01-02"), result)
 
-	return nil
+	return result, nil
 }
@@ -0,0 +1,261 @@
+package database_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+	"tx2db/txtango/txtangotest"
+)
+
+//testTours returns tours with the ids 1 to n
+func testTours(n int) []database.Tour {
+	tours := make([]database.Tour, n)
+	for i := range tours {
+		tours[i].ID = uint(i + 1)
+	}
+	return tours
+}
+
+func TestImportConcurrently(t *testing.T) {
+	tests := []struct {
+		name        string
+		tours       int
+		workers     int
+		failing     map[uint]bool
+		wantWorkers int
+	}{
+		{"every worker is used", 10, 4, nil, 4},
+		{"no more workers than tours", 2, 4, nil, 2},
+		{"at least one worker", 3, 0, nil, 1},
+		{"failures are collected", 6, 3, map[uint]bool{2: true, 5: true}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu                sync.Mutex
+				imported          []int
+				running, inFlight int
+			)
+			failed := database.ImportConcurrently(context.Background(), testTours(tt.tours), tt.workers, func(tour *database.Tour) error {
+				mu.Lock()
+				running++
+				if running > inFlight {
+					inFlight = running
+				}
+				mu.Unlock()
+
+				//let the other workers pick a tour
+				for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+					mu.Lock()
+					busy := inFlight >= tt.wantWorkers
+					mu.Unlock()
+					if busy {
+						break
+					}
+				}
+
+				mu.Lock()
+				running--
+				imported = append(imported, int(tour.ID))
+				mu.Unlock()
+
+				if tt.failing[tour.ID] {
+					return errors.New("no data")
+				}
+				return nil
+			})
+
+			if len(imported) != tt.tours {
+				t.Errorf("got %d tours imported, want %d", len(imported), tt.tours)
+			}
+			sort.Ints(imported)
+			for i, id := range imported {
+				if id != i+1 {
+					t.Fatalf("got tours %v, want every tour once", imported)
+				}
+			}
+			if inFlight != tt.wantWorkers {
+				t.Errorf("got %d tours imported at the same time, want %d", inFlight, tt.wantWorkers)
+			}
+
+			if len(failed) != len(tt.failing) {
+				t.Fatalf("got failures %v, want %d", failed, len(tt.failing))
+			}
+			for _, tourErr := range failed {
+				if !tt.failing[tourErr.TourID] || tourErr.Err == nil {
+					t.Errorf("unexpected failure %+v", tourErr)
+				}
+			}
+		})
+	}
+}
+
+func TestImportConcurrentlyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var imported int
+	database.ImportConcurrently(ctx, testTours(10), 2, func(tour *database.Tour) error {
+		mu.Lock()
+		defer mu.Unlock()
+		imported++
+		//the import is cancelled while the first tours are imported
+		if imported == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	//at most a tour per worker is picked after the cancellation
+	if imported > 4 {
+		t.Errorf("got %d tours imported after the cancellation, want at most 4", imported)
+	}
+}
+
+func TestTourImportErrors(t *testing.T) {
+	err := database.TourImportErrors{{TourID: 1, Err: errors.New("timeout")}, {TourID: 3, Err: errors.New("no data")}}
+	if got, want := err.Error(), "2 tours failed to import (tour 1: timeout; tour 3: no data)"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+//importTestTour imports driver 1 in truck 100 and starts its tour at the given time
+func importTestTour(t *testing.T, server *txtangotest.Server, start time.Time) database.Tour {
+	t.Helper()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.AddDriver(txtangotest.Driver{TransicsID: 1, PersonID: "P1", Name: "Jan Peeters", Language: "NL"})
+	server.AddVehicle(txtangotest.Vehicle{TransicsID: 100, LicensePlate: "1-ABC-123", Group: "North", DriverTransicsID: 1,
+		DestinationLongitude: 4.4, DestinationLatitude: 51.2})
+	if err := database.ImportDrivers(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	if err := database.ImportTrucks(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+
+	var tour database.Tour
+	if err := database.DB.First(&tour).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := database.DB.Model(&tour).Update("start_time", start).Error; err != nil {
+		t.Fatal(err)
+	}
+	tour.StartTime = start
+
+	return tour
+}
+
+func TestImportToursData(t *testing.T) {
+	defer databasetest.Open(t)()
+	server, client := startTestServer(t)
+	defer server.Close()
+
+	yesterday := day(-1)
+	tour := importTestTour(t, server, yesterday)
+
+	server.AddActivity(100,
+		txtangotest.Activity{Begin: yesterday.Add(6 * time.Hour), End: yesterday.Add(8 * time.Hour), KmBegin: 1000, KmEnd: 1150, Name: "Driving", CountryCode: "BEL"},
+		txtangotest.Activity{Begin: yesterday.Add(8 * time.Hour), End: yesterday.Add(9 * time.Hour), KmBegin: 1150, KmEnd: 1150, Name: "Loading", CountryCode: "BEL"},
+	)
+	server.AddEcoReport(1,
+		txtangotest.EcoReport{VehicleTransicsID: 100, Begin: yesterday.Add(6 * time.Hour), End: yesterday.Add(8 * time.Hour), Distance: 150, FuelConsumption: 45, NumberOfPanicBrakes: 2},
+	)
+
+	if err := database.ImportToursData(context.Background(), client, false, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	var activities []database.TruckActivityReport
+	if err := database.DB.Order("start_time").Find(&activities).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(activities) != 2 {
+		t.Fatalf("got %d activities, want 2", len(activities))
+	}
+	if a := activities[0]; a.TourID != tour.ID || a.TruckTransicsID != 100 || a.DriverTransicsID != 1 || a.Activity != "Driving" || a.KmEnd-a.KmBegin != 150 || a.CountryCode != "BEL" {
+		t.Errorf("activity stored as %+v", a)
+	}
+
+	var reports []database.DriverEcoMonitorReport
+	if err := database.DB.Find(&reports).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("got %d eco monitor reports, want 1", len(reports))
+	}
+	if r := reports[0]; r.TourID != tour.ID || r.TruckTransicsID != 100 || r.DriverTransicsID != 1 || r.Distance != 150 || r.FuelConsumption != 45 || r.NumberOfPanicBrakes != 2 {
+		t.Errorf("eco monitor report stored as %+v", r)
+	}
+
+	//the tour is marked as imported
+	if err := database.DB.First(&tour, tour.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if tour.LastImport.IsZero() {
+		t.Error("last import of the tour not set")
+	}
+	//the tour starts with its first report
+	if start := yesterday.Add(6 * time.Hour); !tour.StartTime.Equal(start) {
+		t.Errorf("tour starts at %s, want %s", tour.StartTime, start)
+	}
+}
+
+func TestImportToursDataIsIdempotent(t *testing.T) {
+	defer databasetest.Open(t)()
+	server, client := startTestServer(t)
+	defer server.Close()
+
+	yesterday := day(-1)
+	importTestTour(t, server, yesterday)
+	server.AddActivity(100, txtangotest.Activity{Begin: yesterday.Add(6 * time.Hour), End: yesterday.Add(8 * time.Hour), Name: "Driving"})
+
+	//importing twice the same day does not duplicate the reports
+	for i := 0; i < 2; i++ {
+		if err := database.ImportToursData(context.Background(), client, true, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var count int
+	database.DB.Model(&database.TruckActivityReport{}).Count(&count)
+	if count != 1 {
+		t.Errorf("got %d activities, want 1", count)
+	}
+}
+
+func TestImportToursDataQueuesMissingData(t *testing.T) {
+	defer databasetest.Open(t)()
+	server, client := startTestServer(t)
+	defer server.Close()
+
+	tour := importTestTour(t, server, day(0))
+	server.SetHTTPStatus(txtangotest.GetActivityReport, 503)
+
+	//a temporary failure does not fail the import, the tour is queued
+	if err := database.ImportToursData(context.Background(), client, false, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	queue, err := database.QueueItems(database.QueueStatusPending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var queued bool
+	for _, item := range queue {
+		queued = queued || (item.TourID == tour.ID && item.ReportType == "tar" && item.ReasonCode == "HTTP_503")
+	}
+	if !queued {
+		t.Errorf("got queue %+v, want the activities of the tour queued after HTTP_503", queue)
+	}
+}
@@ -1,8 +1,8 @@
 package database
 
 import (
+	"context"
 	"log"
-	"sync"
 	"time"
 	"tx2db/txtango"
 
@@ -38,30 +38,29 @@ type Trailer struct {
 }
 
 //ImportTrucks imports all the trucks from TX-Tango and fill the database
-func ImportTrucks(wg *sync.WaitGroup) error {
-	//notify WaitGroup that we're done
-	defer wg.Done()
-
+func ImportTrucks(ctx context.Context, txClient *txtango.Client) error {
 	//import data from transics
 	log.Println(loadingDataFromTransics)
-	txVehicle, err := txtango.GetVehicle()
+	txVehicle, err := txClient.GetVehicle(ctx)
 	if err != nil {
 		return err
 	}
 
-	//check and return error
-	if txVehicle.Body.GetVehiclesV13Response.GetVehiclesV13Result.Errors.Error != (txtango.TXError{}).Error {
-		log.Printf("ERROR: %s - %s\n", txVehicle.Body.GetVehiclesV13Response.GetVehiclesV13Result.Errors.Error.Code, txVehicle.Body.GetVehiclesV13Response.GetVehiclesV13Result.Errors.Error.Value)
-	}
+	//check and print warnings
+	logTransicsWarnings(txVehicle.Body.GetVehiclesV13Response.GetVehiclesV13Result.Warnings)
 
-	//check and print warning
-	if txVehicle.Body.GetVehiclesV13Response.GetVehiclesV13Result.Warnings.Warning != (txtango.TXWarning{}).Warning {
-		log.Printf("WARNING: %s - %s\n", txVehicle.Body.GetVehiclesV13Response.GetVehiclesV13Result.Warnings.Warning.Code, txVehicle.Body.GetVehiclesV13Response.GetVehiclesV13Result.Warnings.Warning.Value)
-	}
+	//every vehicle of the response has been polled at the same time
+	takenAt := time.Now()
 
 	for i, data := range txVehicle.Body.GetVehiclesV13Response.GetVehiclesV13Result.Vehicles.InterfaceVehicleResultV13 {
-		//import trailer of a vehicle asynchronously
-		go addTrailer(&data.Trailer)
+		//stop when the import is cancelled
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		//import trailer of a vehicle asynchronously, on a copy as data is reused by the loop
+		trailer := data.Trailer
+		go addTrailer(&trailer)
 
 		//parse modified date into time.Time if existing
 		modifiedDate, err := time.Parse("2006-01-02T15:04:05", data.Modified)
@@ -105,6 +104,16 @@ func ImportTrucks(wg *sync.WaitGroup) error {
 			log.Print(err)
 		}
 
+		//record who drives the truck
+		if err := recordSnapshotAssignment(truck.TransicsID, data.Driver.TransicsID, takenAt); err != nil {
+			return err
+		}
+
+		//keep the history of the vehicle (odometer, fuel level, ETA...)
+		if err := saveVehicleSnapshot(&data, takenAt); err != nil {
+			return err
+		}
+
 		//add truck to group
 		addGroup(&newTruck, data.Groups.TxConnectGroups.ConnectGroups.ConnectGroup[0].SubGroup)
 	}
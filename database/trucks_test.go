@@ -0,0 +1,112 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+	"tx2db/txtango/txtangotest"
+)
+
+func TestImportTrucks(t *testing.T) {
+	defer databasetest.Open(t)()
+	server, client := startTestServer(t)
+	defer server.Close()
+
+	modified := time.Date(2020, 2, 10, 8, 0, 0, 0, time.UTC)
+	server.AddVehicle(
+		txtangotest.Vehicle{TransicsID: 100, LicensePlate: "1-ABC-123", Modified: modified, Group: "North", DriverTransicsID: 1, DestinationLongitude: 4.4, DestinationLatitude: 51.2,
+			ETAStatus: "ON_TIME", CurrentKms: 120000, FuelLevel: 80},
+		txtangotest.Vehicle{TransicsID: 200, LicensePlate: "1-DEF-456", Modified: modified, Group: "North"},
+	)
+
+	if err := database.ImportTrucks(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+
+	var trucks []database.Truck
+	if err := database.DB.Order("transics_id").Find(&trucks).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(trucks) != 2 {
+		t.Fatalf("got %d trucks, want 2", len(trucks))
+	}
+	if truck := trucks[0]; truck.TransicsID != 100 || truck.LicensePlate != "1-ABC-123" || !truck.LastModified.Equal(modified) {
+		t.Errorf("truck 100 stored as %+v", truck)
+	}
+
+	var group database.TruckGroup
+	if err := database.DB.Where("name = ?", "North").First(&group).Error; err != nil {
+		t.Fatal(err)
+	}
+	for _, truck := range trucks {
+		if truck.TruckGroupID != group.ID {
+			t.Errorf("truck %d in group %d, want %d", truck.TransicsID, truck.TruckGroupID, group.ID)
+		}
+	}
+
+	//only the truck with a driver gets a tour
+	var tours []database.Tour
+	if err := database.DB.Find(&tours).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(tours) != 1 || tours[0].TruckTransicsID != 100 || tours[0].DriverTransicsID != 1 || tours[0].Status != "ON_TIME" {
+		t.Errorf("got tours %+v, want an open tour of driver 1 in truck 100", tours)
+	}
+	//until its reports are imported the tour starts at the start of the day
+	now := time.Now()
+	if midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()); len(tours) == 1 && !tours[0].StartTime.Equal(midnight) {
+		t.Errorf("tour starts at %s, want %s", tours[0].StartTime, midnight)
+	}
+
+	//every vehicle gets a snapshot, the driver is assigned to the truck
+	var snapshots []database.VehicleSnapshot
+	if err := database.DB.Order("truck_transics_id").Find(&snapshots).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 2 {
+		t.Fatalf("got %d snapshots, want 2", len(snapshots))
+	}
+	if s := snapshots[0]; s.CurrentKms == nil || *s.CurrentKms != 120000 || s.FuelLevel == nil || *s.FuelLevel != 80 || s.DriverTransicsID != 1 {
+		t.Errorf("snapshot of truck 100 stored as %+v", s)
+	}
+	driverID, err := database.DriverAt(100, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if driverID != 1 {
+		t.Errorf("got driver %d in truck 100, want 1", driverID)
+	}
+}
+
+func TestImportTrucksDriverChange(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	modified := time.Date(2020, 2, 10, 8, 0, 0, 0, time.UTC)
+	for _, driverID := range []uint{1, 2} {
+		server, client := startTestServer(t)
+		server.AddVehicle(txtangotest.Vehicle{TransicsID: 100, LicensePlate: "1-ABC-123", Modified: modified, Group: "North", DriverTransicsID: driverID,
+			DestinationLongitude: 4.4, DestinationLatitude: 51.2})
+		err := database.ImportTrucks(context.Background(), client)
+		server.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	//the tour of the first driver is ended, the second driver gets a new one
+	var tours []database.Tour
+	if err := database.DB.Order("id").Find(&tours).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(tours) != 2 {
+		t.Fatalf("got %d tours, want 2", len(tours))
+	}
+	if tours[0].DriverTransicsID != 1 || tours[0].EndTime.IsZero() {
+		t.Errorf("tour of driver 1 stored as %+v, want ended", tours[0])
+	}
+	if tours[1].DriverTransicsID != 2 || !tours[1].EndTime.IsZero() {
+		t.Errorf("tour of driver 2 stored as %+v, want open", tours[1])
+	}
+}
@@ -0,0 +1,288 @@
+package database
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+//maxUpsertParams keeps a statement under the parameter limit of every dialect (999 for sqlite)
+const maxUpsertParams = 900
+
+//tourReportKeys is the unique key of the reports of a tour
+var tourReportKeys = []string{"tour_id", "start_time"}
+
+//driverReportKeys is the unique key of the reports of a driver
+var driverReportKeys = []string{"driver_transics_id", "start_time"}
+
+//UpsertResult counts what an upsert did with the given rows
+type UpsertResult struct {
+	Inserted  int
+	Updated   int
+	Unchanged int
+}
+
+//Add adds the counts of another upsert
+func (r *UpsertResult) Add(other UpsertResult) {
+	r.Inserted += other.Inserted
+	r.Updated += other.Updated
+	r.Unchanged += other.Unchanged
+}
+
+func (r UpsertResult) String() string {
+	return fmt.Sprintf("%d inserted, %d updated, %d unchanged", r.Inserted, r.Updated, r.Unchanged)
+}
+
+//inTransaction runs fn in a transaction, rolled back when fn fails
+func inTransaction(fn func(tx *gorm.DB) error) error {
+	tx := DB.Begin()
+	if tx.Error != nil {
+		return errors.Wrap(tx.Error, ErrorDB)
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+//upsertTourReports writes the reports of a tour keyed by (tour_id, start_time)
+//rows must be pointers to the same model, the existing rows are compared to only write the changed ones
+func upsertTourReports(tx *gorm.DB, tourID uint, rows []interface{}) (UpsertResult, error) {
+	return upsertReports(tx, tourReportKeys, tourID, rows)
+}
+
+//upsertDriverReports writes the reports of a driver keyed by (driver_transics_id, start_time)
+//rows must be pointers to the same model, the existing rows are compared to only write the changed ones
+func upsertDriverReports(tx *gorm.DB, driverID uint, rows []interface{}) (UpsertResult, error) {
+	return upsertReports(tx, driverReportKeys, driverID, rows)
+}
+
+//upsertReports writes the reports of an owner, keys are the owner column followed by start_time
+func upsertReports(tx *gorm.DB, keys []string, ownerID uint, rows []interface{}) (UpsertResult, error) {
+	var result UpsertResult
+	if len(rows) == 0 {
+		return result, nil
+	}
+
+	//the latest row wins when Transics returns the same start time twice
+	rows = uniqueRows(tx, keys, rows)
+
+	//get the existing rows of the time range, deleted ones included
+	first, last := startTimeRange(tx, rows)
+	existing := reflect.New(reflect.SliceOf(reflect.TypeOf(rows[0]).Elem()))
+	err := tx.Unscoped().Where(keys[0]+" = ? AND start_time >= ? AND start_time <= ?", ownerID, first, last).Find(existing.Interface()).Error
+	if err != nil {
+		return result, errors.Wrap(err, ErrorDB)
+	}
+
+	byKey := make(map[string]interface{}, existing.Elem().Len())
+	for i := 0; i < existing.Elem().Len(); i++ {
+		row := existing.Elem().Index(i).Addr().Interface()
+		byKey[rowKey(tx, keys, row)] = row
+	}
+
+	//only write new and changed rows
+	var changed []interface{}
+	for _, row := range rows {
+		old, ok := byKey[rowKey(tx, keys, row)]
+		switch {
+		case !ok:
+			result.Inserted++
+		case !sameRow(tx, old, row):
+			result.Updated++
+		default:
+			result.Unchanged++
+			continue
+		}
+		changed = append(changed, row)
+	}
+
+	if err := upsertRows(tx, changed, keys); err != nil {
+		return UpsertResult{}, errors.Wrap(err, ErrorDB)
+	}
+
+	return result, nil
+}
+
+//upsertRows inserts the rows or updates them when their keys already exist
+func upsertRows(tx *gorm.DB, rows []interface{}, keys []string) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	scope := tx.NewScope(rows[0])
+	columns := []string{"created_at", "updated_at"}
+	columns = append(columns, dataColumns(scope)...)
+
+	//split the rows so no statement exceeds the parameter limit
+	size := maxUpsertParams / len(columns)
+	now := gorm.NowFunc()
+	for start := 0; start < len(rows); start += size {
+		end := start + size
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		var values []interface{}
+		for _, row := range rows[start:end] {
+			values = append(values, now, now)
+			rowScope := tx.NewScope(row)
+			for _, column := range columns[2:] {
+				field, _ := rowScope.FieldByName(column)
+				values = append(values, field.Field.Interface())
+			}
+		}
+
+		statement := upsertStatement(tx.Dialect(), scope.TableName(), columns, keys, end-start)
+		if err := tx.Exec(statement, values...).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//upsertStatement builds the upsert of rowCount rows for the dialect, MERGE for mssql, ON CONFLICT otherwise
+func upsertStatement(d gorm.Dialect, table string, columns, keys []string, rowCount int) string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = d.Quote(column)
+	}
+
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ") + ")"
+	rowValues := strings.TrimSuffix(strings.Repeat(placeholders+", ", rowCount), ", ")
+
+	//every column but the keys and the creation date is updated, a deleted row is restored
+	updates := []string{d.Quote("deleted_at") + " = NULL"}
+	isKey := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		isKey[key] = true
+	}
+
+	if d.GetName() == DriverMSSQL {
+		updates[0] = "target." + updates[0]
+		var on, inserted []string
+		for _, key := range keys {
+			on = append(on, fmt.Sprintf("target.%s = source.%s", d.Quote(key), d.Quote(key)))
+		}
+		for i, column := range columns {
+			inserted = append(inserted, "source."+quoted[i])
+			if !isKey[column] && column != "created_at" {
+				updates = append(updates, fmt.Sprintf("target.%s = source.%s", quoted[i], quoted[i]))
+			}
+		}
+
+		return fmt.Sprintf("MERGE INTO %s WITH (HOLDLOCK) AS target USING (VALUES %s) AS source (%s) ON %s WHEN MATCHED THEN UPDATE SET %s WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s);",
+			d.Quote(table), rowValues, strings.Join(quoted, ", "), strings.Join(on, " AND "), strings.Join(updates, ", "), strings.Join(quoted, ", "), strings.Join(inserted, ", "))
+	}
+
+	var conflict []string
+	for _, key := range keys {
+		conflict = append(conflict, d.Quote(key))
+	}
+	for i, column := range columns {
+		if !isKey[column] && column != "created_at" {
+			updates = append(updates, fmt.Sprintf("%s = excluded.%s", quoted[i], quoted[i]))
+		}
+	}
+
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES %s ON CONFLICT (%s) DO UPDATE SET %s",
+		d.Quote(table), strings.Join(quoted, ", "), rowValues, strings.Join(conflict, ", "), strings.Join(updates, ", "))
+}
+
+//dataColumns returns the columns of a model without the id and the gorm timestamps
+func dataColumns(scope *gorm.Scope) []string {
+	var columns []string
+	for _, field := range scope.Fields() {
+		if !field.IsNormal || field.IsIgnored || field.IsPrimaryKey {
+			continue
+		}
+		switch field.DBName {
+		case "created_at", "updated_at", "deleted_at":
+			continue
+		}
+		columns = append(columns, field.DBName)
+	}
+	return columns
+}
+
+//sameRow compares the data of an existing row with a new one, a deleted row is never the same
+func sameRow(tx *gorm.DB, old, row interface{}) bool {
+	oldScope, newScope := tx.NewScope(old), tx.NewScope(row)
+	if deletedAt, ok := oldScope.FieldByName("deleted_at"); ok && !deletedAt.IsBlank {
+		return false
+	}
+
+	for _, column := range dataColumns(newScope) {
+		oldField, _ := oldScope.FieldByName(column)
+		newField, _ := newScope.FieldByName(column)
+
+		oldValue, newValue := oldField.Field.Interface(), newField.Field.Interface()
+		if oldTime, ok := oldValue.(time.Time); ok {
+			if !oldTime.Equal(newValue.(time.Time)) {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(oldValue, newValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+//rowKey identifies a report by the values of its keys, times by their instant
+func rowKey(tx *gorm.DB, keys []string, row interface{}) string {
+	scope := tx.NewScope(row)
+	values := make([]string, len(keys))
+	for i, key := range keys {
+		field, _ := scope.FieldByName(key)
+		if t, ok := field.Field.Interface().(time.Time); ok {
+			values[i] = fmt.Sprint(t.UnixNano())
+			continue
+		}
+		values[i] = fmt.Sprint(field.Field.Interface())
+	}
+
+	return strings.Join(values, "-")
+}
+
+//uniqueRows removes the rows with the same key, keeping the latest one
+func uniqueRows(tx *gorm.DB, keys []string, rows []interface{}) []interface{} {
+	index := make(map[string]int, len(rows))
+	var unique []interface{}
+	for _, row := range rows {
+		key := rowKey(tx, keys, row)
+		if i, ok := index[key]; ok {
+			unique[i] = row
+			continue
+		}
+		index[key] = len(unique)
+		unique = append(unique, row)
+	}
+	return unique
+}
+
+//startTimeRange returns the first and last start time of the rows
+func startTimeRange(tx *gorm.DB, rows []interface{}) (time.Time, time.Time) {
+	var first, last time.Time
+	for i, row := range rows {
+		field, _ := tx.NewScope(row).FieldByName("start_time")
+		startTime := field.Field.Interface().(time.Time)
+		if i == 0 || startTime.Before(first) {
+			first = startTime
+		}
+		if i == 0 || startTime.After(last) {
+			last = startTime
+		}
+	}
+	return first, last
+}
@@ -0,0 +1,141 @@
+package database_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"tx2db/database"
+	"tx2db/database/databasetest"
+
+	"github.com/jinzhu/gorm"
+)
+
+func TestUpsertStatement(t *testing.T) {
+	columns := []string{"created_at", "updated_at", "tour_id", "start_time", "km_end"}
+	keys := []string{"tour_id", "start_time"}
+
+	tests := []struct {
+		driver string
+		rows   int
+		want   []string
+		//wantNot are parts which must not be in the statement
+		wantNot []string
+	}{
+		{
+			driver: database.DriverMSSQL,
+			rows:   2,
+			want: []string{
+				`MERGE INTO [truck_activity_reports] WITH (HOLDLOCK) AS target USING (VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)) AS source`,
+				`ON target.[tour_id] = source.[tour_id] AND target.[start_time] = source.[start_time]`,
+				`WHEN MATCHED THEN UPDATE SET target.[deleted_at] = NULL, target.[updated_at] = source.[updated_at], target.[km_end] = source.[km_end]`,
+				`WHEN NOT MATCHED THEN INSERT ([created_at], [updated_at], [tour_id], [start_time], [km_end]) VALUES (source.[created_at],`,
+			},
+			wantNot: []string{"ON CONFLICT", `target.[created_at] =`},
+		},
+		{
+			driver: database.DriverPostgres,
+			rows:   1,
+			want: []string{
+				`INSERT INTO "truck_activity_reports" ("created_at", "updated_at", "tour_id", "start_time", "km_end") VALUES (?, ?, ?, ?, ?)`,
+				`ON CONFLICT ("tour_id", "start_time") DO UPDATE SET "deleted_at" = NULL, "updated_at" = excluded."updated_at", "km_end" = excluded."km_end"`,
+			},
+			wantNot: []string{"MERGE", `"created_at" = excluded`, `"tour_id" = excluded`},
+		},
+		{
+			driver: database.DriverSQLite,
+			rows:   3,
+			want: []string{
+				`VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?), (?, ?, ?, ?, ?) ON CONFLICT`,
+				`DO UPDATE SET "deleted_at" = NULL`,
+			},
+			wantNot: []string{"MERGE"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.driver, func(t *testing.T) {
+			dialect, ok := gorm.GetDialect(tt.driver)
+			if !ok {
+				t.Fatalf("dialect %s not registered", tt.driver)
+			}
+
+			got := database.UpsertStatement(dialect, "truck_activity_reports", columns, keys, tt.rows)
+			for _, part := range tt.want {
+				if !strings.Contains(got, part) {
+					t.Errorf("got statement\n%s\nwant it to contain\n%s", got, part)
+				}
+			}
+			for _, part := range tt.wantNot {
+				if strings.Contains(got, part) {
+					t.Errorf("got statement\n%s\nwant it without\n%s", got, part)
+				}
+			}
+		})
+	}
+}
+
+func TestUpsertTourReports(t *testing.T) {
+	defer databasetest.Open(t)()
+
+	start := time.Date(2020, 2, 10, 8, 0, 0, 0, time.UTC)
+	activity := func(hour, km int) *database.TruckActivityReport {
+		return &database.TruckActivityReport{TourID: 1, StartTime: start.Add(time.Duration(hour) * time.Hour), KmEnd: km}
+	}
+
+	tests := []struct {
+		name string
+		rows []interface{}
+		want database.UpsertResult
+		//wantKm are the km of the stored activities by start time
+		wantKm []int
+	}{
+		{"new reports", []interface{}{activity(0, 100), activity(1, 200)}, database.UpsertResult{Inserted: 2}, []int{100, 200}},
+		{"same reports", []interface{}{activity(0, 100), activity(1, 200)}, database.UpsertResult{Unchanged: 2}, []int{100, 200}},
+		{"changed and new reports", []interface{}{activity(1, 250), activity(2, 300)}, database.UpsertResult{Updated: 1, Inserted: 1}, []int{100, 250, 300}},
+		{"latest of the same start time", []interface{}{activity(3, 400), activity(3, 450)}, database.UpsertResult{Inserted: 1}, []int{100, 250, 300, 450}},
+		{"no reports", nil, database.UpsertResult{}, []int{100, 250, 300, 450}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := database.UpsertTourReports(database.DB, 1, tt.rows)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+
+			var activities []database.TruckActivityReport
+			if err := database.DB.Order("start_time").Find(&activities).Error; err != nil {
+				t.Fatal(err)
+			}
+			var km []int
+			for _, a := range activities {
+				km = append(km, a.KmEnd)
+			}
+			if len(km) != len(tt.wantKm) {
+				t.Fatalf("got km %v, want %v", km, tt.wantKm)
+			}
+			for i := range km {
+				if km[i] != tt.wantKm[i] {
+					t.Fatalf("got km %v, want %v", km, tt.wantKm)
+				}
+			}
+		})
+	}
+
+	//a deleted report is restored
+	if err := database.DB.Where("km_end = ?", 100).Delete(&database.TruckActivityReport{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	got, err := database.UpsertTourReports(database.DB, 1, []interface{}{activity(0, 100)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var count int
+	database.DB.Model(&database.TruckActivityReport{}).Count(&count)
+	if got.Updated != 1 || count != 4 {
+		t.Errorf("got %s and %d reports, want the deleted report restored", got, count)
+	}
+}
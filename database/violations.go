@@ -0,0 +1,62 @@
+package database
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+//ComplianceViolation is an infringement of a driving or rest time rule by a driver
+//durations are in minutes
+type ComplianceViolation struct {
+	gorm.Model
+	DriverTransicsID uint
+	Rule             string //rule infringed, see the compliance package
+	StartTime        time.Time
+	EndTime          time.Time
+	Value            float32 //driving or rest time of the driver
+	Limit            float32 //maximum driving or minimum rest time allowed
+	Details          string
+}
+
+//ActivityPeriods returns the driver activity periods started during a period, by driver and oldest first
+//a period is stored once per driver and start time whatever the tours of the driver
+func ActivityPeriods(from, to time.Time) ([]DriverActivityPeriod, error) {
+	var periods []DriverActivityPeriod
+	err := DB.Where("start_time >= ? AND start_time < ?", from, to).Order("driver_transics_id asc, start_time asc").Find(&periods).Error
+	if err != nil {
+		return nil, errors.Wrap(err, ErrorDB)
+	}
+
+	return periods, nil
+}
+
+//Violations returns the violations started during a period, by driver and oldest first
+func Violations(from, to time.Time) ([]ComplianceViolation, error) {
+	var violations []ComplianceViolation
+	err := DB.Where("start_time >= ? AND start_time < ?", from, to).Order("driver_transics_id asc, start_time asc").Find(&violations).Error
+	if err != nil {
+		return nil, errors.Wrap(err, ErrorDB)
+	}
+
+	return violations, nil
+}
+
+//ReplaceViolations replaces the violations started during a period by the given ones in a single transaction
+func ReplaceViolations(from, to time.Time, violations []ComplianceViolation) error {
+	return inTransaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Where("start_time >= ? AND start_time < ?", from, to).Delete(&ComplianceViolation{}).Error
+		if err != nil {
+			return errors.Wrap(err, ErrorDB)
+		}
+
+		for i := range violations {
+			if err := tx.Create(&violations[i]).Error; err != nil {
+				return errors.Wrap(err, ErrorDB)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,236 @@
+//Package fuel detects fuel level changes of the trucks which the activities do not explain
+//the fuel level of consecutive vehicle snapshots is compared to the consumption of the activities and the distance driven
+package fuel
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+	"tx2db/database"
+	"tx2db/util"
+)
+
+//lookBack is the time before the checked period to get the snapshot preceding its first one
+const lookBack = 24 * time.Hour
+
+//Options defines what is considered as an anomaly
+type Options struct {
+	//TankCapacity in liters converts the fuel level (percentage of the tank) to liters, 0 when the fuel level is in liters
+	TankCapacity float64
+	//AverageConsumption in liters per 100 km estimates the consumption when the activities have none
+	AverageConsumption float64
+	//MinLoss is the minimum unexplained loss in liters
+	MinLoss float64
+	//Tolerance is the part of the expected consumption which can be lost without being an anomaly
+	Tolerance float64
+	//MinRefuelling is the minimum increase in liters considered as a refuelling
+	MinRefuelling float64
+	//RefuelActivities are the activity names considered as refuelling, compared case insensitively
+	RefuelActivities []string
+}
+
+//DefaultOptions flags a loss of 30 L over the consumption and a refuelling of 50 L without activity
+var DefaultOptions = Options{
+	TankCapacity:       600,
+	AverageConsumption: 35,
+	MinLoss:            30,
+	Tolerance:          0.15,
+	MinRefuelling:      50,
+	RefuelActivities:   []string{"refuel", "tank", "carbur", "brandstof"},
+}
+
+//Result summarises a detection
+type Result struct {
+	Trucks    int
+	Snapshots int
+	Findings  []database.FuelFinding
+}
+
+func (r *Result) String() string {
+	return fmt.Sprintf("%d trucks, %d snapshots checked: %d anomalies", r.Trucks, r.Snapshots, len(r.Findings))
+}
+
+//Detect looks for fuel anomalies of every truck between from and to and stores the findings
+//the findings started during the period are replaced, so a period can be checked again after a new import
+func Detect(from, to time.Time, opts Options) (*Result, error) {
+	snapshots, err := database.SnapshotsBetween(from.Add(-lookBack), to)
+	if err != nil {
+		return nil, err
+	}
+	activities, err := database.ActivitiesBetween(from.Add(-lookBack), to)
+	if err != nil {
+		return nil, err
+	}
+
+	byTruck := make(map[uint][]database.TruckActivityReport)
+	for _, activity := range activities {
+		byTruck[activity.TruckTransicsID] = append(byTruck[activity.TruckTransicsID], activity)
+	}
+
+	result := &Result{Snapshots: len(snapshots)}
+	//snapshots are ordered by truck
+	for start := 0; start < len(snapshots); {
+		end := start
+		for end < len(snapshots) && snapshots[end].TruckTransicsID == snapshots[start].TruckTransicsID {
+			end++
+		}
+
+		truckID := snapshots[start].TruckTransicsID
+		for _, finding := range Analyse(snapshots[start:end], byTruck[truckID], opts) {
+			//findings of the previous day have been stored by a previous detection
+			if finding.StartTime.Before(from) || !finding.StartTime.Before(to) {
+				continue
+			}
+			result.Findings = append(result.Findings, finding)
+		}
+		result.Trucks++
+		start = end
+	}
+
+	if err := database.ReplaceFuelFindings(from, to, result.Findings); err != nil {
+		return nil, err
+	}
+	log.Printf("Fuel anomalies from %s to %s: %s\n", from.Format("2006-01-02"), to.Format("2006-01-02"), result)
+
+	return result, nil
+}
+
+//Analyse compares the fuel level of consecutive snapshots of a truck with its activities
+//snapshots and activities must be ordered by time, a loss followed by an increase of the same amount is a sensor fault
+func Analyse(snapshots []database.VehicleSnapshot, activities []database.TruckActivityReport, opts Options) []database.FuelFinding {
+	var findings []database.FuelFinding
+	var previous *database.VehicleSnapshot
+	for i := range snapshots {
+		if snapshots[i].FuelLevel == nil {
+			continue
+		}
+		before, after := previous, &snapshots[i]
+		previous = after
+		if before == nil {
+			continue
+		}
+
+		levelBefore, levelAfter := liters(*before.FuelLevel, opts), liters(*after.FuelLevel, opts)
+		var distance float64
+		if before.CurrentKms != nil && after.CurrentKms != nil && *after.CurrentKms > *before.CurrentKms {
+			distance = *after.CurrentKms - *before.CurrentKms
+		}
+		expected, refuelled := consumption(activities, before.TakenAt, after.TakenAt, opts)
+		if expected == 0 {
+			expected = distance * opts.AverageConsumption / 100
+		}
+
+		finding := database.FuelFinding{
+			TruckTransicsID:  after.TruckTransicsID,
+			DriverTransicsID: after.DriverTransicsID,
+			StartTime:        before.TakenAt,
+			EndTime:          after.TakenAt,
+			LevelBefore:      levelBefore,
+			LevelAfter:       levelAfter,
+			Expected:         expected,
+			Distance:         distance,
+		}
+		if finding.DriverTransicsID == 0 {
+			finding.DriverTransicsID = before.DriverTransicsID
+		}
+
+		drop := levelBefore - levelAfter
+		switch {
+		case drop-expected > math.Max(opts.MinLoss, expected*opts.Tolerance):
+			finding.Kind = database.FuelLoss
+			finding.Details = fmt.Sprintf("%.1f L lost, %.1f L expected over %.0f km", drop, expected, distance)
+		case -drop >= opts.MinRefuelling && !refuelled:
+			finding.Kind = database.UnexplainedRefuelling
+			finding.Details = fmt.Sprintf("%.1f L added without a refuelling activity", -drop)
+		default:
+			continue
+		}
+
+		//the level came back after a loss, the sensor is wrong
+		if n := len(findings); n > 0 && finding.Kind == database.UnexplainedRefuelling {
+			last := &findings[n-1]
+			lost := last.LevelBefore - last.LevelAfter
+			if last.Kind == database.FuelLoss && last.EndTime.Equal(finding.StartTime) && math.Abs(-drop-lost) <= lost*opts.Tolerance {
+				last.Kind = database.FuelSensorFault
+				last.EndTime = finding.EndTime
+				last.LevelAfter = finding.LevelAfter
+				last.Details = fmt.Sprintf("level dropped by %.1f L and came back by %.1f L", lost, -drop)
+				continue
+			}
+		}
+		findings = append(findings, finding)
+	}
+
+	return findings
+}
+
+//consumption returns the fuel consumed by the activities between from and to and whether one of them is a refuelling
+//the consumption of an activity crossing the period is prorated
+func consumption(activities []database.TruckActivityReport, from, to time.Time, opts Options) (float64, bool) {
+	var consumed float64
+	var refuelled bool
+	for _, activity := range activities {
+		if !activity.StartTime.Before(to) || !activity.EndTime.After(from) {
+			continue
+		}
+
+		ratio := 1.0
+		if duration := activity.EndTime.Sub(activity.StartTime); duration > 0 {
+			start, end := activity.StartTime, activity.EndTime
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			ratio = float64(end.Sub(start)) / float64(duration)
+		}
+		consumed += float64(activity.Consumption) * ratio
+
+		if isRefuelling(activity.Activity, opts) {
+			refuelled = true
+		}
+	}
+
+	return consumed, refuelled
+}
+
+//isRefuelling checks if an activity is a refuelling
+func isRefuelling(activity string, opts Options) bool {
+	activity = strings.ToLower(activity)
+	if activity == "" {
+		return false
+	}
+	for _, refuel := range opts.RefuelActivities {
+		if strings.Contains(activity, strings.ToLower(refuel)) {
+			return true
+		}
+	}
+	return false
+}
+
+//liters converts a fuel level to liters
+func liters(level float64, opts Options) float64 {
+	if opts.TankCapacity == 0 {
+		return level
+	}
+	return level * opts.TankCapacity / 100
+}
+
+//SendDigest mails the findings to the system administrator, nothing is sent without findings
+func SendDigest(findings []database.FuelFinding, from, to time.Time) error {
+	if len(findings) == 0 {
+		return nil
+	}
+
+	var anomalies []string
+	for _, finding := range findings {
+		anomalies = append(anomalies, fmt.Sprintf("- truck %d (driver %d), %s to %s: %s, %s", finding.TruckTransicsID, finding.DriverTransicsID,
+			finding.StartTime.Format("2006-01-02 15:04"), finding.EndTime.Format("2006-01-02 15:04"), strings.Replace(finding.Kind, "_", " ", -1), finding.Details))
+	}
+
+	//the end of the period is exclusive
+	return util.InformSystemAdministratorFuelAnomalies(from, to.AddDate(0, 0, -1), anomalies)
+}
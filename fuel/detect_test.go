@@ -0,0 +1,214 @@
+package fuel
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"tx2db/database"
+)
+
+//start is the time of the first snapshot of the tests
+var start = time.Date(2020, 2, 10, 6, 0, 0, 0, time.UTC)
+
+//litersOptions are the default options with fuel levels in liters
+var litersOptions = func() Options {
+	opts := DefaultOptions
+	opts.TankCapacity = 0
+	return opts
+}()
+
+func float(f float64) *float64 {
+	return &f
+}
+
+//snapshot is taken by driver 1 of truck 100 some hours after start
+func snapshot(hours int, level, kms float64) database.VehicleSnapshot {
+	return database.VehicleSnapshot{
+		TruckTransicsID:  100,
+		DriverTransicsID: 1,
+		TakenAt:          start.Add(time.Duration(hours) * time.Hour),
+		FuelLevel:        float(level),
+		CurrentKms:       float(kms),
+	}
+}
+
+//withoutLevel is a snapshot without fuel level
+func withoutLevel(hours int, kms float64) database.VehicleSnapshot {
+	s := snapshot(hours, 0, kms)
+	s.FuelLevel = nil
+	return s
+}
+
+//withoutKms is a snapshot without odometer
+func withoutKms(hours int, level float64) database.VehicleSnapshot {
+	s := snapshot(hours, level, 0)
+	s.CurrentKms = nil
+	return s
+}
+
+//activity of truck 100 between two hours after start
+func activity(name string, from, to int, consumption float32) database.TruckActivityReport {
+	return database.TruckActivityReport{
+		TruckTransicsID: 100,
+		Activity:        name,
+		StartTime:       start.Add(time.Duration(from) * time.Hour),
+		EndTime:         start.Add(time.Duration(to) * time.Hour),
+		Consumption:     consumption,
+	}
+}
+
+func TestAnalyse(t *testing.T) {
+	tests := []struct {
+		name       string
+		snapshots  []database.VehicleSnapshot
+		activities []database.TruckActivityReport
+		opts       Options
+		want       []string
+	}{
+		{
+			name:      "drop explained by the distance",
+			snapshots: []database.VehicleSnapshot{snapshot(0, 300, 1000), snapshot(2, 260, 1100)},
+			opts:      litersOptions,
+		},
+		{
+			name:      "loss of the minimum loss over the distance",
+			snapshots: []database.VehicleSnapshot{snapshot(0, 300, 1000), snapshot(2, 235, 1100)},
+			opts:      litersOptions,
+		},
+		{
+			name:      "loss over the minimum loss",
+			snapshots: []database.VehicleSnapshot{snapshot(0, 300, 1000), snapshot(2, 234, 1100)},
+			opts:      litersOptions,
+			want:      []string{database.FuelLoss},
+		},
+		{
+			name:       "loss within the tolerance of the consumption",
+			snapshots:  []database.VehicleSnapshot{snapshot(0, 600, 1000), snapshot(10, 140, 1100)},
+			activities: []database.TruckActivityReport{activity("Driving", 0, 10, 400)},
+			opts:       litersOptions,
+		},
+		{
+			name:       "loss over the tolerance of the consumption",
+			snapshots:  []database.VehicleSnapshot{snapshot(0, 600, 1000), snapshot(10, 139, 1100)},
+			activities: []database.TruckActivityReport{activity("Driving", 0, 10, 400)},
+			opts:       litersOptions,
+			want:       []string{database.FuelLoss},
+		},
+		{
+			name:       "consumption of the activities prevails over the distance",
+			snapshots:  []database.VehicleSnapshot{snapshot(0, 300, 1000), snapshot(2, 220, 1100)},
+			activities: []database.TruckActivityReport{activity("Driving", 0, 2, 50)},
+			opts:       litersOptions,
+		},
+		{
+			name:       "consumption of an activity crossing the snapshots is prorated",
+			snapshots:  []database.VehicleSnapshot{snapshot(0, 300, 1000), snapshot(2, 219, 1100)},
+			activities: []database.TruckActivityReport{activity("Driving", 1, 3, 100)},
+			opts:       litersOptions,
+			want:       []string{database.FuelLoss},
+		},
+		{
+			name:      "fuel level in percentage of the tank",
+			snapshots: []database.VehicleSnapshot{snapshot(0, 50, 1000), snapshot(2, 39, 1100)},
+			opts:      DefaultOptions,
+			want:      []string{database.FuelLoss},
+		},
+		{
+			name:       "refuelling with a refuelling activity",
+			snapshots:  []database.VehicleSnapshot{snapshot(0, 100, 1000), snapshot(1, 500, 1000)},
+			activities: []database.TruckActivityReport{activity("Tanken", 0, 1, 0)},
+			opts:       litersOptions,
+		},
+		{
+			name:       "refuelling without a refuelling activity",
+			snapshots:  []database.VehicleSnapshot{snapshot(0, 100, 1000), snapshot(1, 500, 1000)},
+			activities: []database.TruckActivityReport{activity("Loading", 0, 1, 0)},
+			opts:       litersOptions,
+			want:       []string{database.UnexplainedRefuelling},
+		},
+		{
+			name:      "increase under the minimum refuelling",
+			snapshots: []database.VehicleSnapshot{snapshot(0, 100, 1000), snapshot(1, 149, 1000)},
+			opts:      litersOptions,
+		},
+		{
+			name:      "increase of the minimum refuelling",
+			snapshots: []database.VehicleSnapshot{snapshot(0, 100, 1000), snapshot(1, 150, 1000)},
+			opts:      litersOptions,
+			want:      []string{database.UnexplainedRefuelling},
+		},
+		{
+			name:      "level coming back after a loss is a sensor fault",
+			snapshots: []database.VehicleSnapshot{snapshot(0, 300, 1000), snapshot(1, 200, 1000), snapshot(2, 290, 1000)},
+			opts:      litersOptions,
+			want:      []string{database.FuelSensorFault},
+		},
+		{
+			name:      "refuelling of another amount after a loss",
+			snapshots: []database.VehicleSnapshot{snapshot(0, 300, 1000), snapshot(1, 200, 1000), snapshot(2, 500, 1000)},
+			opts:      litersOptions,
+			want:      []string{database.FuelLoss, database.UnexplainedRefuelling},
+		},
+		{
+			name:      "refuelling of the same amount after a gap",
+			snapshots: []database.VehicleSnapshot{snapshot(0, 300, 1000), snapshot(1, 200, 1000), snapshot(2, 200, 1000), snapshot(3, 300, 1000)},
+			opts:      litersOptions,
+			want:      []string{database.FuelLoss, database.UnexplainedRefuelling},
+		},
+		{
+			name:      "snapshots without fuel level are skipped",
+			snapshots: []database.VehicleSnapshot{snapshot(0, 300, 1000), withoutLevel(1, 1050), snapshot(2, 260, 1100)},
+			opts:      litersOptions,
+		},
+		{
+			name:      "loss without odometer",
+			snapshots: []database.VehicleSnapshot{withoutKms(0, 300), withoutKms(2, 260)},
+			opts:      litersOptions,
+			want:      []string{database.FuelLoss},
+		},
+		{
+			name:      "odometer going back is no distance",
+			snapshots: []database.VehicleSnapshot{snapshot(0, 300, 1100), snapshot(2, 260, 1000)},
+			opts:      litersOptions,
+			want:      []string{database.FuelLoss},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, finding := range Analyse(tt.snapshots, tt.activities, tt.opts) {
+				got = append(got, finding.Kind)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got findings %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyseFindingDetails(t *testing.T) {
+	after := withoutLevel(1, 1050)
+	after.DriverTransicsID = 0
+	last := snapshot(2, 200, 1100)
+	last.DriverTransicsID = 0
+	findings := Analyse([]database.VehicleSnapshot{snapshot(0, 300, 1000), after, last}, nil, litersOptions)
+	if len(findings) != 1 {
+		t.Fatalf("got findings %+v, want 1", findings)
+	}
+
+	//the finding spans the gap and is attributed to the driver of the previous snapshot
+	f := findings[0]
+	if !f.StartTime.Equal(start) || !f.EndTime.Equal(start.Add(2*time.Hour)) || f.DriverTransicsID != 1 || f.TruckTransicsID != 100 {
+		t.Errorf("finding %+v, want truck 100 and driver 1 from start to 2h later", f)
+	}
+	if f.LevelBefore != 300 || f.LevelAfter != 200 || f.Expected != 35 || f.Distance != 100 {
+		t.Errorf("finding %+v, want 100 L lost and 35 L expected over 100 km", f)
+	}
+
+	//the sensor fault spans the loss and the increase
+	findings = Analyse([]database.VehicleSnapshot{snapshot(0, 300, 1000), snapshot(1, 200, 1000), snapshot(2, 290, 1000)}, nil, litersOptions)
+	if len(findings) != 1 || !findings[0].EndTime.Equal(start.Add(2*time.Hour)) || findings[0].LevelAfter != 290 {
+		t.Errorf("got findings %+v, want a sensor fault ending 2h after start at 290 L", findings)
+	}
+}
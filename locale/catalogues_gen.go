@@ -0,0 +1,11 @@
+// Code generated by tools/embed; DO NOT EDIT.
+
+package locale
+
+// embedded contains the embedded files by name
+var embedded = map[string]string{
+	"du.json": "{\n    \"name\": \"Deutsch\",\n    \"tag\": \"de\",\n    \"formats\": {\n        \"decimal_separator\": \",\",\n        \"thousands_separator\": \".\",\n        \"date\": \"02.01.2006\",\n        \"date_time\": \"02.01.2006 15:04\",\n        \"day\": \"02.01.\"\n    },\n    \"messages\": {\n        \"report.title\": \"Fahrstilanalyse\",\n        \"report.period\": \"Zeitraum\",\n        \"report.trucks\": \"Deine Fahrzeuge\",\n        \"report.countries\": \"Besuchte Länder\",\n        \"report.compliance\": \"Lenk- & Ruhezeiten\",\n        \"report.no_infringement\": \"Keine Verstöße, gut gemacht!\",\n        \"report.joke\": \"Witz des Tages (EN)\",\n        \"report.route\": \"Deine Route\",\n        \"report.activities\": \"Aktivitäten\",\n        \"report.consumption\": \"Verbrauch in L/Km\",\n        \"report.idling\": \"Leerlauf\",\n        \"report.speed\": \"Durchschnittsgeschwindigkeit\",\n        \"report.vs_previous\": \"ggü. Vorperiode\",\n        \"report.average\": \"8-Wochen-Schnitt\",\n        \"report.fleet_top\": \"Top %d%% der Flotte\",\n        \"report.group_top\": \"Top %d%% von %s\",\n        \"chart.consumption_axis\": \"Verbrauch (L/Km)\",\n        \"chart.idling_axis\": \"Leerlauf / Gesamtfahrzeit (%)\",\n        \"chart.speed_axis\": \"Durchschnittsgeschwindigkeit (km/h)\",\n        \"chart.week\": \"Woche\",\n        \"rule.continuous_driving\": \"Pause nach 4h30 Lenkzeit\",\n        \"rule.daily_driving\": \"Tägliche Lenkzeit\",\n        \"rule.weekly_driving\": \"Wöchentliche Lenkzeit\",\n        \"rule.fortnightly_driving\": \"Lenkzeit in zwei Wochen\",\n        \"rule.daily_rest\": \"Tägliche Ruhezeit\",\n        \"rule.weekly_rest\": \"Wöchentliche Ruhezeit\",\n        \"rule.missing_data\": \"Fehlende Tachographendaten\",\n        \"metric.eco_score\": \"Öko-Punktzahl\",\n        \"metric.driven_km\": \"Kilometer gefahren\",\n        \"metric.panic_brakes\": \"Abrupt gebremst\",\n        \"metric.cruise_control\": \"Tempomat Nutzung\",\n        \"metric.fuel_consumption\": \"Diesel verbraucht\",\n        \"metric.roll_out\": \"Ausrollen\",\n        \"metric.idling_ratio\": \"Leerlauf\",\n        \"metric.harsh_accelerations\": \"Starke Beschleunigungen\",\n        \"metric.high_rpm\": \"Hohe Drehzahl\",\n        \"mail.driver.subject\": \"[TX2DB] Du hast eine neue Analyse erhalten\",\n        \"mail.driver.body\": \"Hallo,\\ndeine Wochenanalyse für den Zeitraum vom %s bis %s ist verfügbar.\\nEinen schönen Tag noch!\\n\\nDiese E-Mail wurde automatisch erstellt.\"\n    }\n}\n",
+	"en.json": "{\n    \"name\": \"English\",\n    \"tag\": \"en\",\n    \"formats\": {\n        \"decimal_separator\": \".\",\n        \"thousands_separator\": \",\",\n        \"date\": \"2006-01-02\",\n        \"date_time\": \"2006-01-02 15:04\",\n        \"day\": \"02 Jan\"\n    },\n    \"messages\": {\n        \"report.title\": \"Driving style analysis\",\n        \"report.period\": \"Date\",\n        \"report.trucks\": \"You have driven in\",\n        \"report.countries\": \"Visited countries\",\n        \"report.compliance\": \"Driving & rest times\",\n        \"report.no_infringement\": \"No infringement, well done!\",\n        \"report.joke\": \"Joke of the day\",\n        \"report.route\": \"Your route\",\n        \"report.activities\": \"Activities\",\n        \"report.consumption\": \"Consumption in L/Km\",\n        \"report.idling\": \"Idling percentage\",\n        \"report.speed\": \"Average speed\",\n        \"report.vs_previous\": \"vs last period\",\n        \"report.average\": \"8-week avg\",\n        \"report.fleet_top\": \"top %d%% of fleet\",\n        \"report.group_top\": \"top %d%% of %s\",\n        \"chart.consumption_axis\": \"Consumption (L/Km)\",\n        \"chart.idling_axis\": \"Idling / total driving time (%)\",\n        \"chart.speed_axis\": \"Average speed (km/h)\",\n        \"chart.week\": \"Week\",\n        \"rule.continuous_driving\": \"Break after 4h30 of driving\",\n        \"rule.daily_driving\": \"Daily driving time\",\n        \"rule.weekly_driving\": \"Weekly driving time\",\n        \"rule.fortnightly_driving\": \"Driving time over two weeks\",\n        \"rule.daily_rest\": \"Daily rest\",\n        \"rule.weekly_rest\": \"Weekly rest\",\n        \"rule.missing_data\": \"Missing tachograph data\",\n        \"metric.eco_score\": \"Eco Score\",\n        \"metric.driven_km\": \"Kilometer Driven\",\n        \"metric.panic_brakes\": \"Panic Brakes\",\n        \"metric.cruise_control\": \"Cruise Control Usage\",\n        \"metric.fuel_consumption\": \"Diesel Usage\",\n        \"metric.roll_out\": \"Rolling Out\",\n        \"metric.idling_ratio\": \"Idling\",\n        \"metric.harsh_accelerations\": \"Harsh Accelerations\",\n        \"metric.high_rpm\": \"High RPM\",\n        \"mail.driver.subject\": \"[TX2DB] You have received a new analysis\",\n        \"mail.driver.body\": \"Hello,\\nYour weekly analysis for the period %s to %s is available.\\nHave a great day!\\n\\nThis email has been automatically generated.\",\n        \"mail.instructor.subject\": \"[TX2DB] New weekly driver analysis available\",\n        \"mail.instructor.body\": \"Hello,\\nThe weekly driver analysis for the period %s to %s are available from the Bolk FTP Server.\\nHave a great day!\\n\\nThis email has been automatically generated.\",\n        \"mail.driver_email_missing.subject\": \"[TX2DB] A driver mail needs to be added\",\n        \"mail.driver_email_missing.body\": \"Hello,\\nThe driver (personID: %s) does not have an associated email in the TX2DB database. Please add its email in the 'Driver' table so he/she can receive their weekly report.\\nHave a great day!\\n\\nThis email has been automatically generated.\",\n        \"mail.ftp_error.subject\": \"[TX2DB] FTP upload failed\",\n        \"mail.ftp_error.body\": \"Hello,\\n Something wrong happen while uploading the weekly report to the FTP. Manual upload is hence necessary. The weekly report can be found in _%s_.\\nHave a great day!\\n\\nThis email has been automatically generated.\",\n        \"mail.fuel_anomalies.subject\": \"[TX2DB] %d fuel anomalies detected\",\n        \"mail.fuel_anomalies.body\": \"Hello,\\nThe following fuel anomalies (suspected fuel theft, sensor faults or unexplained refuellings) have been detected for the period %s to %s:\\n\\n%s\\n\\nThey are stored in the 'fuel_findings' table.\\nHave a great day!\\n\\nThis email has been automatically generated.\"\n    }\n}\n",
+	"fr.json": "{\n    \"name\": \"Français\",\n    \"tag\": \"fr\",\n    \"formats\": {\n        \"decimal_separator\": \",\",\n        \"thousands_separator\": \"\u00a0\",\n        \"date\": \"02/01/2006\",\n        \"date_time\": \"02/01/2006 15:04\",\n        \"day\": \"02/01\"\n    },\n    \"messages\": {\n        \"report.title\": \"Analyse du style de conduite\",\n        \"report.period\": \"Date\",\n        \"report.trucks\": \"Vous avez conduit dans\",\n        \"report.countries\": \"Pays visités\",\n        \"report.compliance\": \"Temps de conduite & de repos\",\n        \"report.no_infringement\": \"Aucune infraction, bravo !\",\n        \"report.joke\": \"Blague du jour\",\n        \"report.route\": \"Votre trajet\",\n        \"report.activities\": \"Activités\",\n        \"report.consumption\": \"Consommation en L/Km\",\n        \"report.idling\": \"Temps stationnaire\",\n        \"report.speed\": \"Vitesse moyenne\",\n        \"report.vs_previous\": \"vs période précédente\",\n        \"report.average\": \"moy. 8 semaines\",\n        \"report.fleet_top\": \"top %d%% de la flotte\",\n        \"report.group_top\": \"top %d%% de %s\",\n        \"chart.consumption_axis\": \"Consommation (L/Km)\",\n        \"chart.idling_axis\": \"Ralenti / temps de conduite total (%)\",\n        \"chart.speed_axis\": \"Vitesse moyenne (km/h)\",\n        \"chart.week\": \"Semaine\",\n        \"rule.continuous_driving\": \"Pause après 4h30 de conduite\",\n        \"rule.daily_driving\": \"Temps de conduite journalier\",\n        \"rule.weekly_driving\": \"Temps de conduite hebdomadaire\",\n        \"rule.fortnightly_driving\": \"Temps de conduite sur deux semaines\",\n        \"rule.daily_rest\": \"Repos journalier\",\n        \"rule.weekly_rest\": \"Repos hebdomadaire\",\n        \"rule.missing_data\": \"Données du tachygraphe manquantes\",\n        \"metric.eco_score\": \"Score éco\",\n        \"metric.driven_km\": \"Kilomètres parcourus\",\n        \"metric.panic_brakes\": \"Freinage brusque\",\n        \"metric.cruise_control\": \"Utilisation du régulateur de vitesse\",\n        \"metric.fuel_consumption\": \"Consommation diesel\",\n        \"metric.roll_out\": \"Roue libre\",\n        \"metric.idling_ratio\": \"Ralenti\",\n        \"metric.harsh_accelerations\": \"Accélérations brusques\",\n        \"metric.high_rpm\": \"Régime moteur élevé\",\n        \"mail.driver.subject\": \"[TX2DB] Vous avez reçu une nouvelle analyse\",\n        \"mail.driver.body\": \"Bonjour,\\nVotre analyse hebdomadaire pour la période du %s au %s est disponible.\\nBonne journée !\\n\\nCet email a été généré automatiquement.\"\n    }\n}\n",
+	"nl.json": "{\n    \"name\": \"Nederlands\",\n    \"tag\": \"nl\",\n    \"formats\": {\n        \"decimal_separator\": \",\",\n        \"thousands_separator\": \".\",\n        \"date\": \"02-01-2006\",\n        \"date_time\": \"02-01-2006 15:04\",\n        \"day\": \"02-01\"\n    },\n    \"messages\": {\n        \"report.title\": \"Rijstijlanalyse\",\n        \"report.period\": \"Datum\",\n        \"report.trucks\": \"Je hebt gereden in\",\n        \"report.countries\": \"Bezochte landen\",\n        \"report.compliance\": \"Rij- & rusttijden\",\n        \"report.no_infringement\": \"Geen overtredingen, goed gedaan!\",\n        \"report.joke\": \"Grap van de dag\",\n        \"report.route\": \"Je route\",\n        \"report.activities\": \"Bezigheden\",\n        \"report.consumption\": \"Verbruik in L/Km\",\n        \"report.idling\": \"Stationair draaien\",\n        \"report.speed\": \"Gemiddelde snelheid\",\n        \"report.vs_previous\": \"t.o.v. vorige periode\",\n        \"report.average\": \"gem. 8 weken\",\n        \"report.fleet_top\": \"top %d%% van de vloot\",\n        \"report.group_top\": \"top %d%% van %s\",\n        \"chart.consumption_axis\": \"Verbruik (L/Km)\",\n        \"chart.idling_axis\": \"Stationair / totale rijtijd (%)\",\n        \"chart.speed_axis\": \"Gemiddelde snelheid (km/h)\",\n        \"chart.week\": \"Week\",\n        \"rule.continuous_driving\": \"Pauze na 4u30 rijden\",\n        \"rule.daily_driving\": \"Dagelijkse rijtijd\",\n        \"rule.weekly_driving\": \"Wekelijkse rijtijd\",\n        \"rule.fortnightly_driving\": \"Rijtijd over twee weken\",\n        \"rule.daily_rest\": \"Dagelijkse rust\",\n        \"rule.weekly_rest\": \"Wekelijkse rust\",\n        \"rule.missing_data\": \"Ontbrekende tachograafgegevens\",\n        \"metric.eco_score\": \"Eco-score\",\n        \"metric.driven_km\": \"Kilometer gereden\",\n        \"metric.panic_brakes\": \"Hard geremd\",\n        \"metric.cruise_control\": \"Cruise Control gebruik\",\n        \"metric.fuel_consumption\": \"Diesel verbruikt\",\n        \"metric.roll_out\": \"Uitrollen\",\n        \"metric.idling_ratio\": \"Stationair draaien\",\n        \"metric.harsh_accelerations\": \"Harde acceleraties\",\n        \"metric.high_rpm\": \"Hoog toerental\",\n        \"mail.driver.subject\": \"[TX2DB] Je hebt een nieuwe analyse ontvangen\",\n        \"mail.driver.body\": \"Hallo,\\nJe wekelijkse analyse voor de periode van %s tot %s is beschikbaar.\\nNog een fijne dag!\\n\\nDeze e-mail werd automatisch gegenereerd.\"\n    }\n}\n",
+}
@@ -0,0 +1,227 @@
+package locale
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//DefaultLanguage is the language used when a catalogue, a message or a format is missing
+const DefaultLanguage = "EN"
+
+//the catalogues of the locales folder are compiled in the binary, run go generate after changing or adding one
+//go:generate go run ../tools/embed -package locale -var embedded -o catalogues_gen.go ../locales/*.json
+
+//Catalogue contains the messages and the formats of a language, read from a JSON file
+type Catalogue struct {
+	//Name is the name of the language, in the language
+	Name string `json:"name"`
+	//Tag is the IETF language tag, en
+	Tag      string            `json:"tag"`
+	Formats  Formats           `json:"formats"`
+	Messages map[string]string `json:"messages"`
+}
+
+//Formats defines how the numbers and the dates are written, the dates with the layouts of the time package
+type Formats struct {
+	DecimalSeparator   string `json:"decimal_separator"`
+	ThousandsSeparator string `json:"thousands_separator"`
+	Date               string `json:"date"`      //02/01/2006
+	DateTime           string `json:"date_time"` //02/01/2006 15:04
+	Day                string `json:"day"`       //02/01, the days of the charts
+}
+
+var (
+	mu         sync.RWMutex
+	catalogues map[string]*Catalogue
+)
+
+//Load reads the catalogues compiled in the binary, a JSON file per language named after the language of the drivers, nl.json
+//the catalogues loaded before are replaced
+func Load() error {
+	return load(embedded)
+}
+
+//load reads catalogues by file name, the english catalogue is required
+func load(files map[string]string) error {
+	loaded := make(map[string]*Catalogue)
+	for name, content := range files {
+		if path.Ext(name) != ".json" {
+			continue
+		}
+
+		var catalogue Catalogue
+		if err := json.Unmarshal([]byte(content), &catalogue); err != nil {
+			return errors.Wrapf(err, "Invalid catalogue %s", name)
+		}
+		loaded[strings.ToUpper(strings.TrimSuffix(name, ".json"))] = &catalogue
+	}
+	if _, ok := loaded[DefaultLanguage]; !ok {
+		return errors.Errorf("The catalogue of %s is missing", DefaultLanguage)
+	}
+
+	mu.Lock()
+	catalogues = loaded
+	mu.Unlock()
+
+	return nil
+}
+
+//ensureLoaded loads the catalogues when none were loaded
+//the messages are their key when the catalogues cannot be read
+func ensureLoaded() {
+	mu.RLock()
+	loaded := catalogues != nil
+	mu.RUnlock()
+	if loaded {
+		return
+	}
+
+	if err := Load(); err != nil {
+		log.Printf("ERROR: Catalogues not loaded: %v\n", err)
+		mu.Lock()
+		if catalogues == nil {
+			catalogues = make(map[string]*Catalogue)
+		}
+		mu.Unlock()
+	}
+}
+
+//Locale writes the messages, the numbers and the dates in a language
+type Locale struct {
+	language  string
+	catalogue *Catalogue
+	fallback  *Catalogue
+}
+
+//Get returns the locale of a language, English when the language has no catalogue
+func Get(language string) *Locale {
+	ensureLoaded()
+
+	mu.RLock()
+	defer mu.RUnlock()
+	language = strings.ToUpper(language)
+	fallback := catalogues[DefaultLanguage]
+	if fallback == nil {
+		fallback = &Catalogue{}
+	}
+	catalogue, ok := catalogues[language]
+	if !ok {
+		language, catalogue = DefaultLanguage, fallback
+	}
+
+	return &Locale{language: language, catalogue: catalogue, fallback: fallback}
+}
+
+//Language returns the language of the catalogue, DefaultLanguage for a language without catalogue
+func (l *Locale) Language() string {
+	return l.language
+}
+
+//Tag returns the IETF language tag of the catalogue
+func (l *Locale) Tag() string {
+	if l.catalogue.Tag != "" {
+		return l.catalogue.Tag
+	}
+	return l.fallback.Tag
+}
+
+//Lookup returns the message of a key, in English when the catalogue of the language misses it
+func (l *Locale) Lookup(key string) (string, bool) {
+	if message, ok := l.catalogue.Messages[key]; ok {
+		return message, true
+	}
+	message, ok := l.fallback.Messages[key]
+	return message, ok
+}
+
+//T returns the message of a key, the key itself when no catalogue has it
+func (l *Locale) T(key string) string {
+	if message, ok := l.Lookup(key); ok {
+		return message
+	}
+	return key
+}
+
+//Tf formats the message of a key with arguments, as fmt.Sprintf
+func (l *Locale) Tf(key string, args ...interface{}) string {
+	return fmt.Sprintf(l.T(key), args...)
+}
+
+//formats returns the formats of the catalogue, the missing ones in English
+func (l *Locale) formats() Formats {
+	formats, fallback := l.catalogue.Formats, l.fallback.Formats
+	if formats.DecimalSeparator == "" {
+		formats.DecimalSeparator = fallback.DecimalSeparator
+	}
+	if formats.ThousandsSeparator == "" {
+		formats.ThousandsSeparator = fallback.ThousandsSeparator
+	}
+	if formats.Date == "" {
+		formats.Date = fallback.Date
+	}
+	if formats.DateTime == "" {
+		formats.DateTime = fallback.DateTime
+	}
+	if formats.Day == "" {
+		formats.Day = fallback.Day
+	}
+	return formats
+}
+
+//Number writes a number with a number of decimals and the separators of the language, 1,234.5
+func (l *Locale) Number(value float64, decimals int) string {
+	formats := l.formats()
+	if formats.DecimalSeparator == "" {
+		formats.DecimalSeparator = "."
+	}
+
+	//a value rounded to 0 has no sign
+	value = math.Round(value*math.Pow10(decimals)) / math.Pow10(decimals)
+	digits := strconv.FormatFloat(math.Abs(value), 'f', decimals, 64)
+	integer, fraction := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		integer, fraction = digits[:i], digits[i+1:]
+	}
+
+	//group the thousands from the right
+	var grouped strings.Builder
+	if value < 0 {
+		grouped.WriteByte('-')
+	}
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteString(formats.ThousandsSeparator)
+		}
+		grouped.WriteRune(digit)
+	}
+	if fraction != "" {
+		grouped.WriteString(formats.DecimalSeparator)
+		grouped.WriteString(fraction)
+	}
+
+	return grouped.String()
+}
+
+//Date writes a day in the format of the language
+func (l *Locale) Date(t time.Time) string {
+	return t.Format(l.formats().Date)
+}
+
+//DateTime writes a day and a time in the format of the language
+func (l *Locale) DateTime(t time.Time) string {
+	return t.Format(l.formats().DateTime)
+}
+
+//Day writes a short day, without year, in the format of the language
+func (l *Locale) Day(t time.Time) string {
+	return t.Format(l.formats().Day)
+}
@@ -0,0 +1,103 @@
+package locale
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+//testCatalogues are an english catalogue and a partial german one
+var testCatalogues = map[string]string{
+	"en.json": `{"tag": "en", "formats": {"decimal_separator": ".", "thousands_separator": ",", "date": "2006-01-02", "date_time": "2006-01-02 15:04", "day": "02 Jan"},
+		"messages": {"report.title": "Driving style analysis", "report.joke": "Joke of the day"}}`,
+	"du.json": `{"tag": "de", "formats": {"decimal_separator": ",", "thousands_separator": ".", "date": "02.01.2006"},
+		"messages": {"report.title": "Fahrstilanalyse"}}`,
+	"README.md": "not a catalogue",
+}
+
+func TestLookup(t *testing.T) {
+	if err := load(testCatalogues); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		language     string
+		key          string
+		wantLanguage string
+		want         string
+	}{
+		{"translated", "DU", "report.title", "DU", "Fahrstilanalyse"},
+		{"lower case language", "du", "report.title", "DU", "Fahrstilanalyse"},
+		{"missing message", "DU", "report.joke", "DU", "Joke of the day"},
+		{"missing catalogue", "PL", "report.title", "EN", "Driving style analysis"},
+		{"unknown key", "DU", "report.unknown", "DU", "report.unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := Get(tt.language)
+			if loc.Language() != tt.wantLanguage {
+				t.Errorf("got language %s, want %s", loc.Language(), tt.wantLanguage)
+			}
+			if got := loc.T(tt.key); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormats(t *testing.T) {
+	if err := load(testCatalogues); err != nil {
+		t.Fatal(err)
+	}
+
+	day := time.Date(2020, 2, 16, 8, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"english number", Get("EN").Number(1234.56, 1), "1,234.6"},
+		{"german number", Get("DU").Number(1234.56, 1), "1.234,6"},
+		{"millions", Get("EN").Number(1234567, 0), "1,234,567"},
+		{"negative", Get("DU").Number(-1234.5, 2), "-1.234,50"},
+		{"rounded to zero", Get("EN").Number(-0.04, 1), "0.0"},
+		{"english date", Get("EN").Date(day), "2020-02-16"},
+		{"german date", Get("DU").Date(day), "16.02.2020"},
+		{"missing format", Get("DU").DateTime(day), "2020-02-16 08:30"},
+		{"missing catalogue", Get("PL").Day(day), "16 Feb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadRequiresEnglish(t *testing.T) {
+	if err := load(map[string]string{"du.json": `{"tag": "de"}`}); err == nil {
+		t.Error("got no error without the english catalogue")
+	}
+}
+
+func TestEmbeddedCatalogues(t *testing.T) {
+	if err := Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	//every message of the reports and the driver mails is translated, the other mails are in MAIL_LANGUAGE
+	english := Get(DefaultLanguage).catalogue
+	for name := range embedded {
+		catalogue := Get(strings.TrimSuffix(name, ".json")).catalogue
+		for key := range english.Messages {
+			if strings.HasPrefix(key, "mail.") && !strings.HasPrefix(key, "mail.driver.") {
+				continue
+			}
+			if _, ok := catalogue.Messages[key]; !ok {
+				t.Errorf("%s: message %s missing", name, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package score
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+//ConfigEnv is the environment variable containing the path of the JSON configuration, the default one is used when empty
+const ConfigEnv = "ECO_SCORE_CONFIG"
+
+//Criterion defines how a ratio of the eco monitor reports is scored
+//a ratio reaching the target scores 100, a ratio reaching the limit scores 0 and the score is linear in between
+//a lower ratio is better when the target is below the limit
+type Criterion struct {
+	Weight float64 `json:"weight"`
+	Target float64 `json:"target"`
+	Limit  float64 `json:"limit"`
+}
+
+//Config contains the criteria of the eco score, a criterion without weight is ignored
+type Config struct {
+	Coasting           Criterion `json:"coasting"`            //share of the distance coasting
+	CruiseControl      Criterion `json:"cruise_control"`      //share of the distance on cruise control
+	Idling             Criterion `json:"idling"`              //idling time over driving time
+	OverSpeeding       Criterion `json:"over_speeding"`       //overspeeding time over driving time
+	HarshAccelerations Criterion `json:"harsh_accelerations"` //harsh accelerations per 100 km
+	PanicBrakes        Criterion `json:"panic_brakes"`        //panic brakes per 100 km
+	HighRPM            Criterion `json:"high_rpm"`            //high RPM time over driving time
+	GreenSpot          Criterion `json:"green_spot"`          //share of the distance in the green spot
+}
+
+//DefaultConfig weights the distance based criteria the most
+var DefaultConfig = Config{
+	Coasting:           Criterion{Weight: 15, Target: 0.15, Limit: 0.03},
+	CruiseControl:      Criterion{Weight: 15, Target: 0.6, Limit: 0.2},
+	Idling:             Criterion{Weight: 15, Target: 0.05, Limit: 0.25},
+	OverSpeeding:       Criterion{Weight: 15, Target: 0, Limit: 0.1},
+	HarshAccelerations: Criterion{Weight: 10, Target: 0.5, Limit: 5},
+	PanicBrakes:        Criterion{Weight: 10, Target: 0, Limit: 1},
+	HighRPM:            Criterion{Weight: 10, Target: 0.02, Limit: 0.15},
+	GreenSpot:          Criterion{Weight: 10, Target: 0.8, Limit: 0.4},
+}
+
+//LoadConfig reads a JSON configuration, the criteria missing from the file keep their default value
+//the default configuration is returned when path is empty
+func LoadConfig(path string) (Config, error) {
+	config := DefaultConfig
+	if path == "" {
+		return config, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return config, errors.Wrap(err, "Cannot open eco score configuration")
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return config, errors.Wrapf(err, "Invalid eco score configuration %s", path)
+	}
+
+	return config, config.validate()
+}
+
+//criteria returns the criteria by name
+func (c Config) criteria() map[string]Criterion {
+	return map[string]Criterion{
+		"coasting":            c.Coasting,
+		"cruise_control":      c.CruiseControl,
+		"idling":              c.Idling,
+		"over_speeding":       c.OverSpeeding,
+		"harsh_accelerations": c.HarshAccelerations,
+		"panic_brakes":        c.PanicBrakes,
+		"high_rpm":            c.HighRPM,
+		"green_spot":          c.GreenSpot,
+	}
+}
+
+//validate checks that the weighted criteria can be scored
+func (c Config) validate() error {
+	var total float64
+	for name, criterion := range c.criteria() {
+		if criterion.Weight < 0 {
+			return errors.Errorf("Eco score criterion %s has a negative weight", name)
+		}
+		if criterion.Weight > 0 && criterion.Target == criterion.Limit {
+			return errors.Errorf("Eco score criterion %s has the same target and limit", name)
+		}
+		total += criterion.Weight
+	}
+	if total == 0 {
+		return errors.New("Eco score configuration has no weighted criterion")
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+//Package score rates the eco-driving of the drivers from their eco monitor reports
+//every criterion of the configuration gives a sub-score between 0 and 100, the total is their weighted average
+package score
+
+import (
+	"log"
+	"math"
+	"time"
+	"tx2db/database"
+)
+
+//Compute scores every driver over a period and stores the scores, replacing the ones of the same period
+//end is the last day of the period, the tours ending during that day are included
+func Compute(start, end time.Time, config Config) ([]database.DriverScore, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	//a period is stored by days so computing it again replaces its scores
+	start, end = day(start), day(end)
+
+	totals, err := database.EcoTotalsBetween(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var scores []database.DriverScore
+	for _, driver := range totals {
+		scores = append(scores, Score(driver, config))
+	}
+
+	if err := database.ReplaceDriverScores(start, end, scores); err != nil {
+		return nil, err
+	}
+	log.Printf("Eco scores from %s to %s: %d drivers scored\n", start.Format("2006-01-02"), end.Format("2006-01-02"), len(scores))
+
+	return scores, nil
+}
+
+//Score rates the eco monitor reports of a driver
+func Score(totals database.EcoTotals, config Config) database.DriverScore {
+	score := database.DriverScore{
+		DriverTransicsID:   totals.DriverTransicsID,
+		Distance:           totals.Distance,
+		Coasting:           rate(ratio(totals.DistanceCoasting, totals.Distance), config.Coasting),
+		CruiseControl:      rate(ratio(totals.DistanceOnCruiseControl, totals.Distance), config.CruiseControl),
+		Idling:             rate(ratio(totals.DurationIdling, totals.DurationDriving), config.Idling),
+		OverSpeeding:       rate(ratio(totals.DurationOverSpeeding, totals.DurationDriving), config.OverSpeeding),
+		HarshAccelerations: rate(ratio(totals.NumberOfHarshAccelerations*100, totals.Distance), config.HarshAccelerations),
+		PanicBrakes:        rate(ratio(totals.NumberOfPanicBrakes*100, totals.Distance), config.PanicBrakes),
+		HighRPM:            rate(ratio(totals.DurationHighRPM, totals.DurationDriving), config.HighRPM),
+		GreenSpot:          rate(ratio(totals.DistanceGreenSpot, totals.Distance), config.GreenSpot),
+	}
+
+	//weighted average of the sub-scores
+	weighted := []struct {
+		score     float64
+		criterion Criterion
+	}{
+		{score.Coasting, config.Coasting},
+		{score.CruiseControl, config.CruiseControl},
+		{score.Idling, config.Idling},
+		{score.OverSpeeding, config.OverSpeeding},
+		{score.HarshAccelerations, config.HarshAccelerations},
+		{score.PanicBrakes, config.PanicBrakes},
+		{score.HighRPM, config.HighRPM},
+		{score.GreenSpot, config.GreenSpot},
+	}
+	var sum, weights float64
+	for _, w := range weighted {
+		sum += w.score * w.criterion.Weight
+		weights += w.criterion.Weight
+	}
+	if weights > 0 {
+		score.Total = round(sum / weights)
+	}
+
+	return score
+}
+
+//ratio divides two totals, 0 without denominator
+func ratio(value, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return value / total
+}
+
+//rate scores a ratio between 0 and 100 according to a criterion
+func rate(value float64, criterion Criterion) float64 {
+	if criterion.Target == criterion.Limit {
+		return 0
+	}
+	score := (value - criterion.Limit) / (criterion.Target - criterion.Limit)
+	return round(100 * math.Max(0, math.Min(1, score)))
+}
+
+//day returns the midnight of a time
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+//round rounds a score to one decimal
+func round(score float64) float64 {
+	return math.Round(score*10) / 10
+}
@@ -0,0 +1,192 @@
+package score
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"tx2db/database"
+)
+
+func TestRate(t *testing.T) {
+	higher := Criterion{Weight: 1, Target: 0.6, Limit: 0.2}
+	lower := Criterion{Weight: 1, Target: 0.05, Limit: 0.25}
+
+	tests := []struct {
+		name      string
+		value     float64
+		criterion Criterion
+		want      float64
+	}{
+		{"higher is better, target reached", 0.6, higher, 100},
+		{"higher is better, over the target", 0.9, higher, 100},
+		{"higher is better, halfway", 0.4, higher, 50},
+		{"higher is better, limit reached", 0.2, higher, 0},
+		{"higher is better, under the limit", 0.1, higher, 0},
+		{"lower is better, target reached", 0.05, lower, 100},
+		{"lower is better, under the target", 0, lower, 100},
+		{"lower is better, a quarter of the way", 0.1, lower, 75},
+		{"lower is better, over the limit", 0.3, lower, 0},
+		{"rounded to one decimal", 0.3333, higher, 33.3},
+		{"same target and limit", 0.5, Criterion{Target: 0.5, Limit: 0.5}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rate(tt.value, tt.criterion); got != tt.want {
+				t.Errorf("rate(%v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScore(t *testing.T) {
+	//100 km with 60 km on cruise control, 30 km in the green spot and 10 km coasting
+	totals := database.EcoTotals{
+		DriverTransicsID:        1,
+		Distance:                100,
+		DurationDriving:         3600,
+		DistanceCoasting:        10,
+		DistanceOnCruiseControl: 60,
+		DistanceGreenSpot:       30,
+		DurationIdling:          360,
+		NumberOfPanicBrakes:     1,
+	}
+	cruiseControl := Criterion{Weight: 3, Target: 0.6, Limit: 0.2}
+	greenSpot := Criterion{Weight: 1, Target: 0.8, Limit: 0.4}
+
+	tests := []struct {
+		name   string
+		totals database.EcoTotals
+		config Config
+		want   float64
+	}{
+		{
+			name:   "single criterion",
+			totals: totals,
+			config: Config{CruiseControl: cruiseControl},
+			want:   100,
+		},
+		{
+			name:   "weighted average",
+			totals: totals,
+			config: Config{CruiseControl: cruiseControl, GreenSpot: greenSpot},
+			want:   75,
+		},
+		{
+			name:   "equal weights",
+			totals: totals,
+			config: Config{CruiseControl: Criterion{Weight: 1, Target: 0.6, Limit: 0.2}, GreenSpot: greenSpot},
+			want:   50,
+		},
+		{
+			name:   "criterion without weight is ignored",
+			totals: totals,
+			config: Config{CruiseControl: cruiseControl, GreenSpot: Criterion{Target: 0.8, Limit: 0.4}},
+			want:   100,
+		},
+		{
+			name:   "counts per 100 km",
+			totals: totals,
+			config: Config{PanicBrakes: Criterion{Weight: 1, Target: 0, Limit: 2}},
+			want:   50,
+		},
+		{
+			name:   "durations over the driving time",
+			totals: totals,
+			config: Config{Idling: Criterion{Weight: 1, Target: 0.05, Limit: 0.25}},
+			want:   75,
+		},
+		{
+			name:   "ratio without distance scores as 0",
+			totals: database.EcoTotals{DriverTransicsID: 1},
+			config: Config{CruiseControl: cruiseControl},
+			want:   0,
+		},
+		{
+			name:   "default configuration",
+			totals: totals,
+			config: DefaultConfig,
+			want:   70,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := Score(tt.totals, tt.config)
+			if score.Total != tt.want {
+				t.Errorf("got total %v, want %v (%+v)", score.Total, tt.want, score)
+			}
+			if score.DriverTransicsID != tt.totals.DriverTransicsID || score.Distance != tt.totals.Distance {
+				t.Errorf("score %+v of another driver", score)
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "score")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+		want    Config
+	}{
+		{
+			name:    "missing criteria keep their default",
+			content: `{"idling": {"weight": 40, "target": 0.1, "limit": 0.3}}`,
+			want: func() Config {
+				config := DefaultConfig
+				config.Idling = Criterion{Weight: 40, Target: 0.1, Limit: 0.3}
+				return config
+			}(),
+		},
+		{
+			name:    "unknown criterion",
+			content: `{"idle": {"weight": 40}}`,
+			wantErr: true,
+		},
+		{
+			name:    "negative weight",
+			content: `{"idling": {"weight": -1, "target": 0.1, "limit": 0.3}}`,
+			wantErr: true,
+		},
+		{
+			name:    "weighted criterion with the same target and limit",
+			content: `{"idling": {"weight": 1, "target": 0.1, "limit": 0.1}}`,
+			wantErr: true,
+		},
+		{
+			name: "no weighted criterion",
+			content: `{"coasting": {"weight": 0}, "cruise_control": {"weight": 0}, "idling": {"weight": 0}, "over_speeding": {"weight": 0},
+			"harsh_accelerations": {"weight": 0}, "panic_brakes": {"weight": 0}, "high_rpm": {"weight": 0}, "green_spot": {"weight": 0}}`,
+			wantErr: true,
+		},
+	}
+
+	configPath := path.Join(dir, "eco_score.json")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ioutil.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			config, err := LoadConfig(configPath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && config != tt.want {
+				t.Errorf("got config %+v, want %+v", config, tt.want)
+			}
+		})
+	}
+
+	if config, err := LoadConfig(""); err != nil || config != DefaultConfig {
+		t.Errorf("got config %+v and error %v without path, want the default configuration", config, err)
+	}
+}
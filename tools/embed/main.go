@@ -0,0 +1,70 @@
+//embed writes files into a Go source file so they are compiled in the binary
+//the files are stored in a map by file name: embed -package analysis -var assets -o assets_gen.go assets/logo.png
+//the files can be patterns, go generate does not expand them: embed -package locale -var embedded -o catalogues_gen.go ../locales/*.json
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+func main() {
+	pkg := flag.String("package", "", "package of the generated file")
+	name := flag.String("var", "", "name of the generated map")
+	output := flag.String("o", "", "generated file")
+	flag.Parse()
+	if *pkg == "" || *name == "" || *output == "" || flag.NArg() == 0 {
+		log.Fatal("usage: embed -package <package> -var <name> -o <file> <files>")
+	}
+
+	var paths []string
+	for _, pattern := range flag.Args() {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(matches) == 0 {
+			log.Fatalf("%s matches no file", pattern)
+		}
+		paths = append(paths, matches...)
+	}
+
+	files := make(map[string][]byte, len(paths))
+	var names []string
+	for _, file := range paths {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if _, ok := files[path.Base(file)]; ok {
+			log.Fatalf("%s embedded twice", path.Base(file))
+		}
+		files[path.Base(file)] = content
+		names = append(names, path.Base(file))
+	}
+	sort.Strings(names)
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by tools/embed; DO NOT EDIT.\n\npackage %s\n\n", *pkg)
+	fmt.Fprintf(&src, "//%s contains the embedded files by name\nvar %s = map[string]string{\n", *name, *name)
+	for _, file := range names {
+		fmt.Fprintf(&src, "%s: %s,\n", strconv.Quote(file), strconv.Quote(string(files[file])))
+	}
+	fmt.Fprint(&src, "}\n")
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,100 @@
+package txtango
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//defaultTimeout is used when no timeout is given in the client configuration
+const defaultTimeout = 60 * time.Second
+
+//Config contains the settings of a TX-TANGO dispatcher account
+type Config struct {
+	BaseURL    string
+	Dispatcher string
+	Password   string
+	Integrator string
+	SystemNr   string
+	Language   string
+	//Timeout is the maximum duration of a single request (default 60s)
+	Timeout time.Duration
+	//HTTPClient permits to use a custom http.Client (default http.Client using Timeout)
+	HTTPClient *http.Client
+	//RequestsPerSecond is the request rate allowed by Transics (default 0.2, a request every 5s)
+	RequestsPerSecond float64
+	//Burst is the number of requests which can be sent at once (default 1)
+	Burst int
+}
+
+//Client is a TX-TANGO client bound to one dispatcher account
+type Client struct {
+	baseURL    string
+	login      Login
+	httpClient *http.Client
+	limiter    *rateLimiter
+}
+
+//ConfigFromEnv builds a TX-TANGO configuration using .env
+func ConfigFromEnv() Config {
+	cfg := Config{
+		BaseURL:    os.Getenv("TX_HOST"),
+		Dispatcher: os.Getenv("TX_USERNAME"),
+		Password:   os.Getenv("TX_PASSWORD"),
+		Integrator: os.Getenv("TX_INTEGRATOR"),
+		SystemNr:   os.Getenv("TX_SYSTEM_NR"),
+	}
+
+	//optional request timeout in seconds
+	if timeout, err := strconv.Atoi(os.Getenv("TX_TIMEOUT")); err == nil && timeout > 0 {
+		cfg.Timeout = time.Duration(timeout) * time.Second
+	}
+
+	//optional rate limit
+	if rate, err := strconv.ParseFloat(os.Getenv("TX_RATE_LIMIT"), 64); err == nil && rate > 0 {
+		cfg.RequestsPerSecond = rate
+	}
+	if burst, err := strconv.Atoi(os.Getenv("TX_BURST")); err == nil && burst > 0 {
+		cfg.Burst = burst
+	}
+
+	return cfg
+}
+
+//NewClient creates a TX-TANGO client from a given configuration
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.BaseURL == "" {
+		return nil, errors.New("TX-TANGO base URL is missing")
+	}
+	if cfg.Dispatcher == "" {
+		return nil, errors.New("TX-TANGO dispatcher is missing")
+	}
+
+	if cfg.Language == "" {
+		cfg.Language = "EN"
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	return &Client{
+		baseURL: cfg.BaseURL,
+		login: Login{
+			Dispatcher: cfg.Dispatcher,
+			Password:   cfg.Password,
+			Integrator: cfg.Integrator,
+			SystemNr:   cfg.SystemNr,
+			Language:   cfg.Language,
+		},
+		httpClient: httpClient,
+		limiter:    newRateLimiter(cfg.RequestsPerSecond, cfg.Burst),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package txtango
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClient(t *testing.T) {
+	custom := &http.Client{}
+
+	tests := []struct {
+		name         string
+		cfg          Config
+		wantErr      bool
+		wantLanguage string
+		wantTimeout  time.Duration
+	}{
+		{
+			name:    "missing base URL",
+			cfg:     Config{Dispatcher: "dispatcher"},
+			wantErr: true,
+		},
+		{
+			name:    "missing dispatcher",
+			cfg:     Config{BaseURL: "http://localhost"},
+			wantErr: true,
+		},
+		{
+			name:         "defaults",
+			cfg:          Config{BaseURL: "http://localhost", Dispatcher: "dispatcher"},
+			wantLanguage: "EN",
+			wantTimeout:  defaultTimeout,
+		},
+		{
+			name:         "explicit language and timeout",
+			cfg:          Config{BaseURL: "http://localhost", Dispatcher: "dispatcher", Language: "NL", Timeout: 5 * time.Second},
+			wantLanguage: "NL",
+			wantTimeout:  5 * time.Second,
+		},
+		{
+			name:         "custom HTTP client",
+			cfg:          Config{BaseURL: "http://localhost", Dispatcher: "dispatcher", HTTPClient: custom},
+			wantLanguage: "EN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if client.login.Language != tt.wantLanguage {
+				t.Errorf("got language %q, want %q", client.login.Language, tt.wantLanguage)
+			}
+			if tt.cfg.HTTPClient != nil {
+				if client.httpClient != tt.cfg.HTTPClient {
+					t.Errorf("the custom HTTP client is not used")
+				}
+			} else if client.httpClient.Timeout != tt.wantTimeout {
+				t.Errorf("got timeout %v, want %v", client.httpClient.Timeout, tt.wantTimeout)
+			}
+		})
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	defer os.Unsetenv("TX_HOST")
+	defer os.Unsetenv("TX_TIMEOUT")
+	os.Setenv("TX_HOST", "http://localhost")
+
+	tests := []struct {
+		name    string
+		timeout string
+		want    time.Duration
+	}{
+		{"timeout in seconds", "10", 10 * time.Second},
+		{"no timeout", "", 0},
+		{"invalid timeout", "ten", 0},
+		{"negative timeout", "-1", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv("TX_TIMEOUT", tt.timeout)
+
+			cfg := ConfigFromEnv()
+			if cfg.BaseURL != "http://localhost" {
+				t.Errorf("got base URL %q, want http://localhost", cfg.BaseURL)
+			}
+			if cfg.Timeout != tt.want {
+				t.Errorf("got timeout %v, want %v", cfg.Timeout, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientSendsLogin(t *testing.T) {
+	var request string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		request = string(body)
+		w.Write([]byte(`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body/></soap:Envelope>`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{BaseURL: server.URL, Dispatcher: "dispatcher", Password: "secret", SystemNr: "42"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetActivityReport(context.Background(), 100, time.Now(), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"<Dispatcher>dispatcher</Dispatcher>", "<Password>secret</Password>", "<SystemNr>42</SystemNr>", "<Language>EN</Language>", "<Id>100</Id>"} {
+		if !strings.Contains(request, want) {
+			t.Errorf("request does not contain %s:\n%s", want, request)
+		}
+	}
+
+	//every request builds its own login, the client credentials are not changed
+	if client.login.Date != "" {
+		t.Errorf("the client login has been modified: %+v", client.login)
+	}
+}
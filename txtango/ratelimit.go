@@ -0,0 +1,146 @@
+package txtango
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	//defaultRequestsPerSecond is the request rate used when none is configured
+	defaultRequestsPerSecond = 0.2
+	//defaultBurst is the number of requests which can be sent at once
+	defaultBurst = 1
+	//maxBackoff is the longest pause applied after Transics throttled us
+	maxBackoff = 5 * time.Minute
+)
+
+//rateLimiter is a token bucket shared by every call of a client
+//it slows down when TX-TANGO answers with throttling errors
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 //tokens added per second
+	burst  float64
+	tokens float64
+	last   time.Time
+	//pausedUntil blocks every call until this date after a throttling error
+	pausedUntil time.Time
+	backoff     time.Duration
+
+	stats LimiterStats
+}
+
+//LimiterStats contains statistics about the calls made through the rate limiter
+type LimiterStats struct {
+	Calls     int
+	Throttled int
+	Waited    time.Duration
+	MaxWait   time.Duration
+}
+
+//newRateLimiter creates a token bucket starting full
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		requestsPerSecond = defaultRequestsPerSecond
+	}
+	if burst <= 0 {
+		burst = defaultBurst
+	}
+
+	return &rateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+//wait blocks until a call can be made and returns how long it waited
+func (l *rateLimiter) wait(ctx context.Context) (time.Duration, error) {
+	var waited time.Duration
+
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			break
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return waited, ctx.Err()
+		case <-timer.C:
+			waited += delay
+		}
+	}
+
+	l.mu.Lock()
+	l.stats.Calls++
+	l.stats.Waited += waited
+	if waited > l.stats.MaxWait {
+		l.stats.MaxWait = waited
+	}
+	l.mu.Unlock()
+
+	return waited, nil
+}
+
+//reserve takes a token if available, otherwise returns the time to wait for one
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+
+	//refill the bucket
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+//throttled pauses every call after Transics complained about the request rate
+//the pause doubles at each consecutive throttling error
+func (l *rateLimiter) throttled() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.backoff == 0 {
+		l.backoff = time.Duration(float64(time.Second) / l.rate)
+	} else {
+		l.backoff *= 2
+	}
+	if l.backoff > maxBackoff {
+		l.backoff = maxBackoff
+	}
+
+	l.pausedUntil = time.Now().Add(l.backoff)
+	l.tokens = 0
+	l.stats.Throttled++
+}
+
+//succeeded resets the backoff after a call went through
+func (l *rateLimiter) succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.backoff = 0
+}
+
+//Stats returns the statistics of the rate limiter of the client
+func (c *Client) Stats() LimiterStats {
+	c.limiter.mu.Lock()
+	defer c.limiter.mu.Unlock()
+	return c.limiter.stats
+}
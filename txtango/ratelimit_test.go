@@ -0,0 +1,148 @@
+package txtango
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	tests := []struct {
+		name    string
+		rate    float64
+		burst   int
+		tokens  float64
+		elapsed time.Duration
+		//reserved is the number of calls which go through without waiting
+		reserved int
+	}{
+		{"starts full", 1, 3, 3, 0, 3},
+		{"empty bucket", 1, 3, 0, 0, 0},
+		{"refilled by the elapsed time", 1, 3, 0, 2 * time.Second, 2},
+		{"partial token", 1, 3, 0.5, 600 * time.Millisecond, 1},
+		{"refill capped at the burst", 1, 3, 0, time.Hour, 3},
+		{"slow rate", 0.2, 1, 0, 4 * time.Second, 0},
+		{"slow rate refilled", 0.2, 1, 0, 5 * time.Second, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newRateLimiter(tt.rate, tt.burst)
+			l.tokens = tt.tokens
+			l.last = time.Now().Add(-tt.elapsed)
+
+			for i := 0; i < tt.reserved; i++ {
+				if delay := l.reserve(); delay != 0 {
+					t.Fatalf("call %d waits %v, want %d calls without waiting", i+1, delay, tt.reserved)
+				}
+			}
+
+			//the next call waits for the missing part of a token
+			delay := l.reserve()
+			if max := time.Duration(float64(time.Second) / tt.rate); delay <= 0 || delay > max {
+				t.Errorf("call %d waits %v, want between 0 and %v", tt.reserved+1, delay, max)
+			}
+		})
+	}
+}
+
+func TestRateLimiterDefaults(t *testing.T) {
+	l := newRateLimiter(0, 0)
+	if l.rate != defaultRequestsPerSecond || l.burst != defaultBurst || l.tokens != defaultBurst {
+		t.Errorf("got rate %v and burst %v, want the defaults", l.rate, l.burst)
+	}
+}
+
+func TestRateLimiterAdaptation(t *testing.T) {
+	l := newRateLimiter(1, 5)
+
+	//every consecutive throttling doubles the pause, up to maxBackoff
+	for i, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		l.throttled()
+		if l.backoff != want {
+			t.Errorf("throttling %d: got backoff %v, want %v", i+1, l.backoff, want)
+		}
+	}
+	if delay := l.reserve(); delay <= 3*time.Second || delay > 4*time.Second {
+		t.Errorf("got delay %v while paused, want about 4s", delay)
+	}
+	if l.tokens != 0 {
+		t.Errorf("got %v tokens after throttling, want an empty bucket", l.tokens)
+	}
+
+	for i := 0; i < 20; i++ {
+		l.throttled()
+	}
+	if l.backoff != maxBackoff {
+		t.Errorf("got backoff %v, want at most %v", l.backoff, maxBackoff)
+	}
+	if l.stats.Throttled != 23 {
+		t.Errorf("got %d throttled calls, want 23", l.stats.Throttled)
+	}
+
+	//a successful call starts the backoff again from the request interval
+	l.succeeded()
+	l.throttled()
+	if l.backoff != time.Second {
+		t.Errorf("got backoff %v after a success, want 1s", l.backoff)
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	l := newRateLimiter(1000, 1)
+	for i := 0; i < 3; i++ {
+		if _, err := l.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if l.stats.Calls != 3 || l.stats.Waited == 0 {
+		t.Errorf("got stats %+v, want 3 calls which waited", l.stats)
+	}
+
+	//a cancelled call stops waiting
+	l = newRateLimiter(0.001, 1)
+	l.reserve()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestSoapCallRetriesWhenThrottled(t *testing.T) {
+	//throttled is the number of requests refused before answering
+	var calls, throttled int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= throttled {
+			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body/></soap:Envelope>`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{BaseURL: server.URL, Dispatcher: "dispatcher", RequestsPerSecond: 1000, Burst: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	throttled = 2
+	if _, err := client.GetDrivers(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 || client.Stats().Throttled != 2 {
+		t.Errorf("got %d calls and stats %+v, want 2 throttled calls and a successful one", calls, client.Stats())
+	}
+
+	//Transics keeps throttling, the error is returned after the retries
+	calls, throttled = 0, 100
+	if _, err := client.GetDrivers(context.Background()); !isThrottled(err) {
+		t.Errorf("got error %v, want the throttling error", err)
+	}
+	if calls != maxThrottleRetries+1 {
+		t.Errorf("got %d calls, want %d", calls, maxThrottleRetries+1)
+	}
+}
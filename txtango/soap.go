@@ -2,17 +2,17 @@ package txtango
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"io/ioutil"
 	"net/http"
-	"os"
 	"text/template"
 
 	"github.com/pkg/errors"
 )
 
 //soapCall generate a request given a request and a template and sends it
-func soapCall(params interface{}, tmplName, tmplRaw string) ([]byte, error) {
+func (c *Client) soapCall(ctx context.Context, params interface{}, tmplName, tmplRaw string) ([]byte, error) {
 	//construct the request using a template
 	tmpl := template.Must(template.New(tmplName).Parse(tmplRaw))
 	tmpl = template.Must(tmpl.Parse(loginTemplate))
@@ -28,31 +28,114 @@ func soapCall(params interface{}, tmplName, tmplRaw string) ([]byte, error) {
 		return nil, errors.Wrap(err, "There is an error in xml request. Please dig in the code for")
 	}
 
+	//send request, waiting for the rate limiter and retrying when Transics throttled us
+	for attempt := 0; ; attempt++ {
+		if _, err := c.limiter.wait(ctx); err != nil {
+			return nil, &TransportError{Err: err}
+		}
+
+		body, err := c.send(ctx, tmplName, doc.Bytes())
+		if !isThrottled(err) {
+			c.limiter.succeeded()
+			return body, err
+		}
+
+		c.limiter.throttled()
+		if attempt >= maxThrottleRetries {
+			return nil, err
+		}
+	}
+}
+
+//maxThrottleRetries is the number of times a throttled request is sent again
+const maxThrottleRetries = 3
+
+//send posts a SOAP request and checks its response
+func (c *Client) send(ctx context.Context, tmplName string, doc []byte) ([]byte, error) {
 	//build request
 	httpRequest, err := http.NewRequest(
 		http.MethodPost,
-		os.Getenv("TX_HOST"),
-		bytes.NewBuffer([]byte(doc.String())))
+		c.baseURL,
+		bytes.NewBuffer(doc))
 	if err != nil {
 		return nil, errors.Wrap(err, "Error while generating request")
 	}
+	httpRequest = httpRequest.WithContext(ctx)
 	//add request header
 	httpRequest.Header.Add("Content-Type", "text/xml; charset=utf-8")
 
 	//send request
-	client := &http.Client{}
-	response, err := client.Do(httpRequest)
+	response, err := c.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, &TransportError{Err: err}
 	}
+	defer response.Body.Close()
 
 	//read response
 	body, err := ioutil.ReadAll(response.Body)
 	if err != nil {
+		return nil, &TransportError{Err: err}
+	}
+
+	//check response for faults and errors
+	if err := checkResponse(tmplName, response.StatusCode, body); err != nil {
 		return nil, err
 	}
-	defer response.Body.Close()
 
 	//return response
 	return body, nil
 }
+
+//isThrottled checks if Transics refused a request because of the request rate
+func isThrottled(err error) bool {
+	var httpErr *HTTPError
+	var apiErr *APIError
+
+	switch {
+	case errors.As(err, &httpErr):
+		return httpErr.StatusCode == http.StatusTooManyRequests
+	case errors.As(err, &apiErr):
+		return apiErr.IsRateLimited()
+	}
+
+	return false
+}
+
+//soapEnvelope is used to check any TX-TANGO response for faults and errors
+type soapEnvelope struct {
+	Body struct {
+		Fault    *SOAPFault `xml:"Fault"`
+		Response struct {
+			Result struct {
+				Errors TXError `xml:"Errors"`
+			} `xml:",any"`
+		} `xml:",any"`
+	} `xml:"Body"`
+}
+
+//checkResponse tells apart HTTP errors, SOAP faults and TX-TANGO errors
+func checkResponse(operation string, statusCode int, body []byte) error {
+	var envelope soapEnvelope
+	parseErr := xml.Unmarshal(body, &envelope)
+
+	//SOAP faults are usually sent with a 500 status
+	if parseErr == nil && envelope.Body.Fault != nil {
+		envelope.Body.Fault.StatusCode = statusCode
+		return envelope.Body.Fault
+	}
+
+	if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		return &HTTPError{StatusCode: statusCode, Body: string(body)}
+	}
+
+	if parseErr != nil {
+		return errors.Wrap(parseErr, "Error while parsing TX-TANGO response")
+	}
+
+	//business errors returned in the result
+	if txError := envelope.Body.Response.Result.Errors; !txError.Empty() {
+		return &APIError{Operation: operation, TXError: txError}
+	}
+
+	return nil
+}
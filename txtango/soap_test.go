@@ -0,0 +1,69 @@
+package txtango
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	okResponse = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body>
+<Get_Drivers_V9Response xmlns="http://transics.org"><Get_Drivers_V9Result><Errors /><Warnings /></Get_Drivers_V9Result></Get_Drivers_V9Response>
+</soap:Body></soap:Envelope>`
+	faultResponse = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body>
+<soap:Fault><faultcode>soap:Server</faultcode><faultstring>Server was unable to process request</faultstring></soap:Fault>
+</soap:Body></soap:Envelope>`
+	apiErrorResponse = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body>
+<Get_Drivers_V9Response xmlns="http://transics.org"><Get_Drivers_V9Result>
+<Errors>
+<Error><ErrorCode>INVALID_LOGIN</ErrorCode><ErrorCodeExplenation>Wrong password</ErrorCodeExplenation></Error>
+<Error><ErrorCode>NO_DATA</ErrorCode><ErrorCodeExplenation>No data found</ErrorCodeExplenation></Error>
+</Errors>
+</Get_Drivers_V9Result></Get_Drivers_V9Response>
+</soap:Body></soap:Envelope>`
+)
+
+func TestCheckResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantErr    error
+	}{
+		{"valid response", http.StatusOK, okResponse, nil},
+		{"SOAP fault with a 500 status", http.StatusInternalServerError, faultResponse, &SOAPFault{}},
+		{"SOAP fault with a 200 status", http.StatusOK, faultResponse, &SOAPFault{}},
+		{"HTTP error without envelope", http.StatusServiceUnavailable, "Service Unavailable", &HTTPError{}},
+		{"HTTP error with a valid envelope", http.StatusBadGateway, okResponse, &HTTPError{}},
+		{"TX-TANGO error in the result", http.StatusOK, apiErrorResponse, &APIError{}},
+		//the parsing error is wrapped
+		{"invalid XML", http.StatusOK, "<soap:Envelope>", errors.Wrap(errors.New("EOF"), "parsing")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkResponse("GetDrivers", tt.statusCode, []byte(tt.body))
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("got error %T (%v), want %T", err, err, tt.wantErr)
+			}
+
+			switch e := err.(type) {
+			case *SOAPFault:
+				if e.StatusCode != tt.statusCode || e.Code != "soap:Server" {
+					t.Errorf("got fault %+v, want soap:Server with status %d", e, tt.statusCode)
+				}
+			case *HTTPError:
+				if e.StatusCode != tt.statusCode {
+					t.Errorf("got status %d, want %d", e.StatusCode, tt.statusCode)
+				}
+			case *APIError:
+				//every entry is kept
+				if e.Operation != "GetDrivers" || len(e.Errors) != 2 || e.First().Code != "INVALID_LOGIN" || e.Errors[1].Code != "NO_DATA" {
+					t.Errorf("got error %+v, want INVALID_LOGIN and NO_DATA of GetDrivers", e)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package txtango
 
 import (
+	"context"
 	"encoding/xml"
 	"time"
 )
@@ -153,20 +154,20 @@ type GetActivityReportResponse struct {
 
 //GetActivityReport wraps SAOPCall to make a Get_ActivityReport_V11 request
 //the date argument is used to get the report of a specific date
-func GetActivityReport(vehicleTransicsID uint, start, end time.Time) (*GetActivityReportResponse, error) {
+func (c *Client) GetActivityReport(ctx context.Context, vehicleTransicsID uint, start, end time.Time) (*GetActivityReportResponse, error) {
 	startDate := start.Format("2006-01-02")
 	endDate := end.Format("2006-01-02")
 
 	//make an authenticated request
 	params := &GetActivityReportRequest{
-		Login:             *authenticate(),
+		Login:             *c.authenticate(),
 		VehicleTransicsID: vehicleTransicsID,
 		// parse the date to transics format
 		StartDate: startDate,
 		EndDate:   endDate,
 	}
 
-	resp, err := soapCall(params, "GetActivityReport", getActivityReportTemplate)
+	resp, err := c.soapCall(ctx, params, "GetActivityReport", getActivityReportTemplate)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,76 @@
+package txtango
+
+//ErrorCategory groups TX-TANGO error and warning codes by what the caller should do
+type ErrorCategory string
+
+//Categories of TX-TANGO error and warning codes
+const (
+	CategoryUnknown        ErrorCategory = "unknown"
+	CategoryAuth           ErrorCategory = "auth"
+	CategoryRateLimit      ErrorCategory = "rate_limit"
+	CategoryNoData         ErrorCategory = "no_data"
+	CategoryInvalidRequest ErrorCategory = "invalid_request"
+	CategoryServer         ErrorCategory = "server"
+)
+
+//ErrorCode describes a known TX-TANGO error or warning code
+type ErrorCode struct {
+	Code        string
+	Category    ErrorCategory
+	Description string
+}
+
+//codeCatalogue contains the TX-TANGO codes handled by tx2db
+//codes which are not listed here are reported as CategoryUnknown and are never retried, see IsTemporary
+var codeCatalogue = map[string]ErrorCode{}
+
+func init() {
+	for _, code := range []ErrorCode{
+		//login
+		{"LOGIN_FAILED", CategoryAuth, "The login credentials are not valid"},
+		{"INVALID_LOGIN", CategoryAuth, "The login credentials are not valid"},
+		{"INVALID_PASSWORD", CategoryAuth, "The dispatcher password is not valid"},
+		{"INVALID_DISPATCHER", CategoryAuth, "The dispatcher does not exist"},
+		{"INVALID_INTEGRATOR", CategoryAuth, "The integrator is not valid"},
+		{"INVALID_SYSTEMNR", CategoryAuth, "The system number is not valid"},
+		{"PASSWORD_EXPIRED", CategoryAuth, "The dispatcher password has expired"},
+		{"ACCOUNT_LOCKED", CategoryAuth, "The dispatcher account is locked"},
+		{"ACCESS_DENIED", CategoryAuth, "The dispatcher has no access to this service"},
+		{"NO_RIGHTS", CategoryAuth, "The dispatcher has no rights on this service"},
+		//throttling
+		{"TOO_MANY_REQUESTS", CategoryRateLimit, "Too many requests have been sent"},
+		{"MAX_REQUESTS_EXCEEDED", CategoryRateLimit, "The maximum number of requests is exceeded"},
+		{"REQUEST_LIMIT_EXCEEDED", CategoryRateLimit, "The request limit is exceeded"},
+		{"CONCURRENT_REQUESTS", CategoryRateLimit, "Too many requests are running at the same time"},
+		{"SERVICE_BUSY", CategoryRateLimit, "The service is busy, try again later"},
+		//no data
+		{"NO_DATA", CategoryNoData, "No data found"},
+		{"NO_DATA_FOUND", CategoryNoData, "No data found"},
+		{"NO_RESULT", CategoryNoData, "No result found"},
+		{"NO_RESULTS", CategoryNoData, "No result found"},
+		{"NOTHING_FOUND", CategoryNoData, "Nothing found for the selection"},
+		//request
+		{"INVALID_PARAMETER", CategoryInvalidRequest, "A parameter of the request is not valid"},
+		{"MANDATORY_FIELD", CategoryInvalidRequest, "A mandatory field is missing"},
+		{"INVALID_DATE", CategoryInvalidRequest, "A date of the request is not valid"},
+		{"INVALID_DATE_RANGE", CategoryInvalidRequest, "The date range is not valid"},
+		{"DATE_RANGE_TOO_LARGE", CategoryInvalidRequest, "The date range is too large"},
+		{"UNKNOWN_VEHICLE", CategoryInvalidRequest, "The vehicle does not exist"},
+		{"UNKNOWN_DRIVER", CategoryInvalidRequest, "The driver does not exist"},
+		//server
+		{"INTERNAL_ERROR", CategoryServer, "Internal TX-TANGO error"},
+		{"DATABASE_ERROR", CategoryServer, "TX-TANGO database error"},
+		{"TIMEOUT", CategoryServer, "TX-TANGO did not answer in time"},
+	} {
+		codeCatalogue[code.Code] = code
+	}
+}
+
+//LookupCode returns the description of a TX-TANGO error or warning code
+func LookupCode(code string) ErrorCode {
+	if known, ok := codeCatalogue[code]; ok {
+		return known
+	}
+
+	return ErrorCode{Code: code, Category: CategoryUnknown}
+}
@@ -1,6 +1,7 @@
 package txtango
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -114,12 +115,12 @@ type GetDriversResponse struct {
 }
 
 //GetDrivers wraps SAOPCall to make a Get_Drivers_V9 request
-func GetDrivers() (*GetDriversResponse, error) {
+func (c *Client) GetDrivers(ctx context.Context) (*GetDriversResponse, error) {
 	//make an authenticated request
 	params := &GetDriversRequest{
-		Login: *authenticate(),
+		Login: *c.authenticate(),
 	}
-	resp, err := soapCall(params, "GetDrivers", getDriversTemplate)
+	resp, err := c.soapCall(ctx, params, "GetDrivers", getDriversTemplate)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package txtango
 
 import (
+	"context"
 	"encoding/xml"
 	"time"
 )
@@ -187,20 +188,20 @@ type GetEcoReportResponse struct {
 
 //GetEcoReport wraps SAOPCall to make a Get_EcoMonitor_Report_V4 request
 //the date argument is used to get the report of a specific date
-func GetEcoReport(driverTransicsID uint, start, end time.Time) (*GetEcoReportResponse, error) {
+func (c *Client) GetEcoReport(ctx context.Context, driverTransicsID uint, start, end time.Time) (*GetEcoReportResponse, error) {
 	startDate := start.Format("2006-01-02")
 	endDate := end.Format("2006-01-02")
 
 	//make an authenticated request
 	params := &GetEcoReportRequest{
-		Login:            *authenticate(),
+		Login:            *c.authenticate(),
 		DriverTransicsID: driverTransicsID,
 		// parse the date to transics format
 		StartDate: startDate,
 		EndDate:   endDate,
 	}
 
-	resp, err := soapCall(params, "GetEcoReport", getEcoReport)
+	resp, err := c.soapCall(ctx, params, "GetEcoReport", getEcoReport)
 	if err != nil {
 		return nil, err
 	}
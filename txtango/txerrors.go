@@ -1,23 +1,181 @@
 package txtango
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
 //TXError parses errors message in response
+//a response can contain several errors
 type TXError struct {
-	Text  string `xml:",chardata"`
-	Error struct {
-		Code            string `xml:"ErrorCode"`
-		CodeExplenation string `xml:"ErrorCodeExplenation"`
-		Field           string `xml:"Field"`
-		Value           string `xml:"Value"`
-	} `xml:"Error"`
+	Text   string        `xml:",chardata"`
+	Errors []TXErrorItem `xml:"Error"`
+}
+
+//TXErrorItem is a single error of a response
+type TXErrorItem struct {
+	Code            string `xml:"ErrorCode"`
+	CodeExplenation string `xml:"ErrorCodeExplenation"`
+	Field           string `xml:"Field"`
+	Value           string `xml:"Value"`
 }
 
 //TXWarning parses warning message in response
+//a response can contain several warnings
 type TXWarning struct {
-	Text    string `xml:",chardata"`
-	Warning struct {
-		Code            string `xml:"WarningCode"`
-		CodeExplenation string `xml:"WarningCodeExplenation"`
-		Field           string `xml:"Field"`
-		Value           string `xml:"Value"`
-	} `xml:"Warning"`
+	Text     string          `xml:",chardata"`
+	Warnings []TXWarningItem `xml:"Warning"`
+}
+
+//TXWarningItem is a single warning of a response
+type TXWarningItem struct {
+	Code            string `xml:"WarningCode"`
+	CodeExplenation string `xml:"WarningCodeExplenation"`
+	Field           string `xml:"Field"`
+	Value           string `xml:"Value"`
+}
+
+//Category returns the category of the error code
+func (e TXErrorItem) Category() ErrorCategory {
+	return LookupCode(e.Code).Category
+}
+
+//Category returns the category of the warning code
+func (w TXWarningItem) Category() ErrorCategory {
+	return LookupCode(w.Code).Category
+}
+
+//Empty checks if the response contains no error
+func (e TXError) Empty() bool {
+	return len(e.Errors) == 0
+}
+
+//IsAuthError checks if one of the errors is due to the login credentials
+func (e TXError) IsAuthError() bool {
+	return e.has(CategoryAuth)
+}
+
+//IsRateLimited checks if one of the errors is due to Transics throttling
+func (e TXError) IsRateLimited() bool {
+	return e.has(CategoryRateLimit)
+}
+
+//IsNoData checks if the errors only tell that no data has been found
+func (e TXError) IsNoData() bool {
+	for _, item := range e.Errors {
+		if item.Category() != CategoryNoData {
+			return false
+		}
+	}
+	return !e.Empty()
+}
+
+//has checks if one of the errors belongs to the given category
+func (e TXError) has(category ErrorCategory) bool {
+	for _, item := range e.Errors {
+		if item.Category() == category {
+			return true
+		}
+	}
+	return false
+}
+
+//Empty checks if the response contains no warning
+func (w TXWarning) Empty() bool {
+	return len(w.Warnings) == 0
+}
+
+//TransportError is returned when TX-TANGO cannot be reached
+type TransportError struct {
+	Err error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("Error while reaching TX-TANGO: %v", e.Err)
+}
+
+//Unwrap returns the underlying network error
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+//HTTPError is returned when TX-TANGO answers with an unexpected HTTP status
+type HTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("TX-TANGO answered with HTTP status %d", e.StatusCode)
+}
+
+//SOAPFault is returned when TX-TANGO answers with a soap:Fault envelope
+type SOAPFault struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"faultcode"`
+	String     string `xml:"faultstring"`
+	Actor      string `xml:"faultactor"`
+	Detail     struct {
+		Text string `xml:",innerxml"`
+	} `xml:"detail"`
+}
+
+func (e *SOAPFault) Error() string {
+	return fmt.Sprintf("TX-TANGO SOAP fault %s: %s", e.Code, e.String)
+}
+
+//APIError is returned when TX-TANGO answers with errors (TXError) in its result
+type APIError struct {
+	Operation string
+	TXError
+}
+
+func (e *APIError) Error() string {
+	var msg []string
+	for _, item := range e.Errors {
+		msg = append(msg, fmt.Sprintf("%s: %s", item.Code, item.CodeExplenation))
+	}
+	return fmt.Sprintf("TX-TANGO %s error %s", e.Operation, strings.Join(msg, ", "))
+}
+
+//First returns the first error of the response, used as main reason
+func (e *APIError) First() TXErrorItem {
+	if e.Empty() {
+		return TXErrorItem{}
+	}
+	return e.Errors[0]
+}
+
+//IsTemporary checks if a failed TX-TANGO call is worth retrying later
+func IsTemporary(err error) bool {
+	var transportErr *TransportError
+	var httpErr *HTTPError
+	var apiErr *APIError
+
+	switch {
+	case errors.As(err, &transportErr):
+		//a cancelled call should not be retried
+		return !errors.Is(transportErr.Err, context.Canceled)
+	case errors.As(err, &httpErr):
+		return httpErr.StatusCode >= http.StatusInternalServerError ||
+			httpErr.StatusCode == http.StatusTooManyRequests ||
+			httpErr.StatusCode == http.StatusRequestTimeout
+	case errors.As(err, &apiErr):
+		//only throttling, server failures and data not available yet get better with time
+		//wrong credentials or requests and unknown codes are permanent
+		for _, item := range apiErr.Errors {
+			switch item.Category() {
+			case CategoryRateLimit, CategoryServer, CategoryNoData:
+			default:
+				return false
+			}
+		}
+		return !apiErr.Empty()
+	}
+
+	return false
 }
@@ -0,0 +1,73 @@
+package txtango
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+//apiError builds an APIError with the given error codes
+func apiError(codes ...string) *APIError {
+	err := &APIError{Operation: "Get_Drivers_V9"}
+	for _, code := range codes {
+		err.Errors = append(err.Errors, TXErrorItem{Code: code})
+	}
+	return err
+}
+
+func TestIsTemporary(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"network failure", &TransportError{Err: errors.New("connection refused")}, true},
+		{"cancelled call", &TransportError{Err: context.Canceled}, false},
+		{"server error", &HTTPError{StatusCode: http.StatusServiceUnavailable}, true},
+		{"throttled", &HTTPError{StatusCode: http.StatusTooManyRequests}, true},
+		{"request timeout", &HTTPError{StatusCode: http.StatusRequestTimeout}, true},
+		{"bad request", &HTTPError{StatusCode: http.StatusBadRequest}, false},
+		{"soap fault", &SOAPFault{Code: "soap:Client"}, false},
+		{"rate limited", apiError("TOO_MANY_REQUESTS"), true},
+		{"server failure", apiError("INTERNAL_ERROR"), true},
+		{"no data yet", apiError("NO_DATA"), true},
+		{"wrong credentials", apiError("INVALID_LOGIN"), false},
+		{"invalid request", apiError("INVALID_DATE_RANGE"), false},
+		{"unknown code", apiError("SOMETHING_NEW"), false},
+		{"unknown code with a temporary one", apiError("TOO_MANY_REQUESTS", "SOMETHING_NEW"), false},
+		{"no error entry", apiError(), false},
+		{"wrapped", errors.Wrap(apiError("TOO_MANY_REQUESTS"), "import"), true},
+		{"other error", errors.New("parse error"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTemporary(tt.err); got != tt.want {
+				t.Errorf("IsTemporary(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTXErrorCategories(t *testing.T) {
+	if !apiError("NO_DATA", "NO_RESULT").IsNoData() {
+		t.Error("only no data errors, want IsNoData")
+	}
+	if apiError("NO_DATA", "INVALID_DATE").IsNoData() {
+		t.Error("an invalid request is not only no data")
+	}
+	if apiError().IsNoData() {
+		t.Error("no error is not no data")
+	}
+	if !apiError("INVALID_DATE", "ACCESS_DENIED").IsAuthError() {
+		t.Error("want IsAuthError when one of the errors is an auth error")
+	}
+	if !apiError("MAX_REQUESTS_EXCEEDED").IsRateLimited() {
+		t.Error("want IsRateLimited")
+	}
+	if got := LookupCode("SOMETHING_NEW").Category; got != CategoryUnknown {
+		t.Errorf("unknown code in category %s, want %s", got, CategoryUnknown)
+	}
+}
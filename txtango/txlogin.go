@@ -2,7 +2,6 @@
 package txtango
 
 import (
-	"os"
 	"time"
 )
 
@@ -35,15 +34,10 @@ type Login struct {
 	Language   string
 }
 
-//authenticate helper build authentication bloc using .env
-func authenticate() *Login {
-	login := Login{}
-	//fill in login credentials
-	login.Dispatcher = os.Getenv("TX_USERNAME")
-	login.Password = os.Getenv("TX_PASSWORD")
-	login.Integrator = os.Getenv("TX_INTEGRATOR")
-	login.SystemNr = os.Getenv("TX_SYSTEM_NR")
-	login.Language = "EN"
+//authenticate helper build authentication bloc using the client credentials
+func (c *Client) authenticate() *Login {
+	//copy login credentials
+	login := c.login
 
 	// build time string
 	login.Date = time.Time{}.Format(time.RFC3339)
@@ -0,0 +1,129 @@
+package txtango
+
+import (
+	"context"
+	"encoding/xml"
+	"time"
+)
+
+//getTachoDataTemplate implements Get_Tacho_Data_V2
+//the requests filters by driver using their transics_id, the selection is built as the one of the activity report
+var getTachoDataTemplate = `
+<soap:Envelope xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+    <soap:Body>
+        <Get_Tacho_Data_V2 xmlns="http://transics.org">
+			{{ template "login" .Login}}
+            <TachoDataSelection>
+                <Drivers>
+                    <IdentifierDriver>
+                        <IdentifierDriverType>TRANSICS_ID</IdentifierDriverType>
+                        <Id>{{.DriverTransicsID}}</Id>
+                    </IdentifierDriver>
+                </Drivers>
+                <DateTimeRangeSelection>
+                    <DateTypeSelection>STARTED</DateTypeSelection>
+                    <StartDate>{{.StartDate}}</StartDate>
+                    <EndDate>{{.EndDate}}</EndDate>
+                </DateTimeRangeSelection>
+            </TachoDataSelection>
+        </Get_Tacho_Data_V2>
+    </soap:Body>
+</soap:Envelope>`
+
+//Tachograph activities returned by Get_Tacho_Data_V2
+const (
+	TachoDriving   = "DRIVING"
+	TachoWorking   = "WORKING"
+	TachoAvailable = "AVAILABLE"
+	TachoResting   = "RESTING"
+)
+
+//GetTachoDataRequest implements Get_Tacho_Data_V2
+type GetTachoDataRequest struct {
+	// every request must implement the login
+	Login            Login
+	DriverTransicsID uint
+	StartDate        string
+	EndDate          string
+}
+
+//GetTachoDataResponse parses the response from Transics
+//durations are in minutes
+type GetTachoDataResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Text    string   `xml:",chardata"`
+	Soap    string   `xml:"soap,attr"`
+	Xsi     string   `xml:"xsi,attr"`
+	Xsd     string   `xml:"xsd,attr"`
+	Body    struct {
+		Text                   string `xml:",chardata"`
+		GetTachoDataV2Response struct {
+			Text                 string `xml:",chardata"`
+			Xmlns                string `xml:"xmlns,attr"`
+			GetTachoDataV2Result struct {
+				Text            string    `xml:",chardata"`
+				Executiontime   string    `xml:"Executiontime,attr"`
+				Errors          TXError   `xml:"Errors"`
+				Warnings        TXWarning `xml:"Warnings"`
+				TachoActivities struct {
+					Text              string `xml:",chardata"`
+					TachoActivityItem []struct {
+						Text   string `xml:",chardata"`
+						Driver struct {
+							Text       string `xml:",chardata"`
+							ID         string `xml:"ID"`
+							TransicsID uint   `xml:"TransicsID"`
+							Code       string `xml:"Code"`
+							Filter     string `xml:"Filter"`
+							LastName   string `xml:"LastName"`
+							FirstName  string `xml:"FirstName"`
+						} `xml:"Driver"`
+						Vehicle struct {
+							Text         string `xml:",chardata"`
+							ID           string `xml:"ID"`
+							TransicsID   uint   `xml:"TransicsID"`
+							Code         string `xml:"Code"`
+							Filter       string `xml:"Filter"`
+							LicensePlate string `xml:"LicensePlate"`
+						} `xml:"Vehicle"`
+						Activity  string  `xml:"Activity"`
+						Slot      string  `xml:"Slot"`
+						BeginDate string  `xml:"BeginDate"`
+						EndDate   string  `xml:"EndDate"`
+						Duration  float32 `xml:"Duration"`
+					} `xml:"TachoActivityItem"`
+				} `xml:"TachoActivities"`
+			} `xml:"Get_Tacho_Data_V2Result"`
+		} `xml:"Get_Tacho_Data_V2Response"`
+	} `xml:"Body"`
+}
+
+//GetTachoData wraps SAOPCall to make a Get_Tacho_Data_V2 request
+//it returns the tachograph activities of a driver started between start and end
+func (c *Client) GetTachoData(ctx context.Context, driverTransicsID uint, start, end time.Time) (*GetTachoDataResponse, error) {
+	startDate := start.Format("2006-01-02")
+	endDate := end.Format("2006-01-02")
+
+	//make an authenticated request
+	params := &GetTachoDataRequest{
+		Login:            *c.authenticate(),
+		DriverTransicsID: driverTransicsID,
+		// parse the date to transics format
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	resp, err := c.soapCall(ctx, params, "GetTachoData", getTachoDataTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	//unmarshal json
+	data := &GetTachoDataResponse{}
+	err = xml.Unmarshal(resp, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,315 @@
+package txtangotest
+
+import (
+	"text/template"
+	"time"
+)
+
+//Driver is a driver fixture returned by Get_Drivers_V9
+type Driver struct {
+	TransicsID uint
+	PersonID   string
+	Name       string
+	Language   string
+	Inactive   bool
+	LastUpdate time.Time
+}
+
+//Vehicle is a vehicle fixture returned by Get_Vehicles_V13
+type Vehicle struct {
+	TransicsID           uint
+	LicensePlate         string
+	Inactive             bool
+	Modified             time.Time
+	Group                string
+	DriverTransicsID     uint
+	TrailerTransicsID    uint
+	TrailerLicensePlate  string
+	ETAStatus            string
+	DestinationLongitude float32
+	DestinationLatitude  float32
+	CurrentKms           float64
+	FuelLevel            float32
+	Speed                float32
+	DistanceETA          float32
+	ETA                  time.Time //zero is sent as nil
+}
+
+//Activity is an activity fixture returned by Get_ActivityReport_V11
+type Activity struct {
+	Begin        time.Time
+	End          time.Time
+	KmBegin      int
+	KmEnd        int
+	Consumption  float32
+	LoadedStatus string
+	Name         string
+	ActivityType string
+	SpeedAvg     float32
+	Longitude    float32
+	Latitude     float32
+	CountryCode  string
+}
+
+//EcoReport is an eco monitor report fixture returned by Get_EcoMonitor_Report_V4
+type EcoReport struct {
+	VehicleTransicsID          uint
+	DriverTransicsID           uint
+	Begin                      time.Time
+	End                        time.Time
+	Distance                   float32
+	Duration                   float32
+	FuelConsumption            float32
+	SpeedAverage               float32
+	DurationIdling             float32
+	DistanceCoasting           float32
+	NumberOfPanicBrakes        int
+	NumberOfHarshAccelerations int
+	DistanceOnCruiseControl    float32
+}
+
+//TachoActivity is a tachograph activity fixture returned by Get_Tacho_Data_V2
+//Activity is one of the txtango.Tacho activities, Duration is in minutes
+type TachoActivity struct {
+	VehicleTransicsID uint
+	DriverTransicsID  uint
+	Begin             time.Time
+	End               time.Time
+	Activity          string
+	Slot              string
+	Duration          float32
+}
+
+//Message is a text message received by Send_TextMessage
+type Message struct {
+	VehicleTransicsID uint
+	Text              string
+}
+
+//responseData is the data used to fill in the response templates
+type responseData struct {
+	Errors          []Entry
+	Warnings        []Entry
+	Drivers         []Driver
+	Vehicles        []Vehicle
+	Activities      []Activity
+	EcoReports      []EcoReport
+	TachoActivities []TachoActivity
+	Messages        []Message
+}
+
+//resultTemplate is shared by every response to render errors and warnings
+var resultTemplate = `
+{{ define "result" }}
+<Errors>{{range .Errors}}
+	<Error>
+		<ErrorCode>{{escape .Code}}</ErrorCode>
+		<ErrorCodeExplenation>{{escape .Explanation}}</ErrorCodeExplenation>
+		<Field>{{escape .Field}}</Field>
+		<Value>{{escape .Value}}</Value>
+	</Error>{{end}}
+</Errors>
+<Warnings>{{range .Warnings}}
+	<Warning>
+		<WarningCode>{{escape .Code}}</WarningCode>
+		<WarningCodeExplenation>{{escape .Explanation}}</WarningCodeExplenation>
+		<Field>{{escape .Field}}</Field>
+		<Value>{{escape .Value}}</Value>
+	</Warning>{{end}}
+</Warnings>
+{{ end }}
+`
+
+var getDriversResponse = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+<soap:Body>
+<Get_Drivers_V9Response xmlns="http://transics.org">
+<Get_Drivers_V9Result Executiontime="0">
+	{{ template "result" . }}
+	<Persons>{{range .Drivers}}
+		<InterfacePersonResult_V9>
+			<PersonExternalCode>{{escape .PersonID}}</PersonExternalCode>
+			<Inactive>{{.Inactive}}</Inactive>
+			<Languages><WorkingLanguage>{{escape .Language}}</WorkingLanguage></Languages>
+			<PersonTransicsId>{{.TransicsID}}</PersonTransicsId>
+			<UpdateDatesList>
+				<UpdateDatesItem><Name>Person</Name><DateLastUpdate>{{date .LastUpdate}}</DateLastUpdate></UpdateDatesItem>
+			</UpdateDatesList>
+			<FormattedName>{{escape .Name}}</FormattedName>
+		</InterfacePersonResult_V9>{{end}}
+	</Persons>
+</Get_Drivers_V9Result>
+</Get_Drivers_V9Response>
+</soap:Body>
+</soap:Envelope>`
+
+var getVehiclesResponse = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+<soap:Body>
+<Get_Vehicles_V13Response xmlns="http://transics.org">
+<Get_Vehicles_V13Result Executiontime="0">
+	{{ template "result" . }}
+	<Vehicles>{{range .Vehicles}}
+		<InterfaceVehicleResult_V13>
+			<Groups><TxConnectGroups><ConnectGroups>
+				<ConnectGroup><Group>txtangotest</Group><SubGroup>{{escape .Group}}</SubGroup></ConnectGroup>
+			</ConnectGroups></TxConnectGroups></Groups>
+			<LicensePlate>{{escape .LicensePlate}}</LicensePlate>
+			<Inactive>{{.Inactive}}</Inactive>
+			<Trailer>
+				<TransicsID>{{.TrailerTransicsID}}</TransicsID>
+				<LicensePlate>{{escape .TrailerLicensePlate}}</LicensePlate>
+			</Trailer>
+			<VehicleTransicsID>{{.TransicsID}}</VehicleTransicsID>
+			<Modified>{{date .Modified}}</Modified>
+			<CurrentKms>{{.CurrentKms}}</CurrentKms>
+			<FuelLevel>{{.FuelLevel}}</FuelLevel>
+			<Speed>{{.Speed}}</Speed>
+			<Driver><TransicsID>{{.DriverTransicsID}}</TransicsID></Driver>
+			<ETAInfo>
+				<PositionDestination>
+					<Longitude>{{.DestinationLongitude}}</Longitude>
+					<Latitude>{{.DestinationLatitude}}</Latitude>
+				</PositionDestination>
+				<ETAStatus>{{escape .ETAStatus}}</ETAStatus>
+				<DistanceETA>{{.DistanceETA}}</DistanceETA>
+				{{if .ETA.IsZero}}<ETA xsi:nil="true" />{{else}}<ETA>{{date .ETA}}</ETA>{{end}}
+			</ETAInfo>
+		</InterfaceVehicleResult_V13>{{end}}
+	</Vehicles>
+</Get_Vehicles_V13Result>
+</Get_Vehicles_V13Response>
+</soap:Body>
+</soap:Envelope>`
+
+var getActivityReportResponse = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+<soap:Body>
+<Get_ActivityReport_V11Response xmlns="http://transics.org">
+<Get_ActivityReport_V11Result Executiontime="0">
+	{{ template "result" . }}
+	<ActivityReportItems>{{range .Activities}}
+		<ActivityReportItem_V11>
+			<BeginDate>{{date .Begin}}</BeginDate>
+			<EndDate>{{date .End}}</EndDate>
+			<KmBegin>{{.KmBegin}}</KmBegin>
+			<KmEnd>{{.KmEnd}}</KmEnd>
+			<Consumption>{{.Consumption}}</Consumption>
+			<LoadedStatus>{{escape .LoadedStatus}}</LoadedStatus>
+			<Activity>
+				<Name>{{escape .Name}}</Name>
+				<ActivityType>{{escape .ActivityType}}</ActivityType>
+			</Activity>
+			<SpeedAvg>{{.SpeedAvg}}</SpeedAvg>
+			<Position>
+				<Longitude>{{.Longitude}}</Longitude>
+				<Latitude>{{.Latitude}}</Latitude>
+				<CountryCode>{{escape .CountryCode}}</CountryCode>
+			</Position>
+		</ActivityReportItem_V11>{{end}}
+	</ActivityReportItems>
+</Get_ActivityReport_V11Result>
+</Get_ActivityReport_V11Response>
+</soap:Body>
+</soap:Envelope>`
+
+var getEcoReportResponse = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+<soap:Body>
+<Get_EcoMonitor_Report_V4Response xmlns="http://transics.org">
+<Get_EcoMonitor_Report_V4Result Executiontime="0">
+	{{ template "result" . }}
+	<EcoMonitorReportItems>{{range .EcoReports}}
+		<EcoMonitorReportItem_V3>
+			<Vehicle><TransicsID>{{.VehicleTransicsID}}</TransicsID></Vehicle>
+			<Driver><TransicsID>{{.DriverTransicsID}}</TransicsID></Driver>
+			<BeginDate>{{date .Begin}}</BeginDate>
+			<EndDate>{{date .End}}</EndDate>
+			<DataResult>
+				<Distance>{{.Distance}}</Distance>
+				<Duration>{{.Duration}}</Duration>
+				<FuelConsumption>{{.FuelConsumption}}</FuelConsumption>
+				<SpeedAverage>{{.SpeedAverage}}</SpeedAverage>
+			</DataResult>
+			<IdlingResult><DurationIdling>{{.DurationIdling}}</DurationIdling></IdlingResult>
+			<CoastingResult><DistanceCoasting>{{.DistanceCoasting}}</DistanceCoasting></CoastingResult>
+			<AnticipationResult>
+				<NumberOfPanicBrakes>{{.NumberOfPanicBrakes}}</NumberOfPanicBrakes>
+				<NumberOfHarshAccelerations>{{.NumberOfHarshAccelerations}}</NumberOfHarshAccelerations>
+			</AnticipationResult>
+			<CruisingResult><DistanceOnCruiseControl>{{.DistanceOnCruiseControl}}</DistanceOnCruiseControl></CruisingResult>
+		</EcoMonitorReportItem_V3>{{end}}
+	</EcoMonitorReportItems>
+</Get_EcoMonitor_Report_V4Result>
+</Get_EcoMonitor_Report_V4Response>
+</soap:Body>
+</soap:Envelope>`
+
+var getTachoDataResponse = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+<soap:Body>
+<Get_Tacho_Data_V2Response xmlns="http://transics.org">
+<Get_Tacho_Data_V2Result Executiontime="0">
+	{{ template "result" . }}
+	<TachoActivities>{{range .TachoActivities}}
+		<TachoActivityItem>
+			<Driver><TransicsID>{{.DriverTransicsID}}</TransicsID></Driver>
+			<Vehicle><TransicsID>{{.VehicleTransicsID}}</TransicsID></Vehicle>
+			<Activity>{{escape .Activity}}</Activity>
+			<Slot>{{escape .Slot}}</Slot>
+			<BeginDate>{{date .Begin}}</BeginDate>
+			<EndDate>{{date .End}}</EndDate>
+			<Duration>{{.Duration}}</Duration>
+		</TachoActivityItem>{{end}}
+	</TachoActivities>
+</Get_Tacho_Data_V2Result>
+</Get_Tacho_Data_V2Response>
+</soap:Body>
+</soap:Envelope>`
+
+var sendTextMessageResponse = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+<soap:Body>
+<Send_TextMessageResponse xmlns="http://transics.org">
+<Send_TextMessageResult Executiontime="0">
+	{{ template "result" . }}
+	<SendTextMessageResultInfos>{{range .Messages}}
+		<SendTextMessageResultInfo>
+			<Vehicle><TransicsID>{{.VehicleTransicsID}}</TransicsID></Vehicle>
+			<MessageId>1</MessageId>
+		</SendTextMessageResultInfo>{{end}}
+	</SendTextMessageResultInfos>
+</Send_TextMessageResult>
+</Send_TextMessageResponse>
+</soap:Body>
+</soap:Envelope>`
+
+var faultResponse = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+<soap:Body>
+<soap:Fault>
+	<faultcode>{{escape .Code}}</faultcode>
+	<faultstring>{{escape .String}}</faultstring>
+	<detail />
+</soap:Fault>
+</soap:Body>
+</soap:Envelope>`
+
+//faultTemplate is the parsed soap:Fault response
+var faultTemplate = template.Must(template.New("fault").Funcs(templateFuncs).Parse(faultResponse))
+
+//responseTemplates contains the parsed response template of every operation
+var responseTemplates = map[string]*template.Template{
+	GetDrivers:        parseResponse(GetDrivers, getDriversResponse),
+	GetVehicles:       parseResponse(GetVehicles, getVehiclesResponse),
+	GetActivityReport: parseResponse(GetActivityReport, getActivityReportResponse),
+	GetEcoReport:      parseResponse(GetEcoReport, getEcoReportResponse),
+	GetTachoData:      parseResponse(GetTachoData, getTachoDataResponse),
+	SendTextMessage:   parseResponse(SendTextMessage, sendTextMessageResponse),
+}
+
+//parseResponse parses a response template including the result block
+func parseResponse(name, raw string) *template.Template {
+	tmpl := template.Must(template.New(name).Funcs(templateFuncs).Parse(raw))
+	return template.Must(tmpl.Parse(resultTemplate))
+}
@@ -0,0 +1,387 @@
+//Package txtangotest implements a fake TX-TANGO SOAP server answering from fixtures
+//It permits to run the importers against a local server instead of Transics
+package txtangotest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"text/template"
+	"time"
+	"tx2db/txtango"
+)
+
+//TX-TANGO operations answered by the server
+const (
+	GetDrivers        = "Get_Drivers_V9"
+	GetVehicles       = "Get_Vehicles_V13"
+	GetActivityReport = "Get_ActivityReport_V11"
+	GetEcoReport      = "Get_EcoMonitor_Report_V4"
+	GetTachoData      = "Get_Tacho_Data_V2"
+	SendTextMessage   = "Send_TextMessage"
+)
+
+//transicsDateFormat is the date format used by TX-TANGO
+const transicsDateFormat = "2006-01-02T15:04:05"
+
+//Entry represents an error or a warning returned in a TX-TANGO result
+type Entry struct {
+	Code        string
+	Explanation string
+	Field       string
+	Value       string
+}
+
+//Server is a fake TX-TANGO server
+type Server struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	drivers    []Driver
+	vehicles   []Vehicle
+	activities map[uint][]Activity
+	ecoReports map[uint][]EcoReport
+	tacho      map[uint][]TachoActivity
+	messages   []Message
+	errors     map[string][]Entry
+	warnings   map[string][]Entry
+	delays     map[string]time.Duration
+	faults     map[string]Fault
+	statuses   map[string]int
+	calls      map[string]int
+}
+
+//Fault represents a soap:Fault returned instead of a result
+type Fault struct {
+	Code   string
+	String string
+}
+
+//request contains the fields of a TX-TANGO request used to filter fixtures
+type request struct {
+	Operation string
+	ID        uint
+	StartDate string
+	EndDate   string
+	Message   string
+}
+
+//NewServer starts a fake TX-TANGO server, it must be closed by the caller
+func NewServer() *Server {
+	s := &Server{
+		activities: make(map[uint][]Activity),
+		ecoReports: make(map[uint][]EcoReport),
+		tacho:      make(map[uint][]TachoActivity),
+		errors:     make(map[string][]Entry),
+		warnings:   make(map[string][]Entry),
+		delays:     make(map[string]time.Duration),
+		faults:     make(map[string]Fault),
+		statuses:   make(map[string]int),
+		calls:      make(map[string]int),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s
+}
+
+//Config returns a TX-TANGO configuration pointing to the fake server
+//the server has no rate limit, the requests are not slowed down
+func (s *Server) Config() txtango.Config {
+	return txtango.Config{
+		BaseURL:           s.URL,
+		Dispatcher:        "txtangotest",
+		Password:          "txtangotest",
+		Integrator:        "txtangotest",
+		SystemNr:          "1",
+		HTTPClient:        s.Server.Client(),
+		RequestsPerSecond: 1000,
+		Burst:             100,
+	}
+}
+
+//Client returns a TX-TANGO client connected to the fake server
+func (s *Server) Client() (*txtango.Client, error) {
+	return txtango.NewClient(s.Config())
+}
+
+//AddDriver adds a driver returned by Get_Drivers_V9
+func (s *Server) AddDriver(drivers ...Driver) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.drivers = append(s.drivers, drivers...)
+}
+
+//AddVehicle adds a vehicle returned by Get_Vehicles_V13
+func (s *Server) AddVehicle(vehicles ...Vehicle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.vehicles = append(s.vehicles, vehicles...)
+}
+
+//AddActivity adds activities of a vehicle returned by Get_ActivityReport_V11
+func (s *Server) AddActivity(vehicleTransicsID uint, activities ...Activity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.activities[vehicleTransicsID] = append(s.activities[vehicleTransicsID], activities...)
+}
+
+//AddEcoReport adds eco monitor reports of a driver returned by Get_EcoMonitor_Report_V4
+func (s *Server) AddEcoReport(driverTransicsID uint, reports ...EcoReport) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ecoReports[driverTransicsID] = append(s.ecoReports[driverTransicsID], reports...)
+}
+
+//AddTachoActivity adds tachograph activities of a driver returned by Get_Tacho_Data_V2
+func (s *Server) AddTachoActivity(driverTransicsID uint, activities ...TachoActivity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tacho[driverTransicsID] = append(s.tacho[driverTransicsID], activities...)
+}
+
+//SetError makes an operation answer with the given TXError entries (none to remove them)
+func (s *Server) SetError(operation string, entries ...Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors[operation] = entries
+}
+
+//SetWarning makes an operation answer with the given TXWarning entries (none to remove them)
+func (s *Server) SetWarning(operation string, entries ...Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.warnings[operation] = entries
+}
+
+//SetDelay makes an operation wait before answering, useful to simulate a slow Transics
+func (s *Server) SetDelay(operation string, delay time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delays[operation] = delay
+}
+
+//SetFault makes an operation answer with a soap:Fault (nil to remove it)
+func (s *Server) SetFault(operation string, fault *Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if fault == nil {
+		delete(s.faults, operation)
+		return
+	}
+	s.faults[operation] = *fault
+}
+
+//SetHTTPStatus makes an operation answer with an HTTP error status (0 to remove it)
+func (s *Server) SetHTTPStatus(operation string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if status == 0 {
+		delete(s.statuses, operation)
+		return
+	}
+	s.statuses[operation] = status
+}
+
+//Messages returns the text messages received by Send_TextMessage
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+//Calls returns the number of requests received for an operation
+func (s *Server) Calls(operation string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[operation]
+}
+
+//handle answers a SOAP request
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req, err := parseRequest(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.calls[req.Operation]++
+	delay := s.delays[req.Operation]
+	fault, hasFault := s.faults[req.Operation]
+	status := s.statuses[req.Operation]
+	s.mu.Unlock()
+
+	//simulate a slow response
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+
+	if hasFault {
+		w.WriteHeader(http.StatusInternalServerError)
+		faultTemplate.Execute(w, fault)
+		return
+	}
+
+	if status != 0 {
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	tmpl, ok := responseTemplates[req.Operation]
+	if !ok {
+		http.Error(w, "Unknown operation "+req.Operation, http.StatusBadRequest)
+		return
+	}
+
+	doc := &bytes.Buffer{}
+	if err := tmpl.Execute(doc, s.result(req)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(doc.Bytes())
+}
+
+//result builds the data used to fill in a response template
+func (s *Server) result(req *request) *responseData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data := &responseData{
+		Errors:   s.errors[req.Operation],
+		Warnings: s.warnings[req.Operation],
+	}
+
+	switch req.Operation {
+	case GetDrivers:
+		data.Drivers = s.drivers
+	case GetVehicles:
+		data.Vehicles = s.vehicles
+	case GetActivityReport:
+		for _, activity := range s.activities[req.ID] {
+			if inRange(activity.Begin, req) {
+				data.Activities = append(data.Activities, activity)
+			}
+		}
+	case GetEcoReport:
+		for _, report := range s.ecoReports[req.ID] {
+			if inRange(report.Begin, req) {
+				report.DriverTransicsID = req.ID
+				data.EcoReports = append(data.EcoReports, report)
+			}
+		}
+	case GetTachoData:
+		for _, activity := range s.tacho[req.ID] {
+			if inRange(activity.Begin, req) {
+				activity.DriverTransicsID = req.ID
+				data.TachoActivities = append(data.TachoActivities, activity)
+			}
+		}
+	case SendTextMessage:
+		s.messages = append(s.messages, Message{VehicleTransicsID: req.ID, Text: req.Message})
+		data.Messages = s.messages[len(s.messages)-1:]
+	}
+
+	return data
+}
+
+//inRange checks if a date is contained in the date range of a request
+//as TX-TANGO, the end date is exclusive
+func inRange(date time.Time, req *request) bool {
+	start, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		return false
+	}
+
+	return !date.Before(start) && date.Before(end)
+}
+
+//parseRequest finds the operation and the selection of a SOAP request
+func parseRequest(body []byte) (*request, error) {
+	var envelope struct {
+		Body struct {
+			Operation struct {
+				XMLName xml.Name
+			} `xml:",any"`
+		} `xml:"Body"`
+	}
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return nil, err
+	}
+
+	req := &request{Operation: envelope.Body.Operation.XMLName.Local}
+
+	//the selection block differs between operations, look for it generically
+	var selection struct {
+		VehicleID  []uint `xml:"Body>Get_ActivityReport_V11>ActivityReportSelection>Vehicles>IdentifierVehicle>Id"`
+		ActStart   string `xml:"Body>Get_ActivityReport_V11>ActivityReportSelection>DateTimeRangeSelection>StartDate"`
+		ActEnd     string `xml:"Body>Get_ActivityReport_V11>ActivityReportSelection>DateTimeRangeSelection>EndDate"`
+		DriverID   []uint `xml:"Body>Get_EcoMonitor_Report_V4>EcoMonitorReportSelection>Drivers>Identifier>Id"`
+		EcoStart   string `xml:"Body>Get_EcoMonitor_Report_V4>EcoMonitorReportSelection>DateTimeRangeSelection>StartDate"`
+		EcoEnd     string `xml:"Body>Get_EcoMonitor_Report_V4>EcoMonitorReportSelection>DateTimeRangeSelection>EndDate"`
+		TachoID    []uint `xml:"Body>Get_Tacho_Data_V2>TachoDataSelection>Drivers>IdentifierDriver>Id"`
+		TachoStart string `xml:"Body>Get_Tacho_Data_V2>TachoDataSelection>DateTimeRangeSelection>StartDate"`
+		TachoEnd   string `xml:"Body>Get_Tacho_Data_V2>TachoDataSelection>DateTimeRangeSelection>EndDate"`
+		MessageID  []uint `xml:"Body>Send_TextMessage>TextMessageSend>Vehicles>IdentifierVehicle>Id"`
+		Message    string `xml:"Body>Send_TextMessage>TextMessageSend>Message"`
+	}
+	if err := xml.Unmarshal(body, &selection); err != nil {
+		return nil, err
+	}
+
+	switch req.Operation {
+	case GetActivityReport:
+		req.ID = first(selection.VehicleID)
+		req.StartDate, req.EndDate = selection.ActStart, selection.ActEnd
+	case GetEcoReport:
+		req.ID = first(selection.DriverID)
+		req.StartDate, req.EndDate = selection.EcoStart, selection.EcoEnd
+	case GetTachoData:
+		req.ID = first(selection.TachoID)
+		req.StartDate, req.EndDate = selection.TachoStart, selection.TachoEnd
+	case SendTextMessage:
+		req.ID = first(selection.MessageID)
+		req.Message = selection.Message
+	}
+
+	return req, nil
+}
+
+//first returns the first id of a selection
+func first(ids []uint) uint {
+	if len(ids) == 0 {
+		return 0
+	}
+	return ids[0]
+}
+
+//templateFuncs are the helpers used in the response templates
+var templateFuncs = template.FuncMap{
+	"date": func(t time.Time) string {
+		return t.Format(transicsDateFormat)
+	},
+	"escape": func(s string) string {
+		buf := &bytes.Buffer{}
+		xml.EscapeText(buf, []byte(s))
+		return buf.String()
+	},
+}
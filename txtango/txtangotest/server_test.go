@@ -0,0 +1,160 @@
+package txtangotest
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+	"tx2db/txtango"
+)
+
+//day returns the midnight of a fixed day in UTC as the dates sent by TX-TANGO
+func day(offset int) time.Time {
+	return time.Date(2020, 2, 10, 0, 0, 0, 0, time.UTC).AddDate(0, 0, offset)
+}
+
+func TestServerAnswersFixtures(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	server.AddDriver(Driver{TransicsID: 1, Name: "Driver One"}, Driver{TransicsID: 2, Name: "Driver Two"})
+	server.AddVehicle(Vehicle{TransicsID: 100, LicensePlate: "1-ABC-123", DriverTransicsID: 1})
+	server.AddActivity(100,
+		Activity{Begin: day(0).Add(8 * time.Hour), End: day(0).Add(9 * time.Hour), Name: "Driving"},
+		Activity{Begin: day(1).Add(8 * time.Hour), End: day(1).Add(9 * time.Hour), Name: "Driving"},
+		Activity{Begin: day(2), End: day(2).Add(time.Hour), Name: "Driving"},
+	)
+	server.AddEcoReport(1, EcoReport{VehicleTransicsID: 100, Begin: day(0).Add(8 * time.Hour), End: day(0).Add(9 * time.Hour), Distance: 80})
+
+	drivers, err := client.GetDrivers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(drivers.Body.GetDriversV9Response.GetDriversV9Result.Persons.InterfacePersonResultV9); got != 2 {
+		t.Errorf("got %d drivers, want 2", got)
+	}
+
+	vehicles, err := client.GetVehicle(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := vehicles.Body.GetVehiclesV13Response.GetVehiclesV13Result.Vehicles.InterfaceVehicleResultV13; len(got) != 1 || got[0].LicensePlate != "1-ABC-123" {
+		t.Errorf("got vehicles %+v, want 1-ABC-123", got)
+	}
+
+	//the end date of the range is exclusive
+	activities, err := client.GetActivityReport(ctx, 100, day(0), day(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(activities.Body.GetActivityReportV11Response.GetActivityReportV11Result.ActivityReportItems.ActivityReportItemV11); got != 2 {
+		t.Errorf("got %d activities, want 2", got)
+	}
+
+	//the reports are those of the requested driver
+	for _, driverID := range []uint{1, 2} {
+		reports, err := client.GetEcoReport(ctx, driverID, day(0), day(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		items := reports.Body.GetEcoMonitorReportV4Response.GetEcoMonitorReportV4Result.EcoMonitorReportItems.EcoMonitorReportItemV3
+		if want := int(2 - driverID); len(items) != want {
+			t.Errorf("got %d eco reports of driver %d, want %d", len(items), driverID, want)
+		}
+	}
+
+	if _, err := client.SendMessage(ctx, 100, "Back at 18:00"); err != nil {
+		t.Fatal(err)
+	}
+	if got := server.Messages(); len(got) != 1 || got[0] != (Message{VehicleTransicsID: 100, Text: "Back at 18:00"}) {
+		t.Errorf("got messages %+v", got)
+	}
+
+	if got := server.Calls(GetEcoReport); got != 2 {
+		t.Errorf("got %d calls of %s, want 2", got, GetEcoReport)
+	}
+}
+
+func TestServerErrorsAndWarnings(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.SetWarning(GetDrivers, Entry{Code: "NO_DATA", Field: "Persons"}, Entry{Code: "PARTIAL_RESULT"})
+	drivers, err := client.GetDrivers(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if warnings := drivers.Body.GetDriversV9Response.GetDriversV9Result.Warnings.Warnings; len(warnings) != 2 || warnings[0].Code != "NO_DATA" || warnings[0].Field != "Persons" {
+		t.Errorf("got warnings %+v, want NO_DATA and PARTIAL_RESULT", warnings)
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr interface{}
+	}{
+		{
+			name:    "TX-TANGO error",
+			setup:   func() { server.SetError(GetDrivers, Entry{Code: "LOGIN_FAILED", Explanation: "Wrong <password>"}) },
+			wantErr: &txtango.APIError{},
+		},
+		{
+			name:    "SOAP fault",
+			setup:   func() { server.SetFault(GetDrivers, &Fault{Code: "soap:Server", String: "Internal error"}) },
+			wantErr: &txtango.SOAPFault{},
+		},
+		{
+			name:    "HTTP error",
+			setup:   func() { server.SetHTTPStatus(GetDrivers, http.StatusServiceUnavailable) },
+			wantErr: &txtango.HTTPError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer func() {
+				server.SetError(GetDrivers)
+				server.SetFault(GetDrivers, nil)
+				server.SetHTTPStatus(GetDrivers, 0)
+			}()
+
+			_, err := client.GetDrivers(context.Background())
+			if err == nil || reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Errorf("got error %T (%v), want %T", err, err, tt.wantErr)
+			}
+		})
+	}
+
+	//removing the entries restores a clean answer
+	if _, err := client.GetDrivers(context.Background()); err != nil {
+		t.Errorf("got error %v after removing it", err)
+	}
+}
+
+func TestServerDelay(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.SetDelay(GetVehicles, time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.GetVehicle(ctx); err == nil {
+		t.Error("got no error, want the request to be cancelled")
+	}
+}
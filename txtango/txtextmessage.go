@@ -1,6 +1,7 @@
 package txtango
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -21,7 +22,7 @@ var sendTextMessageTemplate = `
 	  </Vehicles>
 	  <VehicleType>NONE</VehicleType>
 	  <ForceOBCWakeUp>true</ForceOBCWakeUp>
-	  <Message>{{.Text}}</Message>
+	  <Message>{{.Message}}</Message>
 	</TextMessageSend>
   </Send_TextMessage>
 </soap:Body>
@@ -73,14 +74,14 @@ type SentTextMessageResponse struct {
 }
 
 //SendMessage wraps SAOPCall to make a Send_TextMessage request
-func SendMessage(vehicleTransicsID uint, text string) (*SentTextMessageResponse, error) {
+func (c *Client) SendMessage(ctx context.Context, vehicleTransicsID uint, text string) (*SentTextMessageResponse, error) {
 	//make an authenticated request
 	params := &SentTextMessageRequest{
-		Login:             *authenticate(),
+		Login:             *c.authenticate(),
 		VehicleTransicsID: vehicleTransicsID,
 		Message:           text,
 	}
-	resp, err := soapCall(params, "SendMessage", sendTextMessageTemplate)
+	resp, err := c.soapCall(ctx, params, "SendMessage", sendTextMessageTemplate)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,34 @@
+package txtango
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSendMessageRendersText(t *testing.T) {
+	var request string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		request = string(body)
+		w.Write([]byte(`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body/></soap:Envelope>`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{BaseURL: server.URL, Dispatcher: "dispatcher"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.SendMessage(context.Background(), 100, "Back at 18:00"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"<Id>100</Id>", "<Message>Back at 18:00</Message>"} {
+		if !strings.Contains(request, want) {
+			t.Errorf("request does not contain %s:\n%s", want, request)
+		}
+	}
+}
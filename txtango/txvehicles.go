@@ -1,6 +1,7 @@
 package txtango
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -57,137 +58,140 @@ type GetVehicleResponse struct {
 				Errors        TXError   `xml:"Errors"`
 				Warnings      TXWarning `xml:"Warnings"`
 				Vehicles      struct {
-					Text                      string `xml:",chardata"`
-					InterfaceVehicleResultV13 []struct {
-						Text               string `xml:",chardata"`
-						VehicleFleetNumber string `xml:"VehicleFleetNumber"`
-						Groups             struct {
-							Text            string `xml:",chardata"`
-							TxConnectGroups struct {
-								Text          string `xml:",chardata"`
-								ConnectGroups struct {
-									Text         string `xml:",chardata"`
-									ConnectGroup []struct {
-										Text     string `xml:",chardata"`
-										Group    string `xml:"Group"`
-										SubGroup string `xml:"SubGroup"`
-									} `xml:"ConnectGroup"`
-								} `xml:"ConnectGroups"`
-							} `xml:"TxConnectGroups"`
-						} `xml:"Groups"`
-						ExtraTruckInfo struct {
-							Text    string `xml:",chardata"`
-							Country string `xml:"Country"`
-							InDuty  struct {
-								Text string `xml:",chardata"`
-								Nil  string `xml:"nil,attr"`
-							} `xml:"InDuty"`
-							OutDuty struct {
-								Text string `xml:",chardata"`
-								Nil  string `xml:"nil,attr"`
-							} `xml:"OutDuty"`
-							VinNumber string `xml:"VinNumber"`
-							Category  string `xml:"Category"`
-						} `xml:"ExtraTruckInfo"`
-						VehicleID           string `xml:"VehicleID"`
-						VehicleExternalCode string `xml:"VehicleExternalCode"`
-						LicensePlate        string `xml:"LicensePlate"`
-						Inactive            bool   `xml:"Inactive"`
-						CanBusConnection    struct {
-							Text string `xml:",chardata"`
-							Nil  string `xml:"nil,attr"`
-						} `xml:"CanBusConnection"`
-						Trailer           TXTrailer `xml:"Trailer"`
-						VehicleTransicsID uint      `xml:"VehicleTransicsID"`
-						Modified          string    `xml:"Modified"`
-						CurrentKms        struct {
-							Text string `xml:",chardata"`
-							Nil  string `xml:"nil,attr"`
-						} `xml:"CurrentKms"`
-						FuelLevel struct {
-							Text string `xml:",chardata"`
-							Nil  string `xml:"nil,attr"`
-						} `xml:"FuelLevel"`
-						FuelLevelIndex struct {
-							Text string `xml:",chardata"`
-							Nil  string `xml:"nil,attr"`
-						} `xml:"FuelLevelIndex"`
-						RefrigeratorIndex struct {
-							Text string `xml:",chardata"`
-							Nil  string `xml:"nil,attr"`
-						} `xml:"RefrigeratorIndex"`
-						Speed             string `xml:"Speed"`
-						ActivityCompleted struct {
-							Text string `xml:",chardata"`
-							Nil  string `xml:"nil,attr"`
-						} `xml:"ActivityCompleted"`
-						Driver struct {
-							Text          string `xml:",chardata"`
-							ID            string `xml:"ID"`
-							TransicsID    uint   `xml:"TransicsID"`
-							Code          string `xml:"Code"`
-							Filter        string `xml:"Filter"`
-							LastName      string `xml:"LastName"`
-							FirstName     string `xml:"FirstName"`
-							FormattedName string `xml:"FormattedName"`
-						} `xml:"Driver"`
-						ETAInfo struct {
-							Text                string `xml:",chardata"`
-							PositionDestination struct {
-								Text      string  `xml:",chardata"`
-								Longitude float32 `xml:"Longitude"`
-								Latitude  float32 `xml:"Latitude"`
-							} `xml:"PositionDestination"`
-							PrevETA struct {
-								Text string `xml:",chardata"`
-								Nil  string `xml:"nil,attr"`
-							} `xml:"PrevETA"`
-							ETAStatus struct {
-								Text string `xml:",chardata"`
-								Nil  string `xml:"nil,attr"`
-							} `xml:"ETAStatus"`
-							DistanceETA struct {
-								Text string `xml:",chardata"`
-								Nil  string `xml:"nil,attr"`
-							} `xml:"DistanceETA"`
-							ETA struct {
-								Text string `xml:",chardata"`
-								Nil  string `xml:"nil,attr"`
-							} `xml:"ETA"`
-							PositionInfoDestination string `xml:"PositionInfoDestination"`
-							EtaRestIncluded         struct {
-								Text string `xml:",chardata"`
-								Nil  string `xml:"nil,attr"`
-							} `xml:"EtaRestIncluded"`
-						} `xml:"ETAInfo"`
-						UpdateDatesList struct {
-							Text            string `xml:",chardata"`
-							UpdateDatesItem []struct {
-								Text           string `xml:",chardata"`
-								Name           string `xml:"Name"`
-								DateLastUpdate struct {
-									Text string `xml:",chardata"`
-									Nil  string `xml:"nil,attr"`
-								} `xml:"DateLastUpdate"`
-							} `xml:"UpdateDatesItem"`
-						} `xml:"UpdateDatesList"`
-						Maintenance     string `xml:"Maintenance"`
-						Remaining       string `xml:"Remaining"`
-						AutoFilter      string `xml:"AutoFilter"`
-						CompanyCardInfo struct {
-							Text     string `xml:",chardata"`
-							CardID   string `xml:"CardId"`
-							CardName string `xml:"CardName"`
-						} `xml:"CompanyCardInfo"`
-						FormattedName   string `xml:"FormattedName"`
-						LastTrailerCode string `xml:"LastTrailerCode"`
-					} `xml:"InterfaceVehicleResult_V13"`
+					Text                      string      `xml:",chardata"`
+					InterfaceVehicleResultV13 []TXVehicle `xml:"InterfaceVehicleResult_V13"`
 				} `xml:"Vehicles"`
 			} `xml:"Get_Vehicles_V13Result"`
 		} `xml:"Get_Vehicles_V13Response"`
 	} `xml:"Body"`
 }
 
+//TXVehicle represent a vehicle from GetVehicleResponse
+type TXVehicle struct {
+	Text               string `xml:",chardata"`
+	VehicleFleetNumber string `xml:"VehicleFleetNumber"`
+	Groups             struct {
+		Text            string `xml:",chardata"`
+		TxConnectGroups struct {
+			Text          string `xml:",chardata"`
+			ConnectGroups struct {
+				Text         string `xml:",chardata"`
+				ConnectGroup []struct {
+					Text     string `xml:",chardata"`
+					Group    string `xml:"Group"`
+					SubGroup string `xml:"SubGroup"`
+				} `xml:"ConnectGroup"`
+			} `xml:"ConnectGroups"`
+		} `xml:"TxConnectGroups"`
+	} `xml:"Groups"`
+	ExtraTruckInfo struct {
+		Text    string `xml:",chardata"`
+		Country string `xml:"Country"`
+		InDuty  struct {
+			Text string `xml:",chardata"`
+			Nil  string `xml:"nil,attr"`
+		} `xml:"InDuty"`
+		OutDuty struct {
+			Text string `xml:",chardata"`
+			Nil  string `xml:"nil,attr"`
+		} `xml:"OutDuty"`
+		VinNumber string `xml:"VinNumber"`
+		Category  string `xml:"Category"`
+	} `xml:"ExtraTruckInfo"`
+	VehicleID           string `xml:"VehicleID"`
+	VehicleExternalCode string `xml:"VehicleExternalCode"`
+	LicensePlate        string `xml:"LicensePlate"`
+	Inactive            bool   `xml:"Inactive"`
+	CanBusConnection    struct {
+		Text string `xml:",chardata"`
+		Nil  string `xml:"nil,attr"`
+	} `xml:"CanBusConnection"`
+	Trailer           TXTrailer `xml:"Trailer"`
+	VehicleTransicsID uint      `xml:"VehicleTransicsID"`
+	Modified          string    `xml:"Modified"`
+	CurrentKms        struct {
+		Text string `xml:",chardata"`
+		Nil  string `xml:"nil,attr"`
+	} `xml:"CurrentKms"`
+	FuelLevel struct {
+		Text string `xml:",chardata"`
+		Nil  string `xml:"nil,attr"`
+	} `xml:"FuelLevel"`
+	FuelLevelIndex struct {
+		Text string `xml:",chardata"`
+		Nil  string `xml:"nil,attr"`
+	} `xml:"FuelLevelIndex"`
+	RefrigeratorIndex struct {
+		Text string `xml:",chardata"`
+		Nil  string `xml:"nil,attr"`
+	} `xml:"RefrigeratorIndex"`
+	Speed             string `xml:"Speed"`
+	ActivityCompleted struct {
+		Text string `xml:",chardata"`
+		Nil  string `xml:"nil,attr"`
+	} `xml:"ActivityCompleted"`
+	Driver struct {
+		Text          string `xml:",chardata"`
+		ID            string `xml:"ID"`
+		TransicsID    uint   `xml:"TransicsID"`
+		Code          string `xml:"Code"`
+		Filter        string `xml:"Filter"`
+		LastName      string `xml:"LastName"`
+		FirstName     string `xml:"FirstName"`
+		FormattedName string `xml:"FormattedName"`
+	} `xml:"Driver"`
+	ETAInfo struct {
+		Text                string `xml:",chardata"`
+		PositionDestination struct {
+			Text      string  `xml:",chardata"`
+			Longitude float32 `xml:"Longitude"`
+			Latitude  float32 `xml:"Latitude"`
+		} `xml:"PositionDestination"`
+		PrevETA struct {
+			Text string `xml:",chardata"`
+			Nil  string `xml:"nil,attr"`
+		} `xml:"PrevETA"`
+		ETAStatus struct {
+			Text string `xml:",chardata"`
+			Nil  string `xml:"nil,attr"`
+		} `xml:"ETAStatus"`
+		DistanceETA struct {
+			Text string `xml:",chardata"`
+			Nil  string `xml:"nil,attr"`
+		} `xml:"DistanceETA"`
+		ETA struct {
+			Text string `xml:",chardata"`
+			Nil  string `xml:"nil,attr"`
+		} `xml:"ETA"`
+		PositionInfoDestination string `xml:"PositionInfoDestination"`
+		EtaRestIncluded         struct {
+			Text string `xml:",chardata"`
+			Nil  string `xml:"nil,attr"`
+		} `xml:"EtaRestIncluded"`
+	} `xml:"ETAInfo"`
+	UpdateDatesList struct {
+		Text            string `xml:",chardata"`
+		UpdateDatesItem []struct {
+			Text           string `xml:",chardata"`
+			Name           string `xml:"Name"`
+			DateLastUpdate struct {
+				Text string `xml:",chardata"`
+				Nil  string `xml:"nil,attr"`
+			} `xml:"DateLastUpdate"`
+		} `xml:"UpdateDatesItem"`
+	} `xml:"UpdateDatesList"`
+	Maintenance     string `xml:"Maintenance"`
+	Remaining       string `xml:"Remaining"`
+	AutoFilter      string `xml:"AutoFilter"`
+	CompanyCardInfo struct {
+		Text     string `xml:",chardata"`
+		CardID   string `xml:"CardId"`
+		CardName string `xml:"CardName"`
+	} `xml:"CompanyCardInfo"`
+	FormattedName   string `xml:"FormattedName"`
+	LastTrailerCode string `xml:"LastTrailerCode"`
+}
+
 //TXTrailer represent a trailer from GetVehicleResponse
 type TXTrailer struct {
 	Text          string `xml:",chardata"`
@@ -200,12 +204,12 @@ type TXTrailer struct {
 }
 
 //GetVehicle wraps SAOPCall to make a Get_Vehicles_V13 request
-func GetVehicle() (*GetVehicleResponse, error) {
+func (c *Client) GetVehicle(ctx context.Context) (*GetVehicleResponse, error) {
 	//make an authenticated request
 	params := &GetVehicleRequest{
-		Login: *authenticate(),
+		Login: *c.authenticate(),
 	}
-	resp, err := soapCall(params, "GetVehicle", getVehiculeTemplate)
+	resp, err := c.soapCall(ctx, params, "GetVehicle", getVehiculeTemplate)
 	if err != nil {
 		return nil, err
 	}
@@ -5,12 +5,22 @@ import (
 	"net/smtp"
 	"os"
 	"strings"
+	"time"
+	"tx2db/locale"
 
 	"github.com/jordan-wright/email"
 )
 
-//InformDriver sends a mail attaching the report to a specific email address
-func InformDriver(recipient, attachmentPath, startTime, endTime string) error {
+//mailLanguageEnv is the environment variable containing the language of the mails to the staff, English when empty
+const mailLanguageEnv = "MAIL_LANGUAGE"
+
+//staffLocale returns the locale of the mails to the instructor and the system administrator
+func staffLocale() *locale.Locale {
+	return locale.Get(os.Getenv(mailLanguageEnv))
+}
+
+//InformDriver sends a mail attaching the report to a specific email address, in the language of the driver
+func InformDriver(recipient, language, attachmentPath string, startTime, endTime time.Time) error {
 	//mail credentials
 	mailServer := os.Getenv("MAIL_SERVER")
 	mailAddress := os.Getenv("MAIL_EMAIL")
@@ -20,8 +30,9 @@ func InformDriver(recipient, attachmentPath, startTime, endTime string) error {
 	e := email.NewEmail()
 	e.From = fmt.Sprintf("TX2DB Analysis <%s>", mailAddress)
 	e.To = []string{recipient}
-	e.Subject = "[TX2DB] You have received a new analysis"
-	e.Text = []byte(fmt.Sprintf("Hello,\nYour weekly analysis for the period %s to %s is available.\nHave a great day!\n\nThis email has been automatically generated.", startTime, endTime))
+	loc := locale.Get(language)
+	e.Subject = loc.T("mail.driver.subject")
+	e.Text = []byte(loc.Tf("mail.driver.body", loc.Date(startTime), loc.Date(endTime)))
 	e.AttachFile(attachmentPath)
 
 	err := e.Send(mailServer, LoginAuth(mailAddress, mailPassword))
@@ -33,7 +44,7 @@ func InformDriver(recipient, attachmentPath, startTime, endTime string) error {
 }
 
 //InformInstructor sends a mail attaching all reports to a specific email address
-func InformInstructor(startTime, endTime string) error {
+func InformInstructor(startTime, endTime time.Time) error {
 	//mail credentials
 	mailServer := os.Getenv("MAIL_SERVER")
 	mailAddress := os.Getenv("MAIL_EMAIL")
@@ -46,8 +57,9 @@ func InformInstructor(startTime, endTime string) error {
 	e := email.NewEmail()
 	e.From = fmt.Sprintf("TX2DB Analysis <%s>", mailAddress)
 	e.To = []string{instructor}
-	e.Subject = "[TX2DB] New weekly driver analysis available"
-	e.Text = []byte(fmt.Sprintf("Hello,\nThe weekly driver analysis for the period %s to %s are available from the Bolk FTP Server.\nHave a great day!\n\nThis email has been automatically generated.", startTime, endTime))
+	loc := staffLocale()
+	e.Subject = loc.T("mail.instructor.subject")
+	e.Text = []byte(loc.Tf("mail.instructor.body", loc.Date(startTime), loc.Date(endTime)))
 
 	if err := e.Send(mailServer, LoginAuth(mailAddress, mailPassword)); err != nil {
 		return err
@@ -70,8 +82,9 @@ func InformSystemAdministratorDriverEmailMissing(driverPersonID string) error {
 	e := email.NewEmail()
 	e.From = fmt.Sprintf("TX2DB Import/Analysis <%s>", mailAddress)
 	e.To = []string{administrator}
-	e.Subject = "[TX2DB] A driver mail needs to be added"
-	e.Text = []byte(fmt.Sprintf("Hello,\nThe driver (personID: %s) does not have an associated email in the TX2DB database. Please add its email in the 'Driver' table so he/she can receive their weekly report.\nHave a great day!\n\nThis email has been automatically generated.", driverPersonID))
+	loc := staffLocale()
+	e.Subject = loc.T("mail.driver_email_missing.subject")
+	e.Text = []byte(loc.Tf("mail.driver_email_missing.body", driverPersonID))
 
 	err := e.Send(mailServer, LoginAuth(mailAddress, mailPassword))
 	if err != nil {
@@ -95,8 +108,35 @@ func InformSystemAdministratorFTPError(filePath string) error {
 	e := email.NewEmail()
 	e.From = fmt.Sprintf("TX2DB Analysis <%s>", mailAddress)
 	e.To = []string{administrator}
-	e.Subject = "[TX2DB] FTP upload failed"
-	e.Text = []byte(fmt.Sprintf("Hello,\n Something wrong happen while uploading the weekly report to the FTP. Manual upload is hence necessary. The weekly report can be found in _%s_.\nHave a great day!\n\nThis email has been automatically generated.", filePath))
+	loc := staffLocale()
+	e.Subject = loc.T("mail.ftp_error.subject")
+	e.Text = []byte(loc.Tf("mail.ftp_error.body", filePath))
+
+	err := e.Send(mailServer, LoginAuth(mailAddress, mailPassword))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+//InformSystemAdministratorFuelAnomalies sends the daily digest of the fuel anomalies to the system administrator
+func InformSystemAdministratorFuelAnomalies(startTime, endTime time.Time, anomalies []string) error {
+	//mail credentials
+	mailServer := os.Getenv("MAIL_SERVER")
+	mailAddress := os.Getenv("MAIL_EMAIL")
+	mailPassword := os.Getenv("MAIL_PASSWORD")
+
+	//recipient
+	administrator := os.Getenv("SYSTEM_ADMINISTATOR_EMAIL")
+
+	//build mail
+	e := email.NewEmail()
+	e.From = fmt.Sprintf("TX2DB Import/Analysis <%s>", mailAddress)
+	e.To = []string{administrator}
+	loc := staffLocale()
+	e.Subject = loc.Tf("mail.fuel_anomalies.subject", len(anomalies))
+	e.Text = []byte(loc.Tf("mail.fuel_anomalies.body", loc.Date(startTime), loc.Date(endTime), strings.Join(anomalies, "\n")))
 
 	err := e.Send(mailServer, LoginAuth(mailAddress, mailPassword))
 	if err != nil {